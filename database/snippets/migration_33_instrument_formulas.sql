@@ -0,0 +1,211 @@
+-- formula (computed timeseries for an instrument)
+CREATE TABLE IF NOT EXISTS formula (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    instrument_id UUID NOT NULL REFERENCES instrument(id) ON DELETE CASCADE,
+    slug VARCHAR(240) NOT NULL,
+    name VARCHAR(240) NOT NULL,
+    formula TEXT NOT NULL,
+    parameter_id UUID NOT NULL REFERENCES parameter(id) DEFAULT '2b7f96e1-820f-4f61-ba8f-861640af6232',
+    unit_id UUID NOT NULL REFERENCES unit(id) DEFAULT '4a999277-4cf5-4282-93ce-23b33c65e2c8',
+    creator UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updater UUID,
+    update_date TIMESTAMPTZ,
+    CONSTRAINT instrument_unique_formula_name UNIQUE(instrument_id, name),
+    CONSTRAINT instrument_unique_formula_slug UNIQUE(instrument_id, slug)
+);
+
+-- Migrate existing instrument formulas; formula_id is kept as the formula id
+-- so computed timeseries IDs already referenced by clients do not change
+INSERT INTO formula (id, instrument_id, slug, name, formula, parameter_id, unit_id, creator, create_date) (
+    SELECT formula_id,
+           id,
+           'formula',
+           'Formula',
+           formula,
+           COALESCE(formula_parameter_id, '2b7f96e1-820f-4f61-ba8f-861640af6232'),
+           COALESCE(formula_unit_id, '4a999277-4cf5-4282-93ce-23b33c65e2c8'),
+           creator,
+           create_date
+    FROM instrument
+    WHERE formula IS NOT NULL
+);
+
+DROP VIEW v_timeseries_dependency;
+DROP VIEW v_timeseries_latest;
+DROP VIEW v_timeseries;
+DROP VIEW v_instrument;
+
+ALTER TABLE instrument
+    DROP COLUMN formula_id,
+    DROP COLUMN formula,
+    DROP COLUMN formula_parameter_id,
+    DROP COLUMN formula_unit_id;
+
+-- v_instrument
+CREATE OR REPLACE VIEW v_instrument AS (
+    SELECT I.id,
+        I.deleted,
+        S.status_id,
+        S.status,
+        S.status_time,
+        I.slug,
+        I.name,
+        I.type_id,
+        T.name AS type,
+        ST_AsBinary(I.geometry) AS geometry,
+        I.station,
+        I.station_offset,
+        I.creator,
+        I.create_date,
+        I.updater,
+        I.update_date,
+        I.project_id,
+        I.nid_id,
+        I.usgs_id,
+        TEL.telemetry AS telemetry,
+        COALESCE(C.constants, '{}') AS constants,
+        COALESCE(G.groups, '{}') AS groups,
+        COALESCE(A.alert_configs, '{}') AS alert_configs,
+        COALESCE(F.formulas, '{}') AS formulas
+    FROM instrument I
+    INNER JOIN instrument_type T ON T.id = I.type_id
+    INNER JOIN (
+        SELECT DISTINCT ON (instrument_id) instrument_id,
+            a.time AS status_time,
+            a.status_id AS status_id,
+            d.name AS status
+        FROM instrument_status a
+        INNER JOIN status d ON d.id = a.status_id
+        WHERE a.time <= now()
+        ORDER BY instrument_id, a.time DESC
+    ) S ON S.instrument_id = I.id
+    LEFT JOIN (
+        SELECT array_agg(timeseries_id) as constants,
+            instrument_id
+        FROM instrument_constants
+        GROUP BY instrument_id
+    ) C on C.instrument_id = I.id
+    LEFT JOIN (
+        SELECT array_agg(instrument_group_id) as groups,
+            instrument_id
+        FROM instrument_group_instruments
+        GROUP BY instrument_id
+    ) G on G.instrument_id = I.id
+    LEFT JOIN (
+        SELECT array_agg(id) as alert_configs,
+            instrument_id
+        FROM alert_config
+        GROUP BY instrument_id
+    ) A on A.instrument_id = I.id
+    LEFT JOIN (
+        SELECT array_agg(id) as formulas,
+            instrument_id
+        FROM formula
+        GROUP BY instrument_id
+    ) F on F.instrument_id = I.id
+    LEFT JOIN (
+        SELECT instrument_id,
+                json_agg(
+                    json_build_object(
+                        'id', v.id,
+                        'slug', v.telemetry_type_slug,
+                        'name', v.telemetry_type_name
+                    )
+                ) AS telemetry
+        FROM v_instrument_telemetry v
+        GROUP BY instrument_id
+    ) TEL ON TEL.instrument_id = I.id
+);
+
+-- v_timeseries
+CREATE OR REPLACE VIEW v_timeseries AS (
+    WITH ts_stored_and_computed AS (
+        SELECT id,
+            slug,
+            name,
+            instrument_id,
+            parameter_id,
+            unit_id,
+            false                AS is_computed
+        FROM timeseries
+        UNION
+        SELECT f.id              AS id,
+            f.slug               AS slug,
+            f.name               AS name,
+            f.instrument_id      AS instrument_id,
+            f.parameter_id       AS parameter_id,
+            f.unit_id            AS unit_id,
+            true                 AS is_computed
+        FROM formula f
+        INNER JOIN instrument i ON i.id = f.instrument_id
+        WHERE NOT i.deleted
+    )
+    SELECT t.id                 AS id,
+        t.slug                  AS slug,
+        t.name                  AS name,
+        t.is_computed           AS is_computed,
+        i.slug || '.' || t.slug AS variable,
+        j.id                    AS project_id,
+        j.slug                  AS project_slug,
+        j.name                  AS project,
+        i.id                    AS instrument_id,
+        i.slug                  AS instrument_slug,
+        i.name                  AS instrument,
+        p.id                    AS parameter_id,
+        p.name                  AS parameter,
+        u.id                    AS unit_id,
+        u.name                  AS unit
+    FROM ts_stored_and_computed t
+    INNER JOIN instrument i ON i.id = t.instrument_id
+    INNER JOIN project j ON j.id = i.project_id
+    INNER JOIN parameter p ON p.id = t.parameter_id
+    INNER JOIN unit U ON u.id = t.unit_id
+);
+
+-- v_timeseries_latest; same as v_timeseries, joined with latest times and values
+CREATE OR REPLACE VIEW v_timeseries_latest AS (
+    SELECT t.*,
+       m.time AS latest_time,
+	   m.value AS latest_value
+    FROM v_timeseries t
+    LEFT JOIN (
+	    SELECT DISTINCT ON (timeseries_id) timeseries_id, time, value
+	    FROM timeseries_measurement
+	    ORDER BY timeseries_id, time DESC
+    ) m ON t.id = m.timeseries_id
+);
+
+-- Only Includes Computed Timeseries
+-- Note: timeseries_id in this table is the formula id for a given instrument
+CREATE OR REPLACE VIEW v_timeseries_dependency AS (
+    WITH variable_tsid_map AS (
+	    SELECT a.id AS timeseries_id,
+               b.slug || '.' || a.slug AS variable
+	    FROM timeseries a
+	    LEFT JOIN instrument b ON b.id = a.instrument_id
+    )
+    SELECT f.instrument_id   AS instrument_id,
+           f.formula_id      AS timeseries_id,
+           f.parsed_variable AS parsed_variable,
+           m.timeseries_id   AS dependency_timeseries_id
+    FROM (
+        SELECT instrument_id,
+            id AS formula_id,
+            (regexp_matches(formula, '\[(.*?)\]', 'g'))[1] AS parsed_variable
+        FROM formula
+    ) f
+    LEFT JOIN variable_tsid_map m ON m.variable = f.parsed_variable
+);
+
+GRANT SELECT ON
+    formula,
+    v_instrument,
+    v_timeseries,
+    v_timeseries_latest,
+    v_timeseries_dependency
+TO instrumentation_reader;
+
+GRANT INSERT,UPDATE,DELETE ON
+    formula
+TO instrumentation_writer;
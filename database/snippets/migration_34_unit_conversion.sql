@@ -0,0 +1,151 @@
+-- unit conversion factors
+ALTER TABLE unit ADD COLUMN si_factor DOUBLE PRECISION;
+ALTER TABLE unit ADD COLUMN si_offset DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+-- unit conversion factors; value in SI unit of measure = value * si_factor + si_offset
+UPDATE unit u SET si_factor = c.si_factor, si_offset = c.si_offset
+FROM (VALUES
+    -- length (meters)
+    ('3ba8644b-46d6-46d2-88ad-8abfb8c1d89e', 0.01, 0),
+    ('2e596176-a176-4f1f-b9d4-7bd03f283eda', 0.01, 0),
+    ('2f50063c-121b-4926-8793-49a9a1baac51', 1000, 0),
+    ('aaf6631a-51a0-478a-be9c-997ffb5a3beb', 1000, 0),
+    ('ae06a7db-1e18-4994-be41-9d5a408d6cad', 1, 0),
+    ('4b503088-fab3-46cf-a5e1-44bf9fbf6ad9', 1, 0),
+    ('612d6fa5-954a-40fd-86d6-c21a75fe6cff', 0.001, 0),
+    ('22c1bb95-4e0c-4e53-a24d-c1327fd11575', 0.001, 0),
+    ('84d8a118-71e3-4541-a7a1-8d4cac9e7b35', 0.000001, 0),
+    ('f777f2e2-5e32-424e-a1ca-19d16cd8abce', 0.3048, 0),
+    ('4ee79a3d-a053-41b8-85b5-bb2eea3c9d1a', 0.0254, 0),
+    ('cc83a42b-16a7-46a8-b3a6-966bad7ae2d7', 0.9144, 0),
+    ('904c90a3-3e96-49fa-9b0d-778dadbe2bc0', 1609.344, 0),
+    ('cfcf408b-9193-4b80-99ce-1171554c9428', 1852, 0),
+    -- pressure (pascals)
+    ('41d4f482-7b11-4761-998e-ffd5cc901e07', 1, 0),
+    ('50f18926-2953-4335-a96b-7212c4b927ed', 1000, 0),
+    ('b3e51265-85df-44c3-8ad6-b04a902bd755', 1000, 0),
+    ('55dda9ef-7ba6-4432-b64d-8ef0e65154f4', 100, 0),
+    ('4aebf158-1306-4b7d-9e35-9e5998414bba', 100, 0),
+    ('3e6ebdd4-dc07-4854-8057-47a4b11da141', 100000, 0),
+    ('bcc09b92-e21b-4be8-a76f-aa418764a83c', 101325, 0),
+    ('7c3f5f46-d7a1-4cf7-be9f-d82c11398a1d', 6894.757293168, 0),
+    ('39b025ed-488d-42ab-93a2-08ae5cdc8ae0', 3386.389, 0),
+    ('3ccd658d-f656-4fb4-b2b9-913ab7279ada', 3386.389, 0),
+    ('fc5f41e1-f5c6-453b-bda9-97530f400cc7', 133.322387415, 0),
+    ('6407a23f-b5f8-4214-9343-50b6231e4bfe', 133.322387415, 0),
+    -- temperature (kelvin)
+    ('959e4dcb-c871-4b85-bc07-1b5ab644962a', 1, 0),
+    ('2d379b61-fef6-4531-9e58-cace51909e44', 1, 273.15),
+    ('6462733b-5b42-46a2-ad44-882a5332eafc', 1, 273.15),
+    ('10e05b5c-7e96-434b-9182-a547333e1c52', 0.5555555555555556, 255.3722222222222),
+    ('daeee256-c762-43a2-8369-2d295525023c', 0.5555555555555556, 255.3722222222222),
+    -- volume (cubic meters)
+    ('bf9a00d8-084b-4006-b71a-602616f1f59a', 1, 0),
+    ('c4d57208-54ea-420f-802b-8de8698f0616', 1, 0),
+    ('e21b6ee7-de8a-4f6b-ae18-aea85429914c', 1, 0),
+    ('c768c0c8-36e4-495a-bb9a-2065402db3c5', 1000, 0),
+    ('a24cdecc-a4ec-4ca7-bc0c-f8fb6bb39e04', 1000000000, 0),
+    ('7e2b9278-c291-4e08-b375-1fe9377ba535', 0.001, 0),
+    ('3562948d-ff27-4604-8a24-0240eb946682', 0.000001, 0),
+    ('b8c28057-9751-4850-be45-d1ab921faf6a', 0.000001, 0),
+    ('af03fe6f-b203-48fd-8fb0-a985fbe188a0', 0.000000001, 0),
+    ('f505adf3-1f8c-4f05-ae51-06d8ed4e1d9a', 0.028316846592, 0),
+    ('71e15fb3-84a6-4aa2-a7d1-f8a6cfe2d9ef', 0.028316846592, 0),
+    ('259c1ab3-4f70-4279-b698-b28bb1aaa009', 0.000016387064, 0),
+    ('31e65a46-2a67-48f9-9b51-c49f0c82a5dc', 1233.48183754752, 0),
+    ('23aa81c1-74a0-4186-a481-1fd2f146986e', 1233.48183754752, 0),
+    ('b4653aaf-cd50-4589-bf1d-c1ded1247d30', 1233481.83754752, 0),
+    ('1c9e2b24-ec6f-49a6-b18a-7ca1a9a4a79d', 2446.5755455488, 0),
+    ('7c5b5dc4-8c71-43f1-8ae5-914ee21d1ccc', 0.003785411784, 0),
+    ('4df68cc3-2065-412b-af6b-fac5ccf1a087', 3.785411784, 0),
+    ('cffd4714-3378-4c3d-96ed-2d68c11223d7', 3785.411784, 0),
+    ('f4367827-9622-40b9-9ff1-711e14252c05', 0.000946352946, 0),
+    ('19cb5112-0393-47b7-bc38-cb59a5d925d1', 0.000473176473, 0),
+    ('def3ff33-dae6-4a67-b24c-9a1b8bb463f8', 0.0000295735295625, 0),
+    ('5a2922f1-3553-4b0f-88ff-1ce12c47bf5c', 4168181825.440579584, 0),
+    -- area (square meters)
+    ('bf91e9d0-f429-44f5-9774-ea7212ba8113', 1, 0),
+    ('61b725e9-a741-4eb9-b34a-57c9dc4853de', 1, 0),
+    ('3383d7d4-ffa1-4522-a8f5-f16561c5bd2f', 1000, 0),
+    ('42029141-61fc-4280-b1cf-d3ad8a3b210e', 10000, 0),
+    ('fe3715f0-bcaa-4b6e-ac68-5677096ab902', 1000000, 0),
+    ('f3ec6179-8399-41b7-9ce3-049336e8a4fe', 1000000, 0),
+    ('81cb1c58-e050-46ba-b510-71dad374192a', 0.0001, 0),
+    ('290f1547-a3c5-4158-a088-b50f5cabe8be', 0.000001, 0),
+    ('6b4727ea-dc34-4699-8a88-d7da04daa2e3', 0.09290304, 0),
+    ('49a95b0b-6688-4fd1-b22a-87a6bee39081', 0.09290304, 0),
+    ('d4a9183d-b333-4b45-a23d-549a5609788e', 0.00064516, 0),
+    ('e0b63a59-6da5-43d8-8e1b-f9d50078eec6', 0.83612736, 0),
+    ('81451270-cce7-49b3-9d1c-cf7b1e557602', 4046.8564224, 0),
+    ('0d179de1-188c-441c-9f04-f89008a8305b', 2589988.110336, 0),
+    ('0fefe6f8-ab65-4ce4-86a6-b223980f06f2', 2589988.110336, 0),
+    -- mass (kilograms)
+    ('6f23f255-a323-401f-97da-2f2d831c1fa0', 1, 0),
+    ('a063b3d6-c476-4dc6-8db1-2e4320bbfc02', 0.001, 0),
+    ('362bee5d-2157-4730-b2f4-bd8d06c374cd', 0.000001, 0),
+    ('7ab9cb43-ac59-457d-8189-ee35fc0eed9e', 0.000000001, 0),
+    ('c0fb3e31-60eb-4203-8755-e88c533bc61c', 1000, 0),
+    ('0b2ecf01-9034-4043-953e-ae20f0e8c935', 907.18474, 0),
+    ('c6a6a797-ee49-46fe-b67a-727c718a51ad', 0.028349523125, 0),
+    -- time (seconds)
+    ('93229888-9513-4d66-9ab8-9fb6e37b65d8', 1, 0),
+    ('72a86938-dd9b-4590-9770-4d6fa76e1f4d', 60, 0),
+    ('eed52efd-ec26-46ba-98de-582a22ec4ed4', 3600, 0),
+    ('3ac8944a-99d4-4ef2-874f-667983b63e6e', 86400, 0),
+    ('5fa61c67-38e6-46ae-ac1f-114278706261', 604800, 0),
+    -- velocity and linear speed (meters per second)
+    ('e142d705-9eb6-4965-91d0-af55739189b0', 1, 0),
+    ('c96294dc-f238-4d4d-8705-0a1b2d3f9b55', 1, 0),
+    ('aaa20163-ef13-4956-9ef4-76f6273954d5', 0.01, 0),
+    ('bdff5fd3-4b67-40b1-8ce3-8ff2733d74a1', 0.001, 0),
+    ('1924c73e-591e-4d08-bd7d-cbd46d555b9b', 0.3048, 0),
+    ('063497fb-7693-47c5-b9a6-fdfac8dd7562', 0.0254, 0),
+    ('1292b2a5-b78e-4a7a-80e3-978d44cbff2b', 0.9144, 0),
+    ('fb756f9f-0132-4e84-ac71-524d9a9c4164', 0.44704, 0),
+    ('9564a419-4cd7-4ca2-87e4-43cc299e6917', 0.5144444444444444, 0),
+    ('5f31367e-be0f-44b0-8618-53476db34944', 0.2777777777777778, 0),
+    ('f5652580-4e5f-4fdb-b80c-ef1ad46c9242', 0.00000029398148148148, 0),
+    ('2210ac17-04f6-4b29-ad7c-0cd9c40ffa2f', 0.0000070555555555556, 0),
+    ('7a6dd39d-7662-43fa-bb1f-1ed28f266b5b', 0.000000011574074074074, 0),
+    ('c3beaa02-38aa-4925-b444-280ae847ded1', 0.00000027777777777778, 0),
+    -- flow and volume rate (cubic meters per second)
+    ('67d3c3f0-ae76-4807-8cdd-4e29fa8d8b39', 1, 0),
+    ('66239345-fa21-48cd-922a-7304fc3cffa2', 1, 0),
+    ('d23b3b3b-69dc-4753-8b59-01848f027408', 0.028316846592, 0),
+    ('ebe3c23b-e25f-42b1-ad2a-db752fccc6fa', 28.316846592, 0),
+    ('8a7afb4f-e0ac-409d-98ea-b025dce9b777', 0.0000630901964, 0),
+    ('576d54f5-c90c-40e5-833f-7e2e9745c00d', 0.0438126363888889, 0)
+) AS c(id, si_factor, si_offset)
+WHERE u.id = c.id::UUID;
+
+CREATE OR REPLACE VIEW v_unit AS (
+    SELECT u.id AS id,
+           u.name AS name,
+           u.abbreviation AS abbreviation,
+           u.unit_family_id AS unit_family_id,
+           f.name           AS unit_family,
+           u.measure_id     AS measure_id,
+           m.name           AS measure,
+           u.si_factor      AS si_factor,
+           u.si_offset      AS si_offset
+    FROM unit u
+    INNER JOIN unit_family f ON f.id = u.unit_family_id
+    INNER JOIN measure m ON m.id = u.measure_id
+);
+
+-- formula_variable_unit (unit a formula variable is converted to before evaluation)
+CREATE TABLE IF NOT EXISTS formula_variable_unit (
+    formula_id UUID NOT NULL REFERENCES formula(id) ON DELETE CASCADE,
+    variable VARCHAR(480) NOT NULL,
+    unit_id UUID NOT NULL REFERENCES unit(id),
+    CONSTRAINT formula_unique_variable UNIQUE(formula_id, variable)
+);
+
+GRANT SELECT ON
+    formula_variable_unit,
+    v_unit
+TO instrumentation_reader;
+
+GRANT INSERT,UPDATE,DELETE ON
+    formula_variable_unit
+TO instrumentation_writer;
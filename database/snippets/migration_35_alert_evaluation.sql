@@ -0,0 +1,20 @@
+-- alert_evaluation (result of each scheduled alert_config evaluation; kept for troubleshooting)
+CREATE TABLE IF NOT EXISTS alert_evaluation (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    alert_config_id UUID NOT NULL REFERENCES alert_config (id) ON DELETE CASCADE,
+    time TIMESTAMPTZ NOT NULL DEFAULT now(),
+    window_start TIMESTAMPTZ NOT NULL,
+    result BOOLEAN NOT NULL DEFAULT false,
+    trigger_time TIMESTAMPTZ,
+    variable_values JSON NOT NULL DEFAULT '{}',
+    error TEXT,
+    alert_id UUID REFERENCES alert (id) ON DELETE SET NULL
+);
+
+GRANT SELECT ON
+    alert_evaluation
+TO instrumentation_reader;
+
+GRANT INSERT,UPDATE,DELETE ON
+    alert_evaluation
+TO instrumentation_writer;
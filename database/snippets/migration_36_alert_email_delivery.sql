@@ -0,0 +1,24 @@
+-- alert_email_delivery (log of alert emails; pending deliveries are retried with backoff)
+CREATE TABLE IF NOT EXISTS alert_email_delivery (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    alert_id UUID NOT NULL REFERENCES alert (id) ON DELETE CASCADE,
+    email VARCHAR(240) NOT NULL,
+    profile_id UUID REFERENCES profile (id) ON DELETE SET NULL,
+    email_id UUID REFERENCES email (id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_error TEXT,
+    create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
+    sent_date TIMESTAMPTZ,
+    CONSTRAINT alert_unique_email_delivery UNIQUE(alert_id, email),
+    CONSTRAINT alert_email_delivery_status CHECK (status IN ('pending', 'sent', 'failed'))
+);
+
+GRANT SELECT ON
+    alert_email_delivery
+TO instrumentation_reader;
+
+GRANT INSERT,UPDATE,DELETE ON
+    alert_email_delivery
+TO instrumentation_writer;
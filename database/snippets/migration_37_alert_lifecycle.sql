@@ -0,0 +1,65 @@
+-- alert lifecycle (open -> acknowledged -> resolved)
+ALTER TABLE alert
+    ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'open',
+    ADD COLUMN acknowledged_by UUID REFERENCES profile (id),
+    ADD COLUMN acknowledge_date TIMESTAMPTZ,
+    ADD COLUMN acknowledge_comment TEXT,
+    ADD COLUMN resolved_by UUID REFERENCES profile (id),
+    ADD COLUMN resolve_date TIMESTAMPTZ,
+    ADD COLUMN resolve_comment TEXT,
+    ADD COLUMN escalate_date TIMESTAMPTZ,
+    ADD CONSTRAINT alert_status CHECK (status IN ('open', 'acknowledged', 'resolved'));
+
+-- alert escalation
+ALTER TABLE alert_config ADD COLUMN escalate_after_minutes INT;
+
+CREATE TABLE IF NOT EXISTS alert_escalation_subscription (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    alert_config_id UUID NOT NULL REFERENCES alert_config (id) ON DELETE CASCADE,
+    profile_id UUID NOT NULL REFERENCES profile (id),
+    CONSTRAINT profile_unique_alert_escalation UNIQUE(profile_id, alert_config_id)
+);
+
+-- escalation emails are logged separately from the original alert email
+ALTER TABLE alert_email_delivery
+    ADD COLUMN escalation BOOLEAN NOT NULL DEFAULT false,
+    DROP CONSTRAINT alert_unique_email_delivery,
+    ADD CONSTRAINT alert_unique_email_delivery UNIQUE(alert_id, email, escalation);
+
+-- v_alert
+CREATE OR REPLACE VIEW v_alert AS (
+    SELECT a.id AS id,
+       a.alert_config_id AS alert_config_id,
+       a.create_date AS create_date,
+       p.id AS project_id,
+       p.name AS project_name,
+	   i.id AS instrument_id,
+	   i.name AS instrument_name,
+	   ac.name AS name,
+	   ac.body AS body,
+	   a.status AS status,
+	   a.acknowledged_by AS acknowledged_by,
+	   pa.username AS acknowledged_by_username,
+	   a.acknowledge_date AS acknowledge_date,
+	   a.acknowledge_comment AS acknowledge_comment,
+	   a.resolved_by AS resolved_by,
+	   pr.username AS resolved_by_username,
+	   a.resolve_date AS resolve_date,
+	   a.resolve_comment AS resolve_comment,
+	   a.escalate_date AS escalate_date
+FROM alert a
+INNER JOIN alert_config ac ON a.alert_config_id = ac.id
+INNER JOIN instrument i ON ac.instrument_id = i.id
+INNER JOIN project p ON i.project_id = p.id
+LEFT JOIN profile pa ON pa.id = a.acknowledged_by
+LEFT JOIN profile pr ON pr.id = a.resolved_by
+);
+
+GRANT SELECT ON
+    alert_escalation_subscription,
+    v_alert
+TO instrumentation_reader;
+
+GRANT INSERT,UPDATE,DELETE ON
+    alert_escalation_subscription
+TO instrumentation_writer;
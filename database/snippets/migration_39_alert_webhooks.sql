@@ -0,0 +1,46 @@
+-- values of the alert config formula variables when an alert was last triggered
+ALTER TABLE alert ADD COLUMN trigger_values JSON NOT NULL DEFAULT '{}';
+
+-- alert_webhook (HTTP endpoints receiving signed alert events; for all alert configs in a project,
+-- or for a single alert config if alert_config_id is set)
+CREATE TABLE IF NOT EXISTS alert_webhook (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL REFERENCES project (id) ON DELETE CASCADE,
+    alert_config_id UUID REFERENCES alert_config (id) ON DELETE CASCADE,
+    name VARCHAR(240) NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    creator UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updater UUID,
+    update_date TIMESTAMPTZ
+);
+
+-- alert_webhook_delivery (log of webhook posts; pending deliveries are retried with backoff)
+CREATE TABLE IF NOT EXISTS alert_webhook_delivery (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    alert_webhook_id UUID NOT NULL REFERENCES alert_webhook (id) ON DELETE CASCADE,
+    alert_id UUID NOT NULL REFERENCES alert (id) ON DELETE CASCADE,
+    event VARCHAR(40) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    response_status INT,
+    last_error TEXT,
+    create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
+    sent_date TIMESTAMPTZ,
+    CONSTRAINT alert_unique_webhook_delivery UNIQUE(alert_webhook_id, alert_id, event),
+    CONSTRAINT alert_webhook_delivery_event CHECK (event IN ('alert.created', 'alert.acknowledged', 'alert.resolved')),
+    CONSTRAINT alert_webhook_delivery_status CHECK (status IN ('pending', 'sent', 'failed'))
+);
+
+GRANT SELECT ON
+    alert_webhook,
+    alert_webhook_delivery
+TO instrumentation_reader;
+
+GRANT INSERT,UPDATE,DELETE ON
+    alert_webhook,
+    alert_webhook_delivery
+TO instrumentation_writer;
@@ -0,0 +1,51 @@
+-- structured threshold alert configs (alert_type 'threshold'); evaluated against a single timeseries
+ALTER TABLE alert_config
+    ADD COLUMN alert_type VARCHAR(20) NOT NULL DEFAULT 'formula',
+    ADD COLUMN timeseries_id UUID REFERENCES timeseries (id) ON DELETE CASCADE,
+    ADD COLUMN threshold_direction VARCHAR(20),
+    ADD COLUMN watch_level DOUBLE PRECISION,
+    ADD COLUMN warning_level DOUBLE PRECISION,
+    ADD COLUMN critical_level DOUBLE PRECISION,
+    ADD COLUMN rate_of_change_limit DOUBLE PRECISION,
+    ADD COLUMN comparison_window_minutes INT,
+    ADD CONSTRAINT alert_config_type CHECK (alert_type IN ('formula', 'threshold')),
+    ADD CONSTRAINT alert_config_threshold_direction CHECK (threshold_direction IN ('above', 'below'));
+
+-- highest level crossed while an alert is active
+ALTER TABLE alert ADD COLUMN level VARCHAR(20);
+
+ALTER TABLE alert_evaluation ADD COLUMN level VARCHAR(20);
+
+-- v_alert
+CREATE OR REPLACE VIEW v_alert AS (
+    SELECT a.id AS id,
+       a.alert_config_id AS alert_config_id,
+       a.create_date AS create_date,
+       p.id AS project_id,
+       p.name AS project_name,
+	   i.id AS instrument_id,
+	   i.name AS instrument_name,
+	   ac.name AS name,
+	   ac.body AS body,
+	   a.status AS status,
+	   a.acknowledged_by AS acknowledged_by,
+	   pa.username AS acknowledged_by_username,
+	   a.acknowledge_date AS acknowledge_date,
+	   a.acknowledge_comment AS acknowledge_comment,
+	   a.resolved_by AS resolved_by,
+	   pr.username AS resolved_by_username,
+	   a.resolve_date AS resolve_date,
+	   a.resolve_comment AS resolve_comment,
+	   a.escalate_date AS escalate_date,
+	   a.last_trigger_date AS last_trigger_date,
+	   a.trigger_count AS trigger_count,
+	   a.clear_date AS clear_date,
+	   a.level AS level
+FROM alert a
+INNER JOIN alert_config ac ON a.alert_config_id = ac.id
+INNER JOIN instrument i ON ac.instrument_id = i.id
+INNER JOIN project p ON i.project_id = p.id
+LEFT JOIN profile pa ON pa.id = a.acknowledged_by
+LEFT JOIN profile pr ON pr.id = a.resolved_by
+);
+
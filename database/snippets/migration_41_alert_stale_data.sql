@@ -0,0 +1,6 @@
+-- stale data alert configs (alert_type 'stale'); alert when no data is received for stale_after_hours
+ALTER TABLE alert_config
+    ADD COLUMN stale_after_hours INT,
+    ADD COLUMN instrument_telemetry_id UUID REFERENCES instrument_telemetry (id) ON DELETE SET NULL,
+    DROP CONSTRAINT alert_config_type,
+    ADD CONSTRAINT alert_config_type CHECK (alert_type IN ('formula', 'threshold', 'stale'));
@@ -0,0 +1,5 @@
+-- email-only alert subscribers; unsubscribe_token is included in alert emails to unsubscribe without logging in
+ALTER TABLE alert_email_subscription
+    ADD COLUMN unsubscribe_token VARCHAR(64) UNIQUE NOT NULL DEFAULT replace(uuid_generate_v4()::text || uuid_generate_v4()::text, '-', ''),
+    DROP CONSTRAINT alert_email_subscription_alert_config_id_fkey,
+    ADD CONSTRAINT alert_email_subscription_alert_config_id_fkey FOREIGN KEY (alert_config_id) REFERENCES alert_config (id) ON DELETE CASCADE;
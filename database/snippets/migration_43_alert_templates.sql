@@ -0,0 +1,40 @@
+-- alert subject and body templates; alerts store the subject and body rendered when they are created
+ALTER TABLE alert_config ADD COLUMN subject TEXT;
+
+ALTER TABLE alert
+    ADD COLUMN subject TEXT,
+    ADD COLUMN body TEXT;
+
+-- v_alert
+CREATE OR REPLACE VIEW v_alert AS (
+    SELECT a.id AS id,
+       a.alert_config_id AS alert_config_id,
+       a.create_date AS create_date,
+       p.id AS project_id,
+       p.name AS project_name,
+	   i.id AS instrument_id,
+	   i.name AS instrument_name,
+	   ac.name AS name,
+	   COALESCE(a.body, ac.body) AS body,
+	   a.status AS status,
+	   a.acknowledged_by AS acknowledged_by,
+	   pa.username AS acknowledged_by_username,
+	   a.acknowledge_date AS acknowledge_date,
+	   a.acknowledge_comment AS acknowledge_comment,
+	   a.resolved_by AS resolved_by,
+	   pr.username AS resolved_by_username,
+	   a.resolve_date AS resolve_date,
+	   a.resolve_comment AS resolve_comment,
+	   a.escalate_date AS escalate_date,
+	   a.last_trigger_date AS last_trigger_date,
+	   a.trigger_count AS trigger_count,
+	   a.clear_date AS clear_date,
+	   a.level AS level,
+	   a.subject AS subject
+FROM alert a
+INNER JOIN alert_config ac ON a.alert_config_id = ac.id
+INNER JOIN instrument i ON ac.instrument_id = i.id
+INNER JOIN project p ON i.project_id = p.id
+LEFT JOIN profile pa ON pa.id = a.acknowledged_by
+LEFT JOIN profile pr ON pr.id = a.resolved_by
+);
@@ -0,0 +1,36 @@
+-- alert subscription delivery modes; hourly, daily and weekly subscriptions receive one digest email per period
+ALTER TABLE alert_profile_subscription
+    ADD COLUMN delivery_mode VARCHAR(20) NOT NULL DEFAULT 'immediate',
+    ADD CONSTRAINT alert_profile_subscription_delivery_mode CHECK (delivery_mode IN ('immediate', 'hourly', 'daily', 'weekly'));
+
+ALTER TABLE alert_email_subscription
+    ADD COLUMN delivery_mode VARCHAR(20) NOT NULL DEFAULT 'immediate',
+    ADD CONSTRAINT alert_email_subscription_delivery_mode CHECK (delivery_mode IN ('immediate', 'hourly', 'daily', 'weekly'));
+
+-- alert_email_digest (one email per address summarizing alerts for subscriptions with an hourly, daily or weekly delivery_mode)
+CREATE TABLE IF NOT EXISTS alert_email_digest (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    email VARCHAR(240) NOT NULL,
+    delivery_mode VARCHAR(20) NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end TIMESTAMPTZ NOT NULL,
+    alert_count INT NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_error TEXT,
+    create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
+    sent_date TIMESTAMPTZ,
+    CONSTRAINT alert_unique_email_digest UNIQUE(email, delivery_mode, period_start),
+    CONSTRAINT alert_email_digest_status CHECK (status IN ('pending', 'sent', 'failed'))
+);
+
+GRANT SELECT ON
+    alert_email_digest
+TO instrumentation_reader;
+
+GRANT INSERT,UPDATE,DELETE ON
+    alert_email_digest
+TO instrumentation_writer;
@@ -0,0 +1,63 @@
+-- project permissions; a role grants a set of permissions in each project it is granted in
+-- built-in roles have no project_id; project admins can create roles for their project
+ALTER TABLE role ADD COLUMN project_id UUID REFERENCES project (id) ON DELETE CASCADE;
+CREATE UNIQUE INDEX IF NOT EXISTS role_unique_name ON role (name, COALESCE(project_id, '00000000-0000-0000-0000-000000000000'));
+
+-- permission
+CREATE TABLE IF NOT EXISTS permission (
+    name VARCHAR(120) PRIMARY KEY NOT NULL,
+    description VARCHAR(480) NOT NULL
+);
+
+-- role_permission
+CREATE TABLE IF NOT EXISTS role_permission (
+    role_id UUID NOT NULL REFERENCES role (id) ON DELETE CASCADE,
+    permission VARCHAR(120) NOT NULL REFERENCES permission (name) ON DELETE CASCADE,
+    CONSTRAINT role_unique_permission UNIQUE(role_id, permission)
+);
+
+INSERT INTO role (id, name) VALUES
+    ('a4c3b7a3-4c0e-4b8f-9c3a-7d0f2e1b5c61', 'VIEWER'),
+    ('5e8d2f1c-9b7a-4e6d-8c5b-3a2f1e0d9c87', 'DATA_ENTRY'),
+    ('c1f0e9d8-7b6a-4c5d-9e8f-1a2b3c4d5e6f', 'ALERT_MANAGER');
+
+-- permission
+INSERT INTO permission (name, description) VALUES
+    ('project.read', 'View the project'),
+    ('project.write', 'Update or delete the project'),
+    ('project.members', 'Manage project members and project roles'),
+    ('instrument.write', 'Create, update and delete instruments, instrument groups, constants and status'),
+    ('timeseries.write', 'Create, update and delete timeseries and formulas'),
+    ('measurement.write', 'Create and update timeseries measurements'),
+    ('note.write', 'Create, update and delete instrument notes'),
+    ('alert_config.write', 'Create, update, delete and backtest alert configs'),
+    ('alert.acknowledge', 'Acknowledge and resolve alerts'),
+    ('alert_notification.write', 'Manage alert webhooks, email subscriptions and escalation subscriptions'),
+    ('collection_group.write', 'Create, update and delete collection groups'),
+    ('plot_configuration.write', 'Create, update and delete plot configurations');
+
+-- role_permission
+INSERT INTO role_permission (role_id, permission)
+    SELECT '37f14863-8f3b-44ca-8deb-4b74ce8a8a69', name FROM permission;
+INSERT INTO role_permission (role_id, permission)
+    SELECT '2962bdde-7007-4ba0-943f-cb8e72e90704', name FROM permission
+    WHERE name NOT IN ('project.write', 'project.members', 'alert_notification.write');
+INSERT INTO role_permission (role_id, permission) VALUES
+    ('a4c3b7a3-4c0e-4b8f-9c3a-7d0f2e1b5c61', 'project.read'),
+    ('5e8d2f1c-9b7a-4e6d-8c5b-3a2f1e0d9c87', 'project.read'),
+    ('5e8d2f1c-9b7a-4e6d-8c5b-3a2f1e0d9c87', 'measurement.write'),
+    ('5e8d2f1c-9b7a-4e6d-8c5b-3a2f1e0d9c87', 'note.write'),
+    ('c1f0e9d8-7b6a-4c5d-9e8f-1a2b3c4d5e6f', 'project.read'),
+    ('c1f0e9d8-7b6a-4c5d-9e8f-1a2b3c4d5e6f', 'alert_config.write'),
+    ('c1f0e9d8-7b6a-4c5d-9e8f-1a2b3c4d5e6f', 'alert.acknowledge'),
+    ('c1f0e9d8-7b6a-4c5d-9e8f-1a2b3c4d5e6f', 'alert_notification.write');
+
+GRANT SELECT ON
+    permission,
+    role_permission
+TO instrumentation_reader;
+
+GRANT INSERT,UPDATE,DELETE ON
+    permission,
+    role_permission
+TO instrumentation_writer;
@@ -0,0 +1,9 @@
+-- profile_token; optional expiry, scopes, project restrictions and last used time
+-- existing tokens keep the full rights of their profile (scope admin) and never expire
+ALTER TABLE profile_token
+    ADD COLUMN name VARCHAR(120),
+    ADD COLUMN description TEXT,
+    ADD COLUMN expires TIMESTAMPTZ,
+    ADD COLUMN scopes VARCHAR(40)[] NOT NULL DEFAULT '{admin}',
+    ADD COLUMN project_ids UUID[] NOT NULL DEFAULT '{}',
+    ADD COLUMN last_used TIMESTAMPTZ;
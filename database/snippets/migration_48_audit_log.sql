@@ -0,0 +1,34 @@
+-- audit_log (API requests that change data; the entity in the route before and after each request)
+CREATE TABLE IF NOT EXISTS audit_log (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    action_time TIMESTAMPTZ NOT NULL DEFAULT now(),
+    actor UUID REFERENCES profile (id),
+    auth_method VARCHAR(40) NOT NULL,
+    token_id VARCHAR,
+    method VARCHAR(10) NOT NULL,
+    route TEXT NOT NULL,
+    params JSONB NOT NULL DEFAULT '{}',
+    status INT NOT NULL,
+    project_ids UUID[] NOT NULL DEFAULT '{}',
+    entity_ids UUID[] NOT NULL DEFAULT '{}',
+    before JSONB,
+    after JSONB,
+    diff JSONB,
+    CONSTRAINT audit_log_auth_method CHECK (auth_method IN ('jwt', 'token', 'application_key', 'anonymous'))
+);
+CREATE INDEX IF NOT EXISTS audit_log_action_time ON audit_log (action_time);
+CREATE INDEX IF NOT EXISTS audit_log_project_ids ON audit_log USING GIN (project_ids);
+CREATE INDEX IF NOT EXISTS audit_log_entity_ids ON audit_log USING GIN (entity_ids);
+
+-- permission
+INSERT INTO permission (name, description) VALUES
+    ('audit.read', 'View the audit log of the project');
+
+-- role_permission; granted to the built-in ADMIN role
+INSERT INTO role_permission (role_id, permission) VALUES
+    ('37f14863-8f3b-44ca-8deb-4b74ce8a8a69', 'audit.read');
+
+GRANT SELECT ON audit_log TO instrumentation_reader;
+
+-- audit_log is append-only
+GRANT INSERT ON audit_log TO instrumentation_writer;
@@ -0,0 +1,6 @@
+-- profile_token; requests per rate limit window allowed for a token, set by application admins
+-- tokens without rate limits have the configured default limits
+ALTER TABLE profile_token
+    ADD COLUMN rate_limit_read INT,
+    ADD COLUMN rate_limit_write INT,
+    ADD CONSTRAINT profile_token_rate_limit CHECK (rate_limit_read > 0 AND rate_limit_write > 0);
@@ -0,0 +1,3 @@
+-- profile; deleted service accounts keep their profile, so their actions stay attributed to them,
+-- but have no tokens or roles and are not listed
+ALTER TABLE profile ADD COLUMN deleted BOOLEAN NOT NULL DEFAULT false;
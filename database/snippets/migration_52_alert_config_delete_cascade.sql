@@ -0,0 +1,13 @@
+-- alerts, their read receipts and profile subscriptions are deleted with their alert config;
+-- alert configs that have fired can be deleted
+ALTER TABLE alert
+    DROP CONSTRAINT alert_alert_config_id_fkey,
+    ADD CONSTRAINT alert_alert_config_id_fkey FOREIGN KEY (alert_config_id) REFERENCES alert_config (id) ON DELETE CASCADE;
+
+ALTER TABLE alert_read
+    DROP CONSTRAINT alert_read_alert_id_fkey,
+    ADD CONSTRAINT alert_read_alert_id_fkey FOREIGN KEY (alert_id) REFERENCES alert (id) ON DELETE CASCADE;
+
+ALTER TABLE alert_profile_subscription
+    DROP CONSTRAINT alert_profile_subscription_alert_config_id_fkey,
+    ADD CONSTRAINT alert_profile_subscription_alert_config_id_fkey FOREIGN KEY (alert_config_id) REFERENCES alert_config (id) ON DELETE CASCADE;
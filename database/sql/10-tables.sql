@@ -8,6 +8,8 @@ CREATE SCHEMA midas;
 -- drop tables if they already exist
 drop table if exists 
     profile_project_roles,
+    role_permission,
+    permission,
     role,
     timeseries_measurement,
     timeseries,
@@ -21,6 +23,8 @@ drop table if exists
     instrument,
     instrument_group,
     instrument_constants,
+    formula,
+    formula_variable_unit,
     parameter,
     unit_family,
     measure,
@@ -35,9 +39,16 @@ drop table if exists
     alert,
     alert_read,
     alert_config,
+    alert_evaluation,
     alert_profile_subscription,
     alert_email_subscription,
+    alert_escalation_subscription,
+    alert_email_delivery,
+    alert_email_digest,
+    alert_webhook,
+    alert_webhook_delivery,
     heartbeat,
+    audit_log,
     collection_group_timeseries,
     collection_group,
     plot_configuration,
@@ -51,20 +62,20 @@ CREATE TABLE IF NOT EXISTS config (
     static_prefix VARCHAR NOT NULL DEFAULT '/instrumentation'
 );
 
--- role
-CREATE TABLE IF NOT EXISTS role (
-    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
-    name VARCHAR NOT NULL,
-    deleted boolean NOT NULL DEFAULT false
-);
-
--- profile
+-- profile; service accounts are profiles for machine integrations created by application admins,
+-- without an EDIPI; they authenticate with their own tokens only
 CREATE TABLE IF NOT EXISTS profile (
     id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
-    edipi BIGINT UNIQUE NOT NULL,
+    edipi BIGINT UNIQUE,
     username VARCHAR(240) UNIQUE NOT NULL,
     email VARCHAR(240) UNIQUE NOT NULL,
-    is_admin boolean NOT NULL DEFAULT false
+    is_admin boolean NOT NULL DEFAULT false,
+    is_service_account BOOLEAN NOT NULL DEFAULT false,
+    description TEXT,
+    creator UUID REFERENCES profile (id),
+    create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
+    deleted BOOLEAN NOT NULL DEFAULT false,
+    CONSTRAINT profile_service_account_edipi CHECK (is_service_account = (edipi IS NULL))
 );
 
 -- profile_token
@@ -73,7 +84,16 @@ CREATE TABLE IF NOT EXISTS profile_token (
     token_id VARCHAR NOT NULL,
     profile_id UUID NOT NULL REFERENCES profile(id),
     issued TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    hash VARCHAR(240) NOT NULL
+    hash VARCHAR(240) NOT NULL,
+    name VARCHAR(120),
+    description TEXT,
+    expires TIMESTAMPTZ,
+    scopes VARCHAR(40)[] NOT NULL DEFAULT '{admin}',
+    project_ids UUID[] NOT NULL DEFAULT '{}',
+    last_used TIMESTAMPTZ,
+    rate_limit_read INT,
+    rate_limit_write INT,
+    CONSTRAINT profile_token_rate_limit CHECK (rate_limit_read > 0 AND rate_limit_write > 0)
 );
 
 -- email (user that will never login but still needs alerts; i.e. just an email)
@@ -94,7 +114,31 @@ CREATE TABLE IF NOT EXISTS project (
     creator UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
     create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
     updater UUID,
-    update_date TIMESTAMPTZ
+    update_date TIMESTAMPTZ,
+    visibility VARCHAR(20) NOT NULL DEFAULT 'public',
+    CONSTRAINT project_visibility CHECK (visibility IN ('public', 'private'))
+);
+
+-- role (project_id is NULL for built-in roles available in every project)
+CREATE TABLE IF NOT EXISTS role (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    name VARCHAR NOT NULL,
+    deleted boolean NOT NULL DEFAULT false,
+    project_id UUID REFERENCES project (id) ON DELETE CASCADE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS role_unique_name ON role (name, COALESCE(project_id, '00000000-0000-0000-0000-000000000000'));
+
+-- permission
+CREATE TABLE IF NOT EXISTS permission (
+    name VARCHAR(120) PRIMARY KEY NOT NULL,
+    description VARCHAR(480) NOT NULL
+);
+
+-- role_permission
+CREATE TABLE IF NOT EXISTS role_permission (
+    role_id UUID NOT NULL REFERENCES role (id) ON DELETE CASCADE,
+    permission VARCHAR(120) NOT NULL REFERENCES permission (name) ON DELETE CASCADE,
+    CONSTRAINT role_unique_permission UNIQUE(role_id, permission)
 );
 
 -- profile_project_roles
@@ -108,6 +152,28 @@ CREATE TABLE IF NOT EXISTS profile_project_roles (
     CONSTRAINT unique_profile_project_role UNIQUE(profile_id,project_id,role_id)
 );
 
+-- audit_log (API requests that change data; the entity in the route before and after each request)
+CREATE TABLE IF NOT EXISTS audit_log (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    action_time TIMESTAMPTZ NOT NULL DEFAULT now(),
+    actor UUID REFERENCES profile (id),
+    auth_method VARCHAR(40) NOT NULL,
+    token_id VARCHAR,
+    method VARCHAR(10) NOT NULL,
+    route TEXT NOT NULL,
+    params JSONB NOT NULL DEFAULT '{}',
+    status INT NOT NULL,
+    project_ids UUID[] NOT NULL DEFAULT '{}',
+    entity_ids UUID[] NOT NULL DEFAULT '{}',
+    before JSONB,
+    after JSONB,
+    diff JSONB,
+    CONSTRAINT audit_log_auth_method CHECK (auth_method IN ('jwt', 'token', 'application_key', 'anonymous'))
+);
+CREATE INDEX IF NOT EXISTS audit_log_action_time ON audit_log (action_time);
+CREATE INDEX IF NOT EXISTS audit_log_project_ids ON audit_log USING GIN (project_ids);
+CREATE INDEX IF NOT EXISTS audit_log_entity_ids ON audit_log USING GIN (entity_ids);
+
 -- heartbeat
 CREATE TABLE IF NOT EXISTS heartbeat (
     time TIMESTAMPTZ NOT NULL DEFAULT now()
@@ -144,7 +210,9 @@ CREATE TABLE IF NOT EXISTS unit (
     name VARCHAR(120) UNIQUE NOT NULL,
     abbreviation VARCHAR(120) UNIQUE NOT NULL,
     unit_family_id UUID REFERENCES unit_family (id),
-    measure_id UUID REFERENCES measure (id)
+    measure_id UUID REFERENCES measure (id),
+    si_factor DOUBLE PRECISION,
+    si_offset DOUBLE PRECISION NOT NULL DEFAULT 0
 );
 
 -- parameter
@@ -174,10 +242,6 @@ CREATE TABLE IF NOT EXISTS instrument (
     deleted BOOLEAN NOT NULL DEFAULT false,
     slug VARCHAR UNIQUE NOT NULL,
     name VARCHAR(360) NOT NULL,
-    formula_id UUID NOT NULL DEFAULT uuid_generate_v4(),
-    formula VARCHAR,
-    formula_parameter_id UUID REFERENCES parameter (id),
-    formula_unit_id UUID REFERENCES unit (id),
     geometry geometry,
     station int,
     station_offset int,
@@ -203,19 +267,70 @@ CREATE TABLE IF NOT EXISTS alert_config (
     create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
     updater UUID,
     update_date TIMESTAMPTZ,
-    CONSTRAINT instrument_unique_alert_config_name UNIQUE(name,instrument_id)
+    escalate_after_minutes INT,
+    clear_formula TEXT,
+    cooldown_minutes INT,
+    consecutive_count INT NOT NULL DEFAULT 1,
+    alert_type VARCHAR(20) NOT NULL DEFAULT 'formula',
+    timeseries_id UUID REFERENCES timeseries (id) ON DELETE CASCADE,
+    threshold_direction VARCHAR(20),
+    watch_level DOUBLE PRECISION,
+    warning_level DOUBLE PRECISION,
+    critical_level DOUBLE PRECISION,
+    rate_of_change_limit DOUBLE PRECISION,
+    comparison_window_minutes INT,
+    stale_after_hours INT,
+    instrument_telemetry_id UUID,
+    -- subject template; body is also a template (see models/alert_template.go)
+    subject TEXT,
+    CONSTRAINT instrument_unique_alert_config_name UNIQUE(name,instrument_id),
+    CONSTRAINT alert_config_type CHECK (alert_type IN ('formula', 'threshold', 'stale')),
+    CONSTRAINT alert_config_threshold_direction CHECK (threshold_direction IN ('above', 'below'))
 );
 
 -- alert
 CREATE TABLE IF NOT EXISTS alert (
     id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
-    alert_config_id UUID NOT NULL REFERENCES alert_config (id),
-    create_date TIMESTAMPTZ NOT NULL DEFAULT now()
+    alert_config_id UUID NOT NULL REFERENCES alert_config (id) ON DELETE CASCADE,
+    create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    acknowledged_by UUID REFERENCES profile (id),
+    acknowledge_date TIMESTAMPTZ,
+    acknowledge_comment TEXT,
+    resolved_by UUID REFERENCES profile (id),
+    resolve_date TIMESTAMPTZ,
+    resolve_comment TEXT,
+    escalate_date TIMESTAMPTZ,
+    last_trigger_date TIMESTAMPTZ,
+    trigger_count INT NOT NULL DEFAULT 1,
+    clear_date TIMESTAMPTZ,
+    trigger_values JSON NOT NULL DEFAULT '{}',
+    level VARCHAR(20),
+    -- subject and body rendered from alert_config templates when the alert is created
+    subject TEXT,
+    body TEXT,
+    CONSTRAINT alert_status CHECK (status IN ('open', 'acknowledged', 'resolved'))
+);
+
+-- alert_evaluation (result of each scheduled alert_config evaluation; kept for troubleshooting)
+CREATE TABLE IF NOT EXISTS alert_evaluation (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    alert_config_id UUID NOT NULL REFERENCES alert_config (id) ON DELETE CASCADE,
+    time TIMESTAMPTZ NOT NULL DEFAULT now(),
+    window_start TIMESTAMPTZ NOT NULL,
+    result BOOLEAN NOT NULL DEFAULT false,
+    trigger_time TIMESTAMPTZ,
+    variable_values JSON NOT NULL DEFAULT '{}',
+    error TEXT,
+    alert_id UUID REFERENCES alert (id) ON DELETE SET NULL,
+    consecutive_count INT NOT NULL DEFAULT 0,
+    action VARCHAR(20),
+    level VARCHAR(20)
 );
 
 -- alert_read
 CREATE TABLE IF NOT EXISTS alert_read (
-    alert_id UUID NOT NULL REFERENCES alert (id),
+    alert_id UUID NOT NULL REFERENCES alert (id) ON DELETE CASCADE,
     profile_id UUID NOT NULL REFERENCES profile (id),
     CONSTRAINT profile_unique_alert_read UNIQUE(alert_id, profile_id)
 );
@@ -223,20 +338,106 @@ CREATE TABLE IF NOT EXISTS alert_read (
 -- profile alerts (subscribe profiles to alerts)
 CREATE TABLE IF NOT EXISTS alert_profile_subscription (
     id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
-    alert_config_id UUID NOT NULL REFERENCES alert_config (id),
+    alert_config_id UUID NOT NULL REFERENCES alert_config (id) ON DELETE CASCADE,
     profile_id UUID NOT NULL REFERENCES profile (id),
     mute_ui boolean NOT NULL DEFAULT false,
     mute_notify boolean NOT NULL DEFAULT false,
-    CONSTRAINT profile_unique_alert_config UNIQUE(profile_id, alert_config_id)
+    delivery_mode VARCHAR(20) NOT NULL DEFAULT 'immediate',
+    CONSTRAINT profile_unique_alert_config UNIQUE(profile_id, alert_config_id),
+    CONSTRAINT alert_profile_subscription_delivery_mode CHECK (delivery_mode IN ('immediate', 'hourly', 'daily', 'weekly'))
 );
 
 -- email alerts (subscribe emails to alerts)
 CREATE TABLE IF NOT EXISTS alert_email_subscription (
     id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
-    alert_config_id UUID NOT NULL REFERENCES alert_config (id),
+    alert_config_id UUID NOT NULL REFERENCES alert_config (id) ON DELETE CASCADE,
     email_id UUID NOT NULL REFERENCES email (id),
     mute_notify boolean NOT NULL DEFAULT false,
-    CONSTRAINT email_unique_alert_config UNIQUE(email_id, alert_config_id)
+    delivery_mode VARCHAR(20) NOT NULL DEFAULT 'immediate',
+    -- unsubscribe_token is included in alert emails to unsubscribe without logging in
+    unsubscribe_token VARCHAR(64) UNIQUE NOT NULL DEFAULT replace(uuid_generate_v4()::text || uuid_generate_v4()::text, '-', ''),
+    CONSTRAINT email_unique_alert_config UNIQUE(email_id, alert_config_id),
+    CONSTRAINT alert_email_subscription_delivery_mode CHECK (delivery_mode IN ('immediate', 'hourly', 'daily', 'weekly'))
+);
+
+-- alert escalation (profiles notified when an alert is not acknowledged within alert_config.escalate_after_minutes)
+CREATE TABLE IF NOT EXISTS alert_escalation_subscription (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    alert_config_id UUID NOT NULL REFERENCES alert_config (id) ON DELETE CASCADE,
+    profile_id UUID NOT NULL REFERENCES profile (id),
+    CONSTRAINT profile_unique_alert_escalation UNIQUE(profile_id, alert_config_id)
+);
+
+-- alert_email_delivery (log of alert emails; pending deliveries are retried with backoff)
+CREATE TABLE IF NOT EXISTS alert_email_delivery (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    alert_id UUID NOT NULL REFERENCES alert (id) ON DELETE CASCADE,
+    email VARCHAR(240) NOT NULL,
+    profile_id UUID REFERENCES profile (id) ON DELETE SET NULL,
+    email_id UUID REFERENCES email (id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_error TEXT,
+    create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
+    sent_date TIMESTAMPTZ,
+    escalation BOOLEAN NOT NULL DEFAULT false,
+    CONSTRAINT alert_unique_email_delivery UNIQUE(alert_id, email, escalation),
+    CONSTRAINT alert_email_delivery_status CHECK (status IN ('pending', 'sent', 'failed'))
+);
+
+-- alert_email_digest (one email per address summarizing alerts for subscriptions with an hourly, daily or weekly delivery_mode)
+CREATE TABLE IF NOT EXISTS alert_email_digest (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    email VARCHAR(240) NOT NULL,
+    delivery_mode VARCHAR(20) NOT NULL,
+    period_start TIMESTAMPTZ NOT NULL,
+    period_end TIMESTAMPTZ NOT NULL,
+    alert_count INT NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_error TEXT,
+    create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
+    sent_date TIMESTAMPTZ,
+    CONSTRAINT alert_unique_email_digest UNIQUE(email, delivery_mode, period_start),
+    CONSTRAINT alert_email_digest_status CHECK (status IN ('pending', 'sent', 'failed'))
+);
+
+-- alert_webhook (HTTP endpoints receiving signed alert events; for all alert configs in a project,
+-- or for a single alert config if alert_config_id is set)
+CREATE TABLE IF NOT EXISTS alert_webhook (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    project_id UUID NOT NULL REFERENCES project (id) ON DELETE CASCADE,
+    alert_config_id UUID REFERENCES alert_config (id) ON DELETE CASCADE,
+    name VARCHAR(240) NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    creator UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updater UUID,
+    update_date TIMESTAMPTZ
+);
+
+-- alert_webhook_delivery (log of webhook posts; pending deliveries are retried with backoff)
+CREATE TABLE IF NOT EXISTS alert_webhook_delivery (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    alert_webhook_id UUID NOT NULL REFERENCES alert_webhook (id) ON DELETE CASCADE,
+    alert_id UUID NOT NULL REFERENCES alert (id) ON DELETE CASCADE,
+    event VARCHAR(40) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt TIMESTAMPTZ NOT NULL DEFAULT now(),
+    response_status INT,
+    last_error TEXT,
+    create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
+    sent_date TIMESTAMPTZ,
+    CONSTRAINT alert_unique_webhook_delivery UNIQUE(alert_webhook_id, alert_id, event),
+    CONSTRAINT alert_webhook_delivery_event CHECK (event IN ('alert.created', 'alert.acknowledged', 'alert.resolved')),
+    CONSTRAINT alert_webhook_delivery_status CHECK (status IN ('pending', 'sent', 'failed'))
 );
 
 -- instrument_note
@@ -297,6 +498,31 @@ CREATE TABLE IF NOT EXISTS instrument_constants (
     CONSTRAINT instrument_unique_timeseries UNIQUE(instrument_id, timeseries_id)
 );
 
+-- formula (computed timeseries for an instrument)
+CREATE TABLE IF NOT EXISTS formula (
+    id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
+    instrument_id UUID NOT NULL REFERENCES instrument(id) ON DELETE CASCADE,
+    slug VARCHAR(240) NOT NULL,
+    name VARCHAR(240) NOT NULL,
+    formula TEXT NOT NULL,
+    parameter_id UUID NOT NULL REFERENCES parameter(id) DEFAULT '2b7f96e1-820f-4f61-ba8f-861640af6232',
+    unit_id UUID NOT NULL REFERENCES unit(id) DEFAULT '4a999277-4cf5-4282-93ce-23b33c65e2c8',
+    creator UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000000',
+    create_date TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updater UUID,
+    update_date TIMESTAMPTZ,
+    CONSTRAINT instrument_unique_formula_name UNIQUE(instrument_id, name),
+    CONSTRAINT instrument_unique_formula_slug UNIQUE(instrument_id, slug)
+);
+
+-- formula_variable_unit (unit a formula variable is converted to before evaluation)
+CREATE TABLE IF NOT EXISTS formula_variable_unit (
+    formula_id UUID NOT NULL REFERENCES formula(id) ON DELETE CASCADE,
+    variable VARCHAR(480) NOT NULL,
+    unit_id UUID NOT NULL REFERENCES unit(id),
+    CONSTRAINT formula_unique_variable UNIQUE(formula_id, variable)
+);
+
 -- project_timeseries
 CREATE TABLE IF NOT EXISTS project_timeseries (
     timeseries_id UUID NOT NULL REFERENCES timeseries(id) ON DELETE CASCADE,
@@ -366,6 +592,10 @@ CREATE TABLE IF NOT EXISTS instrument_telemetry (
     CONSTRAINT instrument_unique_telemetry_id UNIQUE(instrument_id, telemetry_id)
 );
 
+-- stale data alert configs may reference the instrument telemetry expected to deliver data
+ALTER TABLE alert_config ADD CONSTRAINT alert_config_instrument_telemetry_id_fkey
+    FOREIGN KEY (instrument_telemetry_id) REFERENCES instrument_telemetry (id) ON DELETE SET NULL;
+
 -- GOES
 CREATE TABLE IF NOT EXISTS telemetry_goes (
     id UUID PRIMARY KEY NOT NULL DEFAULT uuid_generate_v4(),
@@ -389,7 +619,42 @@ INSERT INTO config (static_host, static_prefix) VALUES
 
 INSERT INTO role (id, name) VALUES
     ('37f14863-8f3b-44ca-8deb-4b74ce8a8a69', 'ADMIN'),
-    ('2962bdde-7007-4ba0-943f-cb8e72e90704', 'MEMBER');
+    ('2962bdde-7007-4ba0-943f-cb8e72e90704', 'MEMBER'),
+    ('a4c3b7a3-4c0e-4b8f-9c3a-7d0f2e1b5c61', 'VIEWER'),
+    ('5e8d2f1c-9b7a-4e6d-8c5b-3a2f1e0d9c87', 'DATA_ENTRY'),
+    ('c1f0e9d8-7b6a-4c5d-9e8f-1a2b3c4d5e6f', 'ALERT_MANAGER');
+
+-- permission
+INSERT INTO permission (name, description) VALUES
+    ('project.read', 'View the project'),
+    ('project.write', 'Update or delete the project'),
+    ('project.members', 'Manage project members and project roles'),
+    ('instrument.write', 'Create, update and delete instruments, instrument groups, constants and status'),
+    ('timeseries.write', 'Create, update and delete timeseries and formulas'),
+    ('measurement.write', 'Create and update timeseries measurements'),
+    ('note.write', 'Create, update and delete instrument notes'),
+    ('alert_config.write', 'Create, update, delete and backtest alert configs'),
+    ('alert.acknowledge', 'Acknowledge and resolve alerts'),
+    ('alert_notification.write', 'Manage alert webhooks, email subscriptions and escalation subscriptions'),
+    ('collection_group.write', 'Create, update and delete collection groups'),
+    ('plot_configuration.write', 'Create, update and delete plot configurations'),
+    ('audit.read', 'View the audit log of the project');
+
+-- role_permission
+INSERT INTO role_permission (role_id, permission)
+    SELECT '37f14863-8f3b-44ca-8deb-4b74ce8a8a69', name FROM permission;
+INSERT INTO role_permission (role_id, permission)
+    SELECT '2962bdde-7007-4ba0-943f-cb8e72e90704', name FROM permission
+    WHERE name NOT IN ('project.write', 'project.members', 'alert_notification.write', 'audit.read');
+INSERT INTO role_permission (role_id, permission) VALUES
+    ('a4c3b7a3-4c0e-4b8f-9c3a-7d0f2e1b5c61', 'project.read'),
+    ('5e8d2f1c-9b7a-4e6d-8c5b-3a2f1e0d9c87', 'project.read'),
+    ('5e8d2f1c-9b7a-4e6d-8c5b-3a2f1e0d9c87', 'measurement.write'),
+    ('5e8d2f1c-9b7a-4e6d-8c5b-3a2f1e0d9c87', 'note.write'),
+    ('c1f0e9d8-7b6a-4c5d-9e8f-1a2b3c4d5e6f', 'project.read'),
+    ('c1f0e9d8-7b6a-4c5d-9e8f-1a2b3c4d5e6f', 'alert_config.write'),
+    ('c1f0e9d8-7b6a-4c5d-9e8f-1a2b3c4d5e6f', 'alert.acknowledge'),
+    ('c1f0e9d8-7b6a-4c5d-9e8f-1a2b3c4d5e6f', 'alert_notification.write');
 
 -- -------
 -- Domains
@@ -658,6 +923,122 @@ INSERT INTO unit (id, unit_family_id, measure_id, name, abbreviation) VALUES
 ('1292b2a5-b78e-4a7a-80e3-978d44cbff2b', 'c4eccc63-4bfb-4dd2-9f73-920ec7b385a0', 'c70e7392-0108-4a17-a99f-244895f12558', 'yards per second', 'yd/s'),
 ('4a999277-4cf5-4282-93ce-23b33c65e2c8', 'c9f3b6d2-3136-4330-a330-66e402b4ee04', '43fefa8b-10e9-4b27-8ed4-36e36174fbeb', 'unknown', 'unknown');
 
+-- unit conversion factors; value in SI unit of measure = value * si_factor + si_offset
+UPDATE unit u SET si_factor = c.si_factor, si_offset = c.si_offset
+FROM (VALUES
+    -- length (meters)
+    ('3ba8644b-46d6-46d2-88ad-8abfb8c1d89e', 0.01, 0),
+    ('2e596176-a176-4f1f-b9d4-7bd03f283eda', 0.01, 0),
+    ('2f50063c-121b-4926-8793-49a9a1baac51', 1000, 0),
+    ('aaf6631a-51a0-478a-be9c-997ffb5a3beb', 1000, 0),
+    ('ae06a7db-1e18-4994-be41-9d5a408d6cad', 1, 0),
+    ('4b503088-fab3-46cf-a5e1-44bf9fbf6ad9', 1, 0),
+    ('612d6fa5-954a-40fd-86d6-c21a75fe6cff', 0.001, 0),
+    ('22c1bb95-4e0c-4e53-a24d-c1327fd11575', 0.001, 0),
+    ('84d8a118-71e3-4541-a7a1-8d4cac9e7b35', 0.000001, 0),
+    ('f777f2e2-5e32-424e-a1ca-19d16cd8abce', 0.3048, 0),
+    ('4ee79a3d-a053-41b8-85b5-bb2eea3c9d1a', 0.0254, 0),
+    ('cc83a42b-16a7-46a8-b3a6-966bad7ae2d7', 0.9144, 0),
+    ('904c90a3-3e96-49fa-9b0d-778dadbe2bc0', 1609.344, 0),
+    ('cfcf408b-9193-4b80-99ce-1171554c9428', 1852, 0),
+    -- pressure (pascals)
+    ('41d4f482-7b11-4761-998e-ffd5cc901e07', 1, 0),
+    ('50f18926-2953-4335-a96b-7212c4b927ed', 1000, 0),
+    ('b3e51265-85df-44c3-8ad6-b04a902bd755', 1000, 0),
+    ('55dda9ef-7ba6-4432-b64d-8ef0e65154f4', 100, 0),
+    ('4aebf158-1306-4b7d-9e35-9e5998414bba', 100, 0),
+    ('3e6ebdd4-dc07-4854-8057-47a4b11da141', 100000, 0),
+    ('bcc09b92-e21b-4be8-a76f-aa418764a83c', 101325, 0),
+    ('7c3f5f46-d7a1-4cf7-be9f-d82c11398a1d', 6894.757293168, 0),
+    ('39b025ed-488d-42ab-93a2-08ae5cdc8ae0', 3386.389, 0),
+    ('3ccd658d-f656-4fb4-b2b9-913ab7279ada', 3386.389, 0),
+    ('fc5f41e1-f5c6-453b-bda9-97530f400cc7', 133.322387415, 0),
+    ('6407a23f-b5f8-4214-9343-50b6231e4bfe', 133.322387415, 0),
+    -- temperature (kelvin)
+    ('959e4dcb-c871-4b85-bc07-1b5ab644962a', 1, 0),
+    ('2d379b61-fef6-4531-9e58-cace51909e44', 1, 273.15),
+    ('6462733b-5b42-46a2-ad44-882a5332eafc', 1, 273.15),
+    ('10e05b5c-7e96-434b-9182-a547333e1c52', 0.5555555555555556, 255.3722222222222),
+    ('daeee256-c762-43a2-8369-2d295525023c', 0.5555555555555556, 255.3722222222222),
+    -- volume (cubic meters)
+    ('bf9a00d8-084b-4006-b71a-602616f1f59a', 1, 0),
+    ('c4d57208-54ea-420f-802b-8de8698f0616', 1, 0),
+    ('e21b6ee7-de8a-4f6b-ae18-aea85429914c', 1, 0),
+    ('c768c0c8-36e4-495a-bb9a-2065402db3c5', 1000, 0),
+    ('a24cdecc-a4ec-4ca7-bc0c-f8fb6bb39e04', 1000000000, 0),
+    ('7e2b9278-c291-4e08-b375-1fe9377ba535', 0.001, 0),
+    ('3562948d-ff27-4604-8a24-0240eb946682', 0.000001, 0),
+    ('b8c28057-9751-4850-be45-d1ab921faf6a', 0.000001, 0),
+    ('af03fe6f-b203-48fd-8fb0-a985fbe188a0', 0.000000001, 0),
+    ('f505adf3-1f8c-4f05-ae51-06d8ed4e1d9a', 0.028316846592, 0),
+    ('71e15fb3-84a6-4aa2-a7d1-f8a6cfe2d9ef', 0.028316846592, 0),
+    ('259c1ab3-4f70-4279-b698-b28bb1aaa009', 0.000016387064, 0),
+    ('31e65a46-2a67-48f9-9b51-c49f0c82a5dc', 1233.48183754752, 0),
+    ('23aa81c1-74a0-4186-a481-1fd2f146986e', 1233.48183754752, 0),
+    ('b4653aaf-cd50-4589-bf1d-c1ded1247d30', 1233481.83754752, 0),
+    ('1c9e2b24-ec6f-49a6-b18a-7ca1a9a4a79d', 2446.5755455488, 0),
+    ('7c5b5dc4-8c71-43f1-8ae5-914ee21d1ccc', 0.003785411784, 0),
+    ('4df68cc3-2065-412b-af6b-fac5ccf1a087', 3.785411784, 0),
+    ('cffd4714-3378-4c3d-96ed-2d68c11223d7', 3785.411784, 0),
+    ('f4367827-9622-40b9-9ff1-711e14252c05', 0.000946352946, 0),
+    ('19cb5112-0393-47b7-bc38-cb59a5d925d1', 0.000473176473, 0),
+    ('def3ff33-dae6-4a67-b24c-9a1b8bb463f8', 0.0000295735295625, 0),
+    ('5a2922f1-3553-4b0f-88ff-1ce12c47bf5c', 4168181825.440579584, 0),
+    -- area (square meters)
+    ('bf91e9d0-f429-44f5-9774-ea7212ba8113', 1, 0),
+    ('61b725e9-a741-4eb9-b34a-57c9dc4853de', 1, 0),
+    ('3383d7d4-ffa1-4522-a8f5-f16561c5bd2f', 1000, 0),
+    ('42029141-61fc-4280-b1cf-d3ad8a3b210e', 10000, 0),
+    ('fe3715f0-bcaa-4b6e-ac68-5677096ab902', 1000000, 0),
+    ('f3ec6179-8399-41b7-9ce3-049336e8a4fe', 1000000, 0),
+    ('81cb1c58-e050-46ba-b510-71dad374192a', 0.0001, 0),
+    ('290f1547-a3c5-4158-a088-b50f5cabe8be', 0.000001, 0),
+    ('6b4727ea-dc34-4699-8a88-d7da04daa2e3', 0.09290304, 0),
+    ('49a95b0b-6688-4fd1-b22a-87a6bee39081', 0.09290304, 0),
+    ('d4a9183d-b333-4b45-a23d-549a5609788e', 0.00064516, 0),
+    ('e0b63a59-6da5-43d8-8e1b-f9d50078eec6', 0.83612736, 0),
+    ('81451270-cce7-49b3-9d1c-cf7b1e557602', 4046.8564224, 0),
+    ('0d179de1-188c-441c-9f04-f89008a8305b', 2589988.110336, 0),
+    ('0fefe6f8-ab65-4ce4-86a6-b223980f06f2', 2589988.110336, 0),
+    -- mass (kilograms)
+    ('6f23f255-a323-401f-97da-2f2d831c1fa0', 1, 0),
+    ('a063b3d6-c476-4dc6-8db1-2e4320bbfc02', 0.001, 0),
+    ('362bee5d-2157-4730-b2f4-bd8d06c374cd', 0.000001, 0),
+    ('7ab9cb43-ac59-457d-8189-ee35fc0eed9e', 0.000000001, 0),
+    ('c0fb3e31-60eb-4203-8755-e88c533bc61c', 1000, 0),
+    ('0b2ecf01-9034-4043-953e-ae20f0e8c935', 907.18474, 0),
+    ('c6a6a797-ee49-46fe-b67a-727c718a51ad', 0.028349523125, 0),
+    -- time (seconds)
+    ('93229888-9513-4d66-9ab8-9fb6e37b65d8', 1, 0),
+    ('72a86938-dd9b-4590-9770-4d6fa76e1f4d', 60, 0),
+    ('eed52efd-ec26-46ba-98de-582a22ec4ed4', 3600, 0),
+    ('3ac8944a-99d4-4ef2-874f-667983b63e6e', 86400, 0),
+    ('5fa61c67-38e6-46ae-ac1f-114278706261', 604800, 0),
+    -- velocity and linear speed (meters per second)
+    ('e142d705-9eb6-4965-91d0-af55739189b0', 1, 0),
+    ('c96294dc-f238-4d4d-8705-0a1b2d3f9b55', 1, 0),
+    ('aaa20163-ef13-4956-9ef4-76f6273954d5', 0.01, 0),
+    ('bdff5fd3-4b67-40b1-8ce3-8ff2733d74a1', 0.001, 0),
+    ('1924c73e-591e-4d08-bd7d-cbd46d555b9b', 0.3048, 0),
+    ('063497fb-7693-47c5-b9a6-fdfac8dd7562', 0.0254, 0),
+    ('1292b2a5-b78e-4a7a-80e3-978d44cbff2b', 0.9144, 0),
+    ('fb756f9f-0132-4e84-ac71-524d9a9c4164', 0.44704, 0),
+    ('9564a419-4cd7-4ca2-87e4-43cc299e6917', 0.5144444444444444, 0),
+    ('5f31367e-be0f-44b0-8618-53476db34944', 0.2777777777777778, 0),
+    ('f5652580-4e5f-4fdb-b80c-ef1ad46c9242', 0.00000029398148148148, 0),
+    ('2210ac17-04f6-4b29-ad7c-0cd9c40ffa2f', 0.0000070555555555556, 0),
+    ('7a6dd39d-7662-43fa-bb1f-1ed28f266b5b', 0.000000011574074074074, 0),
+    ('c3beaa02-38aa-4925-b444-280ae847ded1', 0.00000027777777777778, 0),
+    -- flow and volume rate (cubic meters per second)
+    ('67d3c3f0-ae76-4807-8cdd-4e29fa8d8b39', 1, 0),
+    ('66239345-fa21-48cd-922a-7304fc3cffa2', 1, 0),
+    ('d23b3b3b-69dc-4753-8b59-01848f027408', 0.028316846592, 0),
+    ('ebe3c23b-e25f-42b1-ad2a-db752fccc6fa', 28.316846592, 0),
+    ('8a7afb4f-e0ac-409d-98ea-b025dce9b777', 0.0000630901964, 0),
+    ('576d54f5-c90c-40e5-833f-7e2e9745c00d', 0.0438126363888889, 0)
+) AS c(id, si_factor, si_offset)
+WHERE u.id = c.id::UUID;
+
 -- parameter
 INSERT INTO parameter (id, name) VALUES
     ('b4ea8385-48a3-4e95-82fb-d102dfcbcb54', 'air-temperature'),
@@ -718,9 +1099,9 @@ INSERT INTO instrument_group (project_id, id, slug, name, description) VALUES
     ('5b6f4f37-7755-4cf9-bd02-94f1e9bc5984', 'd0916e8a-39a6-4f2f-bd31-879881f8b40c', 'sample-instrument-group', 'Sample Instrument Group 1', 'This is an example instrument group');
 
 -- instrument
-INSERT INTO instrument (project_id, id, slug, name, formula, formula_parameter_id, formula_unit_id, geometry, type_id) VALUES
-    ('5b6f4f37-7755-4cf9-bd02-94f1e9bc5984', 'a7540f69-c41e-43b3-b655-6e44097edb7e', 'demo-piezometer-1', 'Demo Piezometer 1', '[demo-piezometer-1.top-of-riser] - [demo-piezometer-1.distance-to-water]', '2b7f96e1-820f-4f61-ba8f-861640af6232', '4a999277-4cf5-4282-93ce-23b33c65e2c8', ST_GeomFromText('POINT(-80.8 26.7)',4326),'1bb4bf7c-f5f8-44eb-9805-43b07ffadbef'),
-    ('5b6f4f37-7755-4cf9-bd02-94f1e9bc5984', '9e8f2ca4-4037-45a4-aaca-d9e598877439', 'demo-staffgage-1', 'Demo Staffgage 1', null, null, null, ST_GeomFromText('POINT(-80.85 26.75)',4326),'0fd1f9ba-2731-4ff9-96dd-3c03215ab06f');
+INSERT INTO instrument (project_id, id, slug, name, geometry, type_id) VALUES
+    ('5b6f4f37-7755-4cf9-bd02-94f1e9bc5984', 'a7540f69-c41e-43b3-b655-6e44097edb7e', 'demo-piezometer-1', 'Demo Piezometer 1', ST_GeomFromText('POINT(-80.8 26.7)',4326),'1bb4bf7c-f5f8-44eb-9805-43b07ffadbef'),
+    ('5b6f4f37-7755-4cf9-bd02-94f1e9bc5984', '9e8f2ca4-4037-45a4-aaca-d9e598877439', 'demo-staffgage-1', 'Demo Staffgage 1', ST_GeomFromText('POINT(-80.85 26.75)',4326),'0fd1f9ba-2731-4ff9-96dd-3c03215ab06f');
 
 -- instrument_group_instruments
 INSERT INTO instrument_group_instruments (instrument_id, instrument_group_id) VALUES
@@ -776,6 +1157,10 @@ INSERT INTO instrument_constants (instrument_id, timeseries_id) VALUES
 ('a7540f69-c41e-43b3-b655-6e44097edb7e', '22a734d6-dc24-451d-a462-43a32f335ae8'),
 ('a7540f69-c41e-43b3-b655-6e44097edb7e', '14247bc8-b264-4857-836f-182d47ebb39d');
 
+-- formula
+INSERT INTO formula (id, instrument_id, slug, name, formula, parameter_id, unit_id) VALUES
+('5b7c9ba1-0c4f-4b41-ae53-6b1a4f9e8a8c', 'a7540f69-c41e-43b3-b655-6e44097edb7e', 'formula', 'Formula', '[demo-piezometer-1.top-of-riser] - [demo-piezometer-1.distance-to-water]', '2b7f96e1-820f-4f61-ba8f-861640af6232', '4a999277-4cf5-4282-93ce-23b33c65e2c8');
+
 -- Time Series Measurements
 INSERT INTO timeseries_measurement (timeseries_id, time, value) VALUES
 ('869465fc-dc1e-445e-81f4-9979b5fadda9', '1/1/2020' , 13.16),
@@ -25,7 +25,6 @@ CREATE OR REPLACE VIEW v_instrument AS (
         I.slug,
         I.name,
         I.type_id,
-        I.formula,
         T.name AS type,
         ST_AsBinary(I.geometry) AS geometry,
         I.station,
@@ -40,7 +39,8 @@ CREATE OR REPLACE VIEW v_instrument AS (
         TEL.telemetry AS telemetry,
         COALESCE(C.constants, '{}') AS constants,
         COALESCE(G.groups, '{}') AS groups,
-        COALESCE(A.alert_configs, '{}') AS alert_configs
+        COALESCE(A.alert_configs, '{}') AS alert_configs,
+        COALESCE(F.formulas, '{}') AS formulas
     FROM instrument I
     INNER JOIN instrument_type T ON T.id = I.type_id
     INNER JOIN (
@@ -71,6 +71,12 @@ CREATE OR REPLACE VIEW v_instrument AS (
         FROM alert_config
         GROUP BY instrument_id
     ) A on A.instrument_id = I.id
+    LEFT JOIN (
+        SELECT array_agg(id) as formulas,
+            instrument_id
+        FROM formula
+        GROUP BY instrument_id
+    ) F on F.instrument_id = I.id
     LEFT JOIN (
         SELECT instrument_id,
                 json_agg(
@@ -103,7 +109,8 @@ CREATE OR REPLACE VIEW v_project AS (
             p.update_date,
             COALESCE(t.timeseries, '{}') AS timeseries,
             COALESCE(i.count, 0) AS instrument_count,
-            COALESCE(g.count, 0) AS instrument_group_count
+            COALESCE(g.count, 0) AS instrument_group_count,
+            p.visibility
         FROM project p
             LEFT JOIN (
                 SELECT project_id,
@@ -140,15 +147,16 @@ CREATE OR REPLACE VIEW v_timeseries AS (
             false                AS is_computed
         FROM timeseries
         UNION
-        SELECT formula_id        AS id,
-            'formula'            AS slug,
-            'Formula'            AS name,
-            id                   AS instrument_id,
-            formula_parameter_id AS parameter_id,
-            formula_unit_id      AS unit_id,
+        SELECT f.id              AS id,
+            f.slug               AS slug,
+            f.name               AS name,
+            f.instrument_id      AS instrument_id,
+            f.parameter_id       AS parameter_id,
+            f.unit_id            AS unit_id,
             true                 AS is_computed
-        FROM instrument
-        WHERE NOT deleted AND formula IS NOT NULL
+        FROM formula f
+        INNER JOIN instrument i ON i.id = f.instrument_id
+        WHERE NOT i.deleted
     )
     SELECT t.id                 AS id,
         t.slug                  AS slug,
@@ -209,6 +217,7 @@ CREATE OR REPLACE VIEW v_email_autocomplete AS (
            email,
            username||email AS username_email
     FROM profile
+    WHERE NOT is_service_account
 );
 
 -- v_alert
@@ -221,11 +230,28 @@ CREATE OR REPLACE VIEW v_alert AS (
 	   i.id AS instrument_id,
 	   i.name AS instrument_name,
 	   ac.name AS name,
-	   ac.body AS body
+	   COALESCE(a.body, ac.body) AS body,
+	   a.status AS status,
+	   a.acknowledged_by AS acknowledged_by,
+	   pa.username AS acknowledged_by_username,
+	   a.acknowledge_date AS acknowledge_date,
+	   a.acknowledge_comment AS acknowledge_comment,
+	   a.resolved_by AS resolved_by,
+	   pr.username AS resolved_by_username,
+	   a.resolve_date AS resolve_date,
+	   a.resolve_comment AS resolve_comment,
+	   a.escalate_date AS escalate_date,
+	   a.last_trigger_date AS last_trigger_date,
+	   a.trigger_count AS trigger_count,
+	   a.clear_date AS clear_date,
+	   a.level AS level,
+	   a.subject AS subject
 FROM alert a
 INNER JOIN alert_config ac ON a.alert_config_id = ac.id
 INNER JOIN instrument i ON ac.instrument_id = i.id
 INNER JOIN project p ON i.project_id = p.id
+LEFT JOIN profile pa ON pa.id = a.acknowledged_by
+LEFT JOIN profile pr ON pr.id = a.resolved_by
 );
 
 CREATE OR REPLACE VIEW v_unit AS (
@@ -235,7 +261,9 @@ CREATE OR REPLACE VIEW v_unit AS (
            u.unit_family_id AS unit_family_id,
            f.name           AS unit_family,
            u.measure_id     AS measure_id,
-           m.name           AS measure
+           m.name           AS measure,
+           u.si_factor      AS si_factor,
+           u.si_offset      AS si_offset
     FROM unit u
     INNER JOIN unit_family f ON f.id = u.unit_family_id
     INNER JOIN measure m ON m.id = u.measure_id
@@ -311,7 +339,8 @@ CREATE OR REPLACE VIEW v_profile_project_roles AS (
            c.id AS project_id,
            r.id   AS role_id,
            r.name AS role,
-           UPPER(c.slug || '.' || r.name) AS rolename
+           UPPER(c.slug || '.' || r.name) AS rolename,
+           b.is_service_account
     FROM profile_project_roles a
     INNER JOIN profile b ON b.id = a.profile_id
     INNER JOIN project c ON c.id = a.project_id
@@ -333,14 +362,15 @@ CREATE OR REPLACE VIEW v_profile AS (
            p.username,
            p.email,
            p.is_admin,
-           COALESCE(r.roles,'{}') AS roles
+           COALESCE(r.roles,'{}') AS roles,
+           p.is_service_account
     FROM profile p
     LEFT JOIN roles_by_profile r ON r.profile_id = p.id
 );
 
 
 -- Only Includes Computed Timeseries
--- Note: timeseries_id in this table is the formula_id for a given instrument
+-- Note: timeseries_id in this table is the formula id for a given instrument
 CREATE OR REPLACE VIEW v_timeseries_dependency AS (
     WITH variable_tsid_map AS (
 	    SELECT a.id AS timeseries_id,
@@ -348,15 +378,15 @@ CREATE OR REPLACE VIEW v_timeseries_dependency AS (
 	    FROM timeseries a
 	    LEFT JOIN instrument b ON b.id = a.instrument_id
     )
-    SELECT i.instrument_id   AS instrument_id,
-           i.formula_id      AS timeseries_id,
-           i.parsed_variable AS parsed_variable,
+    SELECT f.instrument_id   AS instrument_id,
+           f.formula_id      AS timeseries_id,
+           f.parsed_variable AS parsed_variable,
            m.timeseries_id   AS dependency_timeseries_id
     FROM (
-        SELECT id AS instrument_id,
-            formula_id,
+        SELECT instrument_id,
+            id AS formula_id,
             (regexp_matches(formula, '\[(.*?)\]', 'g'))[1] AS parsed_variable
-        FROM instrument
-    ) i
-    LEFT JOIN variable_tsid_map m ON m.variable = i.parsed_variable
+        FROM formula
+    ) f
+    LEFT JOIN variable_tsid_map m ON m.variable = f.parsed_variable
 );
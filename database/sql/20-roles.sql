@@ -20,6 +20,7 @@ GRANT USAGE ON SCHEMA midas TO instrumentation_user;
 -- Role instrumentation_reader
 -- Tables specific to instrumentation app
 GRANT SELECT ON
+    audit_log,
     instrument,
     instrument_telemetry,
     telemetry_goes,
@@ -28,7 +29,13 @@ GRANT SELECT ON
     alert,
     alert_read,
     alert_config,
+    alert_evaluation,
     alert_email_subscription,
+    alert_escalation_subscription,
+    alert_email_delivery,
+    alert_email_digest,
+    alert_webhook,
+    alert_webhook_delivery,
     alert_profile_subscription,
     collection_group,
     collection_group_timeseries,
@@ -37,6 +44,8 @@ GRANT SELECT ON
     profile_project_roles,
     profile_token,
     role,
+    role_permission,
+    permission,
     email,
     heartbeat,
     instrument_constants,
@@ -45,6 +54,8 @@ GRANT SELECT ON
     instrument_note,
     instrument_status,
     instrument_type,
+    formula,
+    formula_variable_unit,
     measure,
     parameter,
     plot_configuration,
@@ -78,7 +89,13 @@ GRANT INSERT,UPDATE,DELETE ON
     alert,
     alert_read,
     alert_config,
+    alert_evaluation,
     alert_email_subscription,
+    alert_escalation_subscription,
+    alert_email_delivery,
+    alert_email_digest,
+    alert_webhook,
+    alert_webhook_delivery,
     alert_profile_subscription,
     collection_group,
     collection_group_timeseries,
@@ -98,11 +115,15 @@ GRANT INSERT,UPDATE,DELETE ON
     instrument_note,
     instrument_status,
     instrument_type,
+    formula,
+    formula_variable_unit,
     measure,
     parameter,
     project,
     project_timeseries,
     role,
+    role_permission,
+    permission,
     status,
     telemetry_goes,
     telemetry_iridium,
@@ -113,6 +134,9 @@ GRANT INSERT,UPDATE,DELETE ON
     unit_family
 TO instrumentation_writer;
 
+-- audit_log is append-only
+GRANT INSERT ON audit_log TO instrumentation_writer;
+
 -- Role postgis_reader
 GRANT SELECT ON geometry_columns TO postgis_reader;
 GRANT SELECT ON geography_columns TO postgis_reader;
@@ -76,37 +76,37 @@ where i.project_id = 'a012e753-9eff-426d-b0ee-090b430d1980';
 
 
 --INSERT INSTRUMENTS--COUNT:29
-INSERT INTO instrument(id, deleted, slug, name, formula, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
+INSERT INTO instrument(id, deleted, slug, name, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
  VALUES 
-('089fbda3-5a6a-408d-aec2-4e108910d94b', False, 'avnn6', 'AVNN6', null, ST_GeomFromText('POINT(-77.7566 42.9184)',4326), null, null, '2021-03-12T16:45:21.630469Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04228500'),
-('209980f3-ab26-42d6-9fe7-13c0b6221f88', False, 'blbn6', 'BLBN6', null, ST_GeomFromText('POINT(-77.6806 43.0922)',4326), null, null, '2021-03-12T16:45:21.630825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, null),
-('8d0307a9-7a78-4eae-919a-b38a6b1c9c97', False, 'chcn6', 'CHCN6', null, ST_GeomFromText('POINT(-77.8822 43.1008)',4326), null, null, '2021-03-12T16:45:21.630985Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04231000'),
-('b4d043f5-82bc-4d1e-abdf-a43fe36f1695', False, 'dsvn6', 'DSVN6', null, ST_GeomFromText('POINT(-77.7064 42.5322)',4326), null, null, '2021-03-12T16:45:21.631193Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04224775'),
-('891c7d48-fa1b-4f4a-a7dd-7393258bb575', False, 'garn6', 'GARN6', null, ST_GeomFromText('POINT(-77.7914 43.01)',4326), null, null, '2021-03-12T16:45:21.631427Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04230500'),
-('1dc2a261-8b00-4039-8d3f-f48666e97729', False, 'hnyn6', 'HNYN6', null, ST_GeomFromText('POINT(-77.5869 42.9567)',4326), null, null, '2021-03-12T16:45:21.631609Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04229500'),
-('08735594-14f0-4594-aa38-fb0805968918', False, 'blackcr-churchvl', 'BlackCr Churchvl', null, ST_GeomFromText('POINT(-77.8822 43.1006)',4326), null, null, '2021-03-12T16:45:21.631836Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04231000'),
-('871485de-0116-4f4a-afcd-30f3f8ec02c5', False, 'genr-portagevill', 'GenR Portagevill', null, ST_GeomFromText('POINT(-78.0422 42.5703)',4326), null, null, '2021-03-12T16:45:21.632074Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04223000'),
-('4e06209a-9e0b-4430-87fe-a7ac49d92160', False, 'oatkacr-garbutt', 'OatkaCr Garbutt', null, ST_GeomFromText('POINT(-77.7914 43.01)',4326), null, null, '2021-03-12T16:45:21.632371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04230500'),
-('a4fc3899-be8d-4055-b9e9-cd9ff986ba98', False, 'knvn6', 'KNVN6', null, ST_GeomFromText('POINT(-78.3103 43.3011)',4326), null, null, '2021-03-12T16:45:21.632569Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '0422016550'),
-('3b260872-3454-473b-bfb7-087ed0e809b0', False, 'mbyp1', 'MBYP1', null, ST_GeomFromText('POINT(-77.2736 41.8425)',4326), null, null, '2021-03-12T16:45:21.632863Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '01518420'),
-('606dcda7-8287-4435-9d66-deb4c03b4bf6', False, 'olnn6', 'OLNN6', null, ST_GeomFromText('POINT(-78.4511 42.0731)',4326), null, null, '2021-03-12T16:45:21.633203Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '03010820'),
-('0db970b5-3c46-4f54-b17b-8e861c0a5d65', False, 'rohn6', 'ROHN6', null, ST_GeomFromText('POINT(-77.6163 43.1417)',4326), null, null, '2021-03-12T16:45:21.633494Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04231600'),
-('2673f0e2-16a4-443a-ac86-7c337d960f4f', False, 'shnp1', 'SHNP1', null, ST_GeomFromText('POINT(-78.1983 41.9617)',4326), null, null, '2021-03-12T16:45:21.634043Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '03010655'),
-('c05efa0c-fa2c-4776-a49b-dd68f70f5104', False, 'genr-wellsville', 'GenR Wellsville', null, ST_GeomFromText('POINT(-77.9572 42.1222)',4326), null, null, '2021-03-12T16:45:21.634387Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04221000'),
-('b9b0ec3f-3f84-4c41-b8ff-55bdf7b6138a', False, 'jonn6', 'JONN6', null, ST_GeomFromText('POINT(-77.8386 42.7667)',4326), null, null, '2021-03-12T16:45:21.634674Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04227500'),
-('ef991379-a625-4de7-bc6a-2902fea1bc79', False, 'mount-morris', 'Mount Morris', null, ST_GeomFromText('POINT(-77.9071 42.7333)',4326), null, null, '2021-03-12T16:45:21.634946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04224000'),
-('37989e13-f192-4d1f-aa24-62591f8d731d', False, 'mount-morris-tailwater', 'Mount Morris-Tailwater', null, ST_GeomFromText('POINT(-77.9109 42.7332)',4326), null, null, '2021-03-12T16:45:21.634946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, null),
-('90664d51-0503-45cf-85bb-5d46fa579d0f', False, 'ptgn6', 'PTGN6', null, ST_GeomFromText('POINT(-78.0431 42.5697)',4326), null, null, '2021-03-12T16:45:21.635399Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04223000'),
-('2ae81f1b-2bcb-40e8-9f12-5874d4269cc5', False, 'weln6', 'WELN6', null, ST_GeomFromText('POINT(-77.9572 42.1222)',4326), null, null, '2021-03-12T16:45:21.635707Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04221000'),
-('f063108f-9c3b-49fd-b981-1be021278ebf', False, 'wrsn6', 'WRSN6', null, ST_GeomFromText('POINT(-78.1375 42.7447)',4326), null, null, '2021-03-12T16:45:21.636012Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, null),
-('15614ca3-c115-4cff-a021-c43ef352fda8', False, 'rcrn6', 'RCRN6', null, ST_GeomFromText('POINT(-77.6025 43.258)',4326), null, null, '2021-03-12T16:45:21.636321Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, null),
-('31be5853-f839-4cc2-80cb-071210651059', False, 'elkp1', 'ELKP1', null, ST_GeomFromText('POINT(-77.3025 41.9875)',4326), null, null, '2021-03-12T16:45:21.636578Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '01519200'),
-('8df04db9-392f-4216-9776-66defd9d8d32', False, 'frkn6', 'FRKN6', null, ST_GeomFromText('POINT(-78.4636 42.3294)',4326), null, null, '2021-03-12T16:45:21.636856Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '421946078274901'),
-('a6e1707c-08f9-4484-ba65-cd054e9561bd', False, 'hrln6', 'HRLN6', null, ST_GeomFromText('POINT(-77.7044 42.3489)',4326), null, null, '2021-03-12T16:45:21.637182Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '01523000'),
-('4f8c4f01-5e19-4a60-b55b-e7013e8977bb', False, 'canaseragashaker', 'CanaseragaShaker', null, ST_GeomFromText('POINT(-77.8414 42.7361)',4326), null, null, '2021-03-12T16:45:21.637556Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04227000'),
-('abf85e4e-e9f5-4814-9ecd-d21de65a2feb', False, 'oatkacr-warsaw', 'OatkaCr Warsaw', null, ST_GeomFromText('POINT(-78.1375 42.7442)',4326), null, null, '2021-03-12T16:45:21.637935Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04230380'),
-('c559d145-3351-4e6e-bf8d-df05f69814ea', False, 'genr-avon', 'GenR Avon', null, ST_GeomFromText('POINT(-77.7572 42.9178)',4326), null, null, '2021-03-12T16:45:21.638262Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04228500'),
-('b695967d-c050-4428-ab1e-db9407fe9d2f', False, 'akln6', 'AKLN6', null, ST_GeomFromText('POINT(-77.7167 42.3958)',4326), null, null, '2021-03-12T16:45:21.638580Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '01521000');
+('089fbda3-5a6a-408d-aec2-4e108910d94b', False, 'avnn6', 'AVNN6', ST_GeomFromText('POINT(-77.7566 42.9184)',4326), null, null, '2021-03-12T16:45:21.630469Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04228500'),
+('209980f3-ab26-42d6-9fe7-13c0b6221f88', False, 'blbn6', 'BLBN6', ST_GeomFromText('POINT(-77.6806 43.0922)',4326), null, null, '2021-03-12T16:45:21.630825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, null),
+('8d0307a9-7a78-4eae-919a-b38a6b1c9c97', False, 'chcn6', 'CHCN6', ST_GeomFromText('POINT(-77.8822 43.1008)',4326), null, null, '2021-03-12T16:45:21.630985Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04231000'),
+('b4d043f5-82bc-4d1e-abdf-a43fe36f1695', False, 'dsvn6', 'DSVN6', ST_GeomFromText('POINT(-77.7064 42.5322)',4326), null, null, '2021-03-12T16:45:21.631193Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04224775'),
+('891c7d48-fa1b-4f4a-a7dd-7393258bb575', False, 'garn6', 'GARN6', ST_GeomFromText('POINT(-77.7914 43.01)',4326), null, null, '2021-03-12T16:45:21.631427Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04230500'),
+('1dc2a261-8b00-4039-8d3f-f48666e97729', False, 'hnyn6', 'HNYN6', ST_GeomFromText('POINT(-77.5869 42.9567)',4326), null, null, '2021-03-12T16:45:21.631609Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04229500'),
+('08735594-14f0-4594-aa38-fb0805968918', False, 'blackcr-churchvl', 'BlackCr Churchvl', ST_GeomFromText('POINT(-77.8822 43.1006)',4326), null, null, '2021-03-12T16:45:21.631836Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04231000'),
+('871485de-0116-4f4a-afcd-30f3f8ec02c5', False, 'genr-portagevill', 'GenR Portagevill', ST_GeomFromText('POINT(-78.0422 42.5703)',4326), null, null, '2021-03-12T16:45:21.632074Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04223000'),
+('4e06209a-9e0b-4430-87fe-a7ac49d92160', False, 'oatkacr-garbutt', 'OatkaCr Garbutt', ST_GeomFromText('POINT(-77.7914 43.01)',4326), null, null, '2021-03-12T16:45:21.632371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04230500'),
+('a4fc3899-be8d-4055-b9e9-cd9ff986ba98', False, 'knvn6', 'KNVN6', ST_GeomFromText('POINT(-78.3103 43.3011)',4326), null, null, '2021-03-12T16:45:21.632569Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '0422016550'),
+('3b260872-3454-473b-bfb7-087ed0e809b0', False, 'mbyp1', 'MBYP1', ST_GeomFromText('POINT(-77.2736 41.8425)',4326), null, null, '2021-03-12T16:45:21.632863Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '01518420'),
+('606dcda7-8287-4435-9d66-deb4c03b4bf6', False, 'olnn6', 'OLNN6', ST_GeomFromText('POINT(-78.4511 42.0731)',4326), null, null, '2021-03-12T16:45:21.633203Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '03010820'),
+('0db970b5-3c46-4f54-b17b-8e861c0a5d65', False, 'rohn6', 'ROHN6', ST_GeomFromText('POINT(-77.6163 43.1417)',4326), null, null, '2021-03-12T16:45:21.633494Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04231600'),
+('2673f0e2-16a4-443a-ac86-7c337d960f4f', False, 'shnp1', 'SHNP1', ST_GeomFromText('POINT(-78.1983 41.9617)',4326), null, null, '2021-03-12T16:45:21.634043Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '03010655'),
+('c05efa0c-fa2c-4776-a49b-dd68f70f5104', False, 'genr-wellsville', 'GenR Wellsville', ST_GeomFromText('POINT(-77.9572 42.1222)',4326), null, null, '2021-03-12T16:45:21.634387Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04221000'),
+('b9b0ec3f-3f84-4c41-b8ff-55bdf7b6138a', False, 'jonn6', 'JONN6', ST_GeomFromText('POINT(-77.8386 42.7667)',4326), null, null, '2021-03-12T16:45:21.634674Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04227500'),
+('ef991379-a625-4de7-bc6a-2902fea1bc79', False, 'mount-morris', 'Mount Morris', ST_GeomFromText('POINT(-77.9071 42.7333)',4326), null, null, '2021-03-12T16:45:21.634946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04224000'),
+('37989e13-f192-4d1f-aa24-62591f8d731d', False, 'mount-morris-tailwater', 'Mount Morris-Tailwater', ST_GeomFromText('POINT(-77.9109 42.7332)',4326), null, null, '2021-03-12T16:45:21.634946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, null),
+('90664d51-0503-45cf-85bb-5d46fa579d0f', False, 'ptgn6', 'PTGN6', ST_GeomFromText('POINT(-78.0431 42.5697)',4326), null, null, '2021-03-12T16:45:21.635399Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04223000'),
+('2ae81f1b-2bcb-40e8-9f12-5874d4269cc5', False, 'weln6', 'WELN6', ST_GeomFromText('POINT(-77.9572 42.1222)',4326), null, null, '2021-03-12T16:45:21.635707Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04221000'),
+('f063108f-9c3b-49fd-b981-1be021278ebf', False, 'wrsn6', 'WRSN6', ST_GeomFromText('POINT(-78.1375 42.7447)',4326), null, null, '2021-03-12T16:45:21.636012Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, null),
+('15614ca3-c115-4cff-a021-c43ef352fda8', False, 'rcrn6', 'RCRN6', ST_GeomFromText('POINT(-77.6025 43.258)',4326), null, null, '2021-03-12T16:45:21.636321Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, null),
+('31be5853-f839-4cc2-80cb-071210651059', False, 'elkp1', 'ELKP1', ST_GeomFromText('POINT(-77.3025 41.9875)',4326), null, null, '2021-03-12T16:45:21.636578Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '01519200'),
+('8df04db9-392f-4216-9776-66defd9d8d32', False, 'frkn6', 'FRKN6', ST_GeomFromText('POINT(-78.4636 42.3294)',4326), null, null, '2021-03-12T16:45:21.636856Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '421946078274901'),
+('a6e1707c-08f9-4484-ba65-cd054e9561bd', False, 'hrln6', 'HRLN6', ST_GeomFromText('POINT(-77.7044 42.3489)',4326), null, null, '2021-03-12T16:45:21.637182Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '01523000'),
+('4f8c4f01-5e19-4a60-b55b-e7013e8977bb', False, 'canaseragashaker', 'CanaseragaShaker', ST_GeomFromText('POINT(-77.8414 42.7361)',4326), null, null, '2021-03-12T16:45:21.637556Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04227000'),
+('abf85e4e-e9f5-4814-9ecd-d21de65a2feb', False, 'oatkacr-warsaw', 'OatkaCr Warsaw', ST_GeomFromText('POINT(-78.1375 42.7442)',4326), null, null, '2021-03-12T16:45:21.637935Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04230380'),
+('c559d145-3351-4e6e-bf8d-df05f69814ea', False, 'genr-avon', 'GenR Avon', ST_GeomFromText('POINT(-77.7572 42.9178)',4326), null, null, '2021-03-12T16:45:21.638262Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '04228500'),
+('b695967d-c050-4428-ab1e-db9407fe9d2f', False, 'akln6', 'AKLN6', ST_GeomFromText('POINT(-77.7167 42.3958)',4326), null, null, '2021-03-12T16:45:21.638580Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a012e753-9eff-426d-b0ee-090b430d1980', '00000000-0000-0000-0000-000000000000', null, '01521000');
 
 --INSERT INSTRUMENT STATUS--
 INSERT INTO instrument_status(id, instrument_id, status_id, "time")
@@ -76,48 +76,48 @@ where i.project_id = '1fa28968-d0b1-4832-b4b3-e58206056819';
 
 
 --INSERT INSTRUMENTS--COUNT:40
-INSERT INTO instrument(id, deleted, slug, name, formula, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
+INSERT INTO instrument(id, deleted, slug, name, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
  VALUES 
-('6fbeb371-98db-4b70-93bb-831b14f72c7b', False, 'portland_f32d', 'Portland', null, ST_GeomFromText('POINT(-85.039 40.4277)',4326), null, null, '2021-03-12T16:51:13.861956Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03324200'),
-('1a47c87e-50d1-48ee-bb78-c246580263cb', False, 'linn-grove', 'Linn Grove', null, ST_GeomFromText('POINT(-85.0309 40.6439)',4326), null, null, '2021-03-12T16:51:13.862190Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03322900'),
-('4deb6eee-81ed-4edd-ac38-0b081eb1a081', False, 'berlin', 'Berlin', null, ST_GeomFromText('POINT(-88.95 43.9539)',4326), null, null, '2021-03-12T16:51:13.862339Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04073500'),
-('b3f286b8-60a3-43e0-9d8a-950f342388a4', False, 'obrien', 'Obrien', null, ST_GeomFromText('POINT(-87.5611 41.65)',4326), null, null, '2021-03-12T16:51:13.862479Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, null),
-('3e29ecea-8f9e-439f-ad99-aaff1b7e1cbc', False, 'fonddulac', 'FondDuLac', null, ST_GeomFromText('POINT(-88.4561 43.8)',4326), null, null, '2021-03-12T16:51:13.862806Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, null),
-('005e3595-446b-40e1-b07c-604d2a808acc', False, 'menasha', 'Menasha', null, ST_GeomFromText('POINT(-88.4472 44.1994)',4326), null, null, '2021-03-12T16:51:13.863032Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, null),
-('2155fea5-633e-4556-8c57-c49c8cf34cc1', False, 'lockport', 'Lockport', null, ST_GeomFromText('POINT(-88.0789 41.5697)',4326), null, null, '2021-03-12T16:51:13.863208Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, null),
-('a597b201-1aa7-4965-8ff2-a1a26fef4763', False, 'mississinewa-tailwater', 'Mississinewa-Tailwater', null, ST_GeomFromText('POINT(-85.9575 40.7233)',4326), null, null, '2021-03-12T16:51:13.863521Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03327000'),
-('5c797d3a-f2f6-4149-86b3-e87b3b20618e', False, 'marion', 'Marion', null, ST_GeomFromText('POINT(-85.6595 40.5764)',4326), null, null, '2021-03-12T16:51:13.863666Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03326500'),
-('992677cd-af48-4ee9-82df-e05f17c8724d', False, 'mississinewa-pool', 'Mississinewa-Pool', null, ST_GeomFromText('POINT(-85.9572 40.7144)',4326), null, null, '2021-03-12T16:51:13.863742Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03326950'),
-('c844e536-5a97-4fa9-9e2c-d6502219c115', False, 'bluffton', 'Bluffton', null, ST_GeomFromText('POINT(-85.1714 40.7424)',4326), null, null, '2021-03-12T16:51:13.863815Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04099510'),
-('653e2c8f-eac0-4d0a-882c-abe28355962e', False, 'jerome', 'Jerome', null, ST_GeomFromText('POINT(-85.9188 40.4413)',4326), null, null, '2021-03-12T16:51:13.863916Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03333450'),
-('e9e90579-80a4-4d53-ac11-689a920b887a', False, 'kokomo', 'Kokomo', null, ST_GeomFromText('POINT(-86.1529 40.4709)',4326), null, null, '2021-03-12T16:51:13.863986Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03333700'),
-('b5564b22-e521-49b7-b398-2bf9bc1c5398', False, 'owasco', 'Owasco', null, ST_GeomFromText('POINT(-86.6366 40.4648)',4326), null, null, '2021-03-12T16:51:13.864056Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03334000'),
-('c090102e-82b3-4a01-9bc0-ce73395d3226', False, 'deer-creek', 'Deer Creek', null, ST_GeomFromText('POINT(-86.6214 40.5903)',4326), null, null, '2021-03-12T16:51:13.864130Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03329700'),
-('b1c0bd15-00a4-4dc4-9445-ef9aed4068f0', False, 'salamonie-tailwater', 'Salamonie-Tailwater', null, ST_GeomFromText('POINT(-85.6772 40.8072)',4326), null, null, '2021-03-12T16:51:13.864203Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03324500'),
-('622ba7b6-0bd2-4c26-9275-17b6462c525e', False, 'warren_efc6', 'Warren', null, ST_GeomFromText('POINT(-85.4536 40.7125)',4326), null, null, '2021-03-12T16:51:13.864398Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03324300'),
-('b48f1167-ef1a-469e-89d4-143263120130', False, 'j-edward-roush-pool', 'J Edward Roush-Pool', null, ST_GeomFromText('POINT(-85.4686 40.8461)',4326), null, null, '2021-03-12T16:51:13.864581Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03323450'),
-('b89d5ce3-7d71-4958-8809-2f7e701a5d99', False, 'j-edward-roush-tailwater', 'J Edward Roush-Tailwater', null, ST_GeomFromText('POINT(-85.4898 40.8533)',4326), null, null, '2021-03-12T16:51:13.864701Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03323500'),
-('28b4878c-3e78-4898-84d2-c0d9a47d6a54', False, 'wabash', 'Wabash', null, ST_GeomFromText('POINT(-85.8203 40.7908)',4326), null, null, '2021-03-12T16:51:13.864871Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03325000'),
-('913eb762-d4be-491f-9fde-cbd054b1dfc0', False, 'lafayettewildcat', 'LafayetteWildcat', null, ST_GeomFromText('POINT(-86.8292 40.4406)',4326), null, null, '2021-03-12T16:51:13.865023Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03335000'),
-('c8877ff5-6a74-479a-8b8b-f2bc0a103a47', False, 'delphi', 'Delphi', null, ST_GeomFromText('POINT(-86.7703 40.5939)',4326), null, null, '2021-03-12T16:51:13.865104Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03333050'),
-('11258ce5-b0f0-475f-b06e-f3f056d7e7b8', False, 'oswego', 'Oswego', null, ST_GeomFromText('POINT(-85.7892 41.3206)',4326), null, null, '2021-03-12T16:51:13.865208Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03330500'),
-('0464c8ba-0e8c-4621-9d43-c91e90b011e9', False, 'ora', 'Ora', null, ST_GeomFromText('POINT(-86.5636 41.1572)',4326), null, null, '2021-03-12T16:51:13.865281Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03331500'),
-('ebcdfa47-b1b6-4f49-87f4-85ac03221578', False, 'north-manchester', 'North Manchester', null, ST_GeomFromText('POINT(-85.7825 40.9944)',4326), null, null, '2021-03-12T16:51:13.865385Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03328000'),
-('8c23bf4f-9ac0-4022-bdc2-a730229b87a9', False, 'north-webster', 'North Webster', null, ST_GeomFromText('POINT(-85.6922 41.3164)',4326), null, null, '2021-03-12T16:51:13.865458Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03330241'),
-('7e8a59bd-7e19-45f7-8e75-b336a3bccad2', False, 'newlondon', 'NewLondon', null, ST_GeomFromText('POINT(-88.7403 44.3922)',4326), null, null, '2021-03-12T16:51:13.865531Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04079000'),
-('28927945-3f8f-4dbe-8055-c92baa9df5a7', False, 'oshkosh', 'Oshkosh', null, ST_GeomFromText('POINT(-88.5272 44.0097)',4326), null, null, '2021-03-12T16:51:13.865659Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04082500'),
-('6c106d17-d0c1-4a10-a5ef-59f20dbbfe2c', False, 'royalton', 'Royalton', null, ST_GeomFromText('POINT(-88.8653 44.4125)',4326), null, null, '2021-03-12T16:51:13.865791Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04080000'),
-('a3de6a31-67af-43e7-9ac2-d6042e6823c9', False, 'stockbridge', 'Stockbridge', null, ST_GeomFromText('POINT(-88.8653 44.4125)',4326), null, null, '2021-03-12T16:51:13.865964Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04084255'),
-('3e8f793f-9e7b-482d-b08d-b2354e727644', False, 'waupaca', 'Waupaca', null, ST_GeomFromText('POINT(-88.9961 44.3292)',4326), null, null, '2021-03-12T16:51:13.866105Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04081000'),
-('186ea37e-004c-40fd-8527-b5a288dc5764', False, 'fritsepark', 'FritsePark', null, ST_GeomFromText('POINT(-88.4703 44.205)',4326), null, null, '2021-03-12T16:51:13.866236Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, null),
-('1eab7035-942e-4e0c-87c5-b27e2e353a35', False, 'poygan', 'Poygan', null, ST_GeomFromText('POINT(-88.7125 44.1108)',4326), null, null, '2021-03-12T16:51:13.866363Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, null),
-('a4e8f09d-08b8-4fa7-8ccc-30757e0962f4', False, 'salamonie-pool', 'Salamonie-Pool', null, ST_GeomFromText('POINT(-85.6772 40.8072)',4326), null, null, '2021-03-12T16:51:13.866552Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03324450'),
-('4a7d6948-c69d-4401-8413-8576229a2fa4', False, 'peru', 'Peru', null, ST_GeomFromText('POINT(-86.0667 40.75)',4326), null, null, '2021-03-12T16:51:13.866625Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03327500'),
-('4347b406-e66e-4bd1-92d8-b322ab2e9254', False, 'logansport', 'Logansport', null, ST_GeomFromText('POINT(-86.3775 40.7464)',4326), null, null, '2021-03-12T16:51:13.866755Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03329000'),
-('f79a66b0-6584-4d2b-8340-e014b0fa66e0', False, 'lafayette', 'Lafayette', null, ST_GeomFromText('POINT(-86.8969 40.4219)',4326), null, null, '2021-03-12T16:51:13.866865Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03335500'),
-('cf8614a1-726f-4cf3-a576-8c4a8cf65cfb', False, 'littleriver', 'LittleRiver', null, ST_GeomFromText('POINT(-85.4132 40.8986)',4326), null, null, '2021-03-12T16:51:13.866954Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03324000'),
-('ce302849-2d7d-499c-a862-6b077c42a0ee', False, 'covington', 'Covington', null, ST_GeomFromText('POINT(-87.4067 40.14)',4326), null, null, '2021-03-12T16:51:13.867056Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03336000'),
-('45948095-3b78-4123-91f8-b86e95f28c42', False, 'montezuma', 'Montezuma', null, ST_GeomFromText('POINT(-87.3739 39.7925)',4326), null, null, '2021-03-12T16:51:13.867162Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03340500');
+('6fbeb371-98db-4b70-93bb-831b14f72c7b', False, 'portland_f32d', 'Portland', ST_GeomFromText('POINT(-85.039 40.4277)',4326), null, null, '2021-03-12T16:51:13.861956Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03324200'),
+('1a47c87e-50d1-48ee-bb78-c246580263cb', False, 'linn-grove', 'Linn Grove', ST_GeomFromText('POINT(-85.0309 40.6439)',4326), null, null, '2021-03-12T16:51:13.862190Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03322900'),
+('4deb6eee-81ed-4edd-ac38-0b081eb1a081', False, 'berlin', 'Berlin', ST_GeomFromText('POINT(-88.95 43.9539)',4326), null, null, '2021-03-12T16:51:13.862339Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04073500'),
+('b3f286b8-60a3-43e0-9d8a-950f342388a4', False, 'obrien', 'Obrien', ST_GeomFromText('POINT(-87.5611 41.65)',4326), null, null, '2021-03-12T16:51:13.862479Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, null),
+('3e29ecea-8f9e-439f-ad99-aaff1b7e1cbc', False, 'fonddulac', 'FondDuLac', ST_GeomFromText('POINT(-88.4561 43.8)',4326), null, null, '2021-03-12T16:51:13.862806Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, null),
+('005e3595-446b-40e1-b07c-604d2a808acc', False, 'menasha', 'Menasha', ST_GeomFromText('POINT(-88.4472 44.1994)',4326), null, null, '2021-03-12T16:51:13.863032Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, null),
+('2155fea5-633e-4556-8c57-c49c8cf34cc1', False, 'lockport', 'Lockport', ST_GeomFromText('POINT(-88.0789 41.5697)',4326), null, null, '2021-03-12T16:51:13.863208Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, null),
+('a597b201-1aa7-4965-8ff2-a1a26fef4763', False, 'mississinewa-tailwater', 'Mississinewa-Tailwater', ST_GeomFromText('POINT(-85.9575 40.7233)',4326), null, null, '2021-03-12T16:51:13.863521Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03327000'),
+('5c797d3a-f2f6-4149-86b3-e87b3b20618e', False, 'marion', 'Marion', ST_GeomFromText('POINT(-85.6595 40.5764)',4326), null, null, '2021-03-12T16:51:13.863666Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03326500'),
+('992677cd-af48-4ee9-82df-e05f17c8724d', False, 'mississinewa-pool', 'Mississinewa-Pool', ST_GeomFromText('POINT(-85.9572 40.7144)',4326), null, null, '2021-03-12T16:51:13.863742Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03326950'),
+('c844e536-5a97-4fa9-9e2c-d6502219c115', False, 'bluffton', 'Bluffton', ST_GeomFromText('POINT(-85.1714 40.7424)',4326), null, null, '2021-03-12T16:51:13.863815Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04099510'),
+('653e2c8f-eac0-4d0a-882c-abe28355962e', False, 'jerome', 'Jerome', ST_GeomFromText('POINT(-85.9188 40.4413)',4326), null, null, '2021-03-12T16:51:13.863916Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03333450'),
+('e9e90579-80a4-4d53-ac11-689a920b887a', False, 'kokomo', 'Kokomo', ST_GeomFromText('POINT(-86.1529 40.4709)',4326), null, null, '2021-03-12T16:51:13.863986Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03333700'),
+('b5564b22-e521-49b7-b398-2bf9bc1c5398', False, 'owasco', 'Owasco', ST_GeomFromText('POINT(-86.6366 40.4648)',4326), null, null, '2021-03-12T16:51:13.864056Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03334000'),
+('c090102e-82b3-4a01-9bc0-ce73395d3226', False, 'deer-creek', 'Deer Creek', ST_GeomFromText('POINT(-86.6214 40.5903)',4326), null, null, '2021-03-12T16:51:13.864130Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03329700'),
+('b1c0bd15-00a4-4dc4-9445-ef9aed4068f0', False, 'salamonie-tailwater', 'Salamonie-Tailwater', ST_GeomFromText('POINT(-85.6772 40.8072)',4326), null, null, '2021-03-12T16:51:13.864203Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03324500'),
+('622ba7b6-0bd2-4c26-9275-17b6462c525e', False, 'warren_efc6', 'Warren', ST_GeomFromText('POINT(-85.4536 40.7125)',4326), null, null, '2021-03-12T16:51:13.864398Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03324300'),
+('b48f1167-ef1a-469e-89d4-143263120130', False, 'j-edward-roush-pool', 'J Edward Roush-Pool', ST_GeomFromText('POINT(-85.4686 40.8461)',4326), null, null, '2021-03-12T16:51:13.864581Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03323450'),
+('b89d5ce3-7d71-4958-8809-2f7e701a5d99', False, 'j-edward-roush-tailwater', 'J Edward Roush-Tailwater', ST_GeomFromText('POINT(-85.4898 40.8533)',4326), null, null, '2021-03-12T16:51:13.864701Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03323500'),
+('28b4878c-3e78-4898-84d2-c0d9a47d6a54', False, 'wabash', 'Wabash', ST_GeomFromText('POINT(-85.8203 40.7908)',4326), null, null, '2021-03-12T16:51:13.864871Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03325000'),
+('913eb762-d4be-491f-9fde-cbd054b1dfc0', False, 'lafayettewildcat', 'LafayetteWildcat', ST_GeomFromText('POINT(-86.8292 40.4406)',4326), null, null, '2021-03-12T16:51:13.865023Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03335000'),
+('c8877ff5-6a74-479a-8b8b-f2bc0a103a47', False, 'delphi', 'Delphi', ST_GeomFromText('POINT(-86.7703 40.5939)',4326), null, null, '2021-03-12T16:51:13.865104Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03333050'),
+('11258ce5-b0f0-475f-b06e-f3f056d7e7b8', False, 'oswego', 'Oswego', ST_GeomFromText('POINT(-85.7892 41.3206)',4326), null, null, '2021-03-12T16:51:13.865208Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03330500'),
+('0464c8ba-0e8c-4621-9d43-c91e90b011e9', False, 'ora', 'Ora', ST_GeomFromText('POINT(-86.5636 41.1572)',4326), null, null, '2021-03-12T16:51:13.865281Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03331500'),
+('ebcdfa47-b1b6-4f49-87f4-85ac03221578', False, 'north-manchester', 'North Manchester', ST_GeomFromText('POINT(-85.7825 40.9944)',4326), null, null, '2021-03-12T16:51:13.865385Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03328000'),
+('8c23bf4f-9ac0-4022-bdc2-a730229b87a9', False, 'north-webster', 'North Webster', ST_GeomFromText('POINT(-85.6922 41.3164)',4326), null, null, '2021-03-12T16:51:13.865458Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03330241'),
+('7e8a59bd-7e19-45f7-8e75-b336a3bccad2', False, 'newlondon', 'NewLondon', ST_GeomFromText('POINT(-88.7403 44.3922)',4326), null, null, '2021-03-12T16:51:13.865531Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04079000'),
+('28927945-3f8f-4dbe-8055-c92baa9df5a7', False, 'oshkosh', 'Oshkosh', ST_GeomFromText('POINT(-88.5272 44.0097)',4326), null, null, '2021-03-12T16:51:13.865659Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04082500'),
+('6c106d17-d0c1-4a10-a5ef-59f20dbbfe2c', False, 'royalton', 'Royalton', ST_GeomFromText('POINT(-88.8653 44.4125)',4326), null, null, '2021-03-12T16:51:13.865791Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04080000'),
+('a3de6a31-67af-43e7-9ac2-d6042e6823c9', False, 'stockbridge', 'Stockbridge', ST_GeomFromText('POINT(-88.8653 44.4125)',4326), null, null, '2021-03-12T16:51:13.865964Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04084255'),
+('3e8f793f-9e7b-482d-b08d-b2354e727644', False, 'waupaca', 'Waupaca', ST_GeomFromText('POINT(-88.9961 44.3292)',4326), null, null, '2021-03-12T16:51:13.866105Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '04081000'),
+('186ea37e-004c-40fd-8527-b5a288dc5764', False, 'fritsepark', 'FritsePark', ST_GeomFromText('POINT(-88.4703 44.205)',4326), null, null, '2021-03-12T16:51:13.866236Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, null),
+('1eab7035-942e-4e0c-87c5-b27e2e353a35', False, 'poygan', 'Poygan', ST_GeomFromText('POINT(-88.7125 44.1108)',4326), null, null, '2021-03-12T16:51:13.866363Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, null),
+('a4e8f09d-08b8-4fa7-8ccc-30757e0962f4', False, 'salamonie-pool', 'Salamonie-Pool', ST_GeomFromText('POINT(-85.6772 40.8072)',4326), null, null, '2021-03-12T16:51:13.866552Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03324450'),
+('4a7d6948-c69d-4401-8413-8576229a2fa4', False, 'peru', 'Peru', ST_GeomFromText('POINT(-86.0667 40.75)',4326), null, null, '2021-03-12T16:51:13.866625Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03327500'),
+('4347b406-e66e-4bd1-92d8-b322ab2e9254', False, 'logansport', 'Logansport', ST_GeomFromText('POINT(-86.3775 40.7464)',4326), null, null, '2021-03-12T16:51:13.866755Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03329000'),
+('f79a66b0-6584-4d2b-8340-e014b0fa66e0', False, 'lafayette', 'Lafayette', ST_GeomFromText('POINT(-86.8969 40.4219)',4326), null, null, '2021-03-12T16:51:13.866865Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03335500'),
+('cf8614a1-726f-4cf3-a576-8c4a8cf65cfb', False, 'littleriver', 'LittleRiver', ST_GeomFromText('POINT(-85.4132 40.8986)',4326), null, null, '2021-03-12T16:51:13.866954Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03324000'),
+('ce302849-2d7d-499c-a862-6b077c42a0ee', False, 'covington', 'Covington', ST_GeomFromText('POINT(-87.4067 40.14)',4326), null, null, '2021-03-12T16:51:13.867056Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03336000'),
+('45948095-3b78-4123-91f8-b86e95f28c42', False, 'montezuma', 'Montezuma', ST_GeomFromText('POINT(-87.3739 39.7925)',4326), null, null, '2021-03-12T16:51:13.867162Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '1fa28968-d0b1-4832-b4b3-e58206056819', '00000000-0000-0000-0000-000000000000', null, '03340500');
 
 --INSERT INSTRUMENT STATUS--
 INSERT INTO instrument_status(id, instrument_id, status_id, "time")
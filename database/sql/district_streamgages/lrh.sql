@@ -85,290 +85,290 @@ where i.project_id = 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3';
 --Ignoring GeorgesFk platform sensor site/instrument, already in instruments unique list
 
 --INSERT INSTRUMENTS--COUNT:282
-INSERT INTO instrument(id, deleted, slug, name, formula, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
+INSERT INTO instrument(id, deleted, slug, name, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
  VALUES 
-('c79f8d3c-a7a3-4340-9482-6040fd92f954', False, 'adamsville', 'Adamsville', null, ST_GeomFromText('POINT(-82.3558 38.8736)',4326), null, null, '2021-03-12T15:43:53.447289Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03202000'),
-('3d2997f1-60d7-4a44-809f-1e93e8eb4793', False, 'alderson', 'Alderson', null, ST_GeomFromText('POINT(-80.6413 37.7243)',4326), null, null, '2021-03-12T15:43:53.447543Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03183500'),
-('56803381-ac0c-452e-bf8d-b6e654d6a31b', False, 'grayson-lake', 'Grayson-Lake', null, ST_GeomFromText('POINT(-82.9861 38.2525)',4326), null, null, '2021-03-12T15:43:53.447825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03216300'),
-('42149c00-07d9-4735-ab27-69e3c87de8e9', False, 'grayson-outflow', 'Grayson-Outflow', null, ST_GeomFromText('POINT(-82.9911 38.2539)',4326), null, null, '2021-03-12T15:43:53.447980Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03216350'),
-('0dc47447-a0d6-4af6-b55b-de224741cf42', False, 'willard', 'Willard', null, ST_GeomFromText('POINT(-82.9048 38.214)',4326), null, null, '2021-03-12T15:43:53.448171Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '381100082533601'),
-('261d95af-084a-4a70-87b1-261e66400eaa', False, 'cairowv', 'CairoWV', null, ST_GeomFromText('POINT(-81.0998 39.219)',4326), null, null, '2021-03-12T15:43:53.448324Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03155405'),
-('d140d7bd-404e-4741-b3c6-9b6cf1c1ac1e', False, 'macfarlan', 'Macfarlan', null, ST_GeomFromText('POINT(-81.2124 39.0789)',4326), null, null, '2021-03-12T15:43:53.448424Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03155220'),
-('7a524df2-c925-4e80-a414-55854a0f1eff', False, 'wildcat', 'Wildcat', null, ST_GeomFromText('POINT(-80.5256 38.7433)',4326), null, null, '2021-03-12T15:43:53.448511Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03151400'),
-('001f4621-20de-4f08-af39-753437a1bc38', False, 'rocksdale', 'Rocksdale', null, ST_GeomFromText('POINT(-81.2213 38.8438)',4326), null, null, '2021-03-12T15:43:53.448686Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03154000'),
-('2aa1ac16-6f27-4104-a9f2-fbb4f9541589', False, 'elkhorncity', 'ElkhornCity', null, ST_GeomFromText('POINT(-82.3595 37.3129)',4326), null, null, '2021-03-12T15:43:53.448835Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03209300'),
-('60fe2568-01cb-40f8-9207-88bd6a106cf5', False, 'davenport', 'Davenport', null, ST_GeomFromText('POINT(-82.1369 37.0996)',4326), null, null, '2021-03-12T15:43:53.449015Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('f1d94564-240d-4337-8786-3ad9ef9bf4ea', False, 'bane', 'Bane', null, ST_GeomFromText('POINT(-80.7097 37.2681)',4326), null, null, '2021-03-12T15:43:53.449199Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03173000'),
-('7c82c379-5e80-41dd-a1a6-f178cfd7931d', False, 'beachcity-outflow', 'BeachCity-Outflow', null, ST_GeomFromText('POINT(-81.554 40.6356)',4326), null, null, '2021-03-12T15:43:53.449355Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03124000'),
-('3f14ff05-f4f3-4595-8897-a278b46b3754', False, 'beckley', 'Beckley', null, ST_GeomFromText('POINT(-81.2127 37.7971)',4326), null, null, '2021-03-12T15:43:53.449483Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('e9524f9f-1a3d-4e14-bac7-c5dd1ec93700', False, 'bartlick', 'Bartlick', null, ST_GeomFromText('POINT(-82.3242 37.2453)',4326), null, null, '2021-03-12T15:43:53.449624Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03209200'),
-('f571ca0a-a2c0-421d-878b-e1e49fe9c87d', False, 'bastian', 'Bastian', null, ST_GeomFromText('POINT(-81.3783 37.1525)',4326), null, null, '2021-03-12T15:43:53.449783Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '371030081181000'),
-('d3e11262-0c3d-48ce-af22-7b0a2dea9960', False, 'beechfk-lake', 'BeechFk-Lake', null, ST_GeomFromText('POINT(-82.4164 38.3039)',4326), null, null, '2021-03-12T15:43:53.449888Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('0e0117b4-9208-43ab-94c7-a8779d711d4e', False, 'beechfk-outflow', 'BeechFk-Outflow', null, ST_GeomFromText('POINT(-82.4244 38.305)',4326), null, null, '2021-03-12T15:43:53.450049Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03207057'),
-('eb4b501d-35a3-449e-b52c-2395beec7118', False, 'bellepoint', 'Bellepoint', null, ST_GeomFromText('POINT(-83.1738 40.2483)',4326), null, null, '2021-03-12T15:43:53.450284Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03220000'),
-('f0a157f3-9d31-4cc1-b7d2-62e9dfb7f195', False, 'kermit', 'Kermit', null, ST_GeomFromText('POINT(-82.4088 37.8372)',4326), null, null, '2021-03-12T15:43:53.450469Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03214500'),
-('399cd1e8-cf40-48eb-b249-693e7bf87961', False, 'meta', 'Meta', null, ST_GeomFromText('POINT(-82.4581 37.5669)',4326), null, null, '2021-03-12T15:43:53.450618Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03210000'),
-('a78ead49-4274-4d12-b37b-dcf8e2441d81', False, 'nofkpound-lake', 'NoFkPound-Lake', null, ST_GeomFromText('POINT(-82.6306 37.1239)',4326), null, null, '2021-03-12T15:43:53.450794Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208680'),
-('af432d3c-dd6c-4693-88a7-0beecc19e8b0', False, 'poundupr', 'PoundUpr', null, ST_GeomFromText('POINT(-82.6078 37.1239)',4326), null, null, '2021-03-12T15:43:53.450946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208850'),
-('3641ab0f-27f4-43cf-933d-092aeb5ceb69', False, 'welch', 'Welch', null, ST_GeomFromText('POINT(-81.5833 37.4411)',4326), null, null, '2021-03-12T15:43:53.451143Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03212750'),
-('be42d7f3-2e28-4e49-82f2-d6bab5019995', False, 'williamson', 'Williamson', null, ST_GeomFromText('POINT(-82.2803 37.673)',4326), null, null, '2021-03-12T15:43:53.451316Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03212700'),
-('0db03cbb-ad59-4cf2-80de-fb4f477f3bdc', False, 'yatesville-outflow', 'Yatesville-Outflow', null, ST_GeomFromText('POINT(-82.6972 38.128)',4326), null, null, '2021-03-12T15:43:53.451496Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03215500'),
-('9b6646b4-ebe1-4ee4-8bc4-bceab9d9986e', False, 'lockwood', 'Lockwood', null, ST_GeomFromText('POINT(-81.0233 38.2625)',4326), null, null, '2021-03-12T15:43:53.451711Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03191500'),
-('df190d76-2a02-4744-84b6-878fed5450f4', False, 'marmetld', 'MarmetLD', null, ST_GeomFromText('POINT(-81.5668 38.2537)',4326), null, null, '2021-03-12T15:43:53.451810Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('e3309be4-720d-4591-b8f2-5530abd245e8', False, 'marmetld-tailwater', 'MarmetLD-Tailwater', null, ST_GeomFromText('POINT(-81.5694 38.2561)',4326), null, null, '2021-03-12T15:43:53.451810Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('0b9e5da0-b49e-4e5b-9805-80e4834b965b', False, 'marmetld-pool', 'MarmetLD-Pool', null, ST_GeomFromText('POINT(-81.5663 38.2509)',4326), null, null, '2021-03-12T15:43:53.451810Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('80f6b0bc-1a3e-44d4-b651-d86a7ab87137', False, 'redoakknob', 'RedOakKnob', null, ST_GeomFromText('POINT(-80.4405 38.3533)',4326), null, null, '2021-03-12T15:43:53.452067Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('bfc38643-0317-4d2e-a7c2-14666deddd6b', False, 'redsulphurspr', 'RedSulphurSpr', null, ST_GeomFromText('POINT(-80.7702 37.5292)',4326), null, null, '2021-03-12T15:43:53.452212Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03177480'),
-('b28b27a3-f0d3-4dad-bbdf-a3903003424b', False, 'rockcamp', 'RockCamp', null, ST_GeomFromText('POINT(-80.595 37.4781)',4326), null, null, '2021-03-12T15:43:53.452343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('b9ab2a38-73d6-4d31-aaf0-9f5d70771ac8', False, 'sharpsknob', 'SharpsKnob', null, ST_GeomFromText('POINT(-80.1647 38.4275)',4326), null, null, '2021-03-12T15:43:53.452479Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('b37f6852-767f-401d-a00a-4bca14867e34', False, 'summersville-lake', 'Summersville-Lake', null, ST_GeomFromText('POINT(-80.8903 38.2226)',4326), null, null, '2021-03-12T15:43:53.452624Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('c3e92c04-783c-4cc2-bf64-4d72c7478aa0', False, 'sutton-lake', 'Sutton-Lake', null, ST_GeomFromText('POINT(-80.6931 38.6619)',4326), null, null, '2021-03-12T15:43:53.452767Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('6d294e69-50e3-4ab1-97b9-fa7c3e60fa00', False, 'sutton-sump', 'Sutton-Sump', null, ST_GeomFromText('POINT(-80.6931 38.6623)',4326), null, null, '2021-03-12T15:43:53.452767Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('cf6f090f-29aa-43e3-bdb7-76ddb00caeaa', False, 'leon', 'Leon', null, ST_GeomFromText('POINT(-82.9775 38.2864)',4326), null, null, '2021-03-12T15:43:53.452979Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03216300'),
-('ed8bb870-5ec1-4f80-a7c5-a2700aeabbe3', False, 'dunlow', 'Dunlow', null, ST_GeomFromText('POINT(-82.2964 38.0175)',4326), null, null, '2021-03-12T15:43:53.453152Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03206600'),
-('5681271a-ac0b-48f9-ad9e-2fe1dd777082', False, 'hebron', 'Hebron', null, ST_GeomFromText('POINT(-82.4753 39.9888)',4326), null, null, '2021-03-12T15:43:53.453305Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03145000'),
-('28bf6b1e-1f1e-4df4-a912-372d9b1b33c3', False, 'elizabeth', 'Elizabeth', null, ST_GeomFromText('POINT(-81.3894 39.0592)',4326), null, null, '2021-03-12T15:43:53.453479Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03155000'),
-('971c4dd8-ba6d-42aa-9d27-c46ba70dd88f', False, 'glenville', 'Glenville', null, ST_GeomFromText('POINT(-80.8391 38.9338)',4326), null, null, '2021-03-12T15:43:53.453687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03152000'),
-('56e38c38-243e-4252-8d4b-4ffed288606e', False, 'burnsville-lake', 'Burnsville-Lake', null, ST_GeomFromText('POINT(-80.6203 38.8428)',4326), null, null, '2021-03-12T15:43:53.453861Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('b1c64a88-023a-48e8-a830-ba2049c3dada', False, 'burnsville-sump', 'Burnsville-Sump', null, ST_GeomFromText('POINT(-80.62 38.8433)',4326), null, null, '2021-03-12T15:43:53.453861Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('ca428eda-4d78-4d2d-99df-9ad56031d924', False, 'burnsvillewv', 'BurnsvilleWV', null, ST_GeomFromText('POINT(-80.6733 38.8619)',4326), null, null, '2021-03-12T15:43:53.454102Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03151600'),
-('a5ed4534-16d1-49d0-a896-84f66185f66d', False, 'burnsville-outflow', 'Burnsville-Outflow', null, ST_GeomFromText('POINT(-80.6281 38.845)',4326), null, null, '2021-03-12T15:43:53.454277Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03151520'),
-('bc1283bd-4ac0-44ed-ad5d-cb94e6a1a356', False, 'grantsville', 'Grantsville', null, ST_GeomFromText('POINT(-81.0977 38.9219)',4326), null, null, '2021-03-12T15:43:53.454484Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03153500'),
-('225e5a2d-9213-47e7-8462-328ff5d9cb2f', False, 'fishtrap-outflow', 'Fishtrap-Outflow', null, ST_GeomFromText('POINT(-82.4164 37.43)',4326), null, null, '2021-03-12T15:43:53.454665Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208000'),
-('e54a87e7-00e9-41fa-a353-9415abbc17f1', False, 'mtvernon', 'MtVernon', null, ST_GeomFromText('POINT(-82.4998 40.4058)',4326), null, null, '2021-03-12T15:43:53.454842Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03136500'),
-('2c1b59fa-f836-4322-913f-8ff1a7761162', False, 'newcomerstown', 'Newcomerstown', null, ST_GeomFromText('POINT(-81.6088 40.2616)',4326), null, null, '2021-03-12T15:43:53.455065Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03129000'),
-('8e882fb4-7fec-437b-9974-c892945b598b', False, 'alexandria', 'Alexandria', null, ST_GeomFromText('POINT(-82.6106 40.0869)',4326), null, null, '2021-03-12T15:43:53.455249Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('5c2f454f-b6b1-45db-8185-d77277c2b9f1', False, 'tornado', 'Tornado', null, ST_GeomFromText('POINT(-81.8414 38.3392)',4326), null, null, '2021-03-12T15:43:53.455398Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03200500'),
-('fc99bc44-830a-4821-b9dd-8ccaa071e353', False, 'bigrock', 'BigRock', null, ST_GeomFromText('POINT(-82.1958 37.3536)',4326), null, null, '2021-03-12T15:43:53.455644Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03207800'),
-('f5aac11b-13e8-4947-b1fc-189ad1db7f2f', False, 'allisonia', 'Allisonia', null, ST_GeomFromText('POINT(-80.7458 36.9375)',4326), null, null, '2021-03-12T15:43:53.455765Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03168000'),
-('5241d2a6-1080-463e-b09c-f7388763da8f', False, 'higby', 'Higby', null, ST_GeomFromText('POINT(-82.8641 39.2125)',4326), null, null, '2021-03-12T15:43:53.455902Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03234500'),
-('628951b7-69fe-4ff9-b662-70b1c96db120', False, 'darbyville', 'Darbyville', null, ST_GeomFromText('POINT(-83.1108 39.7005)',4326), null, null, '2021-03-12T15:43:53.456075Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03230500'),
-('c023937e-070c-4074-8ac4-0583e4f53bd4', False, 'ashford', 'Ashford', null, ST_GeomFromText('POINT(-81.7117 38.1797)',4326), null, null, '2021-03-12T15:43:53.456247Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03198500'),
-('3012afd5-aa0d-4d4f-988a-0a73b85927d1', False, 'fishtrap-lake', 'Fishtrap-Lake', null, ST_GeomFromText('POINT(-82.4156 37.4325)',4326), null, null, '2021-03-12T15:43:53.456342Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03207995'),
-('6a37bc9c-ad44-49cf-9ac0-8d785aacf157', False, 'baileysville', 'Baileysville', null, ST_GeomFromText('POINT(-81.6453 37.6039)',4326), null, null, '2021-03-12T15:43:53.456506Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03202400'),
-('6bd51339-f0dc-4e23-ac22-523d8cd13cef', False, 'branchland', 'Branchland', null, ST_GeomFromText('POINT(-82.2022 38.2217)',4326), null, null, '2021-03-12T15:43:53.456634Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03204000'),
-('e2fce378-7927-4467-a051-d326c184642c', False, 'clearfk', 'ClearFk', null, ST_GeomFromText('POINT(-81.7075 37.6231)',4326), null, null, '2021-03-12T15:43:53.456774Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03202750'),
-('9f2e4261-8225-48a8-8df0-27fc9088669c', False, 'nallen', 'Nallen', null, ST_GeomFromText('POINT(-80.876 38.1126)',4326), null, null, '2021-03-12T15:43:53.456952Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03190000'),
-('d28e7e29-8d0e-44de-a14b-374903303799', False, 'narrows', 'Narrows', null, ST_GeomFromText('POINT(-80.85 37.3055)',4326), null, null, '2021-03-12T15:43:53.457074Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03175500'),
-('82b05e4b-9d36-4e43-8910-b31055ac517b', False, 'queenshoals', 'QueenShoals', null, ST_GeomFromText('POINT(-81.2844 38.4722)',4326), null, null, '2021-03-12T15:43:53.457208Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03197000'),
-('cdff6fa8-cf11-41ba-92bc-058ce3d297ec', False, 'radford', 'Radford', null, ST_GeomFromText('POINT(-80.5742 37.1383)',4326), null, null, '2021-03-12T15:43:53.457381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03171000'),
-('e52ccf42-02ae-4cfa-8a40-827f448e29ec', False, 'rainelle', 'Rainelle', null, ST_GeomFromText('POINT(-80.7634 37.9685)',4326), null, null, '2021-03-12T15:43:53.457554Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('1c4c1416-2ab9-4178-bc44-736b3f2907ac', False, 'raleigh', 'Raleigh', null, ST_GeomFromText('POINT(-81.1625 37.7606)',4326), null, null, '2021-03-12T15:43:53.457698Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03185000'),
-('a0bbd1ef-588f-459f-911f-7b948022444e', False, 'jobsknob', 'JobsKnob', null, ST_GeomFromText('POINT(-80.543 38.0786)',4326), null, null, '2021-03-12T15:43:53.457790Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('c321e0fa-72a5-4425-ade3-e5ed7ec01161', False, 'kanawhafalls', 'KanawhaFalls', null, ST_GeomFromText('POINT(-81.2147 38.138)',4326), null, null, '2021-03-12T15:43:53.457944Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03193000'),
-('ae23b824-06f7-4252-bc67-5471a2ee6f88', False, 'lansing', 'Lansing', null, ST_GeomFromText('POINT(-81.5108 36.4981)',4326), null, null, '2021-03-12T15:43:53.458096Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '362954081303800'),
-('764cc6ed-61c7-4249-94fc-4e2e011db1da', False, 'laurelfork', 'LaurelFork', null, ST_GeomFromText('POINT(-80.5269 36.7161)',4326), null, null, '2021-03-12T15:43:53.458219Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '364258080313700'),
-('3ecbfee0-4f43-4b8f-803e-d1eaf24e4cd4', False, 'charlesmill-outflow', 'CharlesMill-Outflow', null, ST_GeomFromText('POINT(-82.3632 40.738)',4326), null, null, '2021-03-12T15:43:53.458345Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03130000'),
-('0394ed68-3a97-497b-84ec-db0117f1bc70', False, 'bolivaraux', 'BolivarAUX', null, ST_GeomFromText('POINT(-81.4489 40.6249)',4326), null, null, '2021-03-12T15:43:53.458492Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('1bb3f9c5-ada9-49e7-b888-3d9c6669df4e', False, 'charlesmill-lake', 'CharlesMill-Lake', null, ST_GeomFromText('POINT(-82.3625 40.7403)',4326), null, null, '2021-03-12T15:43:53.458654Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('559b0a80-bafc-4e9a-81e2-a417c9453092', False, 'summersville-outflow', 'Summersville-Outflow', null, ST_GeomFromText('POINT(-80.8884 38.2151)',4326), null, null, '2021-03-12T15:43:53.458847Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03189600'),
-('f7f616f3-3f4c-40b1-96bc-26fe1a367884', False, 'clendening-lake', 'Clendening-Lake', null, ST_GeomFromText('POINT(-81.2792 40.2694)',4326), null, null, '2021-03-12T15:43:53.459068Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03126500'),
-('773e8254-8912-4715-819a-301a16326307', False, 'dillon-lake', 'Dillon-Lake', null, ST_GeomFromText('POINT(-82.0817 39.9917)',4326), null, null, '2021-03-12T15:43:53.459278Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('cabbad90-1f3d-4d28-8398-d1954dbb4c2e', False, 'dover-outflow', 'Dover-Outflow', null, ST_GeomFromText('POINT(-81.4294 40.5297)',4326), null, null, '2021-03-12T15:43:53.459435Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03122500'),
-('4308f58d-275a-4035-b47c-f8f11bf8c505', False, 'killbuck', 'Killbuck', null, ST_GeomFromText('POINT(-81.9855 40.4816)',4326), null, null, '2021-03-12T15:43:53.459618Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03139000'),
-('5eb590e6-6ebd-4405-ba6a-38a0c32f58d7', False, 'leesville-outflow', 'Leesville-Outflow', null, ST_GeomFromText('POINT(-81.1981 40.4706)',4326), null, null, '2021-03-12T15:43:53.459801Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03120500'),
-('8c35d9ee-4fdc-48df-803b-bbd85ffda3ad', False, 'loudonville', 'Loudonville', null, ST_GeomFromText('POINT(-82.2391 40.6359)',4326), null, null, '2021-03-12T15:43:53.459982Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03131500'),
-('5622a3d4-aa4a-408d-b013-577c89f4e828', False, 'lucas', 'Lucas', null, ST_GeomFromText('POINT(-82.4144 40.7036)',4326), null, null, '2021-03-12T15:43:53.460201Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03131122'),
-('f865f45e-ca7a-481f-9ec4-820241aa6663', False, 'magnolialevee', 'MagnoliaLevee', null, ST_GeomFromText('POINT(-81.2994 40.6511)',4326), null, null, '2021-03-12T15:43:53.460377Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('853aad6c-2d3c-4443-b8a8-82238152101c', False, 'massillon', 'Massillon', null, ST_GeomFromText('POINT(-81.5214 40.7703)',4326), null, null, '2021-03-12T15:43:53.460551Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03117000'),
-('01b4180a-fff5-4be4-a79b-ac2b3393e05d', False, 'mcconnelsville', 'McConnelsville', null, ST_GeomFromText('POINT(-81.8516 39.645)',4326), null, null, '2021-03-12T15:43:53.460696Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03150000'),
-('5e2d6a0b-7d78-4736-8ad0-738fff2b4275', False, 'melco', 'Melco', null, ST_GeomFromText('POINT(-82.3597 40.6986)',4326), null, null, '2021-03-12T15:43:53.460852Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03131200'),
-('0dca368d-8d16-4a85-b4a9-544c5d3612cd', False, 'millport', 'Millport', null, ST_GeomFromText('POINT(-80.898 40.7222)',4326), null, null, '2021-03-12T15:43:53.460999Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('e3f3e9fb-d1de-4730-bdfc-a9eec23679ba', False, 'mohawk-outflow', 'Mohawk-Outflow', null, ST_GeomFromText('POINT(-82.0655 40.3414)',4326), null, null, '2021-03-12T15:43:53.461119Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03138500'),
-('53cec804-9570-43b9-bc74-3ef649f00fbe', False, 'georgesfk', 'GeorgesFk', null, ST_GeomFromText('POINT(-82.525 37.1642)',4326), null, null, '2021-03-12T15:43:53.461285Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208900'),
-('50acbeaf-a316-45de-8fc8-be402f50f770', False, 'pilgrimknob', 'PilgrimKnob', null, ST_GeomFromText('POINT(-81.89 37.28)',4326), null, null, '2021-03-12T15:43:53.461457Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('ffa496d3-bdd4-4b7b-acce-d418c27dd931', False, 'rdbailey-lake', 'RDBailey-Lake', null, ST_GeomFromText('POINT(-81.8189 37.5989)',4326), null, null, '2021-03-12T15:43:53.461602Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03202913'),
-('3af296d4-a2b6-4e9d-b423-aaa55255af42', False, 'rdbailey-outflow', 'RDBailey-Outflow', null, ST_GeomFromText('POINT(-81.827 37.5979)',4326), null, null, '2021-03-12T15:43:53.461729Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03202915'),
-('56040857-a5b3-49cd-8a1e-b056656e028d', False, 'wolfpen', 'WolfPen', null, ST_GeomFromText('POINT(-81.5844 37.5167)',4326), null, null, '2021-03-12T15:43:53.461933Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('e4c00c01-78f8-48f9-b0d9-7b0cec845a24', False, 'noindustry', 'NoIndustry', null, ST_GeomFromText('POINT(-81.3661 40.7405)',4326), null, null, '2021-03-12T15:43:53.462099Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03118500'),
-('e864518e-3271-4c78-b253-f9a87e7a9523', False, 'piedmont-lake', 'Piedmont-Lake', null, ST_GeomFromText('POINT(-81.2175 40.1922)',4326), null, null, '2021-03-12T15:43:53.462248Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03125500'),
-('84fe09ac-c0ba-448c-ae0c-f6309790e109', False, 'piedmont-outflow', 'Piedmont-Outflow', null, ST_GeomFromText('POINT(-81.2156 40.1944)',4326), null, null, '2021-03-12T15:43:53.462391Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03126000'),
-('e7c1093b-980a-4116-9953-3dcca920eda0', False, 'roseville', 'Roseville', null, ST_GeomFromText('POINT(-82.0736 39.8161)',4326), null, null, '2021-03-12T15:43:53.462562Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('8df63b20-8b76-44bc-a5d9-ea95d4ba5d17', False, 'senecaville-lake', 'Senecaville-Lake', null, ST_GeomFromText('POINT(-81.4341 39.9255)',4326), null, null, '2021-03-12T15:43:53.462710Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03141000'),
-('58db3288-96d1-4ea8-b919-c972a31742b5', False, 'tappan-outflow', 'Tappan-Outflow', null, ST_GeomFromText('POINT(-81.2344 40.3603)',4326), null, null, '2021-03-12T15:43:53.462883Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03128500'),
-('e0e13648-5a57-4108-a1f7-c77b390f0bef', False, 'tippecanoe', 'Tippecanoe', null, ST_GeomFromText('POINT(-81.2902 40.2703)',4326), null, null, '2021-03-12T15:43:53.463025Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03127000'),
-('07b645fa-30b3-4a0e-93fb-ac149ae7ae60', False, 'waynesburg', 'Waynesburg', null, ST_GeomFromText('POINT(-81.26 40.6726)',4326), null, null, '2021-03-12T15:43:53.463206Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03117500'),
-('46d2b9e1-d73b-4516-a16a-6a253861d4e6', False, 'eastlynn-lake', 'EastLynn-Lake', null, ST_GeomFromText('POINT(-82.3839 38.1447)',4326), null, null, '2021-03-12T15:43:53.463329Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('36493655-e16f-41ac-b204-b26933231fd6', False, 'eastlynn-outflow', 'EastLynn-Outflow', null, ST_GeomFromText('POINT(-82.3826 38.1476)',4326), null, null, '2021-03-12T15:43:53.463468Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03206790'),
-('dc7c4258-3441-44ea-a201-83890cc6b05a', False, 'wayne', 'Wayne', null, ST_GeomFromText('POINT(-82.4347 38.2486)',4326), null, null, '2021-03-12T15:43:53.463679Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03207020'),
-('93c464a9-0e8d-4adb-bc79-506f133b3643', False, 'prestonsburg', 'Prestonsburg', null, ST_GeomFromText('POINT(-82.7772 37.6708)',4326), null, null, '2021-03-12T15:43:53.463864Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03209800'),
-('ce28e6ee-76fa-4920-843a-cc6aa88202c8', False, 'deercr-lake', 'DeerCr-Lake', null, ST_GeomFromText('POINT(-83.215 39.6222)',4326), null, null, '2021-03-12T15:43:53.464051Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('ad266bbb-b5c1-44b1-a0e0-c6c9669c57b7', False, 'deercr-outflow', 'DeerCr-Outflow', null, ST_GeomFromText('POINT(-83.2123 39.6206)',4326), null, null, '2021-03-12T15:43:53.464204Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03230900'),
-('aa9e7c4b-ce91-4222-af49-60bd7faa987a', False, 'prospect', 'Prospect', null, ST_GeomFromText('POINT(-83.1972 40.4194)',4326), null, null, '2021-03-12T15:43:53.464416Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03219500'),
-('811ac9bf-023a-4bc7-a361-648c100329c6', False, 'racineld', 'RacineLD', null, ST_GeomFromText('POINT(-81.9122 38.9169)',4326), null, null, '2021-03-12T15:43:53.464593Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03159870'),
-('59b61eef-5b30-453a-8d7b-dad9bbbf5aff', False, 'racineld-pool', 'RacineLD-Pool', null, ST_GeomFromText('POINT(-81.9097 38.9148)',4326), null, null, '2021-03-12T15:43:53.464593Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('97b8b79f-2f7a-4ba6-b942-a41fc095ec89', False, 'racineld-tailwater', 'RacineLD-Tailwater', null, ST_GeomFromText('POINT(-81.9108 38.9175)',4326), null, null, '2021-03-12T15:43:53.464593Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('837bbf54-7f8f-4b97-9e4a-e2af92ac5e65', False, 'dresden', 'Dresden', null, ST_GeomFromText('POINT(-81.9992 40.1203)',4326), null, null, '2021-03-12T15:43:53.464811Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03144500'),
-('bf7d0d66-7119-4684-bef5-2f5799e57021', False, 'blaine', 'Blaine', null, ST_GeomFromText('POINT(-82.8461 38.0294)',4326), null, null, '2021-03-12T15:43:53.464955Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03215370'),
-('ce2ebb71-2798-4afb-9ff1-9750474ae748', False, 'bradshaw', 'Bradshaw', null, ST_GeomFromText('POINT(-81.8027 37.3952)',4326), null, null, '2021-03-12T15:43:53.465085Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03212980'),
-('de609c4f-401f-4b6f-8a2c-0b7f40ae17f5', False, 'clintwood', 'Clintwood', null, ST_GeomFromText('POINT(-82.4389 37.1239)',4326), null, null, '2021-03-12T15:43:53.465255Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208950'),
-('8213ac1f-670c-43ef-888b-ed90bb1c09e9', False, 'harts', 'Harts', null, ST_GeomFromText('POINT(-82.14 38.0383)',4326), null, null, '2021-03-12T15:43:53.465433Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('311112a7-9974-4ed0-824d-269c68a4fab2', False, 'hanover', 'Hanover', null, ST_GeomFromText('POINT(-81.8215 37.5852)',4326), null, null, '2021-03-12T15:43:53.465583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03202928'),
-('8287a3a6-e894-44e8-a89f-fa4bbb671711', False, 'logan', 'Logan', null, ST_GeomFromText('POINT(-81.9761 37.8422)',4326), null, null, '2021-03-12T15:43:53.465705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03203600'),
-('926bef8a-2909-44b4-9317-8d743d11fff4', False, 'man', 'Man', null, ST_GeomFromText('POINT(-81.8767 37.7403)',4326), null, null, '2021-03-12T15:43:53.465890Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03203000'),
-('e2dce514-10fb-4f2c-b721-099d1392d007', False, 'palermo', 'Palermo', null, ST_GeomFromText('POINT(-82.0586 38.165)',4326), null, null, '2021-03-12T15:43:53.466048Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03204250'),
-('2a6efe33-e2ae-4538-9bcb-74e155c89699', False, 'mohawk-lake', 'Mohawk-Lake', null, ST_GeomFromText('POINT(-82.0878 40.3525)',4326), null, null, '2021-03-12T15:43:53.466170Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('d18f6158-8f1a-414f-81c1-2b6a8657cf5b', False, 'paintsvilleky', 'PaintsvilleKY', null, ST_GeomFromText('POINT(-82.7906 37.8172)',4326), null, null, '2021-03-12T15:43:53.466316Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03212500'),
-('f76d5f86-00f5-495f-8cca-83f4788c0d8d', False, 'kopperston', 'Kopperston', null, ST_GeomFromText('POINT(-81.575 37.7583)',4326), null, null, '2021-03-12T15:43:53.466497Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('1295f88c-0f08-4c96-ab53-d4da9b11bb57', False, 'virgie', 'Virgie', null, ST_GeomFromText('POINT(-82.5444 37.3864)',4326), null, null, '2021-03-12T15:43:53.466652Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '371954082350601'),
-('5619b90e-ec2a-4f9c-829e-0b467cb1ac08', False, 'walhonding', 'Walhonding', null, ST_GeomFromText('POINT(-81.9069 40.3092)',4326), null, null, '2021-03-12T15:43:53.466824Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03139850'),
-('b7778bca-28e3-4308-b104-f5cc643668ff', False, 'washingtoncourth', 'WashingtonCourtH', null, ST_GeomFromText('POINT(-83.4531 39.5303)',4326), null, null, '2021-03-12T15:43:53.466974Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('ae1920d9-5aec-44d9-afda-5f5a104795d8', False, 'buckeye', 'Buckeye', null, ST_GeomFromText('POINT(-80.1308 38.1858)',4326), null, null, '2021-03-12T15:43:53.467145Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03182500'),
-('c8688c1e-ec68-4dc5-9ac8-8e5ef5a93028', False, 'camdenongauley', 'CamdenOnGauley', null, ST_GeomFromText('POINT(-80.6011 38.3658)',4326), null, null, '2021-03-12T15:43:53.467295Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03187000'),
-('d807f0a9-fb2c-43cb-9724-1b81e0dbf172', False, 'clay', 'Clay', null, ST_GeomFromText('POINT(-81.0875 38.4606)',4326), null, null, '2021-03-12T15:43:53.467387Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03196800'),
-('5c216ac6-9197-4bd9-b78d-23e1d27efd37', False, 'craigsville', 'Craigsville', null, ST_GeomFromText('POINT(-80.6411 38.2911)',4326), null, null, '2021-03-12T15:43:53.467523Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03189100'),
-('a9925ee0-02a0-4af9-bdf2-fe716e46601b', False, 'cranberryr', 'CranberryR', null, ST_GeomFromText('POINT(-80.5267 38.2953)',4326), null, null, '2021-03-12T15:43:53.467732Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03187500'),
-('9cb372ec-b7c8-477f-8ebf-1aee45ee71a3', False, 'ennice', 'Ennice', null, ST_GeomFromText('POINT(-80.9781 36.4997)',4326), null, null, '2021-03-12T15:43:53.467904Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '363000080584000'),
-('37af1a41-df37-439c-b590-1706f136ec57', False, 'fallsmills', 'FallsMills', null, ST_GeomFromText('POINT(-81.305 37.2714)',4326), null, null, '2021-03-12T15:43:53.468021Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03177710'),
-('4d49b17c-5ae0-43d3-a72b-287b7c584214', False, 'frametown', 'Frametown', null, ST_GeomFromText('POINT(-80.8994 38.5663)',4326), null, null, '2021-03-12T15:43:53.468109Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03196600'),
-('66ac52cb-8638-4ea3-a1cd-ea0dd364962b', False, 'galax', 'Galax', null, ST_GeomFromText('POINT(-80.9792 36.6472)',4326), null, null, '2021-03-12T15:43:53.468276Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03164000'),
-('415e191e-b80d-4cbb-90fd-cfef48f02187', False, 'glenlynn', 'GlenLynn', null, ST_GeomFromText('POINT(-80.8608 37.3728)',4326), null, null, '2021-03-12T15:43:53.468457Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03176500'),
-('f4a319bf-b7be-4596-95a1-39e4f490cbdd', False, 'graysontown', 'Graysontown', null, ST_GeomFromText('POINT(-80.5569 37.0375)',4326), null, null, '2021-03-12T15:43:53.468627Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03170000'),
-('3c02eae0-d060-479b-b383-543dc40fa2a2', False, 'guardian', 'Guardian', null, ST_GeomFromText('POINT(-80.4658 38.6353)',4326), null, null, '2021-03-12T15:43:53.468773Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03195100'),
-('6d841e08-cf66-439f-bd56-ddb9e2b0b8ad', False, 'herold', 'Herold', null, ST_GeomFromText('POINT(-80.8011 38.5747)',4326), null, null, '2021-03-12T15:43:53.468945Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03196500'),
-('182ba331-68b7-42d2-be0d-2aa31e511d72', False, 'hilldale', 'Hilldale', null, ST_GeomFromText('POINT(-80.8055 37.64)',4326), null, null, '2021-03-12T15:43:53.469090Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03184000'),
-('83f4d735-6cce-46e0-a0b3-7daf43725966', False, 'hinton', 'Hinton', null, ST_GeomFromText('POINT(-80.8927 37.671)',4326), null, null, '2021-03-12T15:43:53.469234Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03184500'),
-('11be5638-32b0-446c-8a44-4c44b0f00dc4', False, 'webstersprings', 'WebsterSprings', null, ST_GeomFromText('POINT(-80.4889 38.5983)',4326), null, null, '2021-03-12T15:43:53.469413Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03194700'),
-('f4139b77-ca77-4e75-9a88-614950852fa0', False, 'whitesville', 'Whitesville', null, ST_GeomFromText('POINT(-81.5243 37.9662)',4326), null, null, '2021-03-12T15:43:53.469584Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03198350'),
-('055eee13-302e-421c-bf7d-05b280bca6b1', False, 'whsulphursprgs', 'WhSulphurSprgs', null, ST_GeomFromText('POINT(-80.3333 37.7667)',4326), null, null, '2021-03-12T15:43:53.469677Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('9107922b-7346-4a41-b6a1-1b4be6d461c8', False, 'willis', 'Willis', null, ST_GeomFromText('POINT(-80.4819 36.8617)',4326), null, null, '2021-03-12T15:43:53.469813Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '365133080285301'),
-('e2b0a4cc-9e7b-4cbf-b336-8511508ebb35', False, 'willowton', 'Willowton', null, ST_GeomFromText('POINT(-80.8861 37.3485)',4326), null, null, '2021-03-12T15:43:53.469992Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03177120'),
-('4db83962-32b4-49b7-b888-1b48976e3e14', False, 'winfieldld', 'WinfieldLD', null, ST_GeomFromText('POINT(-81.9136 38.5269)',4326), null, null, '2021-03-12T15:43:53.470111Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03201300'),
-('86fdbca8-e7b6-4dac-a5cf-dac93f04275b', False, 'winfieldld-tailwater', 'WinfieldLD-Tailwater', null, ST_GeomFromText('POINT(-81.9169 38.5261)',4326), null, null, '2021-03-12T15:43:53.470111Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('d7acb6ea-d008-4d8a-a33b-f216ff1600e0', False, 'winfieldld-pool', 'WinfieldLD-Pool', null, ST_GeomFromText('POINT(-81.9116 38.5254)',4326), null, null, '2021-03-12T15:43:53.470111Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('62443c3a-e4b0-42a9-a61f-ba0379a06041', False, 'coshocton', 'Coshocton', null, ST_GeomFromText('POINT(-81.8727 40.248)',4326), null, null, '2021-03-12T15:43:53.470431Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03140500'),
-('6b093d52-3f06-48b6-8174-617c3aac0fbe', False, 'atwood-lake', 'Atwood-Lake', null, ST_GeomFromText('POINT(-81.285 40.5267)',4326), null, null, '2021-03-12T15:43:53.470577Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03121000'),
-('471582a8-cff0-4073-a4e4-fba5191696aa', False, 'centerfield', 'Centerfield', null, ST_GeomFromText('POINT(-83.4752 39.329)',4326), null, null, '2021-03-12T15:43:53.470785Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03232300'),
-('a6bfa75f-9b78-4647-bdd5-da53c5b45d99', False, 'centralcollege', 'CentralCollege', null, ST_GeomFromText('POINT(-82.8836 40.1027)',4326), null, null, '2021-03-12T15:43:53.470971Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03228500'),
-('33e25e11-462c-448f-be03-f5475b536b9f', False, 'chillicothe', 'Chillicothe', null, ST_GeomFromText('POINT(-82.9711 39.3413)',4326), null, null, '2021-03-12T15:43:53.471183Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03231500'),
-('26fe9773-323c-4d1c-9f98-440c5c8dcab4', False, 'circleville', 'Circleville', null, ST_GeomFromText('POINT(-82.9553 39.6014)',4326), null, null, '2021-03-12T15:43:53.471381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03230700'),
-('9dabc608-8488-483b-a8ed-7fd94107a653', False, 'beachcity-lake', 'BeachCity-Lake', null, ST_GeomFromText('POINT(-81.5581 40.6356)',4326), null, null, '2021-03-12T15:43:53.471616Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('1889ac8d-ef26-467d-8355-2697a784c5fb', False, 'bolivar-lake', 'Bolivar-Lake', null, ST_GeomFromText('POINT(-81.4328 40.6486)',4326), null, null, '2021-03-12T15:43:53.471786Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('4cd98742-1d6d-4b07-9d2b-58fb5c00cbbd', False, 'saltfk-lake', 'SaltFk-Lake', null, ST_GeomFromText('POINT(-81.5544 40.1044)',4326), null, null, '2021-03-12T15:43:53.471964Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('22afd70a-6249-436d-9a4f-74568bbf8069', False, 'barrettsmills', 'BarrettsMills', null, ST_GeomFromText('POINT(-83.3856 39.2183)',4326), null, null, '2021-03-12T15:43:53.472172Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03232500'),
-('b6c6620e-9e1f-4130-9ee4-d78cd29ef79e', False, 'bourneville', 'Bourneville', null, ST_GeomFromText('POINT(-83.1675 39.2634)',4326), null, null, '2021-03-12T15:43:53.472347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03234000'),
-('05a4cde6-0851-40cb-b943-9c0cd46f4284', False, 'columbusalumcr', 'ColumbusAlumCr', null, ST_GeomFromText('POINT(-82.9411 39.945)',4326), null, null, '2021-03-12T15:43:53.472570Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03229000'),
-('8dc85528-0c42-4786-ad05-009e08f9d693', False, 'columbusscioto', 'ColumbusScioto', null, ST_GeomFromText('POINT(-83.0092 39.9094)',4326), null, null, '2021-03-12T15:43:53.472724Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03227500'),
-('5eb700c2-a12c-4621-bec7-e9f6e9379c7c', False, 'delaware-lake', 'Delaware-Lake', null, ST_GeomFromText('POINT(-83.0692 40.3592)',4326), null, null, '2021-03-12T15:43:53.472942Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('a7112693-c1d6-4b27-bc61-52c7826569e1', False, 'paintcr-lake', 'PaintCr-Lake', null, ST_GeomFromText('POINT(-83.355 39.2525)',4326), null, null, '2021-03-12T15:43:53.473130Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('0fac482e-623f-4543-b550-cf955f1c7ef1', False, 'paintcr-outflow', 'PaintCr-Outflow', null, ST_GeomFromText('POINT(-83.3494 39.2523)',4326), null, null, '2021-03-12T15:43:53.473313Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03232470'),
-('8df61bb2-ca0f-4018-b352-9dcefd50d689', False, 'claridon', 'Claridon', null, ST_GeomFromText('POINT(-82.9888 40.5827)',4326), null, null, '2021-03-12T15:43:53.473528Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03223000'),
-('01b82000-57ea-42c7-991c-afbcd9e34c8c', False, 'delaware-outflow', 'Delaware-Outflow', null, ST_GeomFromText('POINT(-83.0678 40.3558)',4326), null, null, '2021-03-12T15:43:53.473711Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03225500'),
-('57af7668-d914-4299-90ba-8c1aedfe34b5', False, 'galion', 'Galion', null, ST_GeomFromText('POINT(-82.7994 40.7233)',4326), null, null, '2021-03-12T15:43:53.473892Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('40d15aab-1469-477c-82b1-a080176f5e1e', False, 'athens', 'Athens', null, ST_GeomFromText('POINT(-82.0877 39.3288)',4326), null, null, '2021-03-12T15:43:53.474068Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03159500'),
-('047eed27-1c7b-4e82-a284-5cd0622d9194', False, 'derwent', 'Derwent', null, ST_GeomFromText('POINT(-81.5314 39.9189)',4326), null, null, '2021-03-12T15:43:53.474222Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03141700'),
-('d2441cad-29fa-48f8-8b89-ba7b3c704682', False, 'jwflannagan-lake', 'JWFlannagan-Lake', null, ST_GeomFromText('POINT(-82.3489 37.2342)',4326), null, null, '2021-03-12T15:43:53.474447Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208990'),
-('59c9f819-449b-42d0-a10c-e4191395651f', False, 'nobrkokosing-lake', 'NoBrKokosing-Lake', null, ST_GeomFromText('POINT(-82.5775 40.5039)',4326), null, null, '2021-03-12T15:43:53.474595Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03136300'),
-('b93a64b9-9e40-4f3f-80d2-8c7eb8d1b249', False, 'tappan-lake', 'Tappan-Lake', null, ST_GeomFromText('POINT(-81.2261 40.3558)',4326), null, null, '2021-03-12T15:43:53.474771Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03128000'),
-('bad69699-ffce-4daa-b7b6-6fd66d7dd11f', False, 'willscr-lake', 'WillsCr-Lake', null, ST_GeomFromText('POINT(-81.8497 40.1569)',4326), null, null, '2021-03-12T15:43:53.474923Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03143000'),
-('8ad1aa14-d4b4-427f-b88a-e107de71f1ab', False, 'leesville-lake', 'Leesville-Lake', null, ST_GeomFromText('POINT(-81.1944 40.4703)',4326), null, null, '2021-03-12T15:43:53.475120Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03120000'),
-('153e9baf-3f8f-4f49-a828-56f1b25509fd', False, 'bellevilleld', 'BellevilleLD', null, ST_GeomFromText('POINT(-81.7435 39.1187)',4326), null, null, '2021-03-12T15:43:53.475360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03155520'),
-('0c1146d6-8b38-43aa-8893-0de136d561b0', False, 'bellevilleld-pool', 'BellevilleLD-Pool', null, ST_GeomFromText('POINT(-81.74 39.1218)',4326), null, null, '2021-03-12T15:43:53.475360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('5b49cd94-ec90-42fe-8133-2166b25f0155', False, 'bellevilleld-tailwater', 'BellevilleLD-Tailwater', null, ST_GeomFromText('POINT(-81.7414 39.1135)',4326), null, null, '2021-03-12T15:43:53.475360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('819a690a-7600-4ed9-852f-bfb24f7d74b5', False, 'leesvilleaux', 'LeesvilleAux', null, ST_GeomFromText('POINT(-81.2191 40.4755)',4326), null, null, '2021-03-12T15:43:53.475614Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('5c2b7435-3e7b-4d5b-8364-ded9ab9b3b29', False, 'winfieldwq-test', 'WinfieldWQ-Test', null, ST_GeomFromText('POINT(-81.9118 38.5249)',4326), null, null, '2021-03-12T15:43:53.475760Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('568fe2ee-79ed-4358-bf2b-d380fca64e84', False, 'fullers', 'Fullers', null, ST_GeomFromText('POINT(-82.6197 38.1691)',4326), null, null, '2021-03-12T15:43:53.476132Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03215000'),
-('309f510b-c406-434c-99d7-900f0780d0ed', False, 'pipestem', 'Pipestem', null, ST_GeomFromText('POINT(-81.0086 37.54)',4326), null, null, '2021-03-12T15:43:53.476292Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03179000'),
-('09c49db2-8ec8-4b7e-9a3b-bf0e397e8a65', False, 'yatesville-lake', 'Yatesville-Lake', null, ST_GeomFromText('POINT(-82.6992 38.125)',4326), null, null, '2021-03-12T15:43:53.476474Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('f9d7457d-b590-4b6b-8994-ba77d2814ae5', False, 'graysonky', 'GraysonKY', null, ST_GeomFromText('POINT(-82.9394 38.33)',4326), null, null, '2021-03-12T15:43:53.476613Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03216500'),
-('53508bfe-0f25-47ba-aecf-b751e2ab6e29', False, 'paintsville-outflow', 'Paintsville-Outflow', null, ST_GeomFromText('POINT(-82.87 37.8364)',4326), null, null, '2021-03-12T15:43:53.476787Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03212000'),
-('a0c649f0-f75a-40c4-b28d-8af389b83d82', False, 'cambridge', 'Cambridge', null, ST_GeomFromText('POINT(-81.5872 40.0144)',4326), null, null, '2021-03-12T15:43:53.476970Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03142000'),
-('01965876-7c4f-499c-92b1-6c5effbe59f9', False, 'uhrichsville', 'Uhrichsville', null, ST_GeomFromText('POINT(-81.3466 40.3863)',4326), null, null, '2021-03-12T15:43:53.477147Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03127500'),
-('c03134a7-15e5-4824-a546-677e21bb36db', False, 'bluestone-lake', 'Bluestone-Lake', null, ST_GeomFromText('POINT(-80.8855 37.64)',4326), null, null, '2021-03-12T15:43:53.477324Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03179800'),
-('20f05417-ffbf-4580-b815-45b8209d7658', False, 'bluestone-outflow', 'Bluestone-Outflow', null, ST_GeomFromText('POINT(-80.886 37.649)',4326), null, null, '2021-03-12T15:43:53.477324Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('ac3b293a-c2cb-4340-a21d-439c32073f55', False, 'nofkpound-outflow', 'NoFkPound-Outflow', null, ST_GeomFromText('POINT(-82.6266 37.1257)',4326), null, null, '2021-03-12T15:43:53.477577Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208700'),
-('d1f86c44-efaf-46b9-a9e8-f3e723a8f8fe', False, 'odd', 'Odd', null, ST_GeomFromText('POINT(-81.1931 37.5869)',4326), null, null, '2021-03-12T15:43:53.477774Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('f7b2a9c4-262a-45fd-9812-6b2dd00a2e4d', False, 'poundlwr', 'PoundLwr', null, ST_GeomFromText('POINT(-82.5986 37.1225)',4326), null, null, '2021-03-12T15:43:53.477959Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208800'),
-('0cbe42ac-9b07-4f29-b966-59745bb1ddea', False, 'pineville', 'Pineville', null, ST_GeomFromText('POINT(-81.5375 37.5772)',4326), null, null, '2021-03-12T15:43:53.478173Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('465b5668-65d5-43b7-99c5-4d1ad3cb50ea', False, 'lavalette', 'Lavalette', null, ST_GeomFromText('POINT(-82.4437 38.3201)',4326), null, null, '2021-03-12T15:43:53.478422Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03207061'),
-('dc10e673-bf74-40d1-ac83-bab9002165f2', False, 'senecaville-outflow', 'Senecaville-Outflow', null, ST_GeomFromText('POINT(-81.4406 39.9247)',4326), null, null, '2021-03-12T15:43:53.478698Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03141500'),
-('592e5ca3-db8b-484a-b62c-bec7c33efa6d', False, 'dewey-outflow', 'Dewey-Outflow', null, ST_GeomFromText('POINT(-82.7237 37.7429)',4326), null, null, '2021-03-12T15:43:53.478937Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03211500'),
-('02ac6fc9-3499-4b6e-8242-16d01288c1f6', False, 'dewey-lake', 'Dewey-Lake', null, ST_GeomFromText('POINT(-82.7292 37.7356)',4326), null, null, '2021-03-12T15:43:53.479268Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03211000'),
-('db09b95b-9066-4808-ba65-da9fef60bdaf', False, 'gary', 'Gary', null, ST_GeomFromText('POINT(-81.562 37.3837)',4326), null, null, '2021-03-12T15:43:53.479603Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('0ca172aa-0eb4-4129-84ae-6b425f50bf42', False, 'haysi', 'Haysi', null, ST_GeomFromText('POINT(-82.2963 37.2079)',4326), null, null, '2021-03-12T15:43:53.479799Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208500'),
-('696dfff1-f20f-4e0f-94e9-4aca932e5153', False, 'jwflannagan-outflow', 'JWFlannagan-Outflow', null, ST_GeomFromText('POINT(-82.3429 37.2371)',4326), null, null, '2021-03-12T15:43:53.480104Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '0320900'),
-('bfb47627-f9aa-4df2-940d-4ae1c1a30446', False, 'paintsville-lake', 'Paintsville-Lake', null, ST_GeomFromText('POINT(-82.8742 37.8403)',4326), null, null, '2021-03-12T15:43:53.480441Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('d7161dd4-a188-4b06-9213-e7d167010818', False, 'pikeville', 'Pikeville', null, ST_GeomFromText('POINT(-82.5261 37.465)',4326), null, null, '2021-03-12T15:43:53.480769Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03209500'),
-('e9630e90-66fd-40c5-872d-d6ec6cb80405', False, 'sutton-outflow', 'Sutton-Outflow', null, ST_GeomFromText('POINT(-80.7094 38.6627)',4326), null, null, '2021-03-12T15:43:53.481125Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03195500'),
-('a6c4adb6-41ec-48af-be7d-e1ac39fca5c6', False, 'thurmond', 'Thurmond', null, ST_GeomFromText('POINT(-81.0767 37.955)',4326), null, null, '2021-03-12T15:43:53.481499Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03185400'),
-('43451f65-4494-4f57-8190-95a3d7b11f53', False, 'troutsdale', 'Troutsdale', null, ST_GeomFromText('POINT(-81.4401 36.7019)',4326), null, null, '2021-03-12T15:43:53.481847Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '363703081224101'),
-('15851b24-2e23-4ae3-afc6-425a293e1e70', False, 'atwood-outflow', 'Atwood-Outflow', null, ST_GeomFromText('POINT(-81.2906 40.5244)',4326), null, null, '2021-03-12T15:43:53.482170Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03121500'),
-('dd0e7b3a-3462-4cd8-8d7a-1c3bf070627b', False, 'belva', 'Belva', null, ST_GeomFromText('POINT(-81.1861 38.2325)',4326), null, null, '2021-03-12T15:43:53.482477Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03192000'),
-('f753802e-bc48-42f3-b4ca-13863db82dae', False, 'birchr', 'BirchR', null, ST_GeomFromText('POINT(-80.75 38.5)',4326), null, null, '2021-03-12T15:43:53.482740Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('13e869ce-5b25-48dc-b425-bb47e66ca1dd', False, 'bland', 'Bland', null, ST_GeomFromText('POINT(-81.1067 37.105)',4326), null, null, '2021-03-12T15:43:53.482973Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '370609081071801'),
-('cf793fce-843f-4783-b39f-e4e8923f6655', False, 'charlestonlk6', 'CharlestonLk6', null, ST_GeomFromText('POINT(-81.6696 38.3659)',4326), null, null, '2021-03-12T15:43:53.483251Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03198000'),
-('495906ec-9141-4e5f-89de-7bb50470ca91', False, 'enterprise', 'Enterprise', null, ST_GeomFromText('POINT(-82.475 39.565)',4326), null, null, '2021-03-12T15:43:53.483696Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03157500'),
-('23d961f0-a11d-4fc3-af2b-ee01c0891ac9', False, 'glouster', 'Glouster', null, ST_GeomFromText('POINT(-82.0847 39.5)',4326), null, null, '2021-03-12T15:43:53.483930Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '0315900'),
-('0c4be987-161c-40e2-bb80-4860dcabe162', False, 'newlexington', 'NewLexington', null, ST_GeomFromText('POINT(-82.2156 39.7331)',4326), null, null, '2021-03-12T15:43:53.484122Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('4fbd6a41-9350-4117-97e1-ec27b413fa6b', False, 'tomjenkinsaux', 'TomJenkinsAux', null, ST_GeomFromText('POINT(-82.0717 39.5251)',4326), null, null, '2021-03-12T15:43:53.484320Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03158510'),
-('7a899456-2646-4071-aea0-72c5174fceb6', False, 'tomjenkins-lake', 'TomJenkins-Lake', null, ST_GeomFromText('POINT(-82.0567 39.5408)',4326), null, null, '2021-03-12T15:43:53.484520Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03158500'),
-('5f5e3007-7911-4c28-a616-bd25e91397da', False, 'mohicanville-lake', 'Mohicanville-Lake', null, ST_GeomFromText('POINT(-82.1519 40.7242)',4326), null, null, '2021-03-12T15:43:53.484780Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('32eb8c67-fdbd-467f-a196-beb9e9ae2fd3', False, 'mohicanville-outflow', 'Mohicanville-Outflow', null, ST_GeomFromText('POINT(-82.1547 40.7235)',4326), null, null, '2021-03-12T15:43:53.484981Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03135000'),
-('a5c8b019-f157-4d61-9309-93fd8a562595', False, 'newark', 'Newark', null, ST_GeomFromText('POINT(-82.3385 40.0591)',4326), null, null, '2021-03-12T15:43:53.485190Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03146500'),
-('8975d061-f13e-4b91-ba65-017fd6384ecc', False, 'newphiladelphia', 'NewPhiladelphia', null, ST_GeomFromText('POINT(-81.4483 40.4805)',4326), null, null, '2021-03-12T15:43:53.485499Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03124800'),
-('27d3fe59-e4b9-45eb-9e5c-6764f6dc6951', False, 'willscr-outflow', 'WillsCr-Outflow', null, ST_GeomFromText('POINT(-81.8475 40.1591)',4326), null, null, '2021-03-12T15:43:53.485731Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03143500'),
-('d583cb47-9277-4f07-94fc-91af419f3130', False, 'wooster', 'Wooster', null, ST_GeomFromText('POINT(-81.9197 40.7814)',4326), null, null, '2021-03-12T15:43:53.485989Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('1d946714-3530-48c2-b04e-aab4902b6932', False, 'zanesville', 'Zanesville', null, ST_GeomFromText('POINT(-82.0125 39.94)',4326), null, null, '2021-03-12T15:43:53.486232Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03148000'),
-('a058a284-c49b-4884-828c-2d179ed1bb0b', False, 'zoar-dam', 'Zoar-Dam', null, ST_GeomFromText('POINT(-81.4177 40.6138)',4326), null, null, '2021-03-12T15:43:53.486524Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('8cdf2362-2e40-4aae-a3a0-1bb18269b73b', False, 'greenupld', 'GreenupLD', null, ST_GeomFromText('POINT(-82.8608 38.6475)',4326), null, null, '2021-03-12T15:43:53.486860Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03216600'),
-('ce360feb-710a-4fea-8ebc-92105c3be8d0', False, 'greenupld-pool', 'GreenupLD-Pool', null, ST_GeomFromText('POINT(-82.86 38.6456)',4326), null, null, '2021-03-12T15:43:53.486860Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('720158ae-d06d-4237-8bd7-04335388bdfb', False, 'greenupld-tailwater', 'GreenupLD-Tailwater', null, ST_GeomFromText('POINT(-82.86 38.6496)',4326), null, null, '2021-03-12T15:43:53.486860Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('ac5ea8fb-47dd-4fc9-b9f5-e12a57eb5886', False, 'huntington', 'Huntington', null, ST_GeomFromText('POINT(-82.5006 38.4133)',4326), null, null, '2021-03-12T15:43:53.487337Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03206000'),
-('169e0a7c-8b8d-4f56-a40b-0f5a4ff61208', False, 'marietta', 'Marietta', null, ST_GeomFromText('POINT(-81.4577 39.4094)',4326), null, null, '2021-03-12T15:43:53.487551Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03150700'),
-('12245162-29de-4fe7-854d-85ab6b49d137', False, 'parkersburg', 'Parkersburg', null, ST_GeomFromText('POINT(-81.5639 39.2681)',4326), null, null, '2021-03-12T15:43:53.487842Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03151000'),
-('f3786959-d051-44b7-b7de-47949038c14b', False, 'pointpleasant', 'PointPleasant', null, ST_GeomFromText('POINT(-82.1392 38.8439)',4326), null, null, '2021-03-12T15:43:53.488088Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03201500'),
-('b50f9089-9c58-4d3a-b2bc-cf6887b45719', False, 'portsmouth', 'Portsmouth', null, ST_GeomFromText('POINT(-82.9931 38.7278)',4326), null, null, '2021-03-12T15:43:53.488327Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03217200'),
-('92385c9d-8691-4b56-b420-d435629bf4ef', False, 'rcbyrdld', 'RCByrdLD', null, ST_GeomFromText('POINT(-82.1869 38.6819)',4326), null, null, '2021-03-12T15:43:53.488522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('3ed61398-0204-4e41-b7b0-515abd4ed6fb', False, 'rcbyrdld-pool', 'RCByrdLD-Pool', null, ST_GeomFromText('POINT(-82.1884 38.6823)',4326), null, null, '2021-03-12T15:43:53.488522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('5ee3de86-b9d7-452c-a552-bd0bba4be0e4', False, 'rcbyrdld-tailwater', 'RCByrdLD-Tailwater', null, ST_GeomFromText('POINT(-82.1912 38.6803)',4326), null, null, '2021-03-12T15:43:53.488522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('edd5f2e8-a946-4fb7-90a8-28fdad2959e6', False, 'alumcr-outflow', 'AlumCr-Outflow', null, ST_GeomFromText('POINT(-82.9615 40.1823)',4326), null, null, '2021-03-12T15:43:53.488844Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03228805'),
-('92d4ee4b-8411-47bb-b5f1-587d2dd5c26d', False, 'alumcr-lake', 'AlumCr-Lake', null, ST_GeomFromText('POINT(-82.9668 40.1858)',4326), null, null, '2021-03-12T15:43:53.489098Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('3dbab193-9a8a-4408-9a7a-311dee73667f', False, 'greenfield', 'Greenfield', null, ST_GeomFromText('POINT(-83.375 39.3792)',4326), null, null, '2021-03-12T15:43:53.489281Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03232000'),
-('2a053db3-2a3a-4596-8269-bb4a3b74f220', False, 'hillsboro', 'Hillsboro', null, ST_GeomFromText('POINT(-83.6092 39.2333)',4326), null, null, '2021-03-12T15:43:53.489480Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('0617593e-1aad-4aec-979f-976cd4fda805', False, 'kilbourne', 'Kilbourne', null, ST_GeomFromText('POINT(-82.9222 40.3566)',4326), null, null, '2021-03-12T15:43:53.489664Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03228750'),
-('9610bbf0-f690-4bfa-b5fb-f59dbc273d56', False, 'lithopolis', 'Lithopolis', null, ST_GeomFromText('POINT(-82.82 39.8014)',4326), null, null, '2021-03-12T15:43:53.489859Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('ab59ee47-5ed5-4cae-a4d8-199232647c9b', False, 'london', 'London', null, ST_GeomFromText('POINT(-83.4417 39.8767)',4326), null, null, '2021-03-12T15:43:53.490111Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('963a8aca-de2a-42fe-93da-cdab00230385', False, 'mtsterling', 'MtSterling', null, ST_GeomFromText('POINT(-83.2575 39.7153)',4326), null, null, '2021-03-12T15:43:53.490307Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03230800'),
-('503b6d4c-bbfd-45d7-b63b-99e0343be8fc', False, 'piketon', 'Piketon', null, ST_GeomFromText('POINT(-83.0196 39.0701)',4326), null, null, '2021-03-12T15:43:53.490505Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03237020'),
-('dadea930-bb8e-4ef4-a3d0-a9f462e9de73', False, 'rees', 'Rees', null, ST_GeomFromText('POINT(-82.9569 39.8563)',4326), null, null, '2021-03-12T15:43:53.490629Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03229500'),
-('d437867f-9b7a-44bf-abc8-e2b7b6a4c14e', False, 'wejefferson', 'WeJefferson', null, ST_GeomFromText('POINT(-83.2675 39.9506)',4326), null, null, '2021-03-12T15:43:53.490814Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03230310'),
-('c5b05fc0-09a9-43d6-b902-3565851ee092', False, 'williamsport', 'Williamsport', null, ST_GeomFromText('POINT(-83.1217 39.5853)',4326), null, null, '2021-03-12T15:43:53.491080Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03231000'),
-('d21eec60-97f7-43af-b0f2-7960859288c3', False, 'worthington', 'Worthington', null, ST_GeomFromText('POINT(-83.0332 40.1098)',4326), null, null, '2021-03-12T15:43:53.491312Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03226800'),
-('63832009-63d1-46b7-9fdc-3a2785e3ea4e', False, 'londonld', 'LondonLD', null, ST_GeomFromText('POINT(-81.3692 38.1928)',4326), null, null, '2021-03-12T15:43:53.491499Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('cd8146b4-53fd-4e72-8230-8b473af39006', False, 'londonld-pool', 'LondonLD-Pool', null, ST_GeomFromText('POINT(-81.3672 38.1916)',4326), null, null, '2021-03-12T15:43:53.491499Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('4b9787d6-bec2-43b4-9a7a-4f9abac4ba77', False, 'londonld-tailwater', 'LondonLD-Tailwater', null, ST_GeomFromText('POINT(-81.3718 38.1938)',4326), null, null, '2021-03-12T15:43:53.491499Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('258f7dbd-e0d0-4fd7-9c6c-c5ac8dc63273', False, 'ivanhoe', 'Ivanhoe', null, ST_GeomFromText('POINT(-80.9523 36.8349)',4326), null, null, '2021-03-12T15:43:53.491780Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03165500'),
-('783b222e-1598-4717-851a-90c7fde7e80e', False, 'jefferson', 'Jefferson', null, ST_GeomFromText('POINT(-81.4074 36.3947)',4326), null, null, '2021-03-12T15:43:53.491900Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03161000'),
-('7e2ed6b7-8346-4178-8cee-4ea3c5d38e26', False, 'littleriverres', 'LittleRiverRes', null, ST_GeomFromText('POINT(-80.5726 37.0776)',4326), null, null, '2021-03-12T15:43:53.492024Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03170500'),
-('a5c40ef1-edb4-42c4-9aec-429e94c51dd8', False, 'iridiumtest', 'IridiumTest', null, ST_GeomFromText('POINT(-82.1858 38.6844)',4326), null, null, '2021-03-12T15:43:53.492125Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('252545f0-47bc-4123-8ec8-dde65fadb847', False, 'pleasanthill-outflow', 'PleasantHill-Outflow', null, ST_GeomFromText('POINT(82.3238 40.6205)',4326), null, null, '2021-03-12T15:43:53.492211Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03133500'),
-('4fdc5656-ddd8-483c-9caf-be736b6cc077', False, 'dover-lake', 'Dover-Lake', null, ST_GeomFromText('POINT(-81.413 40.5578)',4326), null, null, '2021-03-12T15:43:53.492371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('0e7dfdc6-5ea3-4296-a4d8-959b469e1058', False, 'rockbridge', 'Rockbridge', null, ST_GeomFromText('POINT(-82.5786 39.5883)',4326), null, null, '2021-03-12T15:43:53.492532Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03157000'),
-('70cc718a-7676-4480-ad91-f212df9d19ba', False, 'hawksnest', 'HawksNest', null, ST_GeomFromText('POINT(-81.1425 38.1136)',4326), null, null, '2021-03-12T15:43:53.492645Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '380649081083301'),
-('df03dab4-5dbb-4336-bc58-08d37406d929', False, 'hanniballd', 'HannibalLD', null, ST_GeomFromText('POINT(-80.8661 39.6672)',4326), null, null, '2021-03-12T15:43:53.492812Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03114280'),
-('43185275-845f-432d-a62d-cc37401d8a4b', False, 'hanniballd-pool', 'HannibalLD-Pool', null, ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T15:43:53.492812Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('f2ac8b70-c4a0-46d4-9816-ca4b469ff4d8', False, 'hanniballd-tailwater', 'HannibalLD-Tailwater', null, ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T15:43:53.492812Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('7583d6f6-f0c3-4329-ab4e-ab1301a2cc91', False, 'claytor-lake', 'Claytor-Lake', null, ST_GeomFromText('POINT(-80.5847 37.0744)',4326), null, null, '2021-03-12T15:43:53.492984Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03169000'),
-('b7975b65-407d-4134-9e3a-b3e5fbb508dc', False, 'dillon-outflow', 'Dillon-Outflow', null, ST_GeomFromText('POINT(-82.0788 39.9898)',4326), null, null, '2021-03-12T15:43:53.493146Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03147500'),
-('d3de96c7-9f6f-4821-a936-86edb0130ad5', False, 'dublin', 'Dublin', null, ST_GeomFromText('POINT(-83.1206 40.1408)',4326), null, null, '2021-03-12T15:43:53.493356Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03221000'),
-('0ecaceb0-061f-4918-9ada-ecf66a52dbc2', False, 'paintsville-outflow-iridium', 'Paintsville-Outflow-Iridium', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T15:43:53.493546Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('72b5faf6-e0c4-4ebc-8dd1-5f671342bd58', False, 'willowislandld', 'WillowIslandLD', null, ST_GeomFromText('POINT(-81.3208 39.3603)',4326), null, null, '2021-03-12T15:43:53.493673Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('63ef3999-a2e8-4ce3-af9e-424100931b86', False, 'willowislandld-pool', 'WillowIslandLD-Pool', null, ST_GeomFromText('POINT(-81.3179 39.3628)',4326), null, null, '2021-03-12T15:43:53.493673Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('93c34b08-dd7b-49de-b0f5-08a3dc32270f', False, 'willowislandld-tailwater', 'WillowIslandLD-Tailwater', null, ST_GeomFromText('POINT(-81.3221 39.3607)',4326), null, null, '2021-03-12T15:43:53.493673Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('c3632127-8ed6-4a4a-9246-8541e85a7c70', False, 'replete', 'Replete', null, ST_GeomFromText('POINT(-80.4336 38.6886)',4326), null, null, '2021-03-12T15:43:53.494117Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03195250'),
-('d0a89cec-68f8-4a7b-9668-5e64fa00f0e6', False, 'georgetownoh', 'GeorgetownOH', null, ST_GeomFromText('POINT(-83.9283 38.9197)',4326), null, null, '2021-03-12T15:43:53.494346Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03238495'),
-('8b078de0-8a3a-47e5-a1e8-4a4d782ce09a', False, 'bloomfieldoh', 'BloomfieldOH', null, ST_GeomFromText('POINT(-81.2039 39.5631)',4326), null, null, '2021-03-12T15:43:53.494435Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03115400'),
-('6c396909-aeb0-43b2-9c59-4055ed19a73a', False, 'westunionoh', 'WestUnionOH', null, ST_GeomFromText('POINT(-83.4211 38.8036)',4326), null, null, '2021-03-12T15:43:53.494549Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03237500'),
-('9fd1525e-d5c0-4aa6-ae27-cd46c48e6efe', False, 'middleislandcr', 'MiddleIslandCr', null, ST_GeomFromText('POINT(-80.9976 39.4746)',4326), null, null, '2021-03-12T15:43:53.494643Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03114500'),
-('65545a7d-455e-48f0-8c21-6ae74c713439', False, 'ashland', 'Ashland', null, ST_GeomFromText('POINT(-82.6375 38.4814)',4326), null, null, '2021-03-12T15:43:53.494748Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03216000'),
-('15fdf592-7d91-49b5-b18e-5ea0a78bb88a', False, 'maysville', 'Maysville', null, ST_GeomFromText('POINT(-83.7658 38.6494)',4326), null, null, '2021-03-12T15:43:53.494950Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03238000'),
-('534298f7-f968-4aeb-8e2e-75ea96154ae4', False, 'captameldahlld', 'CaptAMeldahlLD', null, ST_GeomFromText('POINT(-84.1733 38.7975)',4326), null, null, '2021-03-12T15:43:53.495148Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03238680'),
-('d59a3f0d-4d8d-4ac5-8006-11e9b614a207', False, 'captameldahlld-pool', 'CaptAMeldahlLD-Pool', null, ST_GeomFromText('POINT(-84.1667 38.7947)',4326), null, null, '2021-03-12T15:43:53.495148Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('ce6f1bdc-4767-4eca-9315-76850b526031', False, 'captameldahlld-tailwater', 'CaptAMeldahlLD-Tailwater', null, ST_GeomFromText('POINT(-84.1788 38.7965)',4326), null, null, '2021-03-12T15:43:53.495148Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('4c2c6dc9-8059-4ebe-ba43-cbaf0b611e2e', False, 'frazeysburg', 'Frazeysburg', null, ST_GeomFromText('POINT(-82.1481 40.1325)',4326), null, null, '2021-03-12T15:43:53.495523Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03144000'),
-('5eb4285c-a157-4796-ad57-bcc9a6ec9b8c', False, 'pleasanthill-lake', 'PleasantHill-Lake', null, ST_GeomFromText('POINT(-82.3239 40.6239)',4326), null, null, '2021-03-12T15:43:53.495697Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03133000'),
-('b1be9888-191f-49cc-b379-f3b6a901eb2b', False, 'racineld-iridium', 'RacineLD-Iridium', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T15:43:53.495947Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
-('1c534262-2a50-4c63-935c-f4f2a73c0d17', False, 'georgesfk-iridium', 'GeorgesFk-iridium', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T15:43:53.496154Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null);
+('c79f8d3c-a7a3-4340-9482-6040fd92f954', False, 'adamsville', 'Adamsville', ST_GeomFromText('POINT(-82.3558 38.8736)',4326), null, null, '2021-03-12T15:43:53.447289Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03202000'),
+('3d2997f1-60d7-4a44-809f-1e93e8eb4793', False, 'alderson', 'Alderson', ST_GeomFromText('POINT(-80.6413 37.7243)',4326), null, null, '2021-03-12T15:43:53.447543Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03183500'),
+('56803381-ac0c-452e-bf8d-b6e654d6a31b', False, 'grayson-lake', 'Grayson-Lake', ST_GeomFromText('POINT(-82.9861 38.2525)',4326), null, null, '2021-03-12T15:43:53.447825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03216300'),
+('42149c00-07d9-4735-ab27-69e3c87de8e9', False, 'grayson-outflow', 'Grayson-Outflow', ST_GeomFromText('POINT(-82.9911 38.2539)',4326), null, null, '2021-03-12T15:43:53.447980Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03216350'),
+('0dc47447-a0d6-4af6-b55b-de224741cf42', False, 'willard', 'Willard', ST_GeomFromText('POINT(-82.9048 38.214)',4326), null, null, '2021-03-12T15:43:53.448171Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '381100082533601'),
+('261d95af-084a-4a70-87b1-261e66400eaa', False, 'cairowv', 'CairoWV', ST_GeomFromText('POINT(-81.0998 39.219)',4326), null, null, '2021-03-12T15:43:53.448324Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03155405'),
+('d140d7bd-404e-4741-b3c6-9b6cf1c1ac1e', False, 'macfarlan', 'Macfarlan', ST_GeomFromText('POINT(-81.2124 39.0789)',4326), null, null, '2021-03-12T15:43:53.448424Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03155220'),
+('7a524df2-c925-4e80-a414-55854a0f1eff', False, 'wildcat', 'Wildcat', ST_GeomFromText('POINT(-80.5256 38.7433)',4326), null, null, '2021-03-12T15:43:53.448511Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03151400'),
+('001f4621-20de-4f08-af39-753437a1bc38', False, 'rocksdale', 'Rocksdale', ST_GeomFromText('POINT(-81.2213 38.8438)',4326), null, null, '2021-03-12T15:43:53.448686Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03154000'),
+('2aa1ac16-6f27-4104-a9f2-fbb4f9541589', False, 'elkhorncity', 'ElkhornCity', ST_GeomFromText('POINT(-82.3595 37.3129)',4326), null, null, '2021-03-12T15:43:53.448835Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03209300'),
+('60fe2568-01cb-40f8-9207-88bd6a106cf5', False, 'davenport', 'Davenport', ST_GeomFromText('POINT(-82.1369 37.0996)',4326), null, null, '2021-03-12T15:43:53.449015Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('f1d94564-240d-4337-8786-3ad9ef9bf4ea', False, 'bane', 'Bane', ST_GeomFromText('POINT(-80.7097 37.2681)',4326), null, null, '2021-03-12T15:43:53.449199Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03173000'),
+('7c82c379-5e80-41dd-a1a6-f178cfd7931d', False, 'beachcity-outflow', 'BeachCity-Outflow', ST_GeomFromText('POINT(-81.554 40.6356)',4326), null, null, '2021-03-12T15:43:53.449355Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03124000'),
+('3f14ff05-f4f3-4595-8897-a278b46b3754', False, 'beckley', 'Beckley', ST_GeomFromText('POINT(-81.2127 37.7971)',4326), null, null, '2021-03-12T15:43:53.449483Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('e9524f9f-1a3d-4e14-bac7-c5dd1ec93700', False, 'bartlick', 'Bartlick', ST_GeomFromText('POINT(-82.3242 37.2453)',4326), null, null, '2021-03-12T15:43:53.449624Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03209200'),
+('f571ca0a-a2c0-421d-878b-e1e49fe9c87d', False, 'bastian', 'Bastian', ST_GeomFromText('POINT(-81.3783 37.1525)',4326), null, null, '2021-03-12T15:43:53.449783Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '371030081181000'),
+('d3e11262-0c3d-48ce-af22-7b0a2dea9960', False, 'beechfk-lake', 'BeechFk-Lake', ST_GeomFromText('POINT(-82.4164 38.3039)',4326), null, null, '2021-03-12T15:43:53.449888Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('0e0117b4-9208-43ab-94c7-a8779d711d4e', False, 'beechfk-outflow', 'BeechFk-Outflow', ST_GeomFromText('POINT(-82.4244 38.305)',4326), null, null, '2021-03-12T15:43:53.450049Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03207057'),
+('eb4b501d-35a3-449e-b52c-2395beec7118', False, 'bellepoint', 'Bellepoint', ST_GeomFromText('POINT(-83.1738 40.2483)',4326), null, null, '2021-03-12T15:43:53.450284Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03220000'),
+('f0a157f3-9d31-4cc1-b7d2-62e9dfb7f195', False, 'kermit', 'Kermit', ST_GeomFromText('POINT(-82.4088 37.8372)',4326), null, null, '2021-03-12T15:43:53.450469Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03214500'),
+('399cd1e8-cf40-48eb-b249-693e7bf87961', False, 'meta', 'Meta', ST_GeomFromText('POINT(-82.4581 37.5669)',4326), null, null, '2021-03-12T15:43:53.450618Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03210000'),
+('a78ead49-4274-4d12-b37b-dcf8e2441d81', False, 'nofkpound-lake', 'NoFkPound-Lake', ST_GeomFromText('POINT(-82.6306 37.1239)',4326), null, null, '2021-03-12T15:43:53.450794Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208680'),
+('af432d3c-dd6c-4693-88a7-0beecc19e8b0', False, 'poundupr', 'PoundUpr', ST_GeomFromText('POINT(-82.6078 37.1239)',4326), null, null, '2021-03-12T15:43:53.450946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208850'),
+('3641ab0f-27f4-43cf-933d-092aeb5ceb69', False, 'welch', 'Welch', ST_GeomFromText('POINT(-81.5833 37.4411)',4326), null, null, '2021-03-12T15:43:53.451143Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03212750'),
+('be42d7f3-2e28-4e49-82f2-d6bab5019995', False, 'williamson', 'Williamson', ST_GeomFromText('POINT(-82.2803 37.673)',4326), null, null, '2021-03-12T15:43:53.451316Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03212700'),
+('0db03cbb-ad59-4cf2-80de-fb4f477f3bdc', False, 'yatesville-outflow', 'Yatesville-Outflow', ST_GeomFromText('POINT(-82.6972 38.128)',4326), null, null, '2021-03-12T15:43:53.451496Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03215500'),
+('9b6646b4-ebe1-4ee4-8bc4-bceab9d9986e', False, 'lockwood', 'Lockwood', ST_GeomFromText('POINT(-81.0233 38.2625)',4326), null, null, '2021-03-12T15:43:53.451711Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03191500'),
+('df190d76-2a02-4744-84b6-878fed5450f4', False, 'marmetld', 'MarmetLD', ST_GeomFromText('POINT(-81.5668 38.2537)',4326), null, null, '2021-03-12T15:43:53.451810Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('e3309be4-720d-4591-b8f2-5530abd245e8', False, 'marmetld-tailwater', 'MarmetLD-Tailwater', ST_GeomFromText('POINT(-81.5694 38.2561)',4326), null, null, '2021-03-12T15:43:53.451810Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('0b9e5da0-b49e-4e5b-9805-80e4834b965b', False, 'marmetld-pool', 'MarmetLD-Pool', ST_GeomFromText('POINT(-81.5663 38.2509)',4326), null, null, '2021-03-12T15:43:53.451810Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('80f6b0bc-1a3e-44d4-b651-d86a7ab87137', False, 'redoakknob', 'RedOakKnob', ST_GeomFromText('POINT(-80.4405 38.3533)',4326), null, null, '2021-03-12T15:43:53.452067Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('bfc38643-0317-4d2e-a7c2-14666deddd6b', False, 'redsulphurspr', 'RedSulphurSpr', ST_GeomFromText('POINT(-80.7702 37.5292)',4326), null, null, '2021-03-12T15:43:53.452212Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03177480'),
+('b28b27a3-f0d3-4dad-bbdf-a3903003424b', False, 'rockcamp', 'RockCamp', ST_GeomFromText('POINT(-80.595 37.4781)',4326), null, null, '2021-03-12T15:43:53.452343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('b9ab2a38-73d6-4d31-aaf0-9f5d70771ac8', False, 'sharpsknob', 'SharpsKnob', ST_GeomFromText('POINT(-80.1647 38.4275)',4326), null, null, '2021-03-12T15:43:53.452479Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('b37f6852-767f-401d-a00a-4bca14867e34', False, 'summersville-lake', 'Summersville-Lake', ST_GeomFromText('POINT(-80.8903 38.2226)',4326), null, null, '2021-03-12T15:43:53.452624Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('c3e92c04-783c-4cc2-bf64-4d72c7478aa0', False, 'sutton-lake', 'Sutton-Lake', ST_GeomFromText('POINT(-80.6931 38.6619)',4326), null, null, '2021-03-12T15:43:53.452767Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('6d294e69-50e3-4ab1-97b9-fa7c3e60fa00', False, 'sutton-sump', 'Sutton-Sump', ST_GeomFromText('POINT(-80.6931 38.6623)',4326), null, null, '2021-03-12T15:43:53.452767Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('cf6f090f-29aa-43e3-bdb7-76ddb00caeaa', False, 'leon', 'Leon', ST_GeomFromText('POINT(-82.9775 38.2864)',4326), null, null, '2021-03-12T15:43:53.452979Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03216300'),
+('ed8bb870-5ec1-4f80-a7c5-a2700aeabbe3', False, 'dunlow', 'Dunlow', ST_GeomFromText('POINT(-82.2964 38.0175)',4326), null, null, '2021-03-12T15:43:53.453152Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03206600'),
+('5681271a-ac0b-48f9-ad9e-2fe1dd777082', False, 'hebron', 'Hebron', ST_GeomFromText('POINT(-82.4753 39.9888)',4326), null, null, '2021-03-12T15:43:53.453305Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03145000'),
+('28bf6b1e-1f1e-4df4-a912-372d9b1b33c3', False, 'elizabeth', 'Elizabeth', ST_GeomFromText('POINT(-81.3894 39.0592)',4326), null, null, '2021-03-12T15:43:53.453479Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03155000'),
+('971c4dd8-ba6d-42aa-9d27-c46ba70dd88f', False, 'glenville', 'Glenville', ST_GeomFromText('POINT(-80.8391 38.9338)',4326), null, null, '2021-03-12T15:43:53.453687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03152000'),
+('56e38c38-243e-4252-8d4b-4ffed288606e', False, 'burnsville-lake', 'Burnsville-Lake', ST_GeomFromText('POINT(-80.6203 38.8428)',4326), null, null, '2021-03-12T15:43:53.453861Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('b1c64a88-023a-48e8-a830-ba2049c3dada', False, 'burnsville-sump', 'Burnsville-Sump', ST_GeomFromText('POINT(-80.62 38.8433)',4326), null, null, '2021-03-12T15:43:53.453861Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('ca428eda-4d78-4d2d-99df-9ad56031d924', False, 'burnsvillewv', 'BurnsvilleWV', ST_GeomFromText('POINT(-80.6733 38.8619)',4326), null, null, '2021-03-12T15:43:53.454102Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03151600'),
+('a5ed4534-16d1-49d0-a896-84f66185f66d', False, 'burnsville-outflow', 'Burnsville-Outflow', ST_GeomFromText('POINT(-80.6281 38.845)',4326), null, null, '2021-03-12T15:43:53.454277Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03151520'),
+('bc1283bd-4ac0-44ed-ad5d-cb94e6a1a356', False, 'grantsville', 'Grantsville', ST_GeomFromText('POINT(-81.0977 38.9219)',4326), null, null, '2021-03-12T15:43:53.454484Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03153500'),
+('225e5a2d-9213-47e7-8462-328ff5d9cb2f', False, 'fishtrap-outflow', 'Fishtrap-Outflow', ST_GeomFromText('POINT(-82.4164 37.43)',4326), null, null, '2021-03-12T15:43:53.454665Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208000'),
+('e54a87e7-00e9-41fa-a353-9415abbc17f1', False, 'mtvernon', 'MtVernon', ST_GeomFromText('POINT(-82.4998 40.4058)',4326), null, null, '2021-03-12T15:43:53.454842Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03136500'),
+('2c1b59fa-f836-4322-913f-8ff1a7761162', False, 'newcomerstown', 'Newcomerstown', ST_GeomFromText('POINT(-81.6088 40.2616)',4326), null, null, '2021-03-12T15:43:53.455065Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03129000'),
+('8e882fb4-7fec-437b-9974-c892945b598b', False, 'alexandria', 'Alexandria', ST_GeomFromText('POINT(-82.6106 40.0869)',4326), null, null, '2021-03-12T15:43:53.455249Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('5c2f454f-b6b1-45db-8185-d77277c2b9f1', False, 'tornado', 'Tornado', ST_GeomFromText('POINT(-81.8414 38.3392)',4326), null, null, '2021-03-12T15:43:53.455398Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03200500'),
+('fc99bc44-830a-4821-b9dd-8ccaa071e353', False, 'bigrock', 'BigRock', ST_GeomFromText('POINT(-82.1958 37.3536)',4326), null, null, '2021-03-12T15:43:53.455644Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03207800'),
+('f5aac11b-13e8-4947-b1fc-189ad1db7f2f', False, 'allisonia', 'Allisonia', ST_GeomFromText('POINT(-80.7458 36.9375)',4326), null, null, '2021-03-12T15:43:53.455765Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03168000'),
+('5241d2a6-1080-463e-b09c-f7388763da8f', False, 'higby', 'Higby', ST_GeomFromText('POINT(-82.8641 39.2125)',4326), null, null, '2021-03-12T15:43:53.455902Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03234500'),
+('628951b7-69fe-4ff9-b662-70b1c96db120', False, 'darbyville', 'Darbyville', ST_GeomFromText('POINT(-83.1108 39.7005)',4326), null, null, '2021-03-12T15:43:53.456075Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03230500'),
+('c023937e-070c-4074-8ac4-0583e4f53bd4', False, 'ashford', 'Ashford', ST_GeomFromText('POINT(-81.7117 38.1797)',4326), null, null, '2021-03-12T15:43:53.456247Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03198500'),
+('3012afd5-aa0d-4d4f-988a-0a73b85927d1', False, 'fishtrap-lake', 'Fishtrap-Lake', ST_GeomFromText('POINT(-82.4156 37.4325)',4326), null, null, '2021-03-12T15:43:53.456342Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03207995'),
+('6a37bc9c-ad44-49cf-9ac0-8d785aacf157', False, 'baileysville', 'Baileysville', ST_GeomFromText('POINT(-81.6453 37.6039)',4326), null, null, '2021-03-12T15:43:53.456506Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03202400'),
+('6bd51339-f0dc-4e23-ac22-523d8cd13cef', False, 'branchland', 'Branchland', ST_GeomFromText('POINT(-82.2022 38.2217)',4326), null, null, '2021-03-12T15:43:53.456634Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03204000'),
+('e2fce378-7927-4467-a051-d326c184642c', False, 'clearfk', 'ClearFk', ST_GeomFromText('POINT(-81.7075 37.6231)',4326), null, null, '2021-03-12T15:43:53.456774Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03202750'),
+('9f2e4261-8225-48a8-8df0-27fc9088669c', False, 'nallen', 'Nallen', ST_GeomFromText('POINT(-80.876 38.1126)',4326), null, null, '2021-03-12T15:43:53.456952Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03190000'),
+('d28e7e29-8d0e-44de-a14b-374903303799', False, 'narrows', 'Narrows', ST_GeomFromText('POINT(-80.85 37.3055)',4326), null, null, '2021-03-12T15:43:53.457074Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03175500'),
+('82b05e4b-9d36-4e43-8910-b31055ac517b', False, 'queenshoals', 'QueenShoals', ST_GeomFromText('POINT(-81.2844 38.4722)',4326), null, null, '2021-03-12T15:43:53.457208Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03197000'),
+('cdff6fa8-cf11-41ba-92bc-058ce3d297ec', False, 'radford', 'Radford', ST_GeomFromText('POINT(-80.5742 37.1383)',4326), null, null, '2021-03-12T15:43:53.457381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03171000'),
+('e52ccf42-02ae-4cfa-8a40-827f448e29ec', False, 'rainelle', 'Rainelle', ST_GeomFromText('POINT(-80.7634 37.9685)',4326), null, null, '2021-03-12T15:43:53.457554Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('1c4c1416-2ab9-4178-bc44-736b3f2907ac', False, 'raleigh', 'Raleigh', ST_GeomFromText('POINT(-81.1625 37.7606)',4326), null, null, '2021-03-12T15:43:53.457698Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03185000'),
+('a0bbd1ef-588f-459f-911f-7b948022444e', False, 'jobsknob', 'JobsKnob', ST_GeomFromText('POINT(-80.543 38.0786)',4326), null, null, '2021-03-12T15:43:53.457790Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('c321e0fa-72a5-4425-ade3-e5ed7ec01161', False, 'kanawhafalls', 'KanawhaFalls', ST_GeomFromText('POINT(-81.2147 38.138)',4326), null, null, '2021-03-12T15:43:53.457944Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03193000'),
+('ae23b824-06f7-4252-bc67-5471a2ee6f88', False, 'lansing', 'Lansing', ST_GeomFromText('POINT(-81.5108 36.4981)',4326), null, null, '2021-03-12T15:43:53.458096Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '362954081303800'),
+('764cc6ed-61c7-4249-94fc-4e2e011db1da', False, 'laurelfork', 'LaurelFork', ST_GeomFromText('POINT(-80.5269 36.7161)',4326), null, null, '2021-03-12T15:43:53.458219Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '364258080313700'),
+('3ecbfee0-4f43-4b8f-803e-d1eaf24e4cd4', False, 'charlesmill-outflow', 'CharlesMill-Outflow', ST_GeomFromText('POINT(-82.3632 40.738)',4326), null, null, '2021-03-12T15:43:53.458345Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03130000'),
+('0394ed68-3a97-497b-84ec-db0117f1bc70', False, 'bolivaraux', 'BolivarAUX', ST_GeomFromText('POINT(-81.4489 40.6249)',4326), null, null, '2021-03-12T15:43:53.458492Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('1bb3f9c5-ada9-49e7-b888-3d9c6669df4e', False, 'charlesmill-lake', 'CharlesMill-Lake', ST_GeomFromText('POINT(-82.3625 40.7403)',4326), null, null, '2021-03-12T15:43:53.458654Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('559b0a80-bafc-4e9a-81e2-a417c9453092', False, 'summersville-outflow', 'Summersville-Outflow', ST_GeomFromText('POINT(-80.8884 38.2151)',4326), null, null, '2021-03-12T15:43:53.458847Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03189600'),
+('f7f616f3-3f4c-40b1-96bc-26fe1a367884', False, 'clendening-lake', 'Clendening-Lake', ST_GeomFromText('POINT(-81.2792 40.2694)',4326), null, null, '2021-03-12T15:43:53.459068Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03126500'),
+('773e8254-8912-4715-819a-301a16326307', False, 'dillon-lake', 'Dillon-Lake', ST_GeomFromText('POINT(-82.0817 39.9917)',4326), null, null, '2021-03-12T15:43:53.459278Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('cabbad90-1f3d-4d28-8398-d1954dbb4c2e', False, 'dover-outflow', 'Dover-Outflow', ST_GeomFromText('POINT(-81.4294 40.5297)',4326), null, null, '2021-03-12T15:43:53.459435Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03122500'),
+('4308f58d-275a-4035-b47c-f8f11bf8c505', False, 'killbuck', 'Killbuck', ST_GeomFromText('POINT(-81.9855 40.4816)',4326), null, null, '2021-03-12T15:43:53.459618Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03139000'),
+('5eb590e6-6ebd-4405-ba6a-38a0c32f58d7', False, 'leesville-outflow', 'Leesville-Outflow', ST_GeomFromText('POINT(-81.1981 40.4706)',4326), null, null, '2021-03-12T15:43:53.459801Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03120500'),
+('8c35d9ee-4fdc-48df-803b-bbd85ffda3ad', False, 'loudonville', 'Loudonville', ST_GeomFromText('POINT(-82.2391 40.6359)',4326), null, null, '2021-03-12T15:43:53.459982Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03131500'),
+('5622a3d4-aa4a-408d-b013-577c89f4e828', False, 'lucas', 'Lucas', ST_GeomFromText('POINT(-82.4144 40.7036)',4326), null, null, '2021-03-12T15:43:53.460201Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03131122'),
+('f865f45e-ca7a-481f-9ec4-820241aa6663', False, 'magnolialevee', 'MagnoliaLevee', ST_GeomFromText('POINT(-81.2994 40.6511)',4326), null, null, '2021-03-12T15:43:53.460377Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('853aad6c-2d3c-4443-b8a8-82238152101c', False, 'massillon', 'Massillon', ST_GeomFromText('POINT(-81.5214 40.7703)',4326), null, null, '2021-03-12T15:43:53.460551Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03117000'),
+('01b4180a-fff5-4be4-a79b-ac2b3393e05d', False, 'mcconnelsville', 'McConnelsville', ST_GeomFromText('POINT(-81.8516 39.645)',4326), null, null, '2021-03-12T15:43:53.460696Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03150000'),
+('5e2d6a0b-7d78-4736-8ad0-738fff2b4275', False, 'melco', 'Melco', ST_GeomFromText('POINT(-82.3597 40.6986)',4326), null, null, '2021-03-12T15:43:53.460852Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03131200'),
+('0dca368d-8d16-4a85-b4a9-544c5d3612cd', False, 'millport', 'Millport', ST_GeomFromText('POINT(-80.898 40.7222)',4326), null, null, '2021-03-12T15:43:53.460999Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('e3f3e9fb-d1de-4730-bdfc-a9eec23679ba', False, 'mohawk-outflow', 'Mohawk-Outflow', ST_GeomFromText('POINT(-82.0655 40.3414)',4326), null, null, '2021-03-12T15:43:53.461119Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03138500'),
+('53cec804-9570-43b9-bc74-3ef649f00fbe', False, 'georgesfk', 'GeorgesFk', ST_GeomFromText('POINT(-82.525 37.1642)',4326), null, null, '2021-03-12T15:43:53.461285Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208900'),
+('50acbeaf-a316-45de-8fc8-be402f50f770', False, 'pilgrimknob', 'PilgrimKnob', ST_GeomFromText('POINT(-81.89 37.28)',4326), null, null, '2021-03-12T15:43:53.461457Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('ffa496d3-bdd4-4b7b-acce-d418c27dd931', False, 'rdbailey-lake', 'RDBailey-Lake', ST_GeomFromText('POINT(-81.8189 37.5989)',4326), null, null, '2021-03-12T15:43:53.461602Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03202913'),
+('3af296d4-a2b6-4e9d-b423-aaa55255af42', False, 'rdbailey-outflow', 'RDBailey-Outflow', ST_GeomFromText('POINT(-81.827 37.5979)',4326), null, null, '2021-03-12T15:43:53.461729Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03202915'),
+('56040857-a5b3-49cd-8a1e-b056656e028d', False, 'wolfpen', 'WolfPen', ST_GeomFromText('POINT(-81.5844 37.5167)',4326), null, null, '2021-03-12T15:43:53.461933Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('e4c00c01-78f8-48f9-b0d9-7b0cec845a24', False, 'noindustry', 'NoIndustry', ST_GeomFromText('POINT(-81.3661 40.7405)',4326), null, null, '2021-03-12T15:43:53.462099Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03118500'),
+('e864518e-3271-4c78-b253-f9a87e7a9523', False, 'piedmont-lake', 'Piedmont-Lake', ST_GeomFromText('POINT(-81.2175 40.1922)',4326), null, null, '2021-03-12T15:43:53.462248Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03125500'),
+('84fe09ac-c0ba-448c-ae0c-f6309790e109', False, 'piedmont-outflow', 'Piedmont-Outflow', ST_GeomFromText('POINT(-81.2156 40.1944)',4326), null, null, '2021-03-12T15:43:53.462391Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03126000'),
+('e7c1093b-980a-4116-9953-3dcca920eda0', False, 'roseville', 'Roseville', ST_GeomFromText('POINT(-82.0736 39.8161)',4326), null, null, '2021-03-12T15:43:53.462562Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('8df63b20-8b76-44bc-a5d9-ea95d4ba5d17', False, 'senecaville-lake', 'Senecaville-Lake', ST_GeomFromText('POINT(-81.4341 39.9255)',4326), null, null, '2021-03-12T15:43:53.462710Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03141000'),
+('58db3288-96d1-4ea8-b919-c972a31742b5', False, 'tappan-outflow', 'Tappan-Outflow', ST_GeomFromText('POINT(-81.2344 40.3603)',4326), null, null, '2021-03-12T15:43:53.462883Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03128500'),
+('e0e13648-5a57-4108-a1f7-c77b390f0bef', False, 'tippecanoe', 'Tippecanoe', ST_GeomFromText('POINT(-81.2902 40.2703)',4326), null, null, '2021-03-12T15:43:53.463025Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03127000'),
+('07b645fa-30b3-4a0e-93fb-ac149ae7ae60', False, 'waynesburg', 'Waynesburg', ST_GeomFromText('POINT(-81.26 40.6726)',4326), null, null, '2021-03-12T15:43:53.463206Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03117500'),
+('46d2b9e1-d73b-4516-a16a-6a253861d4e6', False, 'eastlynn-lake', 'EastLynn-Lake', ST_GeomFromText('POINT(-82.3839 38.1447)',4326), null, null, '2021-03-12T15:43:53.463329Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('36493655-e16f-41ac-b204-b26933231fd6', False, 'eastlynn-outflow', 'EastLynn-Outflow', ST_GeomFromText('POINT(-82.3826 38.1476)',4326), null, null, '2021-03-12T15:43:53.463468Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03206790'),
+('dc7c4258-3441-44ea-a201-83890cc6b05a', False, 'wayne', 'Wayne', ST_GeomFromText('POINT(-82.4347 38.2486)',4326), null, null, '2021-03-12T15:43:53.463679Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03207020'),
+('93c464a9-0e8d-4adb-bc79-506f133b3643', False, 'prestonsburg', 'Prestonsburg', ST_GeomFromText('POINT(-82.7772 37.6708)',4326), null, null, '2021-03-12T15:43:53.463864Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03209800'),
+('ce28e6ee-76fa-4920-843a-cc6aa88202c8', False, 'deercr-lake', 'DeerCr-Lake', ST_GeomFromText('POINT(-83.215 39.6222)',4326), null, null, '2021-03-12T15:43:53.464051Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('ad266bbb-b5c1-44b1-a0e0-c6c9669c57b7', False, 'deercr-outflow', 'DeerCr-Outflow', ST_GeomFromText('POINT(-83.2123 39.6206)',4326), null, null, '2021-03-12T15:43:53.464204Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03230900'),
+('aa9e7c4b-ce91-4222-af49-60bd7faa987a', False, 'prospect', 'Prospect', ST_GeomFromText('POINT(-83.1972 40.4194)',4326), null, null, '2021-03-12T15:43:53.464416Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03219500'),
+('811ac9bf-023a-4bc7-a361-648c100329c6', False, 'racineld', 'RacineLD', ST_GeomFromText('POINT(-81.9122 38.9169)',4326), null, null, '2021-03-12T15:43:53.464593Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03159870'),
+('59b61eef-5b30-453a-8d7b-dad9bbbf5aff', False, 'racineld-pool', 'RacineLD-Pool', ST_GeomFromText('POINT(-81.9097 38.9148)',4326), null, null, '2021-03-12T15:43:53.464593Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('97b8b79f-2f7a-4ba6-b942-a41fc095ec89', False, 'racineld-tailwater', 'RacineLD-Tailwater', ST_GeomFromText('POINT(-81.9108 38.9175)',4326), null, null, '2021-03-12T15:43:53.464593Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('837bbf54-7f8f-4b97-9e4a-e2af92ac5e65', False, 'dresden', 'Dresden', ST_GeomFromText('POINT(-81.9992 40.1203)',4326), null, null, '2021-03-12T15:43:53.464811Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03144500'),
+('bf7d0d66-7119-4684-bef5-2f5799e57021', False, 'blaine', 'Blaine', ST_GeomFromText('POINT(-82.8461 38.0294)',4326), null, null, '2021-03-12T15:43:53.464955Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03215370'),
+('ce2ebb71-2798-4afb-9ff1-9750474ae748', False, 'bradshaw', 'Bradshaw', ST_GeomFromText('POINT(-81.8027 37.3952)',4326), null, null, '2021-03-12T15:43:53.465085Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03212980'),
+('de609c4f-401f-4b6f-8a2c-0b7f40ae17f5', False, 'clintwood', 'Clintwood', ST_GeomFromText('POINT(-82.4389 37.1239)',4326), null, null, '2021-03-12T15:43:53.465255Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208950'),
+('8213ac1f-670c-43ef-888b-ed90bb1c09e9', False, 'harts', 'Harts', ST_GeomFromText('POINT(-82.14 38.0383)',4326), null, null, '2021-03-12T15:43:53.465433Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('311112a7-9974-4ed0-824d-269c68a4fab2', False, 'hanover', 'Hanover', ST_GeomFromText('POINT(-81.8215 37.5852)',4326), null, null, '2021-03-12T15:43:53.465583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03202928'),
+('8287a3a6-e894-44e8-a89f-fa4bbb671711', False, 'logan', 'Logan', ST_GeomFromText('POINT(-81.9761 37.8422)',4326), null, null, '2021-03-12T15:43:53.465705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03203600'),
+('926bef8a-2909-44b4-9317-8d743d11fff4', False, 'man', 'Man', ST_GeomFromText('POINT(-81.8767 37.7403)',4326), null, null, '2021-03-12T15:43:53.465890Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03203000'),
+('e2dce514-10fb-4f2c-b721-099d1392d007', False, 'palermo', 'Palermo', ST_GeomFromText('POINT(-82.0586 38.165)',4326), null, null, '2021-03-12T15:43:53.466048Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03204250'),
+('2a6efe33-e2ae-4538-9bcb-74e155c89699', False, 'mohawk-lake', 'Mohawk-Lake', ST_GeomFromText('POINT(-82.0878 40.3525)',4326), null, null, '2021-03-12T15:43:53.466170Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('d18f6158-8f1a-414f-81c1-2b6a8657cf5b', False, 'paintsvilleky', 'PaintsvilleKY', ST_GeomFromText('POINT(-82.7906 37.8172)',4326), null, null, '2021-03-12T15:43:53.466316Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03212500'),
+('f76d5f86-00f5-495f-8cca-83f4788c0d8d', False, 'kopperston', 'Kopperston', ST_GeomFromText('POINT(-81.575 37.7583)',4326), null, null, '2021-03-12T15:43:53.466497Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('1295f88c-0f08-4c96-ab53-d4da9b11bb57', False, 'virgie', 'Virgie', ST_GeomFromText('POINT(-82.5444 37.3864)',4326), null, null, '2021-03-12T15:43:53.466652Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '371954082350601'),
+('5619b90e-ec2a-4f9c-829e-0b467cb1ac08', False, 'walhonding', 'Walhonding', ST_GeomFromText('POINT(-81.9069 40.3092)',4326), null, null, '2021-03-12T15:43:53.466824Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03139850'),
+('b7778bca-28e3-4308-b104-f5cc643668ff', False, 'washingtoncourth', 'WashingtonCourtH', ST_GeomFromText('POINT(-83.4531 39.5303)',4326), null, null, '2021-03-12T15:43:53.466974Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('ae1920d9-5aec-44d9-afda-5f5a104795d8', False, 'buckeye', 'Buckeye', ST_GeomFromText('POINT(-80.1308 38.1858)',4326), null, null, '2021-03-12T15:43:53.467145Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03182500'),
+('c8688c1e-ec68-4dc5-9ac8-8e5ef5a93028', False, 'camdenongauley', 'CamdenOnGauley', ST_GeomFromText('POINT(-80.6011 38.3658)',4326), null, null, '2021-03-12T15:43:53.467295Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03187000'),
+('d807f0a9-fb2c-43cb-9724-1b81e0dbf172', False, 'clay', 'Clay', ST_GeomFromText('POINT(-81.0875 38.4606)',4326), null, null, '2021-03-12T15:43:53.467387Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03196800'),
+('5c216ac6-9197-4bd9-b78d-23e1d27efd37', False, 'craigsville', 'Craigsville', ST_GeomFromText('POINT(-80.6411 38.2911)',4326), null, null, '2021-03-12T15:43:53.467523Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03189100'),
+('a9925ee0-02a0-4af9-bdf2-fe716e46601b', False, 'cranberryr', 'CranberryR', ST_GeomFromText('POINT(-80.5267 38.2953)',4326), null, null, '2021-03-12T15:43:53.467732Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03187500'),
+('9cb372ec-b7c8-477f-8ebf-1aee45ee71a3', False, 'ennice', 'Ennice', ST_GeomFromText('POINT(-80.9781 36.4997)',4326), null, null, '2021-03-12T15:43:53.467904Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '363000080584000'),
+('37af1a41-df37-439c-b590-1706f136ec57', False, 'fallsmills', 'FallsMills', ST_GeomFromText('POINT(-81.305 37.2714)',4326), null, null, '2021-03-12T15:43:53.468021Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03177710'),
+('4d49b17c-5ae0-43d3-a72b-287b7c584214', False, 'frametown', 'Frametown', ST_GeomFromText('POINT(-80.8994 38.5663)',4326), null, null, '2021-03-12T15:43:53.468109Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03196600'),
+('66ac52cb-8638-4ea3-a1cd-ea0dd364962b', False, 'galax', 'Galax', ST_GeomFromText('POINT(-80.9792 36.6472)',4326), null, null, '2021-03-12T15:43:53.468276Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03164000'),
+('415e191e-b80d-4cbb-90fd-cfef48f02187', False, 'glenlynn', 'GlenLynn', ST_GeomFromText('POINT(-80.8608 37.3728)',4326), null, null, '2021-03-12T15:43:53.468457Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03176500'),
+('f4a319bf-b7be-4596-95a1-39e4f490cbdd', False, 'graysontown', 'Graysontown', ST_GeomFromText('POINT(-80.5569 37.0375)',4326), null, null, '2021-03-12T15:43:53.468627Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03170000'),
+('3c02eae0-d060-479b-b383-543dc40fa2a2', False, 'guardian', 'Guardian', ST_GeomFromText('POINT(-80.4658 38.6353)',4326), null, null, '2021-03-12T15:43:53.468773Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03195100'),
+('6d841e08-cf66-439f-bd56-ddb9e2b0b8ad', False, 'herold', 'Herold', ST_GeomFromText('POINT(-80.8011 38.5747)',4326), null, null, '2021-03-12T15:43:53.468945Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03196500'),
+('182ba331-68b7-42d2-be0d-2aa31e511d72', False, 'hilldale', 'Hilldale', ST_GeomFromText('POINT(-80.8055 37.64)',4326), null, null, '2021-03-12T15:43:53.469090Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03184000'),
+('83f4d735-6cce-46e0-a0b3-7daf43725966', False, 'hinton', 'Hinton', ST_GeomFromText('POINT(-80.8927 37.671)',4326), null, null, '2021-03-12T15:43:53.469234Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03184500'),
+('11be5638-32b0-446c-8a44-4c44b0f00dc4', False, 'webstersprings', 'WebsterSprings', ST_GeomFromText('POINT(-80.4889 38.5983)',4326), null, null, '2021-03-12T15:43:53.469413Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03194700'),
+('f4139b77-ca77-4e75-9a88-614950852fa0', False, 'whitesville', 'Whitesville', ST_GeomFromText('POINT(-81.5243 37.9662)',4326), null, null, '2021-03-12T15:43:53.469584Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03198350'),
+('055eee13-302e-421c-bf7d-05b280bca6b1', False, 'whsulphursprgs', 'WhSulphurSprgs', ST_GeomFromText('POINT(-80.3333 37.7667)',4326), null, null, '2021-03-12T15:43:53.469677Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('9107922b-7346-4a41-b6a1-1b4be6d461c8', False, 'willis', 'Willis', ST_GeomFromText('POINT(-80.4819 36.8617)',4326), null, null, '2021-03-12T15:43:53.469813Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '365133080285301'),
+('e2b0a4cc-9e7b-4cbf-b336-8511508ebb35', False, 'willowton', 'Willowton', ST_GeomFromText('POINT(-80.8861 37.3485)',4326), null, null, '2021-03-12T15:43:53.469992Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03177120'),
+('4db83962-32b4-49b7-b888-1b48976e3e14', False, 'winfieldld', 'WinfieldLD', ST_GeomFromText('POINT(-81.9136 38.5269)',4326), null, null, '2021-03-12T15:43:53.470111Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03201300'),
+('86fdbca8-e7b6-4dac-a5cf-dac93f04275b', False, 'winfieldld-tailwater', 'WinfieldLD-Tailwater', ST_GeomFromText('POINT(-81.9169 38.5261)',4326), null, null, '2021-03-12T15:43:53.470111Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('d7acb6ea-d008-4d8a-a33b-f216ff1600e0', False, 'winfieldld-pool', 'WinfieldLD-Pool', ST_GeomFromText('POINT(-81.9116 38.5254)',4326), null, null, '2021-03-12T15:43:53.470111Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('62443c3a-e4b0-42a9-a61f-ba0379a06041', False, 'coshocton', 'Coshocton', ST_GeomFromText('POINT(-81.8727 40.248)',4326), null, null, '2021-03-12T15:43:53.470431Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03140500'),
+('6b093d52-3f06-48b6-8174-617c3aac0fbe', False, 'atwood-lake', 'Atwood-Lake', ST_GeomFromText('POINT(-81.285 40.5267)',4326), null, null, '2021-03-12T15:43:53.470577Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03121000'),
+('471582a8-cff0-4073-a4e4-fba5191696aa', False, 'centerfield', 'Centerfield', ST_GeomFromText('POINT(-83.4752 39.329)',4326), null, null, '2021-03-12T15:43:53.470785Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03232300'),
+('a6bfa75f-9b78-4647-bdd5-da53c5b45d99', False, 'centralcollege', 'CentralCollege', ST_GeomFromText('POINT(-82.8836 40.1027)',4326), null, null, '2021-03-12T15:43:53.470971Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03228500'),
+('33e25e11-462c-448f-be03-f5475b536b9f', False, 'chillicothe', 'Chillicothe', ST_GeomFromText('POINT(-82.9711 39.3413)',4326), null, null, '2021-03-12T15:43:53.471183Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03231500'),
+('26fe9773-323c-4d1c-9f98-440c5c8dcab4', False, 'circleville', 'Circleville', ST_GeomFromText('POINT(-82.9553 39.6014)',4326), null, null, '2021-03-12T15:43:53.471381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03230700'),
+('9dabc608-8488-483b-a8ed-7fd94107a653', False, 'beachcity-lake', 'BeachCity-Lake', ST_GeomFromText('POINT(-81.5581 40.6356)',4326), null, null, '2021-03-12T15:43:53.471616Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('1889ac8d-ef26-467d-8355-2697a784c5fb', False, 'bolivar-lake', 'Bolivar-Lake', ST_GeomFromText('POINT(-81.4328 40.6486)',4326), null, null, '2021-03-12T15:43:53.471786Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('4cd98742-1d6d-4b07-9d2b-58fb5c00cbbd', False, 'saltfk-lake', 'SaltFk-Lake', ST_GeomFromText('POINT(-81.5544 40.1044)',4326), null, null, '2021-03-12T15:43:53.471964Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('22afd70a-6249-436d-9a4f-74568bbf8069', False, 'barrettsmills', 'BarrettsMills', ST_GeomFromText('POINT(-83.3856 39.2183)',4326), null, null, '2021-03-12T15:43:53.472172Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03232500'),
+('b6c6620e-9e1f-4130-9ee4-d78cd29ef79e', False, 'bourneville', 'Bourneville', ST_GeomFromText('POINT(-83.1675 39.2634)',4326), null, null, '2021-03-12T15:43:53.472347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03234000'),
+('05a4cde6-0851-40cb-b943-9c0cd46f4284', False, 'columbusalumcr', 'ColumbusAlumCr', ST_GeomFromText('POINT(-82.9411 39.945)',4326), null, null, '2021-03-12T15:43:53.472570Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03229000'),
+('8dc85528-0c42-4786-ad05-009e08f9d693', False, 'columbusscioto', 'ColumbusScioto', ST_GeomFromText('POINT(-83.0092 39.9094)',4326), null, null, '2021-03-12T15:43:53.472724Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03227500'),
+('5eb700c2-a12c-4621-bec7-e9f6e9379c7c', False, 'delaware-lake', 'Delaware-Lake', ST_GeomFromText('POINT(-83.0692 40.3592)',4326), null, null, '2021-03-12T15:43:53.472942Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('a7112693-c1d6-4b27-bc61-52c7826569e1', False, 'paintcr-lake', 'PaintCr-Lake', ST_GeomFromText('POINT(-83.355 39.2525)',4326), null, null, '2021-03-12T15:43:53.473130Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('0fac482e-623f-4543-b550-cf955f1c7ef1', False, 'paintcr-outflow', 'PaintCr-Outflow', ST_GeomFromText('POINT(-83.3494 39.2523)',4326), null, null, '2021-03-12T15:43:53.473313Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03232470'),
+('8df61bb2-ca0f-4018-b352-9dcefd50d689', False, 'claridon', 'Claridon', ST_GeomFromText('POINT(-82.9888 40.5827)',4326), null, null, '2021-03-12T15:43:53.473528Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03223000'),
+('01b82000-57ea-42c7-991c-afbcd9e34c8c', False, 'delaware-outflow', 'Delaware-Outflow', ST_GeomFromText('POINT(-83.0678 40.3558)',4326), null, null, '2021-03-12T15:43:53.473711Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03225500'),
+('57af7668-d914-4299-90ba-8c1aedfe34b5', False, 'galion', 'Galion', ST_GeomFromText('POINT(-82.7994 40.7233)',4326), null, null, '2021-03-12T15:43:53.473892Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('40d15aab-1469-477c-82b1-a080176f5e1e', False, 'athens', 'Athens', ST_GeomFromText('POINT(-82.0877 39.3288)',4326), null, null, '2021-03-12T15:43:53.474068Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03159500'),
+('047eed27-1c7b-4e82-a284-5cd0622d9194', False, 'derwent', 'Derwent', ST_GeomFromText('POINT(-81.5314 39.9189)',4326), null, null, '2021-03-12T15:43:53.474222Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03141700'),
+('d2441cad-29fa-48f8-8b89-ba7b3c704682', False, 'jwflannagan-lake', 'JWFlannagan-Lake', ST_GeomFromText('POINT(-82.3489 37.2342)',4326), null, null, '2021-03-12T15:43:53.474447Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208990'),
+('59c9f819-449b-42d0-a10c-e4191395651f', False, 'nobrkokosing-lake', 'NoBrKokosing-Lake', ST_GeomFromText('POINT(-82.5775 40.5039)',4326), null, null, '2021-03-12T15:43:53.474595Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03136300'),
+('b93a64b9-9e40-4f3f-80d2-8c7eb8d1b249', False, 'tappan-lake', 'Tappan-Lake', ST_GeomFromText('POINT(-81.2261 40.3558)',4326), null, null, '2021-03-12T15:43:53.474771Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03128000'),
+('bad69699-ffce-4daa-b7b6-6fd66d7dd11f', False, 'willscr-lake', 'WillsCr-Lake', ST_GeomFromText('POINT(-81.8497 40.1569)',4326), null, null, '2021-03-12T15:43:53.474923Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03143000'),
+('8ad1aa14-d4b4-427f-b88a-e107de71f1ab', False, 'leesville-lake', 'Leesville-Lake', ST_GeomFromText('POINT(-81.1944 40.4703)',4326), null, null, '2021-03-12T15:43:53.475120Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03120000'),
+('153e9baf-3f8f-4f49-a828-56f1b25509fd', False, 'bellevilleld', 'BellevilleLD', ST_GeomFromText('POINT(-81.7435 39.1187)',4326), null, null, '2021-03-12T15:43:53.475360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03155520'),
+('0c1146d6-8b38-43aa-8893-0de136d561b0', False, 'bellevilleld-pool', 'BellevilleLD-Pool', ST_GeomFromText('POINT(-81.74 39.1218)',4326), null, null, '2021-03-12T15:43:53.475360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('5b49cd94-ec90-42fe-8133-2166b25f0155', False, 'bellevilleld-tailwater', 'BellevilleLD-Tailwater', ST_GeomFromText('POINT(-81.7414 39.1135)',4326), null, null, '2021-03-12T15:43:53.475360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('819a690a-7600-4ed9-852f-bfb24f7d74b5', False, 'leesvilleaux', 'LeesvilleAux', ST_GeomFromText('POINT(-81.2191 40.4755)',4326), null, null, '2021-03-12T15:43:53.475614Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('5c2b7435-3e7b-4d5b-8364-ded9ab9b3b29', False, 'winfieldwq-test', 'WinfieldWQ-Test', ST_GeomFromText('POINT(-81.9118 38.5249)',4326), null, null, '2021-03-12T15:43:53.475760Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('568fe2ee-79ed-4358-bf2b-d380fca64e84', False, 'fullers', 'Fullers', ST_GeomFromText('POINT(-82.6197 38.1691)',4326), null, null, '2021-03-12T15:43:53.476132Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03215000'),
+('309f510b-c406-434c-99d7-900f0780d0ed', False, 'pipestem', 'Pipestem', ST_GeomFromText('POINT(-81.0086 37.54)',4326), null, null, '2021-03-12T15:43:53.476292Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03179000'),
+('09c49db2-8ec8-4b7e-9a3b-bf0e397e8a65', False, 'yatesville-lake', 'Yatesville-Lake', ST_GeomFromText('POINT(-82.6992 38.125)',4326), null, null, '2021-03-12T15:43:53.476474Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('f9d7457d-b590-4b6b-8994-ba77d2814ae5', False, 'graysonky', 'GraysonKY', ST_GeomFromText('POINT(-82.9394 38.33)',4326), null, null, '2021-03-12T15:43:53.476613Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03216500'),
+('53508bfe-0f25-47ba-aecf-b751e2ab6e29', False, 'paintsville-outflow', 'Paintsville-Outflow', ST_GeomFromText('POINT(-82.87 37.8364)',4326), null, null, '2021-03-12T15:43:53.476787Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03212000'),
+('a0c649f0-f75a-40c4-b28d-8af389b83d82', False, 'cambridge', 'Cambridge', ST_GeomFromText('POINT(-81.5872 40.0144)',4326), null, null, '2021-03-12T15:43:53.476970Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03142000'),
+('01965876-7c4f-499c-92b1-6c5effbe59f9', False, 'uhrichsville', 'Uhrichsville', ST_GeomFromText('POINT(-81.3466 40.3863)',4326), null, null, '2021-03-12T15:43:53.477147Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03127500'),
+('c03134a7-15e5-4824-a546-677e21bb36db', False, 'bluestone-lake', 'Bluestone-Lake', ST_GeomFromText('POINT(-80.8855 37.64)',4326), null, null, '2021-03-12T15:43:53.477324Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03179800'),
+('20f05417-ffbf-4580-b815-45b8209d7658', False, 'bluestone-outflow', 'Bluestone-Outflow', ST_GeomFromText('POINT(-80.886 37.649)',4326), null, null, '2021-03-12T15:43:53.477324Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('ac3b293a-c2cb-4340-a21d-439c32073f55', False, 'nofkpound-outflow', 'NoFkPound-Outflow', ST_GeomFromText('POINT(-82.6266 37.1257)',4326), null, null, '2021-03-12T15:43:53.477577Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208700'),
+('d1f86c44-efaf-46b9-a9e8-f3e723a8f8fe', False, 'odd', 'Odd', ST_GeomFromText('POINT(-81.1931 37.5869)',4326), null, null, '2021-03-12T15:43:53.477774Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('f7b2a9c4-262a-45fd-9812-6b2dd00a2e4d', False, 'poundlwr', 'PoundLwr', ST_GeomFromText('POINT(-82.5986 37.1225)',4326), null, null, '2021-03-12T15:43:53.477959Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208800'),
+('0cbe42ac-9b07-4f29-b966-59745bb1ddea', False, 'pineville', 'Pineville', ST_GeomFromText('POINT(-81.5375 37.5772)',4326), null, null, '2021-03-12T15:43:53.478173Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('465b5668-65d5-43b7-99c5-4d1ad3cb50ea', False, 'lavalette', 'Lavalette', ST_GeomFromText('POINT(-82.4437 38.3201)',4326), null, null, '2021-03-12T15:43:53.478422Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03207061'),
+('dc10e673-bf74-40d1-ac83-bab9002165f2', False, 'senecaville-outflow', 'Senecaville-Outflow', ST_GeomFromText('POINT(-81.4406 39.9247)',4326), null, null, '2021-03-12T15:43:53.478698Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03141500'),
+('592e5ca3-db8b-484a-b62c-bec7c33efa6d', False, 'dewey-outflow', 'Dewey-Outflow', ST_GeomFromText('POINT(-82.7237 37.7429)',4326), null, null, '2021-03-12T15:43:53.478937Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03211500'),
+('02ac6fc9-3499-4b6e-8242-16d01288c1f6', False, 'dewey-lake', 'Dewey-Lake', ST_GeomFromText('POINT(-82.7292 37.7356)',4326), null, null, '2021-03-12T15:43:53.479268Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03211000'),
+('db09b95b-9066-4808-ba65-da9fef60bdaf', False, 'gary', 'Gary', ST_GeomFromText('POINT(-81.562 37.3837)',4326), null, null, '2021-03-12T15:43:53.479603Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('0ca172aa-0eb4-4129-84ae-6b425f50bf42', False, 'haysi', 'Haysi', ST_GeomFromText('POINT(-82.2963 37.2079)',4326), null, null, '2021-03-12T15:43:53.479799Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03208500'),
+('696dfff1-f20f-4e0f-94e9-4aca932e5153', False, 'jwflannagan-outflow', 'JWFlannagan-Outflow', ST_GeomFromText('POINT(-82.3429 37.2371)',4326), null, null, '2021-03-12T15:43:53.480104Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '0320900'),
+('bfb47627-f9aa-4df2-940d-4ae1c1a30446', False, 'paintsville-lake', 'Paintsville-Lake', ST_GeomFromText('POINT(-82.8742 37.8403)',4326), null, null, '2021-03-12T15:43:53.480441Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('d7161dd4-a188-4b06-9213-e7d167010818', False, 'pikeville', 'Pikeville', ST_GeomFromText('POINT(-82.5261 37.465)',4326), null, null, '2021-03-12T15:43:53.480769Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03209500'),
+('e9630e90-66fd-40c5-872d-d6ec6cb80405', False, 'sutton-outflow', 'Sutton-Outflow', ST_GeomFromText('POINT(-80.7094 38.6627)',4326), null, null, '2021-03-12T15:43:53.481125Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03195500'),
+('a6c4adb6-41ec-48af-be7d-e1ac39fca5c6', False, 'thurmond', 'Thurmond', ST_GeomFromText('POINT(-81.0767 37.955)',4326), null, null, '2021-03-12T15:43:53.481499Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03185400'),
+('43451f65-4494-4f57-8190-95a3d7b11f53', False, 'troutsdale', 'Troutsdale', ST_GeomFromText('POINT(-81.4401 36.7019)',4326), null, null, '2021-03-12T15:43:53.481847Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '363703081224101'),
+('15851b24-2e23-4ae3-afc6-425a293e1e70', False, 'atwood-outflow', 'Atwood-Outflow', ST_GeomFromText('POINT(-81.2906 40.5244)',4326), null, null, '2021-03-12T15:43:53.482170Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03121500'),
+('dd0e7b3a-3462-4cd8-8d7a-1c3bf070627b', False, 'belva', 'Belva', ST_GeomFromText('POINT(-81.1861 38.2325)',4326), null, null, '2021-03-12T15:43:53.482477Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03192000'),
+('f753802e-bc48-42f3-b4ca-13863db82dae', False, 'birchr', 'BirchR', ST_GeomFromText('POINT(-80.75 38.5)',4326), null, null, '2021-03-12T15:43:53.482740Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('13e869ce-5b25-48dc-b425-bb47e66ca1dd', False, 'bland', 'Bland', ST_GeomFromText('POINT(-81.1067 37.105)',4326), null, null, '2021-03-12T15:43:53.482973Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '370609081071801'),
+('cf793fce-843f-4783-b39f-e4e8923f6655', False, 'charlestonlk6', 'CharlestonLk6', ST_GeomFromText('POINT(-81.6696 38.3659)',4326), null, null, '2021-03-12T15:43:53.483251Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03198000'),
+('495906ec-9141-4e5f-89de-7bb50470ca91', False, 'enterprise', 'Enterprise', ST_GeomFromText('POINT(-82.475 39.565)',4326), null, null, '2021-03-12T15:43:53.483696Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03157500'),
+('23d961f0-a11d-4fc3-af2b-ee01c0891ac9', False, 'glouster', 'Glouster', ST_GeomFromText('POINT(-82.0847 39.5)',4326), null, null, '2021-03-12T15:43:53.483930Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '0315900'),
+('0c4be987-161c-40e2-bb80-4860dcabe162', False, 'newlexington', 'NewLexington', ST_GeomFromText('POINT(-82.2156 39.7331)',4326), null, null, '2021-03-12T15:43:53.484122Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('4fbd6a41-9350-4117-97e1-ec27b413fa6b', False, 'tomjenkinsaux', 'TomJenkinsAux', ST_GeomFromText('POINT(-82.0717 39.5251)',4326), null, null, '2021-03-12T15:43:53.484320Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03158510'),
+('7a899456-2646-4071-aea0-72c5174fceb6', False, 'tomjenkins-lake', 'TomJenkins-Lake', ST_GeomFromText('POINT(-82.0567 39.5408)',4326), null, null, '2021-03-12T15:43:53.484520Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03158500'),
+('5f5e3007-7911-4c28-a616-bd25e91397da', False, 'mohicanville-lake', 'Mohicanville-Lake', ST_GeomFromText('POINT(-82.1519 40.7242)',4326), null, null, '2021-03-12T15:43:53.484780Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('32eb8c67-fdbd-467f-a196-beb9e9ae2fd3', False, 'mohicanville-outflow', 'Mohicanville-Outflow', ST_GeomFromText('POINT(-82.1547 40.7235)',4326), null, null, '2021-03-12T15:43:53.484981Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03135000'),
+('a5c8b019-f157-4d61-9309-93fd8a562595', False, 'newark', 'Newark', ST_GeomFromText('POINT(-82.3385 40.0591)',4326), null, null, '2021-03-12T15:43:53.485190Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03146500'),
+('8975d061-f13e-4b91-ba65-017fd6384ecc', False, 'newphiladelphia', 'NewPhiladelphia', ST_GeomFromText('POINT(-81.4483 40.4805)',4326), null, null, '2021-03-12T15:43:53.485499Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03124800'),
+('27d3fe59-e4b9-45eb-9e5c-6764f6dc6951', False, 'willscr-outflow', 'WillsCr-Outflow', ST_GeomFromText('POINT(-81.8475 40.1591)',4326), null, null, '2021-03-12T15:43:53.485731Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03143500'),
+('d583cb47-9277-4f07-94fc-91af419f3130', False, 'wooster', 'Wooster', ST_GeomFromText('POINT(-81.9197 40.7814)',4326), null, null, '2021-03-12T15:43:53.485989Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('1d946714-3530-48c2-b04e-aab4902b6932', False, 'zanesville', 'Zanesville', ST_GeomFromText('POINT(-82.0125 39.94)',4326), null, null, '2021-03-12T15:43:53.486232Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03148000'),
+('a058a284-c49b-4884-828c-2d179ed1bb0b', False, 'zoar-dam', 'Zoar-Dam', ST_GeomFromText('POINT(-81.4177 40.6138)',4326), null, null, '2021-03-12T15:43:53.486524Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('8cdf2362-2e40-4aae-a3a0-1bb18269b73b', False, 'greenupld', 'GreenupLD', ST_GeomFromText('POINT(-82.8608 38.6475)',4326), null, null, '2021-03-12T15:43:53.486860Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03216600'),
+('ce360feb-710a-4fea-8ebc-92105c3be8d0', False, 'greenupld-pool', 'GreenupLD-Pool', ST_GeomFromText('POINT(-82.86 38.6456)',4326), null, null, '2021-03-12T15:43:53.486860Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('720158ae-d06d-4237-8bd7-04335388bdfb', False, 'greenupld-tailwater', 'GreenupLD-Tailwater', ST_GeomFromText('POINT(-82.86 38.6496)',4326), null, null, '2021-03-12T15:43:53.486860Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('ac5ea8fb-47dd-4fc9-b9f5-e12a57eb5886', False, 'huntington', 'Huntington', ST_GeomFromText('POINT(-82.5006 38.4133)',4326), null, null, '2021-03-12T15:43:53.487337Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03206000'),
+('169e0a7c-8b8d-4f56-a40b-0f5a4ff61208', False, 'marietta', 'Marietta', ST_GeomFromText('POINT(-81.4577 39.4094)',4326), null, null, '2021-03-12T15:43:53.487551Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03150700'),
+('12245162-29de-4fe7-854d-85ab6b49d137', False, 'parkersburg', 'Parkersburg', ST_GeomFromText('POINT(-81.5639 39.2681)',4326), null, null, '2021-03-12T15:43:53.487842Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03151000'),
+('f3786959-d051-44b7-b7de-47949038c14b', False, 'pointpleasant', 'PointPleasant', ST_GeomFromText('POINT(-82.1392 38.8439)',4326), null, null, '2021-03-12T15:43:53.488088Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03201500'),
+('b50f9089-9c58-4d3a-b2bc-cf6887b45719', False, 'portsmouth', 'Portsmouth', ST_GeomFromText('POINT(-82.9931 38.7278)',4326), null, null, '2021-03-12T15:43:53.488327Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03217200'),
+('92385c9d-8691-4b56-b420-d435629bf4ef', False, 'rcbyrdld', 'RCByrdLD', ST_GeomFromText('POINT(-82.1869 38.6819)',4326), null, null, '2021-03-12T15:43:53.488522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('3ed61398-0204-4e41-b7b0-515abd4ed6fb', False, 'rcbyrdld-pool', 'RCByrdLD-Pool', ST_GeomFromText('POINT(-82.1884 38.6823)',4326), null, null, '2021-03-12T15:43:53.488522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('5ee3de86-b9d7-452c-a552-bd0bba4be0e4', False, 'rcbyrdld-tailwater', 'RCByrdLD-Tailwater', ST_GeomFromText('POINT(-82.1912 38.6803)',4326), null, null, '2021-03-12T15:43:53.488522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('edd5f2e8-a946-4fb7-90a8-28fdad2959e6', False, 'alumcr-outflow', 'AlumCr-Outflow', ST_GeomFromText('POINT(-82.9615 40.1823)',4326), null, null, '2021-03-12T15:43:53.488844Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03228805'),
+('92d4ee4b-8411-47bb-b5f1-587d2dd5c26d', False, 'alumcr-lake', 'AlumCr-Lake', ST_GeomFromText('POINT(-82.9668 40.1858)',4326), null, null, '2021-03-12T15:43:53.489098Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('3dbab193-9a8a-4408-9a7a-311dee73667f', False, 'greenfield', 'Greenfield', ST_GeomFromText('POINT(-83.375 39.3792)',4326), null, null, '2021-03-12T15:43:53.489281Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03232000'),
+('2a053db3-2a3a-4596-8269-bb4a3b74f220', False, 'hillsboro', 'Hillsboro', ST_GeomFromText('POINT(-83.6092 39.2333)',4326), null, null, '2021-03-12T15:43:53.489480Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('0617593e-1aad-4aec-979f-976cd4fda805', False, 'kilbourne', 'Kilbourne', ST_GeomFromText('POINT(-82.9222 40.3566)',4326), null, null, '2021-03-12T15:43:53.489664Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03228750'),
+('9610bbf0-f690-4bfa-b5fb-f59dbc273d56', False, 'lithopolis', 'Lithopolis', ST_GeomFromText('POINT(-82.82 39.8014)',4326), null, null, '2021-03-12T15:43:53.489859Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('ab59ee47-5ed5-4cae-a4d8-199232647c9b', False, 'london', 'London', ST_GeomFromText('POINT(-83.4417 39.8767)',4326), null, null, '2021-03-12T15:43:53.490111Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('963a8aca-de2a-42fe-93da-cdab00230385', False, 'mtsterling', 'MtSterling', ST_GeomFromText('POINT(-83.2575 39.7153)',4326), null, null, '2021-03-12T15:43:53.490307Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03230800'),
+('503b6d4c-bbfd-45d7-b63b-99e0343be8fc', False, 'piketon', 'Piketon', ST_GeomFromText('POINT(-83.0196 39.0701)',4326), null, null, '2021-03-12T15:43:53.490505Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03237020'),
+('dadea930-bb8e-4ef4-a3d0-a9f462e9de73', False, 'rees', 'Rees', ST_GeomFromText('POINT(-82.9569 39.8563)',4326), null, null, '2021-03-12T15:43:53.490629Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03229500'),
+('d437867f-9b7a-44bf-abc8-e2b7b6a4c14e', False, 'wejefferson', 'WeJefferson', ST_GeomFromText('POINT(-83.2675 39.9506)',4326), null, null, '2021-03-12T15:43:53.490814Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03230310'),
+('c5b05fc0-09a9-43d6-b902-3565851ee092', False, 'williamsport', 'Williamsport', ST_GeomFromText('POINT(-83.1217 39.5853)',4326), null, null, '2021-03-12T15:43:53.491080Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03231000'),
+('d21eec60-97f7-43af-b0f2-7960859288c3', False, 'worthington', 'Worthington', ST_GeomFromText('POINT(-83.0332 40.1098)',4326), null, null, '2021-03-12T15:43:53.491312Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03226800'),
+('63832009-63d1-46b7-9fdc-3a2785e3ea4e', False, 'londonld', 'LondonLD', ST_GeomFromText('POINT(-81.3692 38.1928)',4326), null, null, '2021-03-12T15:43:53.491499Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('cd8146b4-53fd-4e72-8230-8b473af39006', False, 'londonld-pool', 'LondonLD-Pool', ST_GeomFromText('POINT(-81.3672 38.1916)',4326), null, null, '2021-03-12T15:43:53.491499Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('4b9787d6-bec2-43b4-9a7a-4f9abac4ba77', False, 'londonld-tailwater', 'LondonLD-Tailwater', ST_GeomFromText('POINT(-81.3718 38.1938)',4326), null, null, '2021-03-12T15:43:53.491499Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('258f7dbd-e0d0-4fd7-9c6c-c5ac8dc63273', False, 'ivanhoe', 'Ivanhoe', ST_GeomFromText('POINT(-80.9523 36.8349)',4326), null, null, '2021-03-12T15:43:53.491780Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03165500'),
+('783b222e-1598-4717-851a-90c7fde7e80e', False, 'jefferson', 'Jefferson', ST_GeomFromText('POINT(-81.4074 36.3947)',4326), null, null, '2021-03-12T15:43:53.491900Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03161000'),
+('7e2ed6b7-8346-4178-8cee-4ea3c5d38e26', False, 'littleriverres', 'LittleRiverRes', ST_GeomFromText('POINT(-80.5726 37.0776)',4326), null, null, '2021-03-12T15:43:53.492024Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03170500'),
+('a5c40ef1-edb4-42c4-9aec-429e94c51dd8', False, 'iridiumtest', 'IridiumTest', ST_GeomFromText('POINT(-82.1858 38.6844)',4326), null, null, '2021-03-12T15:43:53.492125Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('252545f0-47bc-4123-8ec8-dde65fadb847', False, 'pleasanthill-outflow', 'PleasantHill-Outflow', ST_GeomFromText('POINT(82.3238 40.6205)',4326), null, null, '2021-03-12T15:43:53.492211Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03133500'),
+('4fdc5656-ddd8-483c-9caf-be736b6cc077', False, 'dover-lake', 'Dover-Lake', ST_GeomFromText('POINT(-81.413 40.5578)',4326), null, null, '2021-03-12T15:43:53.492371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('0e7dfdc6-5ea3-4296-a4d8-959b469e1058', False, 'rockbridge', 'Rockbridge', ST_GeomFromText('POINT(-82.5786 39.5883)',4326), null, null, '2021-03-12T15:43:53.492532Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03157000'),
+('70cc718a-7676-4480-ad91-f212df9d19ba', False, 'hawksnest', 'HawksNest', ST_GeomFromText('POINT(-81.1425 38.1136)',4326), null, null, '2021-03-12T15:43:53.492645Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '380649081083301'),
+('df03dab4-5dbb-4336-bc58-08d37406d929', False, 'hanniballd', 'HannibalLD', ST_GeomFromText('POINT(-80.8661 39.6672)',4326), null, null, '2021-03-12T15:43:53.492812Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03114280'),
+('43185275-845f-432d-a62d-cc37401d8a4b', False, 'hanniballd-pool', 'HannibalLD-Pool', ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T15:43:53.492812Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('f2ac8b70-c4a0-46d4-9816-ca4b469ff4d8', False, 'hanniballd-tailwater', 'HannibalLD-Tailwater', ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T15:43:53.492812Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('7583d6f6-f0c3-4329-ab4e-ab1301a2cc91', False, 'claytor-lake', 'Claytor-Lake', ST_GeomFromText('POINT(-80.5847 37.0744)',4326), null, null, '2021-03-12T15:43:53.492984Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03169000'),
+('b7975b65-407d-4134-9e3a-b3e5fbb508dc', False, 'dillon-outflow', 'Dillon-Outflow', ST_GeomFromText('POINT(-82.0788 39.9898)',4326), null, null, '2021-03-12T15:43:53.493146Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03147500'),
+('d3de96c7-9f6f-4821-a936-86edb0130ad5', False, 'dublin', 'Dublin', ST_GeomFromText('POINT(-83.1206 40.1408)',4326), null, null, '2021-03-12T15:43:53.493356Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03221000'),
+('0ecaceb0-061f-4918-9ada-ecf66a52dbc2', False, 'paintsville-outflow-iridium', 'Paintsville-Outflow-Iridium', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T15:43:53.493546Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('72b5faf6-e0c4-4ebc-8dd1-5f671342bd58', False, 'willowislandld', 'WillowIslandLD', ST_GeomFromText('POINT(-81.3208 39.3603)',4326), null, null, '2021-03-12T15:43:53.493673Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('63ef3999-a2e8-4ce3-af9e-424100931b86', False, 'willowislandld-pool', 'WillowIslandLD-Pool', ST_GeomFromText('POINT(-81.3179 39.3628)',4326), null, null, '2021-03-12T15:43:53.493673Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('93c34b08-dd7b-49de-b0f5-08a3dc32270f', False, 'willowislandld-tailwater', 'WillowIslandLD-Tailwater', ST_GeomFromText('POINT(-81.3221 39.3607)',4326), null, null, '2021-03-12T15:43:53.493673Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('c3632127-8ed6-4a4a-9246-8541e85a7c70', False, 'replete', 'Replete', ST_GeomFromText('POINT(-80.4336 38.6886)',4326), null, null, '2021-03-12T15:43:53.494117Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03195250'),
+('d0a89cec-68f8-4a7b-9668-5e64fa00f0e6', False, 'georgetownoh', 'GeorgetownOH', ST_GeomFromText('POINT(-83.9283 38.9197)',4326), null, null, '2021-03-12T15:43:53.494346Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03238495'),
+('8b078de0-8a3a-47e5-a1e8-4a4d782ce09a', False, 'bloomfieldoh', 'BloomfieldOH', ST_GeomFromText('POINT(-81.2039 39.5631)',4326), null, null, '2021-03-12T15:43:53.494435Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03115400'),
+('6c396909-aeb0-43b2-9c59-4055ed19a73a', False, 'westunionoh', 'WestUnionOH', ST_GeomFromText('POINT(-83.4211 38.8036)',4326), null, null, '2021-03-12T15:43:53.494549Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03237500'),
+('9fd1525e-d5c0-4aa6-ae27-cd46c48e6efe', False, 'middleislandcr', 'MiddleIslandCr', ST_GeomFromText('POINT(-80.9976 39.4746)',4326), null, null, '2021-03-12T15:43:53.494643Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03114500'),
+('65545a7d-455e-48f0-8c21-6ae74c713439', False, 'ashland', 'Ashland', ST_GeomFromText('POINT(-82.6375 38.4814)',4326), null, null, '2021-03-12T15:43:53.494748Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03216000'),
+('15fdf592-7d91-49b5-b18e-5ea0a78bb88a', False, 'maysville', 'Maysville', ST_GeomFromText('POINT(-83.7658 38.6494)',4326), null, null, '2021-03-12T15:43:53.494950Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03238000'),
+('534298f7-f968-4aeb-8e2e-75ea96154ae4', False, 'captameldahlld', 'CaptAMeldahlLD', ST_GeomFromText('POINT(-84.1733 38.7975)',4326), null, null, '2021-03-12T15:43:53.495148Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03238680'),
+('d59a3f0d-4d8d-4ac5-8006-11e9b614a207', False, 'captameldahlld-pool', 'CaptAMeldahlLD-Pool', ST_GeomFromText('POINT(-84.1667 38.7947)',4326), null, null, '2021-03-12T15:43:53.495148Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('ce6f1bdc-4767-4eca-9315-76850b526031', False, 'captameldahlld-tailwater', 'CaptAMeldahlLD-Tailwater', ST_GeomFromText('POINT(-84.1788 38.7965)',4326), null, null, '2021-03-12T15:43:53.495148Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('4c2c6dc9-8059-4ebe-ba43-cbaf0b611e2e', False, 'frazeysburg', 'Frazeysburg', ST_GeomFromText('POINT(-82.1481 40.1325)',4326), null, null, '2021-03-12T15:43:53.495523Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03144000'),
+('5eb4285c-a157-4796-ad57-bcc9a6ec9b8c', False, 'pleasanthill-lake', 'PleasantHill-Lake', ST_GeomFromText('POINT(-82.3239 40.6239)',4326), null, null, '2021-03-12T15:43:53.495697Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, '03133000'),
+('b1be9888-191f-49cc-b379-f3b6a901eb2b', False, 'racineld-iridium', 'RacineLD-Iridium', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T15:43:53.495947Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null),
+('1c534262-2a50-4c63-935c-f4f2a73c0d17', False, 'georgesfk-iridium', 'GeorgesFk-iridium', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T15:43:53.496154Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'a6e542eb-41bc-45b3-aab7-7f45004ad8d3', '00000000-0000-0000-0000-000000000000', null, null);
 
 --INSERT INSTRUMENT STATUS--
 INSERT INTO instrument_status(id, instrument_id, status_id, "time")
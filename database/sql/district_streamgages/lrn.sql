@@ -77,184 +77,184 @@ where i.project_id = '2bd0903c-6e4d-4d83-8558-1eab52079de0';
 --Ignoring MFLK2-MARTINS_FORK site/instrument, already in instruments unique list
 
 --INSERT INSTRUMENTS--COUNT:176
-INSERT INTO instrument(id, deleted, slug, name, formula, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
+INSERT INTO instrument(id, deleted, slug, name, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
  VALUES 
-('27317472-68f5-4341-9152-be4e3cd35aba', False, 'amok2-clarksr-almoky', 'AMOK2-ClarksR-AlmoKY', null, ST_GeomFromText('POINT(-88.2736 36.6917)',4326), null, null, '2021-03-12T16:00:20.196776Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03610200'),
-('ff959a3d-2723-4984-abca-f2bd03aa489d', False, 'csst1-crossvilletn', 'CSST1-CrossvilleTN', null, ST_GeomFromText('POINT(-84.9972 35.9178)',4326), null, null, '2021-03-12T16:00:20.197035Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('83b90989-01ac-443a-98a6-73579bdcd6cd', False, 'fcrt1-fortcampbelltn', 'FCRT1-FortCampbellTN', null, ST_GeomFromText('POINT(-87.5369 36.6256)',4326), null, null, '2021-03-12T16:00:20.197142Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('7c7f7d23-824b-4325-a64e-f12ce009530e', False, 'albk2-albanyky', 'ALBK2-AlbanyKY', null, ST_GeomFromText('POINT(-85.1217 36.7453)',4326), null, null, '2021-03-12T16:00:20.197232Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('0459d406-5eca-4171-9af6-dfba3a7a45c6', False, 'alpt1-wfkobeyr-alpinetn', 'ALPT1-WFkObeyR-AlpineTN', null, ST_GeomFromText('POINT(-85.1745 36.3973)',4326), null, null, '2021-03-12T16:00:20.197635Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03415000'),
-('76972f3b-4723-40c5-adb6-8c8fd05dbf86', False, 'aplv2-appalachiava', 'APLV2-AppalachiaVA', null, ST_GeomFromText('POINT(-82.7883 36.8983)',4326), null, null, '2021-03-12T16:00:20.198075Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('74b37f6e-51db-4daf-8c91-f6b4f2723de6', False, 'artt1-powellr-arthurtn', 'ARTT1-PowellR-ArthurTN', null, ST_GeomFromText('POINT(-83.6303 36.5419)',4326), null, null, '2021-03-12T16:00:20.198465Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03532000'),
-('127e78c0-dcb2-4a3e-a50a-f016f06ce941', False, 'bahk2-barkley', 'BAHK2-BARKLEY', null, ST_GeomFromText('POINT(-88.2228 37.0244)',4326), null, null, '2021-03-12T16:00:20.198902Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('cde9c93e-070e-4386-b6ce-66d539304ad4', False, 'asht1-cheatham', 'ASHT1-CHEATHAM', null, ST_GeomFromText('POINT(-87.2283 36.3228)',4326), null, null, '2021-03-12T16:00:20.199286Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('8cdac320-0eed-41df-8983-5e71f1278b08', False, 'coht1-cordell-hull', 'COHT1-CORDELL_HULL', null, ST_GeomFromText('POINT(-85.9389 36.2917)',4326), null, null, '2021-03-12T16:00:20.199683Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('aeb9ed17-50fe-42bb-acd6-9a2cf808cbba', False, 'cort1-cordell-hull', 'CORT1-CORDELL_HULL', null, ST_GeomFromText('POINT(-85.9397 36.2853)',4326), null, null, '2021-03-12T16:00:20.200060Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03418420'),
-('cc3b9f84-b4f9-4916-96b0-fc73c44d6b0d', False, 'dhtt1-dale-hollow', 'DHTT1-DALE_HOLLOW', null, ST_GeomFromText('POINT(-85.4611 63.5472)',4326), null, null, '2021-03-12T16:00:20.200496Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('8c53aaa6-aad4-497c-9286-741291847fc3', False, 'dlht1-dale-hollow', 'DLHT1-DALE_HOLLOW', null, ST_GeomFromText('POINT(-85.4564 36.5414)',4326), null, null, '2021-03-12T16:00:20.200896Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('6ba15fe7-dfcf-49c3-8230-599356d0c4b0', False, 'caft1-calfkillerr-blwspartatn', 'CAFT1-CalfkillerR-blwSpartaTN', null, ST_GeomFromText('POINT(-85.4763 35.9121)',4326), null, null, '2021-03-12T16:00:20.201311Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03419530'),
-('9375532a-4fcb-4bfc-a80c-a9a8d10aa1f2', False, 'wbnt1-millcr-nrwoodbinetn', 'WBNT1-MillCr-nrWoodbineTN', null, ST_GeomFromText('POINT(-86.719 36.1167)',4326), null, null, '2021-03-12T16:00:20.201745Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431060'),
-('4955897d-c97b-4d36-bd40-d46623c798a2', False, 'wlck2-wolf-creek', 'WLCK2-WOLF_CREEK', null, ST_GeomFromText('POINT(-85.1469 36.8683)',4326), null, null, '2021-03-12T16:00:20.202240Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('57d58971-a9a5-454b-bc24-07d5dc98ae5e', False, 'burkesville', 'Burkesville', null, ST_GeomFromText('POINT(-85.3681 36.7953)',4326), null, null, '2021-03-12T16:00:20.202636Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03414100'),
-('9c72d20a-7806-4b72-8753-a339dcf9955f', False, 'cfak2-cumberlandr-cumberlandfallsky', 'CFAK2-CumberlandR-CumberlandFallsKY', null, ST_GeomFromText('POINT(-84.3414 36.8358)',4326), null, null, '2021-03-12T16:00:20.203135Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03404500'),
-('f9d084c4-0cef-4974-88b9-969ed553da51', False, 'lbvt1-buffalor-blwlobelvilletn', 'LBVT1-BuffaloR-blwLobelvilleTN', null, ST_GeomFromText('POINT(-87.7803 35.8108)',4326), null, null, '2021-03-12T16:00:20.203623Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('b0035cd2-b103-4df1-8561-15a38d56dcc3', False, 'ltwt1-sfkcumberlandr-leatherwoodfordtn', 'LTWT1-SFkCumberlandR-LeatherwoodFordTN', null, ST_GeomFromText('POINT(-84.6694 36.4773)',4326), null, null, '2021-03-12T16:00:20.204026Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03410210'),
-('eaff91e3-8907-4aaa-96b5-24d011fd5c1f', False, 'nast1-cumberlandr-nashvilletn', 'NAST1-CumberlandR-NashvilleTN', null, ST_GeomFromText('POINT(-86.7731 36.1614)',4326), null, null, '2021-03-12T16:00:20.204497Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431500'),
-('9ea8edb4-d7c8-4a40-96ea-f0997b66e16d', False, 'shet1-duckr-shelbyvilletn', 'SHET1-DuckR-ShelbyvilleTN', null, ST_GeomFromText('POINT(-86.4625 35.4828)',4326), null, null, '2021-03-12T16:00:20.205001Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('9ab06dac-707f-48de-bec4-8e0488918b0b', False, 'smhk2-martinsfk-smithky', 'SMHK2-MartinsFk-SmithKY', null, ST_GeomFromText('POINT(-83.2869 36.7258)',4326), null, null, '2021-03-12T16:00:20.205444Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03400785'),
-('ded99a0f-330c-4cda-9417-c83bc7b24af3', False, 'ncfk2-martinsfk-harlanky', 'NCFK2-MartinsFk-HarlanKY', null, ST_GeomFromText('POINT(-83.3253 36.8447)',4326), null, null, '2021-03-12T16:00:20.205843Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03400986'),
-('0b464357-6d6b-4dc0-87ea-aa4f65aa8e9e', False, 'mnlk2-martins-fork', 'MNLK2-MARTINS_FORK', null, ST_GeomFromText('POINT(-83.255 36.7503)',4326), null, null, '2021-03-12T16:00:20.206371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03400800'),
-('cd329248-0df2-4a36-aeac-8e18b54d98f5', False, 'clat1-cumberlandr-celinatn', 'CLAT1-CumberlandR-CelinaTN', null, ST_GeomFromText('POINT(-85.5156 36.5544)',4326), null, null, '2021-03-12T16:00:20.206865Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03417500'),
-('6961517e-7f6e-4a2e-a362-d2d85362c5c1', False, 'dovt1-cumberlandr-dovertn', 'DOVT1-CumberlandR-DoverTN', null, ST_GeomFromText('POINT(-87.8389 36.4897)',4326), null, null, '2021-03-12T16:00:20.207442Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03437000'),
-('3877d14f-4d39-4369-9a63-498851e9d8cd', False, 'hntt1-cumberlandr-hunterspointtn', 'HNTT1-CumberlandR-HuntersPointTN', null, ST_GeomFromText('POINT(-86.2642 36.2992)',4326), null, null, '2021-03-12T16:00:20.207924Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03425400'),
-('346fcc23-8ed0-4e80-99b7-75c2f2261525', False, 'hpkk2-sfklittler-hopkinsvilleky', 'HPKK2-SFkLittleR-HopkinsvilleKY', null, ST_GeomFromText('POINT(-87.4814 36.8394)',4326), null, null, '2021-03-12T16:00:20.208417Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03437500'),
-('58beadda-81ea-4950-b21a-18405d67583c', False, 'stwt1-caneyfk-stonewalltn', 'STWT1-CaneyFk-StonewallTN', null, ST_GeomFromText('POINT(-85.9047 36.19)',4326), null, null, '2021-03-12T16:00:20.208904Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03424860'),
-('0d485c9a-928b-448f-9a13-814f14cefe0f', False, 'ckdt1-chickamauga', 'CKDT1-CHICKAMAUGA', null, ST_GeomFromText('POINT(-85.2294 35.1)',4326), null, null, '2021-03-12T16:00:20.209328Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('11332d06-0506-4a42-8698-c80f3aff861d', False, 'clbt1-columbiatn', 'CLBT1-ColumbiaTN', null, ST_GeomFromText('POINT(-87.0328 35.6636)',4326), null, null, '2021-03-12T16:00:20.209713Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('324034f3-e9fb-429c-b741-1d6d65ea7b4c', False, 'clmt1-coalmonttn', 'CLMT1-CoalmontTN', null, ST_GeomFromText('POINT(-85.6964 35.345)',4326), null, null, '2021-03-12T16:00:20.210160Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('5ddae2ed-a0a9-4fef-a4da-864604996342', False, 'spst1-springfieldtn', 'SPST1-SpringfieldTN', null, ST_GeomFromText('POINT(-86.8417 36.4711)',4326), null, null, '2021-03-12T16:00:20.210560Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('9a25e374-d27d-4f12-b228-7363f70a71f3', False, 'dert1-derossettn', 'DERT1-DeRossetTN', null, ST_GeomFromText('POINT(-85.2817 35.9631)',4326), null, null, '2021-03-12T16:00:20.210962Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('b30e8722-5dfb-4677-b44f-45d49e81a925', False, 'sstk2-somersetky', 'SSTK2-SomersetKY', null, ST_GeomFromText('POINT(-84.6158 37.1044)',4326), null, null, '2021-03-12T16:00:20.211346Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('9ea21f67-c8cb-48ad-9f6f-b3fc729f7b9a', False, 'sbvt1', 'SBVT1', null, ST_GeomFromText('POINT(86.4622 35.4825)',4326), null, null, '2021-03-12T16:00:20.211742Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('224d3ada-e83b-4d19-b152-05d8b099c056', False, 'isot1-isolinetn', 'ISOT1-IsolineTN', null, ST_GeomFromText('POINT(-85.0611 36.0783)',4326), null, null, '2021-03-12T16:00:20.212129Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('5b8a204e-bd38-40bd-b108-4118f962586b', False, 'onit1-oneidatn', 'ONIT1-OneidaTN', null, ST_GeomFromText('POINT(-84.5167 36.5)',4326), null, null, '2021-03-12T16:00:20.212513Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('58278b8b-54d4-4b56-8969-e6a176b900f9', False, 'whla1-wheeler', 'WHLA1-WHEELER', null, ST_GeomFromText('POINT(-87.3836 34.7625)',4326), null, null, '2021-03-12T16:00:20.212935Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('9c89f1b8-6c18-4a42-ae3d-470299b7194b', False, 'lftt1-lafayettetn', 'LFTT1-LafayetteTN', null, ST_GeomFromText('POINT(-85.0311 36.5192)',4326), null, null, '2021-03-12T16:00:20.213388Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('1cdebe4a-52d6-4356-8817-c5984ce4100f', False, 'stlt1-statesvilletn', 'STLT1-StatesvilleTN', null, ST_GeomFromText('POINT(-86.1214 36.0183)',4326), null, null, '2021-03-12T16:00:20.213764Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('9a97a37b-e18f-4cca-9d7c-137a1d3af595', False, 'pict1-pickwick', 'PICT1-PICKWICK', null, ST_GeomFromText('POINT(-88.2511 3.0681)',4326), null, null, '2021-03-12T16:00:20.214223Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('903d19ae-0f01-4bd9-b7ef-c72b6551739d', False, 'mant1-manchestertn', 'MANT1-ManchesterTN', null, ST_GeomFromText('POINT(-86.0831 35.4711)',4326), null, null, '2021-03-12T16:00:20.214610Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('652fcd72-0891-481d-9fa1-7aa96508d0a0', False, 'mnyt1-montereytn', 'MNYT1-MontereyTN', null, ST_GeomFromText('POINT(-85.3 36.1333)',4326), null, null, '2021-03-12T16:00:20.214981Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('64a28953-4f08-4eab-baf5-11b49dc7f957', False, 'lvlt1', 'LVLT1', null, ST_GeomFromText('POINT(87.7747 35.7828)',4326), null, null, '2021-03-12T16:00:20.215358Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('f72eacc7-7a2d-4bf8-827c-a81d80952183', False, 'grtt1-great-falls', 'GRTT1-GREAT_FALLS', null, ST_GeomFromText('POINT(-85.6336 35.8081)',4326), null, null, '2021-03-12T16:00:20.215734Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('9a6682e4-92fd-4d67-87ea-59ffd0bec6ce', False, 'ptst1-petrostn', 'PTST1-PetrosTN', null, ST_GeomFromText('POINT(-84.4461 36.0989)',4326), null, null, '2021-03-12T16:00:20.216108Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('f25498d3-d819-4a59-862d-769f2aba0e74', False, 'newt1-newcombtn', 'NEWT1-NewcombTN', null, ST_GeomFromText('POINT(-84.1731 36.5444)',4326), null, null, '2021-03-12T16:00:20.216509Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('e401d6d3-8111-4f04-8c90-6ccf2d269bc2', False, 'gvda1-guntersville', 'GVDA1-GUNTERSVILLE', null, ST_GeomFromText('POINT(-86.3933 34.4211)',4326), null, null, '2021-03-12T16:00:20.216909Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('7e2ef036-c14a-4e0a-9528-1bcfbaa84219', False, 'grhk2-mckeeky', 'GRHK2-McKeeKY', null, ST_GeomFromText('POINT(-83.9261 37.3842)',4326), null, null, '2021-03-12T16:00:20.217352Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('0a1c5198-b7bd-4090-bc0c-89f44635d8eb', False, 'snnt1', 'SNNT1', null, ST_GeomFromText('POINT(88.2097 35.1797)',4326), null, null, '2021-03-12T16:00:20.217730Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('c9d371d7-9933-484f-853b-cc7483011c0d', False, 'wsda1-wilson', 'WSDA1-WILSON', null, ST_GeomFromText('POINT(-87.625 34.7925)',4326), null, null, '2021-03-12T16:00:20.218149Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('c856ec08-c7f1-4d20-8804-481da266339d', False, 'bcht1-beechr-nrlexingtontn', 'BCHT1-BeechR-nrLexingtonTN', null, ST_GeomFromText('POINT(-88.4147 35.6342)',4326), null, null, '2021-03-12T16:00:20.218537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03594421'),
-('9b52884d-a446-4a1a-8db2-6d73aed87d66', False, 'prvt1-tennesseer-perryvilletn', 'PRVT1-TennesseeR-PerryvilleTN', null, ST_GeomFromText('POINT(-88.0389 35.6247)',4326), null, null, '2021-03-12T16:00:20.218958Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('cb6708b0-9599-470a-8460-411c3969ab01', False, 'hmlt1-duckr-abvhurricanemillstn', 'HMLT1-DuckR-abvHurricaneMillsTN', null, ST_GeomFromText('POINT(-87.7431 35.9297)',4326), null, null, '2021-03-12T16:00:20.219426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('d40d94c2-4b9c-411f-82d2-e48ad6d1119b', False, 'vert1-pineyr-vernontn', 'VERT1-PineyR-VernonTN', null, ST_GeomFromText('POINT(-87.5014 35.8711)',4326), null, null, '2021-03-12T16:00:20.219839Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03602500'),
-('0b5abf6f-d9e5-42fb-bf70-8965a8da9965', False, 'fltt1-buffalor-nrflatwoodstn', 'FLTT1-BuffaloR-nrFlatWoodsTN', null, ST_GeomFromText('POINT(-87.8328 35.4958)',4326), null, null, '2021-03-12T16:00:20.220271Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03604000'),
-('2a5999e7-03b5-45e3-9161-215e4fdd8167', False, 'jpht1-j-percy-priest', 'JPHT1-J_PERCY_PRIEST', null, ST_GeomFromText('POINT(-86.6194 36.1489)',4326), null, null, '2021-03-12T16:00:20.220815Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('f8acc6f8-9158-482d-9074-6f2c04f402a0', False, 'jppt1-j-percy-priest', 'JPPT1-J_PERCY_PRIEST', null, ST_GeomFromText('POINT(-86.6181 36.1567)',4326), null, null, '2021-03-12T16:00:20.221264Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('184e1fa0-dd7b-4ef3-a571-1708ca60874d', False, 'blwk2-rockcastler-billowsky', 'BLWK2-RockcastleR-BillowsKY', null, ST_GeomFromText('POINT(-84.2967 37.1711)',4326), null, null, '2021-03-12T16:00:20.221657Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03406500'),
-('0badc723-222f-47e1-a723-8ccd56363484', False, 'bygt1-wolfr-byrdstowntn', 'BYGT1-WolfR-ByrdstownTN', null, ST_GeomFromText('POINT(-85.0728 36.8631)',4326), null, null, '2021-03-12T16:00:20.222133Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03416000'),
-('7fabbbeb-74da-4853-af05-497eb59b17ac', False, 'cclk2-cranks-creek', 'CCLK2-CRANKS_CREEK', null, ST_GeomFromText('POINT(-83.2383 36.7394)',4326), null, null, '2021-03-12T16:00:20.222612Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('b11b1374-9353-4247-9366-b03ec33097bc', False, 'cdzk2-littler-cadizky', 'CDZK2-LittleR-CadizKY', null, ST_GeomFromText('POINT(-87.7217 36.7778)',4326), null, null, '2021-03-12T16:00:20.223034Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03438000'),
-('94945eaa-d3ac-4c69-8fe7-314607ef41b2', False, 'last1-efkstonesr-lascassastn', 'LAST1-EFkStonesR-LascassasTN', null, ST_GeomFromText('POINT(-86.3336 35.9183)',4326), null, null, '2021-03-12T16:00:20.223525Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03427500'),
-('49a8d0e0-08c1-46ff-838f-51812f118415', False, 'jagt1-efkobeyr-jamestowntn', 'JAGT1-EFkObeyR-JamestownTN', null, ST_GeomFromText('POINT(-85.0261 36.4158)',4326), null, null, '2021-03-12T16:00:20.223992Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('1afa8d2f-8d7e-4ca9-9b17-d49d11cdf84a', False, 'cfkt1-clearfkr-nrrobinstn', 'CFKT1-ClearFkR-nrRobinsTN', null, ST_GeomFromText('POINT(-84.6302 36.3882)',4326), null, null, '2021-03-12T16:00:20.224378Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03409500'),
-('52fa325e-25ef-4d95-9253-a31bf613afd4', False, 'frat1-harpethr-franklintn', 'FRAT1-HarpethR-FranklinTN', null, ST_GeomFromText('POINT(-86.8656 35.9211)',4326), null, null, '2021-03-12T16:00:20.224848Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03432350'),
-('f8083600-9aca-4d24-a87e-1b290aaea940', False, 'lylk2-cumberlandr-loyallky', 'LYLK2-CumberlandR-LoyallKY', null, ST_GeomFromText('POINT(-83.3544 36.8478)',4326), null, null, '2021-03-12T16:00:20.225364Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03401000'),
-('dfe34baa-508b-4471-9ac1-b918021ae6a9', False, 'mugt1-efkstonesr-murfreesborotn', 'MUGT1-EFkStonesR-MurfreesboroTN', null, ST_GeomFromText('POINT(-86.4286 35.9025)',4326), null, null, '2021-03-12T16:00:20.225805Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03428200'),
-('ee6d3280-490b-498e-ab9f-90e5698cd181', False, 'port1-redr-portroyaltn', 'PORT1-RedR-PortRoyalTN', null, ST_GeomFromText('POINT(-87.1422 36.5539)',4326), null, null, '2021-03-12T16:00:20.226285Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03436100'),
-('e8d3b2e6-3b58-410f-96bb-6f8453529940', False, 'strk2-sfkcumberlandr-stearnsky', 'STRK2-SFkCumberlandR-StearnsKY', null, ST_GeomFromText('POINT(-84.5325 36.6292)',4326), null, null, '2021-03-12T16:00:20.226776Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03410500'),
-('2a76c74a-aada-4995-8fa6-3722a521fd32', False, 'nrvt1-newr-nrrobinstn', 'NRVT1-NewR-nrRobinsTN', null, ST_GeomFromText('POINT(-84.5548 36.3854)',4326), null, null, '2021-03-12T16:00:20.227233Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03408500'),
-('a129aa7b-7ec4-4f2d-88ae-457caa2adec7', False, 'smlk2-cumberlandr-smithlandky', 'SMLK2-CumberlandR-SmithlandKY', null, ST_GeomFromText('POINT(-88.3997 37.1467)',4326), null, null, '2021-03-12T16:00:20.227728Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('04117943-7a09-4015-b476-cf3f5cd469ba', False, 'mcgt1-collinsr-mcminnvilletn', 'MCGT1-CollinsR-McMinnvilleTN', null, ST_GeomFromText('POINT(-85.7319 35.7083)',4326), null, null, '2021-03-12T16:00:20.228209Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03421000'),
-('67d97a3a-a3d5-4c8c-9aa2-7329065c902c', False, 'pvlk2-cumberlandr-pinevilleky', 'PVLK2-CumberlandR-PinevilleKY', null, ST_GeomFromText('POINT(-83.6925 36.7644)',4326), null, null, '2021-03-12T16:00:20.228751Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03402900'),
-('2bb2b7cf-5ad8-4158-a42b-9727f61a02a8', False, 'lbst1-springcr-lebanontn', 'LBST1-SpringCr-LebanonTN', null, ST_GeomFromText('POINT(-86.2369 36.2217)',4326), null, null, '2021-03-12T16:00:20.229294Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03425520'),
-('07a61743-18ae-42ef-b47d-c4e20096b086', False, 'pent1-cumberlandr-penitentiarybrtn', 'PENT1-CumberlandR-PenitentiaryBrTN', null, ST_GeomFromText('POINT(-85.5958 36.4394)',4326), null, null, '2021-03-12T16:00:20.229796Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03417600'),
-('32d49c6a-b02c-4d4d-ba52-9a528cd1c80f', False, 'mdlk2-yellowcr-middlesboroky', 'MDLK2-YellowCr-MiddlesboroKY', null, ST_GeomFromText('POINT(-83.6881 36.6678)',4326), null, null, '2021-03-12T16:00:20.230478Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('2a045b91-fdda-45d0-b264-6c21b9790e36', False, 'jnct1-jenningscr-whitleyvilletn', 'JNCT1-JenningsCr-WhitleyvilleTN', null, ST_GeomFromText('POINT(-85.6747 36.4403)',4326), null, null, '2021-03-12T16:00:20.231137Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03418224'),
-('1ff25fb7-8cb9-49b1-a83c-4917e1842b6e', False, 'gvst1-smithfkcr-temperancehalltn', 'GVST1-SmithFkCr-TemperanceHallTN', null, ST_GeomFromText('POINT(-85.9078 36.0875)',4326), null, null, '2021-03-12T16:00:20.231800Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03424730'),
-('89e3534d-b0a3-422a-82ca-58b3b0e0871a', False, 'sxtk2-clearfk-saxtonky', 'SXTK2-ClearFk-SaxtonKY', null, ST_GeomFromText('POINT(-84.1144 36.6339)',4326), null, null, '2021-03-12T16:00:20.232482Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03403910'),
-('3e55442c-b36a-493c-b2f8-6fec535fc2cc', False, 'njnt1-tennesseer-nrnewjohnsonvilletn', 'NJNT1-TennesseeR-nrNewJohnsonvilleTN', null, ST_GeomFromText('POINT(-88.0003 35.0178)',4326), null, null, '2021-03-12T16:00:20.233277Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('8714bb02-ecdf-4116-9f37-9bb8158d0a1d', False, 'kint1-harpethr-kingstonsprngstn', 'KINT1-HarpethR-KingstonSprngsTN', null, ST_GeomFromText('POINT(-87.0989 36.1222)',4326), null, null, '2021-03-12T16:00:20.233965Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03434500'),
-('f1a69f11-6790-4d16-92b0-7bf2907d4039', False, 'hort1-horsecr-nrsavannahtn', 'HORT1-HorseCr-nrSavannahTN', null, ST_GeomFromText('POINT(-88.2094 35.1806)',4326), null, null, '2021-03-12T16:00:20.234753Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('a5a15c22-bced-4ea0-8342-a56df99d753f', False, 'emgt1-yellowcr-ellismillstn', 'EMGT1-YellowCr-EllisMillsTN', null, ST_GeomFromText('POINT(-87.5536 36.3108)',4326), null, null, '2021-03-12T16:00:20.235447Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03436690'),
-('38f9a880-6c47-4b1c-9476-92daa6b8164b', False, 'mtck2-beavercr-monticelloky', 'MTCK2-BeaverCr-MonticelloKY', null, ST_GeomFromText('POINT(-84.8961 36.7975)',4326), null, null, '2021-03-12T16:00:20.236236Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03413200'),
-('7d7e410b-ea8d-48a9-b09f-a6f7212b4ebe', False, 'colt1-duckr-columbiatn', 'COLT1-DuckR-ColumbiaTN', null, ST_GeomFromText('POINT(-87.0325 35.6181)',4326), null, null, '2021-03-12T16:00:20.237027Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('e1508b90-1bcf-4608-acae-0ab4e1ef01d4', False, 'ohit1-old-hickory', 'OHIT1-OLD_HICKORY', null, ST_GeomFromText('POINT(-86.6589 36.2972)',4326), null, null, '2021-03-12T16:00:20.237762Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('6758f2d6-ac16-4a8d-8a89-676578f6a639', False, 'wlbk2-cumberlandr-williamsburgky', 'WLBK2-CumberlandR-WilliamsburgKY', null, ST_GeomFromText('POINT(-84.1564 36.7436)',4326), null, null, '2021-03-12T16:00:20.238470Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03404000'),
-('8d3041bc-ff78-4dca-8044-a904ec35321f', False, 'shvt1-duckr-nrshelbyvilletn', 'SHVT1-DuckR-nrShelbyvilleTN', null, ST_GeomFromText('POINT(-86.4992 35.4803)',4326), null, null, '2021-03-12T16:00:20.239248Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('e88f7b9e-772d-42a3-9d66-33dad5c6b3a8', False, 'fldt1-fort-loudon', 'FLDT1-FORT_LOUDON', null, ST_GeomFromText('POINT(-84.2456 35.7922)',4326), null, null, '2021-03-12T16:00:20.239845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('bf472550-0dfc-4310-ab39-19160a8e073a', False, 'lvgt1-livingstontn', 'LVGT1-LivingstonTN', null, ST_GeomFromText('POINT(-85.3167 36.3833)',4326), null, null, '2021-03-12T16:00:20.240266Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('60804aad-a4c4-41a5-938c-989619315126', False, 'jnvt1', 'JNVT1', null, ST_GeomFromText('POINT(87.9758 36.0308)',4326), null, null, '2021-03-12T16:00:20.240891Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('eebb0956-f1c8-4d76-a78a-e823509fce4d', False, 'lebt1-lebanontn', 'LEBT1-LebanonTN', null, ST_GeomFromText('POINT(-86.3178 36.2286)',4326), null, null, '2021-03-12T16:00:20.241584Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('f017d31a-573e-4993-ba15-10d4224f08d9', False, 'unvt1-unionvilletn', 'UNVT1-UnionvilleTN', null, ST_GeomFromText('POINT(-86.5864 35.6211)',4326), null, null, '2021-03-12T16:00:20.242313Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('81c785c7-365b-49d8-b06b-f85fcd3479e7', False, 'wdbt1-woodburytn', 'WDBT1-WoodburyTN', null, ST_GeomFromText('POINT(-86.0733 35.8292)',4326), null, null, '2021-03-12T16:00:20.242975Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('6a6ca44d-f9f0-4f0e-ab52-ac7af764c6ab', False, 'lbyk2-libertyky', 'LBYK2-LibertyKY', null, ST_GeomFromText('POINT(-84.9333 37.3)',4326), null, null, '2021-03-12T16:00:20.243674Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('f882e4e4-7aaa-422f-a7dd-4a59dfb0a41a', False, 'nkjt1-nickajack', 'NKJT1-NICKAJACK', null, ST_GeomFromText('POINT(-85.6222 35.0017)',4326), null, null, '2021-03-12T16:00:20.244369Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('b8c41a11-f7b0-4a01-8145-4f28fdbbfde9', False, 'wbot1-watts-bar', 'WBOT1-WATTS_BAR', null, ST_GeomFromText('POINT(-84.7886 35.6086)',4326), null, null, '2021-03-12T16:00:20.245101Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('103af248-70db-436b-9b98-882c6db5f95f', False, 'orlt1-orlindatn', 'ORLT1-OrlindaTN', null, ST_GeomFromText('POINT(-86.7153 36.6025)',4326), null, null, '2021-03-12T16:00:20.245823Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('884801ba-13ec-4638-8f8e-fda673a63fb2', False, 'cntt1', 'CNTT1', null, ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:00:20.246794Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('51055b7d-d298-4971-ba98-08de16960b74', False, 'ctnk2-cantonky', 'CTNK2-CantonKY', null, ST_GeomFromText('POINT(-87.9644 36.7942)',4326), null, null, '2021-03-12T16:00:20.247273Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('b1ae57c6-40dc-4fde-9852-e42bdabd4d2e', False, 'coot1-cookevilletn', 'COOT1-CookevilleTN', null, ST_GeomFromText('POINT(-85.5 36.15)',4326), null, null, '2021-03-12T16:00:20.247825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('13c24865-3fab-4a81-acd5-4bc84334cd2b', False, 'kwtt1-knoxvilletn-wtp', 'KWTT1-KnoxvilleTN-WTP', null, ST_GeomFromText('POINT(-83.9289 5.9456)',4326), null, null, '2021-03-12T16:00:20.248522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('cd2b9a42-0f5a-4660-9c53-10ebdc7d422f', False, 'brak2-bereaky', 'BRAK2-BereaKY', null, ST_GeomFromText('POINT(-84.2525 37.5744)',4326), null, null, '2021-03-12T16:00:20.249243Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('2cba28f2-a50f-4ee4-9653-96d6dbaaa74c', False, 'cbbk2-cobbky', 'CBBK2-CobbKY', null, ST_GeomFromText('POINT(-87.7781 36.9933)',4326), null, null, '2021-03-12T16:00:20.249910Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('14e6dda0-c35e-4b77-8c38-7e126a43fa30', False, 'ektk2-elktonky', 'EKTK2-ElktonKY', null, ST_GeomFromText('POINT(-87.1022 36.8022)',4326), null, null, '2021-03-12T16:00:20.250455Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('69bad009-9c10-446a-a782-84b847554bbe', False, 'hbft1-harpethr-blwfranklin', 'HBFT1-HarpethR-blwFranklin', null, ST_GeomFromText('POINT(-86.8817 35.9481)',4326), null, null, '2021-03-12T16:00:20.250856Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03432400'),
-('e6f235c0-acff-4145-af79-f80065678138', False, 'bigt1-bigsandyr-brucetontn', 'BIGT1-BigSandyR-BrucetonTN', null, ST_GeomFromText('POINT(-88.2289 36.0369)',4326), null, null, '2021-03-12T16:00:20.251348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03606500'),
-('f5284c44-6b5c-407d-9e9d-04ce3b942b22', False, 'mltt1-duckr-avbmilltowntn', 'MLTT1-DuckR-avbMilltownTN', null, ST_GeomFromText('POINT(-86.7789 35.5764)',4326), null, null, '2021-03-12T16:00:20.251816Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03599240'),
-('fc5b94e8-5d1b-43b6-973a-acaa2cd1a4d2', False, 'chpt1-cheatham', 'CHPT1-CHEATHAM', null, ST_GeomFromText('POINT(-87.2275 36.3144)',4326), null, null, '2021-03-12T16:00:20.252289Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('665586c1-2bbd-4218-8cb2-5515ec82faf8', False, 'ceht1-center-hill', 'CEHT1-CENTER_HILL', null, ST_GeomFromText('POINT(-85.8272 36.0939)',4326), null, null, '2021-03-12T16:00:20.252723Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('29b2ac8c-5ce0-4692-adf6-eab3c6dc33bc', False, 'lapk2-laurel', 'LAPK2-LAUREL', null, ST_GeomFromText('POINT(-84.2678 36.9625)',4326), null, null, '2021-03-12T16:00:20.253213Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('03961c4b-8278-4159-a5d7-9d7dd11cae34', False, 'cmbk2-poorfk-cumberlandky', 'CMBK2-PoorFk-CumberlandKY', null, ST_GeomFromText('POINT(-82.9939 36.9733)',4326), null, null, '2021-03-12T16:00:20.253670Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03400500'),
-('3c9d0224-ee9d-4738-91b5-8d407512d259', False, 'fnht1-fountaincr-nrfountainheightstn', 'FNHT1-FountainCr-nrFountainHeightsTN', null, ST_GeomFromText('POINT(-86.9419 35.5181)',4326), null, null, '2021-03-12T16:00:20.254348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03599450'),
-('3ee98fa5-5742-4161-908a-24d8053148ab', False, 'bpgt1-bethpagetn', 'BPGT1-BethpageTN', null, ST_GeomFromText('POINT(-86.3106 36.4872)',4326), null, null, '2021-03-12T16:00:20.255071Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('2ede45f1-2a9f-44c9-a40e-1eda74eca784', False, 'bbvk2-cumberlandr-barbourvilleky', 'BBVK2-CumberlandR-BarbourvilleKY', null, ST_GeomFromText('POINT(-83.8875 36.8631)',4326), null, null, '2021-03-12T16:00:20.255757Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03403500'),
-('0b6ee7cb-30b1-477a-94a0-cb0aaa2bb0f1', False, 'cnvt1-duckr-centervilletn', 'CNVT1-DuckR-CentervilleTN', null, ST_GeomFromText('POINT(-87.46 35.7842)',4326), null, null, '2021-03-12T16:00:20.256017Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03601990'),
-('e83479c4-637f-4cf9-80b5-37a891a72216', False, 'dblt1-bigrockcr-nrdoublebridgestn', 'DBLT1-BigRockCr-nrDoubleBridgesTN', null, ST_GeomFromText('POINT(-86.7686 35.5044)',4326), null, null, '2021-03-12T16:00:20.256702Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03599100'),
-('a10b6a71-84ad-4075-b7a9-94091f296ca8', False, 'mcet1-mcewentn', 'MCET1-McEwenTN', null, ST_GeomFromText('POINT(-87.6369 36.1069)',4326), null, null, '2021-03-12T16:00:20.257434Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('e03229c6-97ce-484b-9584-521189519f41', False, 'ohht1-old-hickory', 'OHHT1-OLD_HICKORY', null, ST_GeomFromText('POINT(-86.6589 36.2972)',4326), null, null, '2021-03-12T16:00:20.257747Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('9cb955c5-93cb-4402-822b-12e99580abe3', False, 'ohgt1-old-hickory', 'OHGT1-OLD_HICKORY', null, ST_GeomFromText('POINT(-86.6586 36.2969)',4326), null, null, '2021-03-12T16:00:20.258249Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('57b92e3d-19f6-4ce9-b711-d43f42fab3d9', False, 'barkley-spillway-gates', 'Barkley-Spillway-Gates', null, ST_GeomFromText('POINT(-88.2235 37.0207)',4326), null, null, '2021-03-12T16:00:20.258714Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('5f01ad2c-67aa-4cae-b7b3-36fb7a1881ab', False, 'cordell-hull-spillway-gates', 'Cordell-Hull-Spillway-Gates', null, ST_GeomFromText('POINT(-85.9432 36.2901)',4326), null, null, '2021-03-12T16:00:20.259052Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('828ffaa0-cfbc-4545-bb0e-8bacad7945da', False, 'cheatham-spillway-gates', 'Cheatham-Spillway-Gates', null, ST_GeomFromText('POINT(-87.2226 36.3196)',4326), null, null, '2021-03-12T16:00:20.259620Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('dd724991-14c8-4899-8489-f6ace618a088', False, 'bort1', 'BORT1', null, ST_GeomFromText('POINT(-86.8203 36.2175)',4326), null, null, '2021-03-12T16:00:20.260120Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431599'),
-('54f189a0-4f94-46ea-bdb5-616ccff04bad', False, 'nrct1', 'NRCT1', null, ST_GeomFromText('POINT(-86.8542 36.1511)',4326), null, null, '2021-03-12T16:00:20.260706Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431700'),
-('42a6e80b-5767-4ffe-b302-692cf97da00b', False, 'nbct1', 'NBCT1', null, ST_GeomFromText('POINT(-86.7611 36.13)',4326), null, null, '2021-03-12T16:00:20.261234Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431300'),
-('ffa13475-c097-4316-88e1-9a8ce6f67699', False, 'cumberlandnashville', 'CumberlandNashville', null, ST_GeomFromText('POINT(-86.7764 36.1672)',4326), null, null, '2021-03-12T16:00:20.261697Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '034315005'),
-('e9c58cf6-2093-4359-bba1-3f8ad409d273', False, 'mflk2-martins-fork', 'MFLK2-MARTINS_FORK', null, ST_GeomFromText('POINT(-83.2597 36.7506)',4326), null, null, '2021-03-12T16:00:20.261828Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03400798'),
-('f5dbba6e-7dc3-4c62-b833-c980f950ce9e', False, 'ckvt1-cumberlandr-clarksvilletn', 'CKVT1-CumberlandR-ClarksvilleTN', null, ST_GeomFromText('POINT(-87.3678 36.5414)',4326), null, null, '2021-03-12T16:00:20.262545Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03436500'),
-('a2fa2bde-b031-43d9-b00a-3302beb181c6', False, 'normandy-rain-near-normandy', 'Normandy_Rain_Near_Normandy', null, ST_GeomFromText('POINT(-86.2453 35.4575)',4326), null, null, '2021-03-12T16:00:20.263251Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('24924cc6-ac6d-49f3-bbc6-3a0fdf99323a', False, 'normandy-tailwater', 'NORMANDY_TAILWATER', null, ST_GeomFromText('POINT(-86.2467 35.4628)',4326), null, null, '2021-03-12T16:00:20.263737Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('e0821f6a-a83e-4939-b7e5-e6214d6370f8', False, 'normandy-headwater', 'Normandy_Headwater', null, ST_GeomFromText('POINT(-86.2472 35.4653)',4326), null, null, '2021-03-12T16:00:20.263816Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('afe26545-5c47-4c18-9d6c-bed4e5f42a8a', False, 'rwnk2-wolf-creek', 'RWNK2-WOLF_CREEK', null, ST_GeomFromText('POINT(-85.1394 36.8836)',4326), null, null, '2021-03-12T16:00:20.263889Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03414000'),
-('73f36663-edb9-4c1e-b3bd-7a56dd66695d', False, 'falt1-fallsstateparktn', 'FALT1-FallsStateParkTN', null, ST_GeomFromText('POINT(-85.3086 35.6825)',4326), null, null, '2021-03-12T16:00:20.264533Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('09247b70-5a7b-4830-ae49-28465698b875', False, 'fallingwaterr', 'FallingWaterR', null, ST_GeomFromText('POINT(-85.5214 36.0772)',4326), null, null, '2021-03-12T16:00:20.265113Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03423000'),
-('e6bc7078-e633-45fd-95a6-f3b0b954d915', False, 'cett1-center-hill', 'CETT1-CENTER_HILL', null, ST_GeomFromText('POINT(-85.8333 36.0917)',4326), null, null, '2021-03-12T16:00:20.265577Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('02ae0b07-11c5-4599-bda0-1c3f8cc7492b', False, 'south-chick-cr-near-chattanooga', 'South_Chick_Cr_near_Chattanooga', null, ST_GeomFromText('POINT(-85.2106 35.0136)',4326), null, null, '2021-03-12T16:00:20.266183Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('b81d3d17-3201-4611-aafc-1b594d8942f3', False, 'tenn-river-at-s-pittsburg', 'Tenn_River_at_S_Pittsburg', null, ST_GeomFromText('POINT(-85.6975 35.0114)',4326), null, null, '2021-03-12T16:00:20.266653Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('6c847545-5c33-4916-a837-6c4ff8cea45f', False, 'anderson-rain', 'Anderson_Rain', null, ST_GeomFromText('POINT(-85.8972 35.0028)',4326), null, null, '2021-03-12T16:00:20.267095Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('7b616286-586c-4a46-9573-b25cb76b8758', False, 'n-chick-cr-at-mile-straight', 'N_Chick_Cr_at_Mile_Straight', null, ST_GeomFromText('POINT(-85.2153 35.2111)',4326), null, null, '2021-03-12T16:00:20.267593Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03566535'),
-('46c63d47-8fb2-4279-b1a5-b9fad7e05a00', False, 'mcdonald', 'McDonald', null, ST_GeomFromText('POINT(-84.9897 35.0967)',4326), null, null, '2021-03-12T16:00:20.268158Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('27fd522f-a619-43a2-8011-6cda06261819', False, 'cleveland', 'Cleveland', null, ST_GeomFromText('POINT(-84.8214 35.1836)',4326), null, null, '2021-03-12T16:00:20.268836Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('f9a665ed-ed3e-4593-b02c-bfc852e742f4', False, 'georgetown', 'Georgetown', null, ST_GeomFromText('POINT(-84.9656 35.3217)',4326), null, null, '2021-03-12T16:00:20.269396Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('b8a42122-b8f0-411d-9576-8d24f0091729', False, 'sequatchie-river-near-whitwell', 'Sequatchie_River_near_Whitwell', null, ST_GeomFromText('POINT(-85.4972 35.2064)',4326), null, null, '2021-03-12T16:00:20.269996Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('b0fd85c0-2c44-4081-b390-d50a804e729a', False, 'whitwell', 'Whitwell', null, ST_GeomFromText('POINT(-85.5194 35.2097)',4326), null, null, '2021-03-12T16:00:20.270480Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('6ac6c1fc-f21c-4780-ae21-b9c4ae7084cb', False, 'raccoon-mountain-near-whitwell', 'Raccoon_Mountain_near_Whitwell', null, ST_GeomFromText('POINT(-85.3875 35.0567)',4326), null, null, '2021-03-12T16:00:20.270985Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('7816be03-2d3f-45fe-9390-6f194fa13821', False, 'wckg1-westchickamaugacr-lakeviewga', 'WCKG1-WestChickamaugaCr-LakeviewGA', null, ST_GeomFromText('POINT(-85.2056 34.9572)',4326), null, null, '2021-03-12T16:00:20.271454Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03567340'),
-('b9d0b218-08c9-4a91-9028-811f98542a54', False, 'ccfg1-chattanoogacr-flintstonega', 'CCFG1-ChattanoogaCr-FlintstoneGA', null, ST_GeomFromText('POINT(-85.3344 34.9572)',4326), null, null, '2021-03-12T16:00:20.271967Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03568400'),
-('43c6c79f-9c45-49b0-a8f0-bbbf81db0d51', False, 'sckg1-southchickamaugacr-ringoldga', 'SCKG1-SouthChickamaugaCr-RingoldGA', null, ST_GeomFromText('POINT(-85.1256 34.9189)',4326), null, null, '2021-03-12T16:00:20.272449Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03566700'),
-('fc1a7d87-5598-49b0-beb9-3779829fc8d0', False, 'kydk2-kentucky', 'KYDK2-KENTUCKY', null, ST_GeomFromText('POINT(-88.2678 37.0144)',4326), null, null, '2021-03-12T16:00:20.272983Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
-('87126358-87ec-4974-90f3-a40f14c0d2b3', False, 'ctht1-cumberlandr-carthagetn', 'CTHT1-CumberlandR-CarthageTN', null, ST_GeomFromText('POINT(-85.9564 36.2475)',4326), null, null, '2021-03-12T16:00:20.273107Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03425000'),
-('b24fc5a4-962c-4a0b-a1b4-d2f08a55989b', False, 'antt1-millcr-antiochtn', 'ANTT1-MillCr-AntiochTN', null, ST_GeomFromText('POINT(-86.6808 36.0817)',4326), null, null, '2021-03-12T16:00:20.273676Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03430400'),
-('6fcd34f0-dbc7-4ad2-bdbc-4eddd8277322', False, 'yamacraw', 'Yamacraw', null, ST_GeomFromText('POINT(-84.5631 36.7028)',4326), null, null, '2021-03-12T16:00:20.273969Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03410590'),
-('b172624d-0eab-4c0b-9e95-47438e1b4afd', False, 'cumbr-ashlandcity', 'CumbR_AshlandCity', null, ST_GeomFromText('POINT(-87.0761 36.2706)',4326), null, null, '2021-03-12T16:00:20.274443Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431790'),
-('6b20e874-02f0-40de-95ce-bb1f3e565d46', False, 'bledsoe-cr-nr-bethpage', 'Bledsoe_Cr_nr_Bethpage', null, ST_GeomFromText('POINT(-86.332 36.4456)',4326), null, null, '2021-03-12T16:00:20.275301Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03425622'),
-('cec009f7-5cd7-4194-980a-ebdc69995f0d', False, 'duckr-blw-manchester', 'DuckR_blw_Manchester', null, ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:00:20.276038Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03596000'),
-('b1d3fc99-abaf-4055-b4e6-6ab0c9a60c3d', False, 'duckr-nr-pottsville', 'DuckR_nr_Pottsville', null, ST_GeomFromText('POINT(-86.8714 35.5703)',4326), null, null, '2021-03-12T16:00:20.277067Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03599419'),
-('9e569018-ce7e-4a89-8f8e-0fdf0aed9adf', False, 'duckr-nr-shadygrove', 'DuckR_nr_ShadyGrove', null, ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:00:20.278362Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03601600'),
-('9cda93fc-a495-4722-8834-7023a281b66a', False, 'redr-at-dot', 'RedR_at_Dot', null, ST_GeomFromText('POINT(-86.9521 36.6767)',4326), null, null, '2021-03-12T16:00:20.279487Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03435105'),
-('2a86face-8c5b-48c8-8c4f-def396a8489a', False, 'roaringr-nr-hilham', 'RoaringR_nr_Hilham', null, ST_GeomFromText('POINT(-85.4264 36.3408)',4326), null, null, '2021-03-12T16:00:20.279832Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03418000'),
-('554a7daa-b00f-4d14-a959-68c989ce5bda', False, 'wartracecr-at-wartrace', 'WartraceCr_at_Wartrace', null, ST_GeomFromText('POINT(-86.3403 35.5272)',4326), null, null, '2021-03-12T16:00:20.280774Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03597590'),
-('7a40ac4f-904a-4068-9efb-ef41e81db0b7', False, 'buckcr-shopville', 'BuckCr_Shopville', null, ST_GeomFromText('POINT(-84.4644 37.2106)',4326), null, null, '2021-03-12T16:00:20.281760Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03407500'),
-('5a1b8894-1fb1-4289-b943-8f74b28d7f76', False, 'millcr-nolensvll', 'MillCr_Nolensvll', null, ST_GeomFromText('POINT(-86.7015 36.0091)',4326), null, null, '2021-03-12T16:00:20.282084Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03430550'),
-('aa0341b6-4bed-4683-bb50-7ccb5da4eff7', False, 'drycr-edenwold', 'DryCr_Edenwold', null, ST_GeomFromText('POINT(-86.7063 36.2845)',4326), null, null, '2021-03-12T16:00:20.282874Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03426470'),
-('902be564-bff4-4495-bc2c-388ddcf998d4', False, 'sflittler-hopkin', 'SFLittleR Hopkin', null, ST_GeomFromText('POINT(-87.4289 36.8494)',4326), null, null, '2021-03-12T16:00:20.283830Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03437495'),
-('90d6254b-f8ff-47ff-b97a-981e710e7160', False, 'acst1-sycamorecr-ashlandcitytn', 'ACST1-SycamoreCr-AshlandCityTN', null, ST_GeomFromText('POINT(-87.0539 36.3231)',4326), null, null, '2021-03-12T16:00:20.284163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431800'),
-('cc2b904b-d99f-4583-8fb7-056ba1ba320f', False, 'cork2-lynncampcr-corbinky', 'CORK2-LynnCampCr-CorbinKY', null, ST_GeomFromText('POINT(-84.0875 36.945)',4326), null, null, '2021-03-12T16:00:20.285218Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03404900'),
-('b7abd272-ba0c-428b-88a4-a2dcc5bcda0e', False, 'cbnk2-laurelr-corbinky', 'CBNK2-LaurelR-CorbinKY', null, ST_GeomFromText('POINT(-84.1194 36.9706)',4326), null, null, '2021-03-12T16:00:20.286441Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03404820'),
-('e7902c45-6800-4b84-a70e-b70e77d43bb1', False, 'duckr-abv-williamsport', 'DuckR_abv_Williamsport', null, ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:00:20.288155Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03600358'),
-('ed9c351b-c5cd-411c-aa45-fbca9ac69d7d', False, 'belt1-harpethr-bellevuetn', 'BELT1-HarpethR-BellevueTN', null, ST_GeomFromText('POINT(-86.9286 35.0547)',4326), null, null, '2021-03-12T16:00:20.289424Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03433500'),
-('0929af2a-a93e-4aaa-a926-cfeeba57372b', False, 'cmtt1-cypresscr-camdentn', 'CMTT1-CypressCr-CamdenTN', null, ST_GeomFromText('POINT(-88.0758 36.0469)',4326), null, null, '2021-03-12T16:00:20.290678Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03605078'),
-('8c48ae3d-4581-4aa5-8b81-592c907dd7a0', False, 'crocus-cr', 'Crocus Cr', null, ST_GeomFromText('POINT(-85.3107 36.8967)',4326), null, null, '2021-03-12T16:00:20.291845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03414078'),
-('b5a6d737-0f49-4d0a-9405-5fded7f886ea', False, 'stonesr-donelson', 'StonesR_Donelson', null, ST_GeomFromText('POINT(-86.6334 36.1862)',4326), null, null, '2021-03-12T16:00:20.292705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03430200');
+('27317472-68f5-4341-9152-be4e3cd35aba', False, 'amok2-clarksr-almoky', 'AMOK2-ClarksR-AlmoKY', ST_GeomFromText('POINT(-88.2736 36.6917)',4326), null, null, '2021-03-12T16:00:20.196776Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03610200'),
+('ff959a3d-2723-4984-abca-f2bd03aa489d', False, 'csst1-crossvilletn', 'CSST1-CrossvilleTN', ST_GeomFromText('POINT(-84.9972 35.9178)',4326), null, null, '2021-03-12T16:00:20.197035Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('83b90989-01ac-443a-98a6-73579bdcd6cd', False, 'fcrt1-fortcampbelltn', 'FCRT1-FortCampbellTN', ST_GeomFromText('POINT(-87.5369 36.6256)',4326), null, null, '2021-03-12T16:00:20.197142Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('7c7f7d23-824b-4325-a64e-f12ce009530e', False, 'albk2-albanyky', 'ALBK2-AlbanyKY', ST_GeomFromText('POINT(-85.1217 36.7453)',4326), null, null, '2021-03-12T16:00:20.197232Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('0459d406-5eca-4171-9af6-dfba3a7a45c6', False, 'alpt1-wfkobeyr-alpinetn', 'ALPT1-WFkObeyR-AlpineTN', ST_GeomFromText('POINT(-85.1745 36.3973)',4326), null, null, '2021-03-12T16:00:20.197635Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03415000'),
+('76972f3b-4723-40c5-adb6-8c8fd05dbf86', False, 'aplv2-appalachiava', 'APLV2-AppalachiaVA', ST_GeomFromText('POINT(-82.7883 36.8983)',4326), null, null, '2021-03-12T16:00:20.198075Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('74b37f6e-51db-4daf-8c91-f6b4f2723de6', False, 'artt1-powellr-arthurtn', 'ARTT1-PowellR-ArthurTN', ST_GeomFromText('POINT(-83.6303 36.5419)',4326), null, null, '2021-03-12T16:00:20.198465Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03532000'),
+('127e78c0-dcb2-4a3e-a50a-f016f06ce941', False, 'bahk2-barkley', 'BAHK2-BARKLEY', ST_GeomFromText('POINT(-88.2228 37.0244)',4326), null, null, '2021-03-12T16:00:20.198902Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('cde9c93e-070e-4386-b6ce-66d539304ad4', False, 'asht1-cheatham', 'ASHT1-CHEATHAM', ST_GeomFromText('POINT(-87.2283 36.3228)',4326), null, null, '2021-03-12T16:00:20.199286Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('8cdac320-0eed-41df-8983-5e71f1278b08', False, 'coht1-cordell-hull', 'COHT1-CORDELL_HULL', ST_GeomFromText('POINT(-85.9389 36.2917)',4326), null, null, '2021-03-12T16:00:20.199683Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('aeb9ed17-50fe-42bb-acd6-9a2cf808cbba', False, 'cort1-cordell-hull', 'CORT1-CORDELL_HULL', ST_GeomFromText('POINT(-85.9397 36.2853)',4326), null, null, '2021-03-12T16:00:20.200060Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03418420'),
+('cc3b9f84-b4f9-4916-96b0-fc73c44d6b0d', False, 'dhtt1-dale-hollow', 'DHTT1-DALE_HOLLOW', ST_GeomFromText('POINT(-85.4611 63.5472)',4326), null, null, '2021-03-12T16:00:20.200496Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('8c53aaa6-aad4-497c-9286-741291847fc3', False, 'dlht1-dale-hollow', 'DLHT1-DALE_HOLLOW', ST_GeomFromText('POINT(-85.4564 36.5414)',4326), null, null, '2021-03-12T16:00:20.200896Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('6ba15fe7-dfcf-49c3-8230-599356d0c4b0', False, 'caft1-calfkillerr-blwspartatn', 'CAFT1-CalfkillerR-blwSpartaTN', ST_GeomFromText('POINT(-85.4763 35.9121)',4326), null, null, '2021-03-12T16:00:20.201311Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03419530'),
+('9375532a-4fcb-4bfc-a80c-a9a8d10aa1f2', False, 'wbnt1-millcr-nrwoodbinetn', 'WBNT1-MillCr-nrWoodbineTN', ST_GeomFromText('POINT(-86.719 36.1167)',4326), null, null, '2021-03-12T16:00:20.201745Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431060'),
+('4955897d-c97b-4d36-bd40-d46623c798a2', False, 'wlck2-wolf-creek', 'WLCK2-WOLF_CREEK', ST_GeomFromText('POINT(-85.1469 36.8683)',4326), null, null, '2021-03-12T16:00:20.202240Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('57d58971-a9a5-454b-bc24-07d5dc98ae5e', False, 'burkesville', 'Burkesville', ST_GeomFromText('POINT(-85.3681 36.7953)',4326), null, null, '2021-03-12T16:00:20.202636Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03414100'),
+('9c72d20a-7806-4b72-8753-a339dcf9955f', False, 'cfak2-cumberlandr-cumberlandfallsky', 'CFAK2-CumberlandR-CumberlandFallsKY', ST_GeomFromText('POINT(-84.3414 36.8358)',4326), null, null, '2021-03-12T16:00:20.203135Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03404500'),
+('f9d084c4-0cef-4974-88b9-969ed553da51', False, 'lbvt1-buffalor-blwlobelvilletn', 'LBVT1-BuffaloR-blwLobelvilleTN', ST_GeomFromText('POINT(-87.7803 35.8108)',4326), null, null, '2021-03-12T16:00:20.203623Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('b0035cd2-b103-4df1-8561-15a38d56dcc3', False, 'ltwt1-sfkcumberlandr-leatherwoodfordtn', 'LTWT1-SFkCumberlandR-LeatherwoodFordTN', ST_GeomFromText('POINT(-84.6694 36.4773)',4326), null, null, '2021-03-12T16:00:20.204026Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03410210'),
+('eaff91e3-8907-4aaa-96b5-24d011fd5c1f', False, 'nast1-cumberlandr-nashvilletn', 'NAST1-CumberlandR-NashvilleTN', ST_GeomFromText('POINT(-86.7731 36.1614)',4326), null, null, '2021-03-12T16:00:20.204497Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431500'),
+('9ea8edb4-d7c8-4a40-96ea-f0997b66e16d', False, 'shet1-duckr-shelbyvilletn', 'SHET1-DuckR-ShelbyvilleTN', ST_GeomFromText('POINT(-86.4625 35.4828)',4326), null, null, '2021-03-12T16:00:20.205001Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('9ab06dac-707f-48de-bec4-8e0488918b0b', False, 'smhk2-martinsfk-smithky', 'SMHK2-MartinsFk-SmithKY', ST_GeomFromText('POINT(-83.2869 36.7258)',4326), null, null, '2021-03-12T16:00:20.205444Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03400785'),
+('ded99a0f-330c-4cda-9417-c83bc7b24af3', False, 'ncfk2-martinsfk-harlanky', 'NCFK2-MartinsFk-HarlanKY', ST_GeomFromText('POINT(-83.3253 36.8447)',4326), null, null, '2021-03-12T16:00:20.205843Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03400986'),
+('0b464357-6d6b-4dc0-87ea-aa4f65aa8e9e', False, 'mnlk2-martins-fork', 'MNLK2-MARTINS_FORK', ST_GeomFromText('POINT(-83.255 36.7503)',4326), null, null, '2021-03-12T16:00:20.206371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03400800'),
+('cd329248-0df2-4a36-aeac-8e18b54d98f5', False, 'clat1-cumberlandr-celinatn', 'CLAT1-CumberlandR-CelinaTN', ST_GeomFromText('POINT(-85.5156 36.5544)',4326), null, null, '2021-03-12T16:00:20.206865Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03417500'),
+('6961517e-7f6e-4a2e-a362-d2d85362c5c1', False, 'dovt1-cumberlandr-dovertn', 'DOVT1-CumberlandR-DoverTN', ST_GeomFromText('POINT(-87.8389 36.4897)',4326), null, null, '2021-03-12T16:00:20.207442Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03437000'),
+('3877d14f-4d39-4369-9a63-498851e9d8cd', False, 'hntt1-cumberlandr-hunterspointtn', 'HNTT1-CumberlandR-HuntersPointTN', ST_GeomFromText('POINT(-86.2642 36.2992)',4326), null, null, '2021-03-12T16:00:20.207924Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03425400'),
+('346fcc23-8ed0-4e80-99b7-75c2f2261525', False, 'hpkk2-sfklittler-hopkinsvilleky', 'HPKK2-SFkLittleR-HopkinsvilleKY', ST_GeomFromText('POINT(-87.4814 36.8394)',4326), null, null, '2021-03-12T16:00:20.208417Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03437500'),
+('58beadda-81ea-4950-b21a-18405d67583c', False, 'stwt1-caneyfk-stonewalltn', 'STWT1-CaneyFk-StonewallTN', ST_GeomFromText('POINT(-85.9047 36.19)',4326), null, null, '2021-03-12T16:00:20.208904Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03424860'),
+('0d485c9a-928b-448f-9a13-814f14cefe0f', False, 'ckdt1-chickamauga', 'CKDT1-CHICKAMAUGA', ST_GeomFromText('POINT(-85.2294 35.1)',4326), null, null, '2021-03-12T16:00:20.209328Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('11332d06-0506-4a42-8698-c80f3aff861d', False, 'clbt1-columbiatn', 'CLBT1-ColumbiaTN', ST_GeomFromText('POINT(-87.0328 35.6636)',4326), null, null, '2021-03-12T16:00:20.209713Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('324034f3-e9fb-429c-b741-1d6d65ea7b4c', False, 'clmt1-coalmonttn', 'CLMT1-CoalmontTN', ST_GeomFromText('POINT(-85.6964 35.345)',4326), null, null, '2021-03-12T16:00:20.210160Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('5ddae2ed-a0a9-4fef-a4da-864604996342', False, 'spst1-springfieldtn', 'SPST1-SpringfieldTN', ST_GeomFromText('POINT(-86.8417 36.4711)',4326), null, null, '2021-03-12T16:00:20.210560Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('9a25e374-d27d-4f12-b228-7363f70a71f3', False, 'dert1-derossettn', 'DERT1-DeRossetTN', ST_GeomFromText('POINT(-85.2817 35.9631)',4326), null, null, '2021-03-12T16:00:20.210962Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('b30e8722-5dfb-4677-b44f-45d49e81a925', False, 'sstk2-somersetky', 'SSTK2-SomersetKY', ST_GeomFromText('POINT(-84.6158 37.1044)',4326), null, null, '2021-03-12T16:00:20.211346Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('9ea21f67-c8cb-48ad-9f6f-b3fc729f7b9a', False, 'sbvt1', 'SBVT1', ST_GeomFromText('POINT(86.4622 35.4825)',4326), null, null, '2021-03-12T16:00:20.211742Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('224d3ada-e83b-4d19-b152-05d8b099c056', False, 'isot1-isolinetn', 'ISOT1-IsolineTN', ST_GeomFromText('POINT(-85.0611 36.0783)',4326), null, null, '2021-03-12T16:00:20.212129Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('5b8a204e-bd38-40bd-b108-4118f962586b', False, 'onit1-oneidatn', 'ONIT1-OneidaTN', ST_GeomFromText('POINT(-84.5167 36.5)',4326), null, null, '2021-03-12T16:00:20.212513Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('58278b8b-54d4-4b56-8969-e6a176b900f9', False, 'whla1-wheeler', 'WHLA1-WHEELER', ST_GeomFromText('POINT(-87.3836 34.7625)',4326), null, null, '2021-03-12T16:00:20.212935Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('9c89f1b8-6c18-4a42-ae3d-470299b7194b', False, 'lftt1-lafayettetn', 'LFTT1-LafayetteTN', ST_GeomFromText('POINT(-85.0311 36.5192)',4326), null, null, '2021-03-12T16:00:20.213388Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('1cdebe4a-52d6-4356-8817-c5984ce4100f', False, 'stlt1-statesvilletn', 'STLT1-StatesvilleTN', ST_GeomFromText('POINT(-86.1214 36.0183)',4326), null, null, '2021-03-12T16:00:20.213764Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('9a97a37b-e18f-4cca-9d7c-137a1d3af595', False, 'pict1-pickwick', 'PICT1-PICKWICK', ST_GeomFromText('POINT(-88.2511 3.0681)',4326), null, null, '2021-03-12T16:00:20.214223Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('903d19ae-0f01-4bd9-b7ef-c72b6551739d', False, 'mant1-manchestertn', 'MANT1-ManchesterTN', ST_GeomFromText('POINT(-86.0831 35.4711)',4326), null, null, '2021-03-12T16:00:20.214610Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('652fcd72-0891-481d-9fa1-7aa96508d0a0', False, 'mnyt1-montereytn', 'MNYT1-MontereyTN', ST_GeomFromText('POINT(-85.3 36.1333)',4326), null, null, '2021-03-12T16:00:20.214981Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('64a28953-4f08-4eab-baf5-11b49dc7f957', False, 'lvlt1', 'LVLT1', ST_GeomFromText('POINT(87.7747 35.7828)',4326), null, null, '2021-03-12T16:00:20.215358Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('f72eacc7-7a2d-4bf8-827c-a81d80952183', False, 'grtt1-great-falls', 'GRTT1-GREAT_FALLS', ST_GeomFromText('POINT(-85.6336 35.8081)',4326), null, null, '2021-03-12T16:00:20.215734Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('9a6682e4-92fd-4d67-87ea-59ffd0bec6ce', False, 'ptst1-petrostn', 'PTST1-PetrosTN', ST_GeomFromText('POINT(-84.4461 36.0989)',4326), null, null, '2021-03-12T16:00:20.216108Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('f25498d3-d819-4a59-862d-769f2aba0e74', False, 'newt1-newcombtn', 'NEWT1-NewcombTN', ST_GeomFromText('POINT(-84.1731 36.5444)',4326), null, null, '2021-03-12T16:00:20.216509Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('e401d6d3-8111-4f04-8c90-6ccf2d269bc2', False, 'gvda1-guntersville', 'GVDA1-GUNTERSVILLE', ST_GeomFromText('POINT(-86.3933 34.4211)',4326), null, null, '2021-03-12T16:00:20.216909Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('7e2ef036-c14a-4e0a-9528-1bcfbaa84219', False, 'grhk2-mckeeky', 'GRHK2-McKeeKY', ST_GeomFromText('POINT(-83.9261 37.3842)',4326), null, null, '2021-03-12T16:00:20.217352Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('0a1c5198-b7bd-4090-bc0c-89f44635d8eb', False, 'snnt1', 'SNNT1', ST_GeomFromText('POINT(88.2097 35.1797)',4326), null, null, '2021-03-12T16:00:20.217730Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('c9d371d7-9933-484f-853b-cc7483011c0d', False, 'wsda1-wilson', 'WSDA1-WILSON', ST_GeomFromText('POINT(-87.625 34.7925)',4326), null, null, '2021-03-12T16:00:20.218149Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('c856ec08-c7f1-4d20-8804-481da266339d', False, 'bcht1-beechr-nrlexingtontn', 'BCHT1-BeechR-nrLexingtonTN', ST_GeomFromText('POINT(-88.4147 35.6342)',4326), null, null, '2021-03-12T16:00:20.218537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03594421'),
+('9b52884d-a446-4a1a-8db2-6d73aed87d66', False, 'prvt1-tennesseer-perryvilletn', 'PRVT1-TennesseeR-PerryvilleTN', ST_GeomFromText('POINT(-88.0389 35.6247)',4326), null, null, '2021-03-12T16:00:20.218958Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('cb6708b0-9599-470a-8460-411c3969ab01', False, 'hmlt1-duckr-abvhurricanemillstn', 'HMLT1-DuckR-abvHurricaneMillsTN', ST_GeomFromText('POINT(-87.7431 35.9297)',4326), null, null, '2021-03-12T16:00:20.219426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('d40d94c2-4b9c-411f-82d2-e48ad6d1119b', False, 'vert1-pineyr-vernontn', 'VERT1-PineyR-VernonTN', ST_GeomFromText('POINT(-87.5014 35.8711)',4326), null, null, '2021-03-12T16:00:20.219839Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03602500'),
+('0b5abf6f-d9e5-42fb-bf70-8965a8da9965', False, 'fltt1-buffalor-nrflatwoodstn', 'FLTT1-BuffaloR-nrFlatWoodsTN', ST_GeomFromText('POINT(-87.8328 35.4958)',4326), null, null, '2021-03-12T16:00:20.220271Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03604000'),
+('2a5999e7-03b5-45e3-9161-215e4fdd8167', False, 'jpht1-j-percy-priest', 'JPHT1-J_PERCY_PRIEST', ST_GeomFromText('POINT(-86.6194 36.1489)',4326), null, null, '2021-03-12T16:00:20.220815Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('f8acc6f8-9158-482d-9074-6f2c04f402a0', False, 'jppt1-j-percy-priest', 'JPPT1-J_PERCY_PRIEST', ST_GeomFromText('POINT(-86.6181 36.1567)',4326), null, null, '2021-03-12T16:00:20.221264Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('184e1fa0-dd7b-4ef3-a571-1708ca60874d', False, 'blwk2-rockcastler-billowsky', 'BLWK2-RockcastleR-BillowsKY', ST_GeomFromText('POINT(-84.2967 37.1711)',4326), null, null, '2021-03-12T16:00:20.221657Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03406500'),
+('0badc723-222f-47e1-a723-8ccd56363484', False, 'bygt1-wolfr-byrdstowntn', 'BYGT1-WolfR-ByrdstownTN', ST_GeomFromText('POINT(-85.0728 36.8631)',4326), null, null, '2021-03-12T16:00:20.222133Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03416000'),
+('7fabbbeb-74da-4853-af05-497eb59b17ac', False, 'cclk2-cranks-creek', 'CCLK2-CRANKS_CREEK', ST_GeomFromText('POINT(-83.2383 36.7394)',4326), null, null, '2021-03-12T16:00:20.222612Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('b11b1374-9353-4247-9366-b03ec33097bc', False, 'cdzk2-littler-cadizky', 'CDZK2-LittleR-CadizKY', ST_GeomFromText('POINT(-87.7217 36.7778)',4326), null, null, '2021-03-12T16:00:20.223034Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03438000'),
+('94945eaa-d3ac-4c69-8fe7-314607ef41b2', False, 'last1-efkstonesr-lascassastn', 'LAST1-EFkStonesR-LascassasTN', ST_GeomFromText('POINT(-86.3336 35.9183)',4326), null, null, '2021-03-12T16:00:20.223525Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03427500'),
+('49a8d0e0-08c1-46ff-838f-51812f118415', False, 'jagt1-efkobeyr-jamestowntn', 'JAGT1-EFkObeyR-JamestownTN', ST_GeomFromText('POINT(-85.0261 36.4158)',4326), null, null, '2021-03-12T16:00:20.223992Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('1afa8d2f-8d7e-4ca9-9b17-d49d11cdf84a', False, 'cfkt1-clearfkr-nrrobinstn', 'CFKT1-ClearFkR-nrRobinsTN', ST_GeomFromText('POINT(-84.6302 36.3882)',4326), null, null, '2021-03-12T16:00:20.224378Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03409500'),
+('52fa325e-25ef-4d95-9253-a31bf613afd4', False, 'frat1-harpethr-franklintn', 'FRAT1-HarpethR-FranklinTN', ST_GeomFromText('POINT(-86.8656 35.9211)',4326), null, null, '2021-03-12T16:00:20.224848Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03432350'),
+('f8083600-9aca-4d24-a87e-1b290aaea940', False, 'lylk2-cumberlandr-loyallky', 'LYLK2-CumberlandR-LoyallKY', ST_GeomFromText('POINT(-83.3544 36.8478)',4326), null, null, '2021-03-12T16:00:20.225364Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03401000'),
+('dfe34baa-508b-4471-9ac1-b918021ae6a9', False, 'mugt1-efkstonesr-murfreesborotn', 'MUGT1-EFkStonesR-MurfreesboroTN', ST_GeomFromText('POINT(-86.4286 35.9025)',4326), null, null, '2021-03-12T16:00:20.225805Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03428200'),
+('ee6d3280-490b-498e-ab9f-90e5698cd181', False, 'port1-redr-portroyaltn', 'PORT1-RedR-PortRoyalTN', ST_GeomFromText('POINT(-87.1422 36.5539)',4326), null, null, '2021-03-12T16:00:20.226285Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03436100'),
+('e8d3b2e6-3b58-410f-96bb-6f8453529940', False, 'strk2-sfkcumberlandr-stearnsky', 'STRK2-SFkCumberlandR-StearnsKY', ST_GeomFromText('POINT(-84.5325 36.6292)',4326), null, null, '2021-03-12T16:00:20.226776Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03410500'),
+('2a76c74a-aada-4995-8fa6-3722a521fd32', False, 'nrvt1-newr-nrrobinstn', 'NRVT1-NewR-nrRobinsTN', ST_GeomFromText('POINT(-84.5548 36.3854)',4326), null, null, '2021-03-12T16:00:20.227233Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03408500'),
+('a129aa7b-7ec4-4f2d-88ae-457caa2adec7', False, 'smlk2-cumberlandr-smithlandky', 'SMLK2-CumberlandR-SmithlandKY', ST_GeomFromText('POINT(-88.3997 37.1467)',4326), null, null, '2021-03-12T16:00:20.227728Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('04117943-7a09-4015-b476-cf3f5cd469ba', False, 'mcgt1-collinsr-mcminnvilletn', 'MCGT1-CollinsR-McMinnvilleTN', ST_GeomFromText('POINT(-85.7319 35.7083)',4326), null, null, '2021-03-12T16:00:20.228209Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03421000'),
+('67d97a3a-a3d5-4c8c-9aa2-7329065c902c', False, 'pvlk2-cumberlandr-pinevilleky', 'PVLK2-CumberlandR-PinevilleKY', ST_GeomFromText('POINT(-83.6925 36.7644)',4326), null, null, '2021-03-12T16:00:20.228751Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03402900'),
+('2bb2b7cf-5ad8-4158-a42b-9727f61a02a8', False, 'lbst1-springcr-lebanontn', 'LBST1-SpringCr-LebanonTN', ST_GeomFromText('POINT(-86.2369 36.2217)',4326), null, null, '2021-03-12T16:00:20.229294Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03425520'),
+('07a61743-18ae-42ef-b47d-c4e20096b086', False, 'pent1-cumberlandr-penitentiarybrtn', 'PENT1-CumberlandR-PenitentiaryBrTN', ST_GeomFromText('POINT(-85.5958 36.4394)',4326), null, null, '2021-03-12T16:00:20.229796Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03417600'),
+('32d49c6a-b02c-4d4d-ba52-9a528cd1c80f', False, 'mdlk2-yellowcr-middlesboroky', 'MDLK2-YellowCr-MiddlesboroKY', ST_GeomFromText('POINT(-83.6881 36.6678)',4326), null, null, '2021-03-12T16:00:20.230478Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('2a045b91-fdda-45d0-b264-6c21b9790e36', False, 'jnct1-jenningscr-whitleyvilletn', 'JNCT1-JenningsCr-WhitleyvilleTN', ST_GeomFromText('POINT(-85.6747 36.4403)',4326), null, null, '2021-03-12T16:00:20.231137Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03418224'),
+('1ff25fb7-8cb9-49b1-a83c-4917e1842b6e', False, 'gvst1-smithfkcr-temperancehalltn', 'GVST1-SmithFkCr-TemperanceHallTN', ST_GeomFromText('POINT(-85.9078 36.0875)',4326), null, null, '2021-03-12T16:00:20.231800Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03424730'),
+('89e3534d-b0a3-422a-82ca-58b3b0e0871a', False, 'sxtk2-clearfk-saxtonky', 'SXTK2-ClearFk-SaxtonKY', ST_GeomFromText('POINT(-84.1144 36.6339)',4326), null, null, '2021-03-12T16:00:20.232482Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03403910'),
+('3e55442c-b36a-493c-b2f8-6fec535fc2cc', False, 'njnt1-tennesseer-nrnewjohnsonvilletn', 'NJNT1-TennesseeR-nrNewJohnsonvilleTN', ST_GeomFromText('POINT(-88.0003 35.0178)',4326), null, null, '2021-03-12T16:00:20.233277Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('8714bb02-ecdf-4116-9f37-9bb8158d0a1d', False, 'kint1-harpethr-kingstonsprngstn', 'KINT1-HarpethR-KingstonSprngsTN', ST_GeomFromText('POINT(-87.0989 36.1222)',4326), null, null, '2021-03-12T16:00:20.233965Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03434500'),
+('f1a69f11-6790-4d16-92b0-7bf2907d4039', False, 'hort1-horsecr-nrsavannahtn', 'HORT1-HorseCr-nrSavannahTN', ST_GeomFromText('POINT(-88.2094 35.1806)',4326), null, null, '2021-03-12T16:00:20.234753Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('a5a15c22-bced-4ea0-8342-a56df99d753f', False, 'emgt1-yellowcr-ellismillstn', 'EMGT1-YellowCr-EllisMillsTN', ST_GeomFromText('POINT(-87.5536 36.3108)',4326), null, null, '2021-03-12T16:00:20.235447Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03436690'),
+('38f9a880-6c47-4b1c-9476-92daa6b8164b', False, 'mtck2-beavercr-monticelloky', 'MTCK2-BeaverCr-MonticelloKY', ST_GeomFromText('POINT(-84.8961 36.7975)',4326), null, null, '2021-03-12T16:00:20.236236Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03413200'),
+('7d7e410b-ea8d-48a9-b09f-a6f7212b4ebe', False, 'colt1-duckr-columbiatn', 'COLT1-DuckR-ColumbiaTN', ST_GeomFromText('POINT(-87.0325 35.6181)',4326), null, null, '2021-03-12T16:00:20.237027Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('e1508b90-1bcf-4608-acae-0ab4e1ef01d4', False, 'ohit1-old-hickory', 'OHIT1-OLD_HICKORY', ST_GeomFromText('POINT(-86.6589 36.2972)',4326), null, null, '2021-03-12T16:00:20.237762Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('6758f2d6-ac16-4a8d-8a89-676578f6a639', False, 'wlbk2-cumberlandr-williamsburgky', 'WLBK2-CumberlandR-WilliamsburgKY', ST_GeomFromText('POINT(-84.1564 36.7436)',4326), null, null, '2021-03-12T16:00:20.238470Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03404000'),
+('8d3041bc-ff78-4dca-8044-a904ec35321f', False, 'shvt1-duckr-nrshelbyvilletn', 'SHVT1-DuckR-nrShelbyvilleTN', ST_GeomFromText('POINT(-86.4992 35.4803)',4326), null, null, '2021-03-12T16:00:20.239248Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('e88f7b9e-772d-42a3-9d66-33dad5c6b3a8', False, 'fldt1-fort-loudon', 'FLDT1-FORT_LOUDON', ST_GeomFromText('POINT(-84.2456 35.7922)',4326), null, null, '2021-03-12T16:00:20.239845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('bf472550-0dfc-4310-ab39-19160a8e073a', False, 'lvgt1-livingstontn', 'LVGT1-LivingstonTN', ST_GeomFromText('POINT(-85.3167 36.3833)',4326), null, null, '2021-03-12T16:00:20.240266Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('60804aad-a4c4-41a5-938c-989619315126', False, 'jnvt1', 'JNVT1', ST_GeomFromText('POINT(87.9758 36.0308)',4326), null, null, '2021-03-12T16:00:20.240891Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('eebb0956-f1c8-4d76-a78a-e823509fce4d', False, 'lebt1-lebanontn', 'LEBT1-LebanonTN', ST_GeomFromText('POINT(-86.3178 36.2286)',4326), null, null, '2021-03-12T16:00:20.241584Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('f017d31a-573e-4993-ba15-10d4224f08d9', False, 'unvt1-unionvilletn', 'UNVT1-UnionvilleTN', ST_GeomFromText('POINT(-86.5864 35.6211)',4326), null, null, '2021-03-12T16:00:20.242313Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('81c785c7-365b-49d8-b06b-f85fcd3479e7', False, 'wdbt1-woodburytn', 'WDBT1-WoodburyTN', ST_GeomFromText('POINT(-86.0733 35.8292)',4326), null, null, '2021-03-12T16:00:20.242975Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('6a6ca44d-f9f0-4f0e-ab52-ac7af764c6ab', False, 'lbyk2-libertyky', 'LBYK2-LibertyKY', ST_GeomFromText('POINT(-84.9333 37.3)',4326), null, null, '2021-03-12T16:00:20.243674Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('f882e4e4-7aaa-422f-a7dd-4a59dfb0a41a', False, 'nkjt1-nickajack', 'NKJT1-NICKAJACK', ST_GeomFromText('POINT(-85.6222 35.0017)',4326), null, null, '2021-03-12T16:00:20.244369Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('b8c41a11-f7b0-4a01-8145-4f28fdbbfde9', False, 'wbot1-watts-bar', 'WBOT1-WATTS_BAR', ST_GeomFromText('POINT(-84.7886 35.6086)',4326), null, null, '2021-03-12T16:00:20.245101Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('103af248-70db-436b-9b98-882c6db5f95f', False, 'orlt1-orlindatn', 'ORLT1-OrlindaTN', ST_GeomFromText('POINT(-86.7153 36.6025)',4326), null, null, '2021-03-12T16:00:20.245823Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('884801ba-13ec-4638-8f8e-fda673a63fb2', False, 'cntt1', 'CNTT1', ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:00:20.246794Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('51055b7d-d298-4971-ba98-08de16960b74', False, 'ctnk2-cantonky', 'CTNK2-CantonKY', ST_GeomFromText('POINT(-87.9644 36.7942)',4326), null, null, '2021-03-12T16:00:20.247273Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('b1ae57c6-40dc-4fde-9852-e42bdabd4d2e', False, 'coot1-cookevilletn', 'COOT1-CookevilleTN', ST_GeomFromText('POINT(-85.5 36.15)',4326), null, null, '2021-03-12T16:00:20.247825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('13c24865-3fab-4a81-acd5-4bc84334cd2b', False, 'kwtt1-knoxvilletn-wtp', 'KWTT1-KnoxvilleTN-WTP', ST_GeomFromText('POINT(-83.9289 5.9456)',4326), null, null, '2021-03-12T16:00:20.248522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('cd2b9a42-0f5a-4660-9c53-10ebdc7d422f', False, 'brak2-bereaky', 'BRAK2-BereaKY', ST_GeomFromText('POINT(-84.2525 37.5744)',4326), null, null, '2021-03-12T16:00:20.249243Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('2cba28f2-a50f-4ee4-9653-96d6dbaaa74c', False, 'cbbk2-cobbky', 'CBBK2-CobbKY', ST_GeomFromText('POINT(-87.7781 36.9933)',4326), null, null, '2021-03-12T16:00:20.249910Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('14e6dda0-c35e-4b77-8c38-7e126a43fa30', False, 'ektk2-elktonky', 'EKTK2-ElktonKY', ST_GeomFromText('POINT(-87.1022 36.8022)',4326), null, null, '2021-03-12T16:00:20.250455Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('69bad009-9c10-446a-a782-84b847554bbe', False, 'hbft1-harpethr-blwfranklin', 'HBFT1-HarpethR-blwFranklin', ST_GeomFromText('POINT(-86.8817 35.9481)',4326), null, null, '2021-03-12T16:00:20.250856Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03432400'),
+('e6f235c0-acff-4145-af79-f80065678138', False, 'bigt1-bigsandyr-brucetontn', 'BIGT1-BigSandyR-BrucetonTN', ST_GeomFromText('POINT(-88.2289 36.0369)',4326), null, null, '2021-03-12T16:00:20.251348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03606500'),
+('f5284c44-6b5c-407d-9e9d-04ce3b942b22', False, 'mltt1-duckr-avbmilltowntn', 'MLTT1-DuckR-avbMilltownTN', ST_GeomFromText('POINT(-86.7789 35.5764)',4326), null, null, '2021-03-12T16:00:20.251816Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03599240'),
+('fc5b94e8-5d1b-43b6-973a-acaa2cd1a4d2', False, 'chpt1-cheatham', 'CHPT1-CHEATHAM', ST_GeomFromText('POINT(-87.2275 36.3144)',4326), null, null, '2021-03-12T16:00:20.252289Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('665586c1-2bbd-4218-8cb2-5515ec82faf8', False, 'ceht1-center-hill', 'CEHT1-CENTER_HILL', ST_GeomFromText('POINT(-85.8272 36.0939)',4326), null, null, '2021-03-12T16:00:20.252723Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('29b2ac8c-5ce0-4692-adf6-eab3c6dc33bc', False, 'lapk2-laurel', 'LAPK2-LAUREL', ST_GeomFromText('POINT(-84.2678 36.9625)',4326), null, null, '2021-03-12T16:00:20.253213Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('03961c4b-8278-4159-a5d7-9d7dd11cae34', False, 'cmbk2-poorfk-cumberlandky', 'CMBK2-PoorFk-CumberlandKY', ST_GeomFromText('POINT(-82.9939 36.9733)',4326), null, null, '2021-03-12T16:00:20.253670Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03400500'),
+('3c9d0224-ee9d-4738-91b5-8d407512d259', False, 'fnht1-fountaincr-nrfountainheightstn', 'FNHT1-FountainCr-nrFountainHeightsTN', ST_GeomFromText('POINT(-86.9419 35.5181)',4326), null, null, '2021-03-12T16:00:20.254348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03599450'),
+('3ee98fa5-5742-4161-908a-24d8053148ab', False, 'bpgt1-bethpagetn', 'BPGT1-BethpageTN', ST_GeomFromText('POINT(-86.3106 36.4872)',4326), null, null, '2021-03-12T16:00:20.255071Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('2ede45f1-2a9f-44c9-a40e-1eda74eca784', False, 'bbvk2-cumberlandr-barbourvilleky', 'BBVK2-CumberlandR-BarbourvilleKY', ST_GeomFromText('POINT(-83.8875 36.8631)',4326), null, null, '2021-03-12T16:00:20.255757Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03403500'),
+('0b6ee7cb-30b1-477a-94a0-cb0aaa2bb0f1', False, 'cnvt1-duckr-centervilletn', 'CNVT1-DuckR-CentervilleTN', ST_GeomFromText('POINT(-87.46 35.7842)',4326), null, null, '2021-03-12T16:00:20.256017Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03601990'),
+('e83479c4-637f-4cf9-80b5-37a891a72216', False, 'dblt1-bigrockcr-nrdoublebridgestn', 'DBLT1-BigRockCr-nrDoubleBridgesTN', ST_GeomFromText('POINT(-86.7686 35.5044)',4326), null, null, '2021-03-12T16:00:20.256702Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03599100'),
+('a10b6a71-84ad-4075-b7a9-94091f296ca8', False, 'mcet1-mcewentn', 'MCET1-McEwenTN', ST_GeomFromText('POINT(-87.6369 36.1069)',4326), null, null, '2021-03-12T16:00:20.257434Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('e03229c6-97ce-484b-9584-521189519f41', False, 'ohht1-old-hickory', 'OHHT1-OLD_HICKORY', ST_GeomFromText('POINT(-86.6589 36.2972)',4326), null, null, '2021-03-12T16:00:20.257747Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('9cb955c5-93cb-4402-822b-12e99580abe3', False, 'ohgt1-old-hickory', 'OHGT1-OLD_HICKORY', ST_GeomFromText('POINT(-86.6586 36.2969)',4326), null, null, '2021-03-12T16:00:20.258249Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('57b92e3d-19f6-4ce9-b711-d43f42fab3d9', False, 'barkley-spillway-gates', 'Barkley-Spillway-Gates', ST_GeomFromText('POINT(-88.2235 37.0207)',4326), null, null, '2021-03-12T16:00:20.258714Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('5f01ad2c-67aa-4cae-b7b3-36fb7a1881ab', False, 'cordell-hull-spillway-gates', 'Cordell-Hull-Spillway-Gates', ST_GeomFromText('POINT(-85.9432 36.2901)',4326), null, null, '2021-03-12T16:00:20.259052Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('828ffaa0-cfbc-4545-bb0e-8bacad7945da', False, 'cheatham-spillway-gates', 'Cheatham-Spillway-Gates', ST_GeomFromText('POINT(-87.2226 36.3196)',4326), null, null, '2021-03-12T16:00:20.259620Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('dd724991-14c8-4899-8489-f6ace618a088', False, 'bort1', 'BORT1', ST_GeomFromText('POINT(-86.8203 36.2175)',4326), null, null, '2021-03-12T16:00:20.260120Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431599'),
+('54f189a0-4f94-46ea-bdb5-616ccff04bad', False, 'nrct1', 'NRCT1', ST_GeomFromText('POINT(-86.8542 36.1511)',4326), null, null, '2021-03-12T16:00:20.260706Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431700'),
+('42a6e80b-5767-4ffe-b302-692cf97da00b', False, 'nbct1', 'NBCT1', ST_GeomFromText('POINT(-86.7611 36.13)',4326), null, null, '2021-03-12T16:00:20.261234Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431300'),
+('ffa13475-c097-4316-88e1-9a8ce6f67699', False, 'cumberlandnashville', 'CumberlandNashville', ST_GeomFromText('POINT(-86.7764 36.1672)',4326), null, null, '2021-03-12T16:00:20.261697Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '034315005'),
+('e9c58cf6-2093-4359-bba1-3f8ad409d273', False, 'mflk2-martins-fork', 'MFLK2-MARTINS_FORK', ST_GeomFromText('POINT(-83.2597 36.7506)',4326), null, null, '2021-03-12T16:00:20.261828Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03400798'),
+('f5dbba6e-7dc3-4c62-b833-c980f950ce9e', False, 'ckvt1-cumberlandr-clarksvilletn', 'CKVT1-CumberlandR-ClarksvilleTN', ST_GeomFromText('POINT(-87.3678 36.5414)',4326), null, null, '2021-03-12T16:00:20.262545Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03436500'),
+('a2fa2bde-b031-43d9-b00a-3302beb181c6', False, 'normandy-rain-near-normandy', 'Normandy_Rain_Near_Normandy', ST_GeomFromText('POINT(-86.2453 35.4575)',4326), null, null, '2021-03-12T16:00:20.263251Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('24924cc6-ac6d-49f3-bbc6-3a0fdf99323a', False, 'normandy-tailwater', 'NORMANDY_TAILWATER', ST_GeomFromText('POINT(-86.2467 35.4628)',4326), null, null, '2021-03-12T16:00:20.263737Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('e0821f6a-a83e-4939-b7e5-e6214d6370f8', False, 'normandy-headwater', 'Normandy_Headwater', ST_GeomFromText('POINT(-86.2472 35.4653)',4326), null, null, '2021-03-12T16:00:20.263816Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('afe26545-5c47-4c18-9d6c-bed4e5f42a8a', False, 'rwnk2-wolf-creek', 'RWNK2-WOLF_CREEK', ST_GeomFromText('POINT(-85.1394 36.8836)',4326), null, null, '2021-03-12T16:00:20.263889Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03414000'),
+('73f36663-edb9-4c1e-b3bd-7a56dd66695d', False, 'falt1-fallsstateparktn', 'FALT1-FallsStateParkTN', ST_GeomFromText('POINT(-85.3086 35.6825)',4326), null, null, '2021-03-12T16:00:20.264533Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('09247b70-5a7b-4830-ae49-28465698b875', False, 'fallingwaterr', 'FallingWaterR', ST_GeomFromText('POINT(-85.5214 36.0772)',4326), null, null, '2021-03-12T16:00:20.265113Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03423000'),
+('e6bc7078-e633-45fd-95a6-f3b0b954d915', False, 'cett1-center-hill', 'CETT1-CENTER_HILL', ST_GeomFromText('POINT(-85.8333 36.0917)',4326), null, null, '2021-03-12T16:00:20.265577Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('02ae0b07-11c5-4599-bda0-1c3f8cc7492b', False, 'south-chick-cr-near-chattanooga', 'South_Chick_Cr_near_Chattanooga', ST_GeomFromText('POINT(-85.2106 35.0136)',4326), null, null, '2021-03-12T16:00:20.266183Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('b81d3d17-3201-4611-aafc-1b594d8942f3', False, 'tenn-river-at-s-pittsburg', 'Tenn_River_at_S_Pittsburg', ST_GeomFromText('POINT(-85.6975 35.0114)',4326), null, null, '2021-03-12T16:00:20.266653Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('6c847545-5c33-4916-a837-6c4ff8cea45f', False, 'anderson-rain', 'Anderson_Rain', ST_GeomFromText('POINT(-85.8972 35.0028)',4326), null, null, '2021-03-12T16:00:20.267095Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('7b616286-586c-4a46-9573-b25cb76b8758', False, 'n-chick-cr-at-mile-straight', 'N_Chick_Cr_at_Mile_Straight', ST_GeomFromText('POINT(-85.2153 35.2111)',4326), null, null, '2021-03-12T16:00:20.267593Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03566535'),
+('46c63d47-8fb2-4279-b1a5-b9fad7e05a00', False, 'mcdonald', 'McDonald', ST_GeomFromText('POINT(-84.9897 35.0967)',4326), null, null, '2021-03-12T16:00:20.268158Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('27fd522f-a619-43a2-8011-6cda06261819', False, 'cleveland', 'Cleveland', ST_GeomFromText('POINT(-84.8214 35.1836)',4326), null, null, '2021-03-12T16:00:20.268836Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('f9a665ed-ed3e-4593-b02c-bfc852e742f4', False, 'georgetown', 'Georgetown', ST_GeomFromText('POINT(-84.9656 35.3217)',4326), null, null, '2021-03-12T16:00:20.269396Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('b8a42122-b8f0-411d-9576-8d24f0091729', False, 'sequatchie-river-near-whitwell', 'Sequatchie_River_near_Whitwell', ST_GeomFromText('POINT(-85.4972 35.2064)',4326), null, null, '2021-03-12T16:00:20.269996Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('b0fd85c0-2c44-4081-b390-d50a804e729a', False, 'whitwell', 'Whitwell', ST_GeomFromText('POINT(-85.5194 35.2097)',4326), null, null, '2021-03-12T16:00:20.270480Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('6ac6c1fc-f21c-4780-ae21-b9c4ae7084cb', False, 'raccoon-mountain-near-whitwell', 'Raccoon_Mountain_near_Whitwell', ST_GeomFromText('POINT(-85.3875 35.0567)',4326), null, null, '2021-03-12T16:00:20.270985Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('7816be03-2d3f-45fe-9390-6f194fa13821', False, 'wckg1-westchickamaugacr-lakeviewga', 'WCKG1-WestChickamaugaCr-LakeviewGA', ST_GeomFromText('POINT(-85.2056 34.9572)',4326), null, null, '2021-03-12T16:00:20.271454Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03567340'),
+('b9d0b218-08c9-4a91-9028-811f98542a54', False, 'ccfg1-chattanoogacr-flintstonega', 'CCFG1-ChattanoogaCr-FlintstoneGA', ST_GeomFromText('POINT(-85.3344 34.9572)',4326), null, null, '2021-03-12T16:00:20.271967Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03568400'),
+('43c6c79f-9c45-49b0-a8f0-bbbf81db0d51', False, 'sckg1-southchickamaugacr-ringoldga', 'SCKG1-SouthChickamaugaCr-RingoldGA', ST_GeomFromText('POINT(-85.1256 34.9189)',4326), null, null, '2021-03-12T16:00:20.272449Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03566700'),
+('fc1a7d87-5598-49b0-beb9-3779829fc8d0', False, 'kydk2-kentucky', 'KYDK2-KENTUCKY', ST_GeomFromText('POINT(-88.2678 37.0144)',4326), null, null, '2021-03-12T16:00:20.272983Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, null),
+('87126358-87ec-4974-90f3-a40f14c0d2b3', False, 'ctht1-cumberlandr-carthagetn', 'CTHT1-CumberlandR-CarthageTN', ST_GeomFromText('POINT(-85.9564 36.2475)',4326), null, null, '2021-03-12T16:00:20.273107Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03425000'),
+('b24fc5a4-962c-4a0b-a1b4-d2f08a55989b', False, 'antt1-millcr-antiochtn', 'ANTT1-MillCr-AntiochTN', ST_GeomFromText('POINT(-86.6808 36.0817)',4326), null, null, '2021-03-12T16:00:20.273676Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03430400'),
+('6fcd34f0-dbc7-4ad2-bdbc-4eddd8277322', False, 'yamacraw', 'Yamacraw', ST_GeomFromText('POINT(-84.5631 36.7028)',4326), null, null, '2021-03-12T16:00:20.273969Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03410590'),
+('b172624d-0eab-4c0b-9e95-47438e1b4afd', False, 'cumbr-ashlandcity', 'CumbR_AshlandCity', ST_GeomFromText('POINT(-87.0761 36.2706)',4326), null, null, '2021-03-12T16:00:20.274443Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431790'),
+('6b20e874-02f0-40de-95ce-bb1f3e565d46', False, 'bledsoe-cr-nr-bethpage', 'Bledsoe_Cr_nr_Bethpage', ST_GeomFromText('POINT(-86.332 36.4456)',4326), null, null, '2021-03-12T16:00:20.275301Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03425622'),
+('cec009f7-5cd7-4194-980a-ebdc69995f0d', False, 'duckr-blw-manchester', 'DuckR_blw_Manchester', ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:00:20.276038Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03596000'),
+('b1d3fc99-abaf-4055-b4e6-6ab0c9a60c3d', False, 'duckr-nr-pottsville', 'DuckR_nr_Pottsville', ST_GeomFromText('POINT(-86.8714 35.5703)',4326), null, null, '2021-03-12T16:00:20.277067Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03599419'),
+('9e569018-ce7e-4a89-8f8e-0fdf0aed9adf', False, 'duckr-nr-shadygrove', 'DuckR_nr_ShadyGrove', ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:00:20.278362Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03601600'),
+('9cda93fc-a495-4722-8834-7023a281b66a', False, 'redr-at-dot', 'RedR_at_Dot', ST_GeomFromText('POINT(-86.9521 36.6767)',4326), null, null, '2021-03-12T16:00:20.279487Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03435105'),
+('2a86face-8c5b-48c8-8c4f-def396a8489a', False, 'roaringr-nr-hilham', 'RoaringR_nr_Hilham', ST_GeomFromText('POINT(-85.4264 36.3408)',4326), null, null, '2021-03-12T16:00:20.279832Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03418000'),
+('554a7daa-b00f-4d14-a959-68c989ce5bda', False, 'wartracecr-at-wartrace', 'WartraceCr_at_Wartrace', ST_GeomFromText('POINT(-86.3403 35.5272)',4326), null, null, '2021-03-12T16:00:20.280774Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03597590'),
+('7a40ac4f-904a-4068-9efb-ef41e81db0b7', False, 'buckcr-shopville', 'BuckCr_Shopville', ST_GeomFromText('POINT(-84.4644 37.2106)',4326), null, null, '2021-03-12T16:00:20.281760Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03407500'),
+('5a1b8894-1fb1-4289-b943-8f74b28d7f76', False, 'millcr-nolensvll', 'MillCr_Nolensvll', ST_GeomFromText('POINT(-86.7015 36.0091)',4326), null, null, '2021-03-12T16:00:20.282084Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03430550'),
+('aa0341b6-4bed-4683-bb50-7ccb5da4eff7', False, 'drycr-edenwold', 'DryCr_Edenwold', ST_GeomFromText('POINT(-86.7063 36.2845)',4326), null, null, '2021-03-12T16:00:20.282874Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03426470'),
+('902be564-bff4-4495-bc2c-388ddcf998d4', False, 'sflittler-hopkin', 'SFLittleR Hopkin', ST_GeomFromText('POINT(-87.4289 36.8494)',4326), null, null, '2021-03-12T16:00:20.283830Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03437495'),
+('90d6254b-f8ff-47ff-b97a-981e710e7160', False, 'acst1-sycamorecr-ashlandcitytn', 'ACST1-SycamoreCr-AshlandCityTN', ST_GeomFromText('POINT(-87.0539 36.3231)',4326), null, null, '2021-03-12T16:00:20.284163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03431800'),
+('cc2b904b-d99f-4583-8fb7-056ba1ba320f', False, 'cork2-lynncampcr-corbinky', 'CORK2-LynnCampCr-CorbinKY', ST_GeomFromText('POINT(-84.0875 36.945)',4326), null, null, '2021-03-12T16:00:20.285218Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03404900'),
+('b7abd272-ba0c-428b-88a4-a2dcc5bcda0e', False, 'cbnk2-laurelr-corbinky', 'CBNK2-LaurelR-CorbinKY', ST_GeomFromText('POINT(-84.1194 36.9706)',4326), null, null, '2021-03-12T16:00:20.286441Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03404820'),
+('e7902c45-6800-4b84-a70e-b70e77d43bb1', False, 'duckr-abv-williamsport', 'DuckR_abv_Williamsport', ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:00:20.288155Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03600358'),
+('ed9c351b-c5cd-411c-aa45-fbca9ac69d7d', False, 'belt1-harpethr-bellevuetn', 'BELT1-HarpethR-BellevueTN', ST_GeomFromText('POINT(-86.9286 35.0547)',4326), null, null, '2021-03-12T16:00:20.289424Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03433500'),
+('0929af2a-a93e-4aaa-a926-cfeeba57372b', False, 'cmtt1-cypresscr-camdentn', 'CMTT1-CypressCr-CamdenTN', ST_GeomFromText('POINT(-88.0758 36.0469)',4326), null, null, '2021-03-12T16:00:20.290678Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03605078'),
+('8c48ae3d-4581-4aa5-8b81-592c907dd7a0', False, 'crocus-cr', 'Crocus Cr', ST_GeomFromText('POINT(-85.3107 36.8967)',4326), null, null, '2021-03-12T16:00:20.291845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03414078'),
+('b5a6d737-0f49-4d0a-9405-5fded7f886ea', False, 'stonesr-donelson', 'StonesR_Donelson', ST_GeomFromText('POINT(-86.6334 36.1862)',4326), null, null, '2021-03-12T16:00:20.292705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '2bd0903c-6e4d-4d83-8558-1eab52079de0', '00000000-0000-0000-0000-000000000000', null, '03430200');
 
 --INSERT INSTRUMENT STATUS--
 INSERT INTO instrument_status(id, instrument_id, status_id, "time")
@@ -89,367 +89,367 @@ where i.project_id = 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682';
 --Ignoring Blakely-Weir platform sensor site/instrument, already in instruments unique list
 
 --INSERT INSTRUMENTS--COUNT:359
-INSERT INTO instrument(id, deleted, slug, name, formula, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
+INSERT INTO instrument(id, deleted, slug, name, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
  VALUES 
-('458c2b88-20aa-47eb-ade8-c9c70cdcb7a2', False, 'mvk-test-id', 'MVK Test ID', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.830897Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('5ad04097-9848-4c3c-bc2d-12642cc2d1a3', False, 'low-water-bridge', 'Low Water Bridge', null, ST_GeomFromText('POINT(-90.9548 32.6699)',4326), null, null, '2021-03-12T16:05:05.831156Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('807e9ddc-89b1-4808-878f-81df22ff1c2f', False, 'smackover', 'Smackover', null, ST_GeomFromText('POINT(-92.7772 33.3755)',4326), null, null, '2021-03-12T16:05:05.831256Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07362100'),
-('3210e1ba-ac87-47fe-a616-496bbfca07d9', False, 'girard', 'Girard', null, ST_GeomFromText('POINT(-91.7982 32.481)',4326), null, null, '2021-03-12T16:05:05.831320Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07368000'),
-('629cbb30-542d-49cc-8e36-11ed090ae53c', False, 'rochelle', 'Rochelle', null, ST_GeomFromText('POINT(-92.3451 31.7546)',4326), null, null, '2021-03-12T16:05:05.831413Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07372200'),
-('9caf9758-5a22-4bc9-9bae-13c9ee894dbc', False, 'garrett-bridge', 'Garrett Bridge', null, ST_GeomFromText('POINT(-91.6563 33.8664)',4326), null, null, '2021-03-12T16:05:05.831502Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07364133'),
-('5fd5abae-68fa-46f4-8c46-5d5e1c34f88a', False, 'rye', 'Rye', null, ST_GeomFromText('POINT(-92.0258 33.7008)',4326), null, null, '2021-03-12T16:05:05.831640Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07363500'),
-('8ef81ef8-505c-449b-9e7e-e211a78800b5', False, 'grenada', 'Grenada', null, ST_GeomFromText('POINT(-89.81 33.788)',4326), null, null, '2021-03-12T16:05:05.831764Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07285500'),
-('466899f5-f5f2-440a-a7d7-1bd8f53994bc', False, 'ross-barnett', 'Ross Barnett', null, ST_GeomFromText('POINT(-90.0645 32.3975)',4326), null, null, '2021-03-12T16:05:05.831931Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02485601'),
-('985e5d4c-d7e3-4fc7-b65b-fdd641f24832', False, 'ross-barnett-tailwater', 'Ross Barnett-Tailwater', null, ST_GeomFromText('POINT(-90.0645 32.3971)',4326), null, null, '2021-03-12T16:05:05.831931Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('bfc194f9-27e5-49ab-9f03-5841419d341f', False, 'state-line', 'State Line', null, ST_GeomFromText('POINT(-91.438 33.0014)',4326), null, null, '2021-03-12T16:05:05.832140Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07367690'),
-('e85b0697-caae-4ad3-a762-f4fbb3bbf6b9', False, 'kilbourne_254a', 'Kilbourne', null, ST_GeomFromText('POINT(-91.2623 32.9923)',4326), null, null, '2021-03-12T16:05:05.832269Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07369700'),
-('f92a4af3-6c7c-4876-b163-3124b3b073c5', False, 'spring-bank', 'Spring Bank', null, ST_GeomFromText('POINT(-93.8594 33.0894)',4326), null, null, '2021-03-12T16:05:05.832418Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07344370'),
-('e23cffbd-26c1-444b-a7dd-368992a16f2c', False, 'shed-road', 'Shed Road', null, ST_GeomFromText('POINT(-93.6461 32.5614)',4326), null, null, '2021-03-12T16:05:05.832520Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349298'),
-('1ee31431-77f7-496c-90c8-f4e4fc14982f', False, 'dogwood-trail', 'Dogwood Trail', null, ST_GeomFromText('POINT(-93.6346 32.5698)',4326), null, null, '2021-03-12T16:05:05.832722Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349849'),
-('03b0cd42-0834-4625-a860-8dc088fb1398', False, 'long-lake', 'Long Lake', null, ST_GeomFromText('POINT(-90.9142 32.4442)',4326), null, null, '2021-03-12T16:05:05.832964Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07288955'),
-('8894b9e7-76af-419d-939e-73b079d9d6a8', False, 'olive-branch', 'Olive Branch', null, ST_GeomFromText('POINT(-89.7533 37.9075)',4326), null, null, '2021-03-12T16:05:05.833371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07275900'),
-('edafdfda-ef93-4b83-af86-e7bcf4706293', False, 'jones', 'Jones', null, ST_GeomFromText('POINT(-91.6557 32.9902)',4326), null, null, '2021-03-12T16:05:05.833547Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07364200'),
-('8f363c1e-7237-485b-88cf-7dfc4fb92c0c', False, 'delhi', 'Delhi', null, ST_GeomFromText('POINT(-91.4761 32.4579)',4326), null, null, '2021-03-12T16:05:05.833670Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07370000'),
-('3bda439b-5229-4bf1-96fa-d2b1914644c6', False, 'alto-lafourche', 'Alto_Lafourche', null, ST_GeomFromText('POINT(-91.8808 32.3738)',4326), null, null, '2021-03-12T16:05:05.833763Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07369050'),
-('8bb007c9-971b-4cc5-b3d1-2ab74b6f5aa7', False, 'pearl-river', 'Pearl River', null, ST_GeomFromText('POINT(-89.7367 30.385)',4326), null, null, '2021-03-12T16:05:05.833899Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492600'),
-('fb02b02a-72ff-4909-a3bb-df9c90b752cc', False, 'jackson-backup', 'Jackson Backup', null, ST_GeomFromText('POINT(-90.1786 32.2817)',4326), null, null, '2021-03-12T16:05:05.834013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02486000'),
-('fedb39c7-34d5-4eee-a88b-e29c964d3c2b', False, 'ratliffs-ferry', 'Ratliffs Ferry', null, ST_GeomFromText('POINT(-89.8406 32.5942)',4326), null, null, '2021-03-12T16:05:05.834121Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02484650'),
-('03877a52-1332-47be-ab5f-4effa290f468', False, 'etta', 'Etta', null, ST_GeomFromText('POINT(-89.2241 34.4821)',4326), null, null, '2021-03-12T16:05:05.834264Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07268000'),
-('ab966e73-75bb-4dbc-969c-64e75a10316f', False, 'walnut-grove', 'Walnut Grove', null, ST_GeomFromText('POINT(-89.465 32.5883)',4326), null, null, '2021-03-12T16:05:05.834567Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02483000'),
-('02397d12-99a0-4ff5-b1be-f382e2eb1994', False, 'little-rock', 'Little Rock', null, ST_GeomFromText('POINT(-92.2692 34.7497)',4326), null, null, '2021-03-12T16:05:05.834749Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07263500'),
-('c285ad47-2e2d-463e-8a41-c0ca710069bb', False, 'west', 'West', null, ST_GeomFromText('POINT(-89.7711 33.1944)',4326), null, null, '2021-03-12T16:05:05.834819Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07289350'),
-('594998b1-55a5-48fd-954b-f4e6badbedb6', False, 'kosciusko', 'Kosciusko', null, ST_GeomFromText('POINT(-89.5778 33.0319)',4326), null, null, '2021-03-12T16:05:05.834968Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02484000'),
-('8c7f72c5-e787-4120-bc9f-c54454476b39', False, 'philadelphia', 'Philadelphia', null, ST_GeomFromText('POINT(-89.0978 32.8414)',4326), null, null, '2021-03-12T16:05:05.835085Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02481880'),
-('106960cf-9d65-4f47-b892-58c8a187066c', False, 'edinburg', 'Edinburg', null, ST_GeomFromText('POINT(-89.3361 32.7986)',4326), null, null, '2021-03-12T16:05:05.835230Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02482000'),
-('55a9d9cd-fe05-4a59-92ff-d1ce9a77d347', False, 'carthage', 'Carthage', null, ST_GeomFromText('POINT(-89.5264 32.7069)',4326), null, null, '2021-03-12T16:05:05.835377Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02482550'),
-('0cec4a83-e656-495b-8dc3-9d5b790411f6', False, 'ofahoma', 'Ofahoma', null, ST_GeomFromText('POINT(-89.6722 32.7056)',4326), null, null, '2021-03-12T16:05:05.835522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02484500'),
-('4e54b51a-de28-426e-8983-a9308029c379', False, 'merigold', 'Merigold', null, ST_GeomFromText('POINT(-90.6724 33.8319)',4326), null, null, '2021-03-12T16:05:05.835666Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07288280'),
-('d42748c9-24bd-486c-a172-8059c17d3e3e', False, 'lena', 'Lena', null, ST_GeomFromText('POINT(-89.6461 32.6672)',4326), null, null, '2021-03-12T16:05:05.835724Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02483500'),
-('1676ef54-4e74-4ef7-b77e-872ff5b70cad', False, 'tylertown', 'Tylertown', null, ST_GeomFromText('POINT(-90.2794 31.1769)',4326), null, null, '2021-03-12T16:05:05.835845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02490500'),
-('4d1c36ba-b2db-4bf8-b0ab-cf66d28a6671', False, 'pools-bluff', 'Pools Bluff', null, ST_GeomFromText('POINT(-89.8464 30.7058)',4326), null, null, '2021-03-12T16:05:05.836045Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02490193'),
-('7c25f0e4-512c-41a9-a28d-2c7ce8897ddc', False, 'pearl-l&d-3', 'Pearl L&D 3', null, ST_GeomFromText('POINT(-89.8681 30.6381)',4326), null, null, '2021-03-12T16:05:05.836163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02490200'),
-('8b3608c9-b01c-450a-9499-7e4973018d7c', False, 'pearl-l&d-3-upper-pool', 'Pearl L&D 3-Upper Pool', null, ST_GeomFromText('POINT(-89.8681 30.6381)',4326), null, null, '2021-03-12T16:05:05.836163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02490200'),
-('27ec6d01-e488-42cf-ba6b-ef6de26f6034', False, 'pearl-l&d-3-lock-chamber', 'Pearl L&D 3-Lock Chamber', null, ST_GeomFromText('POINT(-89.8681 30.6381)',4326), null, null, '2021-03-12T16:05:05.836163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('76d5dcaf-7917-4ef0-beb3-9752484f24fd', False, 'pearl-l&d-2', 'Pearl L&D 2', null, ST_GeomFromText('POINT(-89.8644 30.5931)',4326), null, null, '2021-03-12T16:05:05.836537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492100'),
-('57a7b9b3-b601-4d40-a639-35febe640ae3', False, 'pearl-l&d-2-upper-pool', 'Pearl L&D 2-Upper Pool', null, ST_GeomFromText('POINT(-89.8644 30.5931)',4326), null, null, '2021-03-12T16:05:05.836537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492100'),
-('fda90bcd-453d-4d60-b1a5-144ea5770e77', False, 'pearl-l&d-2-lock-chamber', 'Pearl L&D 2-Lock Chamber', null, ST_GeomFromText('POINT(-89.8644 30.5931)',4326), null, null, '2021-03-12T16:05:05.836537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('a813ee70-604f-4802-ba0d-ae86b97d3c3a', False, 'pearl-l&d-1', 'Pearl L&D 1', null, ST_GeomFromText('POINT(-89.7797 30.4564)',4326), null, null, '2021-03-12T16:05:05.836969Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492519'),
-('4e8b21e3-8839-42c4-873c-8d5c16d9a685', False, 'pearl-l&d-1-upper-pool', 'Pearl L&D 1-Upper Pool', null, ST_GeomFromText('POINT(-89.7797 30.4564)',4326), null, null, '2021-03-12T16:05:05.836969Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492519'),
-('b60cda39-f15c-4b81-8d20-9ebc10ca7566', False, 'pearl-l&d-1-lock-chamber', 'Pearl L&D 1-Lock Chamber', null, ST_GeomFromText('POINT(-89.7797 30.4564)',4326), null, null, '2021-03-12T16:05:05.836969Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('4c3cff85-2b27-48e1-9e4a-deef3a5d01cf', False, 'bush', 'Bush', null, ST_GeomFromText('POINT(-89.8972 30.6292)',4326), null, null, '2021-03-12T16:05:05.837474Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492000'),
-('6f7c4ac2-882d-47d0-b84b-b9ff1883d3c7', False, 'franklinton', 'Franklinton', null, ST_GeomFromText('POINT(-90.1619 30.8428)',4326), null, null, '2021-03-12T16:05:05.837653Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02491500'),
-('be86e5c9-adac-4c20-b145-4e96edd06ffb', False, 'bogalusa', 'Bogalusa', null, ST_GeomFromText('POINT(-89.8208 30.7931)',4326), null, null, '2021-03-12T16:05:05.837825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02489500'),
-('869e6649-b162-4eee-9a1d-a11340133089', False, 'columbia', 'Columbia', null, ST_GeomFromText('POINT(-89.8483 31.2372)',4326), null, null, '2021-03-12T16:05:05.838007Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02489000'),
-('bd1f8775-a91f-4470-8002-e5fe705b9e92', False, 'monticello', 'Monticello', null, ST_GeomFromText('POINT(-90.0878 31.5533)',4326), null, null, '2021-03-12T16:05:05.838278Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02488500'),
-('6225e66d-c2c2-438c-83e8-0ca08a237099', False, 'benton', 'Benton', null, ST_GeomFromText('POINT(-92.6108 34.5682)',4326), null, null, '2021-03-12T16:05:05.838443Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07363000'),
-('04a7e155-a702-4118-9c77-08f8271156c3', False, 'walker-lake-pump', 'Walker Lake Pump', null, ST_GeomFromText('POINT(-90.223 33.4872)',4326), null, null, '2021-03-12T16:05:05.838579Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8aa249f0-c5ea-4c1c-9d36-48bf595719d8', False, 'walker-lake-pump-landside', 'Walker Lake Pump-Landside', null, ST_GeomFromText('POINT(-90.223 33.4872)',4326), null, null, '2021-03-12T16:05:05.838579Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('2a04ace4-2248-4d54-b78a-1cff9a1e2520', False, 'walker-lake-pump-riverside', 'Walker Lake Pump-Riverside', null, ST_GeomFromText('POINT(-90.223 33.4872)',4326), null, null, '2021-03-12T16:05:05.838579Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('19491961-345b-4e35-9c90-c69507a7caf0', False, 'blakely-piez#1', 'Blakely_Piez#1', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('0cf9b697-9493-40cd-bc2d-e608ef48b0bf', False, 'blakely-piezometer-1', 'Blakely-Piezometer 1', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8f035a2d-b946-481a-b33a-f792ae474435', False, 'blakely-piezometer-2', 'Blakely-Piezometer 2', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('211d5f89-03e0-48a0-a6bd-44ae7d6643b7', False, 'blakely-piezometer-3', 'Blakely-Piezometer 3', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('30cb8cb0-5121-4120-929e-558135258246', False, 'blakely-piezometer-4', 'Blakely-Piezometer 4', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('2e406cc7-3e9a-44af-bc48-a73634315352', False, 'blakely-piezometer-5', 'Blakely-Piezometer 5', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('0c9a15e6-17e5-4dc4-a539-0b12c437bc50', False, 'blakely-piezometer-6', 'Blakely-Piezometer 6', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('c38ba723-8d5f-45c6-839d-d3b5e7a83463', False, 'blakely-piezometer-6b', 'Blakely-Piezometer 6B', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('da022ca5-5446-40af-afee-ccaab061a68f', False, 'blakely-piezometer-7', 'Blakely-Piezometer 7', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('9a1e1641-4626-47b9-b0a2-fbd0d00b5e97', False, 'blakely-piezometer-8', 'Blakely-Piezometer 8', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('992c3239-df98-4b9b-8501-e09c1f09a36e', False, 'lake-bruin', 'Lake Bruin', null, ST_GeomFromText('POINT(-91.2039 31.9433)',4326), null, null, '2021-03-12T16:05:05.840141Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('a4417d0e-bb78-4298-b028-6e14df4c6fae', False, 'lake-bruin-river-stage', 'Lake Bruin-River Stage', null, ST_GeomFromText('POINT(-91.2039 31.9433)',4326), null, null, '2021-03-12T16:05:05.840141Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f7f13219-df0a-4fc9-868e-d82163b8eec8', False, 'lake-bruin-piezometer-1', 'Lake Bruin-Piezometer 1', null, ST_GeomFromText('POINT(-91.2039 31.9433)',4326), null, null, '2021-03-12T16:05:05.840141Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('02e559d4-b44b-404a-8c05-fd7350a0c20b', False, 'st-joesephs', 'St Joesephs', null, ST_GeomFromText('POINT(-91.2342 31.9133)',4326), null, null, '2021-03-12T16:05:05.840460Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('6af5222c-212d-4467-af23-a5390618e112', False, 'st-joesephs-river-stage', 'St Joesephs-River Stage', null, ST_GeomFromText('POINT(-91.2342 31.9133)',4326), null, null, '2021-03-12T16:05:05.840460Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('ddf0f7dc-76ae-478e-8a2c-c783d56890da', False, 'st-joesephs-piezometer-1', 'St Joesephs-Piezometer 1', null, ST_GeomFromText('POINT(-91.2342 31.9133)',4326), null, null, '2021-03-12T16:05:05.840460Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('11c168a0-2dd5-4a45-a4dc-1def38f45a36', False, 'st-joesephs-piezometer-2', 'St Joesephs-Piezometer 2', null, ST_GeomFromText('POINT(-91.2342 31.9133)',4326), null, null, '2021-03-12T16:05:05.840460Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('babfa8ea-762a-46a6-a8f9-f598bb9ac824', False, 'st-joesephs-piezometer-3', 'St Joesephs-Piezometer 3', null, ST_GeomFromText('POINT(-91.2342 31.9133)',4326), null, null, '2021-03-12T16:05:05.840460Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('b68f7ce1-a68d-4ca8-8000-65957b8ee40a', False, 'st-joesephs-piezometer-4', 'St Joesephs-Piezometer 4', null, ST_GeomFromText('POINT(-91.2342 31.9133)',4326), null, null, '2021-03-12T16:05:05.840460Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('02303a7d-626a-4c17-a23a-4c7acde8aafd', False, 'kemp-bend', 'Kemp Bend', null, ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('0d8489ae-4d18-4e7a-9714-5232153f9521', False, 'kemp-bend-river-stage', 'Kemp Bend-River Stage', null, ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('dd03b1e3-3b6c-4ade-9eed-2e5b3226c869', False, 'kemp-bend-piezometer-1', 'Kemp Bend-Piezometer 1', null, ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('d4c68bb6-dfb0-4b14-a953-fbd8b5c3e213', False, 'kemp-bend-piezometer-2', 'Kemp Bend-Piezometer 2', null, ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('b65c0f89-bedf-40ee-81de-e545466a29ab', False, 'kemp-bend-piezometer-3', 'Kemp Bend-Piezometer 3', null, ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f90f3f16-b980-4fec-b3d3-a3b9f66fd853', False, 'kemp-bend-piezometer-4', 'Kemp Bend-Piezometer 4', null, ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('d89b6053-6ad4-4370-ae83-4d7cbd8f2010', False, 'kemp-bend-well-1', 'Kemp Bend-Well 1', null, ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('0245cbd6-2b3b-42b0-9483-21013bd2b139', False, 'kemp-bend-well-2', 'Kemp Bend-Well 2', null, ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('ef710607-78a2-4fe1-afe9-f110a76c57a5', False, 'arkadelphia', 'Arkadelphia', null, ST_GeomFromText('POINT(-93.0464 34.1211)',4326), null, null, '2021-03-12T16:05:05.841504Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('577d9562-8eda-4557-9236-4c7c644c58a1', False, 'bl111', 'BL111', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.841630Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('1fed5716-c1b9-45a4-8bbb-9a1febfe7e3c', False, 'bl112', 'BL112', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.841749Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('5f9263df-0442-4bb4-a7b4-26267649c3b6', False, 'sl115', 'SL115', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.841836Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('0a62e568-f6b1-41f2-8d59-728367259844', False, 'bl113', 'BL113', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.841960Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('2fdb6919-2b62-4ac1-8c6e-00e9d1fd2311', False, 'bl115', 'BL115', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.842054Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('93bf60d1-826c-42f3-8040-69b217eeebea', False, 'l&d-3-lower', 'L&D 3-Lower', null, ST_GeomFromText('POINT(-92.7261 31.5198)',4326), null, null, '2021-03-12T16:05:05.842174Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('82e4fc73-e4c1-4940-bb61-50327296af03', False, 'l&d-3-upper', 'L&D 3-Upper', null, ST_GeomFromText('POINT(-92.728 31.5216)',4326), null, null, '2021-03-12T16:05:05.842292Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('7e6faf61-7b3d-4fd6-a8d9-755c927f313b', False, 'mid-pool-3', 'Mid Pool 3', null, ST_GeomFromText('POINT(-92.9648 31.7048)',4326), null, null, '2021-03-12T16:05:05.842409Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('42404400-fed0-4b3b-88dc-dd89e11372c0', False, 'bl116', 'BL116', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.842545Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('94032385-2f32-44ac-85ca-e8b052a181d5', False, 'dermott', 'Dermott', null, ST_GeomFromText('POINT(-91.4056 33.4939)',4326), null, null, '2021-03-12T16:05:05.842687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('a81128e7-9338-479f-ae10-68c22303ba6a', False, 'darling', 'Darling', null, ST_GeomFromText('POINT(-90.2903 34.3625)',4326), null, null, '2021-03-12T16:05:05.842818Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('36b8d189-c7fb-4db5-acbc-78f254a88dca', False, 'stuttgart', 'Stuttgart', null, ST_GeomFromText('POINT(-91.6164 34.4536)',4326), null, null, '2021-03-12T16:05:05.842944Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('2b816388-c22a-4063-b30a-6a145ac85a6c', False, 'grand-ecore', 'Grand Ecore', null, ST_GeomFromText('POINT(-93.0839 31.8251)',4326), null, null, '2021-03-12T16:05:05.843041Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('69c28050-211a-4059-a828-df4a0102c6ec', False, 'grady', 'Grady', null, ST_GeomFromText('POINT(-91.7097 34.0342)',4326), null, null, '2021-03-12T16:05:05.843224Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('4ed1f033-65d9-40c0-aee9-28247a25c195', False, 'transylvania', 'Transylvania', null, ST_GeomFromText('POINT(-91.2686 32.7144)',4326), null, null, '2021-03-12T16:05:05.843371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('c8e41cf7-553f-4096-8944-69153e138140', False, 'clarksdale-bigs', 'Clarksdale_BigS', null, ST_GeomFromText('POINT(-90.5753 34.1969)',4326), null, null, '2021-03-12T16:05:05.843472Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('de5d45dd-ec5f-4e4c-b375-f5bbb039d21c', False, 'bobo', 'Bobo', null, ST_GeomFromText('POINT(-90.1758 34.2892)',4326), null, null, '2021-03-12T16:05:05.843582Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('7c7d1540-4ccf-4dd2-ba9f-ecb786e25ade', False, 'marks-cassidy', 'Marks_Cassidy', null, ST_GeomFromText('POINT(-90.4269 34.231)',4326), null, null, '2021-03-12T16:05:05.843704Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('03d657d9-7171-43a6-8121-27627f699d03', False, 'st-louis', 'St Louis', null, ST_GeomFromText('POINT(-90.1833 38.6236)',4326), null, null, '2021-03-12T16:05:05.843795Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07010000'),
-('226147a7-14a1-4225-8a56-df950b7ddc41', False, 'cairo', 'Cairo', null, ST_GeomFromText('POINT(-89.1625 37.0)',4326), null, null, '2021-03-12T16:05:05.843958Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('aae4d98b-2dd3-4518-aca1-08a0c51a5015', False, 'memphis', 'Memphis', null, ST_GeomFromText('POINT(-90.0767 35.1231)',4326), null, null, '2021-03-12T16:05:05.844057Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07032000'),
-('49e8e224-037a-4f54-ae73-aaba817c7686', False, 'helena', 'Helena', null, ST_GeomFromText('POINT(-90.5842 34.5167)',4326), null, null, '2021-03-12T16:05:05.844121Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('61196e60-d8cf-4108-9b81-ebe0befc8593', False, 'arkabutla-lake', 'Arkabutla Lake', null, ST_GeomFromText('POINT(-90.1244 34.7572)',4326), null, null, '2021-03-12T16:05:05.844184Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('e7d4af0e-d6e3-4bba-975a-7e9a182ebccd', False, 'arkabutla-lake-piezometer-1a', 'Arkabutla Lake-Piezometer 1A', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.844184Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('055b8882-e430-47e4-8c41-d4fbe688430a', False, 'arkabutla-lake-piezometer-1b', 'Arkabutla Lake-Piezometer 1B', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.844184Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('e4365c38-71ec-4525-ba17-b201f5403fb3', False, 'arkabutla-lake-piezometer-2a', 'Arkabutla Lake-Piezometer 2A', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.844184Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('210a6218-600f-47fa-8721-813457eb850a', False, 'arkabutla-lake-piezometer-2b', 'Arkabutla Lake-Piezometer 2B', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.844184Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('3f43805c-8da4-418a-9890-a6f025d5ce0e', False, 'lambert', 'Lambert', null, ST_GeomFromText('POINT(-90.2144 34.1808)',4326), null, null, '2021-03-12T16:05:05.844632Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07280000'),
-('6d970695-2bea-4f89-9605-3bc711d45a5b', False, 'batesville', 'Batesville', null, ST_GeomFromText('POINT(-90.1721 33.7806)',4326), null, null, '2021-03-12T16:05:05.844853Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('6483e545-ca9c-4a1e-b3b8-74383d16e869', False, 'swan-lake', 'Swan Lake', null, ST_GeomFromText('POINT(-90.2766 33.8603)',4326), null, null, '2021-03-12T16:05:05.845017Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('e2388136-52f9-41b3-8a06-d3cf9ccb7390', False, 'whaley', 'Whaley', null, ST_GeomFromText('POINT(-90.1091 33.6314)',4326), null, null, '2021-03-12T16:05:05.845208Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('fa5fd6d5-6bd9-4d44-a523-b66292fa5bd7', False, 'degray-lake', 'Degray Lake', null, ST_GeomFromText('POINT(-93.1131 34.2147)',4326), null, null, '2021-03-12T16:05:05.845406Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('942adeba-ab7c-4ff0-8d57-c73cac455fcb', False, 'narrows-dam', 'Narrows Dam', null, ST_GeomFromText('POINT(-93.7153 34.1486)',4326), null, null, '2021-03-12T16:05:05.845620Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('02398292-51f1-44c9-bc22-26f039a51146', False, 'camden', 'Camden', null, ST_GeomFromText('POINT(-92.818 33.597)',4326), null, null, '2021-03-12T16:05:05.845773Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07362000'),
-('265fc11f-3b21-4795-80ac-a0c3c49eadb1', False, 'buck-chute', 'Buck Chute', null, ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('02212214-df31-4023-b7c1-56bdf6865bd2', False, 'buck-chute-water-elevation', 'Buck Chute-Water Elevation', null, ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('ed3f272b-8549-41bc-b1e0-5f91f77aa8bd', False, 'buck-chute-piezometer-1', 'Buck Chute-Piezometer 1', null, ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('050cc6b1-fdde-4bde-9041-f7d4ccd6901d', False, 'buck-chute-piezometer-2', 'Buck Chute-Piezometer 2', null, ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('94a773c5-ffb9-4504-a709-9c137498b2e6', False, 'buck-chute-piezometer-3', 'Buck Chute-Piezometer 3', null, ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f93a5df9-1331-4c8c-b422-8427f034a2ec', False, 'buck-chute-well-11', 'Buck Chute-Well 11', null, ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('2154b23c-42a0-4333-814b-a524833fab2f', False, 'buck-chute-well-12', 'Buck Chute-Well 12', null, ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('825436d6-3b25-405a-8a41-d553f809178a', False, 'bodcau-lake', 'Bodcau Lake', null, ST_GeomFromText('POINT(-93.511 32.702)',4326), null, null, '2021-03-12T16:05:05.846411Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('25909368-b5cb-4b46-af20-53794216d146', False, 'alexandria_afc6', 'Alexandria', null, ST_GeomFromText('POINT(-92.4395 31.309)',4326), null, null, '2021-03-12T16:05:05.846580Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('4a0b0cdf-8e9e-447a-b148-5fc22f88af78', False, 'arkansas-city', 'Arkansas City', null, ST_GeomFromText('POINT(-91.2382 33.5517)',4326), null, null, '2021-03-12T16:05:05.846777Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('9aa977da-babe-4c87-b96f-1ed0145bea74', False, 'greenville', 'Greenville', null, ST_GeomFromText('POINT(-91.161 33.2896)',4326), null, null, '2021-03-12T16:05:05.846914Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07265455'),
-('6e25d318-eda6-437f-a945-d67601ecfd60', False, 'vicksburg', 'Vicksburg', null, ST_GeomFromText('POINT(-90.9023 32.3118)',4326), null, null, '2021-03-12T16:05:05.847008Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07289000'),
-('d765265c-6615-4488-bf1f-0194d87e08bc', False, 'natchez', 'Natchez', null, ST_GeomFromText('POINT(-91.4334 31.544)',4326), null, null, '2021-03-12T16:05:05.847248Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07290880'),
-('1a841015-15cb-4ece-bd24-5815191d5286', False, 'red-river-lndg', 'Red River Lndg', null, ST_GeomFromText('POINT(-91.6644 30.9608)',4326), null, null, '2021-03-12T16:05:05.847383Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8a472143-06b2-49fc-906f-5e56f4ed27a7', False, 'new-orleans', 'New Orleans', null, ST_GeomFromText('POINT(-90.1361 29.9347)',4326), null, null, '2021-03-12T16:05:05.847505Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07374510'),
-('6b2b9f4c-4b51-4c3d-81be-fc81d5909d77', False, 'sarah', 'Sarah', null, ST_GeomFromText('POINT(-90.2244 34.5756)',4326), null, null, '2021-03-12T16:05:05.847568Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07279700'),
-('9c7186bd-d326-45c8-88d6-fa36dd2ad402', False, 'sardis-lake', 'Sardis Lake', null, ST_GeomFromText('POINT(-89.7867 34.3992)',4326), null, null, '2021-03-12T16:05:05.847789Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('5e6d5220-2ea8-4cf0-993a-533bdfd1064e', False, 'enid-lake', 'Enid Lake', null, ST_GeomFromText('POINT(-89.9038 34.158)',4326), null, null, '2021-03-12T16:05:05.848135Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('385441d4-c2c2-4e1d-82cd-ef1eabb49fe0', False, 'grenada-lake', 'Grenada Lake', null, ST_GeomFromText('POINT(-89.7706 33.8086)',4326), null, null, '2021-03-12T16:05:05.848284Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('91550145-347b-478c-8436-dcad772810e8', False, 'greenwood', 'Greenwood', null, ST_GeomFromText('POINT(-90.1808 33.5225)',4326), null, null, '2021-03-12T16:05:05.848405Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07287000'),
-('9483c377-2be1-4e01-8697-6145e4bcf831', False, 'blakely', 'Blakely', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.848633Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('16a74996-97f8-48f8-834f-e350ff4ffd5e', False, 'boughton', 'Boughton', null, ST_GeomFromText('POINT(-93.3043 33.8785)',4326), null, null, '2021-03-12T16:05:05.848769Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8f4c99bc-c7c0-461f-b081-b5fdc1fcc579', False, 'warren', 'Warren', null, ST_GeomFromText('POINT(-92.0109 33.6067)',4326), null, null, '2021-03-12T16:05:05.849009Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07364000'),
-('80619f46-d246-4df6-aa96-b25f5dc034b3', False, 'acme', 'Acme', null, ST_GeomFromText('POINT(-91.8307 31.2687)',4326), null, null, '2021-03-12T16:05:05.849172Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('73f0981e-ee25-481d-bb61-8be49d0397b2', False, 'wallace-lake', 'Wallace Lake', null, ST_GeomFromText('POINT(-93.6702 32.3188)',4326), null, null, '2021-03-12T16:05:05.849270Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('88d5213e-a4c1-4abe-a95d-6826d7d4eadd', False, 'wallace-lake-tailwater', 'Wallace Lake-Tailwater', null, ST_GeomFromText('POINT(-93.6702 32.3198)',4326), null, null, '2021-03-12T16:05:05.849270Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('295e2b4f-2f72-43a6-86d0-4dc5c2ff39f9', False, 'shreveport', 'Shreveport', null, ST_GeomFromText('POINT(-93.7397 32.5158)',4326), null, null, '2021-03-12T16:05:05.849463Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('c8c2d66b-1213-45ed-bf68-41604303e011', False, 'fulton', 'Fulton', null, ST_GeomFromText('POINT(-93.8128 33.6082)',4326), null, null, '2021-03-12T16:05:05.849582Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07341500'),
-('e07dc31a-59bb-480b-9a6b-e19a51bfed17', False, 'webb', 'Webb', null, ST_GeomFromText('POINT(-90.3414 33.9494)',4326), null, null, '2021-03-12T16:05:05.849802Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f566e02c-6034-49bc-a560-37083c8e0c7f', False, 'star-city', 'Star City', null, ST_GeomFromText('POINT(-91.7856 33.9611)',4326), null, null, '2021-03-12T16:05:05.849984Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('629eb4e8-2433-42b6-857c-82ddd684c138', False, 'wilmot', 'Wilmot', null, ST_GeomFromText('POINT(-91.5781 33.0714)',4326), null, null, '2021-03-12T16:05:05.850144Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f51f54a1-1d80-4076-a516-22003d870714', False, 'simmsport', 'Simmsport', null, ST_GeomFromText('POINT(-91.7983 30.9825)',4326), null, null, '2021-03-12T16:05:05.850257Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07381490'),
-('ab535466-e144-438b-97b5-762bcc5461c0', False, 'ft-pemberton-upper', 'Ft Pemberton-Upper', null, ST_GeomFromText('POINT(-90.2388 33.5292)',4326), null, null, '2021-03-12T16:05:05.850380Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('6fbb5d5d-448d-4101-b2f1-b4dc901543dd', False, 'locopolis', 'Locopolis', null, ST_GeomFromText('POINT(-90.2254 33.9755)',4326), null, null, '2021-03-12T16:05:05.850508Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('18ffd24b-08a6-455b-b75f-523118b6506d', False, 'holcomb', 'Holcomb', null, ST_GeomFromText('POINT(-90.0 33.7771)',4326), null, null, '2021-03-12T16:05:05.850660Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('6458716f-7f6b-4dfe-b0a8-41c8d8336b56', False, 'hollandale', 'Hollandale', null, ST_GeomFromText('POINT(-90.9241 33.1592)',4326), null, null, '2021-03-12T16:05:05.850808Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('6be5b3e7-1b96-42da-b3e0-a9660b395dc6', False, 'sardis-lake-tailwater', 'Sardis Lake-Tailwater', null, ST_GeomFromText('POINT(-89.7912 34.399)',4326), null, null, '2021-03-12T16:05:05.851069Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('088fcabb-36a8-4328-b9ce-587fd3ff797c', False, 'beekman', 'Beekman', null, ST_GeomFromText('POINT(-91.8678 32.873)',4326), null, null, '2021-03-12T16:05:05.851159Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('1b115389-2d2c-4964-9205-539dc9b4c1da', False, 'long-branch-rs', 'Long Branch-RS', null, ST_GeomFromText('POINT(-91.9252 31.399)',4326), null, null, '2021-03-12T16:05:05.851341Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('c394c042-4d78-4aa7-8955-35dbe0ac87b9', False, 'anguilla', 'Anguilla', null, ST_GeomFromText('POINT(-90.7773 32.9711)',4326), null, null, '2021-03-12T16:05:05.851436Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f94aa023-557a-490b-a959-afbe9a416738', False, 'holly-bluff', 'Holly Bluff', null, ST_GeomFromText('POINT(-90.718 32.8141)',4326), null, null, '2021-03-12T16:05:05.851775Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('982e1c3b-b4d8-4579-a830-0a84c95e9bcf', False, 'yazoo-city-pump', 'Yazoo City Pump', null, ST_GeomFromText('POINT(-90.4403 32.8339)',4326), null, null, '2021-03-12T16:05:05.851899Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('710a2455-33b0-4050-ac0e-849477b477a4', False, 'yazoo-city-pump-ls', 'Yazoo City Pump-LS', null, ST_GeomFromText('POINT(-90.4403 32.8339)',4326), null, null, '2021-03-12T16:05:05.851899Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('7e236767-da42-4982-ba39-bf6bd1b251ce', False, 'yazoo-city-pump-rs', 'Yazoo City Pump-RS', null, ST_GeomFromText('POINT(-90.4403 32.8339)',4326), null, null, '2021-03-12T16:05:05.851899Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8443da51-c18d-41cd-b4a0-3c5b7c6b8ae9', False, 'little-sunflower-ls', 'Little Sunflower-LS', null, ST_GeomFromText('POINT(-90.7286 32.622)',4326), null, null, '2021-03-12T16:05:05.852080Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('d3c24940-2647-4f3a-99c7-363080c17bd0', False, 'belzoni', 'Belzoni', null, ST_GeomFromText('POINT(-90.4947 33.1644)',4326), null, null, '2021-03-12T16:05:05.852234Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('1297689a-ffdc-4234-b9ca-3ac949af4058', False, 'alligator-catfsh-landside', 'Alligator Catfsh-Landside', null, ST_GeomFromText('POINT(-90.2479 33.4015)',4326), null, null, '2021-03-12T16:05:05.852381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('fc2080c5-c2cd-4c50-ae8d-53b3f7c3d054', False, 'jonesville-l&d', 'Jonesville L&D', null, ST_GeomFromText('POINT(-91.8604 31.4828)',4326), null, null, '2021-03-12T16:05:05.852500Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('9d84861e-8693-4063-934d-4e0471e5caa6', False, 'jonesville-l&d-upper', 'Jonesville L&D-Upper', null, ST_GeomFromText('POINT(-91.8604 31.4828)',4326), null, null, '2021-03-12T16:05:05.852500Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('9962ef2e-846e-4534-a078-8229b0e763f4', False, 'jonesville-l&d-lower', 'Jonesville L&D-Lower', null, ST_GeomFromText('POINT(-91.8603 31.4828)',4326), null, null, '2021-03-12T16:05:05.852500Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('98f3031e-6b6f-4b0f-8a48-647f0fea1d09', False, 'ft-necessity', 'Ft Necessity', null, ST_GeomFromText('POINT(-91.9281 32.0708)',4326), null, null, '2021-03-12T16:05:05.852759Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('d4fdc32c-2ad1-4a5f-b763-1c9edbaa7d2d', False, 'sheridan', 'Sheridan', null, ST_GeomFromText('POINT(-92.4061 34.1155)',4326), null, null, '2021-03-12T16:05:05.852915Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07363200'),
-('bcd00d59-da02-4926-8eb7-8f150b755f2a', False, 'catahoula-lake', 'Catahoula Lake', null, ST_GeomFromText('POINT(-92.1133 31.4794)',4326), null, null, '2021-03-12T16:05:05.853048Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f9f4b952-453b-4f7b-b176-a12bac27f8ee', False, 'catahoula-lake-structure-ls', 'Catahoula Lake-Structure-LS', null, ST_GeomFromText('POINT(-92.1133 31.4794)',4326), null, null, '2021-03-12T16:05:05.853048Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('2e1033f6-baa9-410f-9164-fcce250670a3', False, 'catahoula-lake-structure-rs', 'Catahoula Lake-Structure-RS', null, ST_GeomFromText('POINT(-92.1133 31.4794)',4326), null, null, '2021-03-12T16:05:05.853048Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8ef26da3-b45e-4eda-acb0-e66abddd2430', False, 'columbia-l&d', 'Columbia L&D', null, ST_GeomFromText('POINT(-92.1111 32.1667)',4326), null, null, '2021-03-12T16:05:05.853410Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07367630'),
-('66a16f0b-8ae6-411f-8d5f-b92b53b6c24f', False, 'columbia-l&d-upper', 'Columbia L&D-Upper', null, ST_GeomFromText('POINT(-92.1103 32.1668)',4326), null, null, '2021-03-12T16:05:05.853410Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('72077fe4-f72d-4350-9f0d-a496306650f2', False, 'columbia-l&d-lower', 'Columbia L&D-Lower', null, ST_GeomFromText('POINT(-92.1103 32.1668)',4326), null, null, '2021-03-12T16:05:05.853410Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('957c9052-a51e-48f1-8d6c-f7eff9585682', False, 'newlight', 'Newlight', null, ST_GeomFromText('POINT(-91.4897 32.0893)',4326), null, null, '2021-03-12T16:05:05.853729Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07369649'),
-('0620e0a5-8c5c-4b67-b788-a5928e6b9700', False, 'como', 'Como', null, ST_GeomFromText('POINT(-91.5925 32.0922)',4326), null, null, '2021-03-12T16:05:05.854091Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('ccea25e6-c8ab-4aeb-a047-17980cba65d2', False, 'bodcau-lake-tailwater', 'Bodcau Lake-Tailwater', null, ST_GeomFromText('POINT(-93.5124 32.7012)',4326), null, null, '2021-03-12T16:05:05.854228Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349660'),
-('8bbc7944-1e96-4796-9cc7-9412275f0d84', False, 'grace', 'Grace', null, ST_GeomFromText('POINT(-90.9624 32.917)',4326), null, null, '2021-03-12T16:05:05.854351Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('2b9febbf-d3cb-4b14-ad18-13c28cd03282', False, 'little-sunflower-rs', 'Little Sunflower-RS', null, ST_GeomFromText('POINT(-90.7266 32.6211)',4326), null, null, '2021-03-12T16:05:05.854613Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('eb7dbcfa-490b-4ff5-a88e-06ec1df78d97', False, 'steele-bayou', 'Steele Bayou', null, ST_GeomFromText('POINT(-90.8906 32.457)',4326), null, null, '2021-03-12T16:05:05.854705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('7f40b3a1-8215-4ac4-8ff4-766682b88360', False, 'steele-bayou-ls', 'Steele Bayou-LS', null, ST_GeomFromText('POINT(-90.8906 32.457)',4326), null, null, '2021-03-12T16:05:05.854705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07288938'),
-('18338967-a6ea-4d71-a381-0caa345aee67', False, 'steele-bayou-rs', 'Steele Bayou-RS', null, ST_GeomFromText('POINT(-90.8902 32.4522)',4326), null, null, '2021-03-12T16:05:05.854705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('6d55c347-fb30-46eb-b992-5d96b929a7db', False, 'steele-bayou-ls-bubbler', 'Steele Bayou-LS_Bubbler', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.854705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('38e2b538-fd2b-429e-8995-ba05f34993eb', False, 'ditch-bayou-dam', 'Ditch Bayou Dam', null, ST_GeomFromText('POINT(-91.2247 33.2557)',4326), null, null, '2021-03-12T16:05:05.854956Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('5d2140ee-299a-42e4-8966-d7ed8cc750e6', False, 'macon-lake', 'Macon Lake', null, ST_GeomFromText('POINT(-91.316 33.4569)',4326), null, null, '2021-03-12T16:05:05.855094Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('e6a54c7f-aff3-4926-b01a-b3117de5d828', False, 'canal-43-ark-cty', 'Canal 43_Ark Cty', null, ST_GeomFromText('POINT(-91.2783 33.6058)',4326), null, null, '2021-03-12T16:05:05.855216Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('4db583d1-b6a0-4833-830b-fc1ee4a25014', False, 'canal-81-ark-cty', 'Canal 81_Ark Cty', null, ST_GeomFromText('POINT(-91.2604 33.6054)',4326), null, null, '2021-03-12T16:05:05.855353Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('22dd898a-38f2-4986-93ff-b8b980c42f9e', False, 'birdie', 'Birdie', null, ST_GeomFromText('POINT(-90.3886 34.4138)',4326), null, null, '2021-03-12T16:05:05.855474Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('cb77894b-f134-4ec3-8b4f-b4e89a01cc29', False, 'crew-lake', 'Crew Lake', null, ST_GeomFromText('POINT(-91.9183 32.5001)',4326), null, null, '2021-03-12T16:05:05.855625Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07369000'),
-('cfddba44-12d4-4a85-99b5-4288de3245e6', False, 'bovina', 'Bovina', null, ST_GeomFromText('POINT(-90.6967 32.3475)',4326), null, null, '2021-03-12T16:05:05.855726Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07290000'),
-('f80aee31-7efd-41be-81e0-9e3ace7179ed', False, 'bentonia', 'Bentonia', null, ST_GeomFromText('POINT(-90.364 32.6028)',4326), null, null, '2021-03-12T16:05:05.855845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07289730'),
-('f0f13059-8879-446c-bb9d-97b141268f2b', False, 'ft-pemberton-lower', 'Ft Pemberton-Lower', null, ST_GeomFromText('POINT(-90.2378 33.5307)',4326), null, null, '2021-03-12T16:05:05.855966Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8c2a152a-ddad-494a-b44c-2c78718a7b96', False, 'felsenthal-l&d', 'Felsenthal L&D', null, ST_GeomFromText('POINT(-92.1225 33.0597)',4326), null, null, '2021-03-12T16:05:05.856127Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('c8db5b69-349c-4db8-8442-a87f89e17cce', False, 'felsenthal-l&d-upper', 'Felsenthal L&D-Upper', null, ST_GeomFromText('POINT(-92.1226 33.0597)',4326), null, null, '2021-03-12T16:05:05.856127Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('79e8dc07-e7e4-42fc-9831-7c9facce0464', False, 'felsenthal-l&d-lower', 'Felsenthal L&D-Lower', null, ST_GeomFromText('POINT(-92.1209 33.0563)',4326), null, null, '2021-03-12T16:05:05.856127Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('7cf16437-ab8b-490e-ad53-9bc6bbc52705', False, 'chicot-pump-rs', 'Chicot Pump-RS', null, ST_GeomFromText('POINT(-91.24 33.4318)',4326), null, null, '2021-03-12T16:05:05.856339Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('81c478af-5b7f-4238-bdb0-06d0d522d225', False, 'dumas', 'Dumas', null, ST_GeomFromText('POINT(-90.9846 32.5142)',4326), null, null, '2021-03-12T16:05:05.856431Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('0d9f02a5-d518-48be-b2b6-add187613086', False, 'murfreesboro', 'Murfreesboro', null, ST_GeomFromText('POINT(-93.7199 34.0485)',4326), null, null, '2021-03-12T16:05:05.856551Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('a22c8535-ecd3-4497-9d39-a03295dbc4ee', False, 'l&d-5-upper', 'L&D 5-Upper', null, ST_GeomFromText('POINT(-93.4961 32.2502)',4326), null, null, '2021-03-12T16:05:05.856739Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f60890b9-c4dd-4220-8a1c-064a7ea4ba61', False, 'alligator-catfsh-riverside', 'Alligator Catfsh-Riverside', null, ST_GeomFromText('POINT(-90.2464 33.4147)',4326), null, null, '2021-03-12T16:05:05.856921Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('22ee4fa2-09f4-41e8-99c5-d0bd184e150d', False, 'enid-lake-tailwater', 'Enid Lake-Tailwater', null, ST_GeomFromText('POINT(-89.9068 34.158)',4326), null, null, '2021-03-12T16:05:05.857069Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('af973261-36a7-4beb-ad72-028c2344fa3b', False, 'little-calleo', 'Little Calleo', null, ST_GeomFromText('POINT(-90.6861 33.1839)',4326), null, null, '2021-03-12T16:05:05.857173Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f769559c-33c4-4899-8835-22738c1d19e1', False, 'longwood', 'Longwood', null, ST_GeomFromText('POINT(-91.0045 33.1416)',4326), null, null, '2021-03-12T16:05:05.857316Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8913f49c-7506-41b2-aea4-1d3b45d2a6da', False, 'eudora-boeuf', 'Eudora_Boeuf', null, ST_GeomFromText('POINT(-91.3481 33.1242)',4326), null, null, '2021-03-12T16:05:05.857607Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07367680'),
-('0ddf15e8-2816-47b1-8483-769bcc5a5769', False, 'cincinnati', 'Cincinnati', null, ST_GeomFromText('POINT(-84.5106 39.0944)',4326), null, null, '2021-03-12T16:05:05.857706Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '03255000'),
-('4c4bef86-a406-43bc-815e-218e537386d6', False, 'louisville', 'Louisville', null, ST_GeomFromText('POINT(-85.7992 38.2803)',4326), null, null, '2021-03-12T16:05:05.857846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '03294500'),
-('b66afc0e-1346-4f60-9615-f2752e1e72c4', False, 'calhoun-city', 'Calhoun City', null, ST_GeomFromText('POINT(-89.3159 33.8384)',4326), null, null, '2021-03-12T16:05:05.857970Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07282000'),
-('6ccf1187-f0cd-4245-84b0-0516b3f25795', False, 'bruce', 'Bruce', null, ST_GeomFromText('POINT(-89.3478 33.9733)',4326), null, null, '2021-03-12T16:05:05.858181Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07283000'),
-('e3d4b6b5-a0a3-487b-92f9-0574311423ff', False, 'mckinney-pump', 'McKinney Pump', null, ST_GeomFromText('POINT(-90.448 34.6767)',4326), null, null, '2021-03-12T16:05:05.858359Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('fda25ff4-ab80-4495-918f-90dcea780bb6', False, 'sunflower-quiver', 'Sunflower_Quiver', null, ST_GeomFromText('POINT(-90.4387 33.5292)',4326), null, null, '2021-03-12T16:05:05.858516Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('eb7c8d94-47c4-4ff7-b475-934656f3e8ba', False, 'hernando', 'Hernando', null, ST_GeomFromText('POINT(-89.8831 34.8012)',4326), null, null, '2021-03-12T16:05:05.858721Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('a69770f7-d6b6-4ac8-bb9b-8b8e0599d2eb', False, 'belmont-bridge', 'Belmont Bridge', null, ST_GeomFromText('POINT(-89.8819 34.3875)',4326), null, null, '2021-03-12T16:05:05.858880Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('9f9acf8c-d4c4-49a1-9309-2744103a4495', False, 'shell-bluff', 'Shell Bluff', null, ST_GeomFromText('POINT(-90.2719 33.3967)',4326), null, null, '2021-03-12T16:05:05.859063Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('5d32ebdd-ba49-4e23-96d0-0b82a080c064', False, 'asc-tippo-str-ls', 'Asc Tippo Str-LS', null, ST_GeomFromText('POINT(-90.1721 33.7806)',4326), null, null, '2021-03-12T16:05:05.859205Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('2e55dbdc-9241-4055-bbd3-9c5cefd8d906', False, 'asc-tippo-str-rs', 'Asc Tippo Str-RS', null, ST_GeomFromText('POINT(-90.1721 33.7806)',4326), null, null, '2021-03-12T16:05:05.859205Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('92cb6d83-d616-4aa5-8762-8ac4c6f2727d', False, 'charleston', 'Charleston', null, ST_GeomFromText('POINT(-90.1627 34.0187)',4326), null, null, '2021-03-12T16:05:05.859384Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('17a70904-2ea8-4e16-b28e-0b84f010f4ec', False, 'hwy-82', 'HWY 82', null, ST_GeomFromText('POINT(-90.9682 33.4011)',4326), null, null, '2021-03-12T16:05:05.859501Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('31b58039-d5cc-4f7e-99cd-4a453f356ff8', False, 'muddy-bayou', 'Muddy Bayou', null, ST_GeomFromText('POINT(-90.9846 32.5142)',4326), null, null, '2021-03-12T16:05:05.859632Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('c383e317-e6c1-4813-ae3c-50f28f6234f3', False, 'muddy-bayou-landside', 'Muddy Bayou-Landside', null, ST_GeomFromText('POINT(-90.9846 32.5142)',4326), null, null, '2021-03-12T16:05:05.859632Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('7a982d11-6378-4d3a-adbf-585ec65b1663', False, 'muddy-bayou-riverside', 'Muddy Bayou-Riverside', null, ST_GeomFromText('POINT(-90.9846 32.5142)',4326), null, null, '2021-03-12T16:05:05.859632Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('76c9553d-5cfa-48c8-94ad-571c12ba168c', False, 'l&d-5-lower', 'L&D 5-Lower', null, ST_GeomFromText('POINT(-93.4947 32.248)',4326), null, null, '2021-03-12T16:05:05.859842Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('0646b0cb-3e68-4922-90f9-224926793f99', False, 'oak-grove', 'Oak Grove', null, ST_GeomFromText('POINT(-91.4989 32.7989)',4326), null, null, '2021-03-12T16:05:05.859951Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('11ad5336-2e61-4bea-968d-6a780c7fc096', False, 'long-branch-ls', 'Long Branch-LS', null, ST_GeomFromText('POINT(-91.9246 31.4012)',4326), null, null, '2021-03-12T16:05:05.860047Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('c4a5da16-65e5-4f3b-aa80-ce0a3e2a85a8', False, 'wild-cow-weir', 'Wild Cow Weir', null, ST_GeomFromText('POINT(-91.7394 31.3555)',4326), null, null, '2021-03-12T16:05:05.860167Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('719575d8-5929-47fc-9175-d5eb1cf9d4eb', False, 'wild-cow-weir-upper', 'Wild Cow Weir-Upper', null, ST_GeomFromText('POINT(-91.7392 31.3561)',4326), null, null, '2021-03-12T16:05:05.860167Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f368d55f-a07f-47ff-9bca-2e840ffc57c4', False, 'deer-park', 'Deer Park', null, ST_GeomFromText('POINT(-91.6509 31.4594)',4326), null, null, '2021-03-12T16:05:05.860390Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('53ba3164-3305-4c7d-a724-722d6a82d4ca', False, 'eudora-macon', 'Eudora_Macon', null, ST_GeomFromText('POINT(-91.2535 33.0998)',4326), null, null, '2021-03-12T16:05:05.860542Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07369680'),
-('ef199346-d2ca-4b81-b65d-659a05bffaae', False, 'catahoula-lake-center', 'Catahoula Lake-Center', null, ST_GeomFromText('POINT(-92.1386 31.4931)',4326), null, null, '2021-03-12T16:05:05.860789Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('ba2837a6-4ff0-453f-bf77-73698d389f2e', False, 'reed-road', 'Reed Road', null, ST_GeomFromText('POINT(-91.0232 33.3858)',4326), null, null, '2021-03-12T16:05:05.860936Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('4cbb7b79-6e05-4038-b00f-ddffc90379a2', False, 'oxford', 'Oxford', null, ST_GeomFromText('POINT(-89.5214 32.2733)',4326), null, null, '2021-03-12T16:05:05.861108Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07274000'),
-('83614c3b-e3b5-4699-8a76-aac96cb92744', False, 'old-river-str', 'Old River Str', null, ST_GeomFromText('POINT(-91.5977 31.0779)',4326), null, null, '2021-03-12T16:05:05.861271Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('64072090-3de8-489a-b2b0-3ca330c7f86e', False, 'old-river-str-hw', 'Old River Str-HW', null, ST_GeomFromText('POINT(-91.5977 31.0779)',4326), null, null, '2021-03-12T16:05:05.861271Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('ca604556-ca74-43ab-85fa-b3df4b221757', False, 'old-river-str-tw', 'Old River Str-TW', null, ST_GeomFromText('POINT(-91.5977 31.0779)',4326), null, null, '2021-03-12T16:05:05.861271Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('d687cad0-dff7-4b5a-bcec-398607d67d74', False, 'yazoo-city', 'Yazoo City', null, ST_GeomFromText('POINT(-90.4367 32.8578)',4326), null, null, '2021-03-12T16:05:05.861445Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8751469d-a261-499d-a486-1223a781376d', False, 'l&d-4-lower', 'L&D 4-Lower', null, ST_GeomFromText('POINT(-93.2687 31.9383)',4326), null, null, '2021-03-12T16:05:05.861570Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('22c38d80-cbc1-44f2-865d-44e26569465c', False, 'clayton', 'Clayton', null, ST_GeomFromText('POINT(-91.5443 31.7246)',4326), null, null, '2021-03-12T16:05:05.861690Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8a0e5fbe-cf65-48b8-b74c-c281327320a7', False, 'alto-boeuf', 'Alto_Boeuf', null, ST_GeomFromText('POINT(-91.8808 32.3738)',4326), null, null, '2021-03-12T16:05:05.861838Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('41a5a2dd-dd87-4367-a3d2-22cdddc95b93', False, 'tendal', 'Tendal', null, ST_GeomFromText('POINT(-91.4114 32.4456)',4326), null, null, '2021-03-12T16:05:05.861968Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07369500'),
-('15b6a696-0eb6-4e36-a093-f37120070ca3', False, 'connerly-dam', 'Connerly Dam', null, ST_GeomFromText('POINT(-91.2506 33.4149)',4326), null, null, '2021-03-12T16:05:05.862202Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('7242ab78-83c0-47c0-a68c-b83c13a5b9d2', False, 'lombardy', 'Lombardy', null, ST_GeomFromText('POINT(-90.6115 33.8822)',4326), null, null, '2021-03-12T16:05:05.862650Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07288200'),
-('60751208-1d8f-40dc-87c9-0a9b494d00cd', False, 'l&d-4-upper', 'L&D 4-Upper', null, ST_GeomFromText('POINT(-93.2712 31.9396)',4326), null, null, '2021-03-12T16:05:05.862929Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('45946a67-04c6-4bd3-b284-c90f28032e34', False, 'wasp-lake-rs', 'Wasp Lake-RS', null, ST_GeomFromText('POINT(-90.4208 33.2018)',4326), null, null, '2021-03-12T16:05:05.863153Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('3df9af43-bf42-4b6f-b7c9-805df3ec0dfc', False, 'wasp-lake-ls', 'Wasp Lake-LS', null, ST_GeomFromText('POINT(-90.4215 33.202)',4326), null, null, '2021-03-12T16:05:05.863368Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('4c4e50ec-fe20-4a71-868c-cec15fd556bd', False, 'blakely-piez#2', 'Blakely_Piez#2', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('17493247-a266-45f6-b6ee-52027df496f2', False, 'blakely-piezometer-9', 'Blakely-Piezometer 9', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('3ef2217e-a3be-4852-a1b4-a0b34c0643ca', False, 'blakely-piezometer-10', 'Blakely-Piezometer 10', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('36c64338-986d-467e-b422-33159150e165', False, 'blakely-weir', 'Blakely-Weir', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('5185e825-7da6-4ce0-b90c-ea9c4efa8a79', False, 'blakely-manhole-10', 'Blakely-Manhole 10', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f05373ed-f2c4-43f9-8bfb-aa0313b99b51', False, 'blakely-manhole-8', 'Blakely-Manhole 8', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('a38a9ef8-3aba-4c9b-9fff-c77164ede3a0', False, 'blakely-manhole-4', 'Blakely-Manhole 4', null, ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('d912fbba-9b57-40f9-aaf1-25b2504175aa', False, 'wabbaseka', 'Wabbaseka', null, ST_GeomFromText('POINT(-91.7992 34.3589)',4326), null, null, '2021-03-12T16:05:05.864724Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f4148833-5e49-47fb-9d8d-a3735a7419eb', False, 'lake-village', 'Lake Village', null, ST_GeomFromText('POINT(-91.4197 33.3042)',4326), null, null, '2021-03-12T16:05:05.864848Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('1ddb192a-2e3f-44a7-a5cb-dc184507f4ee', False, 'l&d-2-upper', 'L&D 2-Upper', null, ST_GeomFromText('POINT(-92.2927 31.1872)',4326), null, null, '2021-03-12T16:05:05.864945Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('4ef802ad-2147-4b8c-bd1a-720783209dcb', False, 'l&d-2-lower', 'L&D 2-Lower', null, ST_GeomFromText('POINT(-92.2902 31.1867)',4326), null, null, '2021-03-12T16:05:05.865070Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('b453fbaa-4013-4341-8daa-2c3a5de421c5', False, 'l&d-1-upper', 'L&D 1-Upper', null, ST_GeomFromText('POINT(-91.9588 31.2537)',4326), null, null, '2021-03-12T16:05:05.865177Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('26c45dc9-06b1-4ca8-9af2-ca8f3d1db205', False, 'l&d-1-lower', 'L&D 1-Lower', null, ST_GeomFromText('POINT(-91.9582 31.256)',4326), null, null, '2021-03-12T16:05:05.865315Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8228e3e2-af2a-4543-bb40-45e6a9798cc1', False, 'coushatta', 'Coushatta', null, ST_GeomFromText('POINT(-93.3521 32.0135)',4326), null, null, '2021-03-12T16:05:05.865450Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('2ab25153-f259-47be-a0f6-480a2ff4d3d0', False, 'larto-lake', 'Larto Lake', null, ST_GeomFromText('POINT(-91.9386 31.3597)',4326), null, null, '2021-03-12T16:05:05.865572Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('0d0dd809-6688-4e88-b5e6-2424db866aa1', False, 'caddo-lake', 'Caddo Lake', null, ST_GeomFromText('POINT(-93.9187 32.7044)',4326), null, null, '2021-03-12T16:05:05.865696Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07346310'),
-('a46dc996-754e-4675-9e7b-062d89ea7e7f', False, 'arkabutla-lake-tailwater', 'Arkabutla Lake-Tailwater', null, ST_GeomFromText('POINT(-90.1244 34.7572)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('b57a36f7-929a-45a5-b3ed-9824c257bd58', False, 'arkabutla-lake-piezometer-3a', 'Arkabutla Lake-Piezometer 3A', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('32f18746-6afb-4ea5-b5b5-006adb481d62', False, 'arkabutla-lake-piezometer-3b', 'Arkabutla Lake-Piezometer 3B', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('4ee7f297-f3b6-40b0-8dea-63690e0c3529', False, 'arkabutla-lake-piezometer-4a', 'Arkabutla Lake-Piezometer 4A', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('93033526-695e-412e-a3d4-6579476ea7a1', False, 'arkabutla-lake-piezometer-4b', 'Arkabutla Lake-Piezometer 4B', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f9161d24-e3e4-44a2-a706-f32a7c76147e', False, 'arkabutla-lake-piezometer-5a', 'Arkabutla Lake-Piezometer 5A', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('580f3763-b047-41ee-98c5-b4f503d406f8', False, 'arkabutla-lake-piezometer-5b', 'Arkabutla Lake-Piezometer 5B', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('67e19d70-ccf4-43a0-8f16-18707b0c681c', False, 'arkabutla-lake-piezometer-6a', 'Arkabutla Lake-Piezometer 6A', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('c22c8d10-dbe2-40fd-8922-d2c38f44dd7b', False, 'arkabutla-lake-piezometer-6b', 'Arkabutla Lake-Piezometer 6B', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('db9eb8bc-d87b-40a5-8664-91f26e37d5a2', False, 'ha-ha-bayou-pump', 'Ha Ha Bayou Pump', null, ST_GeomFromText('POINT(-91.7604 31.6851)',4326), null, null, '2021-03-12T16:05:05.866426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('b4d71ec2-323b-483e-ba44-b2cb631ac34a', False, 'ha-ha-bayou-pump-landside', 'Ha Ha Bayou Pump-Landside', null, ST_GeomFromText('POINT(-91.7605 31.6858)',4326), null, null, '2021-03-12T16:05:05.866426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('7e0875c8-d856-477d-b2a8-cb2b0ee28f55', False, 'ha-ha-bayou-pump-riverside', 'Ha Ha Bayou Pump-Riverside', null, ST_GeomFromText('POINT(-91.7601 31.6845)',4326), null, null, '2021-03-12T16:05:05.866426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('4d69a06f-62ed-40ae-ac2c-00d3d2b3156e', False, 'tensas-cocodrie-rs', 'Tensas Cocodrie-RS', null, ST_GeomFromText('POINT(-91.7838 31.3725)',4326), null, null, '2021-03-12T16:05:05.866751Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('0feaa8e6-ff7e-4908-8f01-317842b580d9', False, 'tensas-cocodrie-ls', 'Tensas Cocodrie-LS', null, ST_GeomFromText('POINT(-91.7801 31.372)',4326), null, null, '2021-03-12T16:05:05.866929Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('9f76d202-9f1e-4b05-9402-a5d8f04c02b1', False, 'grenada-lake-tailwater', 'Grenada Lake-Tailwater', null, ST_GeomFromText('POINT(-89.7736 33.8088)',4326), null, null, '2021-03-12T16:05:05.867160Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('452a6427-76f7-4e86-bc5e-bdc1b4165f31', False, 'chicot-pump-ls', 'Chicot Pump-LS', null, ST_GeomFromText('POINT(-91.24 33.4311)',4326), null, null, '2021-03-12T16:05:05.867343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8bd1e8fe-7a7a-43de-a447-ad8d34fdda96', False, 'marks-coldwater', 'Marks_Coldwater', null, ST_GeomFromText('POINT(-90.2666 34.261)',4326), null, null, '2021-03-12T16:05:05.867563Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('1793c043-56a1-4653-aaf6-d75b9c300caf', False, 'baton-rouge', 'Baton Rouge', null, ST_GeomFromText('POINT(-91.1916 30.4457)',4326), null, null, '2021-03-12T16:05:05.867863Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07374000'),
-('531a4200-f861-4e24-824f-7f42e42a32ed', False, 'keithville', 'Keithville', null, ST_GeomFromText('POINT(-93.8278 32.3)',4326), null, null, '2021-03-12T16:05:05.868007Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07351500'),
-('14c85225-bec1-45ce-8609-7aeefffe60b7', False, 'springhill', 'Springhill', null, ST_GeomFromText('POINT(-93.5167 33.0039)',4326), null, null, '2021-03-12T16:05:05.868130Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349450'),
-('a392c083-04d9-4c28-a823-79becd16c13a', False, 'walkiah-bluff-us', 'Walkiah Bluff US', null, ST_GeomFromText('POINT(-89.8067 30.5716)',4326), null, null, '2021-03-12T16:05:05.868255Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492111'),
-('a1a202b8-3b75-49e8-940b-18e46a9d77eb', False, 'walkiah-bluff-ds', 'Walkiah Bluff DS', null, ST_GeomFromText('POINT(-89.8116 30.5701)',4326), null, null, '2021-03-12T16:05:05.868480Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('27d39a1e-3dac-46db-abb4-583a7e514c0c', False, 'west-monroe', 'West Monroe', null, ST_GeomFromText('POINT(-92.1251 32.5057)',4326), null, null, '2021-03-12T16:05:05.868732Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07367005'),
-('95cbda04-2d65-40ae-89d8-b895336d4552', False, 'remmel-dam', 'Remmel Dam', null, ST_GeomFromText('POINT(-92.8941 34.4271)',4326), null, null, '2021-03-12T16:05:05.868852Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07359002'),
-('c2077bea-1cd1-4061-9881-77e99d2645aa', False, 'jackson', 'Jackson', null, ST_GeomFromText('POINT(-90.1786 32.2817)',4326), null, null, '2021-03-12T16:05:05.869118Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02486000'),
-('27f56fa0-f3d7-4ce4-b7f5-dfaebbd58039', False, 'mcgehee', 'McGehee', null, ST_GeomFromText('POINT(-91.4464 33.6287)',4326), null, null, '2021-03-12T16:05:05.869351Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07364150'),
-('c73e5560-ee63-4886-8d75-af4ed7c36eac', False, 'sunflower-bigsun', 'Sunflower_BigSun', null, ST_GeomFromText('POINT(-90.5431 33.5468)',4326), null, null, '2021-03-12T16:05:05.869484Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07288500'),
-('56e0b496-af12-4650-95ad-f0bafccace24', False, 'sarepta', 'Sarepta', null, ST_GeomFromText('POINT(-93.4828 32.905)',4326), null, null, '2021-03-12T16:05:05.869944Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349500'),
-('55c25746-6525-4110-afe8-b06e7b508873', False, 'clarksdale-cass', 'Clarksdale_Cass', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.870036Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07280809'),
-('5c3d2ecd-cf78-4fff-b193-aad93f5673c6', False, 'fool-river-pump', 'Fool River Pump', null, ST_GeomFromText('POINT(-91.5765 31.8852)',4326), null, null, '2021-03-12T16:05:05.870190Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('e157b0fd-ed4e-470f-a5fe-ea52879221b6', False, 'fool-river-pump-landside', 'Fool River Pump-Landside', null, ST_GeomFromText('POINT(-91.578 31.8863)',4326), null, null, '2021-03-12T16:05:05.870190Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('1606c27e-cb2b-4251-b18c-e750f37bb9f0', False, 'fool-river-pump-riverside', 'Fool River Pump-Riverside', null, ST_GeomFromText('POINT(-91.5765 31.885)',4326), null, null, '2021-03-12T16:05:05.870190Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('f51d759c-477f-475a-922d-ba292c9d1871', False, 'harrisonburg', 'Harrisonburg', null, ST_GeomFromText('POINT(-91.8189 31.7708)',4326), null, null, '2021-03-12T16:05:05.870491Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('ecd2da68-19b9-468f-ad67-1cb96505ddfe', False, 'mt-ida', 'Mt Ida', null, ST_GeomFromText('POINT(-93.6976 34.6104)',4326), null, null, '2021-03-12T16:05:05.870708Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07356000'),
-('1242fb3e-e884-4785-bbc6-4af8fffe810b', False, 'caddo-gap', 'Caddo Gap', null, ST_GeomFromText('POINT(-93.6057 34.3828)',4326), null, null, '2021-03-12T16:05:05.870811Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07359610'),
-('71b79a2b-3829-4772-9bc3-700a2c7d2a7d', False, 'leland', 'Leland', null, ST_GeomFromText('POINT(-90.8476 33.3968)',4326), null, null, '2021-03-12T16:05:05.870934Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07288650'),
-('cbf4520e-349e-43fc-8910-0b192c3aa468', False, 'money', 'Money', null, ST_GeomFromText('POINT(-90.2111 33.6511)',4326), null, null, '2021-03-12T16:05:05.871563Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07281600'),
-('23a3e68c-f436-4891-b802-19284cfcc056', False, 'portland', 'Portland', null, ST_GeomFromText('POINT(-91.5348 33.2358)',4326), null, null, '2021-03-12T16:05:05.871874Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07364185'),
-('daac083c-8e27-4b72-95cb-d876def91eb4', False, 'pine-bluff', 'Pine Bluff', null, ST_GeomFromText('POINT(91.9956 34.2903)',4326), null, null, '2021-03-12T16:05:05.871946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07263650'),
-('e02bae8f-70c0-43a9-8a3f-a8b74b0d0d9e', False, 'langley', 'Langley', null, ST_GeomFromText('POINT(-93.8998 34.3118)',4326), null, null, '2021-03-12T16:05:05.872011Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07360200'),
-('1170150e-0b0c-4a09-ac94-b4962f30f311', False, 'lonoke', 'Lonoke', null, ST_GeomFromText('POINT(-91.9028 34.7361)',4326), null, null, '2021-03-12T16:05:05.872134Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07264000'),
-('e702916c-405b-4ff4-88ea-12e05da3dc2e', False, 'index', 'Index', null, ST_GeomFromText('POINT(-94.0411 33.5519)',4326), null, null, '2021-03-12T16:05:05.872199Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07337000'),
-('20a99f65-eb4a-469e-ae59-a4ba44ba0d57', False, 'doddsville', 'Doddsville', null, ST_GeomFromText('POINT(-90.4017 33.6406)',4326), null, null, '2021-03-12T16:05:05.872382Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('55daf64f-9e33-4ace-84f5-4e2db3a09d71', False, 'thatcher-l&d', 'Thatcher L&D', null, ST_GeomFromText('POINT(-92.4827 33.3065)',4326), null, null, '2021-03-12T16:05:05.872665Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('49cfa3a4-9fd1-425a-bdde-6fcd510b0c62', False, 'thatcher-l&d-upper', 'Thatcher L&D-Upper', null, ST_GeomFromText('POINT(-92.4827 33.3065)',4326), null, null, '2021-03-12T16:05:05.872665Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('c0593e4c-b6d6-43e9-8c23-8219c20a9d18', False, 'thatcher-l&d-lower', 'Thatcher L&D-Lower', null, ST_GeomFromText('POINT(-92.4827 33.3065)',4326), null, null, '2021-03-12T16:05:05.872665Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('ec0657a7-b378-4f8a-a717-2319b1217fb2', False, 'jferson-bigc-bay', 'Jferson_BigC_Bay', null, ST_GeomFromText('POINT(-94.4986 32.7494)',4326), null, null, '2021-03-12T16:05:05.873046Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07346000'),
-('35e6d002-13cd-4d57-af13-1029a0a545e2', False, 'jferson-blkc-bay', 'Jferson_BlkC_Bay', null, ST_GeomFromText('POINT(-94.3572 32.7778)',4326), null, null, '2021-03-12T16:05:05.873201Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07346045'),
-('323274cf-f36c-4fda-998b-5b80c08ef0e3', False, 'jferson-ltlc-bay', 'Jferson_LtlC_Bay', null, ST_GeomFromText('POINT(-94.3458 32.7128)',4326), null, null, '2021-03-12T16:05:05.873405Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07346070'),
-('1821db93-3501-49f9-8ed9-311dc71a47e6', False, 'ore-city', 'Ore City', null, ST_GeomFromText('POINT(-94.7508 32.6725)',4326), null, null, '2021-03-12T16:05:05.873611Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07346050'),
-('8088bce2-3ea4-44ce-88cb-af6e93681d73', False, 'karnack', 'Karnack', null, ST_GeomFromText('POINT(-94.2325 32.7386)',4326), null, null, '2021-03-12T16:05:05.873767Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07346080'),
-('96406673-27a3-4c71-a574-31b7a85c6120', False, 'antoine', 'Antoine', null, ST_GeomFromText('POINT(-93.4181 34.0389)',4326), null, null, '2021-03-12T16:05:05.873962Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07361500'),
-('f1eb1b57-ed41-4e79-9311-a08d71913025', False, 'joyce', 'Joyce', null, ST_GeomFromText('POINT(-92.6033 31.9369)',4326), null, null, '2021-03-12T16:05:05.874130Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07372050'),
-('29c76605-2deb-4bf9-8cba-e075c81efc50', False, 'renfroe', 'Renfroe', null, ST_GeomFromText('POINT(-89.4433 32.8622)',4326), null, null, '2021-03-12T16:05:05.874304Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02482470'),
-('d7967575-d65b-43be-979d-4b1541a1fec2', False, 'sand-hill', 'Sand Hill', null, ST_GeomFromText('POINT(-89.8128 32.5056)',4326), null, null, '2021-03-12T16:05:05.874551Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02484760'),
-('f761a4a9-8565-4059-a241-a3be7a617c7a', False, 'fannin', 'Fannin', null, ST_GeomFromText('POINT(-89.9553 32.3881)',4326), null, null, '2021-03-12T16:05:05.874775Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02485498'),
-('311779bb-6317-44d6-9347-eaf65e139265', False, 'sligo-redchute', 'Sligo_RedChute', null, ST_GeomFromText('POINT(-93.5944 32.4472)',4326), null, null, '2021-03-12T16:05:05.874934Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349860'),
-('780d39f1-f682-4936-a5af-148054360b5f', False, 'texarkana', 'Texarkana', null, ST_GeomFromText('POINT(-94.1514 33.3042)',4326), null, null, '2021-03-12T16:05:05.875069Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07344210'),
-('ed2624f8-fdcf-4762-8faf-530f74c4b4f4', False, 'hickahala', 'Hickahala', null, ST_GeomFromText('POINT(-89.9245 34.632)',4326), null, null, '2021-03-12T16:05:05.875212Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('6bf92b7d-c879-4b29-a404-3a60233f5036', False, 'senatobia', 'Senatobia', null, ST_GeomFromText('POINT(-89.9412 34.6166)',4326), null, null, '2021-03-12T16:05:05.875372Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('5b1b22f1-a204-4b1a-8b64-2c81b8b9bf5d', False, 'satartia', 'Satartia', null, ST_GeomFromText('POINT(-90.5485 32.6726)',4326), null, null, '2021-03-12T16:05:05.875576Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('4bedd60e-6df1-4d57-82ba-c8546e21a840', False, 'lake-bistineau', 'Lake Bistineau', null, ST_GeomFromText('POINT(-93.4311 32.3283)',4326), null, null, '2021-03-12T16:05:05.875794Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349250'),
-('e5431262-565f-4493-828f-37e5dddef558', False, 'cross-lake', 'Cross Lake', null, ST_GeomFromText('POINT(-93.7983 32.5125)',4326), null, null, '2021-03-12T16:05:05.875911Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07344480'),
-('6070710d-36cd-449f-8945-2fedeebcffe6', False, 'sterlington', 'Sterlington', null, ST_GeomFromText('POINT(-92.0868 32.6962)',4326), null, null, '2021-03-12T16:05:05.876028Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07364535'),
-('ff51bcd7-ac01-40c1-b163-9fe15e77be6c', False, 'cypress-byu-lake', 'Cypress Byu Lake', null, ST_GeomFromText('POINT(-93.6697 32.6519)',4326), null, null, '2021-03-12T16:05:05.876194Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349815'),
-('cf529851-4ee5-4ce9-be46-a5395a3b1e75', False, 'fordyce', 'Fordyce', null, ST_GeomFromText('POINT(92.3333 33.7922)',4326), null, null, '2021-03-12T16:05:05.876274Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07362500'),
-('6c5fa8a3-b90c-4b03-9437-dcfb24d3937e', False, 'dlo', 'Dlo', null, ST_GeomFromText('POINT(-89.8975 31.9778)',4326), null, null, '2021-03-12T16:05:05.876398Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02487500'),
-('0e9b7893-07f1-417b-b2d3-f084d1599f52', False, 'calionpump-rs', 'CalionPump-RS', null, ST_GeomFromText('POINT(-92.5268 33.3254)',4326), null, null, '2021-03-12T16:05:05.876694Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('a4ea5dbb-c5a5-4a87-aa4a-49320f28895f', False, 'wolf-lake', 'Wolf Lake', null, ST_GeomFromText('POINT(-90.5442 32.8962)',4326), null, null, '2021-03-12T16:05:05.876878Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('1756a225-761a-46b5-ac97-308a6b2ebf56', False, 'sligo', 'Sligo', null, ST_GeomFromText('POINT(-91.8202 32.2052)',4326), null, null, '2021-03-12T16:05:05.877052Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('4efc2277-2c34-431b-83c1-a87f2ae665d2', False, 'pendleton', 'Pendleton', null, ST_GeomFromText('POINT(-91.3844 33.9786)',4326), null, null, '2021-03-12T16:05:05.877275Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07265280'),
-('33708c8a-5aa9-4dba-be6d-c8c7f0c58105', False, 'big-bayou-meto', 'Big Bayou Meto', null, ST_GeomFromText('POINT(-91.4389 31.0825)',4326), null, null, '2021-03-12T16:05:05.877463Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('ed0e3e5f-a895-4761-b2e7-3b486fad8132', False, 'big-bayou-meto-ls', 'Big Bayou Meto-LS', null, ST_GeomFromText('POINT(-91.4389 34.0825)',4326), null, null, '2021-03-12T16:05:05.877463Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('d4d9297b-fd65-43a4-aa9f-84a44808d546', False, 'big-bayou-meto-rs', 'Big Bayou Meto-RS', null, ST_GeomFromText('POINT(-91.4389 34.0825)',4326), null, null, '2021-03-12T16:05:05.877463Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('62707aea-d051-4b60-97fb-a004790b7eb0', False, 'rosedale', 'Rosedale', null, ST_GeomFromText('POINT(-91.2431 33.5653)',4326), null, null, '2021-03-12T16:05:05.877849Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('5262f4a2-1e7d-45d2-a9c8-e506a807153d', False, 'ltl-bayou-meto', 'Ltl Bayou Meto', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878099Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('b5a8791b-8a7c-4ec0-99dc-988e9bd4938b', False, 'ltl-bayou-meto-ls', 'Ltl Bayou Meto-LS', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878099Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('3399ae8f-4257-4d3d-b613-bb8fdd29b1ae', False, 'ltl-bayou-meto-rs', 'Ltl Bayou Meto-RS', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878099Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('1dfb9d3c-cb4f-42d4-9d6a-3fc6da250d45', False, 'columbia-l&d-piezometers', 'Columbia L&D-Piezometers', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('3138d011-d43e-4468-af48-399a82d70b0e', False, 'columbia-l&d-pump-1', 'Columbia L&D-Pump 1', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('a12aaf92-1eed-45a3-b35d-b549ba71053e', False, 'columbia-l&d-pump-2', 'Columbia L&D-Pump 2', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8e925fe9-c15c-4504-93c0-a8be978bcbec', False, 'columbia-l&d-pump-3', 'Columbia L&D-Pump 3', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('50e7ef09-68de-4ee8-8d32-94be2b02976d', False, 'columbia-l&d-pump-4', 'Columbia L&D-Pump 4', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('056d6bd1-962d-4e50-a392-dcbe16b94f71', False, 'columbia-l&d-pump-5', 'Columbia L&D-Pump 5', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('820a5a8f-dcec-428c-b7e5-6421adb7fc43', False, 'columbia-l&d-pump-6', 'Columbia L&D-Pump 6', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('243121b0-fe13-4e1a-acc4-00a7956f7cf9', False, 'columbia-l&d-pump-7', 'Columbia L&D-Pump 7', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('74cafaa7-3948-4f5a-aa8f-974cab167a32', False, 'columbia-l&d-pump-8', 'Columbia L&D-Pump 8', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('69c8f11a-67d8-4022-90f0-a37945ea8e30', False, 'columbia-l&d-pump-9', 'Columbia L&D-Pump 9', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('30ec4016-fb88-48d8-8ae1-2b6ad3761fd3', False, 'coffeeville-pump', 'Coffeeville_Pump', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878943Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('163be643-a493-4c0d-afb9-efbfd3abf16b', False, 'coffeeville-pump-ls', 'Coffeeville_Pump-LS', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878943Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8a4afeb2-6920-4a18-985a-054bdc063413', False, 'coffeeville-pump-rs', 'Coffeeville_Pump-RS', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878943Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('386882df-1e76-420d-a975-f743b2f41076', False, 'lake-providence', 'Lake Providence', null, ST_GeomFromText('POINT(-91.1539 32.8)',4326), null, null, '2021-03-12T16:05:05.879082Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('b8769376-21f0-4e1e-8ad8-e96d32690726', False, 'bayou-cocodrie', 'Bayou Cocodrie', null, ST_GeomFromText('POINT(-91.6775 31.1969)',4326), null, null, '2021-03-12T16:05:05.879267Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('e887ca23-71e8-4e8e-962c-04ec67380b5d', False, 'bayou-cocodrie-riverside', 'Bayou Cocodrie-Riverside', null, ST_GeomFromText('POINT(-91.6775 31.1969)',4326), null, null, '2021-03-12T16:05:05.879267Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('8b6ab624-1121-45d8-b0b7-38642dbbdc72', False, 'bayou-cocodrie-landside', 'Bayou Cocodrie-Landside', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.879267Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
-('86de2c4f-8004-4862-8ce5-fef7abad9179', False, 'willows', 'Willows', null, ST_GeomFromText('POINT(-90.8783 32.0186)',4326), null, null, '2021-03-12T16:05:05.879610Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07290650'),
-('9a234591-568e-41db-8b61-ffbc60c5e79c', False, 'eddiceton', 'Eddiceton', null, ST_GeomFromText('POINT(-90.7775 31.5031)',4326), null, null, '2021-03-12T16:05:05.879814Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07291000'),
-('e687503d-c1ec-47f4-bcc4-75e3f1a61758', False, 'rosetta', 'Rosetta', null, ST_GeomFromText('POINT(-91.1094 31.3247)',4326), null, null, '2021-03-12T16:05:05.880087Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07292500'),
-('dec8e385-e9fd-4d44-b45c-1caaf035a0f6', False, 'woodville', 'Woodville', null, ST_GeomFromText('POINT(-91.2956 31.2269)',4326), null, null, '2021-03-12T16:05:05.880259Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07295000'),
-('52e4a103-008a-4a7e-9ceb-6af54956c0bc', False, 'powhatan', 'Powhatan', null, ST_GeomFromText('POINT(-93.2061 31.8603)',4326), null, null, '2021-03-12T16:05:05.880509Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07351755');
+('458c2b88-20aa-47eb-ade8-c9c70cdcb7a2', False, 'mvk-test-id', 'MVK Test ID', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.830897Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('5ad04097-9848-4c3c-bc2d-12642cc2d1a3', False, 'low-water-bridge', 'Low Water Bridge', ST_GeomFromText('POINT(-90.9548 32.6699)',4326), null, null, '2021-03-12T16:05:05.831156Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('807e9ddc-89b1-4808-878f-81df22ff1c2f', False, 'smackover', 'Smackover', ST_GeomFromText('POINT(-92.7772 33.3755)',4326), null, null, '2021-03-12T16:05:05.831256Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07362100'),
+('3210e1ba-ac87-47fe-a616-496bbfca07d9', False, 'girard', 'Girard', ST_GeomFromText('POINT(-91.7982 32.481)',4326), null, null, '2021-03-12T16:05:05.831320Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07368000'),
+('629cbb30-542d-49cc-8e36-11ed090ae53c', False, 'rochelle', 'Rochelle', ST_GeomFromText('POINT(-92.3451 31.7546)',4326), null, null, '2021-03-12T16:05:05.831413Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07372200'),
+('9caf9758-5a22-4bc9-9bae-13c9ee894dbc', False, 'garrett-bridge', 'Garrett Bridge', ST_GeomFromText('POINT(-91.6563 33.8664)',4326), null, null, '2021-03-12T16:05:05.831502Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07364133'),
+('5fd5abae-68fa-46f4-8c46-5d5e1c34f88a', False, 'rye', 'Rye', ST_GeomFromText('POINT(-92.0258 33.7008)',4326), null, null, '2021-03-12T16:05:05.831640Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07363500'),
+('8ef81ef8-505c-449b-9e7e-e211a78800b5', False, 'grenada', 'Grenada', ST_GeomFromText('POINT(-89.81 33.788)',4326), null, null, '2021-03-12T16:05:05.831764Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07285500'),
+('466899f5-f5f2-440a-a7d7-1bd8f53994bc', False, 'ross-barnett', 'Ross Barnett', ST_GeomFromText('POINT(-90.0645 32.3975)',4326), null, null, '2021-03-12T16:05:05.831931Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02485601'),
+('985e5d4c-d7e3-4fc7-b65b-fdd641f24832', False, 'ross-barnett-tailwater', 'Ross Barnett-Tailwater', ST_GeomFromText('POINT(-90.0645 32.3971)',4326), null, null, '2021-03-12T16:05:05.831931Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('bfc194f9-27e5-49ab-9f03-5841419d341f', False, 'state-line', 'State Line', ST_GeomFromText('POINT(-91.438 33.0014)',4326), null, null, '2021-03-12T16:05:05.832140Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07367690'),
+('e85b0697-caae-4ad3-a762-f4fbb3bbf6b9', False, 'kilbourne_254a', 'Kilbourne', ST_GeomFromText('POINT(-91.2623 32.9923)',4326), null, null, '2021-03-12T16:05:05.832269Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07369700'),
+('f92a4af3-6c7c-4876-b163-3124b3b073c5', False, 'spring-bank', 'Spring Bank', ST_GeomFromText('POINT(-93.8594 33.0894)',4326), null, null, '2021-03-12T16:05:05.832418Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07344370'),
+('e23cffbd-26c1-444b-a7dd-368992a16f2c', False, 'shed-road', 'Shed Road', ST_GeomFromText('POINT(-93.6461 32.5614)',4326), null, null, '2021-03-12T16:05:05.832520Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349298'),
+('1ee31431-77f7-496c-90c8-f4e4fc14982f', False, 'dogwood-trail', 'Dogwood Trail', ST_GeomFromText('POINT(-93.6346 32.5698)',4326), null, null, '2021-03-12T16:05:05.832722Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349849'),
+('03b0cd42-0834-4625-a860-8dc088fb1398', False, 'long-lake', 'Long Lake', ST_GeomFromText('POINT(-90.9142 32.4442)',4326), null, null, '2021-03-12T16:05:05.832964Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07288955'),
+('8894b9e7-76af-419d-939e-73b079d9d6a8', False, 'olive-branch', 'Olive Branch', ST_GeomFromText('POINT(-89.7533 37.9075)',4326), null, null, '2021-03-12T16:05:05.833371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07275900'),
+('edafdfda-ef93-4b83-af86-e7bcf4706293', False, 'jones', 'Jones', ST_GeomFromText('POINT(-91.6557 32.9902)',4326), null, null, '2021-03-12T16:05:05.833547Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07364200'),
+('8f363c1e-7237-485b-88cf-7dfc4fb92c0c', False, 'delhi', 'Delhi', ST_GeomFromText('POINT(-91.4761 32.4579)',4326), null, null, '2021-03-12T16:05:05.833670Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07370000'),
+('3bda439b-5229-4bf1-96fa-d2b1914644c6', False, 'alto-lafourche', 'Alto_Lafourche', ST_GeomFromText('POINT(-91.8808 32.3738)',4326), null, null, '2021-03-12T16:05:05.833763Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07369050'),
+('8bb007c9-971b-4cc5-b3d1-2ab74b6f5aa7', False, 'pearl-river', 'Pearl River', ST_GeomFromText('POINT(-89.7367 30.385)',4326), null, null, '2021-03-12T16:05:05.833899Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492600'),
+('fb02b02a-72ff-4909-a3bb-df9c90b752cc', False, 'jackson-backup', 'Jackson Backup', ST_GeomFromText('POINT(-90.1786 32.2817)',4326), null, null, '2021-03-12T16:05:05.834013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02486000'),
+('fedb39c7-34d5-4eee-a88b-e29c964d3c2b', False, 'ratliffs-ferry', 'Ratliffs Ferry', ST_GeomFromText('POINT(-89.8406 32.5942)',4326), null, null, '2021-03-12T16:05:05.834121Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02484650'),
+('03877a52-1332-47be-ab5f-4effa290f468', False, 'etta', 'Etta', ST_GeomFromText('POINT(-89.2241 34.4821)',4326), null, null, '2021-03-12T16:05:05.834264Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07268000'),
+('ab966e73-75bb-4dbc-969c-64e75a10316f', False, 'walnut-grove', 'Walnut Grove', ST_GeomFromText('POINT(-89.465 32.5883)',4326), null, null, '2021-03-12T16:05:05.834567Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02483000'),
+('02397d12-99a0-4ff5-b1be-f382e2eb1994', False, 'little-rock', 'Little Rock', ST_GeomFromText('POINT(-92.2692 34.7497)',4326), null, null, '2021-03-12T16:05:05.834749Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07263500'),
+('c285ad47-2e2d-463e-8a41-c0ca710069bb', False, 'west', 'West', ST_GeomFromText('POINT(-89.7711 33.1944)',4326), null, null, '2021-03-12T16:05:05.834819Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07289350'),
+('594998b1-55a5-48fd-954b-f4e6badbedb6', False, 'kosciusko', 'Kosciusko', ST_GeomFromText('POINT(-89.5778 33.0319)',4326), null, null, '2021-03-12T16:05:05.834968Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02484000'),
+('8c7f72c5-e787-4120-bc9f-c54454476b39', False, 'philadelphia', 'Philadelphia', ST_GeomFromText('POINT(-89.0978 32.8414)',4326), null, null, '2021-03-12T16:05:05.835085Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02481880'),
+('106960cf-9d65-4f47-b892-58c8a187066c', False, 'edinburg', 'Edinburg', ST_GeomFromText('POINT(-89.3361 32.7986)',4326), null, null, '2021-03-12T16:05:05.835230Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02482000'),
+('55a9d9cd-fe05-4a59-92ff-d1ce9a77d347', False, 'carthage', 'Carthage', ST_GeomFromText('POINT(-89.5264 32.7069)',4326), null, null, '2021-03-12T16:05:05.835377Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02482550'),
+('0cec4a83-e656-495b-8dc3-9d5b790411f6', False, 'ofahoma', 'Ofahoma', ST_GeomFromText('POINT(-89.6722 32.7056)',4326), null, null, '2021-03-12T16:05:05.835522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02484500'),
+('4e54b51a-de28-426e-8983-a9308029c379', False, 'merigold', 'Merigold', ST_GeomFromText('POINT(-90.6724 33.8319)',4326), null, null, '2021-03-12T16:05:05.835666Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07288280'),
+('d42748c9-24bd-486c-a172-8059c17d3e3e', False, 'lena', 'Lena', ST_GeomFromText('POINT(-89.6461 32.6672)',4326), null, null, '2021-03-12T16:05:05.835724Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02483500'),
+('1676ef54-4e74-4ef7-b77e-872ff5b70cad', False, 'tylertown', 'Tylertown', ST_GeomFromText('POINT(-90.2794 31.1769)',4326), null, null, '2021-03-12T16:05:05.835845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02490500'),
+('4d1c36ba-b2db-4bf8-b0ab-cf66d28a6671', False, 'pools-bluff', 'Pools Bluff', ST_GeomFromText('POINT(-89.8464 30.7058)',4326), null, null, '2021-03-12T16:05:05.836045Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02490193'),
+('7c25f0e4-512c-41a9-a28d-2c7ce8897ddc', False, 'pearl-l&d-3', 'Pearl L&D 3', ST_GeomFromText('POINT(-89.8681 30.6381)',4326), null, null, '2021-03-12T16:05:05.836163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02490200'),
+('8b3608c9-b01c-450a-9499-7e4973018d7c', False, 'pearl-l&d-3-upper-pool', 'Pearl L&D 3-Upper Pool', ST_GeomFromText('POINT(-89.8681 30.6381)',4326), null, null, '2021-03-12T16:05:05.836163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02490200'),
+('27ec6d01-e488-42cf-ba6b-ef6de26f6034', False, 'pearl-l&d-3-lock-chamber', 'Pearl L&D 3-Lock Chamber', ST_GeomFromText('POINT(-89.8681 30.6381)',4326), null, null, '2021-03-12T16:05:05.836163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('76d5dcaf-7917-4ef0-beb3-9752484f24fd', False, 'pearl-l&d-2', 'Pearl L&D 2', ST_GeomFromText('POINT(-89.8644 30.5931)',4326), null, null, '2021-03-12T16:05:05.836537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492100'),
+('57a7b9b3-b601-4d40-a639-35febe640ae3', False, 'pearl-l&d-2-upper-pool', 'Pearl L&D 2-Upper Pool', ST_GeomFromText('POINT(-89.8644 30.5931)',4326), null, null, '2021-03-12T16:05:05.836537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492100'),
+('fda90bcd-453d-4d60-b1a5-144ea5770e77', False, 'pearl-l&d-2-lock-chamber', 'Pearl L&D 2-Lock Chamber', ST_GeomFromText('POINT(-89.8644 30.5931)',4326), null, null, '2021-03-12T16:05:05.836537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('a813ee70-604f-4802-ba0d-ae86b97d3c3a', False, 'pearl-l&d-1', 'Pearl L&D 1', ST_GeomFromText('POINT(-89.7797 30.4564)',4326), null, null, '2021-03-12T16:05:05.836969Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492519'),
+('4e8b21e3-8839-42c4-873c-8d5c16d9a685', False, 'pearl-l&d-1-upper-pool', 'Pearl L&D 1-Upper Pool', ST_GeomFromText('POINT(-89.7797 30.4564)',4326), null, null, '2021-03-12T16:05:05.836969Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492519'),
+('b60cda39-f15c-4b81-8d20-9ebc10ca7566', False, 'pearl-l&d-1-lock-chamber', 'Pearl L&D 1-Lock Chamber', ST_GeomFromText('POINT(-89.7797 30.4564)',4326), null, null, '2021-03-12T16:05:05.836969Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('4c3cff85-2b27-48e1-9e4a-deef3a5d01cf', False, 'bush', 'Bush', ST_GeomFromText('POINT(-89.8972 30.6292)',4326), null, null, '2021-03-12T16:05:05.837474Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492000'),
+('6f7c4ac2-882d-47d0-b84b-b9ff1883d3c7', False, 'franklinton', 'Franklinton', ST_GeomFromText('POINT(-90.1619 30.8428)',4326), null, null, '2021-03-12T16:05:05.837653Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02491500'),
+('be86e5c9-adac-4c20-b145-4e96edd06ffb', False, 'bogalusa', 'Bogalusa', ST_GeomFromText('POINT(-89.8208 30.7931)',4326), null, null, '2021-03-12T16:05:05.837825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02489500'),
+('869e6649-b162-4eee-9a1d-a11340133089', False, 'columbia', 'Columbia', ST_GeomFromText('POINT(-89.8483 31.2372)',4326), null, null, '2021-03-12T16:05:05.838007Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02489000'),
+('bd1f8775-a91f-4470-8002-e5fe705b9e92', False, 'monticello', 'Monticello', ST_GeomFromText('POINT(-90.0878 31.5533)',4326), null, null, '2021-03-12T16:05:05.838278Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02488500'),
+('6225e66d-c2c2-438c-83e8-0ca08a237099', False, 'benton', 'Benton', ST_GeomFromText('POINT(-92.6108 34.5682)',4326), null, null, '2021-03-12T16:05:05.838443Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07363000'),
+('04a7e155-a702-4118-9c77-08f8271156c3', False, 'walker-lake-pump', 'Walker Lake Pump', ST_GeomFromText('POINT(-90.223 33.4872)',4326), null, null, '2021-03-12T16:05:05.838579Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8aa249f0-c5ea-4c1c-9d36-48bf595719d8', False, 'walker-lake-pump-landside', 'Walker Lake Pump-Landside', ST_GeomFromText('POINT(-90.223 33.4872)',4326), null, null, '2021-03-12T16:05:05.838579Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('2a04ace4-2248-4d54-b78a-1cff9a1e2520', False, 'walker-lake-pump-riverside', 'Walker Lake Pump-Riverside', ST_GeomFromText('POINT(-90.223 33.4872)',4326), null, null, '2021-03-12T16:05:05.838579Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('19491961-345b-4e35-9c90-c69507a7caf0', False, 'blakely-piez#1', 'Blakely_Piez#1', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('0cf9b697-9493-40cd-bc2d-e608ef48b0bf', False, 'blakely-piezometer-1', 'Blakely-Piezometer 1', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8f035a2d-b946-481a-b33a-f792ae474435', False, 'blakely-piezometer-2', 'Blakely-Piezometer 2', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('211d5f89-03e0-48a0-a6bd-44ae7d6643b7', False, 'blakely-piezometer-3', 'Blakely-Piezometer 3', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('30cb8cb0-5121-4120-929e-558135258246', False, 'blakely-piezometer-4', 'Blakely-Piezometer 4', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('2e406cc7-3e9a-44af-bc48-a73634315352', False, 'blakely-piezometer-5', 'Blakely-Piezometer 5', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('0c9a15e6-17e5-4dc4-a539-0b12c437bc50', False, 'blakely-piezometer-6', 'Blakely-Piezometer 6', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('c38ba723-8d5f-45c6-839d-d3b5e7a83463', False, 'blakely-piezometer-6b', 'Blakely-Piezometer 6B', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('da022ca5-5446-40af-afee-ccaab061a68f', False, 'blakely-piezometer-7', 'Blakely-Piezometer 7', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('9a1e1641-4626-47b9-b0a2-fbd0d00b5e97', False, 'blakely-piezometer-8', 'Blakely-Piezometer 8', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.838743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('992c3239-df98-4b9b-8501-e09c1f09a36e', False, 'lake-bruin', 'Lake Bruin', ST_GeomFromText('POINT(-91.2039 31.9433)',4326), null, null, '2021-03-12T16:05:05.840141Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('a4417d0e-bb78-4298-b028-6e14df4c6fae', False, 'lake-bruin-river-stage', 'Lake Bruin-River Stage', ST_GeomFromText('POINT(-91.2039 31.9433)',4326), null, null, '2021-03-12T16:05:05.840141Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f7f13219-df0a-4fc9-868e-d82163b8eec8', False, 'lake-bruin-piezometer-1', 'Lake Bruin-Piezometer 1', ST_GeomFromText('POINT(-91.2039 31.9433)',4326), null, null, '2021-03-12T16:05:05.840141Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('02e559d4-b44b-404a-8c05-fd7350a0c20b', False, 'st-joesephs', 'St Joesephs', ST_GeomFromText('POINT(-91.2342 31.9133)',4326), null, null, '2021-03-12T16:05:05.840460Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('6af5222c-212d-4467-af23-a5390618e112', False, 'st-joesephs-river-stage', 'St Joesephs-River Stage', ST_GeomFromText('POINT(-91.2342 31.9133)',4326), null, null, '2021-03-12T16:05:05.840460Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('ddf0f7dc-76ae-478e-8a2c-c783d56890da', False, 'st-joesephs-piezometer-1', 'St Joesephs-Piezometer 1', ST_GeomFromText('POINT(-91.2342 31.9133)',4326), null, null, '2021-03-12T16:05:05.840460Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('11c168a0-2dd5-4a45-a4dc-1def38f45a36', False, 'st-joesephs-piezometer-2', 'St Joesephs-Piezometer 2', ST_GeomFromText('POINT(-91.2342 31.9133)',4326), null, null, '2021-03-12T16:05:05.840460Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('babfa8ea-762a-46a6-a8f9-f598bb9ac824', False, 'st-joesephs-piezometer-3', 'St Joesephs-Piezometer 3', ST_GeomFromText('POINT(-91.2342 31.9133)',4326), null, null, '2021-03-12T16:05:05.840460Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('b68f7ce1-a68d-4ca8-8000-65957b8ee40a', False, 'st-joesephs-piezometer-4', 'St Joesephs-Piezometer 4', ST_GeomFromText('POINT(-91.2342 31.9133)',4326), null, null, '2021-03-12T16:05:05.840460Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('02303a7d-626a-4c17-a23a-4c7acde8aafd', False, 'kemp-bend', 'Kemp Bend', ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('0d8489ae-4d18-4e7a-9714-5232153f9521', False, 'kemp-bend-river-stage', 'Kemp Bend-River Stage', ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('dd03b1e3-3b6c-4ade-9eed-2e5b3226c869', False, 'kemp-bend-piezometer-1', 'Kemp Bend-Piezometer 1', ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('d4c68bb6-dfb0-4b14-a953-fbd8b5c3e213', False, 'kemp-bend-piezometer-2', 'Kemp Bend-Piezometer 2', ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('b65c0f89-bedf-40ee-81de-e545466a29ab', False, 'kemp-bend-piezometer-3', 'Kemp Bend-Piezometer 3', ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f90f3f16-b980-4fec-b3d3-a3b9f66fd853', False, 'kemp-bend-piezometer-4', 'Kemp Bend-Piezometer 4', ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('d89b6053-6ad4-4370-ae83-4d7cbd8f2010', False, 'kemp-bend-well-1', 'Kemp Bend-Well 1', ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('0245cbd6-2b3b-42b0-9483-21013bd2b139', False, 'kemp-bend-well-2', 'Kemp Bend-Well 2', ST_GeomFromText('POINT(-91.3481 31.8642)',4326), null, null, '2021-03-12T16:05:05.841030Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('ef710607-78a2-4fe1-afe9-f110a76c57a5', False, 'arkadelphia', 'Arkadelphia', ST_GeomFromText('POINT(-93.0464 34.1211)',4326), null, null, '2021-03-12T16:05:05.841504Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('577d9562-8eda-4557-9236-4c7c644c58a1', False, 'bl111', 'BL111', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.841630Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('1fed5716-c1b9-45a4-8bbb-9a1febfe7e3c', False, 'bl112', 'BL112', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.841749Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('5f9263df-0442-4bb4-a7b4-26267649c3b6', False, 'sl115', 'SL115', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.841836Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('0a62e568-f6b1-41f2-8d59-728367259844', False, 'bl113', 'BL113', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.841960Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('2fdb6919-2b62-4ac1-8c6e-00e9d1fd2311', False, 'bl115', 'BL115', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.842054Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('93bf60d1-826c-42f3-8040-69b217eeebea', False, 'l&d-3-lower', 'L&D 3-Lower', ST_GeomFromText('POINT(-92.7261 31.5198)',4326), null, null, '2021-03-12T16:05:05.842174Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('82e4fc73-e4c1-4940-bb61-50327296af03', False, 'l&d-3-upper', 'L&D 3-Upper', ST_GeomFromText('POINT(-92.728 31.5216)',4326), null, null, '2021-03-12T16:05:05.842292Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('7e6faf61-7b3d-4fd6-a8d9-755c927f313b', False, 'mid-pool-3', 'Mid Pool 3', ST_GeomFromText('POINT(-92.9648 31.7048)',4326), null, null, '2021-03-12T16:05:05.842409Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('42404400-fed0-4b3b-88dc-dd89e11372c0', False, 'bl116', 'BL116', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.842545Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('94032385-2f32-44ac-85ca-e8b052a181d5', False, 'dermott', 'Dermott', ST_GeomFromText('POINT(-91.4056 33.4939)',4326), null, null, '2021-03-12T16:05:05.842687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('a81128e7-9338-479f-ae10-68c22303ba6a', False, 'darling', 'Darling', ST_GeomFromText('POINT(-90.2903 34.3625)',4326), null, null, '2021-03-12T16:05:05.842818Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('36b8d189-c7fb-4db5-acbc-78f254a88dca', False, 'stuttgart', 'Stuttgart', ST_GeomFromText('POINT(-91.6164 34.4536)',4326), null, null, '2021-03-12T16:05:05.842944Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('2b816388-c22a-4063-b30a-6a145ac85a6c', False, 'grand-ecore', 'Grand Ecore', ST_GeomFromText('POINT(-93.0839 31.8251)',4326), null, null, '2021-03-12T16:05:05.843041Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('69c28050-211a-4059-a828-df4a0102c6ec', False, 'grady', 'Grady', ST_GeomFromText('POINT(-91.7097 34.0342)',4326), null, null, '2021-03-12T16:05:05.843224Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('4ed1f033-65d9-40c0-aee9-28247a25c195', False, 'transylvania', 'Transylvania', ST_GeomFromText('POINT(-91.2686 32.7144)',4326), null, null, '2021-03-12T16:05:05.843371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('c8e41cf7-553f-4096-8944-69153e138140', False, 'clarksdale-bigs', 'Clarksdale_BigS', ST_GeomFromText('POINT(-90.5753 34.1969)',4326), null, null, '2021-03-12T16:05:05.843472Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('de5d45dd-ec5f-4e4c-b375-f5bbb039d21c', False, 'bobo', 'Bobo', ST_GeomFromText('POINT(-90.1758 34.2892)',4326), null, null, '2021-03-12T16:05:05.843582Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('7c7d1540-4ccf-4dd2-ba9f-ecb786e25ade', False, 'marks-cassidy', 'Marks_Cassidy', ST_GeomFromText('POINT(-90.4269 34.231)',4326), null, null, '2021-03-12T16:05:05.843704Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('03d657d9-7171-43a6-8121-27627f699d03', False, 'st-louis', 'St Louis', ST_GeomFromText('POINT(-90.1833 38.6236)',4326), null, null, '2021-03-12T16:05:05.843795Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07010000'),
+('226147a7-14a1-4225-8a56-df950b7ddc41', False, 'cairo', 'Cairo', ST_GeomFromText('POINT(-89.1625 37.0)',4326), null, null, '2021-03-12T16:05:05.843958Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('aae4d98b-2dd3-4518-aca1-08a0c51a5015', False, 'memphis', 'Memphis', ST_GeomFromText('POINT(-90.0767 35.1231)',4326), null, null, '2021-03-12T16:05:05.844057Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07032000'),
+('49e8e224-037a-4f54-ae73-aaba817c7686', False, 'helena', 'Helena', ST_GeomFromText('POINT(-90.5842 34.5167)',4326), null, null, '2021-03-12T16:05:05.844121Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('61196e60-d8cf-4108-9b81-ebe0befc8593', False, 'arkabutla-lake', 'Arkabutla Lake', ST_GeomFromText('POINT(-90.1244 34.7572)',4326), null, null, '2021-03-12T16:05:05.844184Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('e7d4af0e-d6e3-4bba-975a-7e9a182ebccd', False, 'arkabutla-lake-piezometer-1a', 'Arkabutla Lake-Piezometer 1A', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.844184Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('055b8882-e430-47e4-8c41-d4fbe688430a', False, 'arkabutla-lake-piezometer-1b', 'Arkabutla Lake-Piezometer 1B', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.844184Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('e4365c38-71ec-4525-ba17-b201f5403fb3', False, 'arkabutla-lake-piezometer-2a', 'Arkabutla Lake-Piezometer 2A', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.844184Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('210a6218-600f-47fa-8721-813457eb850a', False, 'arkabutla-lake-piezometer-2b', 'Arkabutla Lake-Piezometer 2B', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.844184Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('3f43805c-8da4-418a-9890-a6f025d5ce0e', False, 'lambert', 'Lambert', ST_GeomFromText('POINT(-90.2144 34.1808)',4326), null, null, '2021-03-12T16:05:05.844632Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07280000'),
+('6d970695-2bea-4f89-9605-3bc711d45a5b', False, 'batesville', 'Batesville', ST_GeomFromText('POINT(-90.1721 33.7806)',4326), null, null, '2021-03-12T16:05:05.844853Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('6483e545-ca9c-4a1e-b3b8-74383d16e869', False, 'swan-lake', 'Swan Lake', ST_GeomFromText('POINT(-90.2766 33.8603)',4326), null, null, '2021-03-12T16:05:05.845017Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('e2388136-52f9-41b3-8a06-d3cf9ccb7390', False, 'whaley', 'Whaley', ST_GeomFromText('POINT(-90.1091 33.6314)',4326), null, null, '2021-03-12T16:05:05.845208Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('fa5fd6d5-6bd9-4d44-a523-b66292fa5bd7', False, 'degray-lake', 'Degray Lake', ST_GeomFromText('POINT(-93.1131 34.2147)',4326), null, null, '2021-03-12T16:05:05.845406Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('942adeba-ab7c-4ff0-8d57-c73cac455fcb', False, 'narrows-dam', 'Narrows Dam', ST_GeomFromText('POINT(-93.7153 34.1486)',4326), null, null, '2021-03-12T16:05:05.845620Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('02398292-51f1-44c9-bc22-26f039a51146', False, 'camden', 'Camden', ST_GeomFromText('POINT(-92.818 33.597)',4326), null, null, '2021-03-12T16:05:05.845773Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07362000'),
+('265fc11f-3b21-4795-80ac-a0c3c49eadb1', False, 'buck-chute', 'Buck Chute', ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('02212214-df31-4023-b7c1-56bdf6865bd2', False, 'buck-chute-water-elevation', 'Buck Chute-Water Elevation', ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('ed3f272b-8549-41bc-b1e0-5f91f77aa8bd', False, 'buck-chute-piezometer-1', 'Buck Chute-Piezometer 1', ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('050cc6b1-fdde-4bde-9041-f7d4ccd6901d', False, 'buck-chute-piezometer-2', 'Buck Chute-Piezometer 2', ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('94a773c5-ffb9-4504-a709-9c137498b2e6', False, 'buck-chute-piezometer-3', 'Buck Chute-Piezometer 3', ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f93a5df9-1331-4c8c-b422-8427f034a2ec', False, 'buck-chute-well-11', 'Buck Chute-Well 11', ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('2154b23c-42a0-4333-814b-a524833fab2f', False, 'buck-chute-well-12', 'Buck Chute-Well 12', ST_GeomFromText('POINT(-91.0716 32.5211)',4326), null, null, '2021-03-12T16:05:05.846013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('825436d6-3b25-405a-8a41-d553f809178a', False, 'bodcau-lake', 'Bodcau Lake', ST_GeomFromText('POINT(-93.511 32.702)',4326), null, null, '2021-03-12T16:05:05.846411Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('25909368-b5cb-4b46-af20-53794216d146', False, 'alexandria_afc6', 'Alexandria', ST_GeomFromText('POINT(-92.4395 31.309)',4326), null, null, '2021-03-12T16:05:05.846580Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('4a0b0cdf-8e9e-447a-b148-5fc22f88af78', False, 'arkansas-city', 'Arkansas City', ST_GeomFromText('POINT(-91.2382 33.5517)',4326), null, null, '2021-03-12T16:05:05.846777Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('9aa977da-babe-4c87-b96f-1ed0145bea74', False, 'greenville', 'Greenville', ST_GeomFromText('POINT(-91.161 33.2896)',4326), null, null, '2021-03-12T16:05:05.846914Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07265455'),
+('6e25d318-eda6-437f-a945-d67601ecfd60', False, 'vicksburg', 'Vicksburg', ST_GeomFromText('POINT(-90.9023 32.3118)',4326), null, null, '2021-03-12T16:05:05.847008Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07289000'),
+('d765265c-6615-4488-bf1f-0194d87e08bc', False, 'natchez', 'Natchez', ST_GeomFromText('POINT(-91.4334 31.544)',4326), null, null, '2021-03-12T16:05:05.847248Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07290880'),
+('1a841015-15cb-4ece-bd24-5815191d5286', False, 'red-river-lndg', 'Red River Lndg', ST_GeomFromText('POINT(-91.6644 30.9608)',4326), null, null, '2021-03-12T16:05:05.847383Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8a472143-06b2-49fc-906f-5e56f4ed27a7', False, 'new-orleans', 'New Orleans', ST_GeomFromText('POINT(-90.1361 29.9347)',4326), null, null, '2021-03-12T16:05:05.847505Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07374510'),
+('6b2b9f4c-4b51-4c3d-81be-fc81d5909d77', False, 'sarah', 'Sarah', ST_GeomFromText('POINT(-90.2244 34.5756)',4326), null, null, '2021-03-12T16:05:05.847568Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07279700'),
+('9c7186bd-d326-45c8-88d6-fa36dd2ad402', False, 'sardis-lake', 'Sardis Lake', ST_GeomFromText('POINT(-89.7867 34.3992)',4326), null, null, '2021-03-12T16:05:05.847789Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('5e6d5220-2ea8-4cf0-993a-533bdfd1064e', False, 'enid-lake', 'Enid Lake', ST_GeomFromText('POINT(-89.9038 34.158)',4326), null, null, '2021-03-12T16:05:05.848135Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('385441d4-c2c2-4e1d-82cd-ef1eabb49fe0', False, 'grenada-lake', 'Grenada Lake', ST_GeomFromText('POINT(-89.7706 33.8086)',4326), null, null, '2021-03-12T16:05:05.848284Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('91550145-347b-478c-8436-dcad772810e8', False, 'greenwood', 'Greenwood', ST_GeomFromText('POINT(-90.1808 33.5225)',4326), null, null, '2021-03-12T16:05:05.848405Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07287000'),
+('9483c377-2be1-4e01-8697-6145e4bcf831', False, 'blakely', 'Blakely', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.848633Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('16a74996-97f8-48f8-834f-e350ff4ffd5e', False, 'boughton', 'Boughton', ST_GeomFromText('POINT(-93.3043 33.8785)',4326), null, null, '2021-03-12T16:05:05.848769Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8f4c99bc-c7c0-461f-b081-b5fdc1fcc579', False, 'warren', 'Warren', ST_GeomFromText('POINT(-92.0109 33.6067)',4326), null, null, '2021-03-12T16:05:05.849009Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07364000'),
+('80619f46-d246-4df6-aa96-b25f5dc034b3', False, 'acme', 'Acme', ST_GeomFromText('POINT(-91.8307 31.2687)',4326), null, null, '2021-03-12T16:05:05.849172Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('73f0981e-ee25-481d-bb61-8be49d0397b2', False, 'wallace-lake', 'Wallace Lake', ST_GeomFromText('POINT(-93.6702 32.3188)',4326), null, null, '2021-03-12T16:05:05.849270Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('88d5213e-a4c1-4abe-a95d-6826d7d4eadd', False, 'wallace-lake-tailwater', 'Wallace Lake-Tailwater', ST_GeomFromText('POINT(-93.6702 32.3198)',4326), null, null, '2021-03-12T16:05:05.849270Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('295e2b4f-2f72-43a6-86d0-4dc5c2ff39f9', False, 'shreveport', 'Shreveport', ST_GeomFromText('POINT(-93.7397 32.5158)',4326), null, null, '2021-03-12T16:05:05.849463Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('c8c2d66b-1213-45ed-bf68-41604303e011', False, 'fulton', 'Fulton', ST_GeomFromText('POINT(-93.8128 33.6082)',4326), null, null, '2021-03-12T16:05:05.849582Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07341500'),
+('e07dc31a-59bb-480b-9a6b-e19a51bfed17', False, 'webb', 'Webb', ST_GeomFromText('POINT(-90.3414 33.9494)',4326), null, null, '2021-03-12T16:05:05.849802Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f566e02c-6034-49bc-a560-37083c8e0c7f', False, 'star-city', 'Star City', ST_GeomFromText('POINT(-91.7856 33.9611)',4326), null, null, '2021-03-12T16:05:05.849984Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('629eb4e8-2433-42b6-857c-82ddd684c138', False, 'wilmot', 'Wilmot', ST_GeomFromText('POINT(-91.5781 33.0714)',4326), null, null, '2021-03-12T16:05:05.850144Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f51f54a1-1d80-4076-a516-22003d870714', False, 'simmsport', 'Simmsport', ST_GeomFromText('POINT(-91.7983 30.9825)',4326), null, null, '2021-03-12T16:05:05.850257Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07381490'),
+('ab535466-e144-438b-97b5-762bcc5461c0', False, 'ft-pemberton-upper', 'Ft Pemberton-Upper', ST_GeomFromText('POINT(-90.2388 33.5292)',4326), null, null, '2021-03-12T16:05:05.850380Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('6fbb5d5d-448d-4101-b2f1-b4dc901543dd', False, 'locopolis', 'Locopolis', ST_GeomFromText('POINT(-90.2254 33.9755)',4326), null, null, '2021-03-12T16:05:05.850508Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('18ffd24b-08a6-455b-b75f-523118b6506d', False, 'holcomb', 'Holcomb', ST_GeomFromText('POINT(-90.0 33.7771)',4326), null, null, '2021-03-12T16:05:05.850660Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('6458716f-7f6b-4dfe-b0a8-41c8d8336b56', False, 'hollandale', 'Hollandale', ST_GeomFromText('POINT(-90.9241 33.1592)',4326), null, null, '2021-03-12T16:05:05.850808Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('6be5b3e7-1b96-42da-b3e0-a9660b395dc6', False, 'sardis-lake-tailwater', 'Sardis Lake-Tailwater', ST_GeomFromText('POINT(-89.7912 34.399)',4326), null, null, '2021-03-12T16:05:05.851069Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('088fcabb-36a8-4328-b9ce-587fd3ff797c', False, 'beekman', 'Beekman', ST_GeomFromText('POINT(-91.8678 32.873)',4326), null, null, '2021-03-12T16:05:05.851159Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('1b115389-2d2c-4964-9205-539dc9b4c1da', False, 'long-branch-rs', 'Long Branch-RS', ST_GeomFromText('POINT(-91.9252 31.399)',4326), null, null, '2021-03-12T16:05:05.851341Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('c394c042-4d78-4aa7-8955-35dbe0ac87b9', False, 'anguilla', 'Anguilla', ST_GeomFromText('POINT(-90.7773 32.9711)',4326), null, null, '2021-03-12T16:05:05.851436Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f94aa023-557a-490b-a959-afbe9a416738', False, 'holly-bluff', 'Holly Bluff', ST_GeomFromText('POINT(-90.718 32.8141)',4326), null, null, '2021-03-12T16:05:05.851775Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('982e1c3b-b4d8-4579-a830-0a84c95e9bcf', False, 'yazoo-city-pump', 'Yazoo City Pump', ST_GeomFromText('POINT(-90.4403 32.8339)',4326), null, null, '2021-03-12T16:05:05.851899Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('710a2455-33b0-4050-ac0e-849477b477a4', False, 'yazoo-city-pump-ls', 'Yazoo City Pump-LS', ST_GeomFromText('POINT(-90.4403 32.8339)',4326), null, null, '2021-03-12T16:05:05.851899Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('7e236767-da42-4982-ba39-bf6bd1b251ce', False, 'yazoo-city-pump-rs', 'Yazoo City Pump-RS', ST_GeomFromText('POINT(-90.4403 32.8339)',4326), null, null, '2021-03-12T16:05:05.851899Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8443da51-c18d-41cd-b4a0-3c5b7c6b8ae9', False, 'little-sunflower-ls', 'Little Sunflower-LS', ST_GeomFromText('POINT(-90.7286 32.622)',4326), null, null, '2021-03-12T16:05:05.852080Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('d3c24940-2647-4f3a-99c7-363080c17bd0', False, 'belzoni', 'Belzoni', ST_GeomFromText('POINT(-90.4947 33.1644)',4326), null, null, '2021-03-12T16:05:05.852234Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('1297689a-ffdc-4234-b9ca-3ac949af4058', False, 'alligator-catfsh-landside', 'Alligator Catfsh-Landside', ST_GeomFromText('POINT(-90.2479 33.4015)',4326), null, null, '2021-03-12T16:05:05.852381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('fc2080c5-c2cd-4c50-ae8d-53b3f7c3d054', False, 'jonesville-l&d', 'Jonesville L&D', ST_GeomFromText('POINT(-91.8604 31.4828)',4326), null, null, '2021-03-12T16:05:05.852500Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('9d84861e-8693-4063-934d-4e0471e5caa6', False, 'jonesville-l&d-upper', 'Jonesville L&D-Upper', ST_GeomFromText('POINT(-91.8604 31.4828)',4326), null, null, '2021-03-12T16:05:05.852500Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('9962ef2e-846e-4534-a078-8229b0e763f4', False, 'jonesville-l&d-lower', 'Jonesville L&D-Lower', ST_GeomFromText('POINT(-91.8603 31.4828)',4326), null, null, '2021-03-12T16:05:05.852500Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('98f3031e-6b6f-4b0f-8a48-647f0fea1d09', False, 'ft-necessity', 'Ft Necessity', ST_GeomFromText('POINT(-91.9281 32.0708)',4326), null, null, '2021-03-12T16:05:05.852759Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('d4fdc32c-2ad1-4a5f-b763-1c9edbaa7d2d', False, 'sheridan', 'Sheridan', ST_GeomFromText('POINT(-92.4061 34.1155)',4326), null, null, '2021-03-12T16:05:05.852915Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07363200'),
+('bcd00d59-da02-4926-8eb7-8f150b755f2a', False, 'catahoula-lake', 'Catahoula Lake', ST_GeomFromText('POINT(-92.1133 31.4794)',4326), null, null, '2021-03-12T16:05:05.853048Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f9f4b952-453b-4f7b-b176-a12bac27f8ee', False, 'catahoula-lake-structure-ls', 'Catahoula Lake-Structure-LS', ST_GeomFromText('POINT(-92.1133 31.4794)',4326), null, null, '2021-03-12T16:05:05.853048Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('2e1033f6-baa9-410f-9164-fcce250670a3', False, 'catahoula-lake-structure-rs', 'Catahoula Lake-Structure-RS', ST_GeomFromText('POINT(-92.1133 31.4794)',4326), null, null, '2021-03-12T16:05:05.853048Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8ef26da3-b45e-4eda-acb0-e66abddd2430', False, 'columbia-l&d', 'Columbia L&D', ST_GeomFromText('POINT(-92.1111 32.1667)',4326), null, null, '2021-03-12T16:05:05.853410Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07367630'),
+('66a16f0b-8ae6-411f-8d5f-b92b53b6c24f', False, 'columbia-l&d-upper', 'Columbia L&D-Upper', ST_GeomFromText('POINT(-92.1103 32.1668)',4326), null, null, '2021-03-12T16:05:05.853410Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('72077fe4-f72d-4350-9f0d-a496306650f2', False, 'columbia-l&d-lower', 'Columbia L&D-Lower', ST_GeomFromText('POINT(-92.1103 32.1668)',4326), null, null, '2021-03-12T16:05:05.853410Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('957c9052-a51e-48f1-8d6c-f7eff9585682', False, 'newlight', 'Newlight', ST_GeomFromText('POINT(-91.4897 32.0893)',4326), null, null, '2021-03-12T16:05:05.853729Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07369649'),
+('0620e0a5-8c5c-4b67-b788-a5928e6b9700', False, 'como', 'Como', ST_GeomFromText('POINT(-91.5925 32.0922)',4326), null, null, '2021-03-12T16:05:05.854091Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('ccea25e6-c8ab-4aeb-a047-17980cba65d2', False, 'bodcau-lake-tailwater', 'Bodcau Lake-Tailwater', ST_GeomFromText('POINT(-93.5124 32.7012)',4326), null, null, '2021-03-12T16:05:05.854228Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349660'),
+('8bbc7944-1e96-4796-9cc7-9412275f0d84', False, 'grace', 'Grace', ST_GeomFromText('POINT(-90.9624 32.917)',4326), null, null, '2021-03-12T16:05:05.854351Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('2b9febbf-d3cb-4b14-ad18-13c28cd03282', False, 'little-sunflower-rs', 'Little Sunflower-RS', ST_GeomFromText('POINT(-90.7266 32.6211)',4326), null, null, '2021-03-12T16:05:05.854613Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('eb7dbcfa-490b-4ff5-a88e-06ec1df78d97', False, 'steele-bayou', 'Steele Bayou', ST_GeomFromText('POINT(-90.8906 32.457)',4326), null, null, '2021-03-12T16:05:05.854705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('7f40b3a1-8215-4ac4-8ff4-766682b88360', False, 'steele-bayou-ls', 'Steele Bayou-LS', ST_GeomFromText('POINT(-90.8906 32.457)',4326), null, null, '2021-03-12T16:05:05.854705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07288938'),
+('18338967-a6ea-4d71-a381-0caa345aee67', False, 'steele-bayou-rs', 'Steele Bayou-RS', ST_GeomFromText('POINT(-90.8902 32.4522)',4326), null, null, '2021-03-12T16:05:05.854705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('6d55c347-fb30-46eb-b992-5d96b929a7db', False, 'steele-bayou-ls-bubbler', 'Steele Bayou-LS_Bubbler', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.854705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('38e2b538-fd2b-429e-8995-ba05f34993eb', False, 'ditch-bayou-dam', 'Ditch Bayou Dam', ST_GeomFromText('POINT(-91.2247 33.2557)',4326), null, null, '2021-03-12T16:05:05.854956Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('5d2140ee-299a-42e4-8966-d7ed8cc750e6', False, 'macon-lake', 'Macon Lake', ST_GeomFromText('POINT(-91.316 33.4569)',4326), null, null, '2021-03-12T16:05:05.855094Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('e6a54c7f-aff3-4926-b01a-b3117de5d828', False, 'canal-43-ark-cty', 'Canal 43_Ark Cty', ST_GeomFromText('POINT(-91.2783 33.6058)',4326), null, null, '2021-03-12T16:05:05.855216Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('4db583d1-b6a0-4833-830b-fc1ee4a25014', False, 'canal-81-ark-cty', 'Canal 81_Ark Cty', ST_GeomFromText('POINT(-91.2604 33.6054)',4326), null, null, '2021-03-12T16:05:05.855353Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('22dd898a-38f2-4986-93ff-b8b980c42f9e', False, 'birdie', 'Birdie', ST_GeomFromText('POINT(-90.3886 34.4138)',4326), null, null, '2021-03-12T16:05:05.855474Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('cb77894b-f134-4ec3-8b4f-b4e89a01cc29', False, 'crew-lake', 'Crew Lake', ST_GeomFromText('POINT(-91.9183 32.5001)',4326), null, null, '2021-03-12T16:05:05.855625Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07369000'),
+('cfddba44-12d4-4a85-99b5-4288de3245e6', False, 'bovina', 'Bovina', ST_GeomFromText('POINT(-90.6967 32.3475)',4326), null, null, '2021-03-12T16:05:05.855726Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07290000'),
+('f80aee31-7efd-41be-81e0-9e3ace7179ed', False, 'bentonia', 'Bentonia', ST_GeomFromText('POINT(-90.364 32.6028)',4326), null, null, '2021-03-12T16:05:05.855845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07289730'),
+('f0f13059-8879-446c-bb9d-97b141268f2b', False, 'ft-pemberton-lower', 'Ft Pemberton-Lower', ST_GeomFromText('POINT(-90.2378 33.5307)',4326), null, null, '2021-03-12T16:05:05.855966Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8c2a152a-ddad-494a-b44c-2c78718a7b96', False, 'felsenthal-l&d', 'Felsenthal L&D', ST_GeomFromText('POINT(-92.1225 33.0597)',4326), null, null, '2021-03-12T16:05:05.856127Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('c8db5b69-349c-4db8-8442-a87f89e17cce', False, 'felsenthal-l&d-upper', 'Felsenthal L&D-Upper', ST_GeomFromText('POINT(-92.1226 33.0597)',4326), null, null, '2021-03-12T16:05:05.856127Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('79e8dc07-e7e4-42fc-9831-7c9facce0464', False, 'felsenthal-l&d-lower', 'Felsenthal L&D-Lower', ST_GeomFromText('POINT(-92.1209 33.0563)',4326), null, null, '2021-03-12T16:05:05.856127Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('7cf16437-ab8b-490e-ad53-9bc6bbc52705', False, 'chicot-pump-rs', 'Chicot Pump-RS', ST_GeomFromText('POINT(-91.24 33.4318)',4326), null, null, '2021-03-12T16:05:05.856339Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('81c478af-5b7f-4238-bdb0-06d0d522d225', False, 'dumas', 'Dumas', ST_GeomFromText('POINT(-90.9846 32.5142)',4326), null, null, '2021-03-12T16:05:05.856431Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('0d9f02a5-d518-48be-b2b6-add187613086', False, 'murfreesboro', 'Murfreesboro', ST_GeomFromText('POINT(-93.7199 34.0485)',4326), null, null, '2021-03-12T16:05:05.856551Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('a22c8535-ecd3-4497-9d39-a03295dbc4ee', False, 'l&d-5-upper', 'L&D 5-Upper', ST_GeomFromText('POINT(-93.4961 32.2502)',4326), null, null, '2021-03-12T16:05:05.856739Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f60890b9-c4dd-4220-8a1c-064a7ea4ba61', False, 'alligator-catfsh-riverside', 'Alligator Catfsh-Riverside', ST_GeomFromText('POINT(-90.2464 33.4147)',4326), null, null, '2021-03-12T16:05:05.856921Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('22ee4fa2-09f4-41e8-99c5-d0bd184e150d', False, 'enid-lake-tailwater', 'Enid Lake-Tailwater', ST_GeomFromText('POINT(-89.9068 34.158)',4326), null, null, '2021-03-12T16:05:05.857069Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('af973261-36a7-4beb-ad72-028c2344fa3b', False, 'little-calleo', 'Little Calleo', ST_GeomFromText('POINT(-90.6861 33.1839)',4326), null, null, '2021-03-12T16:05:05.857173Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f769559c-33c4-4899-8835-22738c1d19e1', False, 'longwood', 'Longwood', ST_GeomFromText('POINT(-91.0045 33.1416)',4326), null, null, '2021-03-12T16:05:05.857316Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8913f49c-7506-41b2-aea4-1d3b45d2a6da', False, 'eudora-boeuf', 'Eudora_Boeuf', ST_GeomFromText('POINT(-91.3481 33.1242)',4326), null, null, '2021-03-12T16:05:05.857607Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07367680'),
+('0ddf15e8-2816-47b1-8483-769bcc5a5769', False, 'cincinnati', 'Cincinnati', ST_GeomFromText('POINT(-84.5106 39.0944)',4326), null, null, '2021-03-12T16:05:05.857706Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '03255000'),
+('4c4bef86-a406-43bc-815e-218e537386d6', False, 'louisville', 'Louisville', ST_GeomFromText('POINT(-85.7992 38.2803)',4326), null, null, '2021-03-12T16:05:05.857846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '03294500'),
+('b66afc0e-1346-4f60-9615-f2752e1e72c4', False, 'calhoun-city', 'Calhoun City', ST_GeomFromText('POINT(-89.3159 33.8384)',4326), null, null, '2021-03-12T16:05:05.857970Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07282000'),
+('6ccf1187-f0cd-4245-84b0-0516b3f25795', False, 'bruce', 'Bruce', ST_GeomFromText('POINT(-89.3478 33.9733)',4326), null, null, '2021-03-12T16:05:05.858181Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07283000'),
+('e3d4b6b5-a0a3-487b-92f9-0574311423ff', False, 'mckinney-pump', 'McKinney Pump', ST_GeomFromText('POINT(-90.448 34.6767)',4326), null, null, '2021-03-12T16:05:05.858359Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('fda25ff4-ab80-4495-918f-90dcea780bb6', False, 'sunflower-quiver', 'Sunflower_Quiver', ST_GeomFromText('POINT(-90.4387 33.5292)',4326), null, null, '2021-03-12T16:05:05.858516Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('eb7c8d94-47c4-4ff7-b475-934656f3e8ba', False, 'hernando', 'Hernando', ST_GeomFromText('POINT(-89.8831 34.8012)',4326), null, null, '2021-03-12T16:05:05.858721Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('a69770f7-d6b6-4ac8-bb9b-8b8e0599d2eb', False, 'belmont-bridge', 'Belmont Bridge', ST_GeomFromText('POINT(-89.8819 34.3875)',4326), null, null, '2021-03-12T16:05:05.858880Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('9f9acf8c-d4c4-49a1-9309-2744103a4495', False, 'shell-bluff', 'Shell Bluff', ST_GeomFromText('POINT(-90.2719 33.3967)',4326), null, null, '2021-03-12T16:05:05.859063Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('5d32ebdd-ba49-4e23-96d0-0b82a080c064', False, 'asc-tippo-str-ls', 'Asc Tippo Str-LS', ST_GeomFromText('POINT(-90.1721 33.7806)',4326), null, null, '2021-03-12T16:05:05.859205Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('2e55dbdc-9241-4055-bbd3-9c5cefd8d906', False, 'asc-tippo-str-rs', 'Asc Tippo Str-RS', ST_GeomFromText('POINT(-90.1721 33.7806)',4326), null, null, '2021-03-12T16:05:05.859205Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('92cb6d83-d616-4aa5-8762-8ac4c6f2727d', False, 'charleston', 'Charleston', ST_GeomFromText('POINT(-90.1627 34.0187)',4326), null, null, '2021-03-12T16:05:05.859384Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('17a70904-2ea8-4e16-b28e-0b84f010f4ec', False, 'hwy-82', 'HWY 82', ST_GeomFromText('POINT(-90.9682 33.4011)',4326), null, null, '2021-03-12T16:05:05.859501Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('31b58039-d5cc-4f7e-99cd-4a453f356ff8', False, 'muddy-bayou', 'Muddy Bayou', ST_GeomFromText('POINT(-90.9846 32.5142)',4326), null, null, '2021-03-12T16:05:05.859632Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('c383e317-e6c1-4813-ae3c-50f28f6234f3', False, 'muddy-bayou-landside', 'Muddy Bayou-Landside', ST_GeomFromText('POINT(-90.9846 32.5142)',4326), null, null, '2021-03-12T16:05:05.859632Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('7a982d11-6378-4d3a-adbf-585ec65b1663', False, 'muddy-bayou-riverside', 'Muddy Bayou-Riverside', ST_GeomFromText('POINT(-90.9846 32.5142)',4326), null, null, '2021-03-12T16:05:05.859632Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('76c9553d-5cfa-48c8-94ad-571c12ba168c', False, 'l&d-5-lower', 'L&D 5-Lower', ST_GeomFromText('POINT(-93.4947 32.248)',4326), null, null, '2021-03-12T16:05:05.859842Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('0646b0cb-3e68-4922-90f9-224926793f99', False, 'oak-grove', 'Oak Grove', ST_GeomFromText('POINT(-91.4989 32.7989)',4326), null, null, '2021-03-12T16:05:05.859951Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('11ad5336-2e61-4bea-968d-6a780c7fc096', False, 'long-branch-ls', 'Long Branch-LS', ST_GeomFromText('POINT(-91.9246 31.4012)',4326), null, null, '2021-03-12T16:05:05.860047Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('c4a5da16-65e5-4f3b-aa80-ce0a3e2a85a8', False, 'wild-cow-weir', 'Wild Cow Weir', ST_GeomFromText('POINT(-91.7394 31.3555)',4326), null, null, '2021-03-12T16:05:05.860167Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('719575d8-5929-47fc-9175-d5eb1cf9d4eb', False, 'wild-cow-weir-upper', 'Wild Cow Weir-Upper', ST_GeomFromText('POINT(-91.7392 31.3561)',4326), null, null, '2021-03-12T16:05:05.860167Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f368d55f-a07f-47ff-9bca-2e840ffc57c4', False, 'deer-park', 'Deer Park', ST_GeomFromText('POINT(-91.6509 31.4594)',4326), null, null, '2021-03-12T16:05:05.860390Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('53ba3164-3305-4c7d-a724-722d6a82d4ca', False, 'eudora-macon', 'Eudora_Macon', ST_GeomFromText('POINT(-91.2535 33.0998)',4326), null, null, '2021-03-12T16:05:05.860542Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07369680'),
+('ef199346-d2ca-4b81-b65d-659a05bffaae', False, 'catahoula-lake-center', 'Catahoula Lake-Center', ST_GeomFromText('POINT(-92.1386 31.4931)',4326), null, null, '2021-03-12T16:05:05.860789Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('ba2837a6-4ff0-453f-bf77-73698d389f2e', False, 'reed-road', 'Reed Road', ST_GeomFromText('POINT(-91.0232 33.3858)',4326), null, null, '2021-03-12T16:05:05.860936Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('4cbb7b79-6e05-4038-b00f-ddffc90379a2', False, 'oxford', 'Oxford', ST_GeomFromText('POINT(-89.5214 32.2733)',4326), null, null, '2021-03-12T16:05:05.861108Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07274000'),
+('83614c3b-e3b5-4699-8a76-aac96cb92744', False, 'old-river-str', 'Old River Str', ST_GeomFromText('POINT(-91.5977 31.0779)',4326), null, null, '2021-03-12T16:05:05.861271Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('64072090-3de8-489a-b2b0-3ca330c7f86e', False, 'old-river-str-hw', 'Old River Str-HW', ST_GeomFromText('POINT(-91.5977 31.0779)',4326), null, null, '2021-03-12T16:05:05.861271Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('ca604556-ca74-43ab-85fa-b3df4b221757', False, 'old-river-str-tw', 'Old River Str-TW', ST_GeomFromText('POINT(-91.5977 31.0779)',4326), null, null, '2021-03-12T16:05:05.861271Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('d687cad0-dff7-4b5a-bcec-398607d67d74', False, 'yazoo-city', 'Yazoo City', ST_GeomFromText('POINT(-90.4367 32.8578)',4326), null, null, '2021-03-12T16:05:05.861445Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8751469d-a261-499d-a486-1223a781376d', False, 'l&d-4-lower', 'L&D 4-Lower', ST_GeomFromText('POINT(-93.2687 31.9383)',4326), null, null, '2021-03-12T16:05:05.861570Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('22c38d80-cbc1-44f2-865d-44e26569465c', False, 'clayton', 'Clayton', ST_GeomFromText('POINT(-91.5443 31.7246)',4326), null, null, '2021-03-12T16:05:05.861690Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8a0e5fbe-cf65-48b8-b74c-c281327320a7', False, 'alto-boeuf', 'Alto_Boeuf', ST_GeomFromText('POINT(-91.8808 32.3738)',4326), null, null, '2021-03-12T16:05:05.861838Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('41a5a2dd-dd87-4367-a3d2-22cdddc95b93', False, 'tendal', 'Tendal', ST_GeomFromText('POINT(-91.4114 32.4456)',4326), null, null, '2021-03-12T16:05:05.861968Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07369500'),
+('15b6a696-0eb6-4e36-a093-f37120070ca3', False, 'connerly-dam', 'Connerly Dam', ST_GeomFromText('POINT(-91.2506 33.4149)',4326), null, null, '2021-03-12T16:05:05.862202Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('7242ab78-83c0-47c0-a68c-b83c13a5b9d2', False, 'lombardy', 'Lombardy', ST_GeomFromText('POINT(-90.6115 33.8822)',4326), null, null, '2021-03-12T16:05:05.862650Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07288200'),
+('60751208-1d8f-40dc-87c9-0a9b494d00cd', False, 'l&d-4-upper', 'L&D 4-Upper', ST_GeomFromText('POINT(-93.2712 31.9396)',4326), null, null, '2021-03-12T16:05:05.862929Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('45946a67-04c6-4bd3-b284-c90f28032e34', False, 'wasp-lake-rs', 'Wasp Lake-RS', ST_GeomFromText('POINT(-90.4208 33.2018)',4326), null, null, '2021-03-12T16:05:05.863153Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('3df9af43-bf42-4b6f-b7c9-805df3ec0dfc', False, 'wasp-lake-ls', 'Wasp Lake-LS', ST_GeomFromText('POINT(-90.4215 33.202)',4326), null, null, '2021-03-12T16:05:05.863368Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('4c4e50ec-fe20-4a71-868c-cec15fd556bd', False, 'blakely-piez#2', 'Blakely_Piez#2', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('17493247-a266-45f6-b6ee-52027df496f2', False, 'blakely-piezometer-9', 'Blakely-Piezometer 9', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('3ef2217e-a3be-4852-a1b4-a0b34c0643ca', False, 'blakely-piezometer-10', 'Blakely-Piezometer 10', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('36c64338-986d-467e-b422-33159150e165', False, 'blakely-weir', 'Blakely-Weir', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('5185e825-7da6-4ce0-b90c-ea9c4efa8a79', False, 'blakely-manhole-10', 'Blakely-Manhole 10', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f05373ed-f2c4-43f9-8bfb-aa0313b99b51', False, 'blakely-manhole-8', 'Blakely-Manhole 8', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('a38a9ef8-3aba-4c9b-9fff-c77164ede3a0', False, 'blakely-manhole-4', 'Blakely-Manhole 4', ST_GeomFromText('POINT(-93.1947 34.5728)',4326), null, null, '2021-03-12T16:05:05.863583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('d912fbba-9b57-40f9-aaf1-25b2504175aa', False, 'wabbaseka', 'Wabbaseka', ST_GeomFromText('POINT(-91.7992 34.3589)',4326), null, null, '2021-03-12T16:05:05.864724Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f4148833-5e49-47fb-9d8d-a3735a7419eb', False, 'lake-village', 'Lake Village', ST_GeomFromText('POINT(-91.4197 33.3042)',4326), null, null, '2021-03-12T16:05:05.864848Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('1ddb192a-2e3f-44a7-a5cb-dc184507f4ee', False, 'l&d-2-upper', 'L&D 2-Upper', ST_GeomFromText('POINT(-92.2927 31.1872)',4326), null, null, '2021-03-12T16:05:05.864945Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('4ef802ad-2147-4b8c-bd1a-720783209dcb', False, 'l&d-2-lower', 'L&D 2-Lower', ST_GeomFromText('POINT(-92.2902 31.1867)',4326), null, null, '2021-03-12T16:05:05.865070Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('b453fbaa-4013-4341-8daa-2c3a5de421c5', False, 'l&d-1-upper', 'L&D 1-Upper', ST_GeomFromText('POINT(-91.9588 31.2537)',4326), null, null, '2021-03-12T16:05:05.865177Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('26c45dc9-06b1-4ca8-9af2-ca8f3d1db205', False, 'l&d-1-lower', 'L&D 1-Lower', ST_GeomFromText('POINT(-91.9582 31.256)',4326), null, null, '2021-03-12T16:05:05.865315Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8228e3e2-af2a-4543-bb40-45e6a9798cc1', False, 'coushatta', 'Coushatta', ST_GeomFromText('POINT(-93.3521 32.0135)',4326), null, null, '2021-03-12T16:05:05.865450Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('2ab25153-f259-47be-a0f6-480a2ff4d3d0', False, 'larto-lake', 'Larto Lake', ST_GeomFromText('POINT(-91.9386 31.3597)',4326), null, null, '2021-03-12T16:05:05.865572Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('0d0dd809-6688-4e88-b5e6-2424db866aa1', False, 'caddo-lake', 'Caddo Lake', ST_GeomFromText('POINT(-93.9187 32.7044)',4326), null, null, '2021-03-12T16:05:05.865696Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07346310'),
+('a46dc996-754e-4675-9e7b-062d89ea7e7f', False, 'arkabutla-lake-tailwater', 'Arkabutla Lake-Tailwater', ST_GeomFromText('POINT(-90.1244 34.7572)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('b57a36f7-929a-45a5-b3ed-9824c257bd58', False, 'arkabutla-lake-piezometer-3a', 'Arkabutla Lake-Piezometer 3A', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('32f18746-6afb-4ea5-b5b5-006adb481d62', False, 'arkabutla-lake-piezometer-3b', 'Arkabutla Lake-Piezometer 3B', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('4ee7f297-f3b6-40b0-8dea-63690e0c3529', False, 'arkabutla-lake-piezometer-4a', 'Arkabutla Lake-Piezometer 4A', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('93033526-695e-412e-a3d4-6579476ea7a1', False, 'arkabutla-lake-piezometer-4b', 'Arkabutla Lake-Piezometer 4B', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f9161d24-e3e4-44a2-a706-f32a7c76147e', False, 'arkabutla-lake-piezometer-5a', 'Arkabutla Lake-Piezometer 5A', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('580f3763-b047-41ee-98c5-b4f503d406f8', False, 'arkabutla-lake-piezometer-5b', 'Arkabutla Lake-Piezometer 5B', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('67e19d70-ccf4-43a0-8f16-18707b0c681c', False, 'arkabutla-lake-piezometer-6a', 'Arkabutla Lake-Piezometer 6A', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('c22c8d10-dbe2-40fd-8922-d2c38f44dd7b', False, 'arkabutla-lake-piezometer-6b', 'Arkabutla Lake-Piezometer 6B', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.865846Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('db9eb8bc-d87b-40a5-8664-91f26e37d5a2', False, 'ha-ha-bayou-pump', 'Ha Ha Bayou Pump', ST_GeomFromText('POINT(-91.7604 31.6851)',4326), null, null, '2021-03-12T16:05:05.866426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('b4d71ec2-323b-483e-ba44-b2cb631ac34a', False, 'ha-ha-bayou-pump-landside', 'Ha Ha Bayou Pump-Landside', ST_GeomFromText('POINT(-91.7605 31.6858)',4326), null, null, '2021-03-12T16:05:05.866426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('7e0875c8-d856-477d-b2a8-cb2b0ee28f55', False, 'ha-ha-bayou-pump-riverside', 'Ha Ha Bayou Pump-Riverside', ST_GeomFromText('POINT(-91.7601 31.6845)',4326), null, null, '2021-03-12T16:05:05.866426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('4d69a06f-62ed-40ae-ac2c-00d3d2b3156e', False, 'tensas-cocodrie-rs', 'Tensas Cocodrie-RS', ST_GeomFromText('POINT(-91.7838 31.3725)',4326), null, null, '2021-03-12T16:05:05.866751Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('0feaa8e6-ff7e-4908-8f01-317842b580d9', False, 'tensas-cocodrie-ls', 'Tensas Cocodrie-LS', ST_GeomFromText('POINT(-91.7801 31.372)',4326), null, null, '2021-03-12T16:05:05.866929Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('9f76d202-9f1e-4b05-9402-a5d8f04c02b1', False, 'grenada-lake-tailwater', 'Grenada Lake-Tailwater', ST_GeomFromText('POINT(-89.7736 33.8088)',4326), null, null, '2021-03-12T16:05:05.867160Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('452a6427-76f7-4e86-bc5e-bdc1b4165f31', False, 'chicot-pump-ls', 'Chicot Pump-LS', ST_GeomFromText('POINT(-91.24 33.4311)',4326), null, null, '2021-03-12T16:05:05.867343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8bd1e8fe-7a7a-43de-a447-ad8d34fdda96', False, 'marks-coldwater', 'Marks_Coldwater', ST_GeomFromText('POINT(-90.2666 34.261)',4326), null, null, '2021-03-12T16:05:05.867563Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('1793c043-56a1-4653-aaf6-d75b9c300caf', False, 'baton-rouge', 'Baton Rouge', ST_GeomFromText('POINT(-91.1916 30.4457)',4326), null, null, '2021-03-12T16:05:05.867863Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07374000'),
+('531a4200-f861-4e24-824f-7f42e42a32ed', False, 'keithville', 'Keithville', ST_GeomFromText('POINT(-93.8278 32.3)',4326), null, null, '2021-03-12T16:05:05.868007Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07351500'),
+('14c85225-bec1-45ce-8609-7aeefffe60b7', False, 'springhill', 'Springhill', ST_GeomFromText('POINT(-93.5167 33.0039)',4326), null, null, '2021-03-12T16:05:05.868130Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349450'),
+('a392c083-04d9-4c28-a823-79becd16c13a', False, 'walkiah-bluff-us', 'Walkiah Bluff US', ST_GeomFromText('POINT(-89.8067 30.5716)',4326), null, null, '2021-03-12T16:05:05.868255Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02492111'),
+('a1a202b8-3b75-49e8-940b-18e46a9d77eb', False, 'walkiah-bluff-ds', 'Walkiah Bluff DS', ST_GeomFromText('POINT(-89.8116 30.5701)',4326), null, null, '2021-03-12T16:05:05.868480Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('27d39a1e-3dac-46db-abb4-583a7e514c0c', False, 'west-monroe', 'West Monroe', ST_GeomFromText('POINT(-92.1251 32.5057)',4326), null, null, '2021-03-12T16:05:05.868732Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07367005'),
+('95cbda04-2d65-40ae-89d8-b895336d4552', False, 'remmel-dam', 'Remmel Dam', ST_GeomFromText('POINT(-92.8941 34.4271)',4326), null, null, '2021-03-12T16:05:05.868852Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07359002'),
+('c2077bea-1cd1-4061-9881-77e99d2645aa', False, 'jackson', 'Jackson', ST_GeomFromText('POINT(-90.1786 32.2817)',4326), null, null, '2021-03-12T16:05:05.869118Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02486000'),
+('27f56fa0-f3d7-4ce4-b7f5-dfaebbd58039', False, 'mcgehee', 'McGehee', ST_GeomFromText('POINT(-91.4464 33.6287)',4326), null, null, '2021-03-12T16:05:05.869351Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07364150'),
+('c73e5560-ee63-4886-8d75-af4ed7c36eac', False, 'sunflower-bigsun', 'Sunflower_BigSun', ST_GeomFromText('POINT(-90.5431 33.5468)',4326), null, null, '2021-03-12T16:05:05.869484Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07288500'),
+('56e0b496-af12-4650-95ad-f0bafccace24', False, 'sarepta', 'Sarepta', ST_GeomFromText('POINT(-93.4828 32.905)',4326), null, null, '2021-03-12T16:05:05.869944Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349500'),
+('55c25746-6525-4110-afe8-b06e7b508873', False, 'clarksdale-cass', 'Clarksdale_Cass', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.870036Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07280809'),
+('5c3d2ecd-cf78-4fff-b193-aad93f5673c6', False, 'fool-river-pump', 'Fool River Pump', ST_GeomFromText('POINT(-91.5765 31.8852)',4326), null, null, '2021-03-12T16:05:05.870190Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('e157b0fd-ed4e-470f-a5fe-ea52879221b6', False, 'fool-river-pump-landside', 'Fool River Pump-Landside', ST_GeomFromText('POINT(-91.578 31.8863)',4326), null, null, '2021-03-12T16:05:05.870190Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('1606c27e-cb2b-4251-b18c-e750f37bb9f0', False, 'fool-river-pump-riverside', 'Fool River Pump-Riverside', ST_GeomFromText('POINT(-91.5765 31.885)',4326), null, null, '2021-03-12T16:05:05.870190Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('f51d759c-477f-475a-922d-ba292c9d1871', False, 'harrisonburg', 'Harrisonburg', ST_GeomFromText('POINT(-91.8189 31.7708)',4326), null, null, '2021-03-12T16:05:05.870491Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('ecd2da68-19b9-468f-ad67-1cb96505ddfe', False, 'mt-ida', 'Mt Ida', ST_GeomFromText('POINT(-93.6976 34.6104)',4326), null, null, '2021-03-12T16:05:05.870708Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07356000'),
+('1242fb3e-e884-4785-bbc6-4af8fffe810b', False, 'caddo-gap', 'Caddo Gap', ST_GeomFromText('POINT(-93.6057 34.3828)',4326), null, null, '2021-03-12T16:05:05.870811Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07359610'),
+('71b79a2b-3829-4772-9bc3-700a2c7d2a7d', False, 'leland', 'Leland', ST_GeomFromText('POINT(-90.8476 33.3968)',4326), null, null, '2021-03-12T16:05:05.870934Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07288650'),
+('cbf4520e-349e-43fc-8910-0b192c3aa468', False, 'money', 'Money', ST_GeomFromText('POINT(-90.2111 33.6511)',4326), null, null, '2021-03-12T16:05:05.871563Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07281600'),
+('23a3e68c-f436-4891-b802-19284cfcc056', False, 'portland', 'Portland', ST_GeomFromText('POINT(-91.5348 33.2358)',4326), null, null, '2021-03-12T16:05:05.871874Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07364185'),
+('daac083c-8e27-4b72-95cb-d876def91eb4', False, 'pine-bluff', 'Pine Bluff', ST_GeomFromText('POINT(91.9956 34.2903)',4326), null, null, '2021-03-12T16:05:05.871946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07263650'),
+('e02bae8f-70c0-43a9-8a3f-a8b74b0d0d9e', False, 'langley', 'Langley', ST_GeomFromText('POINT(-93.8998 34.3118)',4326), null, null, '2021-03-12T16:05:05.872011Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07360200'),
+('1170150e-0b0c-4a09-ac94-b4962f30f311', False, 'lonoke', 'Lonoke', ST_GeomFromText('POINT(-91.9028 34.7361)',4326), null, null, '2021-03-12T16:05:05.872134Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07264000'),
+('e702916c-405b-4ff4-88ea-12e05da3dc2e', False, 'index', 'Index', ST_GeomFromText('POINT(-94.0411 33.5519)',4326), null, null, '2021-03-12T16:05:05.872199Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07337000'),
+('20a99f65-eb4a-469e-ae59-a4ba44ba0d57', False, 'doddsville', 'Doddsville', ST_GeomFromText('POINT(-90.4017 33.6406)',4326), null, null, '2021-03-12T16:05:05.872382Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('55daf64f-9e33-4ace-84f5-4e2db3a09d71', False, 'thatcher-l&d', 'Thatcher L&D', ST_GeomFromText('POINT(-92.4827 33.3065)',4326), null, null, '2021-03-12T16:05:05.872665Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('49cfa3a4-9fd1-425a-bdde-6fcd510b0c62', False, 'thatcher-l&d-upper', 'Thatcher L&D-Upper', ST_GeomFromText('POINT(-92.4827 33.3065)',4326), null, null, '2021-03-12T16:05:05.872665Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('c0593e4c-b6d6-43e9-8c23-8219c20a9d18', False, 'thatcher-l&d-lower', 'Thatcher L&D-Lower', ST_GeomFromText('POINT(-92.4827 33.3065)',4326), null, null, '2021-03-12T16:05:05.872665Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('ec0657a7-b378-4f8a-a717-2319b1217fb2', False, 'jferson-bigc-bay', 'Jferson_BigC_Bay', ST_GeomFromText('POINT(-94.4986 32.7494)',4326), null, null, '2021-03-12T16:05:05.873046Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07346000'),
+('35e6d002-13cd-4d57-af13-1029a0a545e2', False, 'jferson-blkc-bay', 'Jferson_BlkC_Bay', ST_GeomFromText('POINT(-94.3572 32.7778)',4326), null, null, '2021-03-12T16:05:05.873201Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07346045'),
+('323274cf-f36c-4fda-998b-5b80c08ef0e3', False, 'jferson-ltlc-bay', 'Jferson_LtlC_Bay', ST_GeomFromText('POINT(-94.3458 32.7128)',4326), null, null, '2021-03-12T16:05:05.873405Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07346070'),
+('1821db93-3501-49f9-8ed9-311dc71a47e6', False, 'ore-city', 'Ore City', ST_GeomFromText('POINT(-94.7508 32.6725)',4326), null, null, '2021-03-12T16:05:05.873611Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07346050'),
+('8088bce2-3ea4-44ce-88cb-af6e93681d73', False, 'karnack', 'Karnack', ST_GeomFromText('POINT(-94.2325 32.7386)',4326), null, null, '2021-03-12T16:05:05.873767Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07346080'),
+('96406673-27a3-4c71-a574-31b7a85c6120', False, 'antoine', 'Antoine', ST_GeomFromText('POINT(-93.4181 34.0389)',4326), null, null, '2021-03-12T16:05:05.873962Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07361500'),
+('f1eb1b57-ed41-4e79-9311-a08d71913025', False, 'joyce', 'Joyce', ST_GeomFromText('POINT(-92.6033 31.9369)',4326), null, null, '2021-03-12T16:05:05.874130Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07372050'),
+('29c76605-2deb-4bf9-8cba-e075c81efc50', False, 'renfroe', 'Renfroe', ST_GeomFromText('POINT(-89.4433 32.8622)',4326), null, null, '2021-03-12T16:05:05.874304Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02482470'),
+('d7967575-d65b-43be-979d-4b1541a1fec2', False, 'sand-hill', 'Sand Hill', ST_GeomFromText('POINT(-89.8128 32.5056)',4326), null, null, '2021-03-12T16:05:05.874551Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02484760'),
+('f761a4a9-8565-4059-a241-a3be7a617c7a', False, 'fannin', 'Fannin', ST_GeomFromText('POINT(-89.9553 32.3881)',4326), null, null, '2021-03-12T16:05:05.874775Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02485498'),
+('311779bb-6317-44d6-9347-eaf65e139265', False, 'sligo-redchute', 'Sligo_RedChute', ST_GeomFromText('POINT(-93.5944 32.4472)',4326), null, null, '2021-03-12T16:05:05.874934Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349860'),
+('780d39f1-f682-4936-a5af-148054360b5f', False, 'texarkana', 'Texarkana', ST_GeomFromText('POINT(-94.1514 33.3042)',4326), null, null, '2021-03-12T16:05:05.875069Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07344210'),
+('ed2624f8-fdcf-4762-8faf-530f74c4b4f4', False, 'hickahala', 'Hickahala', ST_GeomFromText('POINT(-89.9245 34.632)',4326), null, null, '2021-03-12T16:05:05.875212Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('6bf92b7d-c879-4b29-a404-3a60233f5036', False, 'senatobia', 'Senatobia', ST_GeomFromText('POINT(-89.9412 34.6166)',4326), null, null, '2021-03-12T16:05:05.875372Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('5b1b22f1-a204-4b1a-8b64-2c81b8b9bf5d', False, 'satartia', 'Satartia', ST_GeomFromText('POINT(-90.5485 32.6726)',4326), null, null, '2021-03-12T16:05:05.875576Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('4bedd60e-6df1-4d57-82ba-c8546e21a840', False, 'lake-bistineau', 'Lake Bistineau', ST_GeomFromText('POINT(-93.4311 32.3283)',4326), null, null, '2021-03-12T16:05:05.875794Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349250'),
+('e5431262-565f-4493-828f-37e5dddef558', False, 'cross-lake', 'Cross Lake', ST_GeomFromText('POINT(-93.7983 32.5125)',4326), null, null, '2021-03-12T16:05:05.875911Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07344480'),
+('6070710d-36cd-449f-8945-2fedeebcffe6', False, 'sterlington', 'Sterlington', ST_GeomFromText('POINT(-92.0868 32.6962)',4326), null, null, '2021-03-12T16:05:05.876028Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07364535'),
+('ff51bcd7-ac01-40c1-b163-9fe15e77be6c', False, 'cypress-byu-lake', 'Cypress Byu Lake', ST_GeomFromText('POINT(-93.6697 32.6519)',4326), null, null, '2021-03-12T16:05:05.876194Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07349815'),
+('cf529851-4ee5-4ce9-be46-a5395a3b1e75', False, 'fordyce', 'Fordyce', ST_GeomFromText('POINT(92.3333 33.7922)',4326), null, null, '2021-03-12T16:05:05.876274Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07362500'),
+('6c5fa8a3-b90c-4b03-9437-dcfb24d3937e', False, 'dlo', 'Dlo', ST_GeomFromText('POINT(-89.8975 31.9778)',4326), null, null, '2021-03-12T16:05:05.876398Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '02487500'),
+('0e9b7893-07f1-417b-b2d3-f084d1599f52', False, 'calionpump-rs', 'CalionPump-RS', ST_GeomFromText('POINT(-92.5268 33.3254)',4326), null, null, '2021-03-12T16:05:05.876694Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('a4ea5dbb-c5a5-4a87-aa4a-49320f28895f', False, 'wolf-lake', 'Wolf Lake', ST_GeomFromText('POINT(-90.5442 32.8962)',4326), null, null, '2021-03-12T16:05:05.876878Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('1756a225-761a-46b5-ac97-308a6b2ebf56', False, 'sligo', 'Sligo', ST_GeomFromText('POINT(-91.8202 32.2052)',4326), null, null, '2021-03-12T16:05:05.877052Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('4efc2277-2c34-431b-83c1-a87f2ae665d2', False, 'pendleton', 'Pendleton', ST_GeomFromText('POINT(-91.3844 33.9786)',4326), null, null, '2021-03-12T16:05:05.877275Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07265280'),
+('33708c8a-5aa9-4dba-be6d-c8c7f0c58105', False, 'big-bayou-meto', 'Big Bayou Meto', ST_GeomFromText('POINT(-91.4389 31.0825)',4326), null, null, '2021-03-12T16:05:05.877463Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('ed0e3e5f-a895-4761-b2e7-3b486fad8132', False, 'big-bayou-meto-ls', 'Big Bayou Meto-LS', ST_GeomFromText('POINT(-91.4389 34.0825)',4326), null, null, '2021-03-12T16:05:05.877463Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('d4d9297b-fd65-43a4-aa9f-84a44808d546', False, 'big-bayou-meto-rs', 'Big Bayou Meto-RS', ST_GeomFromText('POINT(-91.4389 34.0825)',4326), null, null, '2021-03-12T16:05:05.877463Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('62707aea-d051-4b60-97fb-a004790b7eb0', False, 'rosedale', 'Rosedale', ST_GeomFromText('POINT(-91.2431 33.5653)',4326), null, null, '2021-03-12T16:05:05.877849Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('5262f4a2-1e7d-45d2-a9c8-e506a807153d', False, 'ltl-bayou-meto', 'Ltl Bayou Meto', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878099Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('b5a8791b-8a7c-4ec0-99dc-988e9bd4938b', False, 'ltl-bayou-meto-ls', 'Ltl Bayou Meto-LS', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878099Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('3399ae8f-4257-4d3d-b613-bb8fdd29b1ae', False, 'ltl-bayou-meto-rs', 'Ltl Bayou Meto-RS', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878099Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('1dfb9d3c-cb4f-42d4-9d6a-3fc6da250d45', False, 'columbia-l&d-piezometers', 'Columbia L&D-Piezometers', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('3138d011-d43e-4468-af48-399a82d70b0e', False, 'columbia-l&d-pump-1', 'Columbia L&D-Pump 1', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('a12aaf92-1eed-45a3-b35d-b549ba71053e', False, 'columbia-l&d-pump-2', 'Columbia L&D-Pump 2', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8e925fe9-c15c-4504-93c0-a8be978bcbec', False, 'columbia-l&d-pump-3', 'Columbia L&D-Pump 3', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('50e7ef09-68de-4ee8-8d32-94be2b02976d', False, 'columbia-l&d-pump-4', 'Columbia L&D-Pump 4', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('056d6bd1-962d-4e50-a392-dcbe16b94f71', False, 'columbia-l&d-pump-5', 'Columbia L&D-Pump 5', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('820a5a8f-dcec-428c-b7e5-6421adb7fc43', False, 'columbia-l&d-pump-6', 'Columbia L&D-Pump 6', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('243121b0-fe13-4e1a-acc4-00a7956f7cf9', False, 'columbia-l&d-pump-7', 'Columbia L&D-Pump 7', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('74cafaa7-3948-4f5a-aa8f-974cab167a32', False, 'columbia-l&d-pump-8', 'Columbia L&D-Pump 8', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('69c8f11a-67d8-4022-90f0-a37945ea8e30', False, 'columbia-l&d-pump-9', 'Columbia L&D-Pump 9', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('30ec4016-fb88-48d8-8ae1-2b6ad3761fd3', False, 'coffeeville-pump', 'Coffeeville_Pump', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878943Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('163be643-a493-4c0d-afb9-efbfd3abf16b', False, 'coffeeville-pump-ls', 'Coffeeville_Pump-LS', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878943Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8a4afeb2-6920-4a18-985a-054bdc063413', False, 'coffeeville-pump-rs', 'Coffeeville_Pump-RS', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.878943Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('386882df-1e76-420d-a975-f743b2f41076', False, 'lake-providence', 'Lake Providence', ST_GeomFromText('POINT(-91.1539 32.8)',4326), null, null, '2021-03-12T16:05:05.879082Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('b8769376-21f0-4e1e-8ad8-e96d32690726', False, 'bayou-cocodrie', 'Bayou Cocodrie', ST_GeomFromText('POINT(-91.6775 31.1969)',4326), null, null, '2021-03-12T16:05:05.879267Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('e887ca23-71e8-4e8e-962c-04ec67380b5d', False, 'bayou-cocodrie-riverside', 'Bayou Cocodrie-Riverside', ST_GeomFromText('POINT(-91.6775 31.1969)',4326), null, null, '2021-03-12T16:05:05.879267Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('8b6ab624-1121-45d8-b0b7-38642dbbdc72', False, 'bayou-cocodrie-landside', 'Bayou Cocodrie-Landside', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:05:05.879267Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, null),
+('86de2c4f-8004-4862-8ce5-fef7abad9179', False, 'willows', 'Willows', ST_GeomFromText('POINT(-90.8783 32.0186)',4326), null, null, '2021-03-12T16:05:05.879610Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07290650'),
+('9a234591-568e-41db-8b61-ffbc60c5e79c', False, 'eddiceton', 'Eddiceton', ST_GeomFromText('POINT(-90.7775 31.5031)',4326), null, null, '2021-03-12T16:05:05.879814Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07291000'),
+('e687503d-c1ec-47f4-bcc4-75e3f1a61758', False, 'rosetta', 'Rosetta', ST_GeomFromText('POINT(-91.1094 31.3247)',4326), null, null, '2021-03-12T16:05:05.880087Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07292500'),
+('dec8e385-e9fd-4d44-b45c-1caaf035a0f6', False, 'woodville', 'Woodville', ST_GeomFromText('POINT(-91.2956 31.2269)',4326), null, null, '2021-03-12T16:05:05.880259Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07295000'),
+('52e4a103-008a-4a7e-9ceb-6af54956c0bc', False, 'powhatan', 'Powhatan', ST_GeomFromText('POINT(-93.2061 31.8603)',4326), null, null, '2021-03-12T16:05:05.880509Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'adb903f3-c9d0-44ef-b515-c6fd5e7dc682', '00000000-0000-0000-0000-000000000000', null, '07351755');
 
 --INSERT INSTRUMENT STATUS--
 INSERT INTO instrument_status(id, instrument_id, status_id, "time")
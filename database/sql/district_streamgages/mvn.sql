@@ -215,223 +215,223 @@ where i.project_id = 'e38f3d27-21fe-4e35-8de5-3aea8580e909';
 --Ignoring 73473 platform sensor site/instrument, already in instruments unique list
 
 --INSERT INSTRUMENTS--COUNT:215
-INSERT INTO instrument(id, deleted, slug, name, formula, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
+INSERT INTO instrument(id, deleted, slug, name, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
  VALUES 
-('15dacad3-a7e5-4378-91b4-34208e7039cb', False, '73600', '73600', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.948076Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('35896e62-b69f-4bc9-9fc6-78ea7c5fa92a', False, '73650', '73650', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.948289Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('bbeff60a-9198-4956-9ef2-021fc851cdbd', False, 'barataria-pass-at-grand-isle-la', 'BARATARIA PASS AT GRAND ISLE LA', null, ST_GeomFromText('POINT(-89.9468 29.2728)',4326), null, null, '2021-03-12T16:10:12.948450Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '073802516'),
-('64d4fe14-952c-4f0a-b256-1ae56e687fd2', False, '76010', '76010', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.948600Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('9e13d62c-b9e4-49eb-aee5-59b4d1bbd46c', False, '76005', '76005', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.948600Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('d469455f-6ce9-4faf-b2fc-c321f3365a6f', False, '76025', '76025', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.949259Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('b44d2de5-fa95-46fd-9254-93078405d35d', False, '76024', '76024', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.949259Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('41e9219e-2f1f-439f-a724-a3e5c7c7cf73', False, 'giww-east-storm-surge-barrier-at-new-orleans-la', 'GIWW EAST STORM SURGE BARRIER AT NEW ORLEANS LA', null, ST_GeomFromText('POINT(-89.9019 30.0144)',4326), null, null, '2021-03-12T16:10:12.950027Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '073802339'),
-('6ee253df-10ae-493c-b307-de8e85464c07', False, '76032', '76032', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.950027Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('72ca06c0-2710-4135-be3a-bf93d8c51dfd', False, '76030', '76030', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.950027Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('95b436bc-842a-4ad8-83e7-2410a56c439c', False, '76040', '76040', null, ST_GeomFromText('POINT(-89.9374 30.0067)',4326), null, null, '2021-03-12T16:10:12.950279Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('016d2287-c4a8-4544-9588-dfb961726c26', False, '76060', '76060', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.950439Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('3f1daace-2b8e-4d6c-a21c-1a23359ae00b', False, '76065', '76065', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.950616Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('a757599f-b2e5-4fbe-b40d-8522c3b04af0', False, '76062', '76062', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.950616Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('38fa06c8-6457-4523-b4ef-3ebffa520151', False, '76220', '76220', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.951160Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('b9e94249-d8e9-41ac-b683-bde39fa79ed4', False, 'coe-freshwater-c-at-freshwater-b-lock-south', '(COE) FRESHWATER C. AT FRESHWATER B. LOCK (SOUTH)', null, ST_GeomFromText('POINT(-92.1956 29.7833)',4326), null, null, '2021-03-12T16:10:12.951444Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07389700'),
-('616fa910-eabd-4718-940f-c7ec2afeffd8', False, '76260', '76260', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.951621Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('c9e023a7-9cd7-4443-9661-c0f629bc6c8a', False, '76265', '76265', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.951621Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('6d781aa1-93e5-4aa5-85fe-9d057ead4247', False, '76305', '76305', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.952221Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('4adb6c37-4ae1-43e1-9540-e5595bf86d1b', False, '76320', '76320', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.952424Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('67cf3e31-f3de-4413-930d-1cf4903c454e', False, '76360', '76360', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.952571Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('eafbba9f-6875-462c-a8ec-99e224c37765', False, '76362', '76362', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.952571Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('0b4fb382-4721-4c93-8c92-eb47812e0bac', False, '76400', '76400', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.952571Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('bacf256e-eb96-4c36-8954-bd92a306f4d8', False, '76480', '76480', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.952872Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('5524e00c-1f89-418d-9740-6de8645b8333', False, 'giww-at-bayou-sale-ridge-near-franklin-la', 'GIWW AT BAYOU SALE RIDGE NEAR FRANKLIN LA', null, ST_GeomFromText('POINT(-91.4706 29.6808)',4326), null, null, '2021-03-12T16:10:12.953035Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381670'),
-('d7281dd7-778d-4c3a-90a4-b21bb641e850', False, '76592', '76592', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.953219Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('17968170-2ff2-4cd8-9809-818f88732879', False, '76595', '76595', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.953219Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('cabcd7a9-db9e-415e-ba84-cbe5f1f5c768', False, '76593', '76593', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.953219Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('f6e8916f-296a-49e9-aca1-82665748ca62', False, '76680', '76680', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.953537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('ee622c1e-70e8-4620-8f61-2261c0ed13ac', False, '76600', '76600', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.953537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('70ba9edc-40ea-42ad-a8a3-ceabb25b05e2', False, '76720', '76720', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954216Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('b226f922-c336-4929-8bb1-9e9ddf4b59e6', False, '76724', '76724', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954216Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('7c82c421-5294-40a7-87ff-7af6e42a18a5', False, '76800', '76800', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954216Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('694b13bf-449a-4c44-8aa2-c38469c07ed5', False, '76880', '76880', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954530Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('f15dbad6-ccb7-4a72-9298-5cd33a8b4797', False, '76884', '76884', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954530Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('c42b64ce-0460-41f3-8bbf-86a8da1d4c00', False, '76960', '76960', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954530Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('a392c3e8-738d-4feb-afc1-00d064e4298e', False, '82250', '82250', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('e0ecd779-e73c-47f4-a906-55c8002dcba7', False, '82260', '82260', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('8add8896-c24d-44b3-9853-c189d2d83695', False, '82700', '82700', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.955289Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('1592e55f-ff35-40a9-80c4-7c81e4f620b3', False, '82718', '82718', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.955475Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('4d62ac6e-35b9-41cd-80de-7468d2bad87c', False, '82715', '82715', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.955475Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('3604b451-24dc-423f-9e8a-f0bbf9d7d8f2', False, '82720', '82720', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.955929Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('9d3ea29e-59a3-46dd-a091-d8fed56f03ec', False, '82725', '82725', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.956037Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('4c7ce601-f232-4634-936c-d7604eb54e3b', False, '82740', '82740', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.956209Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('e213714d-2656-4719-84f5-61e208032038', False, '82745', '82745', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.956353Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('965c672f-d3d2-41a9-a537-7626ee1da6d1', False, '82742', '82742', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.956353Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('6bdca601-8044-4830-85e5-7f34dd5d5865', False, '82760', '82760', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.957042Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('c7e40f37-2390-404f-82fe-c9e7496b8801', False, '82762', '82762', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.957042Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('75e2eee7-51ce-4c21-b0fc-e039c9ef38d2', False, '82770', '82770', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.957437Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('59bc5f1d-63a4-455c-80d0-fa6ad8f641fc', False, '82772', '82772', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.957437Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('8091654d-2afd-46a6-a2a7-26447b8c0df4', False, '82875', '82875', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.957812Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('2cce0e9d-c608-4424-a8f8-0f76e0691338', False, 'amite-river-near-denham-springs-la', 'AMITE RIVER NEAR DENHAM SPRINGS LA', null, ST_GeomFromText('POINT(-90.9903 30.4639)',4326), null, null, '2021-03-12T16:10:12.958013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07378500'),
-('82d2991c-71db-4699-9e7f-5506f9614d58', False, '85300', '85300', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.958172Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('21bdce69-d2a7-49f0-807b-d29c747f2802', False, 'tickfaw-river-at-holden-la', 'TICKFAW RIVER AT HOLDEN LA', null, ST_GeomFromText('POINT(-90.6772 30.5036)',4326), null, null, '2021-03-12T16:10:12.958428Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07376000'),
-('28bc2a6e-72a9-4e21-9da0-29ce3dda495d', False, 'natalbany-river-at-baptist-la', 'NATALBANY RIVER AT BAPTIST LA', null, ST_GeomFromText('POINT(-90.5458 30.5042)',4326), null, null, '2021-03-12T16:10:12.958696Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07376500'),
-('00fb2016-699b-45cf-a44f-2944e0860c26', False, 'tangipahoa-river-at-robert-la', 'TANGIPAHOA RIVER AT ROBERT LA', null, ST_GeomFromText('POINT(-90.3617 30.5064)',4326), null, null, '2021-03-12T16:10:12.958900Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07375500'),
-('df1cf782-4230-4f79-8437-4e361ee67dfc', False, '85420', '85420', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.959049Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('f0658ffe-4b1f-4b6c-b17b-7b8cc43ad302', False, 'tchefuncte-river-near-folsom-la', 'TCHEFUNCTE RIVER NEAR FOLSOM LA', null, ST_GeomFromText('POINT(-90.2486 30.6158)',4326), null, null, '2021-03-12T16:10:12.959245Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07375000'),
-('581ba9dc-3836-4fea-9598-fc0d9255da47', False, '85555', '85555', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.959449Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('9ce6e98b-a26b-450e-b859-41bdc05bab06', False, '85575', '85575', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.959650Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('1cc92566-d593-4c0f-abd5-fd39aabf9fb1', False, '85625', '85625', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.960032Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('5c3f1f19-1639-477f-81e0-8edf1b85bda7', False, '85627', '85627', null, ST_GeomFromText('POINT(-90.0742 30.0308)',4326), null, null, '2021-03-12T16:10:12.960348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('9a60c8bd-475f-44a9-8e14-7a0c794f0c1c', False, '85626', '85626', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.960348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('449c9725-605c-4d0d-a0c6-8e434054b26d', False, '85631', '85631', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.961250Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('c37feb2a-559b-4f6d-8b3c-75230e5adeae', False, '85630', '85630', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.961250Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('a70a73d7-47fa-4f92-9b00-6bf9f02c52df', False, '85633', '85633', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.961584Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('3416688d-bd11-4ac3-86c7-f75f2d311498', False, '85632', '85632', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.961584Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('d56068c3-a3c4-4553-b30a-a885570cd7d8', False, '85634', '85634', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.961964Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('6fb38d2f-9428-43ef-a45f-d9dc90c7bedc', False, '85636', '85636', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.962150Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('5d870e7d-cf49-4b9a-8352-10a75948e282', False, '85635', '85635', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.962150Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('572ce69b-8b32-40ab-ac00-e783dc6ceb4f', False, '85637', '85637', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.963175Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('252a79a9-2538-4016-a9bd-c5edcfb701b8', False, '85638', '85638', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.963175Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('bcfb5c23-300d-4d78-b8b7-f7cf40bfbd09', False, '85639', '85639', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.963531Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('0d2b2c4a-e8ab-464d-aa5e-5d4fb3145dc5', False, '85641', '85641', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.963904Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('d0803aa7-bbee-48ed-b23f-7dea74b2ac59', False, '85653', '85653', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.964324Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('40f1872b-b305-44ab-bc8d-06351fd29d3a', False, '85652', '85652', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.964324Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('5e19aa7a-1611-49e9-bec3-75c3421c7b5e', False, '85655', '85655', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.965240Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('e7665d40-d030-4b35-b91a-741546df5fb0', False, '85654', '85654', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.965240Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('e1993ab5-ec08-4e07-bc3c-c4db92731d1c', False, '85657', '85657', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.965538Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('4429e603-e648-4a97-a2ce-42d1f94347fd', False, '85656', '85656', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.965538Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('2db45595-fefc-4eb8-9237-8fc72561b47e', False, '85659', '85659', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.965946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('19c0ac2f-09ab-4c68-b509-71d8a3c07a99', False, '85658', '85658', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.965946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('ec40c087-d75b-4580-9ee5-337eeff32f95', False, '85660', '85660', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.966295Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('4009c409-974c-46f8-86af-992ee43d5ac7', False, '85661', '85661', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.966295Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('f3b99e3b-1856-4226-a73c-1c46b200fb66', False, '85667', '85667', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.966596Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('f58051b1-180a-4c5d-a602-e4dbaaac3367', False, '85666', '85666', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.966596Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('72d33561-aaaa-4e90-91b4-eb73eaeaaac6', False, '85670', '85670', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.966959Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('6b78716c-4975-46e4-b635-9ff79e7f0627', False, '85700', '85700', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.967531Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('a88ece8d-d3ba-4a77-a413-5dd03ebfb623', False, '85750', '85750', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.967792Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('f6629ae2-98e2-4e06-b685-331189cff3a7', False, '85765', '85765', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.968003Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('eb4969b9-30ae-45ea-ad68-d19948a84020', False, '85760', '85760', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.968003Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('59db968a-43d1-4590-b4f7-6f7f6423e735', False, '85780', '85780', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.968359Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('ac71a2f1-74bb-49de-8dd4-d2b15b974978', False, '88450', '88450', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.968467Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('ae73a827-b0b9-4fa6-83fd-fa7d78e22f5c', False, 'mouth-of-atchafalaya-river-at-atchafalaya-bay', 'MOUTH OF ATCHAFALAYA RIVER AT ATCHAFALAYA BAY', null, ST_GeomFromText('POINT(-91.3339 29.4303)',4326), null, null, '2021-03-12T16:10:12.968642Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381654'),
-('4916c4af-8019-4492-a734-fc3ff8c49074', False, '88800', '88800', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.968781Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('5dfc826b-a94c-4cee-b057-6fb858afc95d', False, 'acme_7350', 'ACME', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.968932Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('7e772c75-64b6-485e-8294-d1d3bc6da0fe', False, 'arkcity', 'ARKCITY', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.969013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('c4c87cf8-a155-4abe-bf89-4f7af607db31', False, 'bayou-des-glaises-diversion-ch-at-moreauville-la', 'BAYOU DES GLAISES DIVERSION CH. AT MOREAUVILLE LA', null, ST_GeomFromText('POINT(-91.9825 31.0331)',4326), null, null, '2021-03-12T16:10:12.969105Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07383500'),
-('3fcc1834-0263-4dc5-a7c8-9157c767bb4f', False, 'cairo_ca30', 'CAIRO', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.969820Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('6b5d1a5d-0861-45a1-b56c-ec37c501e993', False, 'dupage-county-airport-near-st-charles-il', 'DUPAGE COUNTY AIRPORT NEAR ST CHARLES IL', null, ST_GeomFromText('POINT(-88.2517 41.9158)',4326), null, null, '2021-03-12T16:10:12.969954Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07375175'),
-('6890212a-c7f1-499e-a3fe-e7d973eb7cc1', False, 'gndl1', 'GNDL1', null, ST_GeomFromText('POINT(-91.4456 29.8925)',4326), null, null, '2021-03-12T16:10:12.970341Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '073815450'),
-('bb1389a0-bfad-430d-a66d-2f041a738cb1', False, 'green', 'GREEN', null, ST_GeomFromText('POINT(-91.1606 33.2925)',4326), null, null, '2021-03-12T16:10:12.970452Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07265455'),
-('38ea9864-0181-475e-a8c7-b34edbf31b6b', False, 'big-shoe-heel-creek-nr-laurinburg-nc', 'BIG SHOE HEEL CREEK NR LAURINBURG NC', null, ST_GeomFromText('POINT(-79.3867 34.7506)',4326), null, null, '2021-03-12T16:10:12.970593Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '073816202'),
-('b417e9f4-ce46-47a7-8bd2-0b26506395e4', False, 'tangipahoa-river-near-kentwood-la', 'TANGIPAHOA RIVER NEAR KENTWOOD LA', null, ST_GeomFromText('POINT(-90.4903 30.9375)',4326), null, null, '2021-03-12T16:10:12.971045Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07375300'),
-('04e220cf-128b-4115-8a7b-76ac2625a81b', False, 'lower-atchafalaya-river-at-morgan-city-la', 'LOWER ATCHAFALAYA RIVER AT MORGAN CITY LA', null, ST_GeomFromText('POINT(-91.2118 29.6926)',4326), null, null, '2021-03-12T16:10:12.971160Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381600'),
-('7a6b4f10-c1d7-4301-89f5-cbadcc1e255e', False, 'natch', 'NATCH', null, ST_GeomFromText('POINT(-91.4186 31.5603)',4326), null, null, '2021-03-12T16:10:12.971257Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07290880'),
-('892ca8ff-08f2-4e1b-af40-32a77baab497', False, 'rio-humacao-at-las-piedras-pr', 'RIO HUMACAO AT LAS PIEDRAS PR', null, ST_GeomFromText('POINT(-65.8694 18.1741)',4326), null, null, '2021-03-12T16:10:12.971452Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07376420'),
-('8250ac38-3d9f-4039-9f08-149912301977', False, 'atchafalaya-river-at-simmesport-la', 'ATCHAFALAYA RIVER AT SIMMESPORT LA', null, ST_GeomFromText('POINT(-91.7983 30.9825)',4326), null, null, '2021-03-12T16:10:12.971601Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381490'),
-('9dcf334f-2982-49c9-941c-38c91565f019', False, 'vicks', 'VICKS', null, ST_GeomFromText('POINT(-90.9058 32.315)',4326), null, null, '2021-03-12T16:10:12.971709Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07289000'),
-('df1b2707-3dea-4b91-be0d-2f64f21544e2', False, '40700', '40700', null, ST_GeomFromText('POINT(-91.7389 30.7258)',4326), null, null, '2021-03-12T16:10:12.971900Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('fcf546da-e8c6-471b-b894-98a691c71251', False, '01440', '01440', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.971970Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('1aafd0d7-eca1-4237-8d5c-6bcf24b31bbf', False, '01080', '01080', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.972095Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('3eba9840-cebb-45ee-8e9f-a62d8279c86a', False, '01120', '01120', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.972249Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('bc7d2d9a-8e02-4a78-b838-cadd444ed756', False, '01143', '01143', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.972384Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('3a43504e-d72c-43e0-9849-d67e016fbc7a', False, '01142', '01142', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.972384Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('3a4dc536-bbfb-4891-927f-c5cb4e2bb3e5', False, '01145', '01145', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.972880Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('5171ef3b-9ef9-4984-8ab7-57b76db13621', False, '01160', '01160', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.973019Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('d02cd42c-3cf0-4b41-a670-1d35708b97cd', False, '52415', '52415', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.973019Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('7c317f0e-f87f-482e-9776-e6c8ee96170a', False, '52417', '52417', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.973019Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('b72006fc-5809-4985-8f3c-45baed7cfcb9', False, '01220', '01220', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.973681Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('4811e8d0-4c72-4c86-8bec-37e491f5ab63', False, '01260', '01260', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.973802Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('3f9a0fcf-4e4f-4b0c-ac88-011455d6957f', False, '01275', '01275', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.973944Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('553f696a-9292-41b1-a4bf-67236068a1d6', False, '01280', '01280', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974052Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('394d6441-43b2-4745-b97d-c6462c7bc908', False, '01300', '01300', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974176Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('2711323d-5ed2-44b5-a7a6-f0614c0b422b', False, '01320', '01320', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('b04e2a92-11d5-416a-a50f-d91a161456bd', False, '76205', '76205', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('3838e35a-efaa-493a-bd48-52561971ce52', False, '76200', '76200', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('3bd76812-033f-4367-b8c4-eceba262f393', False, '01340', '01340', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('0eb3be0e-dd34-4e25-bf96-c5443b2cb400', False, '76160', '76160', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('89cdff86-ab37-4149-aa95-5f6e43454b39', False, '76165', '76165', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('f8d6ccd6-fb48-4228-b777-e423612b1f27', False, '01380', '01380', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974947Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('cf64b9de-4b12-462e-955c-669ca84e2716', False, '76240', '76240', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974947Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('6c4ee0c2-6972-4e69-9555-ff05f155ad5d', False, '76245', '76245', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974947Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('7babc4e1-950e-41e8-874f-49559dd947bf', False, '01390', '01390', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975208Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('ab7ee39b-8397-484a-ac29-e2e813ed6adb', False, '01400', '01400', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975345Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('d93718c4-08ae-4065-8855-674c5206c1ed', False, '01480', '01480', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975574Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('de20f637-d3c7-4ea4-8ca6-604f6a127532', False, '01515', '01515', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975677Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('8a7739c0-ccae-4f3b-83d7-e2f6f506e6da', False, '01516', '01516', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975800Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('2006cc18-de31-4ae0-8aaa-81ba6cf1ba95', False, '01545', '01545', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975863Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('e29fcc6e-a0a8-4fe0-b450-099142997846', False, '01575', '01575', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975954Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('7a2db3f0-aea2-4f91-b6ec-23aa4d2a70d7', False, '01670', '01670', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976045Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('bcc35574-bf23-4952-9760-049ba8843bd7', False, '01850', '01850', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976167Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('4bbb3d28-b816-4f71-98c1-de647d8f5e38', False, '02050', '02050', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976293Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('e0e57af0-7523-4bb1-b327-eff3dca3e508', False, '02100', '02100', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976293Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('b3524459-4cbe-4802-835e-b415cc6657b2', False, '02200', '02200', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976487Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('5d20b46f-1fbe-48c2-875e-1894f4aeb348', False, '02210', '02210', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976487Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('ed2c1d1c-7bb2-4374-8e90-878ab6b70d9c', False, 'old-river-outflow-channel-below-hydropower-channel', 'OLD RIVER OUTFLOW CHANNEL BELOW HYDROPOWER CHANNEL', null, ST_GeomFromText('POINT(-91.6483 31.0667)',4326), null, null, '2021-03-12T16:10:12.976771Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381482'),
-('eaa406e4-560c-4fa3-9c28-8f265999f17b', False, '03045', '03045', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976965Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('bd3d7701-94b0-4390-b2f8-40e03c48508c', False, '03060', '03060', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977098Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('8e5ed58a-febf-4b91-86e3-4b39d798a592', False, '03075', '03075', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977321Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('33564f35-da99-4db5-9d9d-2c96698f99e6', False, 'atchafalaya-river-at-butte-la-rose-la', 'ATCHAFALAYA RIVER AT BUTTE LA ROSE LA', null, ST_GeomFromText('POINT(-91.6867 30.2814)',4326), null, null, '2021-03-12T16:10:12.977451Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381515'),
-('228d8881-b162-4842-92c9-75422535a5ad', False, '03210', '03210', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977561Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('8f32236d-1e18-47f4-bf36-383eb8c82fbe', False, '03240', '03240', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977655Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('dccf96c3-4dff-4b3b-a7b7-446a97db654b', False, '03315', '03315', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977749Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('8527c379-3f95-4ef6-aae7-4fcf94ad8691', False, '03465', '03465', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977842Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('8bd798a4-1c7b-4684-ae3b-eb75a62a09cf', False, '03550', '03550', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977950Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('5fbab803-eb25-480c-9d30-1064b32d9f87', False, '64400', '64400', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977950Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('2eed3135-b0ee-423d-b8a6-5336072ac52a', False, '03615', '03615', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.978175Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('3277950f-fca8-4bcd-8799-64570ea99bd6', False, '03645', '03645', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.978278Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('9bda847a-a23e-430c-bbbe-27da4e67d463', False, 'wax-lake-outlet-at-calumet-la', 'WAX LAKE OUTLET AT CALUMET LA', null, ST_GeomFromText('POINT(-91.3728 29.6978)',4326), null, null, '2021-03-12T16:10:12.978431Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381590'),
-('9b19c51c-b11f-4d74-9eab-2228dc25508b', False, '03750', '03750', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.978545Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('8965cb62-506a-4f0e-b285-48fe8ddfe35e', False, '03780', '03780', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.978723Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('2432c199-8996-4ae7-8a30-50c52c9f746e', False, 'thompson-creek-at-chesterfield-sc', 'THOMPSON CREEK AT CHESTERFIELD SC', null, ST_GeomFromText('POINT(-80.0641 34.7286)',4326), null, null, '2021-03-12T16:10:12.978940Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '02130450'),
-('93960641-3b74-456c-8fcf-7aadbf48c6d8', False, '03830', '03830', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.979092Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('1e6a3e9c-3b71-4256-a4b7-75a9615ba8b1', False, '07374525', '07374525', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.979230Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('d56eb333-2749-4797-b5c8-f282ecf8fd4a', False, 'northeast-bay-gardene-near-point-a-la-hache-la', 'NORTHEAST BAY GARDENE NEAR POINT-A-LA-HACHE LA', null, ST_GeomFromText('POINT(-89.606 29.5857)',4326), null, null, '2021-03-12T16:10:12.979362Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07374527'),
-('3ba8a61e-8920-46d2-9831-8cd0713508ef', False, 'bartlett-wwtf-near-bartlett-il', 'BARTLETT WWTF NEAR BARTLETT IL', null, ST_GeomFromText('POINT(-88.1658 41.9669)',4326), null, null, '2021-03-12T16:10:12.979509Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '415801088095700'),
-('7e52d2f4-c471-400e-9f1f-8d2f50b4d6e4', False, 'tangipahoa-river-near-amite-la', 'TANGIPAHOA RIVER NEAR AMITE LA', null, ST_GeomFromText('POINT(-90.4842 30.7289)',4326), null, null, '2021-03-12T16:10:12.979659Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07375430'),
-('b72a87bd-94c0-48af-b773-d9bdc5419859', False, 'tickfaw-river-at-liverpool-la', 'TICKFAW RIVER AT LIVERPOOL LA', null, ST_GeomFromText('POINT(-90.6733 30.9306)',4326), null, null, '2021-03-12T16:10:12.979783Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07375800'),
-('69d3de52-e320-46e4-8c5b-f29cb8cbb4bb', False, 'barataria-bay-n-of-grand-isle-la', 'BARATARIA BAY N OF GRAND ISLE LA', null, ST_GeomFromText('POINT(-89.9506 29.4225)',4326), null, null, '2021-03-12T16:10:12.979904Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07380251'),
-('b4cd105a-dd1c-485f-bbdb-b80269084676', False, 'caillou-lake-sister-lake-sw-of-dulac-la', 'CAILLOU LAKE (SISTER LAKE) SW OF DULAC LA', null, ST_GeomFromText('POINT(-90.9211 29.2492)',4326), null, null, '2021-03-12T16:10:12.980056Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381349'),
-('d4682aed-6cd6-498c-a610-3d565b03c971', False, 'vermilion-bay-near-cypremort-point-la', 'VERMILION BAY NEAR CYPREMORT POINT LA', null, ST_GeomFromText('POINT(-91.8803 29.7131)',4326), null, null, '2021-03-12T16:10:12.980177Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07387040'),
-('017d8b2c-2234-412e-a88c-d5949ce2fd02', False, 'mississippi-sound-near-grand-pass', 'MISSISSIPPI SOUND NEAR GRAND PASS', null, ST_GeomFromText('POINT(-89.2503 30.1228)',4326), null, null, '2021-03-12T16:10:12.980297Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '300722089150100'),
-('333d17db-11e8-47b9-8621-af2fccce7190', False, '43500', '43500', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.980428Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('53e8a131-3739-42d5-9189-fd5aec548a56', False, '40900', '40900', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.980428Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('1cff71c6-56ec-4baa-8524-ddcc2b292f25', False, '49235', '49235', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.980750Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381567'),
-('afda57df-9971-4d0d-8d7f-7092d94d1791', False, '49255', '49255', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.980833Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('7b94b33a-0922-42ab-9928-2d4dfe40aa22', False, '49355', '49355', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.980931Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('d2beb71a-5aeb-4ba3-ae0e-346e81943d97', False, '49365', '49365', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981027Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('704bded7-49c2-43d6-9c4f-9b6b1512b9f1', False, '49400', '49400', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981153Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('707c1f3f-cf12-4768-b826-419478701450', False, '49415', '49415', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981246Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('60a37953-139e-4d66-b000-06f5b16ece51', False, '49542', '49542', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981376Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('908dcb66-f39e-415a-af5f-fa8272a67a42', False, '49570', '49570', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981473Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('5478ea0d-9117-48fb-9694-02515d516579', False, '49615', '49615', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981598Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('59062009-9c5c-413e-9f0a-077fe4d1a015', False, 'mctier-creek-rd-209-near-monetta-sc', 'MCTIER CREEK (RD 209) NEAR MONETTA SC', null, ST_GeomFromText('POINT(-81.6019 33.7533)',4326), null, null, '2021-03-12T16:10:12.981748Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '02172300'),
-('a4b91146-0108-4d0d-8cba-21e9cc6281eb', False, '49725', '49725', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981950Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('4f556761-b926-4086-bdcd-19bcc311ff1e', False, '52280', '52280', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982052Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('4369132f-f2b1-4161-a857-95e6bcc2de59', False, '52330', '52330', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982150Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('76862e2d-87cb-4605-804b-e4d9cb4ce00c', False, '52390', '52390', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982277Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('d4198ec8-52ae-4296-a5ce-3763c3165d79', False, '52441', '52441', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982375Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('68eaa243-1fe5-4615-9d87-eb0779971a30', False, '52560', '52560', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982471Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('ba4e3eea-f71b-4116-883e-0ab07fada8e4', False, '49630', '49630', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982471Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('b3087995-8550-4b0f-a201-dbbf1e52698b', False, '52750', '52750', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982977Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('47c17779-6fd6-4620-a176-4112878f687a', False, '52800', '52800', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983098Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('081d900a-ef53-442b-940f-d7cee74a26d8', False, '52840', '52840', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983197Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('514648d9-4d3b-4bc4-a57e-edd091b9f3ce', False, '52880', '52880', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983292Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('15101a32-a069-4b0b-a29b-f86a409053b3', False, '52900', '52900', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983387Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('ce4e2f40-708a-434e-9d71-2b441ce8611b', False, '58050', '58050', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983452Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('2725f343-d52f-43b2-b41d-0f9f671aa84e', False, '58350', '58350', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983546Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('c0b1ecd2-af0a-450c-8a51-f7b44130d612', False, 'coe-lake-pontchartrain-at-frenier-la', '(COE) LAKE PONTCHARTRAIN AT FRENIER LA', null, ST_GeomFromText('POINT(-90.4214 30.1061)',4326), null, null, '2021-03-12T16:10:12.983687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '073802305'),
-('94e20e3a-7c6b-4eae-ac1c-fea8a22e4662', False, '58600', '58600', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983832Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('615d5750-5d23-4dfc-98d1-5fa69b3fc8ca', False, '58700', '58700', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983903Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('f8f8fd49-cdf8-47ec-bfaa-a406ccbb12c2', False, '61720', '61720', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.984001Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('e0806b46-1b66-460e-97d5-0c98bff2edd9', False, '61760', '61760', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.984097Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('b7425815-d58a-4422-9907-e115f41c77dd', False, '64050', '64050', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.984193Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('6207bc92-5cc7-46ed-a93f-1ff32121a68a', False, 'charenton-drainage-canal-at-baldwin-la', 'CHARENTON DRAINAGE CANAL AT BALDWIN LA', null, ST_GeomFromText('POINT(-91.5417 29.8231)',4326), null, null, '2021-03-12T16:10:12.984329Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07385790'),
-('5f0e95fe-84b1-4dee-9584-408d2b79b4e1', False, 'bayou-teche-w-of-calumet-flood-gate-at-calumet-la', 'BAYOU TECHE W OF CALUMET FLOOD GATE AT CALUMET LA', null, ST_GeomFromText('POINT(-91.3728 29.7039)',4326), null, null, '2021-03-12T16:10:12.984463Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07385820'),
-('34d6ecd5-a584-4de1-90a6-dacc386988bd', False, 'bayou-teche-e-of-calumet-flood-gate-at-calumet-la', 'BAYOU TECHE E OF CALUMET FLOOD GATE AT CALUMET LA', null, ST_GeomFromText('POINT(-91.3739 29.7042)',4326), null, null, '2021-03-12T16:10:12.984668Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '073815945'),
-('5f4a3ce2-f359-4f7b-b622-9db903ca8f30', False, '70600', '70600', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.984836Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('d0c6b11b-44a4-4041-9cd7-1ff725f042a4', False, '70675', '70675', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.984937Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('26ff06dc-5aa1-4928-9595-9fd5713dcf02', False, '70750', '70750', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.984937Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('9c4eec28-485c-405f-93fe-a1671ba1e85e', False, '70900', '70900', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.985270Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('2a0651b4-b2fa-4e91-a4f6-1412b6ce7893', False, '73472', '73472', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.985381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('77576b15-85c2-42ca-82b8-98cbc8244122', False, '73473', '73473', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.985381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
-('c8197081-957a-451d-ab4d-41805e0ecf5f', False, '73550', '73550', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.986013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null);
+('15dacad3-a7e5-4378-91b4-34208e7039cb', False, '73600', '73600', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.948076Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('35896e62-b69f-4bc9-9fc6-78ea7c5fa92a', False, '73650', '73650', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.948289Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('bbeff60a-9198-4956-9ef2-021fc851cdbd', False, 'barataria-pass-at-grand-isle-la', 'BARATARIA PASS AT GRAND ISLE LA', ST_GeomFromText('POINT(-89.9468 29.2728)',4326), null, null, '2021-03-12T16:10:12.948450Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '073802516'),
+('64d4fe14-952c-4f0a-b256-1ae56e687fd2', False, '76010', '76010', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.948600Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('9e13d62c-b9e4-49eb-aee5-59b4d1bbd46c', False, '76005', '76005', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.948600Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('d469455f-6ce9-4faf-b2fc-c321f3365a6f', False, '76025', '76025', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.949259Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('b44d2de5-fa95-46fd-9254-93078405d35d', False, '76024', '76024', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.949259Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('41e9219e-2f1f-439f-a724-a3e5c7c7cf73', False, 'giww-east-storm-surge-barrier-at-new-orleans-la', 'GIWW EAST STORM SURGE BARRIER AT NEW ORLEANS LA', ST_GeomFromText('POINT(-89.9019 30.0144)',4326), null, null, '2021-03-12T16:10:12.950027Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '073802339'),
+('6ee253df-10ae-493c-b307-de8e85464c07', False, '76032', '76032', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.950027Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('72ca06c0-2710-4135-be3a-bf93d8c51dfd', False, '76030', '76030', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.950027Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('95b436bc-842a-4ad8-83e7-2410a56c439c', False, '76040', '76040', ST_GeomFromText('POINT(-89.9374 30.0067)',4326), null, null, '2021-03-12T16:10:12.950279Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('016d2287-c4a8-4544-9588-dfb961726c26', False, '76060', '76060', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.950439Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('3f1daace-2b8e-4d6c-a21c-1a23359ae00b', False, '76065', '76065', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.950616Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('a757599f-b2e5-4fbe-b40d-8522c3b04af0', False, '76062', '76062', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.950616Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('38fa06c8-6457-4523-b4ef-3ebffa520151', False, '76220', '76220', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.951160Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('b9e94249-d8e9-41ac-b683-bde39fa79ed4', False, 'coe-freshwater-c-at-freshwater-b-lock-south', '(COE) FRESHWATER C. AT FRESHWATER B. LOCK (SOUTH)', ST_GeomFromText('POINT(-92.1956 29.7833)',4326), null, null, '2021-03-12T16:10:12.951444Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07389700'),
+('616fa910-eabd-4718-940f-c7ec2afeffd8', False, '76260', '76260', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.951621Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('c9e023a7-9cd7-4443-9661-c0f629bc6c8a', False, '76265', '76265', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.951621Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('6d781aa1-93e5-4aa5-85fe-9d057ead4247', False, '76305', '76305', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.952221Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('4adb6c37-4ae1-43e1-9540-e5595bf86d1b', False, '76320', '76320', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.952424Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('67cf3e31-f3de-4413-930d-1cf4903c454e', False, '76360', '76360', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.952571Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('eafbba9f-6875-462c-a8ec-99e224c37765', False, '76362', '76362', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.952571Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('0b4fb382-4721-4c93-8c92-eb47812e0bac', False, '76400', '76400', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.952571Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('bacf256e-eb96-4c36-8954-bd92a306f4d8', False, '76480', '76480', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.952872Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('5524e00c-1f89-418d-9740-6de8645b8333', False, 'giww-at-bayou-sale-ridge-near-franklin-la', 'GIWW AT BAYOU SALE RIDGE NEAR FRANKLIN LA', ST_GeomFromText('POINT(-91.4706 29.6808)',4326), null, null, '2021-03-12T16:10:12.953035Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381670'),
+('d7281dd7-778d-4c3a-90a4-b21bb641e850', False, '76592', '76592', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.953219Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('17968170-2ff2-4cd8-9809-818f88732879', False, '76595', '76595', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.953219Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('cabcd7a9-db9e-415e-ba84-cbe5f1f5c768', False, '76593', '76593', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.953219Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('f6e8916f-296a-49e9-aca1-82665748ca62', False, '76680', '76680', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.953537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('ee622c1e-70e8-4620-8f61-2261c0ed13ac', False, '76600', '76600', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.953537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('70ba9edc-40ea-42ad-a8a3-ceabb25b05e2', False, '76720', '76720', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954216Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('b226f922-c336-4929-8bb1-9e9ddf4b59e6', False, '76724', '76724', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954216Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('7c82c421-5294-40a7-87ff-7af6e42a18a5', False, '76800', '76800', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954216Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('694b13bf-449a-4c44-8aa2-c38469c07ed5', False, '76880', '76880', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954530Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('f15dbad6-ccb7-4a72-9298-5cd33a8b4797', False, '76884', '76884', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954530Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('c42b64ce-0460-41f3-8bbf-86a8da1d4c00', False, '76960', '76960', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954530Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('a392c3e8-738d-4feb-afc1-00d064e4298e', False, '82250', '82250', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('e0ecd779-e73c-47f4-a906-55c8002dcba7', False, '82260', '82260', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.954845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('8add8896-c24d-44b3-9853-c189d2d83695', False, '82700', '82700', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.955289Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('1592e55f-ff35-40a9-80c4-7c81e4f620b3', False, '82718', '82718', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.955475Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('4d62ac6e-35b9-41cd-80de-7468d2bad87c', False, '82715', '82715', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.955475Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('3604b451-24dc-423f-9e8a-f0bbf9d7d8f2', False, '82720', '82720', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.955929Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('9d3ea29e-59a3-46dd-a091-d8fed56f03ec', False, '82725', '82725', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.956037Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('4c7ce601-f232-4634-936c-d7604eb54e3b', False, '82740', '82740', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.956209Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('e213714d-2656-4719-84f5-61e208032038', False, '82745', '82745', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.956353Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('965c672f-d3d2-41a9-a537-7626ee1da6d1', False, '82742', '82742', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.956353Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('6bdca601-8044-4830-85e5-7f34dd5d5865', False, '82760', '82760', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.957042Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('c7e40f37-2390-404f-82fe-c9e7496b8801', False, '82762', '82762', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.957042Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('75e2eee7-51ce-4c21-b0fc-e039c9ef38d2', False, '82770', '82770', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.957437Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('59bc5f1d-63a4-455c-80d0-fa6ad8f641fc', False, '82772', '82772', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.957437Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('8091654d-2afd-46a6-a2a7-26447b8c0df4', False, '82875', '82875', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.957812Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('2cce0e9d-c608-4424-a8f8-0f76e0691338', False, 'amite-river-near-denham-springs-la', 'AMITE RIVER NEAR DENHAM SPRINGS LA', ST_GeomFromText('POINT(-90.9903 30.4639)',4326), null, null, '2021-03-12T16:10:12.958013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07378500'),
+('82d2991c-71db-4699-9e7f-5506f9614d58', False, '85300', '85300', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.958172Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('21bdce69-d2a7-49f0-807b-d29c747f2802', False, 'tickfaw-river-at-holden-la', 'TICKFAW RIVER AT HOLDEN LA', ST_GeomFromText('POINT(-90.6772 30.5036)',4326), null, null, '2021-03-12T16:10:12.958428Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07376000'),
+('28bc2a6e-72a9-4e21-9da0-29ce3dda495d', False, 'natalbany-river-at-baptist-la', 'NATALBANY RIVER AT BAPTIST LA', ST_GeomFromText('POINT(-90.5458 30.5042)',4326), null, null, '2021-03-12T16:10:12.958696Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07376500'),
+('00fb2016-699b-45cf-a44f-2944e0860c26', False, 'tangipahoa-river-at-robert-la', 'TANGIPAHOA RIVER AT ROBERT LA', ST_GeomFromText('POINT(-90.3617 30.5064)',4326), null, null, '2021-03-12T16:10:12.958900Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07375500'),
+('df1cf782-4230-4f79-8437-4e361ee67dfc', False, '85420', '85420', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.959049Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('f0658ffe-4b1f-4b6c-b17b-7b8cc43ad302', False, 'tchefuncte-river-near-folsom-la', 'TCHEFUNCTE RIVER NEAR FOLSOM LA', ST_GeomFromText('POINT(-90.2486 30.6158)',4326), null, null, '2021-03-12T16:10:12.959245Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07375000'),
+('581ba9dc-3836-4fea-9598-fc0d9255da47', False, '85555', '85555', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.959449Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('9ce6e98b-a26b-450e-b859-41bdc05bab06', False, '85575', '85575', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.959650Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('1cc92566-d593-4c0f-abd5-fd39aabf9fb1', False, '85625', '85625', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.960032Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('5c3f1f19-1639-477f-81e0-8edf1b85bda7', False, '85627', '85627', ST_GeomFromText('POINT(-90.0742 30.0308)',4326), null, null, '2021-03-12T16:10:12.960348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('9a60c8bd-475f-44a9-8e14-7a0c794f0c1c', False, '85626', '85626', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.960348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('449c9725-605c-4d0d-a0c6-8e434054b26d', False, '85631', '85631', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.961250Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('c37feb2a-559b-4f6d-8b3c-75230e5adeae', False, '85630', '85630', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.961250Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('a70a73d7-47fa-4f92-9b00-6bf9f02c52df', False, '85633', '85633', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.961584Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('3416688d-bd11-4ac3-86c7-f75f2d311498', False, '85632', '85632', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.961584Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('d56068c3-a3c4-4553-b30a-a885570cd7d8', False, '85634', '85634', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.961964Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('6fb38d2f-9428-43ef-a45f-d9dc90c7bedc', False, '85636', '85636', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.962150Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('5d870e7d-cf49-4b9a-8352-10a75948e282', False, '85635', '85635', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.962150Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('572ce69b-8b32-40ab-ac00-e783dc6ceb4f', False, '85637', '85637', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.963175Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('252a79a9-2538-4016-a9bd-c5edcfb701b8', False, '85638', '85638', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.963175Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('bcfb5c23-300d-4d78-b8b7-f7cf40bfbd09', False, '85639', '85639', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.963531Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('0d2b2c4a-e8ab-464d-aa5e-5d4fb3145dc5', False, '85641', '85641', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.963904Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('d0803aa7-bbee-48ed-b23f-7dea74b2ac59', False, '85653', '85653', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.964324Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('40f1872b-b305-44ab-bc8d-06351fd29d3a', False, '85652', '85652', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.964324Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('5e19aa7a-1611-49e9-bec3-75c3421c7b5e', False, '85655', '85655', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.965240Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('e7665d40-d030-4b35-b91a-741546df5fb0', False, '85654', '85654', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.965240Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('e1993ab5-ec08-4e07-bc3c-c4db92731d1c', False, '85657', '85657', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.965538Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('4429e603-e648-4a97-a2ce-42d1f94347fd', False, '85656', '85656', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.965538Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('2db45595-fefc-4eb8-9237-8fc72561b47e', False, '85659', '85659', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.965946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('19c0ac2f-09ab-4c68-b509-71d8a3c07a99', False, '85658', '85658', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.965946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('ec40c087-d75b-4580-9ee5-337eeff32f95', False, '85660', '85660', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.966295Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('4009c409-974c-46f8-86af-992ee43d5ac7', False, '85661', '85661', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.966295Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('f3b99e3b-1856-4226-a73c-1c46b200fb66', False, '85667', '85667', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.966596Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('f58051b1-180a-4c5d-a602-e4dbaaac3367', False, '85666', '85666', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.966596Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('72d33561-aaaa-4e90-91b4-eb73eaeaaac6', False, '85670', '85670', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.966959Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('6b78716c-4975-46e4-b635-9ff79e7f0627', False, '85700', '85700', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.967531Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('a88ece8d-d3ba-4a77-a413-5dd03ebfb623', False, '85750', '85750', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.967792Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('f6629ae2-98e2-4e06-b685-331189cff3a7', False, '85765', '85765', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.968003Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('eb4969b9-30ae-45ea-ad68-d19948a84020', False, '85760', '85760', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.968003Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('59db968a-43d1-4590-b4f7-6f7f6423e735', False, '85780', '85780', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.968359Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('ac71a2f1-74bb-49de-8dd4-d2b15b974978', False, '88450', '88450', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.968467Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('ae73a827-b0b9-4fa6-83fd-fa7d78e22f5c', False, 'mouth-of-atchafalaya-river-at-atchafalaya-bay', 'MOUTH OF ATCHAFALAYA RIVER AT ATCHAFALAYA BAY', ST_GeomFromText('POINT(-91.3339 29.4303)',4326), null, null, '2021-03-12T16:10:12.968642Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381654'),
+('4916c4af-8019-4492-a734-fc3ff8c49074', False, '88800', '88800', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.968781Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('5dfc826b-a94c-4cee-b057-6fb858afc95d', False, 'acme_7350', 'ACME', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.968932Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('7e772c75-64b6-485e-8294-d1d3bc6da0fe', False, 'arkcity', 'ARKCITY', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.969013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('c4c87cf8-a155-4abe-bf89-4f7af607db31', False, 'bayou-des-glaises-diversion-ch-at-moreauville-la', 'BAYOU DES GLAISES DIVERSION CH. AT MOREAUVILLE LA', ST_GeomFromText('POINT(-91.9825 31.0331)',4326), null, null, '2021-03-12T16:10:12.969105Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07383500'),
+('3fcc1834-0263-4dc5-a7c8-9157c767bb4f', False, 'cairo_ca30', 'CAIRO', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.969820Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('6b5d1a5d-0861-45a1-b56c-ec37c501e993', False, 'dupage-county-airport-near-st-charles-il', 'DUPAGE COUNTY AIRPORT NEAR ST CHARLES IL', ST_GeomFromText('POINT(-88.2517 41.9158)',4326), null, null, '2021-03-12T16:10:12.969954Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07375175'),
+('6890212a-c7f1-499e-a3fe-e7d973eb7cc1', False, 'gndl1', 'GNDL1', ST_GeomFromText('POINT(-91.4456 29.8925)',4326), null, null, '2021-03-12T16:10:12.970341Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '073815450'),
+('bb1389a0-bfad-430d-a66d-2f041a738cb1', False, 'green', 'GREEN', ST_GeomFromText('POINT(-91.1606 33.2925)',4326), null, null, '2021-03-12T16:10:12.970452Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07265455'),
+('38ea9864-0181-475e-a8c7-b34edbf31b6b', False, 'big-shoe-heel-creek-nr-laurinburg-nc', 'BIG SHOE HEEL CREEK NR LAURINBURG NC', ST_GeomFromText('POINT(-79.3867 34.7506)',4326), null, null, '2021-03-12T16:10:12.970593Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '073816202'),
+('b417e9f4-ce46-47a7-8bd2-0b26506395e4', False, 'tangipahoa-river-near-kentwood-la', 'TANGIPAHOA RIVER NEAR KENTWOOD LA', ST_GeomFromText('POINT(-90.4903 30.9375)',4326), null, null, '2021-03-12T16:10:12.971045Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07375300'),
+('04e220cf-128b-4115-8a7b-76ac2625a81b', False, 'lower-atchafalaya-river-at-morgan-city-la', 'LOWER ATCHAFALAYA RIVER AT MORGAN CITY LA', ST_GeomFromText('POINT(-91.2118 29.6926)',4326), null, null, '2021-03-12T16:10:12.971160Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381600'),
+('7a6b4f10-c1d7-4301-89f5-cbadcc1e255e', False, 'natch', 'NATCH', ST_GeomFromText('POINT(-91.4186 31.5603)',4326), null, null, '2021-03-12T16:10:12.971257Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07290880'),
+('892ca8ff-08f2-4e1b-af40-32a77baab497', False, 'rio-humacao-at-las-piedras-pr', 'RIO HUMACAO AT LAS PIEDRAS PR', ST_GeomFromText('POINT(-65.8694 18.1741)',4326), null, null, '2021-03-12T16:10:12.971452Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07376420'),
+('8250ac38-3d9f-4039-9f08-149912301977', False, 'atchafalaya-river-at-simmesport-la', 'ATCHAFALAYA RIVER AT SIMMESPORT LA', ST_GeomFromText('POINT(-91.7983 30.9825)',4326), null, null, '2021-03-12T16:10:12.971601Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381490'),
+('9dcf334f-2982-49c9-941c-38c91565f019', False, 'vicks', 'VICKS', ST_GeomFromText('POINT(-90.9058 32.315)',4326), null, null, '2021-03-12T16:10:12.971709Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07289000'),
+('df1b2707-3dea-4b91-be0d-2f64f21544e2', False, '40700', '40700', ST_GeomFromText('POINT(-91.7389 30.7258)',4326), null, null, '2021-03-12T16:10:12.971900Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('fcf546da-e8c6-471b-b894-98a691c71251', False, '01440', '01440', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.971970Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('1aafd0d7-eca1-4237-8d5c-6bcf24b31bbf', False, '01080', '01080', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.972095Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('3eba9840-cebb-45ee-8e9f-a62d8279c86a', False, '01120', '01120', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.972249Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('bc7d2d9a-8e02-4a78-b838-cadd444ed756', False, '01143', '01143', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.972384Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('3a43504e-d72c-43e0-9849-d67e016fbc7a', False, '01142', '01142', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.972384Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('3a4dc536-bbfb-4891-927f-c5cb4e2bb3e5', False, '01145', '01145', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.972880Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('5171ef3b-9ef9-4984-8ab7-57b76db13621', False, '01160', '01160', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.973019Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('d02cd42c-3cf0-4b41-a670-1d35708b97cd', False, '52415', '52415', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.973019Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('7c317f0e-f87f-482e-9776-e6c8ee96170a', False, '52417', '52417', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.973019Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('b72006fc-5809-4985-8f3c-45baed7cfcb9', False, '01220', '01220', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.973681Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('4811e8d0-4c72-4c86-8bec-37e491f5ab63', False, '01260', '01260', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.973802Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('3f9a0fcf-4e4f-4b0c-ac88-011455d6957f', False, '01275', '01275', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.973944Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('553f696a-9292-41b1-a4bf-67236068a1d6', False, '01280', '01280', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974052Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('394d6441-43b2-4745-b97d-c6462c7bc908', False, '01300', '01300', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974176Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('2711323d-5ed2-44b5-a7a6-f0614c0b422b', False, '01320', '01320', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('b04e2a92-11d5-416a-a50f-d91a161456bd', False, '76205', '76205', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('3838e35a-efaa-493a-bd48-52561971ce52', False, '76200', '76200', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('3bd76812-033f-4367-b8c4-eceba262f393', False, '01340', '01340', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('0eb3be0e-dd34-4e25-bf96-c5443b2cb400', False, '76160', '76160', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('89cdff86-ab37-4149-aa95-5f6e43454b39', False, '76165', '76165', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('f8d6ccd6-fb48-4228-b777-e423612b1f27', False, '01380', '01380', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974947Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('cf64b9de-4b12-462e-955c-669ca84e2716', False, '76240', '76240', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974947Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('6c4ee0c2-6972-4e69-9555-ff05f155ad5d', False, '76245', '76245', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.974947Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('7babc4e1-950e-41e8-874f-49559dd947bf', False, '01390', '01390', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975208Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('ab7ee39b-8397-484a-ac29-e2e813ed6adb', False, '01400', '01400', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975345Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('d93718c4-08ae-4065-8855-674c5206c1ed', False, '01480', '01480', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975574Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('de20f637-d3c7-4ea4-8ca6-604f6a127532', False, '01515', '01515', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975677Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('8a7739c0-ccae-4f3b-83d7-e2f6f506e6da', False, '01516', '01516', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975800Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('2006cc18-de31-4ae0-8aaa-81ba6cf1ba95', False, '01545', '01545', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975863Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('e29fcc6e-a0a8-4fe0-b450-099142997846', False, '01575', '01575', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.975954Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('7a2db3f0-aea2-4f91-b6ec-23aa4d2a70d7', False, '01670', '01670', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976045Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('bcc35574-bf23-4952-9760-049ba8843bd7', False, '01850', '01850', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976167Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('4bbb3d28-b816-4f71-98c1-de647d8f5e38', False, '02050', '02050', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976293Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('e0e57af0-7523-4bb1-b327-eff3dca3e508', False, '02100', '02100', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976293Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('b3524459-4cbe-4802-835e-b415cc6657b2', False, '02200', '02200', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976487Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('5d20b46f-1fbe-48c2-875e-1894f4aeb348', False, '02210', '02210', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976487Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('ed2c1d1c-7bb2-4374-8e90-878ab6b70d9c', False, 'old-river-outflow-channel-below-hydropower-channel', 'OLD RIVER OUTFLOW CHANNEL BELOW HYDROPOWER CHANNEL', ST_GeomFromText('POINT(-91.6483 31.0667)',4326), null, null, '2021-03-12T16:10:12.976771Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381482'),
+('eaa406e4-560c-4fa3-9c28-8f265999f17b', False, '03045', '03045', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.976965Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('bd3d7701-94b0-4390-b2f8-40e03c48508c', False, '03060', '03060', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977098Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('8e5ed58a-febf-4b91-86e3-4b39d798a592', False, '03075', '03075', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977321Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('33564f35-da99-4db5-9d9d-2c96698f99e6', False, 'atchafalaya-river-at-butte-la-rose-la', 'ATCHAFALAYA RIVER AT BUTTE LA ROSE LA', ST_GeomFromText('POINT(-91.6867 30.2814)',4326), null, null, '2021-03-12T16:10:12.977451Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381515'),
+('228d8881-b162-4842-92c9-75422535a5ad', False, '03210', '03210', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977561Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('8f32236d-1e18-47f4-bf36-383eb8c82fbe', False, '03240', '03240', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977655Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('dccf96c3-4dff-4b3b-a7b7-446a97db654b', False, '03315', '03315', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977749Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('8527c379-3f95-4ef6-aae7-4fcf94ad8691', False, '03465', '03465', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977842Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('8bd798a4-1c7b-4684-ae3b-eb75a62a09cf', False, '03550', '03550', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977950Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('5fbab803-eb25-480c-9d30-1064b32d9f87', False, '64400', '64400', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.977950Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('2eed3135-b0ee-423d-b8a6-5336072ac52a', False, '03615', '03615', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.978175Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('3277950f-fca8-4bcd-8799-64570ea99bd6', False, '03645', '03645', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.978278Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('9bda847a-a23e-430c-bbbe-27da4e67d463', False, 'wax-lake-outlet-at-calumet-la', 'WAX LAKE OUTLET AT CALUMET LA', ST_GeomFromText('POINT(-91.3728 29.6978)',4326), null, null, '2021-03-12T16:10:12.978431Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381590'),
+('9b19c51c-b11f-4d74-9eab-2228dc25508b', False, '03750', '03750', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.978545Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('8965cb62-506a-4f0e-b285-48fe8ddfe35e', False, '03780', '03780', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.978723Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('2432c199-8996-4ae7-8a30-50c52c9f746e', False, 'thompson-creek-at-chesterfield-sc', 'THOMPSON CREEK AT CHESTERFIELD SC', ST_GeomFromText('POINT(-80.0641 34.7286)',4326), null, null, '2021-03-12T16:10:12.978940Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '02130450'),
+('93960641-3b74-456c-8fcf-7aadbf48c6d8', False, '03830', '03830', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.979092Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('1e6a3e9c-3b71-4256-a4b7-75a9615ba8b1', False, '07374525', '07374525', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.979230Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('d56eb333-2749-4797-b5c8-f282ecf8fd4a', False, 'northeast-bay-gardene-near-point-a-la-hache-la', 'NORTHEAST BAY GARDENE NEAR POINT-A-LA-HACHE LA', ST_GeomFromText('POINT(-89.606 29.5857)',4326), null, null, '2021-03-12T16:10:12.979362Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07374527'),
+('3ba8a61e-8920-46d2-9831-8cd0713508ef', False, 'bartlett-wwtf-near-bartlett-il', 'BARTLETT WWTF NEAR BARTLETT IL', ST_GeomFromText('POINT(-88.1658 41.9669)',4326), null, null, '2021-03-12T16:10:12.979509Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '415801088095700'),
+('7e52d2f4-c471-400e-9f1f-8d2f50b4d6e4', False, 'tangipahoa-river-near-amite-la', 'TANGIPAHOA RIVER NEAR AMITE LA', ST_GeomFromText('POINT(-90.4842 30.7289)',4326), null, null, '2021-03-12T16:10:12.979659Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07375430'),
+('b72a87bd-94c0-48af-b773-d9bdc5419859', False, 'tickfaw-river-at-liverpool-la', 'TICKFAW RIVER AT LIVERPOOL LA', ST_GeomFromText('POINT(-90.6733 30.9306)',4326), null, null, '2021-03-12T16:10:12.979783Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07375800'),
+('69d3de52-e320-46e4-8c5b-f29cb8cbb4bb', False, 'barataria-bay-n-of-grand-isle-la', 'BARATARIA BAY N OF GRAND ISLE LA', ST_GeomFromText('POINT(-89.9506 29.4225)',4326), null, null, '2021-03-12T16:10:12.979904Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07380251'),
+('b4cd105a-dd1c-485f-bbdb-b80269084676', False, 'caillou-lake-sister-lake-sw-of-dulac-la', 'CAILLOU LAKE (SISTER LAKE) SW OF DULAC LA', ST_GeomFromText('POINT(-90.9211 29.2492)',4326), null, null, '2021-03-12T16:10:12.980056Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381349'),
+('d4682aed-6cd6-498c-a610-3d565b03c971', False, 'vermilion-bay-near-cypremort-point-la', 'VERMILION BAY NEAR CYPREMORT POINT LA', ST_GeomFromText('POINT(-91.8803 29.7131)',4326), null, null, '2021-03-12T16:10:12.980177Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07387040'),
+('017d8b2c-2234-412e-a88c-d5949ce2fd02', False, 'mississippi-sound-near-grand-pass', 'MISSISSIPPI SOUND NEAR GRAND PASS', ST_GeomFromText('POINT(-89.2503 30.1228)',4326), null, null, '2021-03-12T16:10:12.980297Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '300722089150100'),
+('333d17db-11e8-47b9-8621-af2fccce7190', False, '43500', '43500', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.980428Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('53e8a131-3739-42d5-9189-fd5aec548a56', False, '40900', '40900', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.980428Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('1cff71c6-56ec-4baa-8524-ddcc2b292f25', False, '49235', '49235', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.980750Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07381567'),
+('afda57df-9971-4d0d-8d7f-7092d94d1791', False, '49255', '49255', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.980833Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('7b94b33a-0922-42ab-9928-2d4dfe40aa22', False, '49355', '49355', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.980931Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('d2beb71a-5aeb-4ba3-ae0e-346e81943d97', False, '49365', '49365', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981027Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('704bded7-49c2-43d6-9c4f-9b6b1512b9f1', False, '49400', '49400', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981153Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('707c1f3f-cf12-4768-b826-419478701450', False, '49415', '49415', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981246Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('60a37953-139e-4d66-b000-06f5b16ece51', False, '49542', '49542', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981376Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('908dcb66-f39e-415a-af5f-fa8272a67a42', False, '49570', '49570', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981473Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('5478ea0d-9117-48fb-9694-02515d516579', False, '49615', '49615', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981598Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('59062009-9c5c-413e-9f0a-077fe4d1a015', False, 'mctier-creek-rd-209-near-monetta-sc', 'MCTIER CREEK (RD 209) NEAR MONETTA SC', ST_GeomFromText('POINT(-81.6019 33.7533)',4326), null, null, '2021-03-12T16:10:12.981748Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '02172300'),
+('a4b91146-0108-4d0d-8cba-21e9cc6281eb', False, '49725', '49725', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.981950Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('4f556761-b926-4086-bdcd-19bcc311ff1e', False, '52280', '52280', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982052Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('4369132f-f2b1-4161-a857-95e6bcc2de59', False, '52330', '52330', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982150Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('76862e2d-87cb-4605-804b-e4d9cb4ce00c', False, '52390', '52390', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982277Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('d4198ec8-52ae-4296-a5ce-3763c3165d79', False, '52441', '52441', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982375Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('68eaa243-1fe5-4615-9d87-eb0779971a30', False, '52560', '52560', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982471Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('ba4e3eea-f71b-4116-883e-0ab07fada8e4', False, '49630', '49630', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982471Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('b3087995-8550-4b0f-a201-dbbf1e52698b', False, '52750', '52750', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.982977Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('47c17779-6fd6-4620-a176-4112878f687a', False, '52800', '52800', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983098Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('081d900a-ef53-442b-940f-d7cee74a26d8', False, '52840', '52840', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983197Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('514648d9-4d3b-4bc4-a57e-edd091b9f3ce', False, '52880', '52880', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983292Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('15101a32-a069-4b0b-a29b-f86a409053b3', False, '52900', '52900', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983387Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('ce4e2f40-708a-434e-9d71-2b441ce8611b', False, '58050', '58050', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983452Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('2725f343-d52f-43b2-b41d-0f9f671aa84e', False, '58350', '58350', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983546Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('c0b1ecd2-af0a-450c-8a51-f7b44130d612', False, 'coe-lake-pontchartrain-at-frenier-la', '(COE) LAKE PONTCHARTRAIN AT FRENIER LA', ST_GeomFromText('POINT(-90.4214 30.1061)',4326), null, null, '2021-03-12T16:10:12.983687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '073802305'),
+('94e20e3a-7c6b-4eae-ac1c-fea8a22e4662', False, '58600', '58600', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983832Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('615d5750-5d23-4dfc-98d1-5fa69b3fc8ca', False, '58700', '58700', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.983903Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('f8f8fd49-cdf8-47ec-bfaa-a406ccbb12c2', False, '61720', '61720', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.984001Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('e0806b46-1b66-460e-97d5-0c98bff2edd9', False, '61760', '61760', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.984097Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('b7425815-d58a-4422-9907-e115f41c77dd', False, '64050', '64050', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.984193Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('6207bc92-5cc7-46ed-a93f-1ff32121a68a', False, 'charenton-drainage-canal-at-baldwin-la', 'CHARENTON DRAINAGE CANAL AT BALDWIN LA', ST_GeomFromText('POINT(-91.5417 29.8231)',4326), null, null, '2021-03-12T16:10:12.984329Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07385790'),
+('5f0e95fe-84b1-4dee-9584-408d2b79b4e1', False, 'bayou-teche-w-of-calumet-flood-gate-at-calumet-la', 'BAYOU TECHE W OF CALUMET FLOOD GATE AT CALUMET LA', ST_GeomFromText('POINT(-91.3728 29.7039)',4326), null, null, '2021-03-12T16:10:12.984463Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '07385820'),
+('34d6ecd5-a584-4de1-90a6-dacc386988bd', False, 'bayou-teche-e-of-calumet-flood-gate-at-calumet-la', 'BAYOU TECHE E OF CALUMET FLOOD GATE AT CALUMET LA', ST_GeomFromText('POINT(-91.3739 29.7042)',4326), null, null, '2021-03-12T16:10:12.984668Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, '073815945'),
+('5f4a3ce2-f359-4f7b-b622-9db903ca8f30', False, '70600', '70600', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.984836Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('d0c6b11b-44a4-4041-9cd7-1ff725f042a4', False, '70675', '70675', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.984937Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('26ff06dc-5aa1-4928-9595-9fd5713dcf02', False, '70750', '70750', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.984937Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('9c4eec28-485c-405f-93fe-a1671ba1e85e', False, '70900', '70900', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.985270Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('2a0651b4-b2fa-4e91-a4f6-1412b6ce7893', False, '73472', '73472', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.985381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('77576b15-85c2-42ca-82b8-98cbc8244122', False, '73473', '73473', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.985381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null),
+('c8197081-957a-451d-ab4d-41805e0ecf5f', False, '73550', '73550', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:10:12.986013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', 'e38f3d27-21fe-4e35-8de5-3aea8580e909', '00000000-0000-0000-0000-000000000000', null, null);
 
 --INSERT INSTRUMENT STATUS--
 INSERT INTO instrument_status(id, instrument_id, status_id, "time")
@@ -105,161 +105,161 @@ where i.project_id = '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3';
 --Ignoring ING site/instrument, already in instruments unique list
 
 --INSERT INSTRUMENTS--COUNT:153
-INSERT INTO instrument(id, deleted, slug, name, formula, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
+INSERT INTO instrument(id, deleted, slug, name, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
  VALUES 
-('3b4e1f2c-381e-44bd-9945-3c7cc3e6def4', False, 'hub', 'HUB', null, ST_GeomFromText('POINT(-139.4864 59.9944)',4326), null, null, '2021-03-12T16:33:30.244166Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('2f525323-e8d1-4e94-a9ee-d06731c29568', False, 'peg', 'PEG', null, ST_GeomFromText('POINT(166.3611 -78.0911)',4326), null, null, '2021-03-12T16:33:30.244852Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('ba7f146c-ade1-4c0c-b5e2-9a9e85c7d82d', False, 'hns', 'HNS', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.245706Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('a16dc8f6-009e-42d1-ad4e-95c3d2ea2673', False, 'hcd', 'HCD', null, ST_GeomFromText('POINT(-73.0381 41.6219)',4326), null, null, '2021-03-12T16:33:30.246750Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('96945d06-8d27-4d10-b652-a8f2e51f2557', False, 'brd', 'BRD', null, ST_GeomFromText('POINT(-73.1056 41.6575)',4326), null, null, '2021-03-12T16:33:30.247395Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01208011'),
-('0827aea6-82b2-4525-b257-d06eb6489296', False, 'tmd', 'TMD', null, ST_GeomFromText('POINT(-73.0625 41.6947)',4326), null, null, '2021-03-12T16:33:30.248376Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01206600'),
-('7bc1bd4a-44a3-4105-88d2-338c282a3cbf', False, 'mrd', 'MRD', null, ST_GeomFromText('POINT(-73.09 41.9314)',4326), null, null, '2021-03-12T16:33:30.249646Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01186090'),
-('e77fd949-af05-45f1-baa7-0e045302ab2e', False, 'hmd', 'HMD', null, ST_GeomFromText('POINT(-73.1683 41.8689)',4326), null, null, '2021-03-12T16:33:30.249926Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01205560'),
-('9be6949e-5129-4be7-b924-6b3612b5db34', False, 'hbd', 'HBD', null, ST_GeomFromText('POINT(-73.0664 41.5144)',4326), null, null, '2021-03-12T16:33:30.250140Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01208410'),
-('2d8e0e00-a43c-456c-9b00-7647e2e3ac89', False, 'nbd', 'NBD', null, ST_GeomFromText('POINT(-73.0908 41.6814)',4326), null, null, '2021-03-12T16:33:30.251496Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01206940'),
-('00f9733d-07d0-4e28-ae35-26c01366aaaf', False, 'uvd', 'UVD', null, ST_GeomFromText('POINT(-72.2575 43.7937)',4326), null, null, '2021-03-12T16:33:30.252231Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01141500'),
-('8578af90-d100-44da-85ae-3a49d8d128fe', False, 'nsd', 'NSD', null, ST_GeomFromText('POINT(-72.5075 43.3375)',4326), null, null, '2021-03-12T16:33:30.253984Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('94911500-32be-4460-8b45-a0205172669c', False, 'bmd', 'BMD', null, ST_GeomFromText('POINT(-72.7744 43.1274)',4326), null, null, '2021-03-12T16:33:30.255638Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('cb8de53b-f044-4771-ac3b-1d996af38c16', False, 'tsd', 'TSD', null, ST_GeomFromText('POINT(-72.7022 43.0531)',4326), null, null, '2021-03-12T16:33:30.257803Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01155910'),
-('1a687a2d-4995-4dae-90b9-6c20a167cfe4', False, 'smd', 'SMD', null, ST_GeomFromText('POINT(-72.312 42.998)',4326), null, null, '2021-03-12T16:33:30.259240Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01158000'),
-('136cb9e0-3b46-43be-8182-e5e729db3a55', False, 'obd', 'OBD', null, ST_GeomFromText('POINT(-72.2367 42.9465)',4326), null, null, '2021-03-12T16:33:30.260727Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01158600'),
-('1a127876-f15b-4d84-a547-63ce43e68012', False, 'bhd', 'BHD', null, ST_GeomFromText('POINT(-72.1239 42.6322)',4326), null, null, '2021-03-12T16:33:30.262506Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01163500'),
-('0ca2fc25-32a2-4cd6-9207-d2e988ec122d', False, 'tld', 'TLD', null, ST_GeomFromText('POINT(-72.225 42.6414)',4326), null, null, '2021-03-12T16:33:30.263789Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01165000'),
-('818b694f-feec-43cc-98d0-4aa8397616bf', False, 'bfd', 'BFD', null, ST_GeomFromText('POINT(-72.0261 42.4278)',4326), null, null, '2021-03-12T16:33:30.264751Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01172500'),
-('b42a6ac5-9c15-4851-8519-d4074580c794', False, 'kvd', 'KVD', null, ST_GeomFromText('POINT(-72.8636 42.2906)',4326), null, null, '2021-03-12T16:33:30.265651Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01179500'),
-('bf4dc42b-f317-41e7-b27e-0efc2f90a176', False, 'lvd', 'LVD', null, ST_GeomFromText('POINT(-72.8808 42.2656)',4326), null, null, '2021-03-12T16:33:30.267156Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('593d9f2d-0ed0-4899-81f0-1408e4e4fd18', False, 'crd', 'CRD', null, ST_GeomFromText('POINT(-73.0364 42.0064)',4326), null, null, '2021-03-12T16:33:30.268950Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01185850'),
-('9eeca841-ee77-4824-84bd-f0a9d97fdda7', False, 'ffd', 'FFD', null, ST_GeomFromText('POINT(-71.6593 43.4691)',4326), null, null, '2021-03-12T16:33:30.270224Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('df4c0585-fbbf-422c-a1ba-988c9629f5e6', False, 'bwd', 'BWD', null, ST_GeomFromText('POINT(-71.7231 43.3156)',4326), null, null, '2021-03-12T16:33:30.271981Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('75c56d48-5840-4740-961d-453bd3f40987', False, 'emd', 'EMD', null, ST_GeomFromText('POINT(-71.9878 42.8939)',4326), null, null, '2021-03-12T16:33:30.273256Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01083000'),
-('a4a385d9-d079-4065-a6fb-2ba243f86c11', False, 'hkd', 'HKD', null, ST_GeomFromText('POINT(-71.7472 43.1886)',4326), null, null, '2021-03-12T16:33:30.275656Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('3bc0a05a-c70e-4d13-a373-40a413500ebc', False, 'evd', 'EVD', null, ST_GeomFromText('POINT(-71.6603 43.0933)',4326), null, null, '2021-03-12T16:33:30.278139Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01090800'),
-('d85ca5c2-6f51-4afb-a52c-c1c098f354ac', False, 'ebd', 'EBD', null, ST_GeomFromText('POINT(-72.1269 42.1083)',4326), null, null, '2021-03-12T16:33:30.280622Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01123360'),
-('9d24e2ce-8572-4520-8157-7a03cc76bd85', False, 'wvd', 'WVD', null, ST_GeomFromText('POINT(-72.0589 42.0825)',4326), null, null, '2021-03-12T16:33:30.282125Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01123600'),
-('834aaa95-c01e-4a8b-84b7-27af345ca78c', False, 'wtd', 'WTD', null, ST_GeomFromText('POINT(-71.9014 41.945)',4326), null, null, '2021-03-12T16:33:30.285938Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01124151'),
-('cfe992d9-8c5c-46b3-b790-98bd6fde35b2', False, 'bvd', 'BVD', null, ST_GeomFromText('POINT(-71.9083 42.1158)',4326), null, null, '2021-03-12T16:33:30.288163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('bc2fdcb8-0b2f-474f-976c-6c87dd260143', False, 'hvd', 'HVD', null, ST_GeomFromText('POINT(-71.8819 42.1192)',4326), null, null, '2021-03-12T16:33:30.289010Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01124350'),
-('8c00f847-da05-4965-8119-f04158da3b54', False, 'mhd', 'MHD', null, ST_GeomFromText('POINT(-72.1817 41.765)',4326), null, null, '2021-03-12T16:33:30.292089Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01121500'),
-('27d550b2-e59f-45a5-9b28-9846a77efdfa', False, 'whd', 'WHD', null, ST_GeomFromText('POINT(-71.6092 42.1058)',4326), null, null, '2021-03-12T16:33:30.294825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01111200'),
-('840a3367-1818-4385-89b7-10baee0f9490', False, 'cbd', 'CBD', null, ST_GeomFromText('POINT(-72.2904 42.0735)',4326), null, null, '2021-03-12T16:33:30.296700Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01176410'),
-('6d846897-1083-49dd-9a32-3c650e1578f6', False, 'wds', 'WDS', null, ST_GeomFromText('POINT(-71.68 43.9764)',4326), null, null, '2021-03-12T16:33:30.297730Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01075000'),
-('5d282c8e-ff28-4227-b90f-66cc87834553', False, 'wil', 'WIL', null, ST_GeomFromText('POINT(-72.1828 41.7003)',4326), null, null, '2021-03-12T16:33:30.298347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01122500'),
-('a79c2f39-a23c-4b94-9656-a7b1ca5eaccc', False, 'web', 'WEB', null, ST_GeomFromText('POINT(-71.8853 42.0508)',4326), null, null, '2021-03-12T16:33:30.298727Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01125000'),
-('e745ca0e-b476-45f4-829b-691ed48c6d4b', False, 'hen', 'HEN', null, ST_GeomFromText('POINT(-71.8578 43.1517)',4326), null, null, '2021-03-12T16:33:30.299087Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01085000'),
-('0d2cd5d3-e139-43c7-896a-1526449c7e52', False, 'jcy', 'JCY', null, ST_GeomFromText('POINT(-71.9847 41.5978)',4326), null, null, '2021-03-12T16:33:30.299433Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01127000'),
-('b62ff707-ae3c-416d-9718-b19e63bde806', False, 'gib', 'GIB', null, ST_GeomFromText('POINT(-72.2733 42.2358)',4326), null, null, '2021-03-12T16:33:30.299760Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01173500'),
-('04aad57c-23be-4799-a527-adcadee079ee', False, 'drf', 'DRF', null, ST_GeomFromText('POINT(-72.6547 42.5367)',4326), null, null, '2021-03-12T16:33:30.300063Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01170000'),
-('9712ade8-cd53-4020-9f16-104bb4992834', False, 'gft', 'GFT', null, ST_GeomFromText('POINT(-71.5511 43.0167)',4326), null, null, '2021-03-12T16:33:30.300380Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01091500'),
-('9b2fc48a-fcad-408f-a22a-cc80004b8854', False, 'gfl', 'GFL', null, ST_GeomFromText('POINT(-71.4639 42.9481)',4326), null, null, '2021-03-12T16:33:30.300703Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01092000'),
-('6f927220-aa7c-4534-acd7-97d56797adbf', False, 'rvh', 'RVH', null, ST_GeomFromText('POINT(-71.62 43.2494)',4326), null, null, '2021-03-12T16:33:30.301005Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01087850'),
-('b8f39348-ad52-4e3e-bc32-1b204108bd30', False, 'con', 'CON', null, ST_GeomFromText('POINT(-71.5308 43.2089)',4326), null, null, '2021-03-12T16:33:30.301323Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01088400'),
-('ac669939-8e61-4fc9-81de-f7b5eabf28ec', False, 'wns', 'WNS', null, ST_GeomFromText('POINT(-71.5036 42.0061)',4326), null, null, '2021-03-12T16:33:30.301640Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01112500'),
-('cb60fe8a-6898-4bf8-8a74-d68e44e87ba8', False, 'ntb', 'NTB', null, ST_GeomFromText('POINT(-71.6523 42.1539)',4326), null, null, '2021-03-12T16:33:30.302015Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01110500'),
-('f33df158-81c1-468c-84f0-bc5735fd849e', False, 'low', 'LOW', null, ST_GeomFromText('POINT(-71.2989 42.6458)',4326), null, null, '2021-03-12T16:33:30.302371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01100000'),
-('66150415-b2db-46ba-8aa2-4246cc463d9a', False, 'kee', 'KEE', null, ST_GeomFromText('POINT(-72.2797 42.9192)',4326), null, null, '2021-03-12T16:33:30.302695Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01158110'),
-('44ac1f0e-d8b3-4e9e-8f86-11ae7e50fe5f', False, 'tvl', 'TVL', null, ST_GeomFromText('POINT(-72.6061 41.9869)',4326), null, null, '2021-03-12T16:33:30.303101Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01184000'),
-('e5960d2c-3fc0-4e99-a5fe-16722bcbad47', False, 'ind', 'IND', null, ST_GeomFromText('POINT(-72.5147 42.1603)',4326), null, null, '2021-03-12T16:33:30.303424Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01177000'),
-('01a956f6-158c-424b-9f41-33e8b0fc6975', False, 'wsf', 'WSF', null, ST_GeomFromText('POINT(-72.6997 42.1064)',4326), null, null, '2021-03-12T16:33:30.303740Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01183500'),
-('f5fc2904-a4aa-4f12-8bbb-85f2295a2069', False, 'htf', 'HTF', null, ST_GeomFromText('POINT(-72.6675 41.7689)',4326), null, null, '2021-03-12T16:33:30.304048Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01190070'),
-('f65ecead-ce27-49a6-a5a9-b74113f56d95', False, 'sim', 'SIM', null, ST_GeomFromText('POINT(-72.7981 41.8697)',4326), null, null, '2021-03-12T16:33:30.304364Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01189213'),
-('82882c0b-2013-4dc7-a801-1166064e6484', False, 'stv', 'STV', null, ST_GeomFromText('POINT(-73.1675 41.3836)',4326), null, null, '2021-03-12T16:33:30.304702Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01205500'),
-('0a4a6fae-95ae-4943-8499-a88a2e805bac', False, 'unv', 'UNV', null, ST_GeomFromText('POINT(-72.8869 41.7556)',4326), null, null, '2021-03-12T16:33:30.305026Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01188090'),
-('537ae103-2df7-472a-856b-f317e5fa26f1', False, 'ply', 'PLY', null, ST_GeomFromText('POINT(-71.6864 43.7592)',4326), null, null, '2021-03-12T16:33:30.305346Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01076500'),
-('553eb778-60ff-4ddd-af6a-6d8fec279cfe', False, 'rum', 'RUM', null, ST_GeomFromText('POINT(-71.8458 43.7956)',4326), null, null, '2021-03-12T16:33:30.305749Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01076000'),
-('1bd4a9e8-fcac-46ca-8c84-b9fe5cff9680', False, 'leb', 'LEB', null, ST_GeomFromText('POINT(-72.3131 43.6464)',4326), null, null, '2021-03-12T16:33:30.306147Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01144500'),
-('5fad6bf6-639c-47d3-bad4-b8b2f24917bc', False, 'wha', 'WHA', null, ST_GeomFromText('POINT(-72.4186 43.7142)',4326), null, null, '2021-03-12T16:33:30.306554Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01144000'),
-('eb70854b-a8f0-471f-bcc6-b5c9ad7fae1c', False, 'nwa', 'NWA', null, ST_GeomFromText('POINT(-72.4358 43.125)',4326), null, null, '2021-03-12T16:33:30.307032Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01154500'),
-('5a767c06-565d-4504-91bb-bbfa38938147', False, 'wls', 'WLS', null, ST_GeomFromText('POINT(-72.0425 44.1533)',4326), null, null, '2021-03-12T16:33:30.307805Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01138500'),
-('4a835069-bc18-4675-9cf0-371728c2d180', False, 'tlt', 'TLT', null, ST_GeomFromText('POINT(-71.5883 43.4417)',4326), null, null, '2021-03-12T16:33:30.308339Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01081000'),
-('8b9e5146-f636-43b0-994f-bb5409bf5d8f', False, 'brs', 'BRS', null, ST_GeomFromText('POINT(-71.7483 43.5667)',4326), null, null, '2021-03-12T16:33:30.308970Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01078000'),
-('6aa941d4-526f-4954-9cd9-4a9e1b594458', False, 'sgn', 'SGN', null, ST_GeomFromText('POINT(-71.5069 42.8572)',4326), null, null, '2021-03-12T16:33:30.311317Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01094000'),
-('a4de2bc1-81c0-4c1d-8f8b-b40f5ee7c032', False, 'wat', 'WAT', null, ST_GeomFromText('POINT(-73.0547 41.5572)',4326), null, null, '2021-03-12T16:33:30.312360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01208171'),
-('d8838f8d-d360-46cb-845c-c5897fb9ed45', False, 'ptb', 'PTB', null, ST_GeomFromText('POINT(-71.9597 42.8625)',4326), null, null, '2021-03-12T16:33:30.313720Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01082000'),
-('cb832c4d-e625-4c83-903a-9e9b1ffd5af4', False, 'put', 'PUT', null, ST_GeomFromText('POINT(-71.9131 41.9092)',4326), null, null, '2021-03-12T16:33:30.314821Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01125500'),
-('4f456122-f3cd-4c72-a124-a806b396ee8f', False, 'bar', 'BAR', null, ST_GeomFromText('POINT(-72.1144 42.3803)',4326), null, null, '2021-03-12T16:33:30.315635Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01173180'),
-('c8ddcc69-4834-4621-b48d-c64d52bfc07b', False, 'frn', 'FRN', null, ST_GeomFromText('POINT(-71.6533 43.4225)',4326), null, null, '2021-03-12T16:33:30.316468Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01081500'),
-('cff98845-d6eb-4b98-95fe-6ab991340ff4', False, 'ath', 'ATH', null, ST_GeomFromText('POINT(-72.2391 42.5928)',4326), null, null, '2021-03-12T16:33:30.326407Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('ed4d243e-5277-4707-8a17-443659d26e79', False, 'nbs', 'NBS', null, ST_GeomFromText('POINT(-73.0728 42.079)',4326), null, null, '2021-03-12T16:33:30.326825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01185500'),
-('877903b5-8bdb-4df7-9eea-5102159f4c8f', False, 'hol', 'HOL', null, ST_GeomFromText('POINT(-72.6089 42.1906)',4326), null, null, '2021-03-12T16:33:30.327073Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01172010'),
-('da8062b8-3875-480e-91ce-218794860ec0', False, 'hun', 'HUN', null, ST_GeomFromText('POINT(-72.8961 42.2372)',4326), null, null, '2021-03-12T16:33:30.327302Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01181000'),
-('3d939d27-2cee-43e9-919a-c490ebbd2db1', False, 'rvt', 'RVT', null, ST_GeomFromText('POINT(-73.0181 41.9628)',4326), null, null, '2021-03-12T16:33:30.327534Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01186000'),
-('530763d9-01dd-49fa-a8ac-a5af2f971294', False, 'dav', 'DAV', null, ST_GeomFromText('POINT(-71.7317 43.2517)',4326), null, null, '2021-03-12T16:33:30.327768Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01086000'),
-('27f192ae-4ba8-466e-b3d8-918c4a00acea', False, 'nbb', 'NBB', null, ST_GeomFromText('POINT(-70.9061 41.6242)',4326), null, null, '2021-03-12T16:33:30.329143Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('a49e5740-1db1-4564-a2d2-c7bcb4fa9c83', False, 'met', 'MET', null, ST_GeomFromText('POINT(-71.2089 42.7431)',4326), null, null, '2021-03-12T16:33:30.329856Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01100561'),
-('4d10bd0f-afb1-4cf1-8daa-b28757b58731', False, 'win', 'WIN', null, ST_GeomFromText('POINT(-72.1156 42.6825)',4326), null, null, '2021-03-12T16:33:30.330168Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01162500'),
-('3bba455b-4a3b-4d7e-987c-f6e89b9f4817', False, 'war', 'WAR', null, ST_GeomFromText('POINT(-72.1694 41.8436)',4326), null, null, '2021-03-12T16:33:30.330356Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01121000'),
-('0cd55a61-8fbe-4bdf-b2d3-ce828c5f79e0', False, 'erv', 'ERV', null, ST_GeomFromText('POINT(-72.4386 42.5975)',4326), null, null, '2021-03-12T16:33:30.330611Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01166500'),
-('a537df19-b26c-4c12-92fa-8e1b04914301', False, 'lin', 'LIN', null, ST_GeomFromText('POINT(-71.6603 44.0475)',4326), null, null, '2021-03-12T16:33:30.330865Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01074520'),
-('93358fb8-1751-493d-b7af-2dba3a50817f', False, 'swy', 'SWY', null, ST_GeomFromText('POINT(-72.3281 42.8717)',4326), null, null, '2021-03-12T16:33:30.331111Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01160350'),
-('28705721-77a2-413d-b189-51e727bb9614', False, 'sbd', 'SBD', null, ST_GeomFromText('POINT(-73.1 41.9031)',4326), null, null, '2021-03-12T16:33:30.331321Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('37de6afd-08b7-4bf5-a3fd-5a9ee0575664', False, 'ccc', 'CCC', null, ST_GeomFromText('POINT(-70.5903 41.7486)',4326), null, null, '2021-03-12T16:33:30.331519Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('a70157ad-7568-40ff-a5ff-3a9059dd3999', False, 'cha', 'CHA', null, ST_GeomFromText('POINT(-72.1067 41.8158)',4326), null, null, '2021-03-12T16:33:30.331689Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01120790'),
-('f5e0a98a-541f-42f9-8366-92939535ea02', False, 'clo', 'CLO', null, ST_GeomFromText('POINT(-71.3025 42.6367)',4326), null, null, '2021-03-12T16:33:30.331928Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01099500'),
-('57bcaa31-0d89-4041-9d33-471e606aa44f', False, 'cov', 'COV', null, ST_GeomFromText('POINT(-72.2661 41.7506)',4326), null, null, '2021-03-12T16:33:30.332167Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01119500'),
-('ce09a25f-3018-4bd4-9138-a36622bc7859', False, 'fen', 'FEN', null, ST_GeomFromText('POINT(-72.2433 41.8333)',4326), null, null, '2021-03-12T16:33:30.332412Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01121330'),
-('9db49d0c-7e1a-4ce5-97c1-6c5e3c068e3d', False, 'hav', 'HAV', null, ST_GeomFromText('POINT(-71.0831 42.7728)',4326), null, null, '2021-03-12T16:33:30.332648Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01100693'),
-('edfd48f9-27de-4cd7-80e5-7d7dc5560551', False, 'hin', 'HIN', null, ST_GeomFromText('POINT(-72.4867 42.7858)',4326), null, null, '2021-03-12T16:33:30.333017Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01161000'),
-('affd94dd-3d0f-4eee-ae6a-2d0fe36c7fd1', False, 'lon', 'LON', null, ST_GeomFromText('POINT(-72.7972 43.1568)',4326), null, null, '2021-03-12T16:33:30.333662Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('b762b187-d886-4edf-83e1-6a1a26996c0c', False, 'mil', 'MIL', null, ST_GeomFromText('POINT(-71.7656 42.1889)',4326), null, null, '2021-03-12T16:33:30.334198Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01109730'),
-('d0380d12-1265-49a8-8d68-78655951ca54', False, 'nbf', 'NBF', null, ST_GeomFromText('POINT(-72.0261 42.4278)',4326), null, null, '2021-03-12T16:33:30.335090Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('dcdafb50-2824-405a-8a66-c3569ea2e5ce', False, 'nuv', 'NUV', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.335341Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('31b1b10b-4eab-4769-ab4a-cdc4d698778b', False, 'uxb', 'UXB', null, ST_GeomFromText('POINT(-71.6169 42.0547)',4326), null, null, '2021-03-12T16:33:30.335511Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01111212'),
-('c8f24c59-6b40-482b-94ec-044b547a6b64', False, 'col', 'COL', null, ST_GeomFromText('POINT(-146.8339 61.2225)',4326), null, null, '2021-03-12T16:33:30.336345Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('cea35093-cf14-4565-ab5c-8be0c3ebccdc', False, 'pep', 'PEP', null, ST_GeomFromText('POINT(-71.5753 42.6672)',4326), null, null, '2021-03-12T16:33:30.336862Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01096500'),
-('b59a72c7-aac0-4872-a6a1-54328d167634', False, 'wbr', 'WBR', null, ST_GeomFromText('POINT(-72.7594 43.6222)',4326), null, null, '2021-03-12T16:33:30.337714Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01150900'),
-('4e7b8e3f-1df3-4e07-9baf-6ebec1bf0c57', False, 'wmt', 'WMT', null, ST_GeomFromText('POINT(-71.2153 42.5681)',4326), null, null, '2021-03-12T16:33:30.337970Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01100600'),
-('aae9d540-b8a7-416a-936a-b9145468b21e', False, 'pfp', 'PFP', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.338238Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('87ce5712-fd02-4f08-a1d0-0687360bdfcc', False, 'wol', 'WOL', null, ST_GeomFromText('POINT(-148.9061 60.4094)',4326), null, null, '2021-03-12T16:33:30.338826Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '15236895'),
-('abe0ba5f-6269-4809-b6a9-ca3ba93019fc', False, 'snd', 'SND', null, ST_GeomFromText('POINT(-70.5053 41.7719)',4326), null, null, '2021-03-12T16:33:30.339551Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('aa6847d8-b1a5-452b-9db8-c2bb8300c8cc', False, 'wng', 'WNG', null, ST_GeomFromText('POINT(-70.6614 41.6806)',4326), null, null, '2021-03-12T16:33:30.339975Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('f9b3916a-9991-4832-b56a-ceb4f12b52c2', False, 'was', 'WAS', null, ST_GeomFromText('POINT(-68.1572 46.7772)',4326), null, null, '2021-03-12T16:33:30.340389Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01017000'),
-('722c001d-8b7e-47d6-b953-851485df8100', False, 'ebn', 'EBN', null, ST_GeomFromText('POINT(-70.9786 42.2208)',4326), null, null, '2021-03-12T16:33:30.340561Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01105583'),
-('d998e68b-1a29-42e0-8158-14c979dd2507', False, 'wal', 'WAL', null, ST_GeomFromText('POINT(-72.8414 41.4494)',4326), null, null, '2021-03-12T16:33:30.340729Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01196500'),
-('2e286a65-85d0-4115-b298-98f325a06847', False, 'can', 'CAN', null, ST_GeomFromText('POINT(-71.1464 42.1544)',4326), null, null, '2021-03-12T16:33:30.340986Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01105500'),
-('53422e2b-0fe4-4c4e-8c1d-12a82e849ec9', False, 'wbf', 'WBF', null, ST_GeomFromText('POINT(-72.2642 42.1822)',4326), null, null, '2021-03-12T16:33:30.341146Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01176000'),
-('10a32c15-f8c1-4e47-9e49-bbe059d5c007', False, 'otr', 'OTR', null, ST_GeomFromText('POINT(-72.0414 42.5883)',4326), null, null, '2021-03-12T16:33:30.341378Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01163200'),
-('58c0dd1f-9042-4609-8ed7-2741c9198718', False, 'nhp', 'NHP', null, ST_GeomFromText('POINT(-72.6656 42.3189)',4326), null, null, '2021-03-12T16:33:30.341538Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01171500'),
-('ffcf2405-0279-47dc-9d67-515687eba5f6', False, 'sax', 'SAX', null, ST_GeomFromText('POINT(-71.3981 42.3253)',4326), null, null, '2021-03-12T16:33:30.341790Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01098530'),
-('1a02dbd5-7967-43cb-aa77-effb6aa209e7', False, 'edd', 'EDD', null, ST_GeomFromText('POINT(-68.6967 44.8267)',4326), null, null, '2021-03-12T16:33:30.341993Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01036390'),
-('a1e96fc0-0e78-4705-bb8e-6c46ad0b6d6a', False, 'fkt', 'FKT', null, ST_GeomFromText('POINT(-68.5958 47.2581)',4326), null, null, '2021-03-12T16:33:30.342165Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01014000'),
-('818e9a87-163a-4831-a0fd-a4001e0165e2', False, 'roc', 'ROC', null, ST_GeomFromText('POINT(-70.9742 43.2683)',4326), null, null, '2021-03-12T16:33:30.342328Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01072800'),
-('877eab1c-2669-4e27-aa2b-5f28f710eef2', False, 'lp1', 'LP1', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.342626Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('e5ac7c93-50af-43c3-b62a-dca3a6c2444c', False, 'bcn', 'BCN', null, ST_GeomFromText('POINT(-73.0631 41.4419)',4326), null, null, '2021-03-12T16:33:30.344272Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01208500'),
-('ae976bb1-dc1a-4ed7-93fb-6f58e4f854e0', False, 'gil', 'GIL', null, ST_GeomFromText('POINT(-72.2706 43.0392)',4326), null, null, '2021-03-12T16:33:30.344752Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01157000'),
-('759d8ecd-798e-4227-8911-8b168e6934c3', False, 'mal', 'MAL', null, ST_GeomFromText('POINT(-72.2192 42.9101)',4326), null, null, '2021-03-12T16:33:30.345014Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('057c05ee-d15c-4ed2-af84-a407617f545d', False, 'qbq', 'QBQ', null, ST_GeomFromText('POINT(-71.9561 42.0222)',4326), null, null, '2021-03-12T16:33:30.345190Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01124000'),
-('4f64435a-b798-4a80-bbce-53ea32981750', False, 'fpb', 'FPB', null, ST_GeomFromText('POINT(-71.4003 41.8008)',4326), null, null, '2021-03-12T16:33:30.345426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('36bc37e0-eca1-4cf9-a27c-1d18109dc581', False, 'shb', 'SHB', null, ST_GeomFromText('POINT(-73.5344 41.0369)',4326), null, null, '2021-03-12T16:33:30.345981Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01209788'),
-('4eb76cd9-8191-4c11-a006-25a2765b6738', False, 'wfd', 'WFD', null, ST_GeomFromText('POINT(-71.5181 41.9994)',4326), null, null, '2021-03-12T16:33:30.346607Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('ca33744c-9ca9-430e-a10b-326e238ecd5b', False, 'mcy', 'MCY', null, ST_GeomFromText('POINT(-72.5744 42.5802)',4326), null, null, '2021-03-12T16:33:30.346812Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01170500'),
-('af134ab4-ed49-44e4-86ca-944542345210', False, 'ead', 'EAD', null, ST_GeomFromText('POINT(-73.1206 41.8364)',4326), null, null, '2021-03-12T16:33:30.347046Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01205650'),
-('e58e32cf-3c39-42af-a068-abb5c71db22c', False, 'for', 'FOR', null, ST_GeomFromText('POINT(-71.5631 41.9964)',4326), null, null, '2021-03-12T16:33:30.347274Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01111500'),
-('206e4912-6187-4ca0-b278-6ba8d0156664', False, 'gul', 'GUL', null, ST_GeomFromText('POINT(-145.4147 63.2764)',4326), null, null, '2021-03-12T16:33:30.347436Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '15478038'),
-('830af55a-4fbf-4556-acc6-162ed629cbf4', False, 'lp2', 'LP2', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.348171Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('0d4e5339-4141-43c9-acfb-deb3133633c0', False, 'cen', 'CEN', null, ST_GeomFromText('POINT(-71.4878 41.8589)',4326), null, null, '2021-03-12T16:33:30.349514Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01114500'),
-('69c2e36d-6a02-4aca-ad75-0702763479ad', False, 'pro', 'PRO', null, ST_GeomFromText('POINT(-71.4117 41.8339)',4326), null, null, '2021-03-12T16:33:30.349789Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01114000'),
-('eb844d9c-11d9-43ba-880a-1833a0b9fde5', False, 'roy', 'ROY', null, ST_GeomFromText('POINT(-72.1507 42.6297)',4326), null, null, '2021-03-12T16:33:30.349986Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01164000'),
-('1cec4fa0-84f1-40d0-8943-87c9ebc563b9', False, 'helnorth', 'HELNORTH', null, ST_GeomFromText('POINT(-38.2 66.4)',4326), null, null, '2021-03-12T16:33:30.350280Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('d57e939f-855d-4ec1-9014-8d9b30e7bf4c', False, 'nas', 'NAS', null, ST_GeomFromText('POINT(-71.45 42.7667)',4326), null, null, '2021-03-12T16:33:30.352348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('d0fcdb8a-7883-4cb0-a1db-430fe450e50e', False, 'cor', 'COR', null, ST_GeomFromText('POINT(-145.2714 60.6671)',4326), null, null, '2021-03-12T16:33:30.355924Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('1f5631fa-109f-4a38-957e-81d96259b96e', False, 'val', 'VAL', null, ST_GeomFromText('POINT(-146.1717 61.1772)',4326), null, null, '2021-03-12T16:33:30.356741Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('dc6efaeb-b255-4a78-8ccc-21863951f4f9', False, 'rob', 'ROB', null, ST_GeomFromText('POINT(-73.0334 41.9679)',4326), null, null, '2021-03-12T16:33:30.357431Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01186500'),
-('444b4886-4795-4747-a028-d96815c16503', False, 'wch', 'WCH', null, ST_GeomFromText('POINT(-72.0839 42.6842)',4326), null, null, '2021-03-12T16:33:30.357823Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01162000'),
-('a627f52e-033e-46cf-89cb-37e5388ffbe7', False, 'sid', 'SID', null, ST_GeomFromText('POINT(-69.6839 44.4722)',4326), null, null, '2021-03-12T16:33:30.358096Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01049265'),
-('9d2137c7-6b46-4ef8-9f12-8acb24c363ec', False, 'pas', 'PAS', null, ST_GeomFromText('POINT(-72.0397 44.3656)',4326), null, null, '2021-03-12T16:33:30.358353Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01135500'),
-('f37e1138-8ad7-4893-bf3f-9d6a0759ae62', False, 'wwa', 'WWA', null, ST_GeomFromText('POINT(-72.3331 42.2678)',4326), null, null, '2021-03-12T16:33:30.358903Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01175500'),
-('e803b8c8-f6c9-435b-965e-8db47572b8d5', False, 'ess', 'ESS', null, ST_GeomFromText('POINT(-72.3844 41.3514)',4326), null, null, '2021-03-12T16:33:30.359380Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01194750'),
-('e20eac2b-67fe-4d52-9877-a0513c5f685b', False, 'wts', 'WTS', null, ST_GeomFromText('POINT(-50.6704 67.0054)',4326), null, null, '2021-03-12T16:33:30.360176Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('b0190dc8-60af-4b8f-9ef4-17f464b38f5b', False, 'rcc', 'RCC', null, ST_GeomFromText('POINT(-71.302 42.4635)',4326), null, null, '2021-03-12T16:33:30.360564Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('8548004f-8044-48be-a44c-048fe074ec3f', False, 'hel', 'HEL', null, ST_GeomFromText('POINT(-38.0 66.4)',4326), null, null, '2021-03-12T16:33:30.360740Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('3e40ee6b-40a3-49d2-b0e3-14e5c98c3356', False, 'nhd', 'NHD', null, ST_GeomFromText('POINT(-72.3609 43.6044)',4326), null, null, '2021-03-12T16:33:30.361701Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01151400'),
-('2518f4e9-b3aa-403c-ba85-20ceaab7ef94', False, 'nli', 'NLI', null, ST_GeomFromText('POINT(-71.6725 44.0425)',4326), null, null, '2021-03-12T16:33:30.363361Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('fc79f6f9-13e3-416e-81d2-3978a5ea1d14', False, 'helsouth', 'HELSOUTH', null, ST_GeomFromText('POINT(-38.2 66.4)',4326), null, null, '2021-03-12T16:33:30.363677Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('b28f4eea-d72f-4336-ba86-65c793d25112', False, 'ing', 'ING', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.365596Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('6956c85c-34b9-413f-9ee9-daeaa097dffe', False, 'atlas-crrel', 'Atlas-CRREL', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.366614Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('f706775b-f8a7-4c15-9ab2-0f57d393cf4e', False, 'fit', 'FIT', null, ST_GeomFromText('POINT(-71.7886 42.5761)',4326), null, null, '2021-03-12T16:33:30.368990Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01094400'),
-('967aa515-bcdc-47b9-a649-ae78524a2387', False, 'crrelobllasertestsite', 'CRRELOblLaserTestSite', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.369186Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
-('9eea0cba-d470-4d37-8cb8-3f4f12f001b6', False, 'thl', 'THL', null, ST_GeomFromText('POINT(-69.2285 77.467)',4326), null, null, '2021-03-12T16:33:30.369842Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null);
+('3b4e1f2c-381e-44bd-9945-3c7cc3e6def4', False, 'hub', 'HUB', ST_GeomFromText('POINT(-139.4864 59.9944)',4326), null, null, '2021-03-12T16:33:30.244166Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('2f525323-e8d1-4e94-a9ee-d06731c29568', False, 'peg', 'PEG', ST_GeomFromText('POINT(166.3611 -78.0911)',4326), null, null, '2021-03-12T16:33:30.244852Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('ba7f146c-ade1-4c0c-b5e2-9a9e85c7d82d', False, 'hns', 'HNS', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.245706Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('a16dc8f6-009e-42d1-ad4e-95c3d2ea2673', False, 'hcd', 'HCD', ST_GeomFromText('POINT(-73.0381 41.6219)',4326), null, null, '2021-03-12T16:33:30.246750Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('96945d06-8d27-4d10-b652-a8f2e51f2557', False, 'brd', 'BRD', ST_GeomFromText('POINT(-73.1056 41.6575)',4326), null, null, '2021-03-12T16:33:30.247395Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01208011'),
+('0827aea6-82b2-4525-b257-d06eb6489296', False, 'tmd', 'TMD', ST_GeomFromText('POINT(-73.0625 41.6947)',4326), null, null, '2021-03-12T16:33:30.248376Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01206600'),
+('7bc1bd4a-44a3-4105-88d2-338c282a3cbf', False, 'mrd', 'MRD', ST_GeomFromText('POINT(-73.09 41.9314)',4326), null, null, '2021-03-12T16:33:30.249646Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01186090'),
+('e77fd949-af05-45f1-baa7-0e045302ab2e', False, 'hmd', 'HMD', ST_GeomFromText('POINT(-73.1683 41.8689)',4326), null, null, '2021-03-12T16:33:30.249926Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01205560'),
+('9be6949e-5129-4be7-b924-6b3612b5db34', False, 'hbd', 'HBD', ST_GeomFromText('POINT(-73.0664 41.5144)',4326), null, null, '2021-03-12T16:33:30.250140Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01208410'),
+('2d8e0e00-a43c-456c-9b00-7647e2e3ac89', False, 'nbd', 'NBD', ST_GeomFromText('POINT(-73.0908 41.6814)',4326), null, null, '2021-03-12T16:33:30.251496Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01206940'),
+('00f9733d-07d0-4e28-ae35-26c01366aaaf', False, 'uvd', 'UVD', ST_GeomFromText('POINT(-72.2575 43.7937)',4326), null, null, '2021-03-12T16:33:30.252231Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01141500'),
+('8578af90-d100-44da-85ae-3a49d8d128fe', False, 'nsd', 'NSD', ST_GeomFromText('POINT(-72.5075 43.3375)',4326), null, null, '2021-03-12T16:33:30.253984Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('94911500-32be-4460-8b45-a0205172669c', False, 'bmd', 'BMD', ST_GeomFromText('POINT(-72.7744 43.1274)',4326), null, null, '2021-03-12T16:33:30.255638Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('cb8de53b-f044-4771-ac3b-1d996af38c16', False, 'tsd', 'TSD', ST_GeomFromText('POINT(-72.7022 43.0531)',4326), null, null, '2021-03-12T16:33:30.257803Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01155910'),
+('1a687a2d-4995-4dae-90b9-6c20a167cfe4', False, 'smd', 'SMD', ST_GeomFromText('POINT(-72.312 42.998)',4326), null, null, '2021-03-12T16:33:30.259240Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01158000'),
+('136cb9e0-3b46-43be-8182-e5e729db3a55', False, 'obd', 'OBD', ST_GeomFromText('POINT(-72.2367 42.9465)',4326), null, null, '2021-03-12T16:33:30.260727Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01158600'),
+('1a127876-f15b-4d84-a547-63ce43e68012', False, 'bhd', 'BHD', ST_GeomFromText('POINT(-72.1239 42.6322)',4326), null, null, '2021-03-12T16:33:30.262506Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01163500'),
+('0ca2fc25-32a2-4cd6-9207-d2e988ec122d', False, 'tld', 'TLD', ST_GeomFromText('POINT(-72.225 42.6414)',4326), null, null, '2021-03-12T16:33:30.263789Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01165000'),
+('818b694f-feec-43cc-98d0-4aa8397616bf', False, 'bfd', 'BFD', ST_GeomFromText('POINT(-72.0261 42.4278)',4326), null, null, '2021-03-12T16:33:30.264751Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01172500'),
+('b42a6ac5-9c15-4851-8519-d4074580c794', False, 'kvd', 'KVD', ST_GeomFromText('POINT(-72.8636 42.2906)',4326), null, null, '2021-03-12T16:33:30.265651Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01179500'),
+('bf4dc42b-f317-41e7-b27e-0efc2f90a176', False, 'lvd', 'LVD', ST_GeomFromText('POINT(-72.8808 42.2656)',4326), null, null, '2021-03-12T16:33:30.267156Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('593d9f2d-0ed0-4899-81f0-1408e4e4fd18', False, 'crd', 'CRD', ST_GeomFromText('POINT(-73.0364 42.0064)',4326), null, null, '2021-03-12T16:33:30.268950Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01185850'),
+('9eeca841-ee77-4824-84bd-f0a9d97fdda7', False, 'ffd', 'FFD', ST_GeomFromText('POINT(-71.6593 43.4691)',4326), null, null, '2021-03-12T16:33:30.270224Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('df4c0585-fbbf-422c-a1ba-988c9629f5e6', False, 'bwd', 'BWD', ST_GeomFromText('POINT(-71.7231 43.3156)',4326), null, null, '2021-03-12T16:33:30.271981Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('75c56d48-5840-4740-961d-453bd3f40987', False, 'emd', 'EMD', ST_GeomFromText('POINT(-71.9878 42.8939)',4326), null, null, '2021-03-12T16:33:30.273256Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01083000'),
+('a4a385d9-d079-4065-a6fb-2ba243f86c11', False, 'hkd', 'HKD', ST_GeomFromText('POINT(-71.7472 43.1886)',4326), null, null, '2021-03-12T16:33:30.275656Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('3bc0a05a-c70e-4d13-a373-40a413500ebc', False, 'evd', 'EVD', ST_GeomFromText('POINT(-71.6603 43.0933)',4326), null, null, '2021-03-12T16:33:30.278139Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01090800'),
+('d85ca5c2-6f51-4afb-a52c-c1c098f354ac', False, 'ebd', 'EBD', ST_GeomFromText('POINT(-72.1269 42.1083)',4326), null, null, '2021-03-12T16:33:30.280622Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01123360'),
+('9d24e2ce-8572-4520-8157-7a03cc76bd85', False, 'wvd', 'WVD', ST_GeomFromText('POINT(-72.0589 42.0825)',4326), null, null, '2021-03-12T16:33:30.282125Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01123600'),
+('834aaa95-c01e-4a8b-84b7-27af345ca78c', False, 'wtd', 'WTD', ST_GeomFromText('POINT(-71.9014 41.945)',4326), null, null, '2021-03-12T16:33:30.285938Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01124151'),
+('cfe992d9-8c5c-46b3-b790-98bd6fde35b2', False, 'bvd', 'BVD', ST_GeomFromText('POINT(-71.9083 42.1158)',4326), null, null, '2021-03-12T16:33:30.288163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('bc2fdcb8-0b2f-474f-976c-6c87dd260143', False, 'hvd', 'HVD', ST_GeomFromText('POINT(-71.8819 42.1192)',4326), null, null, '2021-03-12T16:33:30.289010Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01124350'),
+('8c00f847-da05-4965-8119-f04158da3b54', False, 'mhd', 'MHD', ST_GeomFromText('POINT(-72.1817 41.765)',4326), null, null, '2021-03-12T16:33:30.292089Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01121500'),
+('27d550b2-e59f-45a5-9b28-9846a77efdfa', False, 'whd', 'WHD', ST_GeomFromText('POINT(-71.6092 42.1058)',4326), null, null, '2021-03-12T16:33:30.294825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01111200'),
+('840a3367-1818-4385-89b7-10baee0f9490', False, 'cbd', 'CBD', ST_GeomFromText('POINT(-72.2904 42.0735)',4326), null, null, '2021-03-12T16:33:30.296700Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01176410'),
+('6d846897-1083-49dd-9a32-3c650e1578f6', False, 'wds', 'WDS', ST_GeomFromText('POINT(-71.68 43.9764)',4326), null, null, '2021-03-12T16:33:30.297730Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01075000'),
+('5d282c8e-ff28-4227-b90f-66cc87834553', False, 'wil', 'WIL', ST_GeomFromText('POINT(-72.1828 41.7003)',4326), null, null, '2021-03-12T16:33:30.298347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01122500'),
+('a79c2f39-a23c-4b94-9656-a7b1ca5eaccc', False, 'web', 'WEB', ST_GeomFromText('POINT(-71.8853 42.0508)',4326), null, null, '2021-03-12T16:33:30.298727Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01125000'),
+('e745ca0e-b476-45f4-829b-691ed48c6d4b', False, 'hen', 'HEN', ST_GeomFromText('POINT(-71.8578 43.1517)',4326), null, null, '2021-03-12T16:33:30.299087Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01085000'),
+('0d2cd5d3-e139-43c7-896a-1526449c7e52', False, 'jcy', 'JCY', ST_GeomFromText('POINT(-71.9847 41.5978)',4326), null, null, '2021-03-12T16:33:30.299433Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01127000'),
+('b62ff707-ae3c-416d-9718-b19e63bde806', False, 'gib', 'GIB', ST_GeomFromText('POINT(-72.2733 42.2358)',4326), null, null, '2021-03-12T16:33:30.299760Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01173500'),
+('04aad57c-23be-4799-a527-adcadee079ee', False, 'drf', 'DRF', ST_GeomFromText('POINT(-72.6547 42.5367)',4326), null, null, '2021-03-12T16:33:30.300063Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01170000'),
+('9712ade8-cd53-4020-9f16-104bb4992834', False, 'gft', 'GFT', ST_GeomFromText('POINT(-71.5511 43.0167)',4326), null, null, '2021-03-12T16:33:30.300380Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01091500'),
+('9b2fc48a-fcad-408f-a22a-cc80004b8854', False, 'gfl', 'GFL', ST_GeomFromText('POINT(-71.4639 42.9481)',4326), null, null, '2021-03-12T16:33:30.300703Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01092000'),
+('6f927220-aa7c-4534-acd7-97d56797adbf', False, 'rvh', 'RVH', ST_GeomFromText('POINT(-71.62 43.2494)',4326), null, null, '2021-03-12T16:33:30.301005Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01087850'),
+('b8f39348-ad52-4e3e-bc32-1b204108bd30', False, 'con', 'CON', ST_GeomFromText('POINT(-71.5308 43.2089)',4326), null, null, '2021-03-12T16:33:30.301323Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01088400'),
+('ac669939-8e61-4fc9-81de-f7b5eabf28ec', False, 'wns', 'WNS', ST_GeomFromText('POINT(-71.5036 42.0061)',4326), null, null, '2021-03-12T16:33:30.301640Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01112500'),
+('cb60fe8a-6898-4bf8-8a74-d68e44e87ba8', False, 'ntb', 'NTB', ST_GeomFromText('POINT(-71.6523 42.1539)',4326), null, null, '2021-03-12T16:33:30.302015Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01110500'),
+('f33df158-81c1-468c-84f0-bc5735fd849e', False, 'low', 'LOW', ST_GeomFromText('POINT(-71.2989 42.6458)',4326), null, null, '2021-03-12T16:33:30.302371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01100000'),
+('66150415-b2db-46ba-8aa2-4246cc463d9a', False, 'kee', 'KEE', ST_GeomFromText('POINT(-72.2797 42.9192)',4326), null, null, '2021-03-12T16:33:30.302695Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01158110'),
+('44ac1f0e-d8b3-4e9e-8f86-11ae7e50fe5f', False, 'tvl', 'TVL', ST_GeomFromText('POINT(-72.6061 41.9869)',4326), null, null, '2021-03-12T16:33:30.303101Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01184000'),
+('e5960d2c-3fc0-4e99-a5fe-16722bcbad47', False, 'ind', 'IND', ST_GeomFromText('POINT(-72.5147 42.1603)',4326), null, null, '2021-03-12T16:33:30.303424Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01177000'),
+('01a956f6-158c-424b-9f41-33e8b0fc6975', False, 'wsf', 'WSF', ST_GeomFromText('POINT(-72.6997 42.1064)',4326), null, null, '2021-03-12T16:33:30.303740Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01183500'),
+('f5fc2904-a4aa-4f12-8bbb-85f2295a2069', False, 'htf', 'HTF', ST_GeomFromText('POINT(-72.6675 41.7689)',4326), null, null, '2021-03-12T16:33:30.304048Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01190070'),
+('f65ecead-ce27-49a6-a5a9-b74113f56d95', False, 'sim', 'SIM', ST_GeomFromText('POINT(-72.7981 41.8697)',4326), null, null, '2021-03-12T16:33:30.304364Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01189213'),
+('82882c0b-2013-4dc7-a801-1166064e6484', False, 'stv', 'STV', ST_GeomFromText('POINT(-73.1675 41.3836)',4326), null, null, '2021-03-12T16:33:30.304702Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01205500'),
+('0a4a6fae-95ae-4943-8499-a88a2e805bac', False, 'unv', 'UNV', ST_GeomFromText('POINT(-72.8869 41.7556)',4326), null, null, '2021-03-12T16:33:30.305026Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01188090'),
+('537ae103-2df7-472a-856b-f317e5fa26f1', False, 'ply', 'PLY', ST_GeomFromText('POINT(-71.6864 43.7592)',4326), null, null, '2021-03-12T16:33:30.305346Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01076500'),
+('553eb778-60ff-4ddd-af6a-6d8fec279cfe', False, 'rum', 'RUM', ST_GeomFromText('POINT(-71.8458 43.7956)',4326), null, null, '2021-03-12T16:33:30.305749Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01076000'),
+('1bd4a9e8-fcac-46ca-8c84-b9fe5cff9680', False, 'leb', 'LEB', ST_GeomFromText('POINT(-72.3131 43.6464)',4326), null, null, '2021-03-12T16:33:30.306147Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01144500'),
+('5fad6bf6-639c-47d3-bad4-b8b2f24917bc', False, 'wha', 'WHA', ST_GeomFromText('POINT(-72.4186 43.7142)',4326), null, null, '2021-03-12T16:33:30.306554Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01144000'),
+('eb70854b-a8f0-471f-bcc6-b5c9ad7fae1c', False, 'nwa', 'NWA', ST_GeomFromText('POINT(-72.4358 43.125)',4326), null, null, '2021-03-12T16:33:30.307032Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01154500'),
+('5a767c06-565d-4504-91bb-bbfa38938147', False, 'wls', 'WLS', ST_GeomFromText('POINT(-72.0425 44.1533)',4326), null, null, '2021-03-12T16:33:30.307805Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01138500'),
+('4a835069-bc18-4675-9cf0-371728c2d180', False, 'tlt', 'TLT', ST_GeomFromText('POINT(-71.5883 43.4417)',4326), null, null, '2021-03-12T16:33:30.308339Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01081000'),
+('8b9e5146-f636-43b0-994f-bb5409bf5d8f', False, 'brs', 'BRS', ST_GeomFromText('POINT(-71.7483 43.5667)',4326), null, null, '2021-03-12T16:33:30.308970Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01078000'),
+('6aa941d4-526f-4954-9cd9-4a9e1b594458', False, 'sgn', 'SGN', ST_GeomFromText('POINT(-71.5069 42.8572)',4326), null, null, '2021-03-12T16:33:30.311317Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01094000'),
+('a4de2bc1-81c0-4c1d-8f8b-b40f5ee7c032', False, 'wat', 'WAT', ST_GeomFromText('POINT(-73.0547 41.5572)',4326), null, null, '2021-03-12T16:33:30.312360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01208171'),
+('d8838f8d-d360-46cb-845c-c5897fb9ed45', False, 'ptb', 'PTB', ST_GeomFromText('POINT(-71.9597 42.8625)',4326), null, null, '2021-03-12T16:33:30.313720Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01082000'),
+('cb832c4d-e625-4c83-903a-9e9b1ffd5af4', False, 'put', 'PUT', ST_GeomFromText('POINT(-71.9131 41.9092)',4326), null, null, '2021-03-12T16:33:30.314821Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01125500'),
+('4f456122-f3cd-4c72-a124-a806b396ee8f', False, 'bar', 'BAR', ST_GeomFromText('POINT(-72.1144 42.3803)',4326), null, null, '2021-03-12T16:33:30.315635Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01173180'),
+('c8ddcc69-4834-4621-b48d-c64d52bfc07b', False, 'frn', 'FRN', ST_GeomFromText('POINT(-71.6533 43.4225)',4326), null, null, '2021-03-12T16:33:30.316468Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01081500'),
+('cff98845-d6eb-4b98-95fe-6ab991340ff4', False, 'ath', 'ATH', ST_GeomFromText('POINT(-72.2391 42.5928)',4326), null, null, '2021-03-12T16:33:30.326407Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('ed4d243e-5277-4707-8a17-443659d26e79', False, 'nbs', 'NBS', ST_GeomFromText('POINT(-73.0728 42.079)',4326), null, null, '2021-03-12T16:33:30.326825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01185500'),
+('877903b5-8bdb-4df7-9eea-5102159f4c8f', False, 'hol', 'HOL', ST_GeomFromText('POINT(-72.6089 42.1906)',4326), null, null, '2021-03-12T16:33:30.327073Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01172010'),
+('da8062b8-3875-480e-91ce-218794860ec0', False, 'hun', 'HUN', ST_GeomFromText('POINT(-72.8961 42.2372)',4326), null, null, '2021-03-12T16:33:30.327302Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01181000'),
+('3d939d27-2cee-43e9-919a-c490ebbd2db1', False, 'rvt', 'RVT', ST_GeomFromText('POINT(-73.0181 41.9628)',4326), null, null, '2021-03-12T16:33:30.327534Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01186000'),
+('530763d9-01dd-49fa-a8ac-a5af2f971294', False, 'dav', 'DAV', ST_GeomFromText('POINT(-71.7317 43.2517)',4326), null, null, '2021-03-12T16:33:30.327768Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01086000'),
+('27f192ae-4ba8-466e-b3d8-918c4a00acea', False, 'nbb', 'NBB', ST_GeomFromText('POINT(-70.9061 41.6242)',4326), null, null, '2021-03-12T16:33:30.329143Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('a49e5740-1db1-4564-a2d2-c7bcb4fa9c83', False, 'met', 'MET', ST_GeomFromText('POINT(-71.2089 42.7431)',4326), null, null, '2021-03-12T16:33:30.329856Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01100561'),
+('4d10bd0f-afb1-4cf1-8daa-b28757b58731', False, 'win', 'WIN', ST_GeomFromText('POINT(-72.1156 42.6825)',4326), null, null, '2021-03-12T16:33:30.330168Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01162500'),
+('3bba455b-4a3b-4d7e-987c-f6e89b9f4817', False, 'war', 'WAR', ST_GeomFromText('POINT(-72.1694 41.8436)',4326), null, null, '2021-03-12T16:33:30.330356Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01121000'),
+('0cd55a61-8fbe-4bdf-b2d3-ce828c5f79e0', False, 'erv', 'ERV', ST_GeomFromText('POINT(-72.4386 42.5975)',4326), null, null, '2021-03-12T16:33:30.330611Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01166500'),
+('a537df19-b26c-4c12-92fa-8e1b04914301', False, 'lin', 'LIN', ST_GeomFromText('POINT(-71.6603 44.0475)',4326), null, null, '2021-03-12T16:33:30.330865Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01074520'),
+('93358fb8-1751-493d-b7af-2dba3a50817f', False, 'swy', 'SWY', ST_GeomFromText('POINT(-72.3281 42.8717)',4326), null, null, '2021-03-12T16:33:30.331111Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01160350'),
+('28705721-77a2-413d-b189-51e727bb9614', False, 'sbd', 'SBD', ST_GeomFromText('POINT(-73.1 41.9031)',4326), null, null, '2021-03-12T16:33:30.331321Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('37de6afd-08b7-4bf5-a3fd-5a9ee0575664', False, 'ccc', 'CCC', ST_GeomFromText('POINT(-70.5903 41.7486)',4326), null, null, '2021-03-12T16:33:30.331519Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('a70157ad-7568-40ff-a5ff-3a9059dd3999', False, 'cha', 'CHA', ST_GeomFromText('POINT(-72.1067 41.8158)',4326), null, null, '2021-03-12T16:33:30.331689Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01120790'),
+('f5e0a98a-541f-42f9-8366-92939535ea02', False, 'clo', 'CLO', ST_GeomFromText('POINT(-71.3025 42.6367)',4326), null, null, '2021-03-12T16:33:30.331928Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01099500'),
+('57bcaa31-0d89-4041-9d33-471e606aa44f', False, 'cov', 'COV', ST_GeomFromText('POINT(-72.2661 41.7506)',4326), null, null, '2021-03-12T16:33:30.332167Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01119500'),
+('ce09a25f-3018-4bd4-9138-a36622bc7859', False, 'fen', 'FEN', ST_GeomFromText('POINT(-72.2433 41.8333)',4326), null, null, '2021-03-12T16:33:30.332412Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01121330'),
+('9db49d0c-7e1a-4ce5-97c1-6c5e3c068e3d', False, 'hav', 'HAV', ST_GeomFromText('POINT(-71.0831 42.7728)',4326), null, null, '2021-03-12T16:33:30.332648Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01100693'),
+('edfd48f9-27de-4cd7-80e5-7d7dc5560551', False, 'hin', 'HIN', ST_GeomFromText('POINT(-72.4867 42.7858)',4326), null, null, '2021-03-12T16:33:30.333017Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01161000'),
+('affd94dd-3d0f-4eee-ae6a-2d0fe36c7fd1', False, 'lon', 'LON', ST_GeomFromText('POINT(-72.7972 43.1568)',4326), null, null, '2021-03-12T16:33:30.333662Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('b762b187-d886-4edf-83e1-6a1a26996c0c', False, 'mil', 'MIL', ST_GeomFromText('POINT(-71.7656 42.1889)',4326), null, null, '2021-03-12T16:33:30.334198Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01109730'),
+('d0380d12-1265-49a8-8d68-78655951ca54', False, 'nbf', 'NBF', ST_GeomFromText('POINT(-72.0261 42.4278)',4326), null, null, '2021-03-12T16:33:30.335090Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('dcdafb50-2824-405a-8a66-c3569ea2e5ce', False, 'nuv', 'NUV', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.335341Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('31b1b10b-4eab-4769-ab4a-cdc4d698778b', False, 'uxb', 'UXB', ST_GeomFromText('POINT(-71.6169 42.0547)',4326), null, null, '2021-03-12T16:33:30.335511Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01111212'),
+('c8f24c59-6b40-482b-94ec-044b547a6b64', False, 'col', 'COL', ST_GeomFromText('POINT(-146.8339 61.2225)',4326), null, null, '2021-03-12T16:33:30.336345Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('cea35093-cf14-4565-ab5c-8be0c3ebccdc', False, 'pep', 'PEP', ST_GeomFromText('POINT(-71.5753 42.6672)',4326), null, null, '2021-03-12T16:33:30.336862Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01096500'),
+('b59a72c7-aac0-4872-a6a1-54328d167634', False, 'wbr', 'WBR', ST_GeomFromText('POINT(-72.7594 43.6222)',4326), null, null, '2021-03-12T16:33:30.337714Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01150900'),
+('4e7b8e3f-1df3-4e07-9baf-6ebec1bf0c57', False, 'wmt', 'WMT', ST_GeomFromText('POINT(-71.2153 42.5681)',4326), null, null, '2021-03-12T16:33:30.337970Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01100600'),
+('aae9d540-b8a7-416a-936a-b9145468b21e', False, 'pfp', 'PFP', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.338238Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('87ce5712-fd02-4f08-a1d0-0687360bdfcc', False, 'wol', 'WOL', ST_GeomFromText('POINT(-148.9061 60.4094)',4326), null, null, '2021-03-12T16:33:30.338826Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '15236895'),
+('abe0ba5f-6269-4809-b6a9-ca3ba93019fc', False, 'snd', 'SND', ST_GeomFromText('POINT(-70.5053 41.7719)',4326), null, null, '2021-03-12T16:33:30.339551Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('aa6847d8-b1a5-452b-9db8-c2bb8300c8cc', False, 'wng', 'WNG', ST_GeomFromText('POINT(-70.6614 41.6806)',4326), null, null, '2021-03-12T16:33:30.339975Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('f9b3916a-9991-4832-b56a-ceb4f12b52c2', False, 'was', 'WAS', ST_GeomFromText('POINT(-68.1572 46.7772)',4326), null, null, '2021-03-12T16:33:30.340389Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01017000'),
+('722c001d-8b7e-47d6-b953-851485df8100', False, 'ebn', 'EBN', ST_GeomFromText('POINT(-70.9786 42.2208)',4326), null, null, '2021-03-12T16:33:30.340561Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01105583'),
+('d998e68b-1a29-42e0-8158-14c979dd2507', False, 'wal', 'WAL', ST_GeomFromText('POINT(-72.8414 41.4494)',4326), null, null, '2021-03-12T16:33:30.340729Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01196500'),
+('2e286a65-85d0-4115-b298-98f325a06847', False, 'can', 'CAN', ST_GeomFromText('POINT(-71.1464 42.1544)',4326), null, null, '2021-03-12T16:33:30.340986Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01105500'),
+('53422e2b-0fe4-4c4e-8c1d-12a82e849ec9', False, 'wbf', 'WBF', ST_GeomFromText('POINT(-72.2642 42.1822)',4326), null, null, '2021-03-12T16:33:30.341146Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01176000'),
+('10a32c15-f8c1-4e47-9e49-bbe059d5c007', False, 'otr', 'OTR', ST_GeomFromText('POINT(-72.0414 42.5883)',4326), null, null, '2021-03-12T16:33:30.341378Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01163200'),
+('58c0dd1f-9042-4609-8ed7-2741c9198718', False, 'nhp', 'NHP', ST_GeomFromText('POINT(-72.6656 42.3189)',4326), null, null, '2021-03-12T16:33:30.341538Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01171500'),
+('ffcf2405-0279-47dc-9d67-515687eba5f6', False, 'sax', 'SAX', ST_GeomFromText('POINT(-71.3981 42.3253)',4326), null, null, '2021-03-12T16:33:30.341790Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01098530'),
+('1a02dbd5-7967-43cb-aa77-effb6aa209e7', False, 'edd', 'EDD', ST_GeomFromText('POINT(-68.6967 44.8267)',4326), null, null, '2021-03-12T16:33:30.341993Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01036390'),
+('a1e96fc0-0e78-4705-bb8e-6c46ad0b6d6a', False, 'fkt', 'FKT', ST_GeomFromText('POINT(-68.5958 47.2581)',4326), null, null, '2021-03-12T16:33:30.342165Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01014000'),
+('818e9a87-163a-4831-a0fd-a4001e0165e2', False, 'roc', 'ROC', ST_GeomFromText('POINT(-70.9742 43.2683)',4326), null, null, '2021-03-12T16:33:30.342328Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01072800'),
+('877eab1c-2669-4e27-aa2b-5f28f710eef2', False, 'lp1', 'LP1', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.342626Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('e5ac7c93-50af-43c3-b62a-dca3a6c2444c', False, 'bcn', 'BCN', ST_GeomFromText('POINT(-73.0631 41.4419)',4326), null, null, '2021-03-12T16:33:30.344272Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01208500'),
+('ae976bb1-dc1a-4ed7-93fb-6f58e4f854e0', False, 'gil', 'GIL', ST_GeomFromText('POINT(-72.2706 43.0392)',4326), null, null, '2021-03-12T16:33:30.344752Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01157000'),
+('759d8ecd-798e-4227-8911-8b168e6934c3', False, 'mal', 'MAL', ST_GeomFromText('POINT(-72.2192 42.9101)',4326), null, null, '2021-03-12T16:33:30.345014Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('057c05ee-d15c-4ed2-af84-a407617f545d', False, 'qbq', 'QBQ', ST_GeomFromText('POINT(-71.9561 42.0222)',4326), null, null, '2021-03-12T16:33:30.345190Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01124000'),
+('4f64435a-b798-4a80-bbce-53ea32981750', False, 'fpb', 'FPB', ST_GeomFromText('POINT(-71.4003 41.8008)',4326), null, null, '2021-03-12T16:33:30.345426Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('36bc37e0-eca1-4cf9-a27c-1d18109dc581', False, 'shb', 'SHB', ST_GeomFromText('POINT(-73.5344 41.0369)',4326), null, null, '2021-03-12T16:33:30.345981Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01209788'),
+('4eb76cd9-8191-4c11-a006-25a2765b6738', False, 'wfd', 'WFD', ST_GeomFromText('POINT(-71.5181 41.9994)',4326), null, null, '2021-03-12T16:33:30.346607Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('ca33744c-9ca9-430e-a10b-326e238ecd5b', False, 'mcy', 'MCY', ST_GeomFromText('POINT(-72.5744 42.5802)',4326), null, null, '2021-03-12T16:33:30.346812Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01170500'),
+('af134ab4-ed49-44e4-86ca-944542345210', False, 'ead', 'EAD', ST_GeomFromText('POINT(-73.1206 41.8364)',4326), null, null, '2021-03-12T16:33:30.347046Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01205650'),
+('e58e32cf-3c39-42af-a068-abb5c71db22c', False, 'for', 'FOR', ST_GeomFromText('POINT(-71.5631 41.9964)',4326), null, null, '2021-03-12T16:33:30.347274Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01111500'),
+('206e4912-6187-4ca0-b278-6ba8d0156664', False, 'gul', 'GUL', ST_GeomFromText('POINT(-145.4147 63.2764)',4326), null, null, '2021-03-12T16:33:30.347436Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '15478038'),
+('830af55a-4fbf-4556-acc6-162ed629cbf4', False, 'lp2', 'LP2', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.348171Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('0d4e5339-4141-43c9-acfb-deb3133633c0', False, 'cen', 'CEN', ST_GeomFromText('POINT(-71.4878 41.8589)',4326), null, null, '2021-03-12T16:33:30.349514Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01114500'),
+('69c2e36d-6a02-4aca-ad75-0702763479ad', False, 'pro', 'PRO', ST_GeomFromText('POINT(-71.4117 41.8339)',4326), null, null, '2021-03-12T16:33:30.349789Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01114000'),
+('eb844d9c-11d9-43ba-880a-1833a0b9fde5', False, 'roy', 'ROY', ST_GeomFromText('POINT(-72.1507 42.6297)',4326), null, null, '2021-03-12T16:33:30.349986Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01164000'),
+('1cec4fa0-84f1-40d0-8943-87c9ebc563b9', False, 'helnorth', 'HELNORTH', ST_GeomFromText('POINT(-38.2 66.4)',4326), null, null, '2021-03-12T16:33:30.350280Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('d57e939f-855d-4ec1-9014-8d9b30e7bf4c', False, 'nas', 'NAS', ST_GeomFromText('POINT(-71.45 42.7667)',4326), null, null, '2021-03-12T16:33:30.352348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('d0fcdb8a-7883-4cb0-a1db-430fe450e50e', False, 'cor', 'COR', ST_GeomFromText('POINT(-145.2714 60.6671)',4326), null, null, '2021-03-12T16:33:30.355924Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('1f5631fa-109f-4a38-957e-81d96259b96e', False, 'val', 'VAL', ST_GeomFromText('POINT(-146.1717 61.1772)',4326), null, null, '2021-03-12T16:33:30.356741Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('dc6efaeb-b255-4a78-8ccc-21863951f4f9', False, 'rob', 'ROB', ST_GeomFromText('POINT(-73.0334 41.9679)',4326), null, null, '2021-03-12T16:33:30.357431Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01186500'),
+('444b4886-4795-4747-a028-d96815c16503', False, 'wch', 'WCH', ST_GeomFromText('POINT(-72.0839 42.6842)',4326), null, null, '2021-03-12T16:33:30.357823Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01162000'),
+('a627f52e-033e-46cf-89cb-37e5388ffbe7', False, 'sid', 'SID', ST_GeomFromText('POINT(-69.6839 44.4722)',4326), null, null, '2021-03-12T16:33:30.358096Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01049265'),
+('9d2137c7-6b46-4ef8-9f12-8acb24c363ec', False, 'pas', 'PAS', ST_GeomFromText('POINT(-72.0397 44.3656)',4326), null, null, '2021-03-12T16:33:30.358353Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01135500'),
+('f37e1138-8ad7-4893-bf3f-9d6a0759ae62', False, 'wwa', 'WWA', ST_GeomFromText('POINT(-72.3331 42.2678)',4326), null, null, '2021-03-12T16:33:30.358903Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01175500'),
+('e803b8c8-f6c9-435b-965e-8db47572b8d5', False, 'ess', 'ESS', ST_GeomFromText('POINT(-72.3844 41.3514)',4326), null, null, '2021-03-12T16:33:30.359380Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01194750'),
+('e20eac2b-67fe-4d52-9877-a0513c5f685b', False, 'wts', 'WTS', ST_GeomFromText('POINT(-50.6704 67.0054)',4326), null, null, '2021-03-12T16:33:30.360176Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('b0190dc8-60af-4b8f-9ef4-17f464b38f5b', False, 'rcc', 'RCC', ST_GeomFromText('POINT(-71.302 42.4635)',4326), null, null, '2021-03-12T16:33:30.360564Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('8548004f-8044-48be-a44c-048fe074ec3f', False, 'hel', 'HEL', ST_GeomFromText('POINT(-38.0 66.4)',4326), null, null, '2021-03-12T16:33:30.360740Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('3e40ee6b-40a3-49d2-b0e3-14e5c98c3356', False, 'nhd', 'NHD', ST_GeomFromText('POINT(-72.3609 43.6044)',4326), null, null, '2021-03-12T16:33:30.361701Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01151400'),
+('2518f4e9-b3aa-403c-ba85-20ceaab7ef94', False, 'nli', 'NLI', ST_GeomFromText('POINT(-71.6725 44.0425)',4326), null, null, '2021-03-12T16:33:30.363361Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('fc79f6f9-13e3-416e-81d2-3978a5ea1d14', False, 'helsouth', 'HELSOUTH', ST_GeomFromText('POINT(-38.2 66.4)',4326), null, null, '2021-03-12T16:33:30.363677Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('b28f4eea-d72f-4336-ba86-65c793d25112', False, 'ing', 'ING', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.365596Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('6956c85c-34b9-413f-9ee9-daeaa097dffe', False, 'atlas-crrel', 'Atlas-CRREL', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.366614Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('f706775b-f8a7-4c15-9ab2-0f57d393cf4e', False, 'fit', 'FIT', ST_GeomFromText('POINT(-71.7886 42.5761)',4326), null, null, '2021-03-12T16:33:30.368990Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, '01094400'),
+('967aa515-bcdc-47b9-a649-ae78524a2387', False, 'crrelobllasertestsite', 'CRRELOblLaserTestSite', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:33:30.369186Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null),
+('9eea0cba-d470-4d37-8cb8-3f4f12f001b6', False, 'thl', 'THL', ST_GeomFromText('POINT(-69.2285 77.467)',4326), null, null, '2021-03-12T16:33:30.369842Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '7601b4d7-a2f2-45cc-99c8-55f61b35e6f3', '00000000-0000-0000-0000-000000000000', null, null);
 
 --INSERT INSTRUMENT STATUS--
 INSERT INTO instrument_status(id, instrument_id, status_id, "time")
@@ -77,693 +77,693 @@ where i.project_id = '5ce8a0b5-876a-49bc-9e06-05f49724b794';
 --Ignoring TIBR2-Tiber_Dam-Marias platform sensor site/instrument, already in instruments unique list
 
 --INSERT INSTRUMENTS--COUNT:685
-INSERT INTO instrument(id, deleted, slug, name, formula, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
+INSERT INTO instrument(id, deleted, slug, name, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
  VALUES 
-('f1329c17-e386-4f5b-b297-c817d5b6f73c', False, 'grnd-grand-rapids-james', 'GRND-Grand_Rapids-James', null, ST_GeomFromText('POINT(-98.3614 46.4422)',4326), null, null, '2021-03-12T16:16:13.744497Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06470475'),
-('076ffc60-3e9e-452f-88e1-6886fea4eca2', False, 'chmt-chester-marias', 'CHMT-Chester-Marias', null, ST_GeomFromText('POINT(-111.0805 48.306)',4326), null, null, '2021-03-12T16:16:13.744678Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06101500'),
-('e4aaf87e-d1ba-41c0-aad1-9c22634fd5f8', False, 'hsmt-bl-hauser-dam-missouri', 'HSMT-bl_Hauser_Dam-Missouri', null, ST_GeomFromText('POINT(-111.8888 46.7662)',4326), null, null, '2021-03-12T16:16:13.744889Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06065500'),
-('636bc081-089e-45af-bd14-8cda7985cbda', False, 'aune-auburn-little-nemaha', 'AUNE-Auburn-Little_Nemaha', null, ST_GeomFromText('POINT(-95.8128 40.3928)',4326), null, null, '2021-03-12T16:16:13.745059Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06811500'),
-('844e3566-76a5-4264-b6a0-5874b603ad70', False, 'cbco-ab-cheesman-dam-south-platte', 'CBCO-ab_Cheesman_Dam-South_Platte', null, ST_GeomFromText('POINT(-105.3097 39.1628)',4326), null, null, '2021-03-12T16:16:13.745240Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06700000'),
-('b0d5e262-76fe-4605-9fcc-debdd563e0e3', False, 'adak-ada-salt', 'ADAK-Ada-Salt', null, ST_GeomFromText('POINT(-97.8367 39.1392)',4326), null, null, '2021-03-12T16:16:13.745424Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06876700'),
-('b3a708f5-79c0-4765-9ed1-7be5795c3c49', False, 'cssd-sioux-falls-silver', 'CSSD-Sioux_Falls-Silver', null, ST_GeomFromText('POINT(-96.7314 43.6022)',4326), null, null, '2021-03-12T16:16:13.745493Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06482010'),
-('d9d5db16-8f00-46cb-8245-dc97696350fc', False, 'gdmt-gibson-dam-north-fork-sun', 'GDMT-Gibson_Dam-North_Fork_Sun', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.745626Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('1f12a4b7-01d6-45ba-82eb-7c15155174f3', False, 'akia-akron-big-sioux', 'AKIA-Akron-Big_Sioux', null, ST_GeomFromText('POINT(-96.562 42.837)',4326), null, null, '2021-03-12T16:16:13.745822Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06485500'),
-('816b67df-af41-4fe0-81da-12135e96f64b', False, 'ania-alton-floyd', 'ANIA-Alton-Floyd', null, ST_GeomFromText('POINT(-96.0008 42.9819)',4326), null, null, '2021-03-12T16:16:13.746016Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06600100'),
-('5a51b53f-6a3d-4e49-8f90-1f9f5026fe85', False, 'ldsd-spearfish-spearfish', 'LDSD-Spearfish-Spearfish', null, ST_GeomFromText('POINT(-103.8611 44.4825)',4326), null, null, '2021-03-12T16:16:13.746307Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06431500'),
-('604eef87-ef8e-499f-9c66-c8bbc1b0c1b2', False, 'anga-angostura-dam-cheyenne', 'ANGA-Angostura_Dam-Cheyenne', null, ST_GeomFromText('POINT(-103.4378 43.3431)',4326), null, null, '2021-03-12T16:16:13.746538Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06401000'),
-('3ec7053b-1d85-40d0-b496-b54ef3c478b8', False, 'anco-bl-antero-res-south-platte', 'ANCO-bl_Antero_Res-South_Platte', null, ST_GeomFromText('POINT(-105.9445 38.9939)',4326), null, null, '2021-03-12T16:16:13.746765Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('7fc5fceb-c9fe-47c5-be02-0928ca75a164', False, 'arsd-argyle-road-fall', 'ARSD-Argyle_Road-Fall', null, ST_GeomFromText('POINT(-103.4902 43.4715)',4326), null, null, '2021-03-12T16:16:13.746868Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('5a2aa39e-baed-4198-af05-67f50e67e765', False, 'arhm-archie-south-grand', 'ARHM-Archie-South_Grand', null, ST_GeomFromText('POINT(-94.3442 38.4916)',4326), null, null, '2021-03-12T16:16:13.747079Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921590'),
-('871e2686-6afd-4ee4-ae8d-9c68541ba946', False, 'ardk-arnold-smoky-hill', 'ARDK-Arnold-Smoky_Hill', null, ST_GeomFromText('POINT(-100.0222 38.8078)',4326), null, null, '2021-03-12T16:16:13.747338Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06861000'),
-('cf58a570-3175-441c-8adb-1ff4d310b396', False, 'awnd-arrowwood-res-james', 'AWND-Arrowwood_Res-James', null, ST_GeomFromText('POINT(-98.8529 47.2592)',4326), null, null, '2021-03-12T16:16:13.747598Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('8e2c8ef6-13aa-4886-a511-c31fd23de666', False, 'asne-ashland-platte', 'ASNE-Ashland-Platte', null, ST_GeomFromText('POINT(-96.3247 41.0622)',4326), null, null, '2021-03-12T16:16:13.747723Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06801000'),
-('dba269e2-f403-4c7e-a9dc-5efa6780a169', False, 'atlm-atlanta-long-branch', 'ATLM-Atlanta-Long_Branch', null, ST_GeomFromText('POINT(-92.4933 39.8973)',4326), null, null, '2021-03-12T16:16:13.747987Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06906150'),
-('fd904240-bfcc-435d-a86e-65bb13d4875f', False, 'assd-ashton-james', 'ASSD-Ashton-James', null, ST_GeomFromText('POINT(-98.4806 44.9983)',4326), null, null, '2021-03-12T16:16:13.748117Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06473000'),
-('bd64c58d-0417-4d38-b30d-7d4a1d220d0f', False, 'baco-bailey-nf-south-platte', 'BACO-Bailey-NF_South_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.748283Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('2f9699ce-d1bc-4309-8628-a4e5e8ef3c47', False, 'bark-barnes-little-blue', 'BARK-Barnes-Little_Blue', null, ST_GeomFromText('POINT(-96.8044 39.7258)',4326), null, null, '2021-03-12T16:16:13.748385Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06884400'),
-('e54f5d4a-2932-4fab-a7a1-1b1bf0bcebae', False, 'erco-evergreen-bear', 'ERCO-Evergreen-Bear', null, ST_GeomFromText('POINT(-105.3369 39.6332)',4326), null, null, '2021-03-12T16:16:13.748541Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06710385'),
-('8bb43003-5390-49c3-8f30-7c683794421f', False, 'brmt-barretts-beaverhead', 'BRMT-Barretts-Beaverhead', null, ST_GeomFromText('POINT(-112.7505 45.0995)',4326), null, null, '2021-03-12T16:16:13.748678Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06016000'),
-('14cf3fb3-b52e-46b0-b8d8-5886ce2e45ef', False, 'barn-barneston-big-blue', 'BARN-Barneston-Big_Blue', null, ST_GeomFromText('POINT(-96.5872 40.0447)',4326), null, null, '2021-03-12T16:16:13.748839Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06882000'),
-('c90c04d2-ed7f-4dba-943b-cda2d0c4cb53', False, 'byco-bailey-weather-nf-s-platte', 'BYCO-Bailey_Weather-NF_S_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.749019Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('8e658f14-4941-4fd8-9764-ba93e05d70fb', False, 'sfs-ab-buffalo-bill-dam-sf-shoshone', 'SFS-ab_Buffalo_Bill_Dam-SF_Shoshone', null, ST_GeomFromText('POINT(-109.2572 44.4192)',4326), null, null, '2021-03-12T16:16:13.749120Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06281000'),
-('aaceee4b-ad00-4040-be21-8afd1fcf0cfe', False, 'bccl-burl-well-canal-south-platte', 'BCCL-Burl_Well_Canal-South_Platte', null, ST_GeomFromText('POINT(-104.9664 39.7919)',4326), null, null, '2021-03-12T16:16:13.749286Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('fa75cfb7-f158-4461-b777-2d317fdd9731', False, 'becr-bear-creek-dam-bear', 'BECR-Bear_Creek_Dam-Bear', null, ST_GeomFromText('POINT(-105.1385 39.6529)',4326), null, null, '2021-03-12T16:16:13.749386Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('71681c0a-f6e3-4982-9400-e449360d3772', False, 'bhdy-bighorn-canal-bighorn', 'BHDY-Bighorn_Canal-Bighorn', null, ST_GeomFromText('POINT(-108.0169 43.9453)',4326), null, null, '2021-03-12T16:16:13.749538Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('07378d53-a7a6-4ef8-8ec9-3c86ff53f433', False, 'sznd-oakes-bear', 'SZND-Oakes-Bear', null, ST_GeomFromText('POINT(-98.0714 46.2253)',4326), null, null, '2021-03-12T16:16:13.749683Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06470800'),
-('4e5cb760-97bf-4344-bce2-dfd80d99299c', False, 'moco-ab-bear-cr-dam-bear', 'MOCO-Ab_Bear_Cr_Dam-Bear', null, ST_GeomFromText('POINT(-105.1731 39.6522)',4326), null, null, '2021-03-12T16:16:13.749852Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06710605'),
-('3e87a6f2-f587-4b65-9ee8-de36d1ac9bd4', False, 'pa11-papio-cr-dam-11-cunningham-lake', 'PA11-Papio_Cr_Dam_11-Cunningham_Lake', null, ST_GeomFromText('POINT(-96.0507 41.3384)',4326), null, null, '2021-03-12T16:16:13.749999Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('7c05506c-218c-483a-880c-0570a4aeee2f', False, 'bedi-bedford-east-fork-102', 'BEDI-Bedford-East_Fork_102', null, ST_GeomFromText('POINT(-94.7164 40.6606)',4326), null, null, '2021-03-12T16:16:13.750209Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06819185'),
-('f4da5b3e-2538-42ef-a260-9cffee1dc386', False, 'bnkn-benkleman-republican', 'BNKN-Benkleman-Republican', null, ST_GeomFromText('POINT(-163.6744 67.8761)',4326), null, null, '2021-03-12T16:16:13.750396Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06827500'),
-('653259a0-94b1-442a-9ecc-8b1138e7f945', False, 'bemo-bethany-e-fork-big', 'BEMO-Bethany-E_Fork_Big', null, ST_GeomFromText('POINT(-94.0262 40.2972)',4326), null, null, '2021-03-12T16:16:13.750585Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06897000'),
-('48fe10ab-721f-44c6-a94a-1564967c3b10', False, 'bfc-bl-belle-fourche-res-belle-f', 'BFC-bl_Belle_Fourche_Res-Belle_F', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.750760Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('d1b49e26-c36b-4bfb-95b9-95caea9f94a1', False, 'bfr-belle-fourche-res-belle-fourche', 'BFR-Belle_Fourche_Res-Belle_Fourche', null, ST_GeomFromText('POINT(-103.677 44.7304)',4326), null, null, '2021-03-12T16:16:13.750862Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06435000'),
-('673f60a3-b388-4a3c-98dd-0b32fbdc5fc4', False, 'tqwy-basin-bighorn', 'TQWY-Basin-Bighorn', null, ST_GeomFromText('POINT(-108.0356 44.3833)',4326), null, null, '2021-03-12T16:16:13.751029Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06274300'),
-('6bfb1665-430d-44f8-b897-1efd963264f5', False, 'yetl-yellowtail-dam-bighorn-lake', 'YETL-Yellowtail_Dam-Bighorn_Lake', null, ST_GeomFromText('POINT(-107.9582 45.3068)',4326), null, null, '2021-03-12T16:16:13.751206Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('5b2257ca-20d7-424f-8100-410b7433e9ba', False, 'bil-billings-yellowstone', 'BIL-Billings-Yellowstone', null, ST_GeomFromText('POINT(-108.4681 45.8002)',4326), null, null, '2021-03-12T16:16:13.751400Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06214500'),
-('8f9890cd-75a2-4ffc-a49f-61b003d4d68c', False, 'bis-bismarck-missouri', 'BIS-Bismarck-Missouri', null, ST_GeomFromText('POINT(-100.8214 46.8142)',4326), null, null, '2021-03-12T16:16:13.751681Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06342500'),
-('3f4c5eb6-0b5c-43a4-b9ea-5e6fff8464f1', False, 'blay-ab-bull-lake-bull-lake', 'BLAY-ab_Bull_Lake-Bull_Lake', null, ST_GeomFromText('POINT(-109.2026 43.1766)',4326), null, null, '2021-03-12T16:16:13.751900Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06224000'),
-('2b256b3a-106c-4fcb-95ed-cf9672df698e', False, 'blrk-blue-rapids-big-blue', 'BLRK-Blue_Rapids-Big_Blue', null, ST_GeomFromText('POINT(-96.6397 39.6872)',4326), null, null, '2021-03-12T16:16:13.752074Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06884700'),
-('17cbcc48-45f0-4d98-9994-2f164d5366fb', False, 'blrm-blairstown-big-creek', 'BLRM-Blairstown-Big_Creek', null, ST_GeomFromText('POINT(-93.9654 38.555)',4326), null, null, '2021-03-12T16:16:13.752266Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921720'),
-('3f9a06b6-9ed0-4f1c-be72-3fb3fc71e7cc', False, 'bula-bull-lake-dam-bull-lake', 'BULA-Bull_Lake_Dam-Bull_Lake', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.752418Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('27cab640-13a8-4f81-8a73-66ae6489eb1a', False, 'blcm-blue-lick-blackwater', 'BLCM-Blue_Lick-Blackwater', null, ST_GeomFromText('POINT(-93.1967 38.9922)',4326), null, null, '2021-03-12T16:16:13.752518Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06908000'),
-('522478b0-ef0d-4a71-8471-9685dd06f60c', False, 'bnmo-boonville-missouri', 'BNMO-Boonville-Missouri', null, ST_GeomFromText('POINT(-92.7453 38.9803)',4326), null, null, '2021-03-12T16:16:13.752699Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06909000'),
-('2028a54b-bcb6-43ea-8503-ba5648e97659', False, 'brsd-bruce-big-sioux', 'BRSD-Bruce-Big_Sioux', null, ST_GeomFromText('POINT(-96.8871 44.4676)',4326), null, null, '2021-03-12T16:16:13.753051Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479770'),
-('e8e2539a-0f6d-4458-af60-e35150c45a94', False, 'brnd-breien-cannonball', 'BRND-Breien-Cannonball', null, ST_GeomFromText('POINT(-100.9344 46.3761)',4326), null, null, '2021-03-12T16:16:13.753300Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06354000'),
-('9963f2e8-a844-463d-9e9c-cd9d0ca66fff', False, 'brgk-burlingame-dragoon', 'BRGK-Burlingame-Dragoon', null, ST_GeomFromText('POINT(-95.8381 38.7092)',4326), null, null, '2021-03-12T16:16:13.753581Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06911900'),
-('301e8240-a1a1-4ec0-8866-5b7fdc7841b4', False, 'bosd-brookings-big-sioux', 'BOSD-Brookings-Big_Sioux', null, ST_GeomFromText('POINT(-96.7492 44.1803)',4326), null, null, '2021-03-12T16:16:13.753739Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06480000'),
-('e80d63eb-5d97-47f5-9928-d6498a1f3e48', False, 'bvne-brownville-missouri', 'BVNE-Brownville-Missouri', null, ST_GeomFromText('POINT(-95.6533 40.3989)',4326), null, null, '2021-03-12T16:16:13.753952Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06810070'),
-('d3d790f9-3f07-4b24-9c3b-c415c2de41c6', False, 'bspm-blue-springs-lake-little-blue', 'BSPM-Blue_Springs_Lake-Little_Blue', null, ST_GeomFromText('POINT(-94.3355 39.0169)',4326), null, null, '2021-03-12T16:16:13.754155Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893885'),
-('c5c8786d-adcd-4dae-9ecd-32362a2df41a', False, 'blck-lenore-bull-lake', 'BLCK-Lenore-Bull_Lake', null, ST_GeomFromText('POINT(-110.3109 43.576)',4326), null, null, '2021-03-12T16:16:13.754288Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '13014300'),
-('41bdf558-da9a-4451-964e-1af3bf961c45', False, 'bunk-bunker-hill-smoky-hill', 'BUNK-Bunker_Hill-Smoky_Hill', null, ST_GeomFromText('POINT(-98.8544 38.7767)',4326), null, null, '2021-03-12T16:16:13.754502Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06864050'),
-('8c01f7aa-b411-4474-b804-12316f0a0fb9', False, 'brok-burr-oak-white-rock', 'BROK-Burr_Oak-White_Rock', null, ST_GeomFromText('POINT(-98.25 39.8992)',4326), null, null, '2021-03-12T16:16:13.754668Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06853800'),
-('7e724780-6b47-40a0-9162-97ea748055ae', False, 'bvuk-belvue-kansas', 'BVUK-Belvue-Kansas', null, ST_GeomFromText('POINT(-96.1472 39.1931)',4326), null, null, '2021-03-12T16:16:13.754826Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06888350'),
-('867bc4b2-fd0d-4c4a-989f-7c18f74b5835', False, 'boha-bowman-haley-dam-grand', 'BOHA-Bowman_Haley_Dam-Grand', null, ST_GeomFromText('POINT(-103.2461 45.9823)',4326), null, null, '2021-03-12T16:16:13.754941Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('6a738f79-a603-4dff-a041-b4f5be007cf4', False, 'bfwy-state-line-belle-fourche', 'BFWY-State_Line-Belle_Fourche', null, ST_GeomFromText('POINT(-104.0511 44.7489)',4326), null, null, '2021-03-12T16:16:13.755302Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06428500'),
-('2ada3609-8038-4874-a4be-58bbde433506', False, 'bzco-balzac-south-platte', 'BZCO-Balzac-South_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.755522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('febb6021-f55c-498a-93dd-52e21e88087c', False, 'cmbn-cambridge-republican', 'CMBN-Cambridge-Republican', null, ST_GeomFromText('POINT(-100.1436 40.2844)',4326), null, null, '2021-03-12T16:16:13.755646Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06843500'),
-('36e5d6a7-765d-4faa-b1b7-37a1aff71703', False, 'llnd-lake-lamoure-cottonwood', 'LLND-Lake_Lamoure-Cottonwood', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.755746Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('a16d890e-5a49-410d-bff9-de9683864898', False, 'cold-coldbrook-dam-cold-br', 'COLD-Coldbrook_Dam-Cold_Br', null, ST_GeomFromText('POINT(-103.4888 43.4542)',4326), null, null, '2021-03-12T16:16:13.755879Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06401950'),
-('c6b3e8a8-b605-4040-9718-f55d902eb494', False, 'fcsd-lk-francis-case-missouri', 'FCSD-Lk_Francis_Case-Missouri', null, ST_GeomFromText('POINT(-99.3269 43.8161)',4326), null, null, '2021-03-12T16:16:13.756126Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06442996'),
-('6da5d199-7369-450c-be0c-65179d395468', False, 'cdbk-cedar-bluffs-beaver', 'CDBK-Cedar_Bluffs-Beaver', null, ST_GeomFromText('POINT(-100.5597 39.985)',4326), null, null, '2021-03-12T16:16:13.756283Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06846500'),
-('f1ee263f-259c-452d-bb9f-7ebbd1a6d383', False, 'cott-cottonwood-dam-cottonwood-spr', 'COTT-Cottonwood_Dam-Cottonwood_Spr', null, ST_GeomFromText('POINT(-103.5666 43.4388)',4326), null, null, '2021-03-12T16:16:13.756430Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('35e480ae-9c24-41e8-8dc1-5bec61bbaea4', False, 'cdco-denver-cherry', 'CDCO-Denver-Cherry', null, ST_GeomFromText('POINT(-104.9994 39.7425)',4326), null, null, '2021-03-12T16:16:13.756559Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06713500'),
-('56a01993-9347-4822-85b2-772cc517fc77', False, 'bubi-buffalo-bill-dam-shoshone', 'BUBI-Buffalo_Bill_Dam-Shoshone', null, ST_GeomFromText('POINT(-109.1843 44.5014)',4326), null, null, '2021-03-12T16:16:13.756796Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('0cec77e3-51cd-4b29-ad88-937258c3a5ce', False, 'chfi-chatfield-dam-south-platte', 'CHFI-Chatfield_Dam-South_Platte', null, ST_GeomFromText('POINT(-105.0621 39.5587)',4326), null, null, '2021-03-12T16:16:13.756909Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('64e1124d-6c14-4ac0-9855-3e1fa4eb56b5', False, 'chco-bl-cherry-creek-dam-cherry', 'CHCO-bl_Cherry_Creek_Dam-Cherry', null, ST_GeomFromText('POINT(-104.8625 39.6536)',4326), null, null, '2021-03-12T16:16:13.757157Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06713000'),
-('9d6a8700-86e6-4767-a691-0fea65a61460', False, 'chpk-chapman-chapman', 'CHPK-Chapman-Chapman', null, ST_GeomFromText('POINT(-97.04 39.0311)',4326), null, null, '2021-03-12T16:16:13.757333Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06878000'),
-('0a89f0be-30d3-4303-9094-be797a483d2c', False, 'chma-cheesman-dam-south-platte', 'CHMA-Cheesman_Dam-South_Platte', null, ST_GeomFromText('POINT(-105.2721 39.2087)',4326), null, null, '2021-03-12T16:16:13.757470Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('5e9af63f-923d-4f4e-8e6f-5a856f29f46d', False, 'chti-chariton-chariton', 'CHTI-Chariton-Chariton', null, ST_GeomFromText('POINT(-93.2596 40.9519)',4326), null, null, '2021-03-12T16:16:13.757576Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06903400'),
-('08c967c4-729b-4ecd-b718-afce30521d89', False, 'chmo-chillicothe-grand', 'CHMO-Chillicothe-Grand', null, ST_GeomFromText('POINT(-93.5586 39.7558)',4326), null, null, '2021-03-12T16:16:13.757792Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06899680'),
-('eb187a4c-b817-4803-a511-f9473aeeb0d1', False, 'clzm-clinton-south-grand', 'CLZM-Clinton-South_Grand', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.757967Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('ca084b28-7035-4b3b-940f-4d722e3635cf', False, 'clmt-culbertson-missouri', 'CLMT-Culbertson-Missouri', null, ST_GeomFromText('POINT(-104.4733 48.1235)',4326), null, null, '2021-03-12T16:16:13.758105Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06185500'),
-('8665a3dc-9dff-47f0-a696-b38107484e16', False, 'clin-clinton-lake-wakarusa', 'CLIN-Clinton_Lake-Wakarusa', null, ST_GeomFromText('POINT(-95.3323 38.931)',4326), null, null, '2021-03-12T16:16:13.758319Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06891478'),
-('7d026a9b-8e17-415c-812e-b825593b75ad', False, 'clca-clark-canyon-dam-red-rock', 'CLCA-Clark_Canyon_Dam-Red_Rock', null, ST_GeomFromText('POINT(-112.8589 45.0007)',4326), null, null, '2021-03-12T16:16:13.758452Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('819f2722-fd0a-477b-af79-9ae6b1e4181b', False, 'nork-norton-prairie-dog', 'NORK-Norton-Prairie_Dog', null, ST_GeomFromText('POINT(-100.1003 39.7697)',4326), null, null, '2021-03-12T16:16:13.758706Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06847900'),
-('addde4ed-facb-4ded-98b3-9318e21dd462', False, 'mrkr-cameron-madison', 'MRKR-Cameron-Madison', null, ST_GeomFromText('POINT(-111.5809 44.8887)',4326), null, null, '2021-03-12T16:16:13.758904Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06038800'),
-('0d215cae-b9ea-4377-b00c-54bf013457d1', False, 'cpmo-caplinger-mills-sac', 'CPMO-Caplinger_Mills-Sac', null, ST_GeomFromText('POINT(-93.8032 37.8698)',4326), null, null, '2021-03-12T16:16:13.759105Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06919900'),
-('54a4bbb3-eb76-4c6c-a800-21d0ac188321', False, 'cnkk-concordia-republican', 'CNKK-Concordia-Republican', null, ST_GeomFromText('POINT(-97.6581 39.5886)',4326), null, null, '2021-03-12T16:16:13.759252Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06856000'),
-('134be154-4b5f-4e18-9af8-a6b3fb03d9ce', False, 'cncl-conifer-turkey', 'CNCL-Conifer-Turkey', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.759376Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('bf703aa1-d94a-46ce-98a3-659edb7b00c8', False, 'csmt-corwin-springs-yellowstone', 'CSMT-Corwin_Springs-Yellowstone', null, ST_GeomFromText('POINT(-110.7937 45.1121)',4326), null, null, '2021-03-12T16:16:13.759546Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06191500'),
-('f40994dd-f746-4111-a179-d7bba6c08c38', False, 'paco-parker-cherry', 'PACO-Parker-Cherry', null, ST_GeomFromText('POINT(-104.7792 39.5192)',4326), null, null, '2021-03-12T16:16:13.759819Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '393109104464500'),
-('7415ff9c-b60c-4b67-885c-75c7c7e5b563', False, 'cawy-casper-north-platte', 'CAWY-Casper-North_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.760040Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('97ad958d-754d-44d6-a6c1-1e1ec9af1fcd', False, 'wrch-crowheart-wind', 'WRCH-Crowheart-Wind', null, ST_GeomFromText('POINT(-110.1787 43.8071)',4326), null, null, '2021-03-12T16:16:13.760300Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '13011820'),
-('ecde649c-cde0-4dad-8106-6c61fc5adecd', False, 'ceia-correctionville-little-sioux', 'CEIA-Correctionville-Little_Sioux', null, ST_GeomFromText('POINT(-95.7926 42.4822)',4326), null, null, '2021-03-12T16:16:13.760521Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06606600'),
-('bc4addb5-9202-49ec-bc47-71dd4a83d0d9', False, 'crtn-crete-big-blue', 'CRTN-Crete-Big_Blue', null, ST_GeomFromText('POINT(-96.9606 40.5967)',4326), null, null, '2021-03-12T16:16:13.760796Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06881000'),
-('b78217b8-97e5-4cde-a1a0-0fea2a9faed5', False, 'txsd-cash-south-fork-grand', 'TXSD-Cash-South_Fork_Grand', null, ST_GeomFromText('POINT(-102.6429 45.6487)',4326), null, null, '2021-03-12T16:16:13.761013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06356500'),
-('de7b24a9-eca4-4a6d-88f0-592fbb2e3e69', False, 'casd-castlewood-big-sioux', 'CASD-Castlewood-Big_Sioux', null, ST_GeomFromText('POINT(-97.0443 44.7316)',4326), null, null, '2021-03-12T16:16:13.761202Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479525'),
-('0b9aa79f-376d-4546-9960-9eaa97aa1cb6', False, 'ctnd-cartwright-yellowstone', 'CTND-Cartwright-Yellowstone', null, ST_GeomFromText('POINT(-103.9664 47.8619)',4326), null, null, '2021-03-12T16:16:13.761398Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06329610'),
-('dcdf927d-463a-47d4-8eea-401e10b2c0cf', False, 'culn-culbertson-frenchman', 'CULN-Culbertson-Frenchman', null, ST_GeomFromText('POINT(-100.8778 40.2347)',4326), null, null, '2021-03-12T16:16:13.761559Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06835500'),
-('73ef7260-506c-46d9-82eb-aedab7fdcb72', False, 'cafe-canyon-ferry-dam-missouri', 'CAFE-Canyon_Ferry_Dam-Missouri', null, ST_GeomFromText('POINT(-111.7286 46.6477)',4326), null, null, '2021-03-12T16:16:13.761705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('edba772f-26ec-48b0-9504-5b23205cd1dd', False, 'tfsd-canyon-lake-rapid', 'TFSD-Canyon_Lake-Rapid', null, ST_GeomFromText('POINT(-103.3114 44.0528)',4326), null, null, '2021-03-12T16:16:13.761859Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06412500'),
-('331ed6b2-f538-4189-9624-0487e1e5af3f', False, 'drmt-craig-dearborn', 'DRMT-Craig-Dearborn', null, ST_GeomFromText('POINT(-112.0959 47.199)',4326), null, null, '2021-03-12T16:16:13.762175Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06073500'),
-('e44a6ec5-2a8e-446f-be19-2fe7722a2eee', False, 'dene-decatur-missouri', 'DENE-Decatur-Missouri', null, ST_GeomFromText('POINT(-96.2414 42.0072)',4326), null, null, '2021-03-12T16:16:13.762371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06601200'),
-('ef7849ee-edac-4eac-91fe-95b11102b077', False, 'ddvm-dadeville-sac', 'DDVM-Dadeville-Sac', null, ST_GeomFromText('POINT(-93.6849 37.4431)',4326), null, null, '2021-03-12T16:16:13.762845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918440'),
-('20643ffd-8466-4827-9618-668fdcffe4cd', False, 'delk-delia-soldier', 'DELK-Delia-Soldier', null, ST_GeomFromText('POINT(-95.8883 39.2383)',4326), null, null, '2021-03-12T16:16:13.763025Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06889200'),
-('32c32a5c-d82c-49f0-bb4b-75afef200828', False, 'den-denver-south-platte', 'DEN-Denver-South_Platte', null, ST_GeomFromText('POINT(-105.004 39.7594)',4326), null, null, '2021-03-12T16:16:13.763188Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('ce1aaeb8-7414-42c7-8987-b61ee2a1cd51', False, 'drsd-dell-rapids-big-sioux', 'DRSD-Dell_Rapids-Big_Sioux', null, ST_GeomFromText('POINT(-96.7453 43.7905)',4326), null, null, '2021-03-12T16:16:13.763314Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06481000'),
-('cd487959-460e-4a81-a958-3fb70f2438b1', False, 'dimt-dillon-beaverhead', 'DIMT-Dillon-Beaverhead', null, ST_GeomFromText('POINT(-112.6553 45.2184)',4326), null, null, '2021-03-12T16:16:13.763753Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06017000'),
-('fad6a302-37ac-4c27-88c5-ee3a7765466e', False, 'dcby-burris-dinwoody', 'DCBY-Burris-Dinwoody', null, ST_GeomFromText('POINT(-109.4086 43.3461)',4326), null, null, '2021-03-12T16:16:13.763895Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06221400'),
-('987d480e-818e-47d5-a2e7-d4c4727e9db0', False, 'cecl-bl-cheesman-dam-south-platte', 'CECL-bl_Cheesman_Dam-South_Platte', null, ST_GeomFromText('POINT(-105.2672 39.0917)',4326), null, null, '2021-03-12T16:16:13.764039Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('3c1f4e0c-cb26-4843-be7c-a3383e437043', False, 'dmrk-damar-south-fork-solomon', 'DMRK-Damar-South_Fork_Solomon', null, ST_GeomFromText('POINT(-99.5797 39.3767)',4326), null, null, '2021-03-12T16:16:13.764215Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06873000'),
-('8592a90d-976a-4a90-8354-309edb6d17a0', False, 'mdmt-nr-dodson-milk', 'MDMT-nr_Dodson-Milk', null, ST_GeomFromText('POINT(-108.2941 48.4028)',4326), null, null, '2021-03-12T16:16:13.764394Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06155030'),
-('21764380-6f97-483a-ac16-72da9b169b3a', False, 'dind-dickinson-dam-heart', 'DIND-Dickinson_Dam-Heart', null, ST_GeomFromText('POINT(-102.8269 46.8697)',4326), null, null, '2021-03-12T16:16:13.764557Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('d3b12d54-682e-4ace-a37e-e1dc5fcd6b5e', False, 'decl-derby-clear', 'DECL-Derby-Clear', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.764721Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('334a6942-bc04-4bb6-b1b8-4c3c26c5466f', False, 'dfsd-deerfield-dam-castle', 'DFSD-Deerfield_Dam-Castle', null, ST_GeomFromText('POINT(-103.7858 44.0291)',4326), null, null, '2021-03-12T16:16:13.764891Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('d076feb3-7840-4224-ab0d-9412d13e8754', False, 'drgn-dorchester-west-fork-big-blue', 'DRGN-Dorchester-West_Fork_Big_Blue', null, ST_GeomFromText('POINT(-97.1772 40.7311)',4326), null, null, '2021-03-12T16:16:13.765162Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06880800'),
-('ce44554a-7816-44f8-acfa-09122454580c', False, 'deso-desoto-kansas', 'DESO-DeSoto-Kansas', null, ST_GeomFromText('POINT(-94.9647 38.983)',4326), null, null, '2021-03-12T16:16:13.765303Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06892350'),
-('cfadae4a-5a6c-4335-a534-be6ed57393a5', False, 'dvsi-davis-city-thompson', 'DVSI-Davis_City-Thompson', null, ST_GeomFromText('POINT(-93.8081 40.6403)',4326), null, null, '2021-03-12T16:16:13.765518Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06898000'),
-('bcc65d4f-87d2-4697-9144-f3e06ed0dd08', False, 'dwen-deweese-little-blue', 'DWEN-Deweese-Little_Blue', null, ST_GeomFromText('POINT(-98.0669 40.3325)',4326), null, null, '2021-03-12T16:16:13.765691Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06883000'),
-('6eb662e8-7434-4b12-aa35-745a595af96e', False, 'stl-st-louis-mississippi', 'STL-St_Louis-Mississippi', null, ST_GeomFromText('POINT(-90.1798 38.629)',4326), null, null, '2021-03-12T16:16:13.765933Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '7010000'),
-('df529778-914d-477b-aa50-2c75f759c2be', False, 'edgk-edgerton-big-bull', 'EDGK-Edgerton-Big_Bull', null, ST_GeomFromText('POINT(-94.9769 38.7533)',4326), null, null, '2021-03-12T16:16:13.766149Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06914950'),
-('0161085d-5636-4599-b146-35d022a873bd', False, 'cred-edgemont-cheyenne', 'CRED-Edgemont-Cheyenne', null, ST_GeomFromText('POINT(-103.8206 43.3056)',4326), null, null, '2021-03-12T16:16:13.766344Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06395000'),
-('247d6542-65e0-4c70-9e23-875cb97a3467', False, 'cebl-cedar-bluff-res-smoky-hill', 'CEBL-Cedar_Bluff_Res-Smoky_Hill', null, ST_GeomFromText('POINT(-99.7222 38.7846)',4326), null, null, '2021-03-12T16:16:13.766574Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06861500'),
-('434d91d3-1df5-4e14-98a7-4a9f320113f6', False, 'ewks-ellsworth-smoky-hill', 'EWKS-Ellsworth-Smoky_Hill', null, ST_GeomFromText('POINT(-98.2333 38.7267)',4326), null, null, '2021-03-12T16:16:13.766715Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06864500'),
-('385662f1-41db-4301-8d2c-53531b4a3331', False, 'egco-englewood-south-platte', 'EGCO-Englewood-South_Platte', null, ST_GeomFromText('POINT(-105.0036 39.665)',4326), null, null, '2021-03-12T16:16:13.766867Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06711565'),
-('4926b290-7f76-493e-a420-8349cb3286ba', False, 'epks-enterprise-smoky-hill', 'EPKS-Enterprise-Smoky_Hill', null, ST_GeomFromText('POINT(-97.1175 38.9064)',4326), null, null, '2021-03-12T16:16:13.767007Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06877600'),
-('ea37c6ab-07a5-47b1-93ca-96c09949dd4a', False, 'enco-union-south-platte', 'ENCO-Union-South_Platte', null, ST_GeomFromText('POINT(-105.0144 39.6325)',4326), null, null, '2021-03-12T16:16:13.767159Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06710247'),
-('a960f057-006e-4c78-9fcb-5a49756e9f2e', False, 'frsd-frederick-elm', 'FRSD-Frederick-Elm', null, ST_GeomFromText('POINT(-98.7017 45.8375)',4326), null, null, '2021-03-12T16:16:13.767360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06471065'),
-('0a784d80-065b-4228-96f7-06cc47e538b7', False, 'sc14-salt-cr-dam-14-pawnee-lake', 'SC14-Salt_Cr_Dam_14-Pawnee_Lake', null, ST_GeomFromText('POINT(-96.8668 40.8393)',4326), null, null, '2021-03-12T16:16:13.767539Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('b7e22fd7-05bf-4a57-8568-12b5f6e028e6', False, 'ecmt-eastern-crossing-milk', 'ECMT-Eastern_Crossing-Milk', null, ST_GeomFromText('POINT(-110.4218 48.9748)',4326), null, null, '2021-03-12T16:16:13.767771Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06135000'),
-('b22ed1f7-2625-49ec-8b08-675a8352bbab', False, 'tane-ewing-elkhorn', 'TANE-Ewing-Elkhorn', null, ST_GeomFromText('POINT(-98.3394 42.2684)',4326), null, null, '2021-03-12T16:16:13.767989Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06797500'),
-('83b5dd58-f2ca-4099-a6b2-170e60f492e7', False, 'tbsd-farmingdale-rapid', 'TBSD-Farmingdale-Rapid', null, ST_GeomFromText('POINT(-102.8535 43.9421)',4326), null, null, '2021-03-12T16:16:13.768164Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06421500'),
-('990123ba-904d-43cc-8ef6-40bbd2220e06', False, 'tcco-rocky-mtn-ars-first', 'TCCO-Rocky_Mtn_Ars-First', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.768331Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('0e27bd8a-cda6-4c81-94bd-17088a988b1a', False, 'fkfk-frankfort-black-vermillion', 'FKFK-Frankfort-Black_Vermillion', null, ST_GeomFromText('POINT(-96.4425 39.6819)',4326), null, null, '2021-03-12T16:16:13.768492Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06885500'),
-('86d330ef-d69c-48cc-b685-a3868cd5a159', False, 'flwy-fort-laramie-laramie', 'FLWY-Fort_Laramie-Laramie', null, ST_GeomFromText('POINT(-104.5378 42.2006)',4326), null, null, '2021-03-12T16:16:13.768661Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06670500'),
-('1b76f4fc-c3b5-4bd0-b9a8-ac057c5ee32a', False, 'flsd-florence-big-sioux', 'FLSD-Florence-Big_Sioux', null, ST_GeomFromText('POINT(-97.1858 45.1808)',4326), null, null, '2021-03-12T16:16:13.768877Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479215'),
-('7f794ee3-9f60-47fb-b2af-9c48d07c7552', False, 'ftco-fort-morgan-south-platte', 'FTCO-Fort_Morgan-South_Platte', null, ST_GeomFromText('POINT(-103.8012 40.2684)',4326), null, null, '2021-03-12T16:16:13.769110Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06759500'),
-('a5fa91b1-645e-4cf9-8c98-62f2e66f7d3a', False, 'ftsd-forestburg-james', 'FTSD-Forestburg-James', null, ST_GeomFromText('POINT(-98.0706 43.9739)',4326), null, null, '2021-03-12T16:16:13.769263Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06477000'),
-('25c2c657-cce3-41b2-9058-915cd88c1350', False, 'fpmt-blw-ft-peck-res-missouri', 'FPMT-Blw_Ft_Peck_Res-Missouri', null, ST_GeomFromText('POINT(-106.3563 48.0444)',4326), null, null, '2021-03-12T16:16:13.769445Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06132000'),
-('2f7c6724-559d-4737-8be7-89c7efb85914', False, 'fpsd-fort-pierre-bad', 'FPSD-Fort_Pierre-Bad', null, ST_GeomFromText('POINT(-100.3839 44.3267)',4326), null, null, '2021-03-12T16:16:13.769631Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06441500'),
-('5c5d07ab-1877-454d-a832-9434dc099695', False, 'frbn-fairbury-little-blue', 'FRBN-Fairbury-Little_Blue', null, ST_GeomFromText('POINT(-97.2007 40.1465)',4326), null, null, '2021-03-12T16:16:13.769902Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06884000'),
-('df91d7b5-d1fa-491a-952a-3f2f3cccbde2', False, 'frcl-franktown-cherry', 'FRCL-Franktown-Cherry', null, ST_GeomFromText('POINT(-104.7628 39.3558)',4326), null, null, '2021-03-12T16:16:13.770052Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06712000'),
-('71005ded-a786-4f11-8ec7-d095cfa585bd', False, 'fisd-pierre-missouri-farm-island', 'FISD-Pierre-Missouri-Farm_Island', null, ST_GeomFromText('POINT(-100.265 44.3342)',4326), null, null, '2021-03-12T16:16:13.770272Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06441595'),
-('fdfdb20b-0abb-47cc-afbb-389082614863', False, 'ftnk-fulton-little-osage', 'FTNK-Fulton-Little_Osage', null, ST_GeomFromText('POINT(-94.7039 38.0089)',4326), null, null, '2021-03-12T16:16:13.770429Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06917000'),
-('b299f3b3-98b0-47ed-be0b-d8c3e3e068d6', False, 'fri-fort-riley-kansas', 'FRI-Fort_Riley-Kansas', null, ST_GeomFromText('POINT(-96.7661 39.0617)',4326), null, null, '2021-03-12T16:16:13.770634Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06879100'),
-('9451dc72-2ef0-4a37-8083-90cc1b49a4c5', False, 'gcnd-grace-city-james', 'GCND-Grace_City-James', null, ST_GeomFromText('POINT(-98.8625 47.5581)',4326), null, null, '2021-03-12T16:16:13.770823Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06468170'),
-('597cd3d1-8a27-4d87-94b2-12ff6d58258b', False, 'gdek-glade-north-fork-solomon', 'GDEK-Glade-North_Fork_Solomon', null, ST_GeomFromText('POINT(-99.3092 39.6731)',4326), null, null, '2021-03-12T16:16:13.771043Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06871000'),
-('5f681c8a-abf0-4d37-bd15-ad758f05f14e', False, 'glnk-bl-glen-elder-solomon', 'GLNK-bl_Glen_Elder-Solomon', null, ST_GeomFromText('POINT(-98.2833 39.4739)',4326), null, null, '2021-03-12T16:16:13.771205Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06875900'),
-('08671a17-680a-439c-8f66-25b222b2bad2', False, 'gfmo-greenfield-turnback', 'GFMO-Greenfield-Turnback', null, ST_GeomFromText('POINT(-93.802 37.4024)',4326), null, null, '2021-03-12T16:16:13.771346Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918460'),
-('459c4e9c-9c0e-41c4-afc3-38089af62325', False, 'gtco-georgetown-clear', 'GTCO-Georgetown-Clear', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.771492Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('1971a820-fc80-4672-b616-d86a29cfcafb', False, 'glwy-bl-glendo-dam-north-platte', 'GLWY-bl_Glendo_Dam-North_Platte', null, ST_GeomFromText('POINT(-105.9472 42.4569)',4326), null, null, '2021-03-12T16:16:13.771656Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('d16d9696-b54e-4e7f-a70a-d885c3385e2f', False, 'glcl-golden-clear', 'GLCL-Golden-Clear', null, ST_GeomFromText('POINT(-105.2347 39.7531)',4326), null, null, '2021-03-12T16:16:13.771828Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06719505'),
-('34a3d5b5-80f6-44a2-a58e-982162ea7070', False, 'glel-waconda-lake-solomon', 'GLEL-Waconda_Lake-Solomon', null, ST_GeomFromText('POINT(-98.314 39.4857)',4326), null, null, '2021-03-12T16:16:13.772024Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('3ca83c12-fe17-423e-b41c-40326d5c41f5', False, 'gvmt-glendive-yellowstone', 'GVMT-Glendive-Yellowstone', null, ST_GeomFromText('POINT(-104.7184 47.1059)',4326), null, null, '2021-03-12T16:16:13.772204Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06327500'),
-('efbbf87e-707e-467c-935a-cff89351b325', False, 'grwy-glenrock-north-platte', 'GRWY-Glenrock-North_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.772376Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('119eae7a-ea0c-41ee-a9fc-6e64979e0b15', False, 'hebu-heart-butte-dam-heart', 'HEBU-Heart_Butte_Dam-Heart', null, ST_GeomFromText('POINT(-101.8101 46.5979)',4326), null, null, '2021-03-12T16:16:13.772546Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('dbc80028-a537-446e-8d38-3c602e662b67', False, 'glmo-glasgow-missouri', 'GLMO-Glasgow-Missouri', null, ST_GeomFromText('POINT(-92.8486 39.2222)',4326), null, null, '2021-03-12T16:16:13.772870Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06906500'),
-('c5da0351-b00b-4999-938b-95c929ab7d18', False, 'glmt-glen-big-hole', 'GLMT-Glen-Big_Hole', null, ST_GeomFromText('POINT(-112.5561 45.4401)',4326), null, null, '2021-03-12T16:16:13.773092Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06026210'),
-('a154ccac-5692-4cb6-a74d-7238d12c9029', False, 'tysd-white-butte-nf-grand', 'TYSD-White_Butte-NF_Grand', null, ST_GeomFromText('POINT(-102.3619 45.8022)',4326), null, null, '2021-03-12T16:16:13.773259Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06355500'),
-('8a277f3a-e8ab-4810-9867-e5eea7ca3e74', False, 'grne-grand-island-platte', 'GRNE-Grand_Island-Platte', null, ST_GeomFromText('POINT(-98.2817 40.8744)',4326), null, null, '2021-03-12T16:16:13.773453Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06770500'),
-('6689b893-bc87-48cd-a942-6350440327e2', False, 'grcl-grant-nf-south-platte', 'GRCL-Grant-NF_South_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.773651Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('fa2c73bd-f6c5-4783-932a-241c196911db', False, 'gwne-greenwood-salt', 'GWNE-Greenwood-Salt', null, ST_GeomFromText('POINT(-96.4544 40.9656)',4326), null, null, '2021-03-12T16:16:13.773857Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803555'),
-('a7fc47bd-35e5-4ab7-b855-1916bd92dd2d', False, 'gycl-greeley-cache-la-poudre', 'GYCL-Greeley-Cache_La_Poudre', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.774066Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('4a01d9fe-263a-4d29-9bef-9e318aea668b', False, 'gdkn-guide-rock-republican', 'GDKN-Guide_Rock-Republican', null, ST_GeomFromText('POINT(-98.3308 40.0642)',4326), null, null, '2021-03-12T16:16:13.774226Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06853020'),
-('5b1c35c6-e9dc-4c1f-9634-072be4a300c2', False, 'mbwy-sinclair-medicine-bow', 'MBWY-Sinclair-Medicine_Bow', null, ST_GeomFromText('POINT(-106.5125 42.0097)',4326), null, null, '2021-03-12T16:16:13.774418Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06635000'),
-('fd0d85c3-62f2-4c66-b0e3-dd536156d9b2', False, 'hacl-ab-11-mile-res-south-platte', 'HACL-ab_11-Mile_Res-South_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.774561Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('c8b1df5e-f24e-446b-a364-737579c91299', False, 'fdmt-fresno-dam-milk', 'FDMT-Fresno_Dam-Milk', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.774771Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('706bf886-ab29-443c-b95a-0d0d6384e3a5', False, 'hebn-hebgen-dam-madison', 'HEBN-Hebgen_Dam-Madison', null, ST_GeomFromText('POINT(-111.3388 44.8664)',4326), null, null, '2021-03-12T16:16:13.775028Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06038500'),
-('1ec2be89-d47f-42ac-a7f8-1a23876fb228', False, 'sc09-salt-cr-dam-9-stagecoach-lake', 'SC09-Salt_Cr_Dam_9-Stagecoach_Lake', null, ST_GeomFromText('POINT(-96.6363 40.6043)',4326), null, null, '2021-03-12T16:16:13.775142Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('ba756f6a-5db2-4dee-bbec-094a35c2ac6e', False, 'harn-hardy-republican', 'HARN-Hardy-Republican', null, ST_GeomFromText('POINT(-97.9322 39.9925)',4326), null, null, '2021-03-12T16:16:13.775303Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06853500'),
-('3321eb20-2f0f-4c37-86ae-7d53fa5a5e00', False, 'hewy-state-line-north-platte', 'HEWY-State_Line-North_Platte', null, ST_GeomFromText('POINT(-104.0528 41.9886)',4326), null, null, '2021-03-12T16:16:13.775552Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06674500'),
-('a277254c-0fbd-4cdf-bddb-da8174ef1bc7', False, 'hils-hillsdale-lake-big-bull', 'HILS-Hillsdale_Lake-Big_Bull', null, ST_GeomFromText('POINT(-94.9026 38.6539)',4326), null, null, '2021-03-12T16:16:13.775744Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06914995'),
-('6dfa5493-0187-499e-a2f2-595215494cff', False, 'hilk-hillsdale-bl-big-bull', 'HILK-Hillsdale-bl_Big_Bull', null, ST_GeomFromText('POINT(-94.8961 38.6564)',4326), null, null, '2021-03-12T16:16:13.775848Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06915000'),
-('67fd3251-69be-4747-9dc9-774e3026457a', False, 'hncl-henderson-south-platte', 'HNCL-Henderson-South_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.775974Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('7d4ee274-b078-491c-bfb3-f3edb9b92521', False, 'hcia-hancock-west-nishnabotna', 'HCIA-Hancock-West_Nishnabotna', null, ST_GeomFromText('POINT(-95.3714 41.39)',4326), null, null, '2021-03-12T16:16:13.776146Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06807410'),
-('82591b74-73c7-4104-bec9-aff039d29f26', False, 'hutm-huntsville-east-fork-chariton', 'HUTM-Huntsville-East_Fork_Chariton', null, ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:16:13.776347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('5c44a8d0-ecac-41fa-9f8b-dfe8e44a7cca', False, 'hnia-hornick-west-fork-ditch', 'HNIA-Hornick-West_Fork_Ditch', null, ST_GeomFromText('POINT(-96.0778 42.2269)',4326), null, null, '2021-03-12T16:16:13.776480Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06602020'),
-('67489f5c-5830-4ae0-bb2a-92729804573a', False, 'hssd-hot-springs-fall', 'HSSD-Hot_Springs-Fall', null, ST_GeomFromText('POINT(-103.4758 43.4306)',4326), null, null, '2021-03-12T16:16:13.776668Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06402000'),
-('e02b0d75-2e39-4b5a-9e34-7f9f57d9dda6', False, 'hamt-hardin-little-bighorn', 'HAMT-Hardin-Little_Bighorn', null, ST_GeomFromText('POINT(-107.5575 45.7357)',4326), null, null, '2021-03-12T16:16:13.776826Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06294000'),
-('09bedd5d-0087-4b7a-84c7-9c03f937ecae', False, 'mrhm-harlem-milk', 'MRHM-Harlem-Milk', null, ST_GeomFromText('POINT(-108.7589 48.4896)',4326), null, null, '2021-03-12T16:16:13.776940Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06154100'),
-('b7e91e0b-d213-4f2c-a138-3046dff46706', False, 'hemo-hermann-missouri', 'HEMO-Hermann-Missouri', null, ST_GeomFromText('POINT(-91.4385 38.7098)',4326), null, null, '2021-03-12T16:16:13.777090Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06934500'),
-('65c1c681-cbbd-4e1e-a054-ead05db1613f', False, 'prmi-promise-city-south-fork-chariton', 'PRMI-Promise_City-South_Fork_Chariton', null, ST_GeomFromText('POINT(-93.1922 40.8006)',4326), null, null, '2021-03-12T16:16:13.777447Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06903700'),
-('71fde463-b529-47e0-80e4-80ca18d3cf01', False, 'pery-perry-lake-delaware', 'PERY-Perry_Lake-Delaware', null, ST_GeomFromText('POINT(-95.4265 39.1134)',4326), null, null, '2021-03-12T16:16:13.777551Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06890898'),
-('85ffd7fa-6b48-45b6-ac98-cdec98976c86', False, 'psia-pisgah-soldier', 'PSIA-Pisgah-Soldier', null, ST_GeomFromText('POINT(-95.9311 41.8306)',4326), null, null, '2021-03-12T16:16:13.777656Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06608500'),
-('16683ec3-e9e8-4e0a-85d9-ba18dc572241', False, 'ptsk-portis-nf-solomon', 'PTSK-Portis-NF_Solomon', null, ST_GeomFromText('POINT(-98.6919 39.555)',4326), null, null, '2021-03-12T16:16:13.777899Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06872500'),
-('f6d36b76-4fed-4831-9767-6601032e2e42', False, 'ptcl-platteville-st-vrain', 'PTCL-Platteville-St_Vrain', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.778032Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('e13f6e31-8d65-40cd-84c7-2dc70c9c1058', False, 'podt-pomme-de-terre-lake-pomme-de-ter', 'PODT-Pomme_De_Terre_Lake-Pomme_De_Ter', null, ST_GeomFromText('POINT(-93.3192 37.9012)',4326), null, null, '2021-03-12T16:16:13.778208Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921325'),
-('c2bf0151-0bfd-4dda-8865-aa670e9aa00b', False, 'pdtm-hermitage-pomme-de-terre', 'PDTM-Hermitage-Pomme_De_Terre', null, ST_GeomFromText('POINT(-93.3289 37.9061)',4326), null, null, '2021-03-12T16:16:13.778356Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921350'),
-('4fa36003-665c-4391-850b-1b1cb0c6bfd2', False, 'plvm-pleasant-view-cedar', 'PLVM-Pleasant_View-Cedar', null, ST_GeomFromText('POINT(-93.8753 37.8342)',4326), null, null, '2021-03-12T16:16:13.778458Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06919500'),
-('1143fc95-4779-4501-abde-db641fa1e747', False, 'pxck-paxico-mill', 'PXCK-Paxico-Mill', null, ST_GeomFromText('POINT(-96.1811 39.0622)',4326), null, null, '2021-03-12T16:16:13.778558Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06888500'),
-('189da51e-9efa-4a46-82a4-494e2f378284', False, 'pomk-quenemo-110', 'POMK-Quenemo-110', null, ST_GeomFromText('POINT(-95.5594 38.645)',4326), null, null, '2021-03-12T16:16:13.778803Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06912500'),
-('75e3a848-0938-43ab-95de-a1709e714b86', False, 'rati-bl-rathbun-chariton', 'RATI-bl_Rathbun-Chariton', null, ST_GeomFromText('POINT(-92.8911 40.8219)',4326), null, null, '2021-03-12T16:16:13.778948Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06903900'),
-('0e340e20-8557-4331-adb5-da9d46f7aea3', False, 'ratn-rathbun-lake-chariton', 'RATN-Rathbun_Lake-Chariton', null, ST_GeomFromText('POINT(-92.8866 40.8266)',4326), null, null, '2021-03-12T16:16:13.779076Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06903880'),
-('a91b4700-d5af-4f38-bb5a-217110e91665', False, 'rasd-rauville-mud', 'RASD-Rauville-Mud', null, ST_GeomFromText('POINT(-97.1059 45.0082)',4326), null, null, '2021-03-12T16:16:13.779241Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479490'),
-('d14719ef-f8a0-4ff5-8e4f-c6b9cabcc6a1', False, 'visd-abv-victoria-cr-rapid', 'VISD-abv_Victoria_Cr-Rapid', null, ST_GeomFromText('POINT(-103.3511 44.0466)',4326), null, null, '2021-03-12T16:16:13.779549Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06412200'),
-('2666a47d-d86c-473e-83f6-7bec80850af9', False, 'sc18-salt-cr-dam-18-branched-oak-lake', 'SC18-Salt_Cr_Dam_18-Branched_Oak_Lake', null, ST_GeomFromText('POINT(-96.8527 40.9706)',4326), null, null, '2021-03-12T16:16:13.779747Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('ecf32f7c-da6c-4e41-99b6-69ef87f7aec8', False, 'rtcl-roberts-tunnel-south-platte', 'RTCL-Roberts_Tunnel-South_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.780058Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06100046'),
-('fee43214-e313-4b84-adf6-a5a17986de27', False, 'rldk-richland-wakarusa', 'RLDK-Richland-Wakarusa', null, ST_GeomFromText('POINT(-95.5944 38.8919)',4326), null, null, '2021-03-12T16:16:13.780227Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06891260'),
-('f3497024-85f5-432a-b62e-2ac8ad0b84c5', False, 'rcsd-rapid-city-rapid', 'RCSD-Rapid_City-Rapid', null, ST_GeomFromText('POINT(-103.2414 44.0855)',4326), null, null, '2021-03-12T16:16:13.780429Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06414000'),
-('4243bfb9-a3a4-4d6e-9055-9c9e7f177fa5', False, 'mfnd', 'MFND', null, ST_GeomFromText('POINT(-99.7478 47.6981)',4326), null, null, '2021-03-12T16:16:13.780605Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('a9f658e3-758f-4bf1-b4aa-581b1073783b', False, 'simt-sidney-yellowstone', 'SIMT-Sidney-Yellowstone', null, ST_GeomFromText('POINT(-104.1554 47.6774)',4326), null, null, '2021-03-12T16:16:13.780767Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06329500'),
-('38626896-aff7-4ed4-b270-9fa536540922', False, 'rdgk-reading-marais-des-cygnes', 'RDGK-Reading-Marais_Des_Cygnes', null, ST_GeomFromText('POINT(-95.9614 38.5669)',4326), null, null, '2021-03-12T16:16:13.780906Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06910800'),
-('b146da08-def5-4366-a14c-153007a7e13b', False, 'roia-red-oak-east-nishnabotna', 'ROIA-Red_Oak-East_Nishnabotna', null, ST_GeomFromText('POINT(-95.2414 41.0086)',4326), null, null, '2021-03-12T16:16:13.781051Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06809500'),
-('18bacb38-65f4-4142-bb59-dfb4edf67639', False, 'rnia-randolph-west-nishnabotna', 'RNIA-Randolph-West_Nishnabotna', null, ST_GeomFromText('POINT(-95.58 40.8731)',4326), null, null, '2021-03-12T16:16:13.781337Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06808500'),
-('c9b09b4a-4432-4087-947f-9e64260d3023', False, 'haco-harlan-co-lake-republican', 'HACO-Harlan_Co_Lake-Republican', null, ST_GeomFromText('POINT(-99.2108 40.0715)',4326), null, null, '2021-03-12T16:16:13.781668Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06849000'),
-('cac71606-0dbc-4ddf-8bf1-a81d477c866c', False, 'resd-redfield-james', 'RESD-Redfield-James', null, ST_GeomFromText('POINT(-98.4717 44.9106)',4326), null, null, '2021-03-12T16:16:13.781937Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06475000'),
-('e965182f-9d3d-4de7-9601-87b2e20c4600', False, 'tmnd-richardton-heart', 'TMND-Richardton-Heart', null, ST_GeomFromText('POINT(-102.3083 46.7456)',4326), null, null, '2021-03-12T16:16:13.782178Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06345500'),
-('b02449ac-2b20-413f-b18d-4b0a456d193f', False, 'rifm-rich-fountain-gasconade', 'RIFM-Rich_Fountain-Gasconade', null, ST_GeomFromText('POINT(-91.8199 38.3888)',4326), null, null, '2021-03-12T16:16:13.782414Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06934000'),
-('e431c32b-da15-4ca3-aa9b-ee0dbe864acc', False, 'rvia-rock-valley-rock', 'RVIA-Rock_Valley-Rock', null, ST_GeomFromText('POINT(-96.2949 43.2146)',4326), null, null, '2021-03-12T16:16:13.782606Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06483500'),
-('5afe7477-729c-4795-87d8-52325dc8f044', False, 'rone-roca-salt', 'RONE-Roca-Salt', null, ST_GeomFromText('POINT(-96.6656 40.6578)',4326), null, null, '2021-03-12T16:16:13.782865Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803000'),
-('0c286ec4-c0e5-4df1-9803-a3fc72a57c95', False, 'rune-rulo-missouri', 'RUNE-Rulo-Missouri', null, ST_GeomFromText('POINT(-95.4224 40.0536)',4326), null, null, '2021-03-12T16:16:13.783070Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06813500'),
-('92c5e06b-101c-45b3-8eb6-1b4ce9de3238', False, 'rumt-roundup-musselshell', 'RUMT-Roundup-Musselshell', null, ST_GeomFromText('POINT(-108.5701 46.4277)',4326), null, null, '2021-03-12T16:16:13.783582Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06126500'),
-('b5c65a06-070a-49ca-8142-adad0335bd6d', False, 'rusk-russell-saline', 'RUSK-Russell-Saline', null, ST_GeomFromText('POINT(-98.8542 38.9658)',4326), null, null, '2021-03-12T16:16:13.784020Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06867000'),
-('9ce81594-fa9c-4a40-b1b6-2bab989703a2', False, 'rusd-rauville-s-br-mud', 'RUSD-Rauville-S_Br_Mud', null, ST_GeomFromText('POINT(-97.1064 44.9531)',4326), null, null, '2021-03-12T16:16:13.784240Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479498'),
-('6d312cc6-7f0f-4cf0-9273-f731dc9dcd36', False, 'rilw-riverton-little-wind', 'RILW-Riverton-Little_Wind', null, ST_GeomFromText('POINT(-108.3754 42.9975)',4326), null, null, '2021-03-12T16:16:13.784548Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06235500'),
-('823fdb7f-2668-4da7-bf1b-20a95b7fca7c', False, 'rwln-indianola-red-willow', 'RWLN-Indianola-Red_Willow', null, ST_GeomFromText('POINT(-132.1303 56.7081)',4326), null, null, '2021-03-12T16:16:13.784827Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06838000'),
-('378ae400-af5e-452b-aa33-560c4ee180ec', False, 'csco-commerce-city-sand', 'CSCO-Commerce_City-Sand', null, ST_GeomFromText('POINT(-104.9516 39.811)',4326), null, null, '2021-03-12T16:16:13.785065Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '394839104570300'),
-('35cca152-c0aa-4edb-8e75-28fff70bc5e4', False, 'saco-saco-milk', 'SACO-Saco-Milk', null, ST_GeomFromText('POINT(-107.2188 48.5092)',4326), null, null, '2021-03-12T16:16:13.785260Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06164510'),
-('da57c545-a22f-4250-936e-23c6cc84bc2a', False, 'smcl-ab-spinney-res-south-platte', 'SMCL-Ab_Spinney_Res-South_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.785543Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('678dc373-1a75-4959-9ca9-1f13571aa308', False, 'boyn-boysen-dam-wind', 'BOYN-Boysen_Dam-Wind', null, ST_GeomFromText('POINT(-108.1793 43.4174)',4326), null, null, '2021-03-12T16:16:13.785719Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('096ca1e3-cb79-436c-8ced-57de290de106', False, 'stch-st-charles-missouri', 'STCH-St_Charles-Missouri', null, ST_GeomFromText('POINT(-90.4707 38.7889)',4326), null, null, '2021-03-12T16:16:13.786111Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06935965'),
-('e22fd8bc-c5ea-47c9-ad39-6363661e9c64', False, 'scsd-scotland-james', 'SCSD-Scotland-James', null, ST_GeomFromText('POINT(-97.6353 43.1858)',4326), null, null, '2021-03-12T16:16:13.786561Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06478500'),
-('0e13c945-3b8d-48f3-9c56-1bb1f5d6f11f', False, 'scsk-schoenchen-smoky-hill', 'SCSK-Schoenchen-Smoky_Hill', null, ST_GeomFromText('POINT(-99.2919 38.7122)',4326), null, null, '2021-03-12T16:16:13.787125Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06862850'),
-('2cef2752-238e-4126-92c6-cc11361b4552', False, 'scmo-schell-city-osage', 'SCMO-Schell_City-Osage', null, ST_GeomFromText('POINT(-94.1454 38.0559)',4326), null, null, '2021-03-12T16:16:13.787347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918070'),
-('4f472fe6-d8a3-4aa0-8291-e0a84da9b4c1', False, 'lgco-nr-lake-george-south-platte', 'LGCO-nr_Lake_George-South_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.787544Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('63a099ae-0a3e-43e4-a3fa-8d1ee18a0795', False, 'arco-ab-antero-res-sf-south-platte', 'ARCO-ab_Antero_Res-SF_South_Platte', null, ST_GeomFromText('POINT(-105.9735 39.0708)',4326), null, null, '2021-03-12T16:16:13.787673Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('014ed1d0-60e8-4c7b-a6c5-ddbd12ed25c1', False, 'stsd-stratford-james', 'STSD-Stratford-James', null, ST_GeomFromText('POINT(-98.3911 45.2417)',4326), null, null, '2021-03-12T16:16:13.787839Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06472000'),
-('d7d779ef-56c9-4be0-9ac8-8b952271de2f', False, 'bbsd-s-falls-blw-div-big-sioux', 'BBSD-S_Falls_blw_Div-Big_Sioux', null, ST_GeomFromText('POINT(-96.7639 43.5731)',4326), null, null, '2021-03-12T16:16:13.788177Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06481400'),
-('adb307a6-da61-4a16-814a-08ccd14c5fe1', False, 'shhi-shadehill-dam-grand', 'SHHI-Shadehill_Dam-Grand', null, ST_GeomFromText('POINT(-102.2011 45.753)',4326), null, null, '2021-03-12T16:16:13.788471Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06357000'),
-('e61cf8fb-62f4-4a40-b876-17d01e5209d1', False, 'marm-shelby-marias', 'MARM-Shelby-Marias', null, ST_GeomFromText('POINT(-111.8898 48.4272)',4326), null, null, '2021-03-12T16:16:13.788857Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06099500'),
-('e0a12d7c-731c-4c63-8823-66b492ed2121', False, 'srbb-bl-buffalo-bill-shoshone', 'SRBB-bl_Buffalo_Bill-Shoshone', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.789144Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('27392689-8df7-4818-83a9-a262213276e0', False, 'srcl-sheridan-bear', 'SRCL-Sheridan-Bear', null, ST_GeomFromText('POINT(-105.0329 39.6523)',4326), null, null, '2021-03-12T16:16:13.789338Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('ae06e5a5-ba10-4654-bb3c-7014f4ec774f', False, 'rssd-red-shirt-cheyenne', 'RSSD-Red_Shirt-Cheyenne', null, ST_GeomFromText('POINT(-102.8917 43.6722)',4326), null, null, '2021-03-12T16:16:13.789546Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06403700'),
-('f0d0dd30-ff01-493d-8181-613acc5c3292', False, 'sksd-sioux-falls-skunk', 'SKSD-Sioux_Falls-Skunk', null, ST_GeomFromText('POINT(-96.7906 43.5336)',4326), null, null, '2021-03-12T16:16:13.789837Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06481500'),
-('ded695d7-f964-4bbb-b13c-681d2f795dc1', False, 'simk-simpson-solomon', 'SIMK-Simpson-Solomon', null, ST_GeomFromText('POINT(-97.9292 39.3683)',4326), null, null, '2021-03-12T16:16:13.790162Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06876070'),
-('3126feeb-3e80-434e-b02b-9873d8fd243b', False, 'siwy-sinclair-north-platte', 'SIWY-Sinclair-North_Platte', null, ST_GeomFromText('POINT(-107.0569 41.8722)',4326), null, null, '2021-03-12T16:16:13.790414Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06630000'),
-('38e1d4f3-6506-410e-8ee7-2a1b21933e8d', False, 'pcia-sioux-city-perry', 'PCIA-Sioux_City-Perry', null, ST_GeomFromText('POINT(-96.4104 42.5349)',4326), null, null, '2021-03-12T16:16:13.790780Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06600000'),
-('cb0db493-aad0-4e85-b906-0c4c6efcf0a5', False, 'gark-garnett-pottawatomie', 'GARK-Garnett-Pottawatomie', null, ST_GeomFromText('POINT(-95.2486 38.3336)',4326), null, null, '2021-03-12T16:16:13.791086Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06914100'),
-('ca9f5bfc-469d-4b34-92ba-35d003c338eb', False, 'stj-st-joseph-missouri', 'STJ-St_Joseph-Missouri', null, ST_GeomFromText('POINT(-94.8568 39.7533)',4326), null, null, '2021-03-12T16:16:13.791295Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06818000'),
-('fe291449-29bb-4481-b29a-5a8343ba7953', False, 'shjm-stockton-sac', 'SHJM-Stockton-Sac', null, ST_GeomFromText('POINT(-93.7797 37.7359)',4326), null, null, '2021-03-12T16:16:13.791709Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06919020'),
-('8b040633-9497-45aa-aa51-36d9bf7218e7', False, 'hesd-bl-hermosa-battle-creek', 'HESD-bl_Hermosa-Battle_Creek', null, ST_GeomFromText('POINT(-102.9056 43.7253)',4326), null, null, '2021-03-12T16:16:13.791878Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06406500'),
-('1a95c93c-698a-4e3e-9a55-59fcf83bb0c1', False, 'sktk-stockton-bow', 'SKTK-Stockton-Bow', null, ST_GeomFromText('POINT(-99.2856 39.5594)',4326), null, null, '2021-03-12T16:16:13.792094Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06871500'),
-('bcbb686c-cc14-4569-bf89-fcbd176a3cde', False, 'smie-smithville-lake-little-platte', 'SMIE-Smithville_Lake-Little_Platte', null, ST_GeomFromText('POINT(-94.5559 39.3962)',4326), null, null, '2021-03-12T16:16:13.792281Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06821140'),
-('50064ec3-e0d4-4e96-af5e-5778900d8f8a', False, 'srly-lovell-shoshone', 'SRLY-Lovell-Shoshone', null, ST_GeomFromText('POINT(-108.4344 44.8386)',4326), null, null, '2021-03-12T16:16:13.792534Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06285100'),
-('661e29f2-3250-4901-8513-2e9d4301c9d6', False, 'smhm-smithville-little-platte', 'SMHM-Smithville-Little_Platte', null, ST_GeomFromText('POINT(-94.5789 39.3881)',4326), null, null, '2021-03-12T16:16:13.792761Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06821150'),
-('8d135c20-4e51-4b45-84cb-35ba64e9a36b', False, 'flmt-fort-logan-smith', 'FLMT-Fort_Logan-Smith', null, ST_GeomFromText('POINT(-111.1786 46.7958)',4326), null, null, '2021-03-12T16:16:13.793002Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06076690'),
-('e5264afe-e6fc-4746-82d5-34ea32d72464', False, 'snck-seneca-turkey', 'SNCK-Seneca-Turkey', null, ST_GeomFromText('POINT(-96.1083 39.9478)',4326), null, null, '2021-03-12T16:16:13.793402Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06814000'),
-('113aecff-2819-4c4a-81ea-51231a3696c0', False, 'smnm-sumner-grand', 'SMNM-Sumner-Grand', null, ST_GeomFromText('POINT(-93.2737 39.64)',4326), null, null, '2021-03-12T16:16:13.793939Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06902000'),
-('182ec91a-8124-476c-8974-bb0bb3b5bd19', False, 'spsd-springfield-missouri', 'SPSD-Springfield-Missouri', null, ST_GeomFromText('POINT(-97.885 42.8558)',4326), null, null, '2021-03-12T16:16:13.794285Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06466700'),
-('38bf4836-e0af-431f-a2a5-ae4d1964385f', False, 'crco-commerce-south-platte', 'CRCO-Commerce-South_Platte', null, ST_GeomFromText('POINT(-104.9578 39.8122)',4326), null, null, '2021-03-12T16:16:13.794617Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06714215'),
-('b5f4a8ae-2323-4960-8baf-ac8213a8a3f0', False, 'mlne-st-paul-middle-loup', 'MLNE-St_Paul-Middle_Loup', null, ST_GeomFromText('POINT(-98.4461 41.2035)',4326), null, null, '2021-03-12T16:16:13.794914Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06785000'),
-('3eb7f564-ded2-41ac-b5ce-b22d29e3461b', False, 'spnk-spring-bull-little-bull', 'SPNK-Spring_Bull-Little_Bull', null, ST_GeomFromText('POINT(-94.8792 38.7675)',4326), null, null, '2021-03-12T16:16:13.795237Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06914990'),
-('da1d6111-3cff-47b4-bfe6-3d7a4c79a8b8', False, 'spcl-south-platte-south-platte', 'SPCL-South_Platte-South_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.795465Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('7fa61274-dbf9-40df-9bfb-9752e6b54479', False, 'nlne-st-paul-north-loup', 'NLNE-St_Paul-North_Loup', null, ST_GeomFromText('POINT(-98.4489 41.2633)',4326), null, null, '2021-03-12T16:16:13.795790Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06790500'),
-('695605d1-e33d-4a9f-82f5-2d15a0fd0762', False, 'sux-sioux-city-missouri', 'SUX-Sioux_City-Missouri', null, ST_GeomFromText('POINT(-96.3803 42.4469)',4326), null, null, '2021-03-12T16:16:13.796187Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06486000'),
-('bf8e9fd9-48be-4507-ac82-a64e785ac4d4', False, 'ssco-bl-strontia-springs-south-platte', 'SSCO-bl_Strontia_Springs-South_Platte', null, ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:16:13.796683Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('7c53e8e2-f1ee-4dc5-b8f9-bacc1a32538f', False, 'stak-stanley-blue', 'STAK-Stanley-Blue', null, ST_GeomFromText('POINT(-94.6756 38.8125)',4326), null, null, '2021-03-12T16:16:13.796978Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893080'),
-('9689697e-83b4-47e0-9ae2-bd5340601c68', False, 'webr-webster-res-sf-solomon', 'WEBR-Webster_Res-SF_Solomon', null, ST_GeomFromText('POINT(-99.4257 39.4117)',4326), null, null, '2021-03-12T16:16:13.797310Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06873100'),
-('2584e768-e6d4-4d79-bce7-bdb17f094dd4', False, 'stmn-stamford-sappa', 'STMN-Stamford-Sappa', null, ST_GeomFromText('POINT(-99.5169 40.1183)',4326), null, null, '2021-03-12T16:16:13.797626Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06847500'),
-('829148e4-2fab-4261-9bf2-c0aff860db51', False, 'sttn-stratton-republican', 'STTN-Stratton-Republican', null, ST_GeomFromText('POINT(-101.2297 40.1406)',4326), null, null, '2021-03-12T16:16:13.797838Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06828500'),
-('8aa7a30a-bcff-464c-a4d2-214b85cd90d9', False, 'ston-stockton-lake-sac', 'STON-Stockton_Lake-Sac', null, ST_GeomFromText('POINT(-93.7594 37.6929)',4326), null, null, '2021-03-12T16:16:13.798161Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918990'),
-('0af59e21-cbf0-43f9-bb86-537fa2f00eed', False, 'sxmt-st-xavier-bighorn', 'SXMT-St_Xavier-Bighorn', null, ST_GeomFromText('POINT(-107.9189 45.3169)',4326), null, null, '2021-03-12T16:16:13.798387Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06287000'),
-('66f1221c-f4f2-498b-b34d-9c55812e04e6', False, 'stom-bl-stockton-lake-sac', 'STOM-bl_Stockton_Lake-Sac', null, ST_GeomFromText('POINT(-93.7568 37.7011)',4326), null, null, '2021-03-12T16:16:13.798796Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06919000'),
-('b3bd4a9a-157b-4c77-aa6e-201b59a38fae', False, 'swwy-alcova-sweetwater', 'SWWY-Alcova-Sweetwater', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.799003Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('7911ab8f-ec29-485a-a08a-afc5ae3b0077', False, 'ncsd-sioux-falls-big-sioux-n-cliff-av', 'NCSD-Sioux_Falls-Big_Sioux-N_Cliff_Av', null, ST_GeomFromText('POINT(-96.424 43.3401)',4326), null, null, '2021-03-12T16:16:13.799213Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06482020'),
-('5145a2eb-0d49-42c8-8b41-3b037b8bc71b', False, 'scam-schell-city-osage-aux', 'SCAM-Schell_City-Osage-Aux', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.799851Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918080'),
-('50035dc6-3b25-4fdd-89ab-6f0994844c28', False, 'tibr-tiber-dam-marias', 'TIBR-Tiber_Dam-Marias', null, ST_GeomFromText('POINT(-111.1022 48.3196)',4326), null, null, '2021-03-12T16:16:13.800019Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('6e4089df-dac1-4f16-bb30-91123bb28b10', False, 'trum-warsaw-osage-bl-truman', 'TRUM-Warsaw-Osage-bl_Truman', null, ST_GeomFromText('POINT(-106.5447 35.3947)',4326), null, null, '2021-03-12T16:16:13.800328Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06922450'),
-('24db676f-3174-41cc-8629-45cfb9ba90a7', False, 'tfmt-three-forks-jefferson', 'TFMT-Three_Forks-Jefferson', null, ST_GeomFromText('POINT(-111.5957 45.8971)',4326), null, null, '2021-03-12T16:16:13.800793Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06036650'),
-('458913a0-c26b-4978-a206-f31e7fc9aa1b', False, 'tsne-blw-tristate-div-north-platte', 'TSNE-blw_TriState_Div-North_Platte', null, ST_GeomFromText('POINT(-104.0322 41.9842)',4326), null, null, '2021-03-12T16:16:13.801114Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('301352ea-3341-45a5-983e-5f694aaa0ece', False, 'htnm-horton-little-osage', 'HTNM-Horton-Little_Osage', null, ST_GeomFromText('POINT(-94.3693 37.9948)',4326), null, null, '2021-03-12T16:16:13.801369Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06917060'),
-('b4b39b18-adba-41f5-9f5b-0b17b512de10', False, 'holm-bl-holter-dam-missouri', 'HOLM-bl_Holter_Dam-Missouri', null, ST_GeomFromText('POINT(-112.0107 46.9947)',4326), null, null, '2021-03-12T16:16:13.801592Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06066500'),
-('e69078f0-9340-42de-9fa2-b0c8352f0a94', False, 'munk-hays-big', 'MUNK-Hays-Big', null, ST_GeomFromText('POINT(-99.3178 38.8522)',4326), null, null, '2021-03-12T16:16:13.801835Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06863500'),
-('bf6e67f8-a221-4c4d-ab0e-9cc4f93be805', False, 'hand-hazen-knife', 'HAND-Hazen-Knife', null, ST_GeomFromText('POINT(-101.6217 47.2853)',4326), null, null, '2021-03-12T16:16:13.802126Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06340500'),
-('da8bf443-7d23-40e9-9fac-04292bba4988', False, 'clri-clarinda-nodaway', 'CLRI-Clarinda-Nodaway', null, ST_GeomFromText('POINT(-95.0142 40.7433)',4326), null, null, '2021-03-12T16:16:13.802458Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06817000'),
-('c8a59df4-f112-4ecc-8651-ff877ba736f4', False, 'irne-irvington-little-papillion', 'IRNE-Irvington-Little_Papillion', null, ST_GeomFromText('POINT(-96.0475 41.3106)',4326), null, null, '2021-03-12T16:16:13.802749Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610750'),
-('e2a704be-9862-48b4-8ffa-a5fb320f6219', False, 'itne-ithaca-wahoo', 'ITNE-Ithaca-Wahoo', null, ST_GeomFromText('POINT(-96.5378 41.1475)',4326), null, null, '2021-03-12T16:16:13.803088Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06804000'),
-('6d682698-7686-454c-a2d4-2be89021945f', False, 'pist-pipestem-dam-pipestem', 'PIST-Pipestem_Dam-Pipestem', null, ST_GeomFromText('POINT(-98.7518 46.9627)',4326), null, null, '2021-03-12T16:16:13.803486Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('a51b91c6-5494-495e-a4b3-37555576b9ef', False, 'scia-sioux-city-big-sioux', 'SCIA-Sioux_City-Big_Sioux', null, ST_GeomFromText('POINT(-96.48 42.5244)',4326), null, null, '2021-03-12T16:16:13.804065Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06485950'),
-('e6534b5a-3c4f-4f3c-94a5-b0d226331336', False, 'jato-jamestown-dam-james', 'JATO-Jamestown_Dam-James', null, ST_GeomFromText('POINT(-98.7091 46.9307)',4326), null, null, '2021-03-12T16:16:13.804271Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('62976872-7b67-4a1f-a9ed-a0d02a912d72', False, 'jmia-james-floyd', 'JMIA-James-Floyd', null, ST_GeomFromText('POINT(-96.3111 42.5767)',4326), null, null, '2021-03-12T16:16:13.804781Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06600500'),
-('949014ea-319a-4451-bd09-cb92b30adc55', False, 'jand-jamestown-james', 'JAND-Jamestown-James', null, ST_GeomFromText('POINT(-98.6817 46.8897)',4326), null, null, '2021-03-12T16:16:13.805149Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06470000'),
-('0e40f86b-75dd-4cb6-aa8d-f1f2b611d707', False, 'jrmm-jerome-gasconade', 'JRMM-Jerome-Gasconade', null, ST_GeomFromText('POINT(-91.9773 37.9299)',4326), null, null, '2021-03-12T16:16:13.805667Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06933500'),
-('d2215679-474b-4cf5-a708-951bdcd01f09', False, 'misd-mitchell-james', 'MISD-Mitchell-James', null, ST_GeomFromText('POINT(-97.9189 43.6589)',4326), null, null, '2021-03-12T16:16:13.805967Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06478000'),
-('59021e50-ac9a-42b9-a002-558d4ad52200', False, 'slnd-james-river-state-line', 'SLND-James_River-State_Line', null, ST_GeomFromText('POINT(-98.1739 45.9364)',4326), null, null, '2021-03-12T16:16:13.806255Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06470878'),
-('761dee77-3afa-481f-b01e-100af99942fa', False, 'jmco-julesberg-south-platte-ch-1', 'JMCO-Julesberg-South_Platte-Ch_1', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.806694Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('9cdc7349-1c97-477a-af60-f8a8dab3534b', False, 'sbnd-starck-bridge-heart', 'SBND-Starck_Bridge-Heart', null, ST_GeomFromText('POINT(-101.2136 46.7033)',4326), null, null, '2021-03-12T16:16:13.806991Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06348300'),
-('8bbe8f07-65f1-4aba-86c7-4f03cbdb61eb', False, 'jrco-julesberg-south-platte-ch-2', 'JRCO-Julesberg-South_Platte-Ch_2', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.807318Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('f2154bf2-456c-47cd-be5c-d861e07e1071', False, 'kans-kanopolis-lake-smoky-hill', 'KANS-Kanopolis_Lake-Smoky_Hill', null, ST_GeomFromText('POINT(-97.968 38.6176)',4326), null, null, '2021-03-12T16:16:13.807492Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06865000'),
-('08b8560b-df6b-4db3-bba4-f450e5aef3c6', False, 'kacm-bannister-blue', 'KACM-Bannister-Blue', null, ST_GeomFromText('POINT(-94.5589 38.957)',4326), null, null, '2021-03-12T16:16:13.807695Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893500'),
-('a62deed5-7a58-4951-8609-37d60a859e29', False, 'mkc-kansas-city-missouri', 'MKC-Kansas_City-Missouri', null, ST_GeomFromText('POINT(-94.5881 39.1117)',4326), null, null, '2021-03-12T16:16:13.808106Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893000'),
-('267514b0-88be-4f6f-97ba-a3f6836b5b7d', False, 'kckk-kansas-city-kansas-23rd-st', 'KCKK-Kansas_City-Kansas-23rd_St', null, ST_GeomFromText('POINT(-94.6089 39.09)',4326), null, null, '2021-03-12T16:16:13.808366Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06892950'),
-('25bf48c0-40f5-4f84-b76e-77ecca25325b', False, 'kctm-kansas-city-blue-12th-st', 'KCTM-Kansas_City-Blue-12th_St', null, ST_GeomFromText('POINT(-94.491 39.0969)',4326), null, null, '2021-03-12T16:16:13.808555Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893590'),
-('98ce4ef1-2e4b-4a8e-a21c-b171fe3b8539', False, 'kene-kearney-platte', 'KENE-Kearney-Platte', null, ST_GeomFromText('POINT(-99.0861 40.6581)',4326), null, null, '2021-03-12T16:16:13.809033Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06770200'),
-('2f835463-4886-4b27-a24c-7d64aac5d14c', False, 'kecl-kersey-south-platte', 'KECL-Kersey-South_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.809406Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('775d78b7-a1cc-46d4-8575-d26e2b70f812', False, 'hcsd-hill-city-castle', 'HCSD-Hill_City-Castle', null, ST_GeomFromText('POINT(-103.83 44.0136)',4326), null, null, '2021-03-12T16:16:13.809636Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06409000'),
-('9861014d-5239-4e89-b692-f5393d9bc5ca', False, 'keyo-keyhole-dam-belle-fourche', 'KEYO-Keyhole_Dam-Belle_Fourche', null, ST_GeomFromText('POINT(-104.7787 44.3826)',4326), null, null, '2021-03-12T16:16:13.809914Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('4dc0f9ce-552b-4088-89e0-6c89fc419322', False, 'wrky-kinnear-wind', 'WRKY-Kinnear-Wind', null, ST_GeomFromText('POINT(-108.7089 43.1428)',4326), null, null, '2021-03-12T16:16:13.810555Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06227600'),
-('54151931-04f8-4532-963c-abee13f26e67', False, 'ddsd-bl-deerfield-dam-castle', 'DDSD-bl_Deerfield_Dam-Castle', null, ST_GeomFromText('POINT(-103.7814 44.0292)',4326), null, null, '2021-03-12T16:16:13.810838Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06410000'),
-('796a0df2-eea8-4c3d-842e-3c97e584c257', False, 'kamp-watertown-lake-kampeska-inlet', 'KAMP-Watertown-Lake_Kampeska_inlet', null, ST_GeomFromText('POINT(-97.1606 44.9489)',4326), null, null, '2021-03-12T16:16:13.811072Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479452'),
-('825791e2-9250-4d79-80dd-b1401f574779', False, 'kirn-kirwin-res-nf-solomon', 'KIRN-Kirwin_Res-NF_Solomon', null, ST_GeomFromText('POINT(-99.1284 39.6631)',4326), null, null, '2021-03-12T16:16:13.811348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06871700'),
-('48329b82-38dd-4ebf-b146-3bb316794934', False, 'kend-kensal-james', 'KEND-Kensal-James', null, ST_GeomFromText('POINT(-98.7972 47.3997)',4326), null, null, '2021-03-12T16:16:13.811674Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06468250'),
-('44e1a270-fd38-4d59-bce0-6f8db41dd912', False, 'laco-lawson-clear', 'LACO-Lawson-Clear', null, ST_GeomFromText('POINT(-105.6256 39.7658)',4326), null, null, '2021-03-12T16:16:13.812041Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06716500'),
-('cd68132c-9cb8-4956-a75e-0e0513d5d5e5', False, 'land-lamoure-james', 'LAND-Lamoure-James', null, ST_GeomFromText('POINT(-98.3042 46.3556)',4326), null, null, '2021-03-12T16:16:13.812440Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06470500'),
-('2be8a13f-4879-49e0-b48d-313c9e3339fb', False, 'lwrk-lawrence-wakarusa', 'LWRK-Lawrence-Wakarusa', null, ST_GeomFromText('POINT(-95.2608 38.9114)',4326), null, null, '2021-03-12T16:16:13.812996Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06891500'),
-('0a9a6dcc-2948-407b-9d30-823cf82f823a', False, 'lngb-long-branch-lake-chariton', 'LNGB-Long_Branch_Lake-Chariton', null, ST_GeomFromText('POINT(-92.5152 39.7522)',4326), null, null, '2021-03-12T16:16:13.813427Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06906190'),
-('51b6c189-01f4-4292-9971-df590943dc95', False, 'lcgk-lacygne-marais-des-cygnes', 'LCGK-LaCygne-Marais_Des_Cygnes', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.813671Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06915800'),
-('7f5cb9a6-4e16-489d-a381-49094b3db343', False, 'leks-lecompton-kansas', 'LEKS-Lecompton-Kansas', null, ST_GeomFromText('POINT(-95.3861 39.0511)',4326), null, null, '2021-03-12T16:16:13.814028Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06891000'),
-('f769b9cb-63db-49d0-861b-8c9b754c84db', False, 'rbmt-landusky-missouri', 'RBMT-Landusky-Missouri', null, ST_GeomFromText('POINT(-108.6877 47.6314)',4326), null, null, '2021-03-12T16:16:13.814366Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06115200'),
-('a27b45ff-27c2-4219-825d-caab89981393', False, 'lfsd-pierre-missouri-laframbois-isl', 'LFSD-Pierre-Missouri-LaFrambois_Isl', null, ST_GeomFromText('POINT(-100.3619 44.3503)',4326), null, null, '2021-03-12T16:16:13.814856Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06441590'),
-('4dbfed7a-9342-4eb8-98ea-457e7dd64ffb', False, 'lglk-langley-smoky-hill', 'LGLK-Langley-Smoky_Hill', null, ST_GeomFromText('POINT(-97.9525 38.6114)',4326), null, null, '2021-03-12T16:16:13.815198Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06865500'),
-('1ae719d7-7b70-43ed-a6cf-cc0082aa2c00', False, 'lgia-logan-boyer', 'LGIA-Logan-Boyer', null, ST_GeomFromText('POINT(-95.7822 41.6416)',4326), null, null, '2021-03-12T16:16:13.815558Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06609500'),
-('981de61c-a2d9-4ae8-b201-db541e88fdca', False, 'hbne-lincoln-sw-56th-st-haines-branch', 'HBNE-Lincoln-SW_56th_St-Haines_Branch', null, ST_GeomFromText('POINT(-96.797 40.7675)',4326), null, null, '2021-03-12T16:16:13.815988Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803093'),
-('a7a06f92-c1de-49f2-affd-f34be3bdd158', False, 'lesd-little-eagle-grand', 'LESD-Little_Eagle-Grand', null, ST_GeomFromText('POINT(-100.8778 45.6578)',4326), null, null, '2021-03-12T16:16:13.816234Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06357800'),
-('501111a9-8a21-401b-b37b-b136d55b2af1', False, 'lvmt-livingston-yellowstone', 'LVMT-Livingston-Yellowstone', null, ST_GeomFromText('POINT(-110.5665 45.5972)',4326), null, null, '2021-03-12T16:16:13.816559Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06192500'),
-('84401537-a71c-4e89-be81-29b875f5b092', False, 'bagm-bl-bagnell-dam-osage', 'BAGM-bl_Bagnell_Dam-Osage', null, ST_GeomFromText('POINT(-92.6072 38.1914)',4326), null, null, '2021-03-12T16:16:13.816924Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06926000'),
-('b5f07690-f363-4258-b30e-999374a6800f', False, 'lctm-lake-city-little-blue', 'LCTM-Lake_City-Little_Blue', null, ST_GeomFromText('POINT(-94.3003 39.1006)',4326), null, null, '2021-03-12T16:16:13.817205Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06894000'),
-('e2558975-24c8-4f5a-aa9b-16c92eb8c1be', False, 'mcne-lincoln-sw-63rd-st-middle-cr', 'MCNE-Lincoln-SW_63rd_St-Middle_Cr', null, ST_GeomFromText('POINT(-96.8061 40.8108)',4326), null, null, '2021-03-12T16:16:13.817787Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803170'),
-('64381fdb-1e37-4eb8-8a8f-694b6ea2d3ac', False, 'sc17-salt-cr-dam-17-holmes-lake', 'SC17-Salt_Cr_Dam_17-Holmes_Lake', null, ST_GeomFromText('POINT(-96.6365 40.7827)',4326), null, null, '2021-03-12T16:16:13.818099Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('e46226bf-49e6-418e-ae8f-93a559ce784b', False, 'lndk-lyndon-salt', 'LNDK-Lyndon-Salt', null, ST_GeomFromText('POINT(-95.6381 38.6089)',4326), null, null, '2021-03-12T16:16:13.818455Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06911490'),
-('ce009b39-c478-45e0-b890-88f1bad33d8e', False, 'lvia-linn-grove-little-sioux', 'LVIA-Linn_Grove-Little_Sioux', null, ST_GeomFromText('POINT(-95.2431 42.8958)',4326), null, null, '2021-03-12T16:16:13.818827Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06605850'),
-('1a326a87-622f-4373-a9e6-f7f979a60348', False, 'lycl-lyons-st-vrain', 'LYCL-Lyons-St_Vrain', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.819212Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('8797f300-bfdc-400c-9db1-4b55e178b0ca', False, 'lnmo-linneus-locust', 'LNMO-Linneus-Locust', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.819468Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06901500'),
-('79fb403a-4498-48a7-85f2-57e33da21ab9', False, 'lgvm-longview-lake-little-blue', 'LGVM-Longview_Lake-Little_Blue', null, ST_GeomFromText('POINT(-94.4682 38.923)',4326), null, null, '2021-03-12T16:16:13.819725Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893791'),
-('096d249c-4eba-451b-b8e6-f24c8d81d6b3', False, 'lomt-locate-powder', 'LOMT-Locate-Powder', null, ST_GeomFromText('POINT(-105.3103 46.4294)',4326), null, null, '2021-03-12T16:16:13.820054Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06326500'),
-('31c60bdb-0a6b-49ae-b27c-66c76e63f814', False, 'lgmt-logan-gallatin', 'LGMT-Logan-Gallatin', null, ST_GeomFromText('POINT(-111.4383 45.8854)',4326), null, null, '2021-03-12T16:16:13.820474Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06052500'),
-('833c496a-b7ff-42fd-9e24-353b13c2794d', False, 'lmmt-loma-marias', 'LMMT-Loma-Marias', null, ST_GeomFromText('POINT(-110.5808 47.9459)',4326), null, null, '2021-03-12T16:16:13.821015Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06102050'),
-('7fae309b-3fbf-4c60-887c-941d7b9427f3', False, 'lune-louisville-platte', 'LUNE-Louisville-Platte', null, ST_GeomFromText('POINT(-96.1578 41.0153)',4326), null, null, '2021-03-12T16:16:13.821353Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06805500'),
-('7ac6bad7-8f00-4194-b853-97d3360f58cc', False, 'seco-sedalia-plum', 'SECO-Sedalia-Plum', null, ST_GeomFromText('POINT(-104.9825 39.4383)',4326), null, null, '2021-03-12T16:16:13.822126Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06709000'),
-('305e5173-bb20-4d59-b488-13c4d61725b6', False, 'lovl-lovewell-res-white-rock', 'LOVL-Lovewell_Res-White_Rock', null, ST_GeomFromText('POINT(-98.0263 39.8901)',4326), null, null, '2021-03-12T16:16:13.822639Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06853900'),
-('6655211e-bf6d-4aa6-84f2-be6784868b37', False, 'plbm-plattsburg-little-platte', 'PLBM-Plattsburg-Little_Platte', null, ST_GeomFromText('POINT(-94.407 39.5678)',4326), null, null, '2021-03-12T16:16:13.822941Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06821080'),
-('f2f948bc-7e85-4736-8162-f1febbc30a94', False, 'lrmt-lima-dam-red-rock', 'LRMT-Lima_Dam-Red_Rock', null, ST_GeomFromText('POINT(-112.3712 44.6559)',4326), null, null, '2021-03-12T16:16:13.823307Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06012500'),
-('dee60e93-e2cf-4659-add8-07331039f366', False, 'slne-lincoln-n-70th-st-salt', 'SLNE-Lincoln-N_70th_St-Salt', null, ST_GeomFromText('POINT(-96.6242 40.8861)',4326), null, null, '2021-03-12T16:16:13.823762Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803513'),
-('ea3c61a6-fb84-47ee-924a-5c4ce0e2bc44', False, 'lacl-lasalle-big-thompson', 'LACL-LaSalle-Big_Thompson', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.824083Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('812900c0-b197-4c68-b98b-cc4d7d0329f5', False, 'lund-ludden-james-river', 'LUND-Ludden-James_River', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.824285Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('c5fb1ded-8d7d-4353-916e-94e099560466', False, 'pbne-lincoln-pioneers-blvd-salt', 'PBNE-Lincoln-Pioneers_Blvd-Salt', null, ST_GeomFromText('POINT(-96.7189 40.7703)',4326), null, null, '2021-03-12T16:16:13.824503Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803080'),
-('d370ede1-f01d-4875-a2fc-4b51b34d2462', False, 'kawy-kane-bighorn', 'KAWY-Kane-Bighorn', null, ST_GeomFromText('POINT(-108.1808 44.7586)',4326), null, null, '2021-03-12T16:16:13.824771Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06279500'),
-('a534c193-5d93-480b-ac96-d47c6ca4f8f6', False, 'sasd-salem-little-vermillion', 'SASD-Salem-Little_Vermillion', null, ST_GeomFromText('POINT(-110.5542 40.1547)',4326), null, null, '2021-03-12T16:16:13.825097Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '09288180'),
-('f06242d9-1441-4b1a-bc1f-dbe908082c49', False, 'livm-livonia-chariton', 'LIVM-Livonia-Chariton', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.825408Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06904050'),
-('3a3b2392-f571-4bac-81e4-87235dbcf6b6', False, 'lwlk-bl-lovewell-res-white-rock', 'LWLK-bl_Lovewell_Res-White_Rock', null, ST_GeomFromText('POINT(-98.0222 39.8842)',4326), null, null, '2021-03-12T16:16:13.825687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06854000'),
-('097587a4-0618-4102-9d1e-a772ff87fd4a', False, 'mcmt-mcallister-madison', 'MCMT-McAllister-Madison', null, ST_GeomFromText('POINT(-111.6345 45.4902)',4326), null, null, '2021-03-12T16:16:13.825973Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06041000'),
-('281bea70-648b-493e-8150-35994e135fae', False, 'mpia-mapleton-maple', 'MPIA-Mapleton-Maple', null, ST_GeomFromText('POINT(-95.8097 42.1569)',4326), null, null, '2021-03-12T16:16:13.826391Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06607200'),
-('78b26a9a-6a9b-42ab-8056-7414beb9739b', False, 'masd-state-line-maple', 'MASD-State_Line-Maple', null, ST_GeomFromText('POINT(-98.4522 45.9389)',4326), null, null, '2021-03-12T16:16:13.826843Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06471200'),
-('d38859d8-23ac-4a8f-b4aa-3150af47c86a', False, 'jeco-jefferson-michigan', 'JECO-Jefferson-Michigan', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.827147Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('7471d1dd-6524-4e54-9e15-8dec5f6880fe', False, 'mckn-mccook-driftwood', 'MCKN-McCook-Driftwood', null, ST_GeomFromText('POINT(-100.6728 40.1458)',4326), null, null, '2021-03-12T16:16:13.827447Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06836500'),
-('cc2035c1-c39c-4897-a2d0-8d9330bb7a71', False, 'mand-mandan-heart', 'MAND-Mandan-Heart', null, ST_GeomFromText('POINT(-100.9742 46.8339)',4326), null, null, '2021-03-12T16:16:13.827805Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06349000'),
-('36104b4b-d1d2-4045-b84a-7931f4b6bfef', False, 'vumt-vaughn-muddy', 'VUMT-Vaughn-Muddy', null, ST_GeomFromText('POINT(-111.5418 47.5613)',4326), null, null, '2021-03-12T16:16:13.828327Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06088500'),
-('ac212863-7b56-4d33-afc2-8641a755453f', False, 'prco-prince-mf-south-platte', 'PRCO-Prince-MF_South_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.828624Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('d4e35b6c-3686-4310-b9b2-973323846e17', False, 'smco-santa-maria-mf-south-platte', 'SMCO-Santa_Maria-MF_South_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.828867Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('39691428-9e02-4e75-8992-a3f3a3239d51', False, 'mimt-miles-city-tongue', 'MIMT-Miles_City-Tongue', null, ST_GeomFromText('POINT(-105.8455 46.3846)',4326), null, null, '2021-03-12T16:16:13.829168Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06308500'),
-('0130ae0b-1d49-435f-81ea-5cc13c7cbefc', False, 'milk-bl-milford-lake-republican', 'MILK-bl_Milford_Lake-Republican', null, ST_GeomFromText('POINT(-96.8292 39.0428)',4326), null, null, '2021-03-12T16:16:13.829645Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06857100'),
-('a48274c4-0b25-4a4d-a79e-9bcf21b386c4', False, 'mls-miles-city-yellowstone', 'MLS-Miles_City-Yellowstone', null, ST_GeomFromText('POINT(-105.86 46.4208)',4326), null, null, '2021-03-12T16:16:13.830075Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06309000'),
-('423253ba-8b69-41cb-9c31-78751068e51c', False, 'mane-maskell-missouri', 'MANE-Maskell-Missouri', null, ST_GeomFromText('POINT(-96.9436 42.7147)',4326), null, null, '2021-03-12T16:16:13.830672Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06478526'),
-('e30aa8ca-768c-45dd-a1e8-77a03d6dce1c', False, 'mcon-mccook-republican', 'MCON-McCook-Republican', null, ST_GeomFromText('POINT(-100.6186 40.1878)',4326), null, null, '2021-03-12T16:16:13.831073Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06837000'),
-('8ab7c161-0064-4e50-9ad9-f58133da686c', False, 'mild-milford-lake-republican', 'MILD-Milford_Lake-Republican', null, ST_GeomFromText('POINT(-96.8927 39.0857)',4326), null, null, '2021-03-12T16:16:13.831473Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06857050'),
-('fd5196eb-fa9f-491b-a54b-6a5974a938a4', False, 'melm-melrose-big-hole', 'MELM-Melrose-Big_Hole', null, ST_GeomFromText('POINT(-112.7017 45.5266)',4326), null, null, '2021-03-12T16:16:13.831807Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06025500'),
-('cedb39eb-5900-418b-9f38-60df06758623', False, 'meln-melvern-lake-marais-des-cygnes', 'MELN-Melvern_Lake-Marais_Des_Cygnes', null, ST_GeomFromText('POINT(-95.7082 38.5106)',4326), null, null, '2021-03-12T16:16:13.832219Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06910997'),
-('0d974476-25da-4031-aa25-ca8aff227e2e', False, 'csmk-manhattan-big-blue', 'CSMK-Manhattan-Big_Blue', null, ST_GeomFromText('POINT(-96.5711 39.2372)',4326), null, null, '2021-03-12T16:16:13.832529Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06887000'),
-('a98cb303-e958-4d18-9bb2-d43934d8a764', False, 'mlti-moulton-chariton', 'MLTI-Moulton-Chariton', null, ST_GeomFromText('POINT(-92.7722 40.6925)',4326), null, null, '2021-03-12T16:16:13.832849Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06904010'),
-('626f9a6a-388a-4142-8a97-22da669f5316', False, 'nmne-niobrara-missouri', 'NMNE-Niobrara-Missouri', null, ST_GeomFromText('POINT(-98.0278 42.7617)',4326), null, null, '2021-03-12T16:16:13.833255Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06466010'),
-('d3ff9fc9-5b1e-4b2c-88eb-38d459e038a1', False, 'pone-ponca-missouri', 'PONE-Ponca-Missouri', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.833563Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('7575ce15-0590-4eab-a1f9-6f970511e95f', False, 'mocl-morrison-bear', 'MOCL-Morrison-Bear', null, ST_GeomFromText('POINT(-105.1956 39.6529)',4326), null, null, '2021-03-12T16:16:13.833957Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('1adbe771-1938-4ae3-94f5-0f75f976760e', False, 'mark-marmaton-marmaton', 'MARK-Marmaton-Marmaton', null, ST_GeomFromText('POINT(-94.9811 37.8356)',4326), null, null, '2021-03-12T16:16:13.834202Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06917380'),
-('f5a0f369-82a5-4694-a5f9-1f10d9648eb8', False, 'vene-verdel-missouri', 'VENE-Verdel-Missouri', null, ST_GeomFromText('POINT(-98.0619 42.771)',4326), null, null, '2021-03-12T16:16:13.834546Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06453620'),
-('997c2976-4e66-40d8-adda-e7f371446b34', False, 'mryk-marysville-big-blue', 'MRYK-Marysville-Big_Blue', null, ST_GeomFromText('POINT(-96.6619 39.8419)',4326), null, null, '2021-03-12T16:16:13.834870Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06882510'),
-('a82b2325-6cbe-46c0-8c6b-13b105bcd1ae', False, 'msck-muscotah-delaware', 'MSCK-Muscotah-Delaware', null, ST_GeomFromText('POINT(-95.5325 39.5214)',4326), null, null, '2021-03-12T16:16:13.835170Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06890100'),
-('d7dd810b-e54c-4498-a328-233a0cac29fa', False, 'mrrm-morrisville-little-sac', 'MRRM-Morrisville-Little_Sac', null, ST_GeomFromText('POINT(-93.4856 37.4829)',4326), null, null, '2021-03-12T16:16:13.835493Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918740'),
-('a008bc03-367d-43cc-8d71-913784dc1f06', False, 'bowy-bosler-laramie', 'BOWY-Bosler-Laramie', null, ST_GeomFromText('POINT(-105.6831 41.5536)',4326), null, null, '2021-03-12T16:16:13.835817Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('b64b60d2-efb0-44ec-b180-bd70bedca1bd', False, 'meks-mentor-smoky-hill', 'MEKS-Mentor-Smoky_Hill', null, ST_GeomFromText('POINT(-97.5714 38.7111)',4326), null, null, '2021-03-12T16:16:13.836141Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06866500'),
-('92b9b542-b9a8-47d7-9cb5-5ecdb40299bd', False, 'tucr-tuttle-creek-lake-big-blue', 'TUCR-Tuttle_Creek_Lake-Big_Blue', null, ST_GeomFromText('POINT(-96.5906 39.2549)',4326), null, null, '2021-03-12T16:16:13.836451Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06886900'),
-('cb297644-f5d2-4caf-8807-b3a353bb3e62', False, 'quks-quenemo-marais-des-cygnes', 'QUKS-Quenemo-Marais_Des_Cygnes', null, ST_GeomFromText('POINT(-95.5269 38.5161)',4326), null, null, '2021-03-12T16:16:13.836694Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06911400'),
-('f3376aae-8ae6-4036-9bd3-867d5fd00739', False, 'mrym-maryville-102', 'MRYM-Maryville-102', null, ST_GeomFromText('POINT(-94.8322 40.3455)',4326), null, null, '2021-03-12T16:16:13.837043Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06819500'),
-('b071dc14-1669-4ec9-9dcb-fed20e0be25f', False, 'napm-napoleon-missouri', 'NAPM-Napoleon-Missouri', null, ST_GeomFromText('POINT(-94.0642 39.1332)',4326), null, null, '2021-03-12T16:16:13.837404Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '00000001'),
-('895cf343-29dc-45db-9a8d-1646f6b68038', False, 'nbne-north-bend-platte', 'NBNE-North_Bend-Platte', null, ST_GeomFromText('POINT(-96.7756 41.4528)',4326), null, null, '2021-03-12T16:16:13.837814Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06796000'),
-('97cf42f9-6a16-4487-a30b-5841f0d7ea47', False, 'nwck-new-cambria-smoky-hill', 'NWCK-New_Cambria-Smoky_Hill', null, ST_GeomFromText('POINT(-97.4831 38.8639)',4326), null, null, '2021-03-12T16:16:13.838260Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06870200'),
-('bc0db7f9-27dc-42dc-873d-41bb7f53d0d5', False, 'ncrk-norcatur-sappa', 'NCRK-Norcatur-Sappa', null, ST_GeomFromText('POINT(-99.9925 40.0017)',4326), null, null, '2021-03-12T16:16:13.838608Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06845110'),
-('c94008f6-7c4f-4790-87f7-ca275127ad70', False, 'ncne-nebraska-city-missouri', 'NCNE-Nebraska_City-Missouri', null, ST_GeomFromText('POINT(-95.8467 40.6819)',4326), null, null, '2021-03-12T16:16:13.839179Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06807000'),
-('33edaf52-38ae-4bf8-9faf-1052f5d45d06', False, 'ofk-norfolk-elkhorn', 'OFK-Norfolk-Elkhorn', null, ST_GeomFromText('POINT(-97.426 42.0038)',4326), null, null, '2021-03-12T16:16:13.839926Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06799000'),
-('84d75d90-11d8-4b7b-adc7-5bb1adff96d7', False, 'nkma-browning-nf-milk', 'NKMA-Browning-NF_Milk', null, ST_GeomFromText('POINT(-113.0619 48.9633)',4326), null, null, '2021-03-12T16:16:13.840347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06133500'),
-('6acc777d-0e2c-4ad7-bcd2-99f7d96cc20f', False, 'nfs-wapiti-nf-shoshone', 'NFS-Wapiti-NF_Shoshone', null, ST_GeomFromText('POINT(-109.4297 44.4697)',4326), null, null, '2021-03-12T16:16:13.840773Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06279940'),
-('8d8723fe-2ce4-46c4-8baf-d141da44fee5', False, 'ngwy-northgate-north-platte', 'NGWY-Northgate-North_Platte', null, ST_GeomFromText('POINT(-106.3392 40.9366)',4326), null, null, '2021-03-12T16:16:13.841303Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06620000'),
-('55964321-de45-4e7e-91c8-f3b1c1d8ac6f', False, 'nkne-nickerson-maple', 'NKNE-Nickerson-Maple', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.841798Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('5f644ae9-579c-4e3d-98ae-509548e350c5', False, 'nlsk-niles-solomon', 'NLSK-Niles-Solomon', null, ST_GeomFromText('POINT(-97.4769 38.9692)',4326), null, null, '2021-03-12T16:16:13.842236Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06876900'),
-('35d402ef-48b9-421f-a709-770f0a31315e', False, 'lowy-lookout-laramie', 'LOWY-Lookout-Laramie', null, ST_GeomFromText('POINT(-105.6925 41.7664)',4326), null, null, '2021-03-12T16:16:13.842565Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('3d3df58d-f824-4dd4-abb2-1bbbdaf72d0c', False, 'nine-niobrara-niobrara', 'NINE-Niobrara-Niobrara', null, ST_GeomFromText('POINT(-98.058 42.7484)',4326), null, null, '2021-03-12T16:16:13.842892Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06466000'),
-('46abbd57-a21e-4868-8998-9a9e82eccc69', False, 'namt-nashua-milk', 'NAMT-Nashua-Milk', null, ST_GeomFromText('POINT(-106.3643 48.1301)',4326), null, null, '2021-03-12T16:16:13.843328Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06174500'),
-('25a8d942-21f4-4e26-8288-ca9778672c11', False, 'norn-norton-res-prairie-dog', 'NORN-Norton_Res-Prairie_Dog', null, ST_GeomFromText('POINT(-99.9349 39.8066)',4326), null, null, '2021-03-12T16:16:13.843770Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06847951'),
-('2860f95d-f01f-4fe9-a9e3-e8801fdd4671', False, 'nevm-nevada-marmaton', 'NEVM-Nevada-Marmaton', null, ST_GeomFromText('POINT(-94.3992 37.862)',4326), null, null, '2021-03-12T16:16:13.844073Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918065'),
-('282aa29b-fa3c-4174-9a36-d227239112f9', False, 'novm-novinger-chariton', 'NOVM-Novinger-Chariton', null, ST_GeomFromText('POINT(-92.6864 40.2343)',4326), null, null, '2021-03-12T16:16:13.844307Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06904500'),
-('84d970c7-508d-424e-aa7c-372e2cb57673', False, 'becr-tailwater', 'BECR-Tailwater', null, ST_GeomFromText('POINT(-105.1385 39.4325)',4326), null, null, '2021-03-12T16:16:13.844644Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('351614b6-ecc0-46e0-9117-2e2858f62e77', False, 'bfsd-bf-inlet-canal-belle-fourche', 'BFSD-BF_Inlet_Canal-Belle_Fourche', null, ST_GeomFromText('POINT(-103.7339 44.7014)',4326), null, null, '2021-03-12T16:16:13.844888Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06434505'),
-('3359ec74-5ffd-4d4b-9811-4b3efb44d237', False, 'chfi-tailwater', 'CHFI-Tailwater', null, ST_GeomFromText('POINT(-105.0621 39.5587)',4326), null, null, '2021-03-12T16:16:13.845192Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('64be22bf-1801-4199-97f5-e49fc2b7cde4', False, 'ftmt-fort-benton-missouri', 'FTMT-Fort_Benton-Missouri', null, ST_GeomFromText('POINT(-110.6661 47.8169)',4326), null, null, '2021-03-12T16:16:13.845496Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06090800'),
-('0a0a5d68-63c9-4a27-84e2-8e771a560a52', False, 'glen-glendo-dam-north-platte', 'GLEN-Glendo_Dam-North_Platte', null, ST_GeomFromText('POINT(-104.9507 42.4787)',4326), null, null, '2021-03-12T16:16:13.845833Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('e6b798e0-e58b-4476-8434-68507129f6eb', False, 'grar-gray-reef-dam-north-platte', 'GRAR-Gray_Reef_Dam-North_Platte', null, ST_GeomFromText('POINT(-106.7016 42.5652)',4326), null, null, '2021-03-12T16:16:13.855937Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('ef1b2572-2c76-4d47-bd7e-db650db5fb39', False, 'grmt-great-falls-missouri', 'GRMT-Great_Falls-Missouri', null, ST_GeomFromText('POINT(-111.0606 47.5844)',4326), null, null, '2021-03-12T16:16:13.856807Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06090300'),
-('c01bf4a7-dbba-4042-80d2-1161e9426c94', False, 'gtmt-gallatin-gateway-gallatin', 'GTMT-Gallatin_Gateway-Gallatin', null, ST_GeomFromText('POINT(-111.2707 45.4973)',4326), null, null, '2021-03-12T16:16:13.857275Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06043500'),
-('9e609bdd-ea06-46be-9c6a-10c0fbb314ca', False, 'hbia-hamburg-missouri', 'HBIA-Hamburg-Missouri', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.857622Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('d3cece1f-1bcc-4085-9c61-7e7c3b6ba02c', False, 'wwy-woods-landing-laramie', 'WWY-Woods_Landing-Laramie', null, ST_GeomFromText('POINT(-106.0347 41.1381)',4326), null, null, '2021-03-12T16:16:13.858040Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('f95bdcdd-0aac-4e68-9555-231b4bcc8579', False, 'j5nd-5th-street-james', 'J5ND-5th_Street-James', null, ST_GeomFromText('POINT(-98.7097 46.9292)',4326), null, null, '2021-03-12T16:16:13.858283Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06469015'),
-('e50f4e3c-ed8f-454a-a2a3-295354ceaac7', False, 'lpsd-lake-poinsett-big-sioux', 'LPSD-Lake_Poinsett-Big_Sioux', null, ST_GeomFromText('POINT(-97.0821 44.5656)',4326), null, null, '2021-03-12T16:16:13.858621Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('c0689109-c19d-40eb-8e9d-82a756ae9b72', False, 'momt-mosby-musselshell', 'MOMT-Mosby-Musselshell', null, ST_GeomFromText('POINT(-107.8892 46.9947)',4326), null, null, '2021-03-12T16:16:13.858983Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06130500'),
-('7c0229bc-bb72-44f6-a775-a6d678bc58d7', False, 'mowy-moorcroft-belle-fourche', 'MOWY-Moorcroft-Belle_Fourche', null, ST_GeomFromText('POINT(-104.94 44.3219)',4326), null, null, '2021-03-12T16:16:13.859477Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06426500'),
-('69b3e00d-b5aa-427c-ae86-2baa9d541de9', False, 'pa2a-papio-cr-dam-20-sediment-trap', 'PA2A-Papio_Cr_Dam_20-Sediment_Trap', null, ST_GeomFromText('POINT(-96.1685 41.1455)',4326), null, null, '2021-03-12T16:16:13.859897Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('e857cbef-e9da-4fc8-847e-3c0b96676a1e', False, 'pgsd-pringle-beaver-creek', 'PGSD-Pringle-Beaver_Creek', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.860209Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('37a3e33f-cb95-471f-8f70-8b3e66b9a4fd', False, 'poca-lake-pocasse-spring', 'POCA-Lake_Pocasse-Spring', null, ST_GeomFromText('POINT(-100.2958 45.9119)',4326), null, null, '2021-03-12T16:16:13.860480Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('15ee1940-279c-4f85-a8ed-26b9d0e0fc39', False, 'rvsd-elm-springs-belle-fourche', 'RVSD-Elm_Springs-Belle_Fourche', null, ST_GeomFromText('POINT(-102.5661 44.37)',4326), null, null, '2021-03-12T16:16:13.860787Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06438000'),
-('e6d4d8af-d9b7-4ccc-b3fa-7e068f3a5102', False, 'smmt-simms-sun', 'SMMT-Simms-Sun', null, ST_GeomFromText('POINT(-111.9319 47.5016)',4326), null, null, '2021-03-12T16:16:13.861269Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06085800'),
-('29645660-4c6b-4fac-93a5-7f0b377f5aa8', False, 'smsd-fruitdale-belle-fourche', 'SMSD-Fruitdale-Belle_Fourche', null, ST_GeomFromText('POINT(-103.7372 44.6908)',4326), null, null, '2021-03-12T16:16:13.861681Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06436000'),
-('fb933e16-e672-4dc6-8a0c-1b3c47957f5b', False, 'spia-little-sioux-spencer', 'SPIA-Little_Sioux-Spencer', null, ST_GeomFromText('POINT(-95.2392 43.2128)',4326), null, null, '2021-03-12T16:16:13.862112Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06604440'),
-('8ac38027-abe3-458e-87e4-792ca5bd7a54', False, 'thmt-forsyth-yellowstone', 'THMT-Forsyth-Yellowstone', null, ST_GeomFromText('POINT(-106.6913 46.2666)',4326), null, null, '2021-03-12T16:16:13.862662Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06295000'),
-('abc8cbd4-14bb-42e6-82c6-edbe15dc2a54', False, 'thsd-blw-pactola-dam-rapid', 'THSD-blw_Pactola_Dam-Rapid', null, ST_GeomFromText('POINT(-103.4817 44.0767)',4326), null, null, '2021-03-12T16:16:13.863093Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06411500'),
-('cabc22e8-bf31-4de7-93fb-970470fcbc85', False, 'tisd-silver-city-rapid', 'TISD-Silver_City-Rapid', null, ST_GeomFromText('POINT(-103.58 44.0847)',4326), null, null, '2021-03-12T16:16:13.863462Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06410500'),
-('78f38d25-de8f-42d5-bb0c-c92e10625be4', False, 'tlnd-glen-ullin-heart', 'TLND-Glen_Ullin-Heart', null, ST_GeomFromText('POINT(-102.0789 46.6569)',4326), null, null, '2021-03-12T16:16:13.863993Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06345780'),
-('5e445ad1-aa42-4e2b-be64-c7887f235b7c', False, 'ocsd-oacoma-white', 'OCSD-Oacoma-White', null, ST_GeomFromText('POINT(-99.5561 43.7483)',4326), null, null, '2021-03-12T16:16:13.864507Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06452000'),
-('1be7ddbd-8c41-4ed9-9ca6-e85ca0ec64e7', False, 'grai-grayrocks-in-laramie', 'GRAI-Grayrocks_In-Laramie', null, ST_GeomFromText('POINT(-104.8136 42.1192)',4326), null, null, '2021-03-12T16:16:13.865028Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('67784acf-b244-41c0-8066-ebf8fd7b5f94', False, 'obnk-oberlin-sappa', 'OBNK-Oberlin-Sappa', null, ST_GeomFromText('POINT(-66.0276 18.2487)',4326), null, null, '2021-03-12T16:16:13.865409Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06845000'),
-('e8b7296d-7680-4481-9185-0dd8f7f96e54', False, 'fsne-omaha-fort-st-big-papillion', 'FSNE-Omaha-Fort_St-Big_Papillion', null, ST_GeomFromText('POINT(-96.1042 41.3067)',4326), null, null, '2021-03-12T16:16:13.870185Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610732'),
-('7c994390-02a1-4d62-8c98-35aa150897b4', False, 'ekdk-elkader-smoky-hill', 'EKDK-Elkader-Smoky_Hill', null, ST_GeomFromText('POINT(-100.8581 38.7947)',4326), null, null, '2021-03-12T16:16:13.870835Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06860000'),
-('08f632b1-882a-4633-8edf-8e77e4bf4b00', False, 'grao-grayrocks-out-laramie', 'GRAO-Grayrocks_Out-Laramie', null, ST_GeomFromText('POINT(-104.6814 42.1867)',4326), null, null, '2021-03-12T16:16:13.871255Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('014adfaf-dc6a-433b-af8d-61f24f3fe0bd', False, 'sbwy-canal-3-sybille', 'SBWY-Canal_3-Sybille', null, ST_GeomFromText('POINT(-105.1267 41.9111)',4326), null, null, '2021-03-12T16:16:13.871647Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '13018250'),
-('62718b4c-3076-496a-8b3d-5157ae38865f', False, 'opdk-overland-park-indian', 'OPDK-Overland_Park-Indian', null, ST_GeomFromText('POINT(-94.6711 38.9406)',4326), null, null, '2021-03-12T16:16:13.872134Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893300'),
-('d59e3ecf-4c39-42ae-8096-a4df7671f624', False, 'odsd-ordway-elm', 'ODSD-Ordway-Elm', null, ST_GeomFromText('POINT(-98.4124 45.5623)',4326), null, null, '2021-03-12T16:16:13.872652Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06471510'),
-('3721a836-0e99-4841-ada7-4b7b90614f52', False, 'npow-orin-north-platte', 'NPOW-Orin-North_Platte', null, ST_GeomFromText('POINT(-105.1586 42.6525)',4326), null, null, '2021-03-12T16:16:13.873121Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06652000'),
-('65b1cb8d-5cf5-47e3-946d-c59a8d9d0875', False, 'ornn-orleans-republican', 'ORNN-Orleans_Republican', null, ST_GeomFromText('POINT(-99.5025 40.1317)',4326), null, null, '2021-03-12T16:16:13.873683Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06844500'),
-('093ea821-93a4-4db6-8c0f-ed3e8288829a', False, 'odco-orodell-boulder', 'ODCO-Orodell-Boulder', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.874163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('a55aef62-154e-428e-a1df-1c3893da55e6', False, 'osbk-osborne-sf-solomon', 'OSBK-Osborne-SF_Solomon', null, ST_GeomFromText('POINT(-98.6939 39.4278)',4326), null, null, '2021-03-12T16:16:13.874625Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06874000'),
-('0b46c136-d8b2-462a-8d7b-011be66e3516', False, 'otvm-otterville-lamine', 'OTVM-Otterville-Lamine', null, ST_GeomFromText('POINT(-92.9788 38.7022)',4326), null, null, '2021-03-12T16:16:13.875142Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06906800'),
-('0c383d64-628a-4ac5-8927-7aa026ad9a83', False, 'ottk-ottawa-marais-des-cygnes', 'OTTK-Ottawa-Marais_Des_Cygnes', null, ST_GeomFromText('POINT(-95.2681 38.6181)',4326), null, null, '2021-03-12T16:16:13.875619Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06913500'),
-('14fd3d9f-9969-406f-a7ab-81ec6ae77932', False, 'piwy-piney-belle-fourche', 'PIWY-Piney-Belle_Fourche', null, ST_GeomFromText('POINT(-105.3878 43.9844)',4326), null, null, '2021-03-12T16:16:13.876031Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06425720'),
-('4d941923-9654-466d-a18e-5f86fe65e6a5', False, 'paca-pactola-dam-rapid', 'PACA-Pactola_Dam-Rapid', null, ST_GeomFromText('POINT(-103.4889 44.0747)',4326), null, null, '2021-03-12T16:16:13.876343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('9e7f3184-5639-412b-8a1a-16a85a57b6b3', False, 'paln-palisade-frenchman', 'PALN-Palisade-Frenchman', null, ST_GeomFromText('POINT(-101.1236 40.3517)',4326), null, null, '2021-03-12T16:16:13.876870Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06834000'),
-('94f014bf-8c43-474f-b0fc-eb800135dd30', False, 'wymt-wyola-little-bighorn', 'WYMT-Wyola-Little_Bighorn', null, ST_GeomFromText('POINT(-107.6154 45.0071)',4326), null, null, '2021-03-12T16:16:13.877468Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06289000'),
-('e150e563-87a6-4686-b8b5-4b26165a6196', False, 'pcco-louviers-plum', 'PCCO-Louviers-Plum', null, ST_GeomFromText('POINT(-105.0239 39.5075)',4326), null, null, '2021-03-12T16:16:13.886598Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06709530'),
-('f6ee414c-4b94-43d7-9795-54aa5639ccab', False, 'pbks-bl-perry-lake-delaware', 'PBKS-bl_Perry_Lake-Delaware', null, ST_GeomFromText('POINT(-95.4036 39.075)',4326), null, null, '2021-03-12T16:16:13.887481Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06890900'),
-('9ea4ed3b-effd-41d3-8a5b-55e25cc8baf1', False, 'pind-pingree-pipestem', 'PIND-Pingree-Pipestem', null, ST_GeomFromText('POINT(-98.9686 47.1675)',4326), null, null, '2021-03-12T16:16:13.888335Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06469400'),
-('8eb33672-785d-4bdd-b223-33a0a7111052', False, 'eewy-encmpt-encampment', 'EEWY-Encmpt-Encampment', null, ST_GeomFromText('POINT(-106.7147 41.3033)',4326), null, null, '2021-03-12T16:16:13.888905Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06625000'),
-('732ccef3-108c-45d3-854f-960f2b05870a', False, 'pir-pierre-missouri', 'PIR-Pierre-Missouri', null, ST_GeomFromText('POINT(-100.3675 44.3731)',4326), null, null, '2021-03-12T16:16:13.894787Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06440000'),
-('b3365c71-912c-40f7-8589-1b94e83dc1ad', False, 'sc13-salt-cr-dam-13-twin-lakes', 'SC13-Salt_Cr_Dam_13-Twin_Lakes', null, ST_GeomFromText('POINT(-96.9468 40.825)',4326), null, null, '2021-03-12T16:16:13.895384Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('ae2bca4e-2274-4703-a909-d738bef1b293', False, 'syne-pilger-elkhorn', 'SYNE-Pilger-Elkhorn', null, ST_GeomFromText('POINT(-97.0581 41.9953)',4326), null, null, '2021-03-12T16:16:13.895915Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06799315'),
-('e9576c46-f9d0-438a-b61e-4b91c158e825', False, 'poma-pomona-lake-110-quenemo', 'POMA-Pomona_Lake-110-Quenemo', null, ST_GeomFromText('POINT(-95.5592 38.6497)',4326), null, null, '2021-03-12T16:16:13.896229Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06912490'),
-('cc1f07b9-4a61-47e5-b2b7-b00d05d04c28', False, 'plkm-polk-lindley', 'PLKM-Polk-Lindley', null, ST_GeomFromText('POINT(-93.2662 37.7505)',4326), null, null, '2021-03-12T16:16:13.896532Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921200'),
-('32d2baee-313b-45f7-ae19-aac4cf5c80de', False, 'epcl-haskins-east-plum', 'EPCL-Haskins-East_Plum', null, ST_GeomFromText('POINT(-104.9046 39.423)',4326), null, null, '2021-03-12T16:16:13.896778Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06708800'),
-('d67d5691-fe82-4378-af89-f6a537c49d46', False, 'ccsd-howes-cheyenne', 'CCSD-Howes-Cheyenne', null, ST_GeomFromText('POINT(-101.9297 44.5294)',4326), null, null, '2021-03-12T16:16:13.897018Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06438500'),
-('95910905-1e08-4ea0-a20f-2cab0fd558dc', False, 'pmnk-pomona-marais-des-cygnes', 'PMNK-Pomona-Marais_Des_Cygnes', null, ST_GeomFromText('POINT(-95.4533 38.5842)',4326), null, null, '2021-03-12T16:16:13.897881Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06913000'),
-('3a8d68f7-be43-4119-9ebe-ae2bfbccd58d', False, 'pokm-polk-pomme-de-terre', 'POKM-Polk-Pomme_De_Terre', null, ST_GeomFromText('POINT(-93.3703 37.6827)',4326), null, null, '2021-03-12T16:16:13.898206Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921070'),
-('b1e368f7-d61a-4184-9bdf-af33762404e4', False, 'ppne-papillion-papillion', 'PPNE-Papillion-Papillion', null, ST_GeomFromText('POINT(-96.0431 41.1557)',4326), null, null, '2021-03-12T16:16:13.898519Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610793'),
-('a809e4d4-9984-4808-8933-5b12554ccd63', False, 'szne-pierce-nf-elkhorn', 'SZNE-Pierce-NF_Elkhorn', null, ST_GeomFromText('POINT(-97.4785 42.1485)',4326), null, null, '2021-03-12T16:16:13.898890Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06799100'),
-('7347071d-eb82-422d-a756-4b8e0c254ef9', False, 'prnd-price-missouri', 'PRND-Price-Missouri', null, ST_GeomFromText('POINT(-100.9319 47.0797)',4326), null, null, '2021-03-12T16:16:13.899213Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06342020'),
-('040820e9-1dbd-4a34-bf17-02ab1903c671', False, 'prim-prairie-hill-chariton', 'PRIM-Prairie_Hill-Chariton', null, ST_GeomFromText('POINT(-92.7908 39.5399)',4326), null, null, '2021-03-12T16:16:13.899595Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06905500'),
-('b72e9e7d-5569-44c9-9641-c0ada621e7c5', False, 'hast-truman-lake-marais-des-cygnes', 'HAST-Truman_Lake-Marais_Des_Cygnes', null, ST_GeomFromText('POINT(-93.4011 38.265)',4326), null, null, '2021-03-12T16:16:13.900117Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06922440'),
-('0d0d22d9-9086-49dc-92d1-e1935bc0e357', False, 'tmrm-browning-two-medicine', 'TMRM-Browning-Two_Medicine', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.901278Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('49a5bc95-bb20-4ca7-8e01-8df8725d1d9f', False, 'tngk-tonganoxie-stranger', 'TNGK-Tonganoxie-Stranger', null, ST_GeomFromText('POINT(-95.0106 39.1164)',4326), null, null, '2021-03-12T16:16:13.901674Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06892000'),
-('10eedbd9-da07-4ac7-8483-91f75908db36', False, 'topk-topeka-soldier', 'TOPK-Topeka-Soldier', null, ST_GeomFromText('POINT(-95.7247 39.0994)',4326), null, null, '2021-03-12T16:16:13.902153Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06889500'),
-('298ecc91-786a-4fd3-973f-913247de33da', False, 'tomt-toston-missouri', 'TOMT-Toston-Missouri', null, ST_GeomFromText('POINT(-111.4203 46.1466)',4326), null, null, '2021-03-12T16:16:13.902534Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06054500'),
-('03f9dae9-42e1-45de-a662-0b585b8642e2', False, 'tpak-topeka-kansas', 'TPAK-Topeka-Kansas', null, ST_GeomFromText('POINT(-95.6494 39.0667)',4326), null, null, '2021-03-12T16:16:13.903097Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06889000'),
-('bd7afc0e-fdb9-49d8-b16c-ddbe3a7f0747', False, 'mksl-state-line-marais-des-cygnes', 'MKSL-State_Line-Marais_Des_Cygnes', null, ST_GeomFromText('POINT(-94.6128 38.2186)',4326), null, null, '2021-03-12T16:16:13.903575Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06916600'),
-('321955f5-c218-45e4-8f46-d617a03f3fca', False, 'trco-tarryall-res-tarryall', 'TRCO-Tarryall_Res-Tarryall', null, ST_GeomFromText('POINT(-105.6025 39.2217)',4326), null, null, '2021-03-12T16:16:13.903985Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('ebd63490-75b5-460b-90c4-f21afead613e', False, 'brco-borden-ditch-tarryall-creek', 'BRCO-Borden_Ditch-Tarryall_Creek', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.904258Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('cf9d1a22-175a-42a7-8f44-c2bdc1ef0634', False, 'mhia-turin-monona-harrison-ditch', 'MHIA-Turin-Monona_Harrison_Ditch', null, ST_GeomFromText('POINT(-95.9917 41.9644)',4326), null, null, '2021-03-12T16:16:13.904613Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06602400'),
-('3f6213c8-aa5e-48fc-b822-873d61e49946', False, 'tbco-trumbull-south-platte', 'TBCO-Trumbull-South_Platte', null, ST_GeomFromText('POINT(-105.2214 39.26)',4326), null, null, '2021-03-12T16:16:13.905251Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06701900'),
-('d26bd7d0-c955-4c40-88b9-b7716680b2e7', False, 'coco-como-tarryall', 'COCO-Como-Tarryall', null, ST_GeomFromText('POINT(-105.8661 39.3319)',4326), null, null, '2021-03-12T16:16:13.905613Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06696980'),
-('7204e776-3183-4ff6-83e7-4f6ca90a0415', False, 'tstk-tescott-saline', 'TSTK-Tescott-Saline', null, ST_GeomFromText('POINT(-97.8736 39.0039)',4326), null, null, '2021-03-12T16:16:13.906018Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06869500'),
-('5050c1a0-8f4c-4f0a-845c-e03e14c01e10', False, 'ttnm-trenton-thompson', 'TTNM-Trenton-Thompson', null, ST_GeomFromText('POINT(-93.638 40.0693)',4326), null, null, '2021-03-12T16:16:13.907052Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06899500'),
-('434d4b4d-e1e9-4660-ab32-a9c0958e5511', False, 'tdmo-tunnel-dam-niangua-macks-creek', 'TDMO-Tunnel_Dam-Niangua-Macks_Creek', null, ST_GeomFromText('POINT(-92.8514 37.9369)',4326), null, null, '2021-03-12T16:16:13.907380Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06923950'),
-('e79e81d4-504b-48e3-8f39-b37efbe55386', False, 'tuia-turin-little-sioux', 'TUIA-Turin-Little_Sioux', null, ST_GeomFromText('POINT(-95.9725 41.9644)',4326), null, null, '2021-03-12T16:16:13.907819Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06607500'),
-('423ff7c2-da37-4852-a5e4-7ac426ab780c', False, 'jrmt-twin-bridges-jefferson', 'JRMT-Twin_Bridges-Jefferson', null, ST_GeomFromText('POINT(-112.3294 45.6133)',4326), null, null, '2021-03-12T16:16:13.908465Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06026500'),
-('8dd142c1-9d3f-441a-8a1d-edff4aeaf03c', False, 'tbmt-twin-bridges-beaverhead', 'TBMT-Twin_Bridges-Beaverhead', null, ST_GeomFromText('POINT(-112.4528 45.3834)',4326), null, null, '2021-03-12T16:16:13.908953Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06018500'),
-('4107add3-5748-4ab0-8ad6-28c53127c7c8', False, 'taco-blw-tarryall-res-tarryall-cr', 'TACO-blw_Tarryall_Res-Tarryall_Cr', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.909377Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('669dd1da-c98f-47e5-9041-99ae4e17e50b', False, 'uene-uehling-logan', 'UENE-Uehling-Logan', null, ST_GeomFromText('POINT(-96.5216 41.7126)',4326), null, null, '2021-03-12T16:16:13.909811Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06799500'),
-('c13648e4-006d-49b4-9c37-582df4e7c0f4', False, 'unne-union-weeping-water', 'UNNE-Union-Weeping_Water', null, ST_GeomFromText('POINT(-95.9114 40.7939)',4326), null, null, '2021-03-12T16:16:13.910318Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06806500'),
-('2fd326a6-de7e-45aa-a6be-3ac88fdab73f', False, 'ul6e-ulm-missouri', 'UL6E-Ulm-Missouri', null, ST_GeomFromText('POINT(-111.3884 47.435)',4326), null, null, '2021-03-12T16:16:13.910859Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06078200'),
-('a5ad0345-cfa5-41ce-a0fd-7d33bf22c92d', False, 'vamt-vaughn-sun', 'VAMT-Vaughn-Sun', null, ST_GeomFromText('POINT(-111.511 47.5258)',4326), null, null, '2021-03-12T16:16:13.911206Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06089000'),
-('d6246cab-1568-408c-a705-1211059e4221', False, 'vcwk-laclede-vermillion', 'VCWK-LaClede-Vermillion', null, ST_GeomFromText('POINT(-96.2172 39.3478)',4326), null, null, '2021-03-12T16:16:13.911655Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06888000'),
-('4ddf05b1-cd15-4d4b-9162-9caafff898da', False, 'vdne-verdel-ponca', 'VDNE-Verdel-Ponca', null, ST_GeomFromText('POINT(-98.1758 42.8111)',4326), null, null, '2021-03-12T16:16:13.912014Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06453600'),
-('b9a920af-0b67-4d76-a9b1-af6f28a6605b', False, 'vrsd-vermillion-vermillion', 'VRSD-Vermillion-Vermillion', null, ST_GeomFromText('POINT(-96.9239 42.8172)',4326), null, null, '2021-03-12T16:16:13.912520Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479010'),
-('769f0f08-9ce7-4628-adcb-cb8b4950b28a', False, 'vrne-verdel-niobrara', 'VRNE-Verdel-Niobrara', null, ST_GeomFromText('POINT(-98.2228 42.7397)',4326), null, null, '2021-03-12T16:16:13.913486Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06465500'),
-('12883e59-647c-41f8-9bcf-71388402dd9f', False, 'vimt-virgelle-missouri', 'VIMT-Virgelle-Missouri', null, ST_GeomFromText('POINT(-110.2588 48.0038)',4326), null, null, '2021-03-12T16:16:13.914346Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06109500'),
-('79bca4c1-f7a1-49ff-905e-247abf36b8e3', False, 'pasd-parker-ef-vermillion', 'PASD-Parker-EF_Vermillion', null, ST_GeomFromText('POINT(-97.1094 43.4453)',4326), null, null, '2021-03-12T16:16:13.916420Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06478600'),
-('9f954295-21d5-457a-9833-67225ddbb673', False, 'waak-wakeeney-saline', 'WAAk-WaKeeney-Saline', null, ST_GeomFromText('POINT(-99.8697 39.1061)',4326), null, null, '2021-03-12T16:16:13.917068Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06866900'),
-('88a7b3f1-8ae0-4c85-8b54-493a743a7b84', False, 'wrsy-ab-boysen-res-wind', 'WRSY-ab_Boysen_Res-Wind', null, ST_GeomFromText('POINT(-108.2233 43.1292)',4326), null, null, '2021-03-12T16:16:13.917528Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '09219200'),
-('5087266f-6b60-4f63-aca5-0e60739ef831', False, 'wssd-wasta-cheyenne', 'WSSD-Wasta-Cheyenne', null, ST_GeomFromText('POINT(-102.4008 44.0811)',4326), null, null, '2021-03-12T16:16:13.917990Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06423500'),
-('e32bbe2b-224a-4c31-ba54-e085e9cbe767', False, 'snwy-saratoga-north-brush', 'SNWY-Saratoga-North_Brush', null, ST_GeomFromText('POINT(-106.52 41.3703)',4326), null, null, '2021-03-12T16:16:13.918849Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06622700'),
-('3f53f6f9-01bf-4ab8-a1e0-a6a03f50a6d1', False, 'wtco-waterton-south-platte', 'WTCO-Waterton-South_Platte', null, ST_GeomFromText('POINT(-105.0922 39.4883)',4326), null, null, '2021-03-12T16:16:13.919252Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('259937f3-da71-4d2c-b481-c77266605a44', False, 'wcnd-watford-city-little-missouri', 'WCND-Watford_City-Little_Missouri', null, ST_GeomFromText('POINT(-103.2634 47.5954)',4326), null, null, '2021-03-12T16:16:13.919578Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06337000'),
-('ffc37226-df29-4179-a875-f3c6973c4efe', False, 'wesd-at-watertown-big-sioux', 'WESD-at_Watertown-Big_Sioux', null, ST_GeomFromText('POINT(-97.1458 44.9425)',4326), null, null, '2021-03-12T16:16:13.920124Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479500'),
-('f2553a00-72cb-44a1-8c4d-9dd2f9e72a9e', False, 'wbsd-watertown-big-sioux-broadway', 'WBSD-Watertown-Big_Sioux-Broadway', null, ST_GeomFromText('POINT(-97.119 44.8891)',4326), null, null, '2021-03-12T16:16:13.920625Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479512'),
-('8abaf43c-68a0-49a5-a5f8-5547faa39a12', False, 'wofk-woodruff-prairie-dog', 'WOFK-Woodruff-Prairie_Dog', null, ST_GeomFromText('POINT(-99.4783 39.9787)',4326), null, null, '2021-03-12T16:16:13.921189Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06848500'),
-('e55f0960-3e48-4af9-8b20-2f73faddf3ed', False, 'wrry-riverton-wind', 'WRRY-Riverton-Wind', null, ST_GeomFromText('POINT(-108.3761 43.0106)',4326), null, null, '2021-03-12T16:16:13.921676Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06228000'),
-('b2f55a2f-f0c8-4347-b22f-6fad4833daa8', False, 'wpsd-westport-elm', 'WPSD-Westport-Elm', null, ST_GeomFromText('POINT(-98.4967 45.6561)',4326), null, null, '2021-03-12T16:16:13.922350Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06471500'),
-('a56e223d-d9b4-433b-ad88-43d9deab21b2', False, 'whsd-whitehorse-moreau', 'WHSD-Whitehorse-Moreau', null, ST_GeomFromText('POINT(-100.8425 45.2558)',4326), null, null, '2021-03-12T16:16:13.923015Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06360500'),
-('e36ad2a9-ffb2-48b3-ab68-f3d5881a83e7', False, 'whwy-bl-whalen-north-platte', 'WHWY-bl_Whalen-North_Platte', null, ST_GeomFromText('POINT(-104.6274 42.2411)',4326), null, null, '2021-03-12T16:16:13.923853Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06657000'),
-('194e34df-53c4-409e-911c-63dc6466a122', False, 'wakp-wakpala-oak', 'WAKP-Wakpala-Oak', null, ST_GeomFromText('POINT(-100.5963 45.7284)',4326), null, null, '2021-03-12T16:16:13.924642Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06354881'),
-('25dc31d5-2e39-4dc2-ba73-0b8aa2ed86ab', False, 'wiln-wilson-lake-saline', 'WILN-Wilson_Lake-Saline', null, ST_GeomFromText('POINT(-98.4946 38.9658)',4326), null, null, '2021-03-12T16:16:13.925474Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06868100'),
-('c228055b-e9a9-422a-867e-6e356727be3b', False, 'wsn-williston-missouri', 'WSN-Williston-Missouri', null, ST_GeomFromText('POINT(-103.7142 48.1081)',4326), null, null, '2021-03-12T16:16:13.926217Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06330000'),
-('9e09065e-6f9d-480f-bde0-886d5b60689e', False, 'wgks-wamego-kansas', 'WGKS-Wamego-Kansas', null, ST_GeomFromText('POINT(-96.3053 39.1983)',4326), null, null, '2021-03-12T16:16:13.927051Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06887500'),
-('add04934-6068-42bf-92a6-6a322667905c', False, 'wecl-weldona-south-platte', 'WECL-Weldona-South_Platte', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.927650Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('10103b2f-efbe-436e-ae94-3cf6c977c93e', False, 'wodk-woodston-sf-solomon', 'WODK-Woodston-SF_Solomon', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.928115Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06873460'),
-('5ca0fbf4-9fda-4d8c-aca6-fb88cc3af281', False, 'wsne-west-point-elkhorn', 'WSNE-West_Point-Elkhorn', null, ST_GeomFromText('POINT(-96.7272 41.8394)',4326), null, null, '2021-03-12T16:16:13.928591Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06799350'),
-('1dbbef84-0011-47f1-8996-fe96cbd2e270', False, 'wpmt-wolf-point-missouri', 'WPMT-Wolf_Point-Missouri', null, ST_GeomFromText('POINT(-105.5331 48.0673)',4326), null, null, '2021-03-12T16:16:13.929387Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06177000'),
-('0906044d-8908-4a1f-99ec-662b08c2cd49', False, 'hw7m-warsaw-osage', 'HW7M-Warsaw-Osage', null, ST_GeomFromText('POINT(-93.3871 38.2445)',4326), null, null, '2021-03-12T16:16:13.930015Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06922500'),
-('963f04e9-51bc-4874-b008-cdd9106e7745', False, 'wsnd-washburn-missouri', 'WSND-Washburn-Missouri', null, ST_GeomFromText('POINT(-101.0375 47.2889)',4326), null, null, '2021-03-12T16:16:13.930523Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06341000'),
-('69016049-9bdb-41c7-9391-38b1fe7bfcbb', False, 'wshk-washington-mill', 'WSHK-Washington-Mill', null, ST_GeomFromText('POINT(-97.0372 39.8136)',4326), null, null, '2021-03-12T16:16:13.931280Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06884200'),
-('9cca7dcf-8cc8-4b16-858c-149b7b2e950f', False, 'wilk-bl-wilson-lake-saline', 'WILK-bl_Wilson_Lake-Saline', null, ST_GeomFromText('POINT(-98.49 38.9747)',4326), null, null, '2021-03-12T16:16:13.931650Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06868200'),
-('edae07b9-b6c6-4c14-bbb5-f44141d8ef6b', False, 'wtne-waterloo-elkhorn', 'WTNE-Waterloo-Elkhorn', null, ST_GeomFromText('POINT(-96.2839 41.2933)',4326), null, null, '2021-03-12T16:16:13.932108Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06800500'),
-('39663433-687e-4aad-a17e-aa6b8094ece6', False, 'wtsd-nr-watertown-big-sioux', 'WTSD-nr_Watertown-Big_Sioux', null, ST_GeomFromText('POINT(-97.1463 44.9424)',4326), null, null, '2021-03-12T16:16:13.933040Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479438'),
-('b7d78f8c-7945-489a-88ce-d19b82a363bc', False, 'wvmo-waverly-missouri', 'WVMO-Waverly-Missouri', null, ST_GeomFromText('POINT(-93.515 39.215)',4326), null, null, '2021-03-12T16:16:13.933557Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06895500'),
-('3cfddf48-2ce9-4d0c-8cec-e29a4f7e2b9f', False, 'bywy-blw-boysen-res-wind', 'BYWY-blw_Boysen_Res-Wind', null, ST_GeomFromText('POINT(-108.1783 43.425)',4326), null, null, '2021-03-12T16:16:13.934468Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('0f40fe5a-755a-4c63-8fbf-8abccf2a09b4', False, 'tmwy-thermopolis-bighorn', 'TMWY-Thermopolis-Bighorn', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.934898Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('7d3bd68c-4e61-44e0-8d3d-f99ca2e8ffc2', False, 'wwsd-watertown-willow', 'WWSD-Watertown-Willow', null, ST_GeomFromText('POINT(-97.0453 44.9189)',4326), null, null, '2021-03-12T16:16:13.935269Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479515'),
-('1fa54c42-6629-4447-9939-afbd66b218ad', False, 'iwsd-pierre-missouri-isaac-walton', 'IWSD-Pierre-Missouri-Isaac_Walton', null, ST_GeomFromText('POINT(-100.307 44.3372)',4326), null, null, '2021-03-12T16:16:13.935720Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06441592'),
-('9b34c6e6-5e1a-48b8-930c-6422c22973b3', False, 'ykn-yankton-missouri', 'YKN-Yankton-Missouri', null, ST_GeomFromText('POINT(-97.3973 42.8656)',4326), null, null, '2021-03-12T16:16:13.936078Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06467500'),
-('349335bb-ca47-441d-824c-e3d164548db4', False, 'ykn1-yankton-missouri', 'YKN1-Yankton-Missouri', null, ST_GeomFromText('POINT(-91.3475 36.7866)',4326), null, null, '2021-03-12T16:16:13.936706Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '07071000'),
-('e3fc8b71-694b-4423-aa21-46195cc218aa', False, 'pa16-papio-cr-dam-16-stand-bear-lake', 'PA16-Papio_Cr_Dam_16-Stand_Bear_Lake', null, ST_GeomFromText('POINT(-96.1218 41.3144)',4326), null, null, '2021-03-12T16:16:13.937342Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('278246ba-5dc4-4ba5-b184-6a52b97edee7', False, 'pmia-millnerville-perry', 'PMIA-Millnerville-Perry', null, ST_GeomFromText('POINT(-96.37 42.6933)',4326), null, null, '2021-03-12T16:16:13.937845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06599900'),
-('bc29ca96-0c34-46dc-8a99-fc0f3e6b46ff', False, 'kcia-glenwood-keg', 'KCIA-Glenwood-Keg', null, ST_GeomFromText('POINT(-95.7183 41.1022)',4326), null, null, '2021-03-12T16:16:13.938243Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06805850'),
-('9f42e8cf-f13c-4427-bd80-fe9df9c0e2c2', False, 'guwy-bl-guernsey-north-platte', 'GUWY-bl_Guernsey-North_Platte', null, ST_GeomFromText('POINT(-104.7542 42.2806)',4326), null, null, '2021-03-12T16:16:13.938604Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('7174bfdb-1c2d-45dd-a24c-4a33599d21b8', False, 'sawy-saratoga-north-platte', 'SAWY-Saratoga-North_Platte', null, ST_GeomFromText('POINT(-106.8056 41.455)',4326), null, null, '2021-03-12T16:16:13.938921Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('c7bcfc4a-3770-42c4-b02e-7334ba3ab4c5', False, 'shwy-boxelder-boxelder', 'SHWY-Boxelder-Boxelder', null, ST_GeomFromText('POINT(-105.8592 42.6119)',4326), null, null, '2021-03-12T16:16:13.939331Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '13012475'),
-('296a45f6-b330-496d-9de0-dd613a060edd', False, 'lawy-lander-popo-agie', 'LAWY-Lander-Popo_Agie', null, ST_GeomFromText('POINT(-108.6428 42.7667)',4326), null, null, '2021-03-12T16:16:13.939986Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('609c3bee-0dc0-4d7f-8907-d399b6fa6753', False, 'mpwy-lander-middle-popo-agie', 'MPWY-Lander-Middle_Popo_Agie', null, ST_GeomFromText('POINT(-108.7978 42.7572)',4326), null, null, '2021-03-12T16:16:13.940448Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('59e3ad21-aeec-4776-a538-87c9d8b045e2', False, 'brwy-worland-bighorn', 'BRWY-Worland-Bighorn', null, ST_GeomFromText('POINT(-107.9689 44.0147)',4326), null, null, '2021-03-12T16:16:13.940892Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('7ac04619-1156-4085-a3c9-86e606009172', False, 'fmwy-shoshoni-five-mile', 'FMWY-Shoshoni-Five_Mile', null, ST_GeomFromText('POINT(-108.2197 43.2219)',4326), null, null, '2021-03-12T16:16:13.941199Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06253000'),
-('1ccdaac9-11dc-4829-8573-47c26f393c5d', False, 'mnwy-morton-wind', 'MNWY-Morton-Wind', null, ST_GeomFromText('POINT(-108.8083 43.1922)',4326), null, null, '2021-03-12T16:16:13.941559Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('c098330d-f3c2-44ec-8aaf-95d6ed227020', False, 'scwy-shell-shell', 'SCWY-Shell-Shell', null, ST_GeomFromText('POINT(-107.7122 44.565)',4326), null, null, '2021-03-12T16:16:13.942057Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('7e603dea-124f-4102-89c7-cca166c97ef2', False, 'mewy-meeteetsee-greybull', 'MEWY-Meeteetsee-Greybull', null, ST_GeomFromText('POINT(-108.8764 44.1556)',4326), null, null, '2021-03-12T16:16:13.942466Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('af1f410c-48d3-49b4-8e72-3085699c7899', False, 'sdmt-swift-reservoir-birch', 'SDMT-Swift_Reservoir-Birch', null, ST_GeomFromText('POINT(-112.8667 48.15)',4326), null, null, '2021-03-12T16:16:13.942772Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('9a106989-5fd5-4a7c-8c6b-96e0a33fdfe9', False, 'leco-georgetown-leavenworth', 'LECO-Georgetown-Leavenworth', null, ST_GeomFromText('POINT(-105.6997 39.6872)',4326), null, null, '2021-03-12T16:16:13.943061Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06714800'),
-('2154ace8-09ae-4491-bc82-39f5cff4d037', False, 'srmt-augusta-bridge-sun', 'SRMT-Augusta_Bridge-Sun', null, ST_GeomFromText('POINT(-111.9306 47.5028)',4326), null, null, '2021-03-12T16:16:13.943428Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06082200'),
-('a5d2602f-9bfe-4754-b3d9-809d7affe6c3', False, 'mamt-international-boundary-st-mary', 'MAMT-International_Boundary-St_Mary', null, ST_GeomFromText('POINT(-113.2992 49.0119)',4326), null, null, '2021-03-12T16:16:13.943808Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('72a96a78-2bbf-4c38-8821-5d57b68e91c3', False, 'awne-ashland-wahoo', 'AWNE-Ashland-Wahoo', null, ST_GeomFromText('POINT(-96.3678 41.0536)',4326), null, null, '2021-03-12T16:16:13.944182Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06804700'),
-('31050872-88b9-4793-a4ea-b4bf57eaca08', False, 'lsne-lincoln-little-salt', 'LSNE-Lincoln-Little_Salt', null, ST_GeomFromText('POINT(-96.6817 40.8931)',4326), null, null, '2021-03-12T16:16:13.944536Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803510'),
-('5661b688-9b34-4b4b-bb91-e22d1a5ed3af', False, 'lfne-lincoln-fairgrounds-salt', 'LFNE-Lincoln-Fairgrounds-Salt', null, ST_GeomFromText('POINT(-96.6914 40.8386)',4326), null, null, '2021-03-12T16:16:13.944928Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803495'),
-('f9f983fa-0fcb-4882-86d6-27d4f48c39a4', False, 'ocne-lincoln-air-park-rd-oak-cr', 'OCNE-Lincoln-Air_Park_Rd-Oak_Cr', null, ST_GeomFromText('POINT(-96.7794 40.8553)',4326), null, null, '2021-03-12T16:16:13.945310Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803486'),
-('89a62a94-e70c-4e81-8ebb-9322baef48ac', False, 'jssd-ab-johnson-siding-rapid', 'JSSD-ab_Johnson_Siding-Rapid', null, ST_GeomFromText('POINT(-103.4422 44.0819)',4326), null, null, '2021-03-12T16:16:13.945667Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06411900'),
-('5692b4e6-8db0-4f0b-99c9-b8eb2465ed38', False, 'besd-big-bend-rapid', 'BESD-Big_Bend-Rapid', null, ST_GeomFromText('POINT(-103.4167 44.055)',4326), null, null, '2021-03-12T16:16:13.946066Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06412000'),
-('6ca1271c-1dc6-43eb-939b-04dfbfea6bc6', False, 'pssd-st-patrick-st-rapid', 'PSSD-St_Patrick_St-Rapid', null, ST_GeomFromText('POINT(-103.1803 44.0672)',4326), null, null, '2021-03-12T16:16:13.946409Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06416000'),
-('32065168-dade-4d48-b493-9ed973a1479a', False, 'mywy-mammoth-raws', 'MYWY-Mammoth-Raws', null, ST_GeomFromText('POINT(-110.6953 44.9772)',4326), null, null, '2021-03-12T16:16:13.946829Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('8fb8f24c-ba7c-42aa-a2f7-e60db8dba73c', False, 'sc02-salt-cr-dam-2-olive-creek-lake', 'SC02-Salt_Cr_Dam_2-Olive_Creek_Lake', null, ST_GeomFromText('POINT(-96.8465 40.5853)',4326), null, null, '2021-03-12T16:16:13.947127Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('1aa216f8-dd4f-459e-9bc8-e8c98dd54839', False, 'pa18-papio-cr-dam-18-zorinsky-lake', 'PA18-Papio_Cr_Dam_18-Zorinsky_Lake', null, ST_GeomFromText('POINT(-96.1608 41.2202)',4326), null, null, '2021-03-12T16:16:13.947610Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('943b3020-5a17-4ffa-9d31-4fc4a8c63f84', False, 'pa20-papio-cr-dam-20-wehrspann-lake', 'PA20-Papio_Cr_Dam_20-Wehrspann_Lake', null, ST_GeomFromText('POINT(-96.1496 41.1719)',4326), null, null, '2021-03-12T16:16:13.948105Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('4760111f-b705-4134-89f7-c0f7ff56e2a5', False, 'sc04-salt-cr-dam-4-blue-stem-lake', 'SC04-Salt_Cr_Dam_4-Blue_Stem_Lake', null, ST_GeomFromText('POINT(-96.7902 40.628)',4326), null, null, '2021-03-12T16:16:13.948480Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('2af8a7fe-f45f-4013-9955-14dd21b86ad5', False, 'mcsd-aberdeen-moccasin', 'MCSD-Aberdeen-Moccasin', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.948963Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('e4213aae-cdac-4ce3-95d9-88fd68f2e70c', False, 'shnd-schmidt-missouri', 'SHND-Schmidt-Missouri', null, ST_GeomFromText('POINT(-100.7392 46.6564)',4326), null, null, '2021-03-12T16:16:13.949432Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06349700'),
-('8b3bb0ea-b1fc-4379-9735-4a023030be7b', False, 'gwsd-greenwood-missouri', 'GWSD-Greenwood-Missouri', null, ST_GeomFromText('POINT(-98.3494 42.9053)',4326), null, null, '2021-03-12T16:16:13.949896Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06453020'),
-('1c7b6f7b-d66f-404c-9bdb-2ded86237b94', False, 'chcr-cherry-creek-dam-cherry', 'CHCR-Cherry_Creek_Dam-Cherry', null, ST_GeomFromText('POINT(-104.859 39.6438)',4326), null, null, '2021-03-12T16:16:13.950251Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('1a202c21-e5b0-40bc-85ac-cf682ed55571', False, 'macm-macon-east-fork-little-chariton', 'MACM-Macon-East_Fork_Little_Chariton', null, ST_GeomFromText('POINT(-92.5189 39.7514)',4326), null, null, '2021-03-12T16:16:13.951106Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06906200'),
-('62a8b8db-f3a6-4769-8661-9d2d67abe92c', False, 'stnd-stanton-missouri', 'STND-Stanton-Missouri', null, ST_GeomFromText('POINT(-101.3397 47.2872)',4326), null, null, '2021-03-12T16:16:13.951630Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06340700'),
-('1dbc13de-12ad-4ee1-ac2e-7c5cb5ad5d66', False, '46nd', '46ND', null, ST_GeomFromText('POINT(-98.5719 46.6292)',4326), null, null, '2021-03-12T16:16:13.951972Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('3216ab89-c388-435d-bea9-636ab9ebe6da', False, 'absd-aberdeen-foot', 'ABSD-Aberdeen-Foot', null, ST_GeomFromText('POINT(-98.5772 45.5192)',4326), null, null, '2021-03-12T16:16:13.952271Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06471800'),
-('ec0b69d3-81d0-495b-a45a-ecfd3df3e1de', False, 'achk-achilles-south-fork-sappa', 'ACHK-Achilles-South_Fork_Sappa', null, ST_GeomFromText('POINT(-84.7078 43.6261)',4326), null, null, '2021-03-12T16:16:13.952920Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06844900'),
-('c977564e-573b-4ee8-83e1-f2174d0828ca', False, 'acia-atlantic-east-nishnabotna', 'ACIA-Atlantic-East_Nishnabotna', null, ST_GeomFromText('POINT(-95.0767 41.3461)',4326), null, null, '2021-03-12T16:16:13.953431Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06809210'),
-('4c39e266-f0d4-43a3-a739-278a21233295', False, 'essd-elm-springs-elk', 'ESSD-Elm_Springs-Elk', null, ST_GeomFromText('POINT(-102.5028 44.2483)',4326), null, null, '2021-03-12T16:16:13.954035Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06425500'),
-('97206268-077e-4f1c-9f3c-cf942df47e52', False, 'flne-falls-city-big-nemaha', 'FLNE-Falls_City-Big_Nemaha', null, ST_GeomFromText('POINT(-95.596 40.0355)',4326), null, null, '2021-03-12T16:16:13.954366Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06815000'),
-('6048784b-1da8-4074-abcf-a9d37855b565', False, 'sc10-salt-cr-dam-10-yankee-hill-lake', 'SC10-Salt_Cr_Dam_10-Yankee_Hill_Lake', null, ST_GeomFromText('POINT(-96.7832 40.7305)',4326), null, null, '2021-03-12T16:16:13.954769Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('f42b4aa3-2285-489d-bc0c-0ed31c28a002', False, 'sc12-salt-cr-dam-12-conestoga-lake', 'SC12-Salt_Cr_Dam_12-Conestoga_Lake', null, ST_GeomFromText('POINT(-96.846 40.7672)',4326), null, null, '2021-03-12T16:16:13.955306Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('cb651eef-22e7-4352-bb46-614b6799fe41', False, 'jdrm8-winifred-judith', 'JDRM8-Winifred-Judith', null, ST_GeomFromText('POINT(-109.6525 47.6683)',4326), null, null, '2021-03-12T16:16:13.955882Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06114700'),
-('1ad01639-6fa9-4f71-a3d4-867165d60ffd', False, 'dttm8-dutton-tenton', 'DTTM8-Dutton-Tenton', null, ST_GeomFromText('POINT(-111.5519 47.9303)',4326), null, null, '2021-03-12T16:16:13.956421Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06108000'),
-('f8612d9a-1043-475a-97ca-e5c3723b2866', False, 'ltrm8-loma-teton', 'LTRM8-Loma-Teton', null, ST_GeomFromText('POINT(-110.5144 47.9328)',4326), null, null, '2021-03-12T16:16:13.956895Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06108800'),
-('54c85a30-b34a-4a18-97e8-7c9e4091237c', False, 'frwy-fales-rock-raws', 'FRWY-Fales-Rock_RAWS', null, ST_GeomFromText('POINT(-107.2722 42.8564)',4326), null, null, '2021-03-12T16:16:13.957320Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('783c103f-0927-4395-b32d-baac7aee2f64', False, 'gllm-gallatin-grand', 'GLLM-Gallatin-Grand', null, ST_GeomFromText('POINT(-93.9425 39.9269)',4326), null, null, '2021-03-12T16:16:13.957594Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06897500'),
-('ac578540-c6de-4923-a151-d06cc66153b0', False, 'pipp-pipestem-dam-precip', 'PIPP-Pipestem_Dam-Precip', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.957850Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('552f8ab5-d307-4ac4-a1c5-e84c3a214bb5', False, 'rrmt-bl-lima-mt-red-rock', 'RRMT-bl_Lima_MT-Red_Rock', null, ST_GeomFromText('POINT(-112.3712 44.6559)',4326), null, null, '2021-03-12T16:16:13.958135Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06012500'),
-('e689b4b9-b19d-49ad-906a-302ecb7f713c', False, 'atmt', 'ATMT', null, ST_GeomFromText('POINT(-112.3372 45.5469)',4326), null, null, '2021-03-12T16:16:13.958572Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06023100'),
-('1c9e4f8a-3df2-4746-a030-952b82007b8a', False, 'psne-omaha-pacific-st-big-papillion', 'PSNE-Omaha-Pacific_St-Big_Papillion', null, ST_GeomFromText('POINT(-96.0788 41.249)',4326), null, null, '2021-03-12T16:16:13.958802Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610740'),
-('e3c748ed-ec47-4ea4-a403-31e46bb5078c', False, 'bwmt', 'BWMT', null, ST_GeomFromText('POINT(-113.0684 45.8508)',4326), null, null, '2021-03-12T16:16:13.959216Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06024580'),
-('0f0258d9-a745-4b6b-bd94-dd92d7a69f6b', False, 'lone-lisco-north-platte', 'LONE-Lisco-North_Platte', null, ST_GeomFromText('POINT(-102.6248 41.4901)',4326), null, null, '2021-03-12T16:16:13.959555Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('1708e3ee-5558-4568-90b3-ab521bf33e13', False, 'lene-lewellen-north-platte', 'LENE-Lewellen-North_Platte', null, ST_GeomFromText('POINT(-102.1259 41.3155)',4326), null, null, '2021-03-12T16:16:13.959946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('a4f67cf5-bbff-4828-a4aa-14825befd1e4', False, 'spne-north-platte-south-platte', 'SPNE-North_Platte-South_Platte', null, ST_GeomFromText('POINT(-100.7728 41.1181)',4326), null, null, '2021-03-12T16:16:13.960360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('ee55f8b5-56c3-4152-8f74-0c4a7c4289a5', False, 'byne-brady-platte', 'BYNE-Brady-Platte', null, ST_GeomFromText('POINT(-100.3716 41.0193)',4326), null, null, '2021-03-12T16:16:13.960763Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('69c72b4c-8105-4b0e-842b-9bb0d9541579', False, 'jpmt', 'JPMT', null, ST_GeomFromText('POINT(-112.1872 45.7475)',4326), null, null, '2021-03-12T16:16:13.961104Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06027600'),
-('659984e2-a5b2-441f-8908-61639f770b2a', False, 'bbmt', 'BBMT', null, ST_GeomFromText('POINT(-112.0917 46.211)',4326), null, null, '2021-03-12T16:16:13.961440Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06033000'),
-('b4840f7d-fe33-4a4c-85c7-d0aaf8309935', False, 'whmt', 'WHMT', null, ST_GeomFromText('POINT(-111.741 45.7231)',4326), null, null, '2021-03-12T16:16:13.961788Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06035000'),
-('80405143-af53-4abe-8520-7e4694400ca0', False, 'fhmt', 'FHMT', null, ST_GeomFromText('POINT(-110.8635 44.6202)',4326), null, null, '2021-03-12T16:16:13.962147Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06036905'),
-('7d7beaf4-4ed6-4a67-99c9-374e98f3fa05', False, 'gmmt', 'GMMT', null, ST_GeomFromText('POINT(-110.8611 44.6408)',4326), null, null, '2021-03-12T16:16:13.962422Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06037100'),
-('f0707b5c-0d89-4a6b-9274-ec9195cac16d', False, 'gbmt', 'GBMT', null, ST_GeomFromText('POINT(-111.0662 45.7257)',4326), null, null, '2021-03-12T16:16:13.962707Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06048650'),
-('281945aa-f31b-46bc-b0ee-0277d429679d', False, 'czne-cozad-platte', 'CZNE-Cozad-Platte', null, ST_GeomFromText('POINT(-99.9867 40.8375)',4326), null, null, '2021-03-12T16:16:13.962929Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('705bb18f-5302-4ea8-a2de-03983ca1cfa0', False, 'ppmt', 'PPMT', null, ST_GeomFromText('POINT(-111.9468 46.5191)',4326), null, null, '2021-03-12T16:16:13.963357Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06061500'),
-('3aa75d03-d45d-41e0-b690-4283dd7a63f8', False, 'tmmt', 'TMMT', null, ST_GeomFromText('POINT(-112.0883 46.605)',4326), null, null, '2021-03-12T16:16:13.963685Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06063000'),
-('7cdbc592-b6bd-4ac4-adce-7e9339294580', False, 'semt', 'SEMT', null, ST_GeomFromText('POINT(-111.3862 47.1893)',4326), null, null, '2021-03-12T16:16:13.963956Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06077500'),
-('f05af919-83ff-4ba3-94f4-a26a00c28809', False, 'nsmt', 'NSMT', null, ST_GeomFromText('POINT(-112.8593 47.6399)',4326), null, null, '2021-03-12T16:16:13.964219Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06078500'),
-('6459e5ee-d5f9-4455-890d-08f7709c4e1a', False, 'ssmt', 'SSMT', null, ST_GeomFromText('POINT(-112.8658 47.6286)',4326), null, null, '2021-03-12T16:16:13.964512Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06079000'),
-('a94471df-d6da-44f5-8adc-e5c57e2220ba', False, 'cone-columbus-shell', 'CONE-Columbus-Shell', null, ST_GeomFromText('POINT(-97.2817 41.5261)',4326), null, null, '2021-03-12T16:16:13.964797Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06795500'),
-('200b2b6c-caab-416a-bb5c-dd025e4af5c2', False, 'chcr2-cherry-creek-dam-cherry', 'CHCR2-Cherry_Creek_Dam-Cherry', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.965294Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('c6117590-0ff9-43a0-be1b-ce895777b2a1', False, 'alne-ashland-salt', 'ALNE-Ashland-Salt', null, ST_GeomFromText('POINT(-96.3386 41.0517)',4326), null, null, '2021-03-12T16:16:13.965602Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06805000'),
-('b655853c-4e71-4e92-b189-140a19185af6', False, 'akne-omaha-aksarben-little-papillion', 'AKNE-Omaha-Aksarben-Little_Papillion', null, ST_GeomFromText('POINT(-96.0206 41.2453)',4326), null, null, '2021-03-12T16:16:13.965922Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610765'),
-('8b076630-aed6-42b8-9953-e75131697d0b', False, 'stmt-st-xavier-bridge-bighorn', 'STMT-St_Xavier_Bridge-Bighorn', null, ST_GeomFromText('POINT(-107.7484 45.4609)',4326), null, null, '2021-03-12T16:16:13.966381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06287800'),
-('7923051f-5fed-4189-b247-338d4c1f2a1c', False, 'fortrandalldcp', 'FortRandallDCP', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.966825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('9af7861e-bf85-44e3-8ea7-fb7985f7ee7a', False, 'fortpeckdcp', 'FortPeckDCP', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.968662Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('a064d8c4-c466-47f9-bac9-167a74e5d47a', False, 'garrisondcp', 'GarrisonDCP', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.970450Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('cddc1ae8-4364-4aeb-8f3d-4374c53a6326', False, 'lnsk-lindsborg-smoky-hill', 'LNSK-Lindsborg-Smoky_Hill', null, ST_GeomFromText('POINT(-97.7928 39.7986)',4326), null, null, '2021-03-12T16:16:13.972262Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06866000'),
-('cb985903-7a82-436d-8420-44d615689b3f', False, 'lcne-lincoln-salt', 'LCNE-Lincoln-Salt', null, ST_GeomFromText('POINT(-96.6817 40.8467)',4326), null, null, '2021-03-12T16:16:13.972835Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803500'),
-('a4d51061-8dda-46cd-bca2-e557744ffc88', False, 'haia-hamburg-nishnabotna', 'HAIA-Hamburg-Nishnabotna', null, ST_GeomFromText('POINT(-95.645 40.6017)',4326), null, null, '2021-03-12T16:16:13.973344Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06810000'),
-('3a5e97c8-bef6-46a9-96a3-f84c7363d824', False, 'sndk-scandia-republican', 'SNDK-Scandia-Republican', null, ST_GeomFromText('POINT(-97.7928 39.7986)',4326), null, null, '2021-03-12T16:16:13.973966Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06854500'),
-('ef41d78d-aea5-4e41-8e54-858d8ba6360a', False, 'mpsk-minneapolis-solomon', 'MPSK-Minneapolis-Solomon', null, ST_GeomFromText('POINT(-97.7928 39.7986)',4326), null, null, '2021-03-12T16:16:13.974391Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06876440'),
-('34aa979a-bafc-4f21-83d2-aa8c940b7f7c', False, 'hbgk-hollenberg-little-blue', 'HBGK-Hollenberg-Little_Blue', null, ST_GeomFromText('POINT(-97.0047 39.9803)',4326), null, null, '2021-03-12T16:16:13.974799Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06884025'),
-('eada8469-f87a-4638-b5dd-23c4b9830c9c', False, 'cbnd-1806-bridge-cannonball', 'CBND-1806_Bridge-Cannonball', null, ST_GeomFromText('POINT(-76.31 37.4928)',4326), null, null, '2021-03-12T16:16:13.975293Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '372934076183600'),
-('074e5eb7-4c14-4e65-91c8-1ec6053bda5a', False, 'wlsd-wewela-keya-paha', 'WLSD-Wewela-Keya_Paha', null, ST_GeomFromText('POINT(-99.7805 43.0271)',4326), null, null, '2021-03-12T16:16:13.975739Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06464500'),
-('d5be40fb-1d9b-42dc-b994-4688a90d3009', False, 'test1', 'Test1', null, ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:16:13.976224Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('e1c6edd8-1b70-41e4-9468-52f069439066', False, 'qsne-omaha-q-st-big-papillion', 'QSNE-Omaha-Q_St-Big_Papillion', null, ST_GeomFromText('POINT(-96.0172 41.2053)',4326), null, null, '2021-03-12T16:16:13.976490Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610770'),
-('ecca9a91-cdf1-493d-b7cb-ce7af8bfdc85', False, 'kdne-kennard-big-papillion', 'KDNE-Kennard-Big_Papillion', null, ST_GeomFromText('POINT(-96.1909 41.4511)',4326), null, null, '2021-03-12T16:16:13.976914Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610710'),
-('1eb1c777-b66a-40a2-a286-b64a95d2147a', False, 'pwne-omaha-pacific-st-west-papillion', 'PWNE-Omaha-Pacific_St-West_Papillion', null, ST_GeomFromText('POINT(-96.1681 41.2487)',4326), null, null, '2021-03-12T16:16:13.977363Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610780'),
-('d8bfed52-cd92-4a18-a890-ff8ebb412a50', False, 'chne-chalco-south-papillion', 'CHNE-Chalco-South_Papillion', null, ST_GeomFromText('POINT(-96.1392 41.1801)',4326), null, null, '2021-03-12T16:16:13.977752Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610788'),
-('5843abb7-129f-4c2a-a7b6-61aa64534c55', False, 'lnim-macks-creek-little-niangua', 'LNIM-Macks_Creek-Little_Niangua', null, ST_GeomFromText('POINT(-93.0669 37.9347)',4326), null, null, '2021-03-12T16:16:13.978342Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06925250'),
-('7afbf9cf-43d1-4e86-a9dc-e2a3fbe8520a', False, 'wphm-westphalia-maries', 'WPHM-Westphalia-Maries', null, ST_GeomFromText('POINT(-91.9886 38.4318)',4326), null, null, '2021-03-12T16:16:13.978958Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06927000'),
-('ced533fb-634a-46e6-b6d2-f651c649b639', False, 'fskk-fort-scott-marmaton', 'FSKK-Fort_Scott-Marmaton', null, ST_GeomFromText('POINT(-94.7025 37.8489)',4326), null, null, '2021-03-12T16:16:13.979486Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06917500'),
-('e311f638-3c4f-4d4c-bc3a-3e517d14ef70', False, 'jcmm-jefferson-city-moreau', 'JCMM-Jefferson_City-Moreau', null, ST_GeomFromText('POINT(-92.192 38.529)',4326), null, null, '2021-03-12T16:16:13.980382Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06910750'),
-('c3c0714b-b04d-43f8-908e-11a6e4f41a1b', False, 'tscm-tuscumbia-osage', 'TSCM-Tuscumbia-Osage', null, ST_GeomFromText('POINT(-92.4586 38.2325)',4326), null, null, '2021-03-12T16:16:13.980899Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06926080'),
-('08cd17d4-0296-4c6d-a4b5-11d2e86ec189', False, 'lnek-lane-pottawatomie', 'LNEK-Lane-Pottawatomie', null, ST_GeomFromText('POINT(-95.0839 38.4439)',4326), null, null, '2021-03-12T16:16:13.981407Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06914500'),
-('fab9363e-0a03-43f4-b23a-58a723fdfeba', False, 'cand', 'CAND', null, ST_GeomFromText('POINT(-99.2572 47.4575)',4326), null, null, '2021-03-12T16:16:13.981914Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('af80c8dc-af9d-44b9-89b0-196468672026', False, 'tvcm-st-elizabeth-tavern', 'TVCM-St_Elizabeth-Tavern', null, ST_GeomFromText('POINT(-92.2333 38.2778)',4326), null, null, '2021-03-12T16:16:13.982268Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06926290'),
-('d632c6cc-40f7-46f2-8a75-463cacd68f5d', False, 'urhm-urich-south-grand', 'URHM-Urich-South_Grand', null, ST_GeomFromText('POINT(-94.0042 38.4522)',4326), null, null, '2021-03-12T16:16:13.982723Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921600'),
-('ea56e292-d11f-484b-8b22-652422b71c50', False, 'tibr2-tiber-dam-marias', 'TIBR2-Tiber_Dam-Marias', null, ST_GeomFromText('POINT(-111.1022 48.3196)',4326), null, null, '2021-03-12T16:16:13.983062Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('1949fb6b-208c-437c-a5e3-dd60292f22da', False, 'husd-huron-james', 'HUSD-Huron-James', null, ST_GeomFromText('POINT(-98.1989 44.3636)',4326), null, null, '2021-03-12T16:16:13.983453Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06476000'),
-('970ed323-3238-4bbc-9f6c-ec7570d12bf8', False, 'camt-cascade-missouri', 'CAMT-Cascade-Missouri', null, ST_GeomFromText('POINT(-111.6952 47.2695)',4326), null, null, '2021-03-12T16:16:13.983924Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06074000'),
-('85925895-b419-44e1-b043-8a226d59579b', False, 'btne-bennington-thomas-cr', 'BTNE-Bennington-Thomas_Cr', null, ST_GeomFromText('POINT(-96.1009 41.3648)',4326), null, null, '2021-03-12T16:16:13.984347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610742'),
-('2f7b6180-6251-446c-a63a-8bbceb1a99de', False, 'bgsd-buffalo-gap-beaver-creek', 'BGSD-Buffalo_Gap-Beaver_Creek', null, ST_GeomFromText('POINT(-103.3056 43.4667)',4326), null, null, '2021-03-12T16:16:13.984757Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06402500'),
-('58ce7bf8-2a56-4150-b0b5-f3ffeeb054df', False, 'jefm-jefferson-city-missouri', 'JEFM-Jefferson_City-Missouri', null, ST_GeomFromText('POINT(-92.1786 38.5871)',4326), null, null, '2021-03-12T16:16:13.985202Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06910450'),
-('a2df19c9-23dc-4ff5-9c3e-7b2e278fa065', False, 'ptem-platte-city-platte', 'PTEM-Platte City-Platte', null, ST_GeomFromText('POINT(-94.7919 39.3744)',4326), null, null, '2021-03-12T16:16:13.986009Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('582eb4b2-dde2-46bb-8d31-e10aff94a6ea', False, 'bgcm8-antelope-big-muddy', 'BGCM8-Antelope-Big_Muddy', null, ST_GeomFromText('POINT(-104.5117 48.6728)',4326), null, null, '2021-03-12T16:16:13.986440Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06183450'),
-('545f78ee-7b7e-4d26-8962-ca7337d496b9', False, 'oahe', 'OAHE', null, ST_GeomFromText('POINT(-100.4053 44.45)',4326), null, null, '2021-03-12T16:16:13.986714Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('5dc056b8-0a42-43e0-b2ac-5f2c2b303b8b', False, 'bend', 'BEND', null, ST_GeomFromText('POINT(-99.4453 44.041)',4326), null, null, '2021-03-12T16:16:13.987705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('c33aab24-898e-4543-ac0b-8d1567ef9c2c', False, 'ssmo-sharps-station-platte', 'SSMO-Sharps_Station-Platte', null, ST_GeomFromText('POINT(-94.7268 39.401)',4326), null, null, '2021-03-12T16:16:13.988624Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06821190'),
-('ea3b2608-78cb-4de9-a3dd-f4254a8379a0', False, 'link-lincoln-saline', 'LINK-Lincoln-Saline', null, ST_GeomFromText('POINT(-98.1508 39.0286)',4326), null, null, '2021-03-12T16:16:13.988826Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06868850'),
-('f1f04ebe-31bf-4849-a805-c984b22d85c5', False, 'mssm-musselfork-mussel-fork', 'MSSM-Musselfork-Mussel_Fork', null, ST_GeomFromText('POINT(-92.9497 39.5236)',4326), null, null, '2021-03-12T16:16:13.989078Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06906000'),
-('9d337689-810d-47fd-bb28-7023dfda8bec', False, 'sfwy-valley-shoshone', 'SFWY-Valley-Shoshone', null, ST_GeomFromText('POINT(-109.555 44.2075)',4326), null, null, '2021-03-12T16:16:13.989276Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06280300'),
-('f22f4d8e-2857-4e81-817a-5463795d6ea6', False, 'nrne-niobrara-bazile-creek', 'NRNE-Niobrara-Bazile_Creek', null, ST_GeomFromText('POINT(-97.9225 42.7322)',4326), null, null, '2021-03-12T16:16:13.989712Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06466500'),
-('5bc5e78c-4fda-4a7c-a00c-d6f411e5c97a', False, 'bdmc-bridgeport-mc-north-platte', 'BDMC-Bridgeport-MC-North_Platte', null, ST_GeomFromText('POINT(-103.0969 41.6767)',4326), null, null, '2021-03-12T16:16:13.990060Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('32fd16e4-be27-429e-ac06-67a6aab44b01', False, 'sjne-st-james-missouri', 'SJNE-St_James-Missouri', null, ST_GeomFromText('POINT(-97.0819 42.7611)',4326), null, null, '2021-03-12T16:16:13.990395Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06478523'),
-('7f2ae867-0697-47fe-8e82-90cbd7f54729', False, 'cosd-columbia-james', 'COSD-Columbia-James', null, ST_GeomFromText('POINT(-98.31 45.6036)',4326), null, null, '2021-03-12T16:16:13.990795Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06471000'),
-('b5e73296-4a36-420e-9ed3-c27e409bae4b', False, 'lmcn8-williston-little-muddy', 'LMCN8-Williston-Little_Muddy', null, ST_GeomFromText('POINT(-103.5725 48.2844)',4326), null, null, '2021-03-12T16:16:13.991189Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06331000'),
-('05cff4a3-827e-4eb6-94c4-2fb8aa4830f4', False, 'bsne-omaha-blondo-st-cole-cr', 'BSNE-Omaha-Blondo_St-Cole_Cr', null, ST_GeomFromText('POINT(-96.0349 41.2777)',4326), null, null, '2021-03-12T16:16:13.991707Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610760'),
-('6fc0ed66-2b4f-4250-923c-0bc435bb855f', False, 'lwkk-bl-lawrence-kansas', 'LWKK-bl-Lawrence-Kansas', null, ST_GeomFromText('POINT(-95.2321 38.9733)',4326), null, null, '2021-03-12T16:16:13.992119Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06891080'),
-('59dca88e-00f6-4a3b-ace8-1ef60bb6723b', False, 'rcwr-ab-wrf-rapid-creek', 'RCWR-ab_WRF-Rapid_Creek', null, ST_GeomFromText('POINT(-103.1011 44.0267)',4326), null, null, '2021-03-12T16:16:13.992493Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06418800'),
-('19cc1527-ba9e-4373-8915-f423dc9f4e7f', False, 'brne-blair-big-papillion', 'BRNE-Blair-Big_Papillion', null, ST_GeomFromText('POINT(-96.2101 41.5091)',4326), null, null, '2021-03-12T16:16:13.992850Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610705'),
-('3826b569-3b1f-4026-957e-57ce402b558f', False, 'ramt', 'RAMT', null, ST_GeomFromText('POINT(-112.1428 45.1922)',4326), null, null, '2021-03-12T16:16:13.993185Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06019500'),
-('54458504-3594-4948-b177-ec0b74e8aa60', False, 'vgne-verdigre-verdigre-creek', 'VGNE-Verdigre-Verdigre_Creek', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.993348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('f02ff807-95dd-45b6-bfe3-67a003efc777', False, 'laud-lake-audubon-snake', 'LAUD-Lake_Audubon-Snake', null, ST_GeomFromText('POINT(-101.2661 47.6119)',4326), null, null, '2021-03-12T16:16:13.993611Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('252d2fa2-ec70-492b-b53c-116c3ab96cd6', False, 'gtne-gretna-south-papillion', 'GTNE-Gretna-South_Papillion', null, ST_GeomFromText('POINT(-96.2161 41.1727)',4326), null, null, '2021-03-12T16:16:13.993824Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610786'),
-('0a1e74b8-02be-44a4-83b0-81431648f1b0', False, 'rrbm8', 'RRBM8', null, ST_GeomFromText('POINT(-112.1112 45.2419)',4326), null, null, '2021-03-12T16:16:13.994226Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06020600'),
-('3bf640c1-45c9-4347-b928-14a6c079578d', False, 'tuco', 'TUCO', null, ST_GeomFromText('POINT(-105.1594 39.6407)',4326), null, null, '2021-03-12T16:16:13.994438Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06711040'),
-('ece6ba7c-2ff9-4f9f-9d33-14d11c5644a3', False, 'acnd', 'ACND', null, ST_GeomFromText('POINT(-101.6454 46.5453)',4326), null, null, '2021-03-12T16:16:13.994755Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06347000'),
-('f4cad785-c5f4-497c-b427-7a23f410237e', False, 'mcnd', 'MCND', null, ST_GeomFromText('POINT(-101.4674 46.6944)',4326), null, null, '2021-03-12T16:16:13.995054Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06347500'),
-('89b6656b-d04b-462e-8da1-4cf17b6d1089', False, 'sjnd', 'SJND', null, ST_GeomFromText('POINT(-101.2528 46.8511)',4326), null, null, '2021-03-12T16:16:13.995273Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06348500'),
-('3c9e8b39-150c-457f-90dd-edfbda909dac', False, 'rwsd', 'RWSD', null, ST_GeomFromText('POINT(-103.8394 44.6672)',4326), null, null, '2021-03-12T16:16:13.995537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06433000'),
-('6fb91d8f-963a-4114-a225-af5892e08a02', False, 'oma-omaha-missouri', 'OMA-Omaha-Missouri', null, ST_GeomFromText('POINT(-95.9222 41.2589)',4326), null, null, '2021-03-12T16:16:13.995918Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610000'),
-('35fc2043-ebf8-4e22-82ea-cfe643135087', False, 'arwy-anchor-reservoir-sf-owl', 'ARWY-Anchor_Reservoir-SF_Owl', null, ST_GeomFromText('POINT(-108.8242 43.6639)',4326), null, null, '2021-03-12T16:16:13.996483Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('1e114668-6e25-485c-af05-dd3404302c21', False, 'hvmt-helena-valley-reservoir-tenmile', 'HVMT-Helena_Valley_Reservoir-Tenmile', null, ST_GeomFromText('POINT(-111.8822 46.6381)',4326), null, null, '2021-03-12T16:16:13.996698Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('078291a7-60c7-4287-ab3f-718b44ea9855', False, 'prmt-pishkun-reservoir-pishkun', 'PRMT-Pishkun_Reservoir-Pishkun', null, ST_GeomFromText('POINT(-112.4967 47.6767)',4326), null, null, '2021-03-12T16:16:13.996923Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('56afa295-949f-4e70-904b-dad374d497b4', False, 'scne-lincoln-stevens-cr', 'SCNE-Lincoln-Stevens_Cr', null, ST_GeomFromText('POINT(-96.595 40.8569)',4326), null, null, '2021-03-12T16:16:13.997384Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803520'),
-('b2e27e47-124d-4236-a54b-f43d8d959f46', False, 'rcwy-abv-red-cr-wind', 'RCWY-Abv_Red_Cr-Wind', null, ST_GeomFromText('POINT(-109.458 43.4417)',4326), null, null, '2021-03-12T16:16:13.997704Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06220800'),
-('c917e4a5-dd53-444b-828a-3a1c9ff16dff', False, 'hbmt-hardin-bighorn', 'HBMT-Hardin-Bighorn', null, ST_GeomFromText('POINT(-107.6575 45.6442)',4326), null, null, '2021-03-12T16:16:13.998059Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06288400'),
-('bb63a385-2f0e-4bc7-8b99-497af8bcba31', False, 'mine-mitchell-north-platte', 'MINE-Mitchell-North_Platte', null, ST_GeomFromText('POINT(-103.8136 41.9267)',4326), null, null, '2021-03-12T16:16:13.998310Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06679500'),
-('41f6602f-28f7-4385-8a10-b352c8449851', False, 'bdbc-bridgeport-bc-north-platte', 'BDBC-Bridgeport-BC-North_Platte', null, ST_GeomFromText('POINT(-103.0989 41.6822)',4326), null, null, '2021-03-12T16:16:13.998618Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('2abb4d27-0786-41a7-97cf-fde10c7ffe28', False, 'lrwy-laramie-laramie', 'LRWY-Laramie-Laramie', null, ST_GeomFromText('POINT(-105.6074 41.3278)',4326), null, null, '2021-03-12T16:16:13.998954Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06660000'),
-('3a32e8ac-1fec-4f6d-9ed4-b3cb6f658e97', False, 'mtmc-minatare-mc-north-platte', 'MTMC-Minatare-MC-North_Platte', null, ST_GeomFromText('POINT(-103.5189 41.7889)',4326), null, null, '2021-03-12T16:16:13.999297Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('dd5666a0-8235-482e-8fc5-ff1979d04697', False, 'mtnm-minatare-nc-north-platte', 'MTNM-Minatare-NC-North_Platte', null, ST_GeomFromText('POINT(-103.5192 41.7922)',4326), null, null, '2021-03-12T16:16:13.999564Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('4d832078-386e-4be3-a291-0f9de1c97bea', False, 'mdne-millard-west-papillion', 'MDNE-Millard-West_Papillion', null, ST_GeomFromText('POINT(-96.128 41.2071)',4326), null, null, '2021-03-12T16:16:13.999848Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610785'),
-('59c5f6d0-7a7c-44a2-8569-e3fef44f245e', False, 'hzlm-hazelgreen-gasconade', 'HZLM-Hazelgreen-Gasconade', null, ST_GeomFromText('POINT(-92.4517 37.7592)',4326), null, null, '2021-03-12T16:16:14.000339Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06928000'),
-('e340d571-0b0d-4649-b5b6-6ae971d93d42', False, 'hpco-homestake-pipeline-south-platte', 'HPCO-Homestake_Pipeline-South_Platte', null, ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:16:14.000759Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('3ab894f9-dc13-498a-b044-a93b9bc0ad64', False, 'rcne-ceresco-rock-cr', 'RCNE-Ceresco-Rock_Cr', null, ST_GeomFromText('POINT(-96.5442 41.0158)',4326), null, null, '2021-03-12T16:16:14.001197Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803530'),
-('fe8dfd31-bbf6-4bcd-9540-d10be11407fb', False, 'acne-lincoln-27th-st-antelope-cr', 'ACNE-Lincoln-27th_St-Antelope_Cr', null, ST_GeomFromText('POINT(-96.6851 40.8049)',4326), null, null, '2021-03-12T16:16:14.001423Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803300'),
-('00b81e91-1a1d-4207-be84-6c2c01563efe', False, 'gavinsdcp', 'GavinsDCP', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:14.001619Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('46df7dfd-4a82-4069-928d-d42761f6d4ae', False, 'npne-north-platte-north-platte', 'NPNE-North_Platte-North_Platte', null, ST_GeomFromText('POINT(-100.7591 41.1539)',4326), null, null, '2021-03-12T16:16:14.003107Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('060208b2-d8a3-4d43-a0f0-ec16da0cddf5', False, 'fcne-omaha-fort-crook-papillion', 'FCNE-Omaha-Fort_Crook-Papillion', null, ST_GeomFromText('POINT(-95.9377 41.1178)',4326), null, null, '2021-03-12T16:16:14.003383Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610795'),
-('566ecbe8-8e84-4cf8-8847-4ef78cb023cd', False, 'ptne-plattsmouth-missouri', 'PTNE-Plattsmouth-Missouri', null, ST_GeomFromText('POINT(-95.8664 41.0011)',4326), null, null, '2021-03-12T16:16:14.003872Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06805600'),
-('d0fcb5cd-c6a6-4c8e-b63b-f22df2c44aac', False, 'sttm-st-thomas-osage', 'STTM-St_Thomas-Osage', null, ST_GeomFromText('POINT(-92.2083 38.4214)',4326), null, null, '2021-03-12T16:16:14.004347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06926510'),
-('d7573d91-0d4e-4a97-9ae4-8ab33af806f5', False, 'gram-graham-nodaway', 'GRAM-Graham-Nodaway', null, ST_GeomFromText('POINT(-95.0696 40.2025)',4326), null, null, '2021-03-12T16:16:14.004803Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06817700'),
-('cd37544f-1e8b-43aa-ae86-c261502a4328', False, 'bhmt-bighorn-bighorn', 'BHMT-Bighorn-Bighorn', null, ST_GeomFromText('POINT(-107.4688 46.1245)',4326), null, null, '2021-03-12T16:16:14.005270Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06294500'),
-('348136ef-9683-4726-b218-ed3ed91ced84', False, 'bltk-beloit-solomon', 'BLTK-Beloit-Solomon', null, ST_GeomFromText('POINT(-98.1094 39.4544)',4326), null, null, '2021-03-12T16:16:14.005829Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06876000'),
-('ef1dd9ec-f644-4363-a046-12bc7d4410fc', False, 'wrdy-dubois-wind', 'WRDY-Dubois-Wind', null, ST_GeomFromText('POINT(-109.7592 43.5786)',4326), null, null, '2021-03-12T16:16:14.006266Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06218500'),
-('2b220e2f-85a8-4a5e-b12a-99c4a96b81eb', False, 'sond-solen-cannonball', 'SOND-Solen-Cannonball', null, ST_GeomFromText('POINT(-100.6667 46.3333)',4326), null, null, '2021-03-12T16:16:14.006751Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('334c4b1d-d168-4fa2-b5c0-65cbe3ad7269', False, 'bcmt', 'BCMT', null, ST_GeomFromText('POINT(-113.4494 45.4229)',4326), null, null, '2021-03-12T16:16:14.006991Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06024020'),
-('4cfef721-71a6-4cfc-b0e1-3593e034ca7a', False, 'trmt', 'TRMT', null, ST_GeomFromText('POINT(-112.2566 46.5239)',4326), null, null, '2021-03-12T16:16:14.007209Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06062500'),
-('cd86a8d9-d92e-4713-b964-151d4e570f3f', False, 'clak-clay-center-republican', 'CLAK-Clay_Center-Republican', null, ST_GeomFromText('POINT(-97.1272 39.3556)',4326), null, null, '2021-03-12T16:16:14.007471Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06856600'),
-('352d122e-8356-4ef9-a93e-95967856f040', False, 'sc08-salt-cr-dam-8-wagon-train-lake', 'SC08-Salt_Cr_Dam_8-Wagon_Train_Lake', null, ST_GeomFromText('POINT(-96.583 40.6203)',4326), null, null, '2021-03-12T16:16:14.007828Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('a72410a9-52ed-41ca-9ff2-dc80e6fdab21', False, 'levk-leavenworth-missouri', 'LEVK-Leavenworth-Missouri', null, ST_GeomFromText('POINT(-94.9094 39.3269)',4326), null, null, '2021-03-12T16:16:14.008343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06820475'),
-('77cf06c5-78a4-4a81-b5a0-5a1065c40820', False, 'blne-blair-missouri', 'BLNE-Blair-Missouri', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:14.008804Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('b510e75b-471d-496d-b57f-a292bac9924c', False, 'lwkk-lawrence-kansas', 'LWKK-Lawrence-Kansas', null, ST_GeomFromText('POINT(-95.2321 38.9733)',4326), null, null, '2021-03-12T16:16:14.009284Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06891080'),
-('8dca412f-b025-4830-a8be-9b5b5d3da7f4', False, 'bwwy-worland-bighorn', 'BWWY-Worland-Bighorn', null, ST_GeomFromText('POINT(-107.9695 44.0147)',4326), null, null, '2021-03-12T16:16:14.009696Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06268600'),
-('58d6f25b-0c1c-49b0-8ce0-64233d17a7e8', False, 'whwy-diversionfortlaramie', 'WHWY-DiversionFortLaramie', null, ST_GeomFromText('POINT(-104.628 42.241)',4326), null, null, '2021-03-12T16:16:14.010071Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('10bc773b-1299-42b5-bb58-e816cb00fc35', False, 'whwy-diversioninterstate', 'WHWY-DiversionInterstate', null, ST_GeomFromText('POINT(-104.628 42.241)',4326), null, null, '2021-03-12T16:16:14.010412Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('44306453-3078-43f9-81bf-9d8af58423e4', False, 'spin', 'SPIN', null, ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:16:14.010723Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('a6cce52b-daf8-4da6-86e9-29a011496537', False, 'hcne-lyman-horse-creek', 'HCNE-Lyman-Horse_Creek', null, ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:14.011004Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
-('f2ea9fcf-e58b-4988-bb3e-3f3cbaab88c1', False, 'bpne-bennington-big-papillion', 'BPNE-Bennington-Big_Papillion', null, ST_GeomFromText('POINT(-96.1829 41.3788)',4326), null, null, '2021-03-12T16:16:14.011204Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610720'),
-('e6774d47-3028-43b5-8bc5-b19daa5cb5af', False, 'elne-elkhorn-west-papillion', 'ELNE-Elkhorn-West_Papillion', null, ST_GeomFromText('POINT(-96.2525 41.2929)',4326), null, null, '2021-03-12T16:16:14.011537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610773'),
-('fc686b25-8626-4ef1-92a5-b32619be7b6c', False, 'tbvm', 'TBVM', null, ST_GeomFromText('POINT(-93.9947 38.0039)',4326), null, null, '2021-03-12T16:16:14.011944Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918250'),
-('8d38c7bb-79ce-4352-aa83-c01d705d2ea9', False, 'rsne', 'RSNE', null, ST_GeomFromText('POINT(-101.5767 41.1261)',4326), null, null, '2021-03-12T16:16:14.012262Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06764880'),
-('fff6b3d6-749b-46fd-8452-8af42f0a3511', False, 'bigm', 'BIGM', null, ST_GeomFromText('POINT(-92.058 37.7602)',4326), null, null, '2021-03-12T16:16:14.012654Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06930060'),
-('284b8d03-1d86-40bd-9e2f-2b3f7d6c9657', False, 'nhnd', 'NHND', null, ST_GeomFromText('POINT(-103.0532 47.0278)',4326), null, null, '2021-03-12T16:16:14.012967Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06344600');
+('f1329c17-e386-4f5b-b297-c817d5b6f73c', False, 'grnd-grand-rapids-james', 'GRND-Grand_Rapids-James', ST_GeomFromText('POINT(-98.3614 46.4422)',4326), null, null, '2021-03-12T16:16:13.744497Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06470475'),
+('076ffc60-3e9e-452f-88e1-6886fea4eca2', False, 'chmt-chester-marias', 'CHMT-Chester-Marias', ST_GeomFromText('POINT(-111.0805 48.306)',4326), null, null, '2021-03-12T16:16:13.744678Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06101500'),
+('e4aaf87e-d1ba-41c0-aad1-9c22634fd5f8', False, 'hsmt-bl-hauser-dam-missouri', 'HSMT-bl_Hauser_Dam-Missouri', ST_GeomFromText('POINT(-111.8888 46.7662)',4326), null, null, '2021-03-12T16:16:13.744889Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06065500'),
+('636bc081-089e-45af-bd14-8cda7985cbda', False, 'aune-auburn-little-nemaha', 'AUNE-Auburn-Little_Nemaha', ST_GeomFromText('POINT(-95.8128 40.3928)',4326), null, null, '2021-03-12T16:16:13.745059Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06811500'),
+('844e3566-76a5-4264-b6a0-5874b603ad70', False, 'cbco-ab-cheesman-dam-south-platte', 'CBCO-ab_Cheesman_Dam-South_Platte', ST_GeomFromText('POINT(-105.3097 39.1628)',4326), null, null, '2021-03-12T16:16:13.745240Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06700000'),
+('b0d5e262-76fe-4605-9fcc-debdd563e0e3', False, 'adak-ada-salt', 'ADAK-Ada-Salt', ST_GeomFromText('POINT(-97.8367 39.1392)',4326), null, null, '2021-03-12T16:16:13.745424Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06876700'),
+('b3a708f5-79c0-4765-9ed1-7be5795c3c49', False, 'cssd-sioux-falls-silver', 'CSSD-Sioux_Falls-Silver', ST_GeomFromText('POINT(-96.7314 43.6022)',4326), null, null, '2021-03-12T16:16:13.745493Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06482010'),
+('d9d5db16-8f00-46cb-8245-dc97696350fc', False, 'gdmt-gibson-dam-north-fork-sun', 'GDMT-Gibson_Dam-North_Fork_Sun', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.745626Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('1f12a4b7-01d6-45ba-82eb-7c15155174f3', False, 'akia-akron-big-sioux', 'AKIA-Akron-Big_Sioux', ST_GeomFromText('POINT(-96.562 42.837)',4326), null, null, '2021-03-12T16:16:13.745822Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06485500'),
+('816b67df-af41-4fe0-81da-12135e96f64b', False, 'ania-alton-floyd', 'ANIA-Alton-Floyd', ST_GeomFromText('POINT(-96.0008 42.9819)',4326), null, null, '2021-03-12T16:16:13.746016Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06600100'),
+('5a51b53f-6a3d-4e49-8f90-1f9f5026fe85', False, 'ldsd-spearfish-spearfish', 'LDSD-Spearfish-Spearfish', ST_GeomFromText('POINT(-103.8611 44.4825)',4326), null, null, '2021-03-12T16:16:13.746307Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06431500'),
+('604eef87-ef8e-499f-9c66-c8bbc1b0c1b2', False, 'anga-angostura-dam-cheyenne', 'ANGA-Angostura_Dam-Cheyenne', ST_GeomFromText('POINT(-103.4378 43.3431)',4326), null, null, '2021-03-12T16:16:13.746538Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06401000'),
+('3ec7053b-1d85-40d0-b496-b54ef3c478b8', False, 'anco-bl-antero-res-south-platte', 'ANCO-bl_Antero_Res-South_Platte', ST_GeomFromText('POINT(-105.9445 38.9939)',4326), null, null, '2021-03-12T16:16:13.746765Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('7fc5fceb-c9fe-47c5-be02-0928ca75a164', False, 'arsd-argyle-road-fall', 'ARSD-Argyle_Road-Fall', ST_GeomFromText('POINT(-103.4902 43.4715)',4326), null, null, '2021-03-12T16:16:13.746868Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('5a2aa39e-baed-4198-af05-67f50e67e765', False, 'arhm-archie-south-grand', 'ARHM-Archie-South_Grand', ST_GeomFromText('POINT(-94.3442 38.4916)',4326), null, null, '2021-03-12T16:16:13.747079Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921590'),
+('871e2686-6afd-4ee4-ae8d-9c68541ba946', False, 'ardk-arnold-smoky-hill', 'ARDK-Arnold-Smoky_Hill', ST_GeomFromText('POINT(-100.0222 38.8078)',4326), null, null, '2021-03-12T16:16:13.747338Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06861000'),
+('cf58a570-3175-441c-8adb-1ff4d310b396', False, 'awnd-arrowwood-res-james', 'AWND-Arrowwood_Res-James', ST_GeomFromText('POINT(-98.8529 47.2592)',4326), null, null, '2021-03-12T16:16:13.747598Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('8e2c8ef6-13aa-4886-a511-c31fd23de666', False, 'asne-ashland-platte', 'ASNE-Ashland-Platte', ST_GeomFromText('POINT(-96.3247 41.0622)',4326), null, null, '2021-03-12T16:16:13.747723Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06801000'),
+('dba269e2-f403-4c7e-a9dc-5efa6780a169', False, 'atlm-atlanta-long-branch', 'ATLM-Atlanta-Long_Branch', ST_GeomFromText('POINT(-92.4933 39.8973)',4326), null, null, '2021-03-12T16:16:13.747987Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06906150'),
+('fd904240-bfcc-435d-a86e-65bb13d4875f', False, 'assd-ashton-james', 'ASSD-Ashton-James', ST_GeomFromText('POINT(-98.4806 44.9983)',4326), null, null, '2021-03-12T16:16:13.748117Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06473000'),
+('bd64c58d-0417-4d38-b30d-7d4a1d220d0f', False, 'baco-bailey-nf-south-platte', 'BACO-Bailey-NF_South_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.748283Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('2f9699ce-d1bc-4309-8628-a4e5e8ef3c47', False, 'bark-barnes-little-blue', 'BARK-Barnes-Little_Blue', ST_GeomFromText('POINT(-96.8044 39.7258)',4326), null, null, '2021-03-12T16:16:13.748385Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06884400'),
+('e54f5d4a-2932-4fab-a7a1-1b1bf0bcebae', False, 'erco-evergreen-bear', 'ERCO-Evergreen-Bear', ST_GeomFromText('POINT(-105.3369 39.6332)',4326), null, null, '2021-03-12T16:16:13.748541Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06710385'),
+('8bb43003-5390-49c3-8f30-7c683794421f', False, 'brmt-barretts-beaverhead', 'BRMT-Barretts-Beaverhead', ST_GeomFromText('POINT(-112.7505 45.0995)',4326), null, null, '2021-03-12T16:16:13.748678Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06016000'),
+('14cf3fb3-b52e-46b0-b8d8-5886ce2e45ef', False, 'barn-barneston-big-blue', 'BARN-Barneston-Big_Blue', ST_GeomFromText('POINT(-96.5872 40.0447)',4326), null, null, '2021-03-12T16:16:13.748839Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06882000'),
+('c90c04d2-ed7f-4dba-943b-cda2d0c4cb53', False, 'byco-bailey-weather-nf-s-platte', 'BYCO-Bailey_Weather-NF_S_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.749019Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('8e658f14-4941-4fd8-9764-ba93e05d70fb', False, 'sfs-ab-buffalo-bill-dam-sf-shoshone', 'SFS-ab_Buffalo_Bill_Dam-SF_Shoshone', ST_GeomFromText('POINT(-109.2572 44.4192)',4326), null, null, '2021-03-12T16:16:13.749120Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06281000'),
+('aaceee4b-ad00-4040-be21-8afd1fcf0cfe', False, 'bccl-burl-well-canal-south-platte', 'BCCL-Burl_Well_Canal-South_Platte', ST_GeomFromText('POINT(-104.9664 39.7919)',4326), null, null, '2021-03-12T16:16:13.749286Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('fa75cfb7-f158-4461-b777-2d317fdd9731', False, 'becr-bear-creek-dam-bear', 'BECR-Bear_Creek_Dam-Bear', ST_GeomFromText('POINT(-105.1385 39.6529)',4326), null, null, '2021-03-12T16:16:13.749386Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('71681c0a-f6e3-4982-9400-e449360d3772', False, 'bhdy-bighorn-canal-bighorn', 'BHDY-Bighorn_Canal-Bighorn', ST_GeomFromText('POINT(-108.0169 43.9453)',4326), null, null, '2021-03-12T16:16:13.749538Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('07378d53-a7a6-4ef8-8ec9-3c86ff53f433', False, 'sznd-oakes-bear', 'SZND-Oakes-Bear', ST_GeomFromText('POINT(-98.0714 46.2253)',4326), null, null, '2021-03-12T16:16:13.749683Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06470800'),
+('4e5cb760-97bf-4344-bce2-dfd80d99299c', False, 'moco-ab-bear-cr-dam-bear', 'MOCO-Ab_Bear_Cr_Dam-Bear', ST_GeomFromText('POINT(-105.1731 39.6522)',4326), null, null, '2021-03-12T16:16:13.749852Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06710605'),
+('3e87a6f2-f587-4b65-9ee8-de36d1ac9bd4', False, 'pa11-papio-cr-dam-11-cunningham-lake', 'PA11-Papio_Cr_Dam_11-Cunningham_Lake', ST_GeomFromText('POINT(-96.0507 41.3384)',4326), null, null, '2021-03-12T16:16:13.749999Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('7c05506c-218c-483a-880c-0570a4aeee2f', False, 'bedi-bedford-east-fork-102', 'BEDI-Bedford-East_Fork_102', ST_GeomFromText('POINT(-94.7164 40.6606)',4326), null, null, '2021-03-12T16:16:13.750209Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06819185'),
+('f4da5b3e-2538-42ef-a260-9cffee1dc386', False, 'bnkn-benkleman-republican', 'BNKN-Benkleman-Republican', ST_GeomFromText('POINT(-163.6744 67.8761)',4326), null, null, '2021-03-12T16:16:13.750396Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06827500'),
+('653259a0-94b1-442a-9ecc-8b1138e7f945', False, 'bemo-bethany-e-fork-big', 'BEMO-Bethany-E_Fork_Big', ST_GeomFromText('POINT(-94.0262 40.2972)',4326), null, null, '2021-03-12T16:16:13.750585Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06897000'),
+('48fe10ab-721f-44c6-a94a-1564967c3b10', False, 'bfc-bl-belle-fourche-res-belle-f', 'BFC-bl_Belle_Fourche_Res-Belle_F', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.750760Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('d1b49e26-c36b-4bfb-95b9-95caea9f94a1', False, 'bfr-belle-fourche-res-belle-fourche', 'BFR-Belle_Fourche_Res-Belle_Fourche', ST_GeomFromText('POINT(-103.677 44.7304)',4326), null, null, '2021-03-12T16:16:13.750862Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06435000'),
+('673f60a3-b388-4a3c-98dd-0b32fbdc5fc4', False, 'tqwy-basin-bighorn', 'TQWY-Basin-Bighorn', ST_GeomFromText('POINT(-108.0356 44.3833)',4326), null, null, '2021-03-12T16:16:13.751029Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06274300'),
+('6bfb1665-430d-44f8-b897-1efd963264f5', False, 'yetl-yellowtail-dam-bighorn-lake', 'YETL-Yellowtail_Dam-Bighorn_Lake', ST_GeomFromText('POINT(-107.9582 45.3068)',4326), null, null, '2021-03-12T16:16:13.751206Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('5b2257ca-20d7-424f-8100-410b7433e9ba', False, 'bil-billings-yellowstone', 'BIL-Billings-Yellowstone', ST_GeomFromText('POINT(-108.4681 45.8002)',4326), null, null, '2021-03-12T16:16:13.751400Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06214500'),
+('8f9890cd-75a2-4ffc-a49f-61b003d4d68c', False, 'bis-bismarck-missouri', 'BIS-Bismarck-Missouri', ST_GeomFromText('POINT(-100.8214 46.8142)',4326), null, null, '2021-03-12T16:16:13.751681Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06342500'),
+('3f4c5eb6-0b5c-43a4-b9ea-5e6fff8464f1', False, 'blay-ab-bull-lake-bull-lake', 'BLAY-ab_Bull_Lake-Bull_Lake', ST_GeomFromText('POINT(-109.2026 43.1766)',4326), null, null, '2021-03-12T16:16:13.751900Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06224000'),
+('2b256b3a-106c-4fcb-95ed-cf9672df698e', False, 'blrk-blue-rapids-big-blue', 'BLRK-Blue_Rapids-Big_Blue', ST_GeomFromText('POINT(-96.6397 39.6872)',4326), null, null, '2021-03-12T16:16:13.752074Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06884700'),
+('17cbcc48-45f0-4d98-9994-2f164d5366fb', False, 'blrm-blairstown-big-creek', 'BLRM-Blairstown-Big_Creek', ST_GeomFromText('POINT(-93.9654 38.555)',4326), null, null, '2021-03-12T16:16:13.752266Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921720'),
+('3f9a06b6-9ed0-4f1c-be72-3fb3fc71e7cc', False, 'bula-bull-lake-dam-bull-lake', 'BULA-Bull_Lake_Dam-Bull_Lake', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.752418Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('27cab640-13a8-4f81-8a73-66ae6489eb1a', False, 'blcm-blue-lick-blackwater', 'BLCM-Blue_Lick-Blackwater', ST_GeomFromText('POINT(-93.1967 38.9922)',4326), null, null, '2021-03-12T16:16:13.752518Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06908000'),
+('522478b0-ef0d-4a71-8471-9685dd06f60c', False, 'bnmo-boonville-missouri', 'BNMO-Boonville-Missouri', ST_GeomFromText('POINT(-92.7453 38.9803)',4326), null, null, '2021-03-12T16:16:13.752699Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06909000'),
+('2028a54b-bcb6-43ea-8503-ba5648e97659', False, 'brsd-bruce-big-sioux', 'BRSD-Bruce-Big_Sioux', ST_GeomFromText('POINT(-96.8871 44.4676)',4326), null, null, '2021-03-12T16:16:13.753051Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479770'),
+('e8e2539a-0f6d-4458-af60-e35150c45a94', False, 'brnd-breien-cannonball', 'BRND-Breien-Cannonball', ST_GeomFromText('POINT(-100.9344 46.3761)',4326), null, null, '2021-03-12T16:16:13.753300Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06354000'),
+('9963f2e8-a844-463d-9e9c-cd9d0ca66fff', False, 'brgk-burlingame-dragoon', 'BRGK-Burlingame-Dragoon', ST_GeomFromText('POINT(-95.8381 38.7092)',4326), null, null, '2021-03-12T16:16:13.753581Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06911900'),
+('301e8240-a1a1-4ec0-8866-5b7fdc7841b4', False, 'bosd-brookings-big-sioux', 'BOSD-Brookings-Big_Sioux', ST_GeomFromText('POINT(-96.7492 44.1803)',4326), null, null, '2021-03-12T16:16:13.753739Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06480000'),
+('e80d63eb-5d97-47f5-9928-d6498a1f3e48', False, 'bvne-brownville-missouri', 'BVNE-Brownville-Missouri', ST_GeomFromText('POINT(-95.6533 40.3989)',4326), null, null, '2021-03-12T16:16:13.753952Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06810070'),
+('d3d790f9-3f07-4b24-9c3b-c415c2de41c6', False, 'bspm-blue-springs-lake-little-blue', 'BSPM-Blue_Springs_Lake-Little_Blue', ST_GeomFromText('POINT(-94.3355 39.0169)',4326), null, null, '2021-03-12T16:16:13.754155Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893885'),
+('c5c8786d-adcd-4dae-9ecd-32362a2df41a', False, 'blck-lenore-bull-lake', 'BLCK-Lenore-Bull_Lake', ST_GeomFromText('POINT(-110.3109 43.576)',4326), null, null, '2021-03-12T16:16:13.754288Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '13014300'),
+('41bdf558-da9a-4451-964e-1af3bf961c45', False, 'bunk-bunker-hill-smoky-hill', 'BUNK-Bunker_Hill-Smoky_Hill', ST_GeomFromText('POINT(-98.8544 38.7767)',4326), null, null, '2021-03-12T16:16:13.754502Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06864050'),
+('8c01f7aa-b411-4474-b804-12316f0a0fb9', False, 'brok-burr-oak-white-rock', 'BROK-Burr_Oak-White_Rock', ST_GeomFromText('POINT(-98.25 39.8992)',4326), null, null, '2021-03-12T16:16:13.754668Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06853800'),
+('7e724780-6b47-40a0-9162-97ea748055ae', False, 'bvuk-belvue-kansas', 'BVUK-Belvue-Kansas', ST_GeomFromText('POINT(-96.1472 39.1931)',4326), null, null, '2021-03-12T16:16:13.754826Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06888350'),
+('867bc4b2-fd0d-4c4a-989f-7c18f74b5835', False, 'boha-bowman-haley-dam-grand', 'BOHA-Bowman_Haley_Dam-Grand', ST_GeomFromText('POINT(-103.2461 45.9823)',4326), null, null, '2021-03-12T16:16:13.754941Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('6a738f79-a603-4dff-a041-b4f5be007cf4', False, 'bfwy-state-line-belle-fourche', 'BFWY-State_Line-Belle_Fourche', ST_GeomFromText('POINT(-104.0511 44.7489)',4326), null, null, '2021-03-12T16:16:13.755302Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06428500'),
+('2ada3609-8038-4874-a4be-58bbde433506', False, 'bzco-balzac-south-platte', 'BZCO-Balzac-South_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.755522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('febb6021-f55c-498a-93dd-52e21e88087c', False, 'cmbn-cambridge-republican', 'CMBN-Cambridge-Republican', ST_GeomFromText('POINT(-100.1436 40.2844)',4326), null, null, '2021-03-12T16:16:13.755646Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06843500'),
+('36e5d6a7-765d-4faa-b1b7-37a1aff71703', False, 'llnd-lake-lamoure-cottonwood', 'LLND-Lake_Lamoure-Cottonwood', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.755746Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('a16d890e-5a49-410d-bff9-de9683864898', False, 'cold-coldbrook-dam-cold-br', 'COLD-Coldbrook_Dam-Cold_Br', ST_GeomFromText('POINT(-103.4888 43.4542)',4326), null, null, '2021-03-12T16:16:13.755879Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06401950'),
+('c6b3e8a8-b605-4040-9718-f55d902eb494', False, 'fcsd-lk-francis-case-missouri', 'FCSD-Lk_Francis_Case-Missouri', ST_GeomFromText('POINT(-99.3269 43.8161)',4326), null, null, '2021-03-12T16:16:13.756126Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06442996'),
+('6da5d199-7369-450c-be0c-65179d395468', False, 'cdbk-cedar-bluffs-beaver', 'CDBK-Cedar_Bluffs-Beaver', ST_GeomFromText('POINT(-100.5597 39.985)',4326), null, null, '2021-03-12T16:16:13.756283Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06846500'),
+('f1ee263f-259c-452d-bb9f-7ebbd1a6d383', False, 'cott-cottonwood-dam-cottonwood-spr', 'COTT-Cottonwood_Dam-Cottonwood_Spr', ST_GeomFromText('POINT(-103.5666 43.4388)',4326), null, null, '2021-03-12T16:16:13.756430Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('35e480ae-9c24-41e8-8dc1-5bec61bbaea4', False, 'cdco-denver-cherry', 'CDCO-Denver-Cherry', ST_GeomFromText('POINT(-104.9994 39.7425)',4326), null, null, '2021-03-12T16:16:13.756559Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06713500'),
+('56a01993-9347-4822-85b2-772cc517fc77', False, 'bubi-buffalo-bill-dam-shoshone', 'BUBI-Buffalo_Bill_Dam-Shoshone', ST_GeomFromText('POINT(-109.1843 44.5014)',4326), null, null, '2021-03-12T16:16:13.756796Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('0cec77e3-51cd-4b29-ad88-937258c3a5ce', False, 'chfi-chatfield-dam-south-platte', 'CHFI-Chatfield_Dam-South_Platte', ST_GeomFromText('POINT(-105.0621 39.5587)',4326), null, null, '2021-03-12T16:16:13.756909Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('64e1124d-6c14-4ac0-9855-3e1fa4eb56b5', False, 'chco-bl-cherry-creek-dam-cherry', 'CHCO-bl_Cherry_Creek_Dam-Cherry', ST_GeomFromText('POINT(-104.8625 39.6536)',4326), null, null, '2021-03-12T16:16:13.757157Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06713000'),
+('9d6a8700-86e6-4767-a691-0fea65a61460', False, 'chpk-chapman-chapman', 'CHPK-Chapman-Chapman', ST_GeomFromText('POINT(-97.04 39.0311)',4326), null, null, '2021-03-12T16:16:13.757333Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06878000'),
+('0a89f0be-30d3-4303-9094-be797a483d2c', False, 'chma-cheesman-dam-south-platte', 'CHMA-Cheesman_Dam-South_Platte', ST_GeomFromText('POINT(-105.2721 39.2087)',4326), null, null, '2021-03-12T16:16:13.757470Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('5e9af63f-923d-4f4e-8e6f-5a856f29f46d', False, 'chti-chariton-chariton', 'CHTI-Chariton-Chariton', ST_GeomFromText('POINT(-93.2596 40.9519)',4326), null, null, '2021-03-12T16:16:13.757576Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06903400'),
+('08c967c4-729b-4ecd-b718-afce30521d89', False, 'chmo-chillicothe-grand', 'CHMO-Chillicothe-Grand', ST_GeomFromText('POINT(-93.5586 39.7558)',4326), null, null, '2021-03-12T16:16:13.757792Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06899680'),
+('eb187a4c-b817-4803-a511-f9473aeeb0d1', False, 'clzm-clinton-south-grand', 'CLZM-Clinton-South_Grand', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.757967Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('ca084b28-7035-4b3b-940f-4d722e3635cf', False, 'clmt-culbertson-missouri', 'CLMT-Culbertson-Missouri', ST_GeomFromText('POINT(-104.4733 48.1235)',4326), null, null, '2021-03-12T16:16:13.758105Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06185500'),
+('8665a3dc-9dff-47f0-a696-b38107484e16', False, 'clin-clinton-lake-wakarusa', 'CLIN-Clinton_Lake-Wakarusa', ST_GeomFromText('POINT(-95.3323 38.931)',4326), null, null, '2021-03-12T16:16:13.758319Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06891478'),
+('7d026a9b-8e17-415c-812e-b825593b75ad', False, 'clca-clark-canyon-dam-red-rock', 'CLCA-Clark_Canyon_Dam-Red_Rock', ST_GeomFromText('POINT(-112.8589 45.0007)',4326), null, null, '2021-03-12T16:16:13.758452Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('819f2722-fd0a-477b-af79-9ae6b1e4181b', False, 'nork-norton-prairie-dog', 'NORK-Norton-Prairie_Dog', ST_GeomFromText('POINT(-100.1003 39.7697)',4326), null, null, '2021-03-12T16:16:13.758706Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06847900'),
+('addde4ed-facb-4ded-98b3-9318e21dd462', False, 'mrkr-cameron-madison', 'MRKR-Cameron-Madison', ST_GeomFromText('POINT(-111.5809 44.8887)',4326), null, null, '2021-03-12T16:16:13.758904Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06038800'),
+('0d215cae-b9ea-4377-b00c-54bf013457d1', False, 'cpmo-caplinger-mills-sac', 'CPMO-Caplinger_Mills-Sac', ST_GeomFromText('POINT(-93.8032 37.8698)',4326), null, null, '2021-03-12T16:16:13.759105Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06919900'),
+('54a4bbb3-eb76-4c6c-a800-21d0ac188321', False, 'cnkk-concordia-republican', 'CNKK-Concordia-Republican', ST_GeomFromText('POINT(-97.6581 39.5886)',4326), null, null, '2021-03-12T16:16:13.759252Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06856000'),
+('134be154-4b5f-4e18-9af8-a6b3fb03d9ce', False, 'cncl-conifer-turkey', 'CNCL-Conifer-Turkey', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.759376Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('bf703aa1-d94a-46ce-98a3-659edb7b00c8', False, 'csmt-corwin-springs-yellowstone', 'CSMT-Corwin_Springs-Yellowstone', ST_GeomFromText('POINT(-110.7937 45.1121)',4326), null, null, '2021-03-12T16:16:13.759546Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06191500'),
+('f40994dd-f746-4111-a179-d7bba6c08c38', False, 'paco-parker-cherry', 'PACO-Parker-Cherry', ST_GeomFromText('POINT(-104.7792 39.5192)',4326), null, null, '2021-03-12T16:16:13.759819Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '393109104464500'),
+('7415ff9c-b60c-4b67-885c-75c7c7e5b563', False, 'cawy-casper-north-platte', 'CAWY-Casper-North_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.760040Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('97ad958d-754d-44d6-a6c1-1e1ec9af1fcd', False, 'wrch-crowheart-wind', 'WRCH-Crowheart-Wind', ST_GeomFromText('POINT(-110.1787 43.8071)',4326), null, null, '2021-03-12T16:16:13.760300Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '13011820'),
+('ecde649c-cde0-4dad-8106-6c61fc5adecd', False, 'ceia-correctionville-little-sioux', 'CEIA-Correctionville-Little_Sioux', ST_GeomFromText('POINT(-95.7926 42.4822)',4326), null, null, '2021-03-12T16:16:13.760521Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06606600'),
+('bc4addb5-9202-49ec-bc47-71dd4a83d0d9', False, 'crtn-crete-big-blue', 'CRTN-Crete-Big_Blue', ST_GeomFromText('POINT(-96.9606 40.5967)',4326), null, null, '2021-03-12T16:16:13.760796Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06881000'),
+('b78217b8-97e5-4cde-a1a0-0fea2a9faed5', False, 'txsd-cash-south-fork-grand', 'TXSD-Cash-South_Fork_Grand', ST_GeomFromText('POINT(-102.6429 45.6487)',4326), null, null, '2021-03-12T16:16:13.761013Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06356500'),
+('de7b24a9-eca4-4a6d-88f0-592fbb2e3e69', False, 'casd-castlewood-big-sioux', 'CASD-Castlewood-Big_Sioux', ST_GeomFromText('POINT(-97.0443 44.7316)',4326), null, null, '2021-03-12T16:16:13.761202Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479525'),
+('0b9aa79f-376d-4546-9960-9eaa97aa1cb6', False, 'ctnd-cartwright-yellowstone', 'CTND-Cartwright-Yellowstone', ST_GeomFromText('POINT(-103.9664 47.8619)',4326), null, null, '2021-03-12T16:16:13.761398Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06329610'),
+('dcdf927d-463a-47d4-8eea-401e10b2c0cf', False, 'culn-culbertson-frenchman', 'CULN-Culbertson-Frenchman', ST_GeomFromText('POINT(-100.8778 40.2347)',4326), null, null, '2021-03-12T16:16:13.761559Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06835500'),
+('73ef7260-506c-46d9-82eb-aedab7fdcb72', False, 'cafe-canyon-ferry-dam-missouri', 'CAFE-Canyon_Ferry_Dam-Missouri', ST_GeomFromText('POINT(-111.7286 46.6477)',4326), null, null, '2021-03-12T16:16:13.761705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('edba772f-26ec-48b0-9504-5b23205cd1dd', False, 'tfsd-canyon-lake-rapid', 'TFSD-Canyon_Lake-Rapid', ST_GeomFromText('POINT(-103.3114 44.0528)',4326), null, null, '2021-03-12T16:16:13.761859Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06412500'),
+('331ed6b2-f538-4189-9624-0487e1e5af3f', False, 'drmt-craig-dearborn', 'DRMT-Craig-Dearborn', ST_GeomFromText('POINT(-112.0959 47.199)',4326), null, null, '2021-03-12T16:16:13.762175Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06073500'),
+('e44a6ec5-2a8e-446f-be19-2fe7722a2eee', False, 'dene-decatur-missouri', 'DENE-Decatur-Missouri', ST_GeomFromText('POINT(-96.2414 42.0072)',4326), null, null, '2021-03-12T16:16:13.762371Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06601200'),
+('ef7849ee-edac-4eac-91fe-95b11102b077', False, 'ddvm-dadeville-sac', 'DDVM-Dadeville-Sac', ST_GeomFromText('POINT(-93.6849 37.4431)',4326), null, null, '2021-03-12T16:16:13.762845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918440'),
+('20643ffd-8466-4827-9618-668fdcffe4cd', False, 'delk-delia-soldier', 'DELK-Delia-Soldier', ST_GeomFromText('POINT(-95.8883 39.2383)',4326), null, null, '2021-03-12T16:16:13.763025Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06889200'),
+('32c32a5c-d82c-49f0-bb4b-75afef200828', False, 'den-denver-south-platte', 'DEN-Denver-South_Platte', ST_GeomFromText('POINT(-105.004 39.7594)',4326), null, null, '2021-03-12T16:16:13.763188Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('ce1aaeb8-7414-42c7-8987-b61ee2a1cd51', False, 'drsd-dell-rapids-big-sioux', 'DRSD-Dell_Rapids-Big_Sioux', ST_GeomFromText('POINT(-96.7453 43.7905)',4326), null, null, '2021-03-12T16:16:13.763314Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06481000'),
+('cd487959-460e-4a81-a958-3fb70f2438b1', False, 'dimt-dillon-beaverhead', 'DIMT-Dillon-Beaverhead', ST_GeomFromText('POINT(-112.6553 45.2184)',4326), null, null, '2021-03-12T16:16:13.763753Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06017000'),
+('fad6a302-37ac-4c27-88c5-ee3a7765466e', False, 'dcby-burris-dinwoody', 'DCBY-Burris-Dinwoody', ST_GeomFromText('POINT(-109.4086 43.3461)',4326), null, null, '2021-03-12T16:16:13.763895Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06221400'),
+('987d480e-818e-47d5-a2e7-d4c4727e9db0', False, 'cecl-bl-cheesman-dam-south-platte', 'CECL-bl_Cheesman_Dam-South_Platte', ST_GeomFromText('POINT(-105.2672 39.0917)',4326), null, null, '2021-03-12T16:16:13.764039Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('3c1f4e0c-cb26-4843-be7c-a3383e437043', False, 'dmrk-damar-south-fork-solomon', 'DMRK-Damar-South_Fork_Solomon', ST_GeomFromText('POINT(-99.5797 39.3767)',4326), null, null, '2021-03-12T16:16:13.764215Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06873000'),
+('8592a90d-976a-4a90-8354-309edb6d17a0', False, 'mdmt-nr-dodson-milk', 'MDMT-nr_Dodson-Milk', ST_GeomFromText('POINT(-108.2941 48.4028)',4326), null, null, '2021-03-12T16:16:13.764394Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06155030'),
+('21764380-6f97-483a-ac16-72da9b169b3a', False, 'dind-dickinson-dam-heart', 'DIND-Dickinson_Dam-Heart', ST_GeomFromText('POINT(-102.8269 46.8697)',4326), null, null, '2021-03-12T16:16:13.764557Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('d3b12d54-682e-4ace-a37e-e1dc5fcd6b5e', False, 'decl-derby-clear', 'DECL-Derby-Clear', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.764721Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('334a6942-bc04-4bb6-b1b8-4c3c26c5466f', False, 'dfsd-deerfield-dam-castle', 'DFSD-Deerfield_Dam-Castle', ST_GeomFromText('POINT(-103.7858 44.0291)',4326), null, null, '2021-03-12T16:16:13.764891Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('d076feb3-7840-4224-ab0d-9412d13e8754', False, 'drgn-dorchester-west-fork-big-blue', 'DRGN-Dorchester-West_Fork_Big_Blue', ST_GeomFromText('POINT(-97.1772 40.7311)',4326), null, null, '2021-03-12T16:16:13.765162Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06880800'),
+('ce44554a-7816-44f8-acfa-09122454580c', False, 'deso-desoto-kansas', 'DESO-DeSoto-Kansas', ST_GeomFromText('POINT(-94.9647 38.983)',4326), null, null, '2021-03-12T16:16:13.765303Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06892350'),
+('cfadae4a-5a6c-4335-a534-be6ed57393a5', False, 'dvsi-davis-city-thompson', 'DVSI-Davis_City-Thompson', ST_GeomFromText('POINT(-93.8081 40.6403)',4326), null, null, '2021-03-12T16:16:13.765518Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06898000'),
+('bcc65d4f-87d2-4697-9144-f3e06ed0dd08', False, 'dwen-deweese-little-blue', 'DWEN-Deweese-Little_Blue', ST_GeomFromText('POINT(-98.0669 40.3325)',4326), null, null, '2021-03-12T16:16:13.765691Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06883000'),
+('6eb662e8-7434-4b12-aa35-745a595af96e', False, 'stl-st-louis-mississippi', 'STL-St_Louis-Mississippi', ST_GeomFromText('POINT(-90.1798 38.629)',4326), null, null, '2021-03-12T16:16:13.765933Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '7010000'),
+('df529778-914d-477b-aa50-2c75f759c2be', False, 'edgk-edgerton-big-bull', 'EDGK-Edgerton-Big_Bull', ST_GeomFromText('POINT(-94.9769 38.7533)',4326), null, null, '2021-03-12T16:16:13.766149Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06914950'),
+('0161085d-5636-4599-b146-35d022a873bd', False, 'cred-edgemont-cheyenne', 'CRED-Edgemont-Cheyenne', ST_GeomFromText('POINT(-103.8206 43.3056)',4326), null, null, '2021-03-12T16:16:13.766344Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06395000'),
+('247d6542-65e0-4c70-9e23-875cb97a3467', False, 'cebl-cedar-bluff-res-smoky-hill', 'CEBL-Cedar_Bluff_Res-Smoky_Hill', ST_GeomFromText('POINT(-99.7222 38.7846)',4326), null, null, '2021-03-12T16:16:13.766574Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06861500'),
+('434d91d3-1df5-4e14-98a7-4a9f320113f6', False, 'ewks-ellsworth-smoky-hill', 'EWKS-Ellsworth-Smoky_Hill', ST_GeomFromText('POINT(-98.2333 38.7267)',4326), null, null, '2021-03-12T16:16:13.766715Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06864500'),
+('385662f1-41db-4301-8d2c-53531b4a3331', False, 'egco-englewood-south-platte', 'EGCO-Englewood-South_Platte', ST_GeomFromText('POINT(-105.0036 39.665)',4326), null, null, '2021-03-12T16:16:13.766867Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06711565'),
+('4926b290-7f76-493e-a420-8349cb3286ba', False, 'epks-enterprise-smoky-hill', 'EPKS-Enterprise-Smoky_Hill', ST_GeomFromText('POINT(-97.1175 38.9064)',4326), null, null, '2021-03-12T16:16:13.767007Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06877600'),
+('ea37c6ab-07a5-47b1-93ca-96c09949dd4a', False, 'enco-union-south-platte', 'ENCO-Union-South_Platte', ST_GeomFromText('POINT(-105.0144 39.6325)',4326), null, null, '2021-03-12T16:16:13.767159Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06710247'),
+('a960f057-006e-4c78-9fcb-5a49756e9f2e', False, 'frsd-frederick-elm', 'FRSD-Frederick-Elm', ST_GeomFromText('POINT(-98.7017 45.8375)',4326), null, null, '2021-03-12T16:16:13.767360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06471065'),
+('0a784d80-065b-4228-96f7-06cc47e538b7', False, 'sc14-salt-cr-dam-14-pawnee-lake', 'SC14-Salt_Cr_Dam_14-Pawnee_Lake', ST_GeomFromText('POINT(-96.8668 40.8393)',4326), null, null, '2021-03-12T16:16:13.767539Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('b7e22fd7-05bf-4a57-8568-12b5f6e028e6', False, 'ecmt-eastern-crossing-milk', 'ECMT-Eastern_Crossing-Milk', ST_GeomFromText('POINT(-110.4218 48.9748)',4326), null, null, '2021-03-12T16:16:13.767771Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06135000'),
+('b22ed1f7-2625-49ec-8b08-675a8352bbab', False, 'tane-ewing-elkhorn', 'TANE-Ewing-Elkhorn', ST_GeomFromText('POINT(-98.3394 42.2684)',4326), null, null, '2021-03-12T16:16:13.767989Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06797500'),
+('83b5dd58-f2ca-4099-a6b2-170e60f492e7', False, 'tbsd-farmingdale-rapid', 'TBSD-Farmingdale-Rapid', ST_GeomFromText('POINT(-102.8535 43.9421)',4326), null, null, '2021-03-12T16:16:13.768164Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06421500'),
+('990123ba-904d-43cc-8ef6-40bbd2220e06', False, 'tcco-rocky-mtn-ars-first', 'TCCO-Rocky_Mtn_Ars-First', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.768331Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('0e27bd8a-cda6-4c81-94bd-17088a988b1a', False, 'fkfk-frankfort-black-vermillion', 'FKFK-Frankfort-Black_Vermillion', ST_GeomFromText('POINT(-96.4425 39.6819)',4326), null, null, '2021-03-12T16:16:13.768492Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06885500'),
+('86d330ef-d69c-48cc-b685-a3868cd5a159', False, 'flwy-fort-laramie-laramie', 'FLWY-Fort_Laramie-Laramie', ST_GeomFromText('POINT(-104.5378 42.2006)',4326), null, null, '2021-03-12T16:16:13.768661Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06670500'),
+('1b76f4fc-c3b5-4bd0-b9a8-ac057c5ee32a', False, 'flsd-florence-big-sioux', 'FLSD-Florence-Big_Sioux', ST_GeomFromText('POINT(-97.1858 45.1808)',4326), null, null, '2021-03-12T16:16:13.768877Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479215'),
+('7f794ee3-9f60-47fb-b2af-9c48d07c7552', False, 'ftco-fort-morgan-south-platte', 'FTCO-Fort_Morgan-South_Platte', ST_GeomFromText('POINT(-103.8012 40.2684)',4326), null, null, '2021-03-12T16:16:13.769110Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06759500'),
+('a5fa91b1-645e-4cf9-8c98-62f2e66f7d3a', False, 'ftsd-forestburg-james', 'FTSD-Forestburg-James', ST_GeomFromText('POINT(-98.0706 43.9739)',4326), null, null, '2021-03-12T16:16:13.769263Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06477000'),
+('25c2c657-cce3-41b2-9058-915cd88c1350', False, 'fpmt-blw-ft-peck-res-missouri', 'FPMT-Blw_Ft_Peck_Res-Missouri', ST_GeomFromText('POINT(-106.3563 48.0444)',4326), null, null, '2021-03-12T16:16:13.769445Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06132000'),
+('2f7c6724-559d-4737-8be7-89c7efb85914', False, 'fpsd-fort-pierre-bad', 'FPSD-Fort_Pierre-Bad', ST_GeomFromText('POINT(-100.3839 44.3267)',4326), null, null, '2021-03-12T16:16:13.769631Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06441500'),
+('5c5d07ab-1877-454d-a832-9434dc099695', False, 'frbn-fairbury-little-blue', 'FRBN-Fairbury-Little_Blue', ST_GeomFromText('POINT(-97.2007 40.1465)',4326), null, null, '2021-03-12T16:16:13.769902Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06884000'),
+('df91d7b5-d1fa-491a-952a-3f2f3cccbde2', False, 'frcl-franktown-cherry', 'FRCL-Franktown-Cherry', ST_GeomFromText('POINT(-104.7628 39.3558)',4326), null, null, '2021-03-12T16:16:13.770052Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06712000'),
+('71005ded-a786-4f11-8ec7-d095cfa585bd', False, 'fisd-pierre-missouri-farm-island', 'FISD-Pierre-Missouri-Farm_Island', ST_GeomFromText('POINT(-100.265 44.3342)',4326), null, null, '2021-03-12T16:16:13.770272Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06441595'),
+('fdfdb20b-0abb-47cc-afbb-389082614863', False, 'ftnk-fulton-little-osage', 'FTNK-Fulton-Little_Osage', ST_GeomFromText('POINT(-94.7039 38.0089)',4326), null, null, '2021-03-12T16:16:13.770429Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06917000'),
+('b299f3b3-98b0-47ed-be0b-d8c3e3e068d6', False, 'fri-fort-riley-kansas', 'FRI-Fort_Riley-Kansas', ST_GeomFromText('POINT(-96.7661 39.0617)',4326), null, null, '2021-03-12T16:16:13.770634Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06879100'),
+('9451dc72-2ef0-4a37-8083-90cc1b49a4c5', False, 'gcnd-grace-city-james', 'GCND-Grace_City-James', ST_GeomFromText('POINT(-98.8625 47.5581)',4326), null, null, '2021-03-12T16:16:13.770823Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06468170'),
+('597cd3d1-8a27-4d87-94b2-12ff6d58258b', False, 'gdek-glade-north-fork-solomon', 'GDEK-Glade-North_Fork_Solomon', ST_GeomFromText('POINT(-99.3092 39.6731)',4326), null, null, '2021-03-12T16:16:13.771043Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06871000'),
+('5f681c8a-abf0-4d37-bd15-ad758f05f14e', False, 'glnk-bl-glen-elder-solomon', 'GLNK-bl_Glen_Elder-Solomon', ST_GeomFromText('POINT(-98.2833 39.4739)',4326), null, null, '2021-03-12T16:16:13.771205Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06875900'),
+('08671a17-680a-439c-8f66-25b222b2bad2', False, 'gfmo-greenfield-turnback', 'GFMO-Greenfield-Turnback', ST_GeomFromText('POINT(-93.802 37.4024)',4326), null, null, '2021-03-12T16:16:13.771346Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918460'),
+('459c4e9c-9c0e-41c4-afc3-38089af62325', False, 'gtco-georgetown-clear', 'GTCO-Georgetown-Clear', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.771492Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('1971a820-fc80-4672-b616-d86a29cfcafb', False, 'glwy-bl-glendo-dam-north-platte', 'GLWY-bl_Glendo_Dam-North_Platte', ST_GeomFromText('POINT(-105.9472 42.4569)',4326), null, null, '2021-03-12T16:16:13.771656Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('d16d9696-b54e-4e7f-a70a-d885c3385e2f', False, 'glcl-golden-clear', 'GLCL-Golden-Clear', ST_GeomFromText('POINT(-105.2347 39.7531)',4326), null, null, '2021-03-12T16:16:13.771828Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06719505'),
+('34a3d5b5-80f6-44a2-a58e-982162ea7070', False, 'glel-waconda-lake-solomon', 'GLEL-Waconda_Lake-Solomon', ST_GeomFromText('POINT(-98.314 39.4857)',4326), null, null, '2021-03-12T16:16:13.772024Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('3ca83c12-fe17-423e-b41c-40326d5c41f5', False, 'gvmt-glendive-yellowstone', 'GVMT-Glendive-Yellowstone', ST_GeomFromText('POINT(-104.7184 47.1059)',4326), null, null, '2021-03-12T16:16:13.772204Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06327500'),
+('efbbf87e-707e-467c-935a-cff89351b325', False, 'grwy-glenrock-north-platte', 'GRWY-Glenrock-North_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.772376Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('119eae7a-ea0c-41ee-a9fc-6e64979e0b15', False, 'hebu-heart-butte-dam-heart', 'HEBU-Heart_Butte_Dam-Heart', ST_GeomFromText('POINT(-101.8101 46.5979)',4326), null, null, '2021-03-12T16:16:13.772546Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('dbc80028-a537-446e-8d38-3c602e662b67', False, 'glmo-glasgow-missouri', 'GLMO-Glasgow-Missouri', ST_GeomFromText('POINT(-92.8486 39.2222)',4326), null, null, '2021-03-12T16:16:13.772870Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06906500'),
+('c5da0351-b00b-4999-938b-95c929ab7d18', False, 'glmt-glen-big-hole', 'GLMT-Glen-Big_Hole', ST_GeomFromText('POINT(-112.5561 45.4401)',4326), null, null, '2021-03-12T16:16:13.773092Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06026210'),
+('a154ccac-5692-4cb6-a74d-7238d12c9029', False, 'tysd-white-butte-nf-grand', 'TYSD-White_Butte-NF_Grand', ST_GeomFromText('POINT(-102.3619 45.8022)',4326), null, null, '2021-03-12T16:16:13.773259Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06355500'),
+('8a277f3a-e8ab-4810-9867-e5eea7ca3e74', False, 'grne-grand-island-platte', 'GRNE-Grand_Island-Platte', ST_GeomFromText('POINT(-98.2817 40.8744)',4326), null, null, '2021-03-12T16:16:13.773453Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06770500'),
+('6689b893-bc87-48cd-a942-6350440327e2', False, 'grcl-grant-nf-south-platte', 'GRCL-Grant-NF_South_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.773651Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('fa2c73bd-f6c5-4783-932a-241c196911db', False, 'gwne-greenwood-salt', 'GWNE-Greenwood-Salt', ST_GeomFromText('POINT(-96.4544 40.9656)',4326), null, null, '2021-03-12T16:16:13.773857Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803555'),
+('a7fc47bd-35e5-4ab7-b855-1916bd92dd2d', False, 'gycl-greeley-cache-la-poudre', 'GYCL-Greeley-Cache_La_Poudre', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.774066Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('4a01d9fe-263a-4d29-9bef-9e318aea668b', False, 'gdkn-guide-rock-republican', 'GDKN-Guide_Rock-Republican', ST_GeomFromText('POINT(-98.3308 40.0642)',4326), null, null, '2021-03-12T16:16:13.774226Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06853020'),
+('5b1c35c6-e9dc-4c1f-9634-072be4a300c2', False, 'mbwy-sinclair-medicine-bow', 'MBWY-Sinclair-Medicine_Bow', ST_GeomFromText('POINT(-106.5125 42.0097)',4326), null, null, '2021-03-12T16:16:13.774418Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06635000'),
+('fd0d85c3-62f2-4c66-b0e3-dd536156d9b2', False, 'hacl-ab-11-mile-res-south-platte', 'HACL-ab_11-Mile_Res-South_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.774561Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('c8b1df5e-f24e-446b-a364-737579c91299', False, 'fdmt-fresno-dam-milk', 'FDMT-Fresno_Dam-Milk', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.774771Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('706bf886-ab29-443c-b95a-0d0d6384e3a5', False, 'hebn-hebgen-dam-madison', 'HEBN-Hebgen_Dam-Madison', ST_GeomFromText('POINT(-111.3388 44.8664)',4326), null, null, '2021-03-12T16:16:13.775028Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06038500'),
+('1ec2be89-d47f-42ac-a7f8-1a23876fb228', False, 'sc09-salt-cr-dam-9-stagecoach-lake', 'SC09-Salt_Cr_Dam_9-Stagecoach_Lake', ST_GeomFromText('POINT(-96.6363 40.6043)',4326), null, null, '2021-03-12T16:16:13.775142Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('ba756f6a-5db2-4dee-bbec-094a35c2ac6e', False, 'harn-hardy-republican', 'HARN-Hardy-Republican', ST_GeomFromText('POINT(-97.9322 39.9925)',4326), null, null, '2021-03-12T16:16:13.775303Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06853500'),
+('3321eb20-2f0f-4c37-86ae-7d53fa5a5e00', False, 'hewy-state-line-north-platte', 'HEWY-State_Line-North_Platte', ST_GeomFromText('POINT(-104.0528 41.9886)',4326), null, null, '2021-03-12T16:16:13.775552Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06674500'),
+('a277254c-0fbd-4cdf-bddb-da8174ef1bc7', False, 'hils-hillsdale-lake-big-bull', 'HILS-Hillsdale_Lake-Big_Bull', ST_GeomFromText('POINT(-94.9026 38.6539)',4326), null, null, '2021-03-12T16:16:13.775744Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06914995'),
+('6dfa5493-0187-499e-a2f2-595215494cff', False, 'hilk-hillsdale-bl-big-bull', 'HILK-Hillsdale-bl_Big_Bull', ST_GeomFromText('POINT(-94.8961 38.6564)',4326), null, null, '2021-03-12T16:16:13.775848Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06915000'),
+('67fd3251-69be-4747-9dc9-774e3026457a', False, 'hncl-henderson-south-platte', 'HNCL-Henderson-South_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.775974Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('7d4ee274-b078-491c-bfb3-f3edb9b92521', False, 'hcia-hancock-west-nishnabotna', 'HCIA-Hancock-West_Nishnabotna', ST_GeomFromText('POINT(-95.3714 41.39)',4326), null, null, '2021-03-12T16:16:13.776146Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06807410'),
+('82591b74-73c7-4104-bec9-aff039d29f26', False, 'hutm-huntsville-east-fork-chariton', 'HUTM-Huntsville-East_Fork_Chariton', ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:16:13.776347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('5c44a8d0-ecac-41fa-9f8b-dfe8e44a7cca', False, 'hnia-hornick-west-fork-ditch', 'HNIA-Hornick-West_Fork_Ditch', ST_GeomFromText('POINT(-96.0778 42.2269)',4326), null, null, '2021-03-12T16:16:13.776480Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06602020'),
+('67489f5c-5830-4ae0-bb2a-92729804573a', False, 'hssd-hot-springs-fall', 'HSSD-Hot_Springs-Fall', ST_GeomFromText('POINT(-103.4758 43.4306)',4326), null, null, '2021-03-12T16:16:13.776668Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06402000'),
+('e02b0d75-2e39-4b5a-9e34-7f9f57d9dda6', False, 'hamt-hardin-little-bighorn', 'HAMT-Hardin-Little_Bighorn', ST_GeomFromText('POINT(-107.5575 45.7357)',4326), null, null, '2021-03-12T16:16:13.776826Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06294000'),
+('09bedd5d-0087-4b7a-84c7-9c03f937ecae', False, 'mrhm-harlem-milk', 'MRHM-Harlem-Milk', ST_GeomFromText('POINT(-108.7589 48.4896)',4326), null, null, '2021-03-12T16:16:13.776940Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06154100'),
+('b7e91e0b-d213-4f2c-a138-3046dff46706', False, 'hemo-hermann-missouri', 'HEMO-Hermann-Missouri', ST_GeomFromText('POINT(-91.4385 38.7098)',4326), null, null, '2021-03-12T16:16:13.777090Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06934500'),
+('65c1c681-cbbd-4e1e-a054-ead05db1613f', False, 'prmi-promise-city-south-fork-chariton', 'PRMI-Promise_City-South_Fork_Chariton', ST_GeomFromText('POINT(-93.1922 40.8006)',4326), null, null, '2021-03-12T16:16:13.777447Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06903700'),
+('71fde463-b529-47e0-80e4-80ca18d3cf01', False, 'pery-perry-lake-delaware', 'PERY-Perry_Lake-Delaware', ST_GeomFromText('POINT(-95.4265 39.1134)',4326), null, null, '2021-03-12T16:16:13.777551Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06890898'),
+('85ffd7fa-6b48-45b6-ac98-cdec98976c86', False, 'psia-pisgah-soldier', 'PSIA-Pisgah-Soldier', ST_GeomFromText('POINT(-95.9311 41.8306)',4326), null, null, '2021-03-12T16:16:13.777656Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06608500'),
+('16683ec3-e9e8-4e0a-85d9-ba18dc572241', False, 'ptsk-portis-nf-solomon', 'PTSK-Portis-NF_Solomon', ST_GeomFromText('POINT(-98.6919 39.555)',4326), null, null, '2021-03-12T16:16:13.777899Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06872500'),
+('f6d36b76-4fed-4831-9767-6601032e2e42', False, 'ptcl-platteville-st-vrain', 'PTCL-Platteville-St_Vrain', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.778032Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('e13f6e31-8d65-40cd-84c7-2dc70c9c1058', False, 'podt-pomme-de-terre-lake-pomme-de-ter', 'PODT-Pomme_De_Terre_Lake-Pomme_De_Ter', ST_GeomFromText('POINT(-93.3192 37.9012)',4326), null, null, '2021-03-12T16:16:13.778208Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921325'),
+('c2bf0151-0bfd-4dda-8865-aa670e9aa00b', False, 'pdtm-hermitage-pomme-de-terre', 'PDTM-Hermitage-Pomme_De_Terre', ST_GeomFromText('POINT(-93.3289 37.9061)',4326), null, null, '2021-03-12T16:16:13.778356Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921350'),
+('4fa36003-665c-4391-850b-1b1cb0c6bfd2', False, 'plvm-pleasant-view-cedar', 'PLVM-Pleasant_View-Cedar', ST_GeomFromText('POINT(-93.8753 37.8342)',4326), null, null, '2021-03-12T16:16:13.778458Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06919500'),
+('1143fc95-4779-4501-abde-db641fa1e747', False, 'pxck-paxico-mill', 'PXCK-Paxico-Mill', ST_GeomFromText('POINT(-96.1811 39.0622)',4326), null, null, '2021-03-12T16:16:13.778558Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06888500'),
+('189da51e-9efa-4a46-82a4-494e2f378284', False, 'pomk-quenemo-110', 'POMK-Quenemo-110', ST_GeomFromText('POINT(-95.5594 38.645)',4326), null, null, '2021-03-12T16:16:13.778803Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06912500'),
+('75e3a848-0938-43ab-95de-a1709e714b86', False, 'rati-bl-rathbun-chariton', 'RATI-bl_Rathbun-Chariton', ST_GeomFromText('POINT(-92.8911 40.8219)',4326), null, null, '2021-03-12T16:16:13.778948Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06903900'),
+('0e340e20-8557-4331-adb5-da9d46f7aea3', False, 'ratn-rathbun-lake-chariton', 'RATN-Rathbun_Lake-Chariton', ST_GeomFromText('POINT(-92.8866 40.8266)',4326), null, null, '2021-03-12T16:16:13.779076Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06903880'),
+('a91b4700-d5af-4f38-bb5a-217110e91665', False, 'rasd-rauville-mud', 'RASD-Rauville-Mud', ST_GeomFromText('POINT(-97.1059 45.0082)',4326), null, null, '2021-03-12T16:16:13.779241Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479490'),
+('d14719ef-f8a0-4ff5-8e4f-c6b9cabcc6a1', False, 'visd-abv-victoria-cr-rapid', 'VISD-abv_Victoria_Cr-Rapid', ST_GeomFromText('POINT(-103.3511 44.0466)',4326), null, null, '2021-03-12T16:16:13.779549Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06412200'),
+('2666a47d-d86c-473e-83f6-7bec80850af9', False, 'sc18-salt-cr-dam-18-branched-oak-lake', 'SC18-Salt_Cr_Dam_18-Branched_Oak_Lake', ST_GeomFromText('POINT(-96.8527 40.9706)',4326), null, null, '2021-03-12T16:16:13.779747Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('ecf32f7c-da6c-4e41-99b6-69ef87f7aec8', False, 'rtcl-roberts-tunnel-south-platte', 'RTCL-Roberts_Tunnel-South_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.780058Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06100046'),
+('fee43214-e313-4b84-adf6-a5a17986de27', False, 'rldk-richland-wakarusa', 'RLDK-Richland-Wakarusa', ST_GeomFromText('POINT(-95.5944 38.8919)',4326), null, null, '2021-03-12T16:16:13.780227Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06891260'),
+('f3497024-85f5-432a-b62e-2ac8ad0b84c5', False, 'rcsd-rapid-city-rapid', 'RCSD-Rapid_City-Rapid', ST_GeomFromText('POINT(-103.2414 44.0855)',4326), null, null, '2021-03-12T16:16:13.780429Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06414000'),
+('4243bfb9-a3a4-4d6e-9055-9c9e7f177fa5', False, 'mfnd', 'MFND', ST_GeomFromText('POINT(-99.7478 47.6981)',4326), null, null, '2021-03-12T16:16:13.780605Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('a9f658e3-758f-4bf1-b4aa-581b1073783b', False, 'simt-sidney-yellowstone', 'SIMT-Sidney-Yellowstone', ST_GeomFromText('POINT(-104.1554 47.6774)',4326), null, null, '2021-03-12T16:16:13.780767Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06329500'),
+('38626896-aff7-4ed4-b270-9fa536540922', False, 'rdgk-reading-marais-des-cygnes', 'RDGK-Reading-Marais_Des_Cygnes', ST_GeomFromText('POINT(-95.9614 38.5669)',4326), null, null, '2021-03-12T16:16:13.780906Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06910800'),
+('b146da08-def5-4366-a14c-153007a7e13b', False, 'roia-red-oak-east-nishnabotna', 'ROIA-Red_Oak-East_Nishnabotna', ST_GeomFromText('POINT(-95.2414 41.0086)',4326), null, null, '2021-03-12T16:16:13.781051Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06809500'),
+('18bacb38-65f4-4142-bb59-dfb4edf67639', False, 'rnia-randolph-west-nishnabotna', 'RNIA-Randolph-West_Nishnabotna', ST_GeomFromText('POINT(-95.58 40.8731)',4326), null, null, '2021-03-12T16:16:13.781337Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06808500'),
+('c9b09b4a-4432-4087-947f-9e64260d3023', False, 'haco-harlan-co-lake-republican', 'HACO-Harlan_Co_Lake-Republican', ST_GeomFromText('POINT(-99.2108 40.0715)',4326), null, null, '2021-03-12T16:16:13.781668Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06849000'),
+('cac71606-0dbc-4ddf-8bf1-a81d477c866c', False, 'resd-redfield-james', 'RESD-Redfield-James', ST_GeomFromText('POINT(-98.4717 44.9106)',4326), null, null, '2021-03-12T16:16:13.781937Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06475000'),
+('e965182f-9d3d-4de7-9601-87b2e20c4600', False, 'tmnd-richardton-heart', 'TMND-Richardton-Heart', ST_GeomFromText('POINT(-102.3083 46.7456)',4326), null, null, '2021-03-12T16:16:13.782178Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06345500'),
+('b02449ac-2b20-413f-b18d-4b0a456d193f', False, 'rifm-rich-fountain-gasconade', 'RIFM-Rich_Fountain-Gasconade', ST_GeomFromText('POINT(-91.8199 38.3888)',4326), null, null, '2021-03-12T16:16:13.782414Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06934000'),
+('e431c32b-da15-4ca3-aa9b-ee0dbe864acc', False, 'rvia-rock-valley-rock', 'RVIA-Rock_Valley-Rock', ST_GeomFromText('POINT(-96.2949 43.2146)',4326), null, null, '2021-03-12T16:16:13.782606Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06483500'),
+('5afe7477-729c-4795-87d8-52325dc8f044', False, 'rone-roca-salt', 'RONE-Roca-Salt', ST_GeomFromText('POINT(-96.6656 40.6578)',4326), null, null, '2021-03-12T16:16:13.782865Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803000'),
+('0c286ec4-c0e5-4df1-9803-a3fc72a57c95', False, 'rune-rulo-missouri', 'RUNE-Rulo-Missouri', ST_GeomFromText('POINT(-95.4224 40.0536)',4326), null, null, '2021-03-12T16:16:13.783070Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06813500'),
+('92c5e06b-101c-45b3-8eb6-1b4ce9de3238', False, 'rumt-roundup-musselshell', 'RUMT-Roundup-Musselshell', ST_GeomFromText('POINT(-108.5701 46.4277)',4326), null, null, '2021-03-12T16:16:13.783582Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06126500'),
+('b5c65a06-070a-49ca-8142-adad0335bd6d', False, 'rusk-russell-saline', 'RUSK-Russell-Saline', ST_GeomFromText('POINT(-98.8542 38.9658)',4326), null, null, '2021-03-12T16:16:13.784020Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06867000'),
+('9ce81594-fa9c-4a40-b1b6-2bab989703a2', False, 'rusd-rauville-s-br-mud', 'RUSD-Rauville-S_Br_Mud', ST_GeomFromText('POINT(-97.1064 44.9531)',4326), null, null, '2021-03-12T16:16:13.784240Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479498'),
+('6d312cc6-7f0f-4cf0-9273-f731dc9dcd36', False, 'rilw-riverton-little-wind', 'RILW-Riverton-Little_Wind', ST_GeomFromText('POINT(-108.3754 42.9975)',4326), null, null, '2021-03-12T16:16:13.784548Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06235500'),
+('823fdb7f-2668-4da7-bf1b-20a95b7fca7c', False, 'rwln-indianola-red-willow', 'RWLN-Indianola-Red_Willow', ST_GeomFromText('POINT(-132.1303 56.7081)',4326), null, null, '2021-03-12T16:16:13.784827Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06838000'),
+('378ae400-af5e-452b-aa33-560c4ee180ec', False, 'csco-commerce-city-sand', 'CSCO-Commerce_City-Sand', ST_GeomFromText('POINT(-104.9516 39.811)',4326), null, null, '2021-03-12T16:16:13.785065Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '394839104570300'),
+('35cca152-c0aa-4edb-8e75-28fff70bc5e4', False, 'saco-saco-milk', 'SACO-Saco-Milk', ST_GeomFromText('POINT(-107.2188 48.5092)',4326), null, null, '2021-03-12T16:16:13.785260Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06164510'),
+('da57c545-a22f-4250-936e-23c6cc84bc2a', False, 'smcl-ab-spinney-res-south-platte', 'SMCL-Ab_Spinney_Res-South_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.785543Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('678dc373-1a75-4959-9ca9-1f13571aa308', False, 'boyn-boysen-dam-wind', 'BOYN-Boysen_Dam-Wind', ST_GeomFromText('POINT(-108.1793 43.4174)',4326), null, null, '2021-03-12T16:16:13.785719Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('096ca1e3-cb79-436c-8ced-57de290de106', False, 'stch-st-charles-missouri', 'STCH-St_Charles-Missouri', ST_GeomFromText('POINT(-90.4707 38.7889)',4326), null, null, '2021-03-12T16:16:13.786111Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06935965'),
+('e22fd8bc-c5ea-47c9-ad39-6363661e9c64', False, 'scsd-scotland-james', 'SCSD-Scotland-James', ST_GeomFromText('POINT(-97.6353 43.1858)',4326), null, null, '2021-03-12T16:16:13.786561Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06478500'),
+('0e13c945-3b8d-48f3-9c56-1bb1f5d6f11f', False, 'scsk-schoenchen-smoky-hill', 'SCSK-Schoenchen-Smoky_Hill', ST_GeomFromText('POINT(-99.2919 38.7122)',4326), null, null, '2021-03-12T16:16:13.787125Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06862850'),
+('2cef2752-238e-4126-92c6-cc11361b4552', False, 'scmo-schell-city-osage', 'SCMO-Schell_City-Osage', ST_GeomFromText('POINT(-94.1454 38.0559)',4326), null, null, '2021-03-12T16:16:13.787347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918070'),
+('4f472fe6-d8a3-4aa0-8291-e0a84da9b4c1', False, 'lgco-nr-lake-george-south-platte', 'LGCO-nr_Lake_George-South_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.787544Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('63a099ae-0a3e-43e4-a3fa-8d1ee18a0795', False, 'arco-ab-antero-res-sf-south-platte', 'ARCO-ab_Antero_Res-SF_South_Platte', ST_GeomFromText('POINT(-105.9735 39.0708)',4326), null, null, '2021-03-12T16:16:13.787673Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('014ed1d0-60e8-4c7b-a6c5-ddbd12ed25c1', False, 'stsd-stratford-james', 'STSD-Stratford-James', ST_GeomFromText('POINT(-98.3911 45.2417)',4326), null, null, '2021-03-12T16:16:13.787839Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06472000'),
+('d7d779ef-56c9-4be0-9ac8-8b952271de2f', False, 'bbsd-s-falls-blw-div-big-sioux', 'BBSD-S_Falls_blw_Div-Big_Sioux', ST_GeomFromText('POINT(-96.7639 43.5731)',4326), null, null, '2021-03-12T16:16:13.788177Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06481400'),
+('adb307a6-da61-4a16-814a-08ccd14c5fe1', False, 'shhi-shadehill-dam-grand', 'SHHI-Shadehill_Dam-Grand', ST_GeomFromText('POINT(-102.2011 45.753)',4326), null, null, '2021-03-12T16:16:13.788471Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06357000'),
+('e61cf8fb-62f4-4a40-b876-17d01e5209d1', False, 'marm-shelby-marias', 'MARM-Shelby-Marias', ST_GeomFromText('POINT(-111.8898 48.4272)',4326), null, null, '2021-03-12T16:16:13.788857Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06099500'),
+('e0a12d7c-731c-4c63-8823-66b492ed2121', False, 'srbb-bl-buffalo-bill-shoshone', 'SRBB-bl_Buffalo_Bill-Shoshone', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.789144Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('27392689-8df7-4818-83a9-a262213276e0', False, 'srcl-sheridan-bear', 'SRCL-Sheridan-Bear', ST_GeomFromText('POINT(-105.0329 39.6523)',4326), null, null, '2021-03-12T16:16:13.789338Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('ae06e5a5-ba10-4654-bb3c-7014f4ec774f', False, 'rssd-red-shirt-cheyenne', 'RSSD-Red_Shirt-Cheyenne', ST_GeomFromText('POINT(-102.8917 43.6722)',4326), null, null, '2021-03-12T16:16:13.789546Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06403700'),
+('f0d0dd30-ff01-493d-8181-613acc5c3292', False, 'sksd-sioux-falls-skunk', 'SKSD-Sioux_Falls-Skunk', ST_GeomFromText('POINT(-96.7906 43.5336)',4326), null, null, '2021-03-12T16:16:13.789837Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06481500'),
+('ded695d7-f964-4bbb-b13c-681d2f795dc1', False, 'simk-simpson-solomon', 'SIMK-Simpson-Solomon', ST_GeomFromText('POINT(-97.9292 39.3683)',4326), null, null, '2021-03-12T16:16:13.790162Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06876070'),
+('3126feeb-3e80-434e-b02b-9873d8fd243b', False, 'siwy-sinclair-north-platte', 'SIWY-Sinclair-North_Platte', ST_GeomFromText('POINT(-107.0569 41.8722)',4326), null, null, '2021-03-12T16:16:13.790414Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06630000'),
+('38e1d4f3-6506-410e-8ee7-2a1b21933e8d', False, 'pcia-sioux-city-perry', 'PCIA-Sioux_City-Perry', ST_GeomFromText('POINT(-96.4104 42.5349)',4326), null, null, '2021-03-12T16:16:13.790780Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06600000'),
+('cb0db493-aad0-4e85-b906-0c4c6efcf0a5', False, 'gark-garnett-pottawatomie', 'GARK-Garnett-Pottawatomie', ST_GeomFromText('POINT(-95.2486 38.3336)',4326), null, null, '2021-03-12T16:16:13.791086Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06914100'),
+('ca9f5bfc-469d-4b34-92ba-35d003c338eb', False, 'stj-st-joseph-missouri', 'STJ-St_Joseph-Missouri', ST_GeomFromText('POINT(-94.8568 39.7533)',4326), null, null, '2021-03-12T16:16:13.791295Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06818000'),
+('fe291449-29bb-4481-b29a-5a8343ba7953', False, 'shjm-stockton-sac', 'SHJM-Stockton-Sac', ST_GeomFromText('POINT(-93.7797 37.7359)',4326), null, null, '2021-03-12T16:16:13.791709Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06919020'),
+('8b040633-9497-45aa-aa51-36d9bf7218e7', False, 'hesd-bl-hermosa-battle-creek', 'HESD-bl_Hermosa-Battle_Creek', ST_GeomFromText('POINT(-102.9056 43.7253)',4326), null, null, '2021-03-12T16:16:13.791878Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06406500'),
+('1a95c93c-698a-4e3e-9a55-59fcf83bb0c1', False, 'sktk-stockton-bow', 'SKTK-Stockton-Bow', ST_GeomFromText('POINT(-99.2856 39.5594)',4326), null, null, '2021-03-12T16:16:13.792094Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06871500'),
+('bcbb686c-cc14-4569-bf89-fcbd176a3cde', False, 'smie-smithville-lake-little-platte', 'SMIE-Smithville_Lake-Little_Platte', ST_GeomFromText('POINT(-94.5559 39.3962)',4326), null, null, '2021-03-12T16:16:13.792281Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06821140'),
+('50064ec3-e0d4-4e96-af5e-5778900d8f8a', False, 'srly-lovell-shoshone', 'SRLY-Lovell-Shoshone', ST_GeomFromText('POINT(-108.4344 44.8386)',4326), null, null, '2021-03-12T16:16:13.792534Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06285100'),
+('661e29f2-3250-4901-8513-2e9d4301c9d6', False, 'smhm-smithville-little-platte', 'SMHM-Smithville-Little_Platte', ST_GeomFromText('POINT(-94.5789 39.3881)',4326), null, null, '2021-03-12T16:16:13.792761Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06821150'),
+('8d135c20-4e51-4b45-84cb-35ba64e9a36b', False, 'flmt-fort-logan-smith', 'FLMT-Fort_Logan-Smith', ST_GeomFromText('POINT(-111.1786 46.7958)',4326), null, null, '2021-03-12T16:16:13.793002Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06076690'),
+('e5264afe-e6fc-4746-82d5-34ea32d72464', False, 'snck-seneca-turkey', 'SNCK-Seneca-Turkey', ST_GeomFromText('POINT(-96.1083 39.9478)',4326), null, null, '2021-03-12T16:16:13.793402Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06814000'),
+('113aecff-2819-4c4a-81ea-51231a3696c0', False, 'smnm-sumner-grand', 'SMNM-Sumner-Grand', ST_GeomFromText('POINT(-93.2737 39.64)',4326), null, null, '2021-03-12T16:16:13.793939Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06902000'),
+('182ec91a-8124-476c-8974-bb0bb3b5bd19', False, 'spsd-springfield-missouri', 'SPSD-Springfield-Missouri', ST_GeomFromText('POINT(-97.885 42.8558)',4326), null, null, '2021-03-12T16:16:13.794285Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06466700'),
+('38bf4836-e0af-431f-a2a5-ae4d1964385f', False, 'crco-commerce-south-platte', 'CRCO-Commerce-South_Platte', ST_GeomFromText('POINT(-104.9578 39.8122)',4326), null, null, '2021-03-12T16:16:13.794617Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06714215'),
+('b5f4a8ae-2323-4960-8baf-ac8213a8a3f0', False, 'mlne-st-paul-middle-loup', 'MLNE-St_Paul-Middle_Loup', ST_GeomFromText('POINT(-98.4461 41.2035)',4326), null, null, '2021-03-12T16:16:13.794914Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06785000'),
+('3eb7f564-ded2-41ac-b5ce-b22d29e3461b', False, 'spnk-spring-bull-little-bull', 'SPNK-Spring_Bull-Little_Bull', ST_GeomFromText('POINT(-94.8792 38.7675)',4326), null, null, '2021-03-12T16:16:13.795237Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06914990'),
+('da1d6111-3cff-47b4-bfe6-3d7a4c79a8b8', False, 'spcl-south-platte-south-platte', 'SPCL-South_Platte-South_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.795465Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('7fa61274-dbf9-40df-9bfb-9752e6b54479', False, 'nlne-st-paul-north-loup', 'NLNE-St_Paul-North_Loup', ST_GeomFromText('POINT(-98.4489 41.2633)',4326), null, null, '2021-03-12T16:16:13.795790Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06790500'),
+('695605d1-e33d-4a9f-82f5-2d15a0fd0762', False, 'sux-sioux-city-missouri', 'SUX-Sioux_City-Missouri', ST_GeomFromText('POINT(-96.3803 42.4469)',4326), null, null, '2021-03-12T16:16:13.796187Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06486000'),
+('bf8e9fd9-48be-4507-ac82-a64e785ac4d4', False, 'ssco-bl-strontia-springs-south-platte', 'SSCO-bl_Strontia_Springs-South_Platte', ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:16:13.796683Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('7c53e8e2-f1ee-4dc5-b8f9-bacc1a32538f', False, 'stak-stanley-blue', 'STAK-Stanley-Blue', ST_GeomFromText('POINT(-94.6756 38.8125)',4326), null, null, '2021-03-12T16:16:13.796978Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893080'),
+('9689697e-83b4-47e0-9ae2-bd5340601c68', False, 'webr-webster-res-sf-solomon', 'WEBR-Webster_Res-SF_Solomon', ST_GeomFromText('POINT(-99.4257 39.4117)',4326), null, null, '2021-03-12T16:16:13.797310Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06873100'),
+('2584e768-e6d4-4d79-bce7-bdb17f094dd4', False, 'stmn-stamford-sappa', 'STMN-Stamford-Sappa', ST_GeomFromText('POINT(-99.5169 40.1183)',4326), null, null, '2021-03-12T16:16:13.797626Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06847500'),
+('829148e4-2fab-4261-9bf2-c0aff860db51', False, 'sttn-stratton-republican', 'STTN-Stratton-Republican', ST_GeomFromText('POINT(-101.2297 40.1406)',4326), null, null, '2021-03-12T16:16:13.797838Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06828500'),
+('8aa7a30a-bcff-464c-a4d2-214b85cd90d9', False, 'ston-stockton-lake-sac', 'STON-Stockton_Lake-Sac', ST_GeomFromText('POINT(-93.7594 37.6929)',4326), null, null, '2021-03-12T16:16:13.798161Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918990'),
+('0af59e21-cbf0-43f9-bb86-537fa2f00eed', False, 'sxmt-st-xavier-bighorn', 'SXMT-St_Xavier-Bighorn', ST_GeomFromText('POINT(-107.9189 45.3169)',4326), null, null, '2021-03-12T16:16:13.798387Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06287000'),
+('66f1221c-f4f2-498b-b34d-9c55812e04e6', False, 'stom-bl-stockton-lake-sac', 'STOM-bl_Stockton_Lake-Sac', ST_GeomFromText('POINT(-93.7568 37.7011)',4326), null, null, '2021-03-12T16:16:13.798796Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06919000'),
+('b3bd4a9a-157b-4c77-aa6e-201b59a38fae', False, 'swwy-alcova-sweetwater', 'SWWY-Alcova-Sweetwater', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.799003Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('7911ab8f-ec29-485a-a08a-afc5ae3b0077', False, 'ncsd-sioux-falls-big-sioux-n-cliff-av', 'NCSD-Sioux_Falls-Big_Sioux-N_Cliff_Av', ST_GeomFromText('POINT(-96.424 43.3401)',4326), null, null, '2021-03-12T16:16:13.799213Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06482020'),
+('5145a2eb-0d49-42c8-8b41-3b037b8bc71b', False, 'scam-schell-city-osage-aux', 'SCAM-Schell_City-Osage-Aux', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.799851Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918080'),
+('50035dc6-3b25-4fdd-89ab-6f0994844c28', False, 'tibr-tiber-dam-marias', 'TIBR-Tiber_Dam-Marias', ST_GeomFromText('POINT(-111.1022 48.3196)',4326), null, null, '2021-03-12T16:16:13.800019Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('6e4089df-dac1-4f16-bb30-91123bb28b10', False, 'trum-warsaw-osage-bl-truman', 'TRUM-Warsaw-Osage-bl_Truman', ST_GeomFromText('POINT(-106.5447 35.3947)',4326), null, null, '2021-03-12T16:16:13.800328Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06922450'),
+('24db676f-3174-41cc-8629-45cfb9ba90a7', False, 'tfmt-three-forks-jefferson', 'TFMT-Three_Forks-Jefferson', ST_GeomFromText('POINT(-111.5957 45.8971)',4326), null, null, '2021-03-12T16:16:13.800793Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06036650'),
+('458913a0-c26b-4978-a206-f31e7fc9aa1b', False, 'tsne-blw-tristate-div-north-platte', 'TSNE-blw_TriState_Div-North_Platte', ST_GeomFromText('POINT(-104.0322 41.9842)',4326), null, null, '2021-03-12T16:16:13.801114Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('301352ea-3341-45a5-983e-5f694aaa0ece', False, 'htnm-horton-little-osage', 'HTNM-Horton-Little_Osage', ST_GeomFromText('POINT(-94.3693 37.9948)',4326), null, null, '2021-03-12T16:16:13.801369Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06917060'),
+('b4b39b18-adba-41f5-9f5b-0b17b512de10', False, 'holm-bl-holter-dam-missouri', 'HOLM-bl_Holter_Dam-Missouri', ST_GeomFromText('POINT(-112.0107 46.9947)',4326), null, null, '2021-03-12T16:16:13.801592Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06066500'),
+('e69078f0-9340-42de-9fa2-b0c8352f0a94', False, 'munk-hays-big', 'MUNK-Hays-Big', ST_GeomFromText('POINT(-99.3178 38.8522)',4326), null, null, '2021-03-12T16:16:13.801835Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06863500'),
+('bf6e67f8-a221-4c4d-ab0e-9cc4f93be805', False, 'hand-hazen-knife', 'HAND-Hazen-Knife', ST_GeomFromText('POINT(-101.6217 47.2853)',4326), null, null, '2021-03-12T16:16:13.802126Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06340500'),
+('da8bf443-7d23-40e9-9fac-04292bba4988', False, 'clri-clarinda-nodaway', 'CLRI-Clarinda-Nodaway', ST_GeomFromText('POINT(-95.0142 40.7433)',4326), null, null, '2021-03-12T16:16:13.802458Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06817000'),
+('c8a59df4-f112-4ecc-8651-ff877ba736f4', False, 'irne-irvington-little-papillion', 'IRNE-Irvington-Little_Papillion', ST_GeomFromText('POINT(-96.0475 41.3106)',4326), null, null, '2021-03-12T16:16:13.802749Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610750'),
+('e2a704be-9862-48b4-8ffa-a5fb320f6219', False, 'itne-ithaca-wahoo', 'ITNE-Ithaca-Wahoo', ST_GeomFromText('POINT(-96.5378 41.1475)',4326), null, null, '2021-03-12T16:16:13.803088Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06804000'),
+('6d682698-7686-454c-a2d4-2be89021945f', False, 'pist-pipestem-dam-pipestem', 'PIST-Pipestem_Dam-Pipestem', ST_GeomFromText('POINT(-98.7518 46.9627)',4326), null, null, '2021-03-12T16:16:13.803486Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('a51b91c6-5494-495e-a4b3-37555576b9ef', False, 'scia-sioux-city-big-sioux', 'SCIA-Sioux_City-Big_Sioux', ST_GeomFromText('POINT(-96.48 42.5244)',4326), null, null, '2021-03-12T16:16:13.804065Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06485950'),
+('e6534b5a-3c4f-4f3c-94a5-b0d226331336', False, 'jato-jamestown-dam-james', 'JATO-Jamestown_Dam-James', ST_GeomFromText('POINT(-98.7091 46.9307)',4326), null, null, '2021-03-12T16:16:13.804271Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('62976872-7b67-4a1f-a9ed-a0d02a912d72', False, 'jmia-james-floyd', 'JMIA-James-Floyd', ST_GeomFromText('POINT(-96.3111 42.5767)',4326), null, null, '2021-03-12T16:16:13.804781Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06600500'),
+('949014ea-319a-4451-bd09-cb92b30adc55', False, 'jand-jamestown-james', 'JAND-Jamestown-James', ST_GeomFromText('POINT(-98.6817 46.8897)',4326), null, null, '2021-03-12T16:16:13.805149Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06470000'),
+('0e40f86b-75dd-4cb6-aa8d-f1f2b611d707', False, 'jrmm-jerome-gasconade', 'JRMM-Jerome-Gasconade', ST_GeomFromText('POINT(-91.9773 37.9299)',4326), null, null, '2021-03-12T16:16:13.805667Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06933500'),
+('d2215679-474b-4cf5-a708-951bdcd01f09', False, 'misd-mitchell-james', 'MISD-Mitchell-James', ST_GeomFromText('POINT(-97.9189 43.6589)',4326), null, null, '2021-03-12T16:16:13.805967Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06478000'),
+('59021e50-ac9a-42b9-a002-558d4ad52200', False, 'slnd-james-river-state-line', 'SLND-James_River-State_Line', ST_GeomFromText('POINT(-98.1739 45.9364)',4326), null, null, '2021-03-12T16:16:13.806255Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06470878'),
+('761dee77-3afa-481f-b01e-100af99942fa', False, 'jmco-julesberg-south-platte-ch-1', 'JMCO-Julesberg-South_Platte-Ch_1', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.806694Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('9cdc7349-1c97-477a-af60-f8a8dab3534b', False, 'sbnd-starck-bridge-heart', 'SBND-Starck_Bridge-Heart', ST_GeomFromText('POINT(-101.2136 46.7033)',4326), null, null, '2021-03-12T16:16:13.806991Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06348300'),
+('8bbe8f07-65f1-4aba-86c7-4f03cbdb61eb', False, 'jrco-julesberg-south-platte-ch-2', 'JRCO-Julesberg-South_Platte-Ch_2', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.807318Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('f2154bf2-456c-47cd-be5c-d861e07e1071', False, 'kans-kanopolis-lake-smoky-hill', 'KANS-Kanopolis_Lake-Smoky_Hill', ST_GeomFromText('POINT(-97.968 38.6176)',4326), null, null, '2021-03-12T16:16:13.807492Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06865000'),
+('08b8560b-df6b-4db3-bba4-f450e5aef3c6', False, 'kacm-bannister-blue', 'KACM-Bannister-Blue', ST_GeomFromText('POINT(-94.5589 38.957)',4326), null, null, '2021-03-12T16:16:13.807695Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893500'),
+('a62deed5-7a58-4951-8609-37d60a859e29', False, 'mkc-kansas-city-missouri', 'MKC-Kansas_City-Missouri', ST_GeomFromText('POINT(-94.5881 39.1117)',4326), null, null, '2021-03-12T16:16:13.808106Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893000'),
+('267514b0-88be-4f6f-97ba-a3f6836b5b7d', False, 'kckk-kansas-city-kansas-23rd-st', 'KCKK-Kansas_City-Kansas-23rd_St', ST_GeomFromText('POINT(-94.6089 39.09)',4326), null, null, '2021-03-12T16:16:13.808366Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06892950'),
+('25bf48c0-40f5-4f84-b76e-77ecca25325b', False, 'kctm-kansas-city-blue-12th-st', 'KCTM-Kansas_City-Blue-12th_St', ST_GeomFromText('POINT(-94.491 39.0969)',4326), null, null, '2021-03-12T16:16:13.808555Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893590'),
+('98ce4ef1-2e4b-4a8e-a21c-b171fe3b8539', False, 'kene-kearney-platte', 'KENE-Kearney-Platte', ST_GeomFromText('POINT(-99.0861 40.6581)',4326), null, null, '2021-03-12T16:16:13.809033Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06770200'),
+('2f835463-4886-4b27-a24c-7d64aac5d14c', False, 'kecl-kersey-south-platte', 'KECL-Kersey-South_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.809406Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('775d78b7-a1cc-46d4-8575-d26e2b70f812', False, 'hcsd-hill-city-castle', 'HCSD-Hill_City-Castle', ST_GeomFromText('POINT(-103.83 44.0136)',4326), null, null, '2021-03-12T16:16:13.809636Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06409000'),
+('9861014d-5239-4e89-b692-f5393d9bc5ca', False, 'keyo-keyhole-dam-belle-fourche', 'KEYO-Keyhole_Dam-Belle_Fourche', ST_GeomFromText('POINT(-104.7787 44.3826)',4326), null, null, '2021-03-12T16:16:13.809914Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('4dc0f9ce-552b-4088-89e0-6c89fc419322', False, 'wrky-kinnear-wind', 'WRKY-Kinnear-Wind', ST_GeomFromText('POINT(-108.7089 43.1428)',4326), null, null, '2021-03-12T16:16:13.810555Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06227600'),
+('54151931-04f8-4532-963c-abee13f26e67', False, 'ddsd-bl-deerfield-dam-castle', 'DDSD-bl_Deerfield_Dam-Castle', ST_GeomFromText('POINT(-103.7814 44.0292)',4326), null, null, '2021-03-12T16:16:13.810838Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06410000'),
+('796a0df2-eea8-4c3d-842e-3c97e584c257', False, 'kamp-watertown-lake-kampeska-inlet', 'KAMP-Watertown-Lake_Kampeska_inlet', ST_GeomFromText('POINT(-97.1606 44.9489)',4326), null, null, '2021-03-12T16:16:13.811072Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479452'),
+('825791e2-9250-4d79-80dd-b1401f574779', False, 'kirn-kirwin-res-nf-solomon', 'KIRN-Kirwin_Res-NF_Solomon', ST_GeomFromText('POINT(-99.1284 39.6631)',4326), null, null, '2021-03-12T16:16:13.811348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06871700'),
+('48329b82-38dd-4ebf-b146-3bb316794934', False, 'kend-kensal-james', 'KEND-Kensal-James', ST_GeomFromText('POINT(-98.7972 47.3997)',4326), null, null, '2021-03-12T16:16:13.811674Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06468250'),
+('44e1a270-fd38-4d59-bce0-6f8db41dd912', False, 'laco-lawson-clear', 'LACO-Lawson-Clear', ST_GeomFromText('POINT(-105.6256 39.7658)',4326), null, null, '2021-03-12T16:16:13.812041Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06716500'),
+('cd68132c-9cb8-4956-a75e-0e0513d5d5e5', False, 'land-lamoure-james', 'LAND-Lamoure-James', ST_GeomFromText('POINT(-98.3042 46.3556)',4326), null, null, '2021-03-12T16:16:13.812440Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06470500'),
+('2be8a13f-4879-49e0-b48d-313c9e3339fb', False, 'lwrk-lawrence-wakarusa', 'LWRK-Lawrence-Wakarusa', ST_GeomFromText('POINT(-95.2608 38.9114)',4326), null, null, '2021-03-12T16:16:13.812996Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06891500'),
+('0a9a6dcc-2948-407b-9d30-823cf82f823a', False, 'lngb-long-branch-lake-chariton', 'LNGB-Long_Branch_Lake-Chariton', ST_GeomFromText('POINT(-92.5152 39.7522)',4326), null, null, '2021-03-12T16:16:13.813427Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06906190'),
+('51b6c189-01f4-4292-9971-df590943dc95', False, 'lcgk-lacygne-marais-des-cygnes', 'LCGK-LaCygne-Marais_Des_Cygnes', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.813671Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06915800'),
+('7f5cb9a6-4e16-489d-a381-49094b3db343', False, 'leks-lecompton-kansas', 'LEKS-Lecompton-Kansas', ST_GeomFromText('POINT(-95.3861 39.0511)',4326), null, null, '2021-03-12T16:16:13.814028Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06891000'),
+('f769b9cb-63db-49d0-861b-8c9b754c84db', False, 'rbmt-landusky-missouri', 'RBMT-Landusky-Missouri', ST_GeomFromText('POINT(-108.6877 47.6314)',4326), null, null, '2021-03-12T16:16:13.814366Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06115200'),
+('a27b45ff-27c2-4219-825d-caab89981393', False, 'lfsd-pierre-missouri-laframbois-isl', 'LFSD-Pierre-Missouri-LaFrambois_Isl', ST_GeomFromText('POINT(-100.3619 44.3503)',4326), null, null, '2021-03-12T16:16:13.814856Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06441590'),
+('4dbfed7a-9342-4eb8-98ea-457e7dd64ffb', False, 'lglk-langley-smoky-hill', 'LGLK-Langley-Smoky_Hill', ST_GeomFromText('POINT(-97.9525 38.6114)',4326), null, null, '2021-03-12T16:16:13.815198Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06865500'),
+('1ae719d7-7b70-43ed-a6cf-cc0082aa2c00', False, 'lgia-logan-boyer', 'LGIA-Logan-Boyer', ST_GeomFromText('POINT(-95.7822 41.6416)',4326), null, null, '2021-03-12T16:16:13.815558Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06609500'),
+('981de61c-a2d9-4ae8-b201-db541e88fdca', False, 'hbne-lincoln-sw-56th-st-haines-branch', 'HBNE-Lincoln-SW_56th_St-Haines_Branch', ST_GeomFromText('POINT(-96.797 40.7675)',4326), null, null, '2021-03-12T16:16:13.815988Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803093'),
+('a7a06f92-c1de-49f2-affd-f34be3bdd158', False, 'lesd-little-eagle-grand', 'LESD-Little_Eagle-Grand', ST_GeomFromText('POINT(-100.8778 45.6578)',4326), null, null, '2021-03-12T16:16:13.816234Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06357800'),
+('501111a9-8a21-401b-b37b-b136d55b2af1', False, 'lvmt-livingston-yellowstone', 'LVMT-Livingston-Yellowstone', ST_GeomFromText('POINT(-110.5665 45.5972)',4326), null, null, '2021-03-12T16:16:13.816559Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06192500'),
+('84401537-a71c-4e89-be81-29b875f5b092', False, 'bagm-bl-bagnell-dam-osage', 'BAGM-bl_Bagnell_Dam-Osage', ST_GeomFromText('POINT(-92.6072 38.1914)',4326), null, null, '2021-03-12T16:16:13.816924Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06926000'),
+('b5f07690-f363-4258-b30e-999374a6800f', False, 'lctm-lake-city-little-blue', 'LCTM-Lake_City-Little_Blue', ST_GeomFromText('POINT(-94.3003 39.1006)',4326), null, null, '2021-03-12T16:16:13.817205Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06894000'),
+('e2558975-24c8-4f5a-aa9b-16c92eb8c1be', False, 'mcne-lincoln-sw-63rd-st-middle-cr', 'MCNE-Lincoln-SW_63rd_St-Middle_Cr', ST_GeomFromText('POINT(-96.8061 40.8108)',4326), null, null, '2021-03-12T16:16:13.817787Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803170'),
+('64381fdb-1e37-4eb8-8a8f-694b6ea2d3ac', False, 'sc17-salt-cr-dam-17-holmes-lake', 'SC17-Salt_Cr_Dam_17-Holmes_Lake', ST_GeomFromText('POINT(-96.6365 40.7827)',4326), null, null, '2021-03-12T16:16:13.818099Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('e46226bf-49e6-418e-ae8f-93a559ce784b', False, 'lndk-lyndon-salt', 'LNDK-Lyndon-Salt', ST_GeomFromText('POINT(-95.6381 38.6089)',4326), null, null, '2021-03-12T16:16:13.818455Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06911490'),
+('ce009b39-c478-45e0-b890-88f1bad33d8e', False, 'lvia-linn-grove-little-sioux', 'LVIA-Linn_Grove-Little_Sioux', ST_GeomFromText('POINT(-95.2431 42.8958)',4326), null, null, '2021-03-12T16:16:13.818827Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06605850'),
+('1a326a87-622f-4373-a9e6-f7f979a60348', False, 'lycl-lyons-st-vrain', 'LYCL-Lyons-St_Vrain', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.819212Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('8797f300-bfdc-400c-9db1-4b55e178b0ca', False, 'lnmo-linneus-locust', 'LNMO-Linneus-Locust', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.819468Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06901500'),
+('79fb403a-4498-48a7-85f2-57e33da21ab9', False, 'lgvm-longview-lake-little-blue', 'LGVM-Longview_Lake-Little_Blue', ST_GeomFromText('POINT(-94.4682 38.923)',4326), null, null, '2021-03-12T16:16:13.819725Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893791'),
+('096d249c-4eba-451b-b8e6-f24c8d81d6b3', False, 'lomt-locate-powder', 'LOMT-Locate-Powder', ST_GeomFromText('POINT(-105.3103 46.4294)',4326), null, null, '2021-03-12T16:16:13.820054Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06326500'),
+('31c60bdb-0a6b-49ae-b27c-66c76e63f814', False, 'lgmt-logan-gallatin', 'LGMT-Logan-Gallatin', ST_GeomFromText('POINT(-111.4383 45.8854)',4326), null, null, '2021-03-12T16:16:13.820474Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06052500'),
+('833c496a-b7ff-42fd-9e24-353b13c2794d', False, 'lmmt-loma-marias', 'LMMT-Loma-Marias', ST_GeomFromText('POINT(-110.5808 47.9459)',4326), null, null, '2021-03-12T16:16:13.821015Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06102050'),
+('7fae309b-3fbf-4c60-887c-941d7b9427f3', False, 'lune-louisville-platte', 'LUNE-Louisville-Platte', ST_GeomFromText('POINT(-96.1578 41.0153)',4326), null, null, '2021-03-12T16:16:13.821353Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06805500'),
+('7ac6bad7-8f00-4194-b853-97d3360f58cc', False, 'seco-sedalia-plum', 'SECO-Sedalia-Plum', ST_GeomFromText('POINT(-104.9825 39.4383)',4326), null, null, '2021-03-12T16:16:13.822126Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06709000'),
+('305e5173-bb20-4d59-b488-13c4d61725b6', False, 'lovl-lovewell-res-white-rock', 'LOVL-Lovewell_Res-White_Rock', ST_GeomFromText('POINT(-98.0263 39.8901)',4326), null, null, '2021-03-12T16:16:13.822639Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06853900'),
+('6655211e-bf6d-4aa6-84f2-be6784868b37', False, 'plbm-plattsburg-little-platte', 'PLBM-Plattsburg-Little_Platte', ST_GeomFromText('POINT(-94.407 39.5678)',4326), null, null, '2021-03-12T16:16:13.822941Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06821080'),
+('f2f948bc-7e85-4736-8162-f1febbc30a94', False, 'lrmt-lima-dam-red-rock', 'LRMT-Lima_Dam-Red_Rock', ST_GeomFromText('POINT(-112.3712 44.6559)',4326), null, null, '2021-03-12T16:16:13.823307Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06012500'),
+('dee60e93-e2cf-4659-add8-07331039f366', False, 'slne-lincoln-n-70th-st-salt', 'SLNE-Lincoln-N_70th_St-Salt', ST_GeomFromText('POINT(-96.6242 40.8861)',4326), null, null, '2021-03-12T16:16:13.823762Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803513'),
+('ea3c61a6-fb84-47ee-924a-5c4ce0e2bc44', False, 'lacl-lasalle-big-thompson', 'LACL-LaSalle-Big_Thompson', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.824083Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('812900c0-b197-4c68-b98b-cc4d7d0329f5', False, 'lund-ludden-james-river', 'LUND-Ludden-James_River', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.824285Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('c5fb1ded-8d7d-4353-916e-94e099560466', False, 'pbne-lincoln-pioneers-blvd-salt', 'PBNE-Lincoln-Pioneers_Blvd-Salt', ST_GeomFromText('POINT(-96.7189 40.7703)',4326), null, null, '2021-03-12T16:16:13.824503Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803080'),
+('d370ede1-f01d-4875-a2fc-4b51b34d2462', False, 'kawy-kane-bighorn', 'KAWY-Kane-Bighorn', ST_GeomFromText('POINT(-108.1808 44.7586)',4326), null, null, '2021-03-12T16:16:13.824771Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06279500'),
+('a534c193-5d93-480b-ac96-d47c6ca4f8f6', False, 'sasd-salem-little-vermillion', 'SASD-Salem-Little_Vermillion', ST_GeomFromText('POINT(-110.5542 40.1547)',4326), null, null, '2021-03-12T16:16:13.825097Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '09288180'),
+('f06242d9-1441-4b1a-bc1f-dbe908082c49', False, 'livm-livonia-chariton', 'LIVM-Livonia-Chariton', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.825408Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06904050'),
+('3a3b2392-f571-4bac-81e4-87235dbcf6b6', False, 'lwlk-bl-lovewell-res-white-rock', 'LWLK-bl_Lovewell_Res-White_Rock', ST_GeomFromText('POINT(-98.0222 39.8842)',4326), null, null, '2021-03-12T16:16:13.825687Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06854000'),
+('097587a4-0618-4102-9d1e-a772ff87fd4a', False, 'mcmt-mcallister-madison', 'MCMT-McAllister-Madison', ST_GeomFromText('POINT(-111.6345 45.4902)',4326), null, null, '2021-03-12T16:16:13.825973Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06041000'),
+('281bea70-648b-493e-8150-35994e135fae', False, 'mpia-mapleton-maple', 'MPIA-Mapleton-Maple', ST_GeomFromText('POINT(-95.8097 42.1569)',4326), null, null, '2021-03-12T16:16:13.826391Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06607200'),
+('78b26a9a-6a9b-42ab-8056-7414beb9739b', False, 'masd-state-line-maple', 'MASD-State_Line-Maple', ST_GeomFromText('POINT(-98.4522 45.9389)',4326), null, null, '2021-03-12T16:16:13.826843Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06471200'),
+('d38859d8-23ac-4a8f-b4aa-3150af47c86a', False, 'jeco-jefferson-michigan', 'JECO-Jefferson-Michigan', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.827147Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('7471d1dd-6524-4e54-9e15-8dec5f6880fe', False, 'mckn-mccook-driftwood', 'MCKN-McCook-Driftwood', ST_GeomFromText('POINT(-100.6728 40.1458)',4326), null, null, '2021-03-12T16:16:13.827447Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06836500'),
+('cc2035c1-c39c-4897-a2d0-8d9330bb7a71', False, 'mand-mandan-heart', 'MAND-Mandan-Heart', ST_GeomFromText('POINT(-100.9742 46.8339)',4326), null, null, '2021-03-12T16:16:13.827805Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06349000'),
+('36104b4b-d1d2-4045-b84a-7931f4b6bfef', False, 'vumt-vaughn-muddy', 'VUMT-Vaughn-Muddy', ST_GeomFromText('POINT(-111.5418 47.5613)',4326), null, null, '2021-03-12T16:16:13.828327Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06088500'),
+('ac212863-7b56-4d33-afc2-8641a755453f', False, 'prco-prince-mf-south-platte', 'PRCO-Prince-MF_South_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.828624Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('d4e35b6c-3686-4310-b9b2-973323846e17', False, 'smco-santa-maria-mf-south-platte', 'SMCO-Santa_Maria-MF_South_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.828867Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('39691428-9e02-4e75-8992-a3f3a3239d51', False, 'mimt-miles-city-tongue', 'MIMT-Miles_City-Tongue', ST_GeomFromText('POINT(-105.8455 46.3846)',4326), null, null, '2021-03-12T16:16:13.829168Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06308500'),
+('0130ae0b-1d49-435f-81ea-5cc13c7cbefc', False, 'milk-bl-milford-lake-republican', 'MILK-bl_Milford_Lake-Republican', ST_GeomFromText('POINT(-96.8292 39.0428)',4326), null, null, '2021-03-12T16:16:13.829645Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06857100'),
+('a48274c4-0b25-4a4d-a79e-9bcf21b386c4', False, 'mls-miles-city-yellowstone', 'MLS-Miles_City-Yellowstone', ST_GeomFromText('POINT(-105.86 46.4208)',4326), null, null, '2021-03-12T16:16:13.830075Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06309000'),
+('423253ba-8b69-41cb-9c31-78751068e51c', False, 'mane-maskell-missouri', 'MANE-Maskell-Missouri', ST_GeomFromText('POINT(-96.9436 42.7147)',4326), null, null, '2021-03-12T16:16:13.830672Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06478526'),
+('e30aa8ca-768c-45dd-a1e8-77a03d6dce1c', False, 'mcon-mccook-republican', 'MCON-McCook-Republican', ST_GeomFromText('POINT(-100.6186 40.1878)',4326), null, null, '2021-03-12T16:16:13.831073Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06837000'),
+('8ab7c161-0064-4e50-9ad9-f58133da686c', False, 'mild-milford-lake-republican', 'MILD-Milford_Lake-Republican', ST_GeomFromText('POINT(-96.8927 39.0857)',4326), null, null, '2021-03-12T16:16:13.831473Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06857050'),
+('fd5196eb-fa9f-491b-a54b-6a5974a938a4', False, 'melm-melrose-big-hole', 'MELM-Melrose-Big_Hole', ST_GeomFromText('POINT(-112.7017 45.5266)',4326), null, null, '2021-03-12T16:16:13.831807Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06025500'),
+('cedb39eb-5900-418b-9f38-60df06758623', False, 'meln-melvern-lake-marais-des-cygnes', 'MELN-Melvern_Lake-Marais_Des_Cygnes', ST_GeomFromText('POINT(-95.7082 38.5106)',4326), null, null, '2021-03-12T16:16:13.832219Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06910997'),
+('0d974476-25da-4031-aa25-ca8aff227e2e', False, 'csmk-manhattan-big-blue', 'CSMK-Manhattan-Big_Blue', ST_GeomFromText('POINT(-96.5711 39.2372)',4326), null, null, '2021-03-12T16:16:13.832529Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06887000'),
+('a98cb303-e958-4d18-9bb2-d43934d8a764', False, 'mlti-moulton-chariton', 'MLTI-Moulton-Chariton', ST_GeomFromText('POINT(-92.7722 40.6925)',4326), null, null, '2021-03-12T16:16:13.832849Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06904010'),
+('626f9a6a-388a-4142-8a97-22da669f5316', False, 'nmne-niobrara-missouri', 'NMNE-Niobrara-Missouri', ST_GeomFromText('POINT(-98.0278 42.7617)',4326), null, null, '2021-03-12T16:16:13.833255Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06466010'),
+('d3ff9fc9-5b1e-4b2c-88eb-38d459e038a1', False, 'pone-ponca-missouri', 'PONE-Ponca-Missouri', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.833563Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('7575ce15-0590-4eab-a1f9-6f970511e95f', False, 'mocl-morrison-bear', 'MOCL-Morrison-Bear', ST_GeomFromText('POINT(-105.1956 39.6529)',4326), null, null, '2021-03-12T16:16:13.833957Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('1adbe771-1938-4ae3-94f5-0f75f976760e', False, 'mark-marmaton-marmaton', 'MARK-Marmaton-Marmaton', ST_GeomFromText('POINT(-94.9811 37.8356)',4326), null, null, '2021-03-12T16:16:13.834202Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06917380'),
+('f5a0f369-82a5-4694-a5f9-1f10d9648eb8', False, 'vene-verdel-missouri', 'VENE-Verdel-Missouri', ST_GeomFromText('POINT(-98.0619 42.771)',4326), null, null, '2021-03-12T16:16:13.834546Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06453620'),
+('997c2976-4e66-40d8-adda-e7f371446b34', False, 'mryk-marysville-big-blue', 'MRYK-Marysville-Big_Blue', ST_GeomFromText('POINT(-96.6619 39.8419)',4326), null, null, '2021-03-12T16:16:13.834870Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06882510'),
+('a82b2325-6cbe-46c0-8c6b-13b105bcd1ae', False, 'msck-muscotah-delaware', 'MSCK-Muscotah-Delaware', ST_GeomFromText('POINT(-95.5325 39.5214)',4326), null, null, '2021-03-12T16:16:13.835170Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06890100'),
+('d7dd810b-e54c-4498-a328-233a0cac29fa', False, 'mrrm-morrisville-little-sac', 'MRRM-Morrisville-Little_Sac', ST_GeomFromText('POINT(-93.4856 37.4829)',4326), null, null, '2021-03-12T16:16:13.835493Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918740'),
+('a008bc03-367d-43cc-8d71-913784dc1f06', False, 'bowy-bosler-laramie', 'BOWY-Bosler-Laramie', ST_GeomFromText('POINT(-105.6831 41.5536)',4326), null, null, '2021-03-12T16:16:13.835817Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('b64b60d2-efb0-44ec-b180-bd70bedca1bd', False, 'meks-mentor-smoky-hill', 'MEKS-Mentor-Smoky_Hill', ST_GeomFromText('POINT(-97.5714 38.7111)',4326), null, null, '2021-03-12T16:16:13.836141Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06866500'),
+('92b9b542-b9a8-47d7-9cb5-5ecdb40299bd', False, 'tucr-tuttle-creek-lake-big-blue', 'TUCR-Tuttle_Creek_Lake-Big_Blue', ST_GeomFromText('POINT(-96.5906 39.2549)',4326), null, null, '2021-03-12T16:16:13.836451Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06886900'),
+('cb297644-f5d2-4caf-8807-b3a353bb3e62', False, 'quks-quenemo-marais-des-cygnes', 'QUKS-Quenemo-Marais_Des_Cygnes', ST_GeomFromText('POINT(-95.5269 38.5161)',4326), null, null, '2021-03-12T16:16:13.836694Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06911400'),
+('f3376aae-8ae6-4036-9bd3-867d5fd00739', False, 'mrym-maryville-102', 'MRYM-Maryville-102', ST_GeomFromText('POINT(-94.8322 40.3455)',4326), null, null, '2021-03-12T16:16:13.837043Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06819500'),
+('b071dc14-1669-4ec9-9dcb-fed20e0be25f', False, 'napm-napoleon-missouri', 'NAPM-Napoleon-Missouri', ST_GeomFromText('POINT(-94.0642 39.1332)',4326), null, null, '2021-03-12T16:16:13.837404Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '00000001'),
+('895cf343-29dc-45db-9a8d-1646f6b68038', False, 'nbne-north-bend-platte', 'NBNE-North_Bend-Platte', ST_GeomFromText('POINT(-96.7756 41.4528)',4326), null, null, '2021-03-12T16:16:13.837814Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06796000'),
+('97cf42f9-6a16-4487-a30b-5841f0d7ea47', False, 'nwck-new-cambria-smoky-hill', 'NWCK-New_Cambria-Smoky_Hill', ST_GeomFromText('POINT(-97.4831 38.8639)',4326), null, null, '2021-03-12T16:16:13.838260Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06870200'),
+('bc0db7f9-27dc-42dc-873d-41bb7f53d0d5', False, 'ncrk-norcatur-sappa', 'NCRK-Norcatur-Sappa', ST_GeomFromText('POINT(-99.9925 40.0017)',4326), null, null, '2021-03-12T16:16:13.838608Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06845110'),
+('c94008f6-7c4f-4790-87f7-ca275127ad70', False, 'ncne-nebraska-city-missouri', 'NCNE-Nebraska_City-Missouri', ST_GeomFromText('POINT(-95.8467 40.6819)',4326), null, null, '2021-03-12T16:16:13.839179Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06807000'),
+('33edaf52-38ae-4bf8-9faf-1052f5d45d06', False, 'ofk-norfolk-elkhorn', 'OFK-Norfolk-Elkhorn', ST_GeomFromText('POINT(-97.426 42.0038)',4326), null, null, '2021-03-12T16:16:13.839926Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06799000'),
+('84d75d90-11d8-4b7b-adc7-5bb1adff96d7', False, 'nkma-browning-nf-milk', 'NKMA-Browning-NF_Milk', ST_GeomFromText('POINT(-113.0619 48.9633)',4326), null, null, '2021-03-12T16:16:13.840347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06133500'),
+('6acc777d-0e2c-4ad7-bcd2-99f7d96cc20f', False, 'nfs-wapiti-nf-shoshone', 'NFS-Wapiti-NF_Shoshone', ST_GeomFromText('POINT(-109.4297 44.4697)',4326), null, null, '2021-03-12T16:16:13.840773Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06279940'),
+('8d8723fe-2ce4-46c4-8baf-d141da44fee5', False, 'ngwy-northgate-north-platte', 'NGWY-Northgate-North_Platte', ST_GeomFromText('POINT(-106.3392 40.9366)',4326), null, null, '2021-03-12T16:16:13.841303Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06620000'),
+('55964321-de45-4e7e-91c8-f3b1c1d8ac6f', False, 'nkne-nickerson-maple', 'NKNE-Nickerson-Maple', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.841798Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('5f644ae9-579c-4e3d-98ae-509548e350c5', False, 'nlsk-niles-solomon', 'NLSK-Niles-Solomon', ST_GeomFromText('POINT(-97.4769 38.9692)',4326), null, null, '2021-03-12T16:16:13.842236Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06876900'),
+('35d402ef-48b9-421f-a709-770f0a31315e', False, 'lowy-lookout-laramie', 'LOWY-Lookout-Laramie', ST_GeomFromText('POINT(-105.6925 41.7664)',4326), null, null, '2021-03-12T16:16:13.842565Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('3d3df58d-f824-4dd4-abb2-1bbbdaf72d0c', False, 'nine-niobrara-niobrara', 'NINE-Niobrara-Niobrara', ST_GeomFromText('POINT(-98.058 42.7484)',4326), null, null, '2021-03-12T16:16:13.842892Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06466000'),
+('46abbd57-a21e-4868-8998-9a9e82eccc69', False, 'namt-nashua-milk', 'NAMT-Nashua-Milk', ST_GeomFromText('POINT(-106.3643 48.1301)',4326), null, null, '2021-03-12T16:16:13.843328Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06174500'),
+('25a8d942-21f4-4e26-8288-ca9778672c11', False, 'norn-norton-res-prairie-dog', 'NORN-Norton_Res-Prairie_Dog', ST_GeomFromText('POINT(-99.9349 39.8066)',4326), null, null, '2021-03-12T16:16:13.843770Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06847951'),
+('2860f95d-f01f-4fe9-a9e3-e8801fdd4671', False, 'nevm-nevada-marmaton', 'NEVM-Nevada-Marmaton', ST_GeomFromText('POINT(-94.3992 37.862)',4326), null, null, '2021-03-12T16:16:13.844073Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918065'),
+('282aa29b-fa3c-4174-9a36-d227239112f9', False, 'novm-novinger-chariton', 'NOVM-Novinger-Chariton', ST_GeomFromText('POINT(-92.6864 40.2343)',4326), null, null, '2021-03-12T16:16:13.844307Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06904500'),
+('84d970c7-508d-424e-aa7c-372e2cb57673', False, 'becr-tailwater', 'BECR-Tailwater', ST_GeomFromText('POINT(-105.1385 39.4325)',4326), null, null, '2021-03-12T16:16:13.844644Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('351614b6-ecc0-46e0-9117-2e2858f62e77', False, 'bfsd-bf-inlet-canal-belle-fourche', 'BFSD-BF_Inlet_Canal-Belle_Fourche', ST_GeomFromText('POINT(-103.7339 44.7014)',4326), null, null, '2021-03-12T16:16:13.844888Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06434505'),
+('3359ec74-5ffd-4d4b-9811-4b3efb44d237', False, 'chfi-tailwater', 'CHFI-Tailwater', ST_GeomFromText('POINT(-105.0621 39.5587)',4326), null, null, '2021-03-12T16:16:13.845192Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('64be22bf-1801-4199-97f5-e49fc2b7cde4', False, 'ftmt-fort-benton-missouri', 'FTMT-Fort_Benton-Missouri', ST_GeomFromText('POINT(-110.6661 47.8169)',4326), null, null, '2021-03-12T16:16:13.845496Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06090800'),
+('0a0a5d68-63c9-4a27-84e2-8e771a560a52', False, 'glen-glendo-dam-north-platte', 'GLEN-Glendo_Dam-North_Platte', ST_GeomFromText('POINT(-104.9507 42.4787)',4326), null, null, '2021-03-12T16:16:13.845833Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('e6b798e0-e58b-4476-8434-68507129f6eb', False, 'grar-gray-reef-dam-north-platte', 'GRAR-Gray_Reef_Dam-North_Platte', ST_GeomFromText('POINT(-106.7016 42.5652)',4326), null, null, '2021-03-12T16:16:13.855937Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('ef1b2572-2c76-4d47-bd7e-db650db5fb39', False, 'grmt-great-falls-missouri', 'GRMT-Great_Falls-Missouri', ST_GeomFromText('POINT(-111.0606 47.5844)',4326), null, null, '2021-03-12T16:16:13.856807Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06090300'),
+('c01bf4a7-dbba-4042-80d2-1161e9426c94', False, 'gtmt-gallatin-gateway-gallatin', 'GTMT-Gallatin_Gateway-Gallatin', ST_GeomFromText('POINT(-111.2707 45.4973)',4326), null, null, '2021-03-12T16:16:13.857275Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06043500'),
+('9e609bdd-ea06-46be-9c6a-10c0fbb314ca', False, 'hbia-hamburg-missouri', 'HBIA-Hamburg-Missouri', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.857622Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('d3cece1f-1bcc-4085-9c61-7e7c3b6ba02c', False, 'wwy-woods-landing-laramie', 'WWY-Woods_Landing-Laramie', ST_GeomFromText('POINT(-106.0347 41.1381)',4326), null, null, '2021-03-12T16:16:13.858040Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('f95bdcdd-0aac-4e68-9555-231b4bcc8579', False, 'j5nd-5th-street-james', 'J5ND-5th_Street-James', ST_GeomFromText('POINT(-98.7097 46.9292)',4326), null, null, '2021-03-12T16:16:13.858283Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06469015'),
+('e50f4e3c-ed8f-454a-a2a3-295354ceaac7', False, 'lpsd-lake-poinsett-big-sioux', 'LPSD-Lake_Poinsett-Big_Sioux', ST_GeomFromText('POINT(-97.0821 44.5656)',4326), null, null, '2021-03-12T16:16:13.858621Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('c0689109-c19d-40eb-8e9d-82a756ae9b72', False, 'momt-mosby-musselshell', 'MOMT-Mosby-Musselshell', ST_GeomFromText('POINT(-107.8892 46.9947)',4326), null, null, '2021-03-12T16:16:13.858983Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06130500'),
+('7c0229bc-bb72-44f6-a775-a6d678bc58d7', False, 'mowy-moorcroft-belle-fourche', 'MOWY-Moorcroft-Belle_Fourche', ST_GeomFromText('POINT(-104.94 44.3219)',4326), null, null, '2021-03-12T16:16:13.859477Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06426500'),
+('69b3e00d-b5aa-427c-ae86-2baa9d541de9', False, 'pa2a-papio-cr-dam-20-sediment-trap', 'PA2A-Papio_Cr_Dam_20-Sediment_Trap', ST_GeomFromText('POINT(-96.1685 41.1455)',4326), null, null, '2021-03-12T16:16:13.859897Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('e857cbef-e9da-4fc8-847e-3c0b96676a1e', False, 'pgsd-pringle-beaver-creek', 'PGSD-Pringle-Beaver_Creek', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.860209Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('37a3e33f-cb95-471f-8f70-8b3e66b9a4fd', False, 'poca-lake-pocasse-spring', 'POCA-Lake_Pocasse-Spring', ST_GeomFromText('POINT(-100.2958 45.9119)',4326), null, null, '2021-03-12T16:16:13.860480Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('15ee1940-279c-4f85-a8ed-26b9d0e0fc39', False, 'rvsd-elm-springs-belle-fourche', 'RVSD-Elm_Springs-Belle_Fourche', ST_GeomFromText('POINT(-102.5661 44.37)',4326), null, null, '2021-03-12T16:16:13.860787Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06438000'),
+('e6d4d8af-d9b7-4ccc-b3fa-7e068f3a5102', False, 'smmt-simms-sun', 'SMMT-Simms-Sun', ST_GeomFromText('POINT(-111.9319 47.5016)',4326), null, null, '2021-03-12T16:16:13.861269Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06085800'),
+('29645660-4c6b-4fac-93a5-7f0b377f5aa8', False, 'smsd-fruitdale-belle-fourche', 'SMSD-Fruitdale-Belle_Fourche', ST_GeomFromText('POINT(-103.7372 44.6908)',4326), null, null, '2021-03-12T16:16:13.861681Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06436000'),
+('fb933e16-e672-4dc6-8a0c-1b3c47957f5b', False, 'spia-little-sioux-spencer', 'SPIA-Little_Sioux-Spencer', ST_GeomFromText('POINT(-95.2392 43.2128)',4326), null, null, '2021-03-12T16:16:13.862112Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06604440'),
+('8ac38027-abe3-458e-87e4-792ca5bd7a54', False, 'thmt-forsyth-yellowstone', 'THMT-Forsyth-Yellowstone', ST_GeomFromText('POINT(-106.6913 46.2666)',4326), null, null, '2021-03-12T16:16:13.862662Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06295000'),
+('abc8cbd4-14bb-42e6-82c6-edbe15dc2a54', False, 'thsd-blw-pactola-dam-rapid', 'THSD-blw_Pactola_Dam-Rapid', ST_GeomFromText('POINT(-103.4817 44.0767)',4326), null, null, '2021-03-12T16:16:13.863093Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06411500'),
+('cabc22e8-bf31-4de7-93fb-970470fcbc85', False, 'tisd-silver-city-rapid', 'TISD-Silver_City-Rapid', ST_GeomFromText('POINT(-103.58 44.0847)',4326), null, null, '2021-03-12T16:16:13.863462Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06410500'),
+('78f38d25-de8f-42d5-bb0c-c92e10625be4', False, 'tlnd-glen-ullin-heart', 'TLND-Glen_Ullin-Heart', ST_GeomFromText('POINT(-102.0789 46.6569)',4326), null, null, '2021-03-12T16:16:13.863993Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06345780'),
+('5e445ad1-aa42-4e2b-be64-c7887f235b7c', False, 'ocsd-oacoma-white', 'OCSD-Oacoma-White', ST_GeomFromText('POINT(-99.5561 43.7483)',4326), null, null, '2021-03-12T16:16:13.864507Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06452000'),
+('1be7ddbd-8c41-4ed9-9ca6-e85ca0ec64e7', False, 'grai-grayrocks-in-laramie', 'GRAI-Grayrocks_In-Laramie', ST_GeomFromText('POINT(-104.8136 42.1192)',4326), null, null, '2021-03-12T16:16:13.865028Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('67784acf-b244-41c0-8066-ebf8fd7b5f94', False, 'obnk-oberlin-sappa', 'OBNK-Oberlin-Sappa', ST_GeomFromText('POINT(-66.0276 18.2487)',4326), null, null, '2021-03-12T16:16:13.865409Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06845000'),
+('e8b7296d-7680-4481-9185-0dd8f7f96e54', False, 'fsne-omaha-fort-st-big-papillion', 'FSNE-Omaha-Fort_St-Big_Papillion', ST_GeomFromText('POINT(-96.1042 41.3067)',4326), null, null, '2021-03-12T16:16:13.870185Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610732'),
+('7c994390-02a1-4d62-8c98-35aa150897b4', False, 'ekdk-elkader-smoky-hill', 'EKDK-Elkader-Smoky_Hill', ST_GeomFromText('POINT(-100.8581 38.7947)',4326), null, null, '2021-03-12T16:16:13.870835Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06860000'),
+('08f632b1-882a-4633-8edf-8e77e4bf4b00', False, 'grao-grayrocks-out-laramie', 'GRAO-Grayrocks_Out-Laramie', ST_GeomFromText('POINT(-104.6814 42.1867)',4326), null, null, '2021-03-12T16:16:13.871255Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('014adfaf-dc6a-433b-af8d-61f24f3fe0bd', False, 'sbwy-canal-3-sybille', 'SBWY-Canal_3-Sybille', ST_GeomFromText('POINT(-105.1267 41.9111)',4326), null, null, '2021-03-12T16:16:13.871647Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '13018250'),
+('62718b4c-3076-496a-8b3d-5157ae38865f', False, 'opdk-overland-park-indian', 'OPDK-Overland_Park-Indian', ST_GeomFromText('POINT(-94.6711 38.9406)',4326), null, null, '2021-03-12T16:16:13.872134Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06893300'),
+('d59e3ecf-4c39-42ae-8096-a4df7671f624', False, 'odsd-ordway-elm', 'ODSD-Ordway-Elm', ST_GeomFromText('POINT(-98.4124 45.5623)',4326), null, null, '2021-03-12T16:16:13.872652Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06471510'),
+('3721a836-0e99-4841-ada7-4b7b90614f52', False, 'npow-orin-north-platte', 'NPOW-Orin-North_Platte', ST_GeomFromText('POINT(-105.1586 42.6525)',4326), null, null, '2021-03-12T16:16:13.873121Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06652000'),
+('65b1cb8d-5cf5-47e3-946d-c59a8d9d0875', False, 'ornn-orleans-republican', 'ORNN-Orleans_Republican', ST_GeomFromText('POINT(-99.5025 40.1317)',4326), null, null, '2021-03-12T16:16:13.873683Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06844500'),
+('093ea821-93a4-4db6-8c0f-ed3e8288829a', False, 'odco-orodell-boulder', 'ODCO-Orodell-Boulder', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.874163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('a55aef62-154e-428e-a1df-1c3893da55e6', False, 'osbk-osborne-sf-solomon', 'OSBK-Osborne-SF_Solomon', ST_GeomFromText('POINT(-98.6939 39.4278)',4326), null, null, '2021-03-12T16:16:13.874625Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06874000'),
+('0b46c136-d8b2-462a-8d7b-011be66e3516', False, 'otvm-otterville-lamine', 'OTVM-Otterville-Lamine', ST_GeomFromText('POINT(-92.9788 38.7022)',4326), null, null, '2021-03-12T16:16:13.875142Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06906800'),
+('0c383d64-628a-4ac5-8927-7aa026ad9a83', False, 'ottk-ottawa-marais-des-cygnes', 'OTTK-Ottawa-Marais_Des_Cygnes', ST_GeomFromText('POINT(-95.2681 38.6181)',4326), null, null, '2021-03-12T16:16:13.875619Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06913500'),
+('14fd3d9f-9969-406f-a7ab-81ec6ae77932', False, 'piwy-piney-belle-fourche', 'PIWY-Piney-Belle_Fourche', ST_GeomFromText('POINT(-105.3878 43.9844)',4326), null, null, '2021-03-12T16:16:13.876031Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06425720'),
+('4d941923-9654-466d-a18e-5f86fe65e6a5', False, 'paca-pactola-dam-rapid', 'PACA-Pactola_Dam-Rapid', ST_GeomFromText('POINT(-103.4889 44.0747)',4326), null, null, '2021-03-12T16:16:13.876343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('9e7f3184-5639-412b-8a1a-16a85a57b6b3', False, 'paln-palisade-frenchman', 'PALN-Palisade-Frenchman', ST_GeomFromText('POINT(-101.1236 40.3517)',4326), null, null, '2021-03-12T16:16:13.876870Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06834000'),
+('94f014bf-8c43-474f-b0fc-eb800135dd30', False, 'wymt-wyola-little-bighorn', 'WYMT-Wyola-Little_Bighorn', ST_GeomFromText('POINT(-107.6154 45.0071)',4326), null, null, '2021-03-12T16:16:13.877468Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06289000'),
+('e150e563-87a6-4686-b8b5-4b26165a6196', False, 'pcco-louviers-plum', 'PCCO-Louviers-Plum', ST_GeomFromText('POINT(-105.0239 39.5075)',4326), null, null, '2021-03-12T16:16:13.886598Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06709530'),
+('f6ee414c-4b94-43d7-9795-54aa5639ccab', False, 'pbks-bl-perry-lake-delaware', 'PBKS-bl_Perry_Lake-Delaware', ST_GeomFromText('POINT(-95.4036 39.075)',4326), null, null, '2021-03-12T16:16:13.887481Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06890900'),
+('9ea4ed3b-effd-41d3-8a5b-55e25cc8baf1', False, 'pind-pingree-pipestem', 'PIND-Pingree-Pipestem', ST_GeomFromText('POINT(-98.9686 47.1675)',4326), null, null, '2021-03-12T16:16:13.888335Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06469400'),
+('8eb33672-785d-4bdd-b223-33a0a7111052', False, 'eewy-encmpt-encampment', 'EEWY-Encmpt-Encampment', ST_GeomFromText('POINT(-106.7147 41.3033)',4326), null, null, '2021-03-12T16:16:13.888905Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06625000'),
+('732ccef3-108c-45d3-854f-960f2b05870a', False, 'pir-pierre-missouri', 'PIR-Pierre-Missouri', ST_GeomFromText('POINT(-100.3675 44.3731)',4326), null, null, '2021-03-12T16:16:13.894787Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06440000'),
+('b3365c71-912c-40f7-8589-1b94e83dc1ad', False, 'sc13-salt-cr-dam-13-twin-lakes', 'SC13-Salt_Cr_Dam_13-Twin_Lakes', ST_GeomFromText('POINT(-96.9468 40.825)',4326), null, null, '2021-03-12T16:16:13.895384Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('ae2bca4e-2274-4703-a909-d738bef1b293', False, 'syne-pilger-elkhorn', 'SYNE-Pilger-Elkhorn', ST_GeomFromText('POINT(-97.0581 41.9953)',4326), null, null, '2021-03-12T16:16:13.895915Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06799315'),
+('e9576c46-f9d0-438a-b61e-4b91c158e825', False, 'poma-pomona-lake-110-quenemo', 'POMA-Pomona_Lake-110-Quenemo', ST_GeomFromText('POINT(-95.5592 38.6497)',4326), null, null, '2021-03-12T16:16:13.896229Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06912490'),
+('cc1f07b9-4a61-47e5-b2b7-b00d05d04c28', False, 'plkm-polk-lindley', 'PLKM-Polk-Lindley', ST_GeomFromText('POINT(-93.2662 37.7505)',4326), null, null, '2021-03-12T16:16:13.896532Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921200'),
+('32d2baee-313b-45f7-ae19-aac4cf5c80de', False, 'epcl-haskins-east-plum', 'EPCL-Haskins-East_Plum', ST_GeomFromText('POINT(-104.9046 39.423)',4326), null, null, '2021-03-12T16:16:13.896778Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06708800'),
+('d67d5691-fe82-4378-af89-f6a537c49d46', False, 'ccsd-howes-cheyenne', 'CCSD-Howes-Cheyenne', ST_GeomFromText('POINT(-101.9297 44.5294)',4326), null, null, '2021-03-12T16:16:13.897018Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06438500'),
+('95910905-1e08-4ea0-a20f-2cab0fd558dc', False, 'pmnk-pomona-marais-des-cygnes', 'PMNK-Pomona-Marais_Des_Cygnes', ST_GeomFromText('POINT(-95.4533 38.5842)',4326), null, null, '2021-03-12T16:16:13.897881Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06913000'),
+('3a8d68f7-be43-4119-9ebe-ae2bfbccd58d', False, 'pokm-polk-pomme-de-terre', 'POKM-Polk-Pomme_De_Terre', ST_GeomFromText('POINT(-93.3703 37.6827)',4326), null, null, '2021-03-12T16:16:13.898206Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921070'),
+('b1e368f7-d61a-4184-9bdf-af33762404e4', False, 'ppne-papillion-papillion', 'PPNE-Papillion-Papillion', ST_GeomFromText('POINT(-96.0431 41.1557)',4326), null, null, '2021-03-12T16:16:13.898519Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610793'),
+('a809e4d4-9984-4808-8933-5b12554ccd63', False, 'szne-pierce-nf-elkhorn', 'SZNE-Pierce-NF_Elkhorn', ST_GeomFromText('POINT(-97.4785 42.1485)',4326), null, null, '2021-03-12T16:16:13.898890Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06799100'),
+('7347071d-eb82-422d-a756-4b8e0c254ef9', False, 'prnd-price-missouri', 'PRND-Price-Missouri', ST_GeomFromText('POINT(-100.9319 47.0797)',4326), null, null, '2021-03-12T16:16:13.899213Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06342020'),
+('040820e9-1dbd-4a34-bf17-02ab1903c671', False, 'prim-prairie-hill-chariton', 'PRIM-Prairie_Hill-Chariton', ST_GeomFromText('POINT(-92.7908 39.5399)',4326), null, null, '2021-03-12T16:16:13.899595Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06905500'),
+('b72e9e7d-5569-44c9-9641-c0ada621e7c5', False, 'hast-truman-lake-marais-des-cygnes', 'HAST-Truman_Lake-Marais_Des_Cygnes', ST_GeomFromText('POINT(-93.4011 38.265)',4326), null, null, '2021-03-12T16:16:13.900117Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06922440'),
+('0d0d22d9-9086-49dc-92d1-e1935bc0e357', False, 'tmrm-browning-two-medicine', 'TMRM-Browning-Two_Medicine', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.901278Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('49a5bc95-bb20-4ca7-8e01-8df8725d1d9f', False, 'tngk-tonganoxie-stranger', 'TNGK-Tonganoxie-Stranger', ST_GeomFromText('POINT(-95.0106 39.1164)',4326), null, null, '2021-03-12T16:16:13.901674Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06892000'),
+('10eedbd9-da07-4ac7-8483-91f75908db36', False, 'topk-topeka-soldier', 'TOPK-Topeka-Soldier', ST_GeomFromText('POINT(-95.7247 39.0994)',4326), null, null, '2021-03-12T16:16:13.902153Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06889500'),
+('298ecc91-786a-4fd3-973f-913247de33da', False, 'tomt-toston-missouri', 'TOMT-Toston-Missouri', ST_GeomFromText('POINT(-111.4203 46.1466)',4326), null, null, '2021-03-12T16:16:13.902534Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06054500'),
+('03f9dae9-42e1-45de-a662-0b585b8642e2', False, 'tpak-topeka-kansas', 'TPAK-Topeka-Kansas', ST_GeomFromText('POINT(-95.6494 39.0667)',4326), null, null, '2021-03-12T16:16:13.903097Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06889000'),
+('bd7afc0e-fdb9-49d8-b16c-ddbe3a7f0747', False, 'mksl-state-line-marais-des-cygnes', 'MKSL-State_Line-Marais_Des_Cygnes', ST_GeomFromText('POINT(-94.6128 38.2186)',4326), null, null, '2021-03-12T16:16:13.903575Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06916600'),
+('321955f5-c218-45e4-8f46-d617a03f3fca', False, 'trco-tarryall-res-tarryall', 'TRCO-Tarryall_Res-Tarryall', ST_GeomFromText('POINT(-105.6025 39.2217)',4326), null, null, '2021-03-12T16:16:13.903985Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('ebd63490-75b5-460b-90c4-f21afead613e', False, 'brco-borden-ditch-tarryall-creek', 'BRCO-Borden_Ditch-Tarryall_Creek', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.904258Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('cf9d1a22-175a-42a7-8f44-c2bdc1ef0634', False, 'mhia-turin-monona-harrison-ditch', 'MHIA-Turin-Monona_Harrison_Ditch', ST_GeomFromText('POINT(-95.9917 41.9644)',4326), null, null, '2021-03-12T16:16:13.904613Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06602400'),
+('3f6213c8-aa5e-48fc-b822-873d61e49946', False, 'tbco-trumbull-south-platte', 'TBCO-Trumbull-South_Platte', ST_GeomFromText('POINT(-105.2214 39.26)',4326), null, null, '2021-03-12T16:16:13.905251Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06701900'),
+('d26bd7d0-c955-4c40-88b9-b7716680b2e7', False, 'coco-como-tarryall', 'COCO-Como-Tarryall', ST_GeomFromText('POINT(-105.8661 39.3319)',4326), null, null, '2021-03-12T16:16:13.905613Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06696980'),
+('7204e776-3183-4ff6-83e7-4f6ca90a0415', False, 'tstk-tescott-saline', 'TSTK-Tescott-Saline', ST_GeomFromText('POINT(-97.8736 39.0039)',4326), null, null, '2021-03-12T16:16:13.906018Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06869500'),
+('5050c1a0-8f4c-4f0a-845c-e03e14c01e10', False, 'ttnm-trenton-thompson', 'TTNM-Trenton-Thompson', ST_GeomFromText('POINT(-93.638 40.0693)',4326), null, null, '2021-03-12T16:16:13.907052Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06899500'),
+('434d4b4d-e1e9-4660-ab32-a9c0958e5511', False, 'tdmo-tunnel-dam-niangua-macks-creek', 'TDMO-Tunnel_Dam-Niangua-Macks_Creek', ST_GeomFromText('POINT(-92.8514 37.9369)',4326), null, null, '2021-03-12T16:16:13.907380Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06923950'),
+('e79e81d4-504b-48e3-8f39-b37efbe55386', False, 'tuia-turin-little-sioux', 'TUIA-Turin-Little_Sioux', ST_GeomFromText('POINT(-95.9725 41.9644)',4326), null, null, '2021-03-12T16:16:13.907819Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06607500'),
+('423ff7c2-da37-4852-a5e4-7ac426ab780c', False, 'jrmt-twin-bridges-jefferson', 'JRMT-Twin_Bridges-Jefferson', ST_GeomFromText('POINT(-112.3294 45.6133)',4326), null, null, '2021-03-12T16:16:13.908465Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06026500'),
+('8dd142c1-9d3f-441a-8a1d-edff4aeaf03c', False, 'tbmt-twin-bridges-beaverhead', 'TBMT-Twin_Bridges-Beaverhead', ST_GeomFromText('POINT(-112.4528 45.3834)',4326), null, null, '2021-03-12T16:16:13.908953Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06018500'),
+('4107add3-5748-4ab0-8ad6-28c53127c7c8', False, 'taco-blw-tarryall-res-tarryall-cr', 'TACO-blw_Tarryall_Res-Tarryall_Cr', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.909377Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('669dd1da-c98f-47e5-9041-99ae4e17e50b', False, 'uene-uehling-logan', 'UENE-Uehling-Logan', ST_GeomFromText('POINT(-96.5216 41.7126)',4326), null, null, '2021-03-12T16:16:13.909811Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06799500'),
+('c13648e4-006d-49b4-9c37-582df4e7c0f4', False, 'unne-union-weeping-water', 'UNNE-Union-Weeping_Water', ST_GeomFromText('POINT(-95.9114 40.7939)',4326), null, null, '2021-03-12T16:16:13.910318Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06806500'),
+('2fd326a6-de7e-45aa-a6be-3ac88fdab73f', False, 'ul6e-ulm-missouri', 'UL6E-Ulm-Missouri', ST_GeomFromText('POINT(-111.3884 47.435)',4326), null, null, '2021-03-12T16:16:13.910859Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06078200'),
+('a5ad0345-cfa5-41ce-a0fd-7d33bf22c92d', False, 'vamt-vaughn-sun', 'VAMT-Vaughn-Sun', ST_GeomFromText('POINT(-111.511 47.5258)',4326), null, null, '2021-03-12T16:16:13.911206Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06089000'),
+('d6246cab-1568-408c-a705-1211059e4221', False, 'vcwk-laclede-vermillion', 'VCWK-LaClede-Vermillion', ST_GeomFromText('POINT(-96.2172 39.3478)',4326), null, null, '2021-03-12T16:16:13.911655Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06888000'),
+('4ddf05b1-cd15-4d4b-9162-9caafff898da', False, 'vdne-verdel-ponca', 'VDNE-Verdel-Ponca', ST_GeomFromText('POINT(-98.1758 42.8111)',4326), null, null, '2021-03-12T16:16:13.912014Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06453600'),
+('b9a920af-0b67-4d76-a9b1-af6f28a6605b', False, 'vrsd-vermillion-vermillion', 'VRSD-Vermillion-Vermillion', ST_GeomFromText('POINT(-96.9239 42.8172)',4326), null, null, '2021-03-12T16:16:13.912520Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479010'),
+('769f0f08-9ce7-4628-adcb-cb8b4950b28a', False, 'vrne-verdel-niobrara', 'VRNE-Verdel-Niobrara', ST_GeomFromText('POINT(-98.2228 42.7397)',4326), null, null, '2021-03-12T16:16:13.913486Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06465500'),
+('12883e59-647c-41f8-9bcf-71388402dd9f', False, 'vimt-virgelle-missouri', 'VIMT-Virgelle-Missouri', ST_GeomFromText('POINT(-110.2588 48.0038)',4326), null, null, '2021-03-12T16:16:13.914346Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06109500'),
+('79bca4c1-f7a1-49ff-905e-247abf36b8e3', False, 'pasd-parker-ef-vermillion', 'PASD-Parker-EF_Vermillion', ST_GeomFromText('POINT(-97.1094 43.4453)',4326), null, null, '2021-03-12T16:16:13.916420Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06478600'),
+('9f954295-21d5-457a-9833-67225ddbb673', False, 'waak-wakeeney-saline', 'WAAk-WaKeeney-Saline', ST_GeomFromText('POINT(-99.8697 39.1061)',4326), null, null, '2021-03-12T16:16:13.917068Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06866900'),
+('88a7b3f1-8ae0-4c85-8b54-493a743a7b84', False, 'wrsy-ab-boysen-res-wind', 'WRSY-ab_Boysen_Res-Wind', ST_GeomFromText('POINT(-108.2233 43.1292)',4326), null, null, '2021-03-12T16:16:13.917528Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '09219200'),
+('5087266f-6b60-4f63-aca5-0e60739ef831', False, 'wssd-wasta-cheyenne', 'WSSD-Wasta-Cheyenne', ST_GeomFromText('POINT(-102.4008 44.0811)',4326), null, null, '2021-03-12T16:16:13.917990Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06423500'),
+('e32bbe2b-224a-4c31-ba54-e085e9cbe767', False, 'snwy-saratoga-north-brush', 'SNWY-Saratoga-North_Brush', ST_GeomFromText('POINT(-106.52 41.3703)',4326), null, null, '2021-03-12T16:16:13.918849Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06622700'),
+('3f53f6f9-01bf-4ab8-a1e0-a6a03f50a6d1', False, 'wtco-waterton-south-platte', 'WTCO-Waterton-South_Platte', ST_GeomFromText('POINT(-105.0922 39.4883)',4326), null, null, '2021-03-12T16:16:13.919252Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('259937f3-da71-4d2c-b481-c77266605a44', False, 'wcnd-watford-city-little-missouri', 'WCND-Watford_City-Little_Missouri', ST_GeomFromText('POINT(-103.2634 47.5954)',4326), null, null, '2021-03-12T16:16:13.919578Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06337000'),
+('ffc37226-df29-4179-a875-f3c6973c4efe', False, 'wesd-at-watertown-big-sioux', 'WESD-at_Watertown-Big_Sioux', ST_GeomFromText('POINT(-97.1458 44.9425)',4326), null, null, '2021-03-12T16:16:13.920124Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479500'),
+('f2553a00-72cb-44a1-8c4d-9dd2f9e72a9e', False, 'wbsd-watertown-big-sioux-broadway', 'WBSD-Watertown-Big_Sioux-Broadway', ST_GeomFromText('POINT(-97.119 44.8891)',4326), null, null, '2021-03-12T16:16:13.920625Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479512'),
+('8abaf43c-68a0-49a5-a5f8-5547faa39a12', False, 'wofk-woodruff-prairie-dog', 'WOFK-Woodruff-Prairie_Dog', ST_GeomFromText('POINT(-99.4783 39.9787)',4326), null, null, '2021-03-12T16:16:13.921189Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06848500'),
+('e55f0960-3e48-4af9-8b20-2f73faddf3ed', False, 'wrry-riverton-wind', 'WRRY-Riverton-Wind', ST_GeomFromText('POINT(-108.3761 43.0106)',4326), null, null, '2021-03-12T16:16:13.921676Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06228000'),
+('b2f55a2f-f0c8-4347-b22f-6fad4833daa8', False, 'wpsd-westport-elm', 'WPSD-Westport-Elm', ST_GeomFromText('POINT(-98.4967 45.6561)',4326), null, null, '2021-03-12T16:16:13.922350Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06471500'),
+('a56e223d-d9b4-433b-ad88-43d9deab21b2', False, 'whsd-whitehorse-moreau', 'WHSD-Whitehorse-Moreau', ST_GeomFromText('POINT(-100.8425 45.2558)',4326), null, null, '2021-03-12T16:16:13.923015Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06360500'),
+('e36ad2a9-ffb2-48b3-ab68-f3d5881a83e7', False, 'whwy-bl-whalen-north-platte', 'WHWY-bl_Whalen-North_Platte', ST_GeomFromText('POINT(-104.6274 42.2411)',4326), null, null, '2021-03-12T16:16:13.923853Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06657000'),
+('194e34df-53c4-409e-911c-63dc6466a122', False, 'wakp-wakpala-oak', 'WAKP-Wakpala-Oak', ST_GeomFromText('POINT(-100.5963 45.7284)',4326), null, null, '2021-03-12T16:16:13.924642Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06354881'),
+('25dc31d5-2e39-4dc2-ba73-0b8aa2ed86ab', False, 'wiln-wilson-lake-saline', 'WILN-Wilson_Lake-Saline', ST_GeomFromText('POINT(-98.4946 38.9658)',4326), null, null, '2021-03-12T16:16:13.925474Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06868100'),
+('c228055b-e9a9-422a-867e-6e356727be3b', False, 'wsn-williston-missouri', 'WSN-Williston-Missouri', ST_GeomFromText('POINT(-103.7142 48.1081)',4326), null, null, '2021-03-12T16:16:13.926217Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06330000'),
+('9e09065e-6f9d-480f-bde0-886d5b60689e', False, 'wgks-wamego-kansas', 'WGKS-Wamego-Kansas', ST_GeomFromText('POINT(-96.3053 39.1983)',4326), null, null, '2021-03-12T16:16:13.927051Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06887500'),
+('add04934-6068-42bf-92a6-6a322667905c', False, 'wecl-weldona-south-platte', 'WECL-Weldona-South_Platte', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.927650Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('10103b2f-efbe-436e-ae94-3cf6c977c93e', False, 'wodk-woodston-sf-solomon', 'WODK-Woodston-SF_Solomon', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.928115Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06873460'),
+('5ca0fbf4-9fda-4d8c-aca6-fb88cc3af281', False, 'wsne-west-point-elkhorn', 'WSNE-West_Point-Elkhorn', ST_GeomFromText('POINT(-96.7272 41.8394)',4326), null, null, '2021-03-12T16:16:13.928591Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06799350'),
+('1dbbef84-0011-47f1-8996-fe96cbd2e270', False, 'wpmt-wolf-point-missouri', 'WPMT-Wolf_Point-Missouri', ST_GeomFromText('POINT(-105.5331 48.0673)',4326), null, null, '2021-03-12T16:16:13.929387Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06177000'),
+('0906044d-8908-4a1f-99ec-662b08c2cd49', False, 'hw7m-warsaw-osage', 'HW7M-Warsaw-Osage', ST_GeomFromText('POINT(-93.3871 38.2445)',4326), null, null, '2021-03-12T16:16:13.930015Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06922500'),
+('963f04e9-51bc-4874-b008-cdd9106e7745', False, 'wsnd-washburn-missouri', 'WSND-Washburn-Missouri', ST_GeomFromText('POINT(-101.0375 47.2889)',4326), null, null, '2021-03-12T16:16:13.930523Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06341000'),
+('69016049-9bdb-41c7-9391-38b1fe7bfcbb', False, 'wshk-washington-mill', 'WSHK-Washington-Mill', ST_GeomFromText('POINT(-97.0372 39.8136)',4326), null, null, '2021-03-12T16:16:13.931280Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06884200'),
+('9cca7dcf-8cc8-4b16-858c-149b7b2e950f', False, 'wilk-bl-wilson-lake-saline', 'WILK-bl_Wilson_Lake-Saline', ST_GeomFromText('POINT(-98.49 38.9747)',4326), null, null, '2021-03-12T16:16:13.931650Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06868200'),
+('edae07b9-b6c6-4c14-bbb5-f44141d8ef6b', False, 'wtne-waterloo-elkhorn', 'WTNE-Waterloo-Elkhorn', ST_GeomFromText('POINT(-96.2839 41.2933)',4326), null, null, '2021-03-12T16:16:13.932108Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06800500'),
+('39663433-687e-4aad-a17e-aa6b8094ece6', False, 'wtsd-nr-watertown-big-sioux', 'WTSD-nr_Watertown-Big_Sioux', ST_GeomFromText('POINT(-97.1463 44.9424)',4326), null, null, '2021-03-12T16:16:13.933040Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479438'),
+('b7d78f8c-7945-489a-88ce-d19b82a363bc', False, 'wvmo-waverly-missouri', 'WVMO-Waverly-Missouri', ST_GeomFromText('POINT(-93.515 39.215)',4326), null, null, '2021-03-12T16:16:13.933557Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06895500'),
+('3cfddf48-2ce9-4d0c-8cec-e29a4f7e2b9f', False, 'bywy-blw-boysen-res-wind', 'BYWY-blw_Boysen_Res-Wind', ST_GeomFromText('POINT(-108.1783 43.425)',4326), null, null, '2021-03-12T16:16:13.934468Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('0f40fe5a-755a-4c63-8fbf-8abccf2a09b4', False, 'tmwy-thermopolis-bighorn', 'TMWY-Thermopolis-Bighorn', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.934898Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('7d3bd68c-4e61-44e0-8d3d-f99ca2e8ffc2', False, 'wwsd-watertown-willow', 'WWSD-Watertown-Willow', ST_GeomFromText('POINT(-97.0453 44.9189)',4326), null, null, '2021-03-12T16:16:13.935269Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06479515'),
+('1fa54c42-6629-4447-9939-afbd66b218ad', False, 'iwsd-pierre-missouri-isaac-walton', 'IWSD-Pierre-Missouri-Isaac_Walton', ST_GeomFromText('POINT(-100.307 44.3372)',4326), null, null, '2021-03-12T16:16:13.935720Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06441592'),
+('9b34c6e6-5e1a-48b8-930c-6422c22973b3', False, 'ykn-yankton-missouri', 'YKN-Yankton-Missouri', ST_GeomFromText('POINT(-97.3973 42.8656)',4326), null, null, '2021-03-12T16:16:13.936078Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06467500'),
+('349335bb-ca47-441d-824c-e3d164548db4', False, 'ykn1-yankton-missouri', 'YKN1-Yankton-Missouri', ST_GeomFromText('POINT(-91.3475 36.7866)',4326), null, null, '2021-03-12T16:16:13.936706Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '07071000'),
+('e3fc8b71-694b-4423-aa21-46195cc218aa', False, 'pa16-papio-cr-dam-16-stand-bear-lake', 'PA16-Papio_Cr_Dam_16-Stand_Bear_Lake', ST_GeomFromText('POINT(-96.1218 41.3144)',4326), null, null, '2021-03-12T16:16:13.937342Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('278246ba-5dc4-4ba5-b184-6a52b97edee7', False, 'pmia-millnerville-perry', 'PMIA-Millnerville-Perry', ST_GeomFromText('POINT(-96.37 42.6933)',4326), null, null, '2021-03-12T16:16:13.937845Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06599900'),
+('bc29ca96-0c34-46dc-8a99-fc0f3e6b46ff', False, 'kcia-glenwood-keg', 'KCIA-Glenwood-Keg', ST_GeomFromText('POINT(-95.7183 41.1022)',4326), null, null, '2021-03-12T16:16:13.938243Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06805850'),
+('9f42e8cf-f13c-4427-bd80-fe9df9c0e2c2', False, 'guwy-bl-guernsey-north-platte', 'GUWY-bl_Guernsey-North_Platte', ST_GeomFromText('POINT(-104.7542 42.2806)',4326), null, null, '2021-03-12T16:16:13.938604Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('7174bfdb-1c2d-45dd-a24c-4a33599d21b8', False, 'sawy-saratoga-north-platte', 'SAWY-Saratoga-North_Platte', ST_GeomFromText('POINT(-106.8056 41.455)',4326), null, null, '2021-03-12T16:16:13.938921Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('c7bcfc4a-3770-42c4-b02e-7334ba3ab4c5', False, 'shwy-boxelder-boxelder', 'SHWY-Boxelder-Boxelder', ST_GeomFromText('POINT(-105.8592 42.6119)',4326), null, null, '2021-03-12T16:16:13.939331Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '13012475'),
+('296a45f6-b330-496d-9de0-dd613a060edd', False, 'lawy-lander-popo-agie', 'LAWY-Lander-Popo_Agie', ST_GeomFromText('POINT(-108.6428 42.7667)',4326), null, null, '2021-03-12T16:16:13.939986Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('609c3bee-0dc0-4d7f-8907-d399b6fa6753', False, 'mpwy-lander-middle-popo-agie', 'MPWY-Lander-Middle_Popo_Agie', ST_GeomFromText('POINT(-108.7978 42.7572)',4326), null, null, '2021-03-12T16:16:13.940448Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('59e3ad21-aeec-4776-a538-87c9d8b045e2', False, 'brwy-worland-bighorn', 'BRWY-Worland-Bighorn', ST_GeomFromText('POINT(-107.9689 44.0147)',4326), null, null, '2021-03-12T16:16:13.940892Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('7ac04619-1156-4085-a3c9-86e606009172', False, 'fmwy-shoshoni-five-mile', 'FMWY-Shoshoni-Five_Mile', ST_GeomFromText('POINT(-108.2197 43.2219)',4326), null, null, '2021-03-12T16:16:13.941199Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06253000'),
+('1ccdaac9-11dc-4829-8573-47c26f393c5d', False, 'mnwy-morton-wind', 'MNWY-Morton-Wind', ST_GeomFromText('POINT(-108.8083 43.1922)',4326), null, null, '2021-03-12T16:16:13.941559Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('c098330d-f3c2-44ec-8aaf-95d6ed227020', False, 'scwy-shell-shell', 'SCWY-Shell-Shell', ST_GeomFromText('POINT(-107.7122 44.565)',4326), null, null, '2021-03-12T16:16:13.942057Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('7e603dea-124f-4102-89c7-cca166c97ef2', False, 'mewy-meeteetsee-greybull', 'MEWY-Meeteetsee-Greybull', ST_GeomFromText('POINT(-108.8764 44.1556)',4326), null, null, '2021-03-12T16:16:13.942466Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('af1f410c-48d3-49b4-8e72-3085699c7899', False, 'sdmt-swift-reservoir-birch', 'SDMT-Swift_Reservoir-Birch', ST_GeomFromText('POINT(-112.8667 48.15)',4326), null, null, '2021-03-12T16:16:13.942772Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('9a106989-5fd5-4a7c-8c6b-96e0a33fdfe9', False, 'leco-georgetown-leavenworth', 'LECO-Georgetown-Leavenworth', ST_GeomFromText('POINT(-105.6997 39.6872)',4326), null, null, '2021-03-12T16:16:13.943061Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06714800'),
+('2154ace8-09ae-4491-bc82-39f5cff4d037', False, 'srmt-augusta-bridge-sun', 'SRMT-Augusta_Bridge-Sun', ST_GeomFromText('POINT(-111.9306 47.5028)',4326), null, null, '2021-03-12T16:16:13.943428Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06082200'),
+('a5d2602f-9bfe-4754-b3d9-809d7affe6c3', False, 'mamt-international-boundary-st-mary', 'MAMT-International_Boundary-St_Mary', ST_GeomFromText('POINT(-113.2992 49.0119)',4326), null, null, '2021-03-12T16:16:13.943808Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('72a96a78-2bbf-4c38-8821-5d57b68e91c3', False, 'awne-ashland-wahoo', 'AWNE-Ashland-Wahoo', ST_GeomFromText('POINT(-96.3678 41.0536)',4326), null, null, '2021-03-12T16:16:13.944182Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06804700'),
+('31050872-88b9-4793-a4ea-b4bf57eaca08', False, 'lsne-lincoln-little-salt', 'LSNE-Lincoln-Little_Salt', ST_GeomFromText('POINT(-96.6817 40.8931)',4326), null, null, '2021-03-12T16:16:13.944536Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803510'),
+('5661b688-9b34-4b4b-bb91-e22d1a5ed3af', False, 'lfne-lincoln-fairgrounds-salt', 'LFNE-Lincoln-Fairgrounds-Salt', ST_GeomFromText('POINT(-96.6914 40.8386)',4326), null, null, '2021-03-12T16:16:13.944928Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803495'),
+('f9f983fa-0fcb-4882-86d6-27d4f48c39a4', False, 'ocne-lincoln-air-park-rd-oak-cr', 'OCNE-Lincoln-Air_Park_Rd-Oak_Cr', ST_GeomFromText('POINT(-96.7794 40.8553)',4326), null, null, '2021-03-12T16:16:13.945310Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803486'),
+('89a62a94-e70c-4e81-8ebb-9322baef48ac', False, 'jssd-ab-johnson-siding-rapid', 'JSSD-ab_Johnson_Siding-Rapid', ST_GeomFromText('POINT(-103.4422 44.0819)',4326), null, null, '2021-03-12T16:16:13.945667Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06411900'),
+('5692b4e6-8db0-4f0b-99c9-b8eb2465ed38', False, 'besd-big-bend-rapid', 'BESD-Big_Bend-Rapid', ST_GeomFromText('POINT(-103.4167 44.055)',4326), null, null, '2021-03-12T16:16:13.946066Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06412000'),
+('6ca1271c-1dc6-43eb-939b-04dfbfea6bc6', False, 'pssd-st-patrick-st-rapid', 'PSSD-St_Patrick_St-Rapid', ST_GeomFromText('POINT(-103.1803 44.0672)',4326), null, null, '2021-03-12T16:16:13.946409Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06416000'),
+('32065168-dade-4d48-b493-9ed973a1479a', False, 'mywy-mammoth-raws', 'MYWY-Mammoth-Raws', ST_GeomFromText('POINT(-110.6953 44.9772)',4326), null, null, '2021-03-12T16:16:13.946829Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('8fb8f24c-ba7c-42aa-a2f7-e60db8dba73c', False, 'sc02-salt-cr-dam-2-olive-creek-lake', 'SC02-Salt_Cr_Dam_2-Olive_Creek_Lake', ST_GeomFromText('POINT(-96.8465 40.5853)',4326), null, null, '2021-03-12T16:16:13.947127Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('1aa216f8-dd4f-459e-9bc8-e8c98dd54839', False, 'pa18-papio-cr-dam-18-zorinsky-lake', 'PA18-Papio_Cr_Dam_18-Zorinsky_Lake', ST_GeomFromText('POINT(-96.1608 41.2202)',4326), null, null, '2021-03-12T16:16:13.947610Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('943b3020-5a17-4ffa-9d31-4fc4a8c63f84', False, 'pa20-papio-cr-dam-20-wehrspann-lake', 'PA20-Papio_Cr_Dam_20-Wehrspann_Lake', ST_GeomFromText('POINT(-96.1496 41.1719)',4326), null, null, '2021-03-12T16:16:13.948105Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('4760111f-b705-4134-89f7-c0f7ff56e2a5', False, 'sc04-salt-cr-dam-4-blue-stem-lake', 'SC04-Salt_Cr_Dam_4-Blue_Stem_Lake', ST_GeomFromText('POINT(-96.7902 40.628)',4326), null, null, '2021-03-12T16:16:13.948480Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('2af8a7fe-f45f-4013-9955-14dd21b86ad5', False, 'mcsd-aberdeen-moccasin', 'MCSD-Aberdeen-Moccasin', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.948963Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('e4213aae-cdac-4ce3-95d9-88fd68f2e70c', False, 'shnd-schmidt-missouri', 'SHND-Schmidt-Missouri', ST_GeomFromText('POINT(-100.7392 46.6564)',4326), null, null, '2021-03-12T16:16:13.949432Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06349700'),
+('8b3bb0ea-b1fc-4379-9735-4a023030be7b', False, 'gwsd-greenwood-missouri', 'GWSD-Greenwood-Missouri', ST_GeomFromText('POINT(-98.3494 42.9053)',4326), null, null, '2021-03-12T16:16:13.949896Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06453020'),
+('1c7b6f7b-d66f-404c-9bdb-2ded86237b94', False, 'chcr-cherry-creek-dam-cherry', 'CHCR-Cherry_Creek_Dam-Cherry', ST_GeomFromText('POINT(-104.859 39.6438)',4326), null, null, '2021-03-12T16:16:13.950251Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('1a202c21-e5b0-40bc-85ac-cf682ed55571', False, 'macm-macon-east-fork-little-chariton', 'MACM-Macon-East_Fork_Little_Chariton', ST_GeomFromText('POINT(-92.5189 39.7514)',4326), null, null, '2021-03-12T16:16:13.951106Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06906200'),
+('62a8b8db-f3a6-4769-8661-9d2d67abe92c', False, 'stnd-stanton-missouri', 'STND-Stanton-Missouri', ST_GeomFromText('POINT(-101.3397 47.2872)',4326), null, null, '2021-03-12T16:16:13.951630Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06340700'),
+('1dbc13de-12ad-4ee1-ac2e-7c5cb5ad5d66', False, '46nd', '46ND', ST_GeomFromText('POINT(-98.5719 46.6292)',4326), null, null, '2021-03-12T16:16:13.951972Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('3216ab89-c388-435d-bea9-636ab9ebe6da', False, 'absd-aberdeen-foot', 'ABSD-Aberdeen-Foot', ST_GeomFromText('POINT(-98.5772 45.5192)',4326), null, null, '2021-03-12T16:16:13.952271Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06471800'),
+('ec0b69d3-81d0-495b-a45a-ecfd3df3e1de', False, 'achk-achilles-south-fork-sappa', 'ACHK-Achilles-South_Fork_Sappa', ST_GeomFromText('POINT(-84.7078 43.6261)',4326), null, null, '2021-03-12T16:16:13.952920Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06844900'),
+('c977564e-573b-4ee8-83e1-f2174d0828ca', False, 'acia-atlantic-east-nishnabotna', 'ACIA-Atlantic-East_Nishnabotna', ST_GeomFromText('POINT(-95.0767 41.3461)',4326), null, null, '2021-03-12T16:16:13.953431Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06809210'),
+('4c39e266-f0d4-43a3-a739-278a21233295', False, 'essd-elm-springs-elk', 'ESSD-Elm_Springs-Elk', ST_GeomFromText('POINT(-102.5028 44.2483)',4326), null, null, '2021-03-12T16:16:13.954035Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06425500'),
+('97206268-077e-4f1c-9f3c-cf942df47e52', False, 'flne-falls-city-big-nemaha', 'FLNE-Falls_City-Big_Nemaha', ST_GeomFromText('POINT(-95.596 40.0355)',4326), null, null, '2021-03-12T16:16:13.954366Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06815000'),
+('6048784b-1da8-4074-abcf-a9d37855b565', False, 'sc10-salt-cr-dam-10-yankee-hill-lake', 'SC10-Salt_Cr_Dam_10-Yankee_Hill_Lake', ST_GeomFromText('POINT(-96.7832 40.7305)',4326), null, null, '2021-03-12T16:16:13.954769Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('f42b4aa3-2285-489d-bc0c-0ed31c28a002', False, 'sc12-salt-cr-dam-12-conestoga-lake', 'SC12-Salt_Cr_Dam_12-Conestoga_Lake', ST_GeomFromText('POINT(-96.846 40.7672)',4326), null, null, '2021-03-12T16:16:13.955306Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('cb651eef-22e7-4352-bb46-614b6799fe41', False, 'jdrm8-winifred-judith', 'JDRM8-Winifred-Judith', ST_GeomFromText('POINT(-109.6525 47.6683)',4326), null, null, '2021-03-12T16:16:13.955882Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06114700'),
+('1ad01639-6fa9-4f71-a3d4-867165d60ffd', False, 'dttm8-dutton-tenton', 'DTTM8-Dutton-Tenton', ST_GeomFromText('POINT(-111.5519 47.9303)',4326), null, null, '2021-03-12T16:16:13.956421Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06108000'),
+('f8612d9a-1043-475a-97ca-e5c3723b2866', False, 'ltrm8-loma-teton', 'LTRM8-Loma-Teton', ST_GeomFromText('POINT(-110.5144 47.9328)',4326), null, null, '2021-03-12T16:16:13.956895Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06108800'),
+('54c85a30-b34a-4a18-97e8-7c9e4091237c', False, 'frwy-fales-rock-raws', 'FRWY-Fales-Rock_RAWS', ST_GeomFromText('POINT(-107.2722 42.8564)',4326), null, null, '2021-03-12T16:16:13.957320Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('783c103f-0927-4395-b32d-baac7aee2f64', False, 'gllm-gallatin-grand', 'GLLM-Gallatin-Grand', ST_GeomFromText('POINT(-93.9425 39.9269)',4326), null, null, '2021-03-12T16:16:13.957594Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06897500'),
+('ac578540-c6de-4923-a151-d06cc66153b0', False, 'pipp-pipestem-dam-precip', 'PIPP-Pipestem_Dam-Precip', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.957850Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('552f8ab5-d307-4ac4-a1c5-e84c3a214bb5', False, 'rrmt-bl-lima-mt-red-rock', 'RRMT-bl_Lima_MT-Red_Rock', ST_GeomFromText('POINT(-112.3712 44.6559)',4326), null, null, '2021-03-12T16:16:13.958135Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06012500'),
+('e689b4b9-b19d-49ad-906a-302ecb7f713c', False, 'atmt', 'ATMT', ST_GeomFromText('POINT(-112.3372 45.5469)',4326), null, null, '2021-03-12T16:16:13.958572Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06023100'),
+('1c9e4f8a-3df2-4746-a030-952b82007b8a', False, 'psne-omaha-pacific-st-big-papillion', 'PSNE-Omaha-Pacific_St-Big_Papillion', ST_GeomFromText('POINT(-96.0788 41.249)',4326), null, null, '2021-03-12T16:16:13.958802Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610740'),
+('e3c748ed-ec47-4ea4-a403-31e46bb5078c', False, 'bwmt', 'BWMT', ST_GeomFromText('POINT(-113.0684 45.8508)',4326), null, null, '2021-03-12T16:16:13.959216Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06024580'),
+('0f0258d9-a745-4b6b-bd94-dd92d7a69f6b', False, 'lone-lisco-north-platte', 'LONE-Lisco-North_Platte', ST_GeomFromText('POINT(-102.6248 41.4901)',4326), null, null, '2021-03-12T16:16:13.959555Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('1708e3ee-5558-4568-90b3-ab521bf33e13', False, 'lene-lewellen-north-platte', 'LENE-Lewellen-North_Platte', ST_GeomFromText('POINT(-102.1259 41.3155)',4326), null, null, '2021-03-12T16:16:13.959946Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('a4f67cf5-bbff-4828-a4aa-14825befd1e4', False, 'spne-north-platte-south-platte', 'SPNE-North_Platte-South_Platte', ST_GeomFromText('POINT(-100.7728 41.1181)',4326), null, null, '2021-03-12T16:16:13.960360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('ee55f8b5-56c3-4152-8f74-0c4a7c4289a5', False, 'byne-brady-platte', 'BYNE-Brady-Platte', ST_GeomFromText('POINT(-100.3716 41.0193)',4326), null, null, '2021-03-12T16:16:13.960763Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('69c72b4c-8105-4b0e-842b-9bb0d9541579', False, 'jpmt', 'JPMT', ST_GeomFromText('POINT(-112.1872 45.7475)',4326), null, null, '2021-03-12T16:16:13.961104Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06027600'),
+('659984e2-a5b2-441f-8908-61639f770b2a', False, 'bbmt', 'BBMT', ST_GeomFromText('POINT(-112.0917 46.211)',4326), null, null, '2021-03-12T16:16:13.961440Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06033000'),
+('b4840f7d-fe33-4a4c-85c7-d0aaf8309935', False, 'whmt', 'WHMT', ST_GeomFromText('POINT(-111.741 45.7231)',4326), null, null, '2021-03-12T16:16:13.961788Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06035000'),
+('80405143-af53-4abe-8520-7e4694400ca0', False, 'fhmt', 'FHMT', ST_GeomFromText('POINT(-110.8635 44.6202)',4326), null, null, '2021-03-12T16:16:13.962147Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06036905'),
+('7d7beaf4-4ed6-4a67-99c9-374e98f3fa05', False, 'gmmt', 'GMMT', ST_GeomFromText('POINT(-110.8611 44.6408)',4326), null, null, '2021-03-12T16:16:13.962422Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06037100'),
+('f0707b5c-0d89-4a6b-9274-ec9195cac16d', False, 'gbmt', 'GBMT', ST_GeomFromText('POINT(-111.0662 45.7257)',4326), null, null, '2021-03-12T16:16:13.962707Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06048650'),
+('281945aa-f31b-46bc-b0ee-0277d429679d', False, 'czne-cozad-platte', 'CZNE-Cozad-Platte', ST_GeomFromText('POINT(-99.9867 40.8375)',4326), null, null, '2021-03-12T16:16:13.962929Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('705bb18f-5302-4ea8-a2de-03983ca1cfa0', False, 'ppmt', 'PPMT', ST_GeomFromText('POINT(-111.9468 46.5191)',4326), null, null, '2021-03-12T16:16:13.963357Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06061500'),
+('3aa75d03-d45d-41e0-b690-4283dd7a63f8', False, 'tmmt', 'TMMT', ST_GeomFromText('POINT(-112.0883 46.605)',4326), null, null, '2021-03-12T16:16:13.963685Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06063000'),
+('7cdbc592-b6bd-4ac4-adce-7e9339294580', False, 'semt', 'SEMT', ST_GeomFromText('POINT(-111.3862 47.1893)',4326), null, null, '2021-03-12T16:16:13.963956Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06077500'),
+('f05af919-83ff-4ba3-94f4-a26a00c28809', False, 'nsmt', 'NSMT', ST_GeomFromText('POINT(-112.8593 47.6399)',4326), null, null, '2021-03-12T16:16:13.964219Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06078500'),
+('6459e5ee-d5f9-4455-890d-08f7709c4e1a', False, 'ssmt', 'SSMT', ST_GeomFromText('POINT(-112.8658 47.6286)',4326), null, null, '2021-03-12T16:16:13.964512Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06079000'),
+('a94471df-d6da-44f5-8adc-e5c57e2220ba', False, 'cone-columbus-shell', 'CONE-Columbus-Shell', ST_GeomFromText('POINT(-97.2817 41.5261)',4326), null, null, '2021-03-12T16:16:13.964797Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06795500'),
+('200b2b6c-caab-416a-bb5c-dd025e4af5c2', False, 'chcr2-cherry-creek-dam-cherry', 'CHCR2-Cherry_Creek_Dam-Cherry', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.965294Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('c6117590-0ff9-43a0-be1b-ce895777b2a1', False, 'alne-ashland-salt', 'ALNE-Ashland-Salt', ST_GeomFromText('POINT(-96.3386 41.0517)',4326), null, null, '2021-03-12T16:16:13.965602Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06805000'),
+('b655853c-4e71-4e92-b189-140a19185af6', False, 'akne-omaha-aksarben-little-papillion', 'AKNE-Omaha-Aksarben-Little_Papillion', ST_GeomFromText('POINT(-96.0206 41.2453)',4326), null, null, '2021-03-12T16:16:13.965922Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610765'),
+('8b076630-aed6-42b8-9953-e75131697d0b', False, 'stmt-st-xavier-bridge-bighorn', 'STMT-St_Xavier_Bridge-Bighorn', ST_GeomFromText('POINT(-107.7484 45.4609)',4326), null, null, '2021-03-12T16:16:13.966381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06287800'),
+('7923051f-5fed-4189-b247-338d4c1f2a1c', False, 'fortrandalldcp', 'FortRandallDCP', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.966825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('9af7861e-bf85-44e3-8ea7-fb7985f7ee7a', False, 'fortpeckdcp', 'FortPeckDCP', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.968662Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('a064d8c4-c466-47f9-bac9-167a74e5d47a', False, 'garrisondcp', 'GarrisonDCP', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.970450Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('cddc1ae8-4364-4aeb-8f3d-4374c53a6326', False, 'lnsk-lindsborg-smoky-hill', 'LNSK-Lindsborg-Smoky_Hill', ST_GeomFromText('POINT(-97.7928 39.7986)',4326), null, null, '2021-03-12T16:16:13.972262Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06866000'),
+('cb985903-7a82-436d-8420-44d615689b3f', False, 'lcne-lincoln-salt', 'LCNE-Lincoln-Salt', ST_GeomFromText('POINT(-96.6817 40.8467)',4326), null, null, '2021-03-12T16:16:13.972835Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803500'),
+('a4d51061-8dda-46cd-bca2-e557744ffc88', False, 'haia-hamburg-nishnabotna', 'HAIA-Hamburg-Nishnabotna', ST_GeomFromText('POINT(-95.645 40.6017)',4326), null, null, '2021-03-12T16:16:13.973344Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06810000'),
+('3a5e97c8-bef6-46a9-96a3-f84c7363d824', False, 'sndk-scandia-republican', 'SNDK-Scandia-Republican', ST_GeomFromText('POINT(-97.7928 39.7986)',4326), null, null, '2021-03-12T16:16:13.973966Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06854500'),
+('ef41d78d-aea5-4e41-8e54-858d8ba6360a', False, 'mpsk-minneapolis-solomon', 'MPSK-Minneapolis-Solomon', ST_GeomFromText('POINT(-97.7928 39.7986)',4326), null, null, '2021-03-12T16:16:13.974391Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06876440'),
+('34aa979a-bafc-4f21-83d2-aa8c940b7f7c', False, 'hbgk-hollenberg-little-blue', 'HBGK-Hollenberg-Little_Blue', ST_GeomFromText('POINT(-97.0047 39.9803)',4326), null, null, '2021-03-12T16:16:13.974799Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06884025'),
+('eada8469-f87a-4638-b5dd-23c4b9830c9c', False, 'cbnd-1806-bridge-cannonball', 'CBND-1806_Bridge-Cannonball', ST_GeomFromText('POINT(-76.31 37.4928)',4326), null, null, '2021-03-12T16:16:13.975293Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '372934076183600'),
+('074e5eb7-4c14-4e65-91c8-1ec6053bda5a', False, 'wlsd-wewela-keya-paha', 'WLSD-Wewela-Keya_Paha', ST_GeomFromText('POINT(-99.7805 43.0271)',4326), null, null, '2021-03-12T16:16:13.975739Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06464500'),
+('d5be40fb-1d9b-42dc-b994-4688a90d3009', False, 'test1', 'Test1', ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:16:13.976224Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('e1c6edd8-1b70-41e4-9468-52f069439066', False, 'qsne-omaha-q-st-big-papillion', 'QSNE-Omaha-Q_St-Big_Papillion', ST_GeomFromText('POINT(-96.0172 41.2053)',4326), null, null, '2021-03-12T16:16:13.976490Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610770'),
+('ecca9a91-cdf1-493d-b7cb-ce7af8bfdc85', False, 'kdne-kennard-big-papillion', 'KDNE-Kennard-Big_Papillion', ST_GeomFromText('POINT(-96.1909 41.4511)',4326), null, null, '2021-03-12T16:16:13.976914Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610710'),
+('1eb1c777-b66a-40a2-a286-b64a95d2147a', False, 'pwne-omaha-pacific-st-west-papillion', 'PWNE-Omaha-Pacific_St-West_Papillion', ST_GeomFromText('POINT(-96.1681 41.2487)',4326), null, null, '2021-03-12T16:16:13.977363Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610780'),
+('d8bfed52-cd92-4a18-a890-ff8ebb412a50', False, 'chne-chalco-south-papillion', 'CHNE-Chalco-South_Papillion', ST_GeomFromText('POINT(-96.1392 41.1801)',4326), null, null, '2021-03-12T16:16:13.977752Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610788'),
+('5843abb7-129f-4c2a-a7b6-61aa64534c55', False, 'lnim-macks-creek-little-niangua', 'LNIM-Macks_Creek-Little_Niangua', ST_GeomFromText('POINT(-93.0669 37.9347)',4326), null, null, '2021-03-12T16:16:13.978342Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06925250'),
+('7afbf9cf-43d1-4e86-a9dc-e2a3fbe8520a', False, 'wphm-westphalia-maries', 'WPHM-Westphalia-Maries', ST_GeomFromText('POINT(-91.9886 38.4318)',4326), null, null, '2021-03-12T16:16:13.978958Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06927000'),
+('ced533fb-634a-46e6-b6d2-f651c649b639', False, 'fskk-fort-scott-marmaton', 'FSKK-Fort_Scott-Marmaton', ST_GeomFromText('POINT(-94.7025 37.8489)',4326), null, null, '2021-03-12T16:16:13.979486Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06917500'),
+('e311f638-3c4f-4d4c-bc3a-3e517d14ef70', False, 'jcmm-jefferson-city-moreau', 'JCMM-Jefferson_City-Moreau', ST_GeomFromText('POINT(-92.192 38.529)',4326), null, null, '2021-03-12T16:16:13.980382Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06910750'),
+('c3c0714b-b04d-43f8-908e-11a6e4f41a1b', False, 'tscm-tuscumbia-osage', 'TSCM-Tuscumbia-Osage', ST_GeomFromText('POINT(-92.4586 38.2325)',4326), null, null, '2021-03-12T16:16:13.980899Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06926080'),
+('08cd17d4-0296-4c6d-a4b5-11d2e86ec189', False, 'lnek-lane-pottawatomie', 'LNEK-Lane-Pottawatomie', ST_GeomFromText('POINT(-95.0839 38.4439)',4326), null, null, '2021-03-12T16:16:13.981407Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06914500'),
+('fab9363e-0a03-43f4-b23a-58a723fdfeba', False, 'cand', 'CAND', ST_GeomFromText('POINT(-99.2572 47.4575)',4326), null, null, '2021-03-12T16:16:13.981914Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('af80c8dc-af9d-44b9-89b0-196468672026', False, 'tvcm-st-elizabeth-tavern', 'TVCM-St_Elizabeth-Tavern', ST_GeomFromText('POINT(-92.2333 38.2778)',4326), null, null, '2021-03-12T16:16:13.982268Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06926290'),
+('d632c6cc-40f7-46f2-8a75-463cacd68f5d', False, 'urhm-urich-south-grand', 'URHM-Urich-South_Grand', ST_GeomFromText('POINT(-94.0042 38.4522)',4326), null, null, '2021-03-12T16:16:13.982723Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06921600'),
+('ea56e292-d11f-484b-8b22-652422b71c50', False, 'tibr2-tiber-dam-marias', 'TIBR2-Tiber_Dam-Marias', ST_GeomFromText('POINT(-111.1022 48.3196)',4326), null, null, '2021-03-12T16:16:13.983062Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('1949fb6b-208c-437c-a5e3-dd60292f22da', False, 'husd-huron-james', 'HUSD-Huron-James', ST_GeomFromText('POINT(-98.1989 44.3636)',4326), null, null, '2021-03-12T16:16:13.983453Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06476000'),
+('970ed323-3238-4bbc-9f6c-ec7570d12bf8', False, 'camt-cascade-missouri', 'CAMT-Cascade-Missouri', ST_GeomFromText('POINT(-111.6952 47.2695)',4326), null, null, '2021-03-12T16:16:13.983924Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06074000'),
+('85925895-b419-44e1-b043-8a226d59579b', False, 'btne-bennington-thomas-cr', 'BTNE-Bennington-Thomas_Cr', ST_GeomFromText('POINT(-96.1009 41.3648)',4326), null, null, '2021-03-12T16:16:13.984347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610742'),
+('2f7b6180-6251-446c-a63a-8bbceb1a99de', False, 'bgsd-buffalo-gap-beaver-creek', 'BGSD-Buffalo_Gap-Beaver_Creek', ST_GeomFromText('POINT(-103.3056 43.4667)',4326), null, null, '2021-03-12T16:16:13.984757Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06402500'),
+('58ce7bf8-2a56-4150-b0b5-f3ffeeb054df', False, 'jefm-jefferson-city-missouri', 'JEFM-Jefferson_City-Missouri', ST_GeomFromText('POINT(-92.1786 38.5871)',4326), null, null, '2021-03-12T16:16:13.985202Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06910450'),
+('a2df19c9-23dc-4ff5-9c3e-7b2e278fa065', False, 'ptem-platte-city-platte', 'PTEM-Platte City-Platte', ST_GeomFromText('POINT(-94.7919 39.3744)',4326), null, null, '2021-03-12T16:16:13.986009Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('582eb4b2-dde2-46bb-8d31-e10aff94a6ea', False, 'bgcm8-antelope-big-muddy', 'BGCM8-Antelope-Big_Muddy', ST_GeomFromText('POINT(-104.5117 48.6728)',4326), null, null, '2021-03-12T16:16:13.986440Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06183450'),
+('545f78ee-7b7e-4d26-8962-ca7337d496b9', False, 'oahe', 'OAHE', ST_GeomFromText('POINT(-100.4053 44.45)',4326), null, null, '2021-03-12T16:16:13.986714Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('5dc056b8-0a42-43e0-b2ac-5f2c2b303b8b', False, 'bend', 'BEND', ST_GeomFromText('POINT(-99.4453 44.041)',4326), null, null, '2021-03-12T16:16:13.987705Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('c33aab24-898e-4543-ac0b-8d1567ef9c2c', False, 'ssmo-sharps-station-platte', 'SSMO-Sharps_Station-Platte', ST_GeomFromText('POINT(-94.7268 39.401)',4326), null, null, '2021-03-12T16:16:13.988624Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06821190'),
+('ea3b2608-78cb-4de9-a3dd-f4254a8379a0', False, 'link-lincoln-saline', 'LINK-Lincoln-Saline', ST_GeomFromText('POINT(-98.1508 39.0286)',4326), null, null, '2021-03-12T16:16:13.988826Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06868850'),
+('f1f04ebe-31bf-4849-a805-c984b22d85c5', False, 'mssm-musselfork-mussel-fork', 'MSSM-Musselfork-Mussel_Fork', ST_GeomFromText('POINT(-92.9497 39.5236)',4326), null, null, '2021-03-12T16:16:13.989078Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06906000'),
+('9d337689-810d-47fd-bb28-7023dfda8bec', False, 'sfwy-valley-shoshone', 'SFWY-Valley-Shoshone', ST_GeomFromText('POINT(-109.555 44.2075)',4326), null, null, '2021-03-12T16:16:13.989276Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06280300'),
+('f22f4d8e-2857-4e81-817a-5463795d6ea6', False, 'nrne-niobrara-bazile-creek', 'NRNE-Niobrara-Bazile_Creek', ST_GeomFromText('POINT(-97.9225 42.7322)',4326), null, null, '2021-03-12T16:16:13.989712Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06466500'),
+('5bc5e78c-4fda-4a7c-a00c-d6f411e5c97a', False, 'bdmc-bridgeport-mc-north-platte', 'BDMC-Bridgeport-MC-North_Platte', ST_GeomFromText('POINT(-103.0969 41.6767)',4326), null, null, '2021-03-12T16:16:13.990060Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('32fd16e4-be27-429e-ac06-67a6aab44b01', False, 'sjne-st-james-missouri', 'SJNE-St_James-Missouri', ST_GeomFromText('POINT(-97.0819 42.7611)',4326), null, null, '2021-03-12T16:16:13.990395Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06478523'),
+('7f2ae867-0697-47fe-8e82-90cbd7f54729', False, 'cosd-columbia-james', 'COSD-Columbia-James', ST_GeomFromText('POINT(-98.31 45.6036)',4326), null, null, '2021-03-12T16:16:13.990795Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06471000'),
+('b5e73296-4a36-420e-9ed3-c27e409bae4b', False, 'lmcn8-williston-little-muddy', 'LMCN8-Williston-Little_Muddy', ST_GeomFromText('POINT(-103.5725 48.2844)',4326), null, null, '2021-03-12T16:16:13.991189Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06331000'),
+('05cff4a3-827e-4eb6-94c4-2fb8aa4830f4', False, 'bsne-omaha-blondo-st-cole-cr', 'BSNE-Omaha-Blondo_St-Cole_Cr', ST_GeomFromText('POINT(-96.0349 41.2777)',4326), null, null, '2021-03-12T16:16:13.991707Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610760'),
+('6fc0ed66-2b4f-4250-923c-0bc435bb855f', False, 'lwkk-bl-lawrence-kansas', 'LWKK-bl-Lawrence-Kansas', ST_GeomFromText('POINT(-95.2321 38.9733)',4326), null, null, '2021-03-12T16:16:13.992119Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06891080'),
+('59dca88e-00f6-4a3b-ace8-1ef60bb6723b', False, 'rcwr-ab-wrf-rapid-creek', 'RCWR-ab_WRF-Rapid_Creek', ST_GeomFromText('POINT(-103.1011 44.0267)',4326), null, null, '2021-03-12T16:16:13.992493Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06418800'),
+('19cc1527-ba9e-4373-8915-f423dc9f4e7f', False, 'brne-blair-big-papillion', 'BRNE-Blair-Big_Papillion', ST_GeomFromText('POINT(-96.2101 41.5091)',4326), null, null, '2021-03-12T16:16:13.992850Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610705'),
+('3826b569-3b1f-4026-957e-57ce402b558f', False, 'ramt', 'RAMT', ST_GeomFromText('POINT(-112.1428 45.1922)',4326), null, null, '2021-03-12T16:16:13.993185Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06019500'),
+('54458504-3594-4948-b177-ec0b74e8aa60', False, 'vgne-verdigre-verdigre-creek', 'VGNE-Verdigre-Verdigre_Creek', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:13.993348Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('f02ff807-95dd-45b6-bfe3-67a003efc777', False, 'laud-lake-audubon-snake', 'LAUD-Lake_Audubon-Snake', ST_GeomFromText('POINT(-101.2661 47.6119)',4326), null, null, '2021-03-12T16:16:13.993611Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('252d2fa2-ec70-492b-b53c-116c3ab96cd6', False, 'gtne-gretna-south-papillion', 'GTNE-Gretna-South_Papillion', ST_GeomFromText('POINT(-96.2161 41.1727)',4326), null, null, '2021-03-12T16:16:13.993824Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610786'),
+('0a1e74b8-02be-44a4-83b0-81431648f1b0', False, 'rrbm8', 'RRBM8', ST_GeomFromText('POINT(-112.1112 45.2419)',4326), null, null, '2021-03-12T16:16:13.994226Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06020600'),
+('3bf640c1-45c9-4347-b928-14a6c079578d', False, 'tuco', 'TUCO', ST_GeomFromText('POINT(-105.1594 39.6407)',4326), null, null, '2021-03-12T16:16:13.994438Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06711040'),
+('ece6ba7c-2ff9-4f9f-9d33-14d11c5644a3', False, 'acnd', 'ACND', ST_GeomFromText('POINT(-101.6454 46.5453)',4326), null, null, '2021-03-12T16:16:13.994755Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06347000'),
+('f4cad785-c5f4-497c-b427-7a23f410237e', False, 'mcnd', 'MCND', ST_GeomFromText('POINT(-101.4674 46.6944)',4326), null, null, '2021-03-12T16:16:13.995054Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06347500'),
+('89b6656b-d04b-462e-8da1-4cf17b6d1089', False, 'sjnd', 'SJND', ST_GeomFromText('POINT(-101.2528 46.8511)',4326), null, null, '2021-03-12T16:16:13.995273Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06348500'),
+('3c9e8b39-150c-457f-90dd-edfbda909dac', False, 'rwsd', 'RWSD', ST_GeomFromText('POINT(-103.8394 44.6672)',4326), null, null, '2021-03-12T16:16:13.995537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06433000'),
+('6fb91d8f-963a-4114-a225-af5892e08a02', False, 'oma-omaha-missouri', 'OMA-Omaha-Missouri', ST_GeomFromText('POINT(-95.9222 41.2589)',4326), null, null, '2021-03-12T16:16:13.995918Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610000'),
+('35fc2043-ebf8-4e22-82ea-cfe643135087', False, 'arwy-anchor-reservoir-sf-owl', 'ARWY-Anchor_Reservoir-SF_Owl', ST_GeomFromText('POINT(-108.8242 43.6639)',4326), null, null, '2021-03-12T16:16:13.996483Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('1e114668-6e25-485c-af05-dd3404302c21', False, 'hvmt-helena-valley-reservoir-tenmile', 'HVMT-Helena_Valley_Reservoir-Tenmile', ST_GeomFromText('POINT(-111.8822 46.6381)',4326), null, null, '2021-03-12T16:16:13.996698Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('078291a7-60c7-4287-ab3f-718b44ea9855', False, 'prmt-pishkun-reservoir-pishkun', 'PRMT-Pishkun_Reservoir-Pishkun', ST_GeomFromText('POINT(-112.4967 47.6767)',4326), null, null, '2021-03-12T16:16:13.996923Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('56afa295-949f-4e70-904b-dad374d497b4', False, 'scne-lincoln-stevens-cr', 'SCNE-Lincoln-Stevens_Cr', ST_GeomFromText('POINT(-96.595 40.8569)',4326), null, null, '2021-03-12T16:16:13.997384Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803520'),
+('b2e27e47-124d-4236-a54b-f43d8d959f46', False, 'rcwy-abv-red-cr-wind', 'RCWY-Abv_Red_Cr-Wind', ST_GeomFromText('POINT(-109.458 43.4417)',4326), null, null, '2021-03-12T16:16:13.997704Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06220800'),
+('c917e4a5-dd53-444b-828a-3a1c9ff16dff', False, 'hbmt-hardin-bighorn', 'HBMT-Hardin-Bighorn', ST_GeomFromText('POINT(-107.6575 45.6442)',4326), null, null, '2021-03-12T16:16:13.998059Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06288400'),
+('bb63a385-2f0e-4bc7-8b99-497af8bcba31', False, 'mine-mitchell-north-platte', 'MINE-Mitchell-North_Platte', ST_GeomFromText('POINT(-103.8136 41.9267)',4326), null, null, '2021-03-12T16:16:13.998310Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06679500'),
+('41f6602f-28f7-4385-8a10-b352c8449851', False, 'bdbc-bridgeport-bc-north-platte', 'BDBC-Bridgeport-BC-North_Platte', ST_GeomFromText('POINT(-103.0989 41.6822)',4326), null, null, '2021-03-12T16:16:13.998618Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('2abb4d27-0786-41a7-97cf-fde10c7ffe28', False, 'lrwy-laramie-laramie', 'LRWY-Laramie-Laramie', ST_GeomFromText('POINT(-105.6074 41.3278)',4326), null, null, '2021-03-12T16:16:13.998954Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06660000'),
+('3a32e8ac-1fec-4f6d-9ed4-b3cb6f658e97', False, 'mtmc-minatare-mc-north-platte', 'MTMC-Minatare-MC-North_Platte', ST_GeomFromText('POINT(-103.5189 41.7889)',4326), null, null, '2021-03-12T16:16:13.999297Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('dd5666a0-8235-482e-8fc5-ff1979d04697', False, 'mtnm-minatare-nc-north-platte', 'MTNM-Minatare-NC-North_Platte', ST_GeomFromText('POINT(-103.5192 41.7922)',4326), null, null, '2021-03-12T16:16:13.999564Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('4d832078-386e-4be3-a291-0f9de1c97bea', False, 'mdne-millard-west-papillion', 'MDNE-Millard-West_Papillion', ST_GeomFromText('POINT(-96.128 41.2071)',4326), null, null, '2021-03-12T16:16:13.999848Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610785'),
+('59c5f6d0-7a7c-44a2-8569-e3fef44f245e', False, 'hzlm-hazelgreen-gasconade', 'HZLM-Hazelgreen-Gasconade', ST_GeomFromText('POINT(-92.4517 37.7592)',4326), null, null, '2021-03-12T16:16:14.000339Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06928000'),
+('e340d571-0b0d-4649-b5b6-6ae971d93d42', False, 'hpco-homestake-pipeline-south-platte', 'HPCO-Homestake_Pipeline-South_Platte', ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:16:14.000759Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('3ab894f9-dc13-498a-b044-a93b9bc0ad64', False, 'rcne-ceresco-rock-cr', 'RCNE-Ceresco-Rock_Cr', ST_GeomFromText('POINT(-96.5442 41.0158)',4326), null, null, '2021-03-12T16:16:14.001197Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803530'),
+('fe8dfd31-bbf6-4bcd-9540-d10be11407fb', False, 'acne-lincoln-27th-st-antelope-cr', 'ACNE-Lincoln-27th_St-Antelope_Cr', ST_GeomFromText('POINT(-96.6851 40.8049)',4326), null, null, '2021-03-12T16:16:14.001423Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06803300'),
+('00b81e91-1a1d-4207-be84-6c2c01563efe', False, 'gavinsdcp', 'GavinsDCP', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:14.001619Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('46df7dfd-4a82-4069-928d-d42761f6d4ae', False, 'npne-north-platte-north-platte', 'NPNE-North_Platte-North_Platte', ST_GeomFromText('POINT(-100.7591 41.1539)',4326), null, null, '2021-03-12T16:16:14.003107Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('060208b2-d8a3-4d43-a0f0-ec16da0cddf5', False, 'fcne-omaha-fort-crook-papillion', 'FCNE-Omaha-Fort_Crook-Papillion', ST_GeomFromText('POINT(-95.9377 41.1178)',4326), null, null, '2021-03-12T16:16:14.003383Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610795'),
+('566ecbe8-8e84-4cf8-8847-4ef78cb023cd', False, 'ptne-plattsmouth-missouri', 'PTNE-Plattsmouth-Missouri', ST_GeomFromText('POINT(-95.8664 41.0011)',4326), null, null, '2021-03-12T16:16:14.003872Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06805600'),
+('d0fcb5cd-c6a6-4c8e-b63b-f22df2c44aac', False, 'sttm-st-thomas-osage', 'STTM-St_Thomas-Osage', ST_GeomFromText('POINT(-92.2083 38.4214)',4326), null, null, '2021-03-12T16:16:14.004347Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06926510'),
+('d7573d91-0d4e-4a97-9ae4-8ab33af806f5', False, 'gram-graham-nodaway', 'GRAM-Graham-Nodaway', ST_GeomFromText('POINT(-95.0696 40.2025)',4326), null, null, '2021-03-12T16:16:14.004803Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06817700'),
+('cd37544f-1e8b-43aa-ae86-c261502a4328', False, 'bhmt-bighorn-bighorn', 'BHMT-Bighorn-Bighorn', ST_GeomFromText('POINT(-107.4688 46.1245)',4326), null, null, '2021-03-12T16:16:14.005270Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06294500'),
+('348136ef-9683-4726-b218-ed3ed91ced84', False, 'bltk-beloit-solomon', 'BLTK-Beloit-Solomon', ST_GeomFromText('POINT(-98.1094 39.4544)',4326), null, null, '2021-03-12T16:16:14.005829Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06876000'),
+('ef1dd9ec-f644-4363-a046-12bc7d4410fc', False, 'wrdy-dubois-wind', 'WRDY-Dubois-Wind', ST_GeomFromText('POINT(-109.7592 43.5786)',4326), null, null, '2021-03-12T16:16:14.006266Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06218500'),
+('2b220e2f-85a8-4a5e-b12a-99c4a96b81eb', False, 'sond-solen-cannonball', 'SOND-Solen-Cannonball', ST_GeomFromText('POINT(-100.6667 46.3333)',4326), null, null, '2021-03-12T16:16:14.006751Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('334c4b1d-d168-4fa2-b5c0-65cbe3ad7269', False, 'bcmt', 'BCMT', ST_GeomFromText('POINT(-113.4494 45.4229)',4326), null, null, '2021-03-12T16:16:14.006991Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06024020'),
+('4cfef721-71a6-4cfc-b0e1-3593e034ca7a', False, 'trmt', 'TRMT', ST_GeomFromText('POINT(-112.2566 46.5239)',4326), null, null, '2021-03-12T16:16:14.007209Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06062500'),
+('cd86a8d9-d92e-4713-b964-151d4e570f3f', False, 'clak-clay-center-republican', 'CLAK-Clay_Center-Republican', ST_GeomFromText('POINT(-97.1272 39.3556)',4326), null, null, '2021-03-12T16:16:14.007471Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06856600'),
+('352d122e-8356-4ef9-a93e-95967856f040', False, 'sc08-salt-cr-dam-8-wagon-train-lake', 'SC08-Salt_Cr_Dam_8-Wagon_Train_Lake', ST_GeomFromText('POINT(-96.583 40.6203)',4326), null, null, '2021-03-12T16:16:14.007828Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('a72410a9-52ed-41ca-9ff2-dc80e6fdab21', False, 'levk-leavenworth-missouri', 'LEVK-Leavenworth-Missouri', ST_GeomFromText('POINT(-94.9094 39.3269)',4326), null, null, '2021-03-12T16:16:14.008343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06820475'),
+('77cf06c5-78a4-4a81-b5a0-5a1065c40820', False, 'blne-blair-missouri', 'BLNE-Blair-Missouri', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:14.008804Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('b510e75b-471d-496d-b57f-a292bac9924c', False, 'lwkk-lawrence-kansas', 'LWKK-Lawrence-Kansas', ST_GeomFromText('POINT(-95.2321 38.9733)',4326), null, null, '2021-03-12T16:16:14.009284Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06891080'),
+('8dca412f-b025-4830-a8be-9b5b5d3da7f4', False, 'bwwy-worland-bighorn', 'BWWY-Worland-Bighorn', ST_GeomFromText('POINT(-107.9695 44.0147)',4326), null, null, '2021-03-12T16:16:14.009696Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06268600'),
+('58d6f25b-0c1c-49b0-8ce0-64233d17a7e8', False, 'whwy-diversionfortlaramie', 'WHWY-DiversionFortLaramie', ST_GeomFromText('POINT(-104.628 42.241)',4326), null, null, '2021-03-12T16:16:14.010071Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('10bc773b-1299-42b5-bb58-e816cb00fc35', False, 'whwy-diversioninterstate', 'WHWY-DiversionInterstate', ST_GeomFromText('POINT(-104.628 42.241)',4326), null, null, '2021-03-12T16:16:14.010412Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('44306453-3078-43f9-81bf-9d8af58423e4', False, 'spin', 'SPIN', ST_GeomFromText('POINT(0 0)',4326), null, null, '2021-03-12T16:16:14.010723Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('a6cce52b-daf8-4da6-86e9-29a011496537', False, 'hcne-lyman-horse-creek', 'HCNE-Lyman-Horse_Creek', ST_GeomFromText('POINT(0.0 0.0)',4326), null, null, '2021-03-12T16:16:14.011004Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, null),
+('f2ea9fcf-e58b-4988-bb3e-3f3cbaab88c1', False, 'bpne-bennington-big-papillion', 'BPNE-Bennington-Big_Papillion', ST_GeomFromText('POINT(-96.1829 41.3788)',4326), null, null, '2021-03-12T16:16:14.011204Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610720'),
+('e6774d47-3028-43b5-8bc5-b19daa5cb5af', False, 'elne-elkhorn-west-papillion', 'ELNE-Elkhorn-West_Papillion', ST_GeomFromText('POINT(-96.2525 41.2929)',4326), null, null, '2021-03-12T16:16:14.011537Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06610773'),
+('fc686b25-8626-4ef1-92a5-b32619be7b6c', False, 'tbvm', 'TBVM', ST_GeomFromText('POINT(-93.9947 38.0039)',4326), null, null, '2021-03-12T16:16:14.011944Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06918250'),
+('8d38c7bb-79ce-4352-aa83-c01d705d2ea9', False, 'rsne', 'RSNE', ST_GeomFromText('POINT(-101.5767 41.1261)',4326), null, null, '2021-03-12T16:16:14.012262Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06764880'),
+('fff6b3d6-749b-46fd-8452-8af42f0a3511', False, 'bigm', 'BIGM', ST_GeomFromText('POINT(-92.058 37.7602)',4326), null, null, '2021-03-12T16:16:14.012654Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06930060'),
+('284b8d03-1d86-40bd-9e2f-2b3f7d6c9657', False, 'nhnd', 'NHND', ST_GeomFromText('POINT(-103.0532 47.0278)',4326), null, null, '2021-03-12T16:16:14.012967Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '5ce8a0b5-876a-49bc-9e06-05f49724b794', '00000000-0000-0000-0000-000000000000', null, '06344600');
 
 --INSERT INSTRUMENT STATUS--
 INSERT INTO instrument_status(id, instrument_id, status_id, "time")
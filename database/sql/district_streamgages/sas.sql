@@ -74,57 +74,57 @@ where i.project_id = '6b60b4e6-ff3a-4b8a-8426-93347824588f';
 --########################################
 
 --INSERT INSTRUMENTS--COUNT:49
-INSERT INTO instrument(id, deleted, slug, name, formula, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
+INSERT INTO instrument(id, deleted, slug, name, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
  VALUES 
-('f15bbc65-b81b-4f2d-b953-8f407770488a', False, 'chattooga-river-at-burrells-ford-nr-pine-mtn-ga', 'CHATTOOGA RIVER AT BURRELLS FORD, NR PINE MTN, GA', null, ST_GeomFromText('POINT(-83.1194 34.9689)',4326), null, null, '2021-03-12T16:23:55.856839Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02176930'),
-('ac22b3f0-391d-456b-9121-0915e9128395', False, 'chattooga-river-near-clayton-ga', 'CHATTOOGA RIVER NEAR CLAYTON, GA', null, ST_GeomFromText('POINT(-83.306 34.814)',4326), null, null, '2021-03-12T16:23:56.190547Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02177000'),
-('5e07bfde-37a7-4647-add3-4967b97be84d', False, 'tallulah-river-near-clayton-ga', 'TALLULAH RIVER NEAR CLAYTON, GA', null, ST_GeomFromText('POINT(-83.5304 34.8904)',4326), null, null, '2021-03-12T16:23:56.512895Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02178400'),
-('1f730421-b1c6-496a-be70-0eac7bbd0569', False, 'tallulah-river-ab-powerhouse-nr-tallulah-falls-ga', 'TALLULAH RIVER AB POWERHOUSE, NR TALLULAH FALLS,GA', null, ST_GeomFromText('POINT(-83.3757 34.732)',4326), null, null, '2021-03-12T16:23:57.075205Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02181580'),
-('4ff4097b-41e4-41e7-b162-7e6f450d04e7', False, '02182000', '02182000', null, ST_GeomFromText('POINT(-83.3452 34.6779)',4326), null, null, '2021-03-12T16:23:57.490155Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02182000'),
-('eaacc1af-594d-4402-9a9c-14e02091463c', False, 'beaverdam-creek-above-elberton-ga', 'BEAVERDAM CREEK ABOVE ELBERTON, GA', null, ST_GeomFromText('POINT(-82.8965 34.1687)',4326), null, null, '2021-03-12T16:23:57.833207Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02188600'),
-('6c8bee7f-bdfd-4ed3-82f0-f6f228efc516', False, 'broad-river-above-carlton-ga', 'BROAD RIVER ABOVE CARLTON, GA', null, ST_GeomFromText('POINT(-83.0033 34.0733)',4326), null, null, '2021-03-12T16:23:58.181701Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02191300'),
-('9bb46afe-2234-4595-85df-3027ab01e462', False, 'broad-river-near-bell-ga', 'BROAD RIVER NEAR BELL, GA', null, ST_GeomFromText('POINT(-82.77 33.9742)',4326), null, null, '2021-03-12T16:23:58.547415Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02192000'),
-('3c237d5a-d700-4092-9f01-cbd6314979a4', False, 'kettle-creek-near-washington-ga', 'KETTLE CREEK NEAR WASHINGTON, GA', null, ST_GeomFromText('POINT(-82.8579 33.6826)',4326), null, null, '2021-03-12T16:23:58.994145Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02193340'),
-('50a3d15a-9408-465b-a143-4655e5ed345d', False, 'little-river-near-washington-ga', 'LITTLE RIVER NEAR WASHINGTON, GA', null, ST_GeomFromText('POINT(-82.7425 33.6128)',4326), null, null, '2021-03-12T16:23:59.327598Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02193500'),
-('87a81cbd-8cc8-4f29-aab3-d1ebedb78b24', False, 'kiokee-creek-at-ga-104-near-evans-ga', 'KIOKEE CREEK AT GA 104, NEAR EVANS, GA', null, ST_GeomFromText('POINT(-82.2326 33.601)',4326), null, null, '2021-03-12T16:23:59.803822Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02195320'),
-('9634693a-1d5f-4e95-8e78-c2ae40ac5a0c', False, 'butler-creek-below-7th-avenue-at-ft-gordon-ga', 'BUTLER CREEK BELOW 7TH AVENUE, AT FT. GORDON, GA', null, ST_GeomFromText('POINT(-82.1161 33.4386)',4326), null, null, '2021-03-12T16:24:00.205167Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02196835'),
-('952595d3-c5e9-40f4-a22c-ac4c0e8dee33', False, 'butler-creek-reservoir-at-fort-gordon-ga', 'BUTLER CREEK RESERVOIR AT FORT GORDON, GA', null, ST_GeomFromText('POINT(-82.0992 33.4258)',4326), null, null, '2021-03-12T16:24:01.500462Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02196838'),
-('005234ba-1cb4-4b79-ad5b-2b9982b7544b', False, 'spirit-creek-at-us-1-near-augusta-ga', 'SPIRIT CREEK AT US 1, NEAR AUGUSTA, GA', null, ST_GeomFromText('POINT(-82.139 33.3735)',4326), null, null, '2021-03-12T16:24:04.076279Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02197020'),
-('e08da097-1833-4ec5-b271-0c6ba47dcc4a', False, 'savannah-river-near-waynesboro-ga', 'SAVANNAH RIVER NEAR WAYNESBORO, GA', null, ST_GeomFromText('POINT(-81.7548 33.1499)',4326), null, null, '2021-03-12T16:24:06.284499Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '021973269'),
-('cefbe623-b8de-4e6f-87e2-992b0dbf4fe9', False, 'brushy-creek-at-campground-road-near-wrens-ga', 'BRUSHY CREEK AT CAMPGROUND ROAD, NEAR WRENS, GA', null, ST_GeomFromText('POINT(-82.3343 33.1807)',4326), null, null, '2021-03-12T16:24:07.338996Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02197598'),
-('1afe57a9-fc1a-45bb-bfa8-e0a578a1ee64', False, 'brier-creek-near-waynesboro-ga', 'BRIER CREEK NEAR WAYNESBORO, GA', null, ST_GeomFromText('POINT(-81.9637 33.1182)',4326), null, null, '2021-03-12T16:24:07.721211Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02197830'),
-('97a23ce8-23bb-45bc-9ab3-16d57dce197c', False, 'brier-creek-at-millhaven-ga', 'BRIER CREEK AT MILLHAVEN, GA', null, ST_GeomFromText('POINT(-81.6512 32.9335)',4326), null, null, '2021-03-12T16:24:08.114775Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198000'),
-('84cfa98f-6a00-4a3d-97b4-442b9212b6a7', False, 'beaverdam-creek-near-sardis-ga', 'BEAVERDAM CREEK NEAR SARDIS, GA', null, ST_GeomFromText('POINT(-81.8154 32.9377)',4326), null, null, '2021-03-12T16:24:08.444838Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198100'),
-('971212d2-be5e-4b80-b2d7-0d19acc01cfa', False, 'ebenezer-creek-at-springfield-ga', 'EBENEZER CREEK AT SPRINGFIELD, GA', null, ST_GeomFromText('POINT(-81.2973 32.3657)',4326), null, null, '2021-03-12T16:24:08.790522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198690'),
-('3d69499f-9a7e-4efe-81b5-fe0f84df3d61', False, 'withlacoochee-river-at-us-41-near-valdosta-ga', 'WITHLACOOCHEE RIVER AT US 41 NEAR VALDOSTA GA', null, ST_GeomFromText('POINT(-81.1615 32.3532)',4326), null, null, '2021-03-12T16:24:09.105054Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198745'),
-('6a157044-3688-45cb-98cf-c4515c024b4f', False, 'yellow-river-at-milstead-ga', 'YELLOW RIVER AT MILSTEAD GA', null, ST_GeomFromText('POINT(-81.1464 32.3003)',4326), null, null, '2021-03-12T16:24:09.453583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198768'),
-('a350805c-0d10-4fa0-9351-43424b2beca2', False, 'abercorn-creek-near-savannah-ga', 'ABERCORN CREEK NEAR SAVANNAH,GA', null, ST_GeomFromText('POINT(-81.1782 32.2558)',4326), null, null, '2021-03-12T16:24:09.837530Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198810'),
-('31509bea-a87b-4d92-af78-aa825ff3ae2a', False, 'savannah-river-i-95-near-port-wentworth-ga', 'SAVANNAH RIVER (I-95) NEAR PORT WENTWORTH, GA', null, ST_GeomFromText('POINT(-81.1512 32.2358)',4326), null, null, '2021-03-12T16:24:10.175450Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198840'),
-('4efebec3-04ac-4f9f-b49a-451770117fac', False, 'savannah-river-at-ga-25-at-port-wentworth-ga', 'SAVANNAH RIVER AT GA 25, AT PORT WENTWORTH, GA', null, ST_GeomFromText('POINT(-81.1537 32.166)',4326), null, null, '2021-03-12T16:24:10.598263Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198920'),
-('6453d62e-e970-4592-a696-74f73bc09901', False, 'middle-river-at-ga-25-at-port-wentworth-ga', 'MIDDLE RIVER AT GA 25 AT PORT WENTWORTH, GA', null, ST_GeomFromText('POINT(-81.1383 32.1656)',4326), null, null, '2021-03-12T16:24:10.943146Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198950'),
-('6492879d-184d-40e0-902f-65a26f121a83', False, 'savannah-river-at-usace-dock-at-savannah-ga', 'SAVANNAH RIVER AT USACE DOCK, AT SAVANNAH, GA', null, ST_GeomFromText('POINT(-81.0812 32.081)',4326), null, null, '2021-03-12T16:24:11.293437Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '021989773'),
-('413b15a2-4666-4c43-a911-7e02548a3841', False, 'l-back-river-above-lucknow-canal-nr-limehouse-sc', 'L BACK RIVER ABOVE LUCKNOW CANAL, NR LIMEHOUSE, SC', null, ST_GeomFromText('POINT(-81.1179 32.1858)',4326), null, null, '2021-03-12T16:24:11.632270Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '021989784'),
-('dead0d24-43b5-4383-9538-aa0769de9206', False, 'little-back-river-at-f&w-dock-near-limehouse-sc', 'LITTLE BACK RIVER AT F&W DOCK, NEAR LIMEHOUSE, SC', null, ST_GeomFromText('POINT(-81.1182 32.1708)',4326), null, null, '2021-03-12T16:24:11.969745Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '021989791'),
-('cda5713a-6f72-4d10-9831-2ae368a7a909', False, 'little-back-river-at-ga-25-at-port-wentworth-ga', 'LITTLE BACK RIVER AT GA 25 AT PORT WENTWORTH, GA', null, ST_GeomFromText('POINT(-81.13 32.1658)',4326), null, null, '2021-03-12T16:24:12.496629Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '021989792'),
-('093180ba-2e1d-4bbb-9dd4-feab113ce564', False, 'savannah-river-at-fort-pulaski-ga', 'SAVANNAH RIVER AT FORT PULASKI, GA', null, ST_GeomFromText('POINT(-80.9032 32.0341)',4326), null, null, '2021-03-12T16:24:12.829141Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198980'),
-('924a4b3d-aa54-452d-955d-d5763b916c03', False, 'south-channel-savannah-river-near-savannah-ga', 'SOUTH CHANNEL (SAVANNAH RIVER) NEAR SAVANNAH, GA', null, ST_GeomFromText('POINT(-81.0023 32.0827)',4326), null, null, '2021-03-12T16:24:13.427343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02199000'),
-('0f2a5b22-363e-472a-ab11-8aabd9779af2', False, 'twelvemile-creek-near-liberty-sc', 'TWELVEMILE CREEK NEAR LIBERTY, SC', null, ST_GeomFromText('POINT(-82.7485 34.8015)',4326), null, null, '2021-03-12T16:24:13.753090Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02186000'),
-('420d5ecc-20f5-46cb-bbfc-f9ec08874283', False, 'eighteenmile-creek-above-pendleton-sc', 'EIGHTEENMILE CREEK ABOVE PENDLETON, SC', null, ST_GeomFromText('POINT(-82.7988 34.659)',4326), null, null, '2021-03-12T16:24:14.074492Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02186699'),
-('8ae11ab9-2efa-410f-ac1c-ce1eb4b9e33f', False, 'hartwell-lake-near-anderson-sc', 'HARTWELL LAKE NEAR ANDERSON, SC', null, ST_GeomFromText('POINT(-82.8161 34.475)',4326), null, null, '2021-03-12T16:24:14.423103Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02187010'),
-('fd46c733-b662-4195-b96d-0369df362a11', False, 'rocky-river-nr-starr-sc', 'ROCKY RIVER NR STARR, SC', null, ST_GeomFromText('POINT(-82.5774 34.3832)',4326), null, null, '2021-03-12T16:24:14.751185Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02187910'),
-('8f7dc916-885e-43dd-850a-509741483b80', False, 'russell-lake-above-calhoun-falls-sc', 'RUSSELL LAKE ABOVE CALHOUN FALLS, SC', null, ST_GeomFromText('POINT(-82.6181 34.1011)',4326), null, null, '2021-03-12T16:24:15.098149Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02188100'),
-('f956ed57-4c11-45aa-ba97-137fc08f26f2', False, 'little-river-near-mt-carmel-sc', 'LITTLE RIVER NEAR MT. CARMEL, SC', null, ST_GeomFromText('POINT(-82.5007 34.0715)',4326), null, null, '2021-03-12T16:24:15.436417Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02192500'),
-('7a1d3531-440f-4a13-bc32-f43101ebac4d', False, 'thurmond-lake-near-plum-branch-sc', 'THURMOND LAKE NEAR PLUM BRANCH, SC', null, ST_GeomFromText('POINT(-82.3506 33.8403)',4326), null, null, '2021-03-12T16:24:15.763304Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02193900'),
-('0247a7d6-fb7f-4968-b6dc-c2e8f277dab6', False, 'savannah-river-near-evans-ga', 'SAVANNAH RIVER NEAR EVANS, GA', null, ST_GeomFromText('POINT(-82.1232 33.5929)',4326), null, null, '2021-03-12T16:24:16.099103Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02195520'),
-('195be647-588b-4973-bf02-8717a31d02e1', False, 'stevens-creek-near-modoc-sc', 'STEVENS CREEK NEAR MODOC, SC', null, ST_GeomFromText('POINT(-82.1818 33.7293)',4326), null, null, '2021-03-12T16:24:16.445858Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02196000'),
-('c2c43566-82eb-4901-a1d3-81f2200e193e', False, 'savannah-rvr-at-stevens-creek-dam-nr-morgana-sc', 'SAVANNAH RVR AT STEVENS CREEK DAM NR MORGANA, SC', null, ST_GeomFromText('POINT(-82.051 33.5629)',4326), null, null, '2021-03-12T16:24:16.811461Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02196483'),
-('f8a51a89-74ce-4c03-bc93-594c97b7de00', False, 'augusta-canal-nr-augusta-ga-upper', 'AUGUSTA CANAL NR AUGUSTA, GA (UPPER)', null, ST_GeomFromText('POINT(-82.0379 33.5493)',4326), null, null, '2021-03-12T16:24:17.138369Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02196485'),
-('34b223e2-5394-466b-b7ac-9d84788a4db3', False, 'horse-creek-at-clearwater-sc', 'HORSE CREEK AT CLEARWATER, SC', null, ST_GeomFromText('POINT(-81.8971 33.4849)',4326), null, null, '2021-03-12T16:24:17.928663Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02196690'),
-('01de28df-bf75-428c-ae1d-f4feb975db63', False, '021970161', '021970161', null, ST_GeomFromText('POINT(-82.1572 33.39)',4326), null, null, '2021-03-12T16:24:18.253181Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '021970161'),
-('ace2e8fb-6357-4bc2-90be-b47e426caf1d', False, 'savannah-r-at-burtons-ferry-br-nr-millhaven-ga', 'SAVANNAH R AT BURTONS FERRY BR NR MILLHAVEN, GA', null, ST_GeomFromText('POINT(-81.5026 32.939)',4326), null, null, '2021-03-12T16:24:18.587471Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02197500'),
-('02675aaf-22fc-426a-bcab-228f285857c1', False, 'savannah-river-near-estill-sc', 'SAVANNAH RIVER NEAR ESTILL, SC', null, ST_GeomFromText('POINT(-81.4283 32.7033)',4326), null, null, '2021-03-12T16:24:18.908695Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198375'),
-('7c73c2ba-9e4f-4ac4-885b-d51aea328d5f', False, 'savannah-river-near-clyo-ga', 'SAVANNAH RIVER NEAR CLYO, GA', null, ST_GeomFromText('POINT(-81.2687 32.5282)',4326), null, null, '2021-03-12T16:24:19.236463Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198500'),
-('cd09e10e-ab4d-49ba-830d-31e34f8faaa3', False, 'savannah-river-above-hardeeville-sc', 'SAVANNAH RIVER ABOVE HARDEEVILLE, SC', null, ST_GeomFromText('POINT(-81.1284 32.3394)',4326), null, null, '2021-03-12T16:24:19.594214Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198760');
+('f15bbc65-b81b-4f2d-b953-8f407770488a', False, 'chattooga-river-at-burrells-ford-nr-pine-mtn-ga', 'CHATTOOGA RIVER AT BURRELLS FORD, NR PINE MTN, GA', ST_GeomFromText('POINT(-83.1194 34.9689)',4326), null, null, '2021-03-12T16:23:55.856839Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02176930'),
+('ac22b3f0-391d-456b-9121-0915e9128395', False, 'chattooga-river-near-clayton-ga', 'CHATTOOGA RIVER NEAR CLAYTON, GA', ST_GeomFromText('POINT(-83.306 34.814)',4326), null, null, '2021-03-12T16:23:56.190547Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02177000'),
+('5e07bfde-37a7-4647-add3-4967b97be84d', False, 'tallulah-river-near-clayton-ga', 'TALLULAH RIVER NEAR CLAYTON, GA', ST_GeomFromText('POINT(-83.5304 34.8904)',4326), null, null, '2021-03-12T16:23:56.512895Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02178400'),
+('1f730421-b1c6-496a-be70-0eac7bbd0569', False, 'tallulah-river-ab-powerhouse-nr-tallulah-falls-ga', 'TALLULAH RIVER AB POWERHOUSE, NR TALLULAH FALLS,GA', ST_GeomFromText('POINT(-83.3757 34.732)',4326), null, null, '2021-03-12T16:23:57.075205Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02181580'),
+('4ff4097b-41e4-41e7-b162-7e6f450d04e7', False, '02182000', '02182000', ST_GeomFromText('POINT(-83.3452 34.6779)',4326), null, null, '2021-03-12T16:23:57.490155Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02182000'),
+('eaacc1af-594d-4402-9a9c-14e02091463c', False, 'beaverdam-creek-above-elberton-ga', 'BEAVERDAM CREEK ABOVE ELBERTON, GA', ST_GeomFromText('POINT(-82.8965 34.1687)',4326), null, null, '2021-03-12T16:23:57.833207Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02188600'),
+('6c8bee7f-bdfd-4ed3-82f0-f6f228efc516', False, 'broad-river-above-carlton-ga', 'BROAD RIVER ABOVE CARLTON, GA', ST_GeomFromText('POINT(-83.0033 34.0733)',4326), null, null, '2021-03-12T16:23:58.181701Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02191300'),
+('9bb46afe-2234-4595-85df-3027ab01e462', False, 'broad-river-near-bell-ga', 'BROAD RIVER NEAR BELL, GA', ST_GeomFromText('POINT(-82.77 33.9742)',4326), null, null, '2021-03-12T16:23:58.547415Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02192000'),
+('3c237d5a-d700-4092-9f01-cbd6314979a4', False, 'kettle-creek-near-washington-ga', 'KETTLE CREEK NEAR WASHINGTON, GA', ST_GeomFromText('POINT(-82.8579 33.6826)',4326), null, null, '2021-03-12T16:23:58.994145Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02193340'),
+('50a3d15a-9408-465b-a143-4655e5ed345d', False, 'little-river-near-washington-ga', 'LITTLE RIVER NEAR WASHINGTON, GA', ST_GeomFromText('POINT(-82.7425 33.6128)',4326), null, null, '2021-03-12T16:23:59.327598Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02193500'),
+('87a81cbd-8cc8-4f29-aab3-d1ebedb78b24', False, 'kiokee-creek-at-ga-104-near-evans-ga', 'KIOKEE CREEK AT GA 104, NEAR EVANS, GA', ST_GeomFromText('POINT(-82.2326 33.601)',4326), null, null, '2021-03-12T16:23:59.803822Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02195320'),
+('9634693a-1d5f-4e95-8e78-c2ae40ac5a0c', False, 'butler-creek-below-7th-avenue-at-ft-gordon-ga', 'BUTLER CREEK BELOW 7TH AVENUE, AT FT. GORDON, GA', ST_GeomFromText('POINT(-82.1161 33.4386)',4326), null, null, '2021-03-12T16:24:00.205167Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02196835'),
+('952595d3-c5e9-40f4-a22c-ac4c0e8dee33', False, 'butler-creek-reservoir-at-fort-gordon-ga', 'BUTLER CREEK RESERVOIR AT FORT GORDON, GA', ST_GeomFromText('POINT(-82.0992 33.4258)',4326), null, null, '2021-03-12T16:24:01.500462Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02196838'),
+('005234ba-1cb4-4b79-ad5b-2b9982b7544b', False, 'spirit-creek-at-us-1-near-augusta-ga', 'SPIRIT CREEK AT US 1, NEAR AUGUSTA, GA', ST_GeomFromText('POINT(-82.139 33.3735)',4326), null, null, '2021-03-12T16:24:04.076279Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02197020'),
+('e08da097-1833-4ec5-b271-0c6ba47dcc4a', False, 'savannah-river-near-waynesboro-ga', 'SAVANNAH RIVER NEAR WAYNESBORO, GA', ST_GeomFromText('POINT(-81.7548 33.1499)',4326), null, null, '2021-03-12T16:24:06.284499Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '021973269'),
+('cefbe623-b8de-4e6f-87e2-992b0dbf4fe9', False, 'brushy-creek-at-campground-road-near-wrens-ga', 'BRUSHY CREEK AT CAMPGROUND ROAD, NEAR WRENS, GA', ST_GeomFromText('POINT(-82.3343 33.1807)',4326), null, null, '2021-03-12T16:24:07.338996Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02197598'),
+('1afe57a9-fc1a-45bb-bfa8-e0a578a1ee64', False, 'brier-creek-near-waynesboro-ga', 'BRIER CREEK NEAR WAYNESBORO, GA', ST_GeomFromText('POINT(-81.9637 33.1182)',4326), null, null, '2021-03-12T16:24:07.721211Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02197830'),
+('97a23ce8-23bb-45bc-9ab3-16d57dce197c', False, 'brier-creek-at-millhaven-ga', 'BRIER CREEK AT MILLHAVEN, GA', ST_GeomFromText('POINT(-81.6512 32.9335)',4326), null, null, '2021-03-12T16:24:08.114775Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198000'),
+('84cfa98f-6a00-4a3d-97b4-442b9212b6a7', False, 'beaverdam-creek-near-sardis-ga', 'BEAVERDAM CREEK NEAR SARDIS, GA', ST_GeomFromText('POINT(-81.8154 32.9377)',4326), null, null, '2021-03-12T16:24:08.444838Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198100'),
+('971212d2-be5e-4b80-b2d7-0d19acc01cfa', False, 'ebenezer-creek-at-springfield-ga', 'EBENEZER CREEK AT SPRINGFIELD, GA', ST_GeomFromText('POINT(-81.2973 32.3657)',4326), null, null, '2021-03-12T16:24:08.790522Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198690'),
+('3d69499f-9a7e-4efe-81b5-fe0f84df3d61', False, 'withlacoochee-river-at-us-41-near-valdosta-ga', 'WITHLACOOCHEE RIVER AT US 41 NEAR VALDOSTA GA', ST_GeomFromText('POINT(-81.1615 32.3532)',4326), null, null, '2021-03-12T16:24:09.105054Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198745'),
+('6a157044-3688-45cb-98cf-c4515c024b4f', False, 'yellow-river-at-milstead-ga', 'YELLOW RIVER AT MILSTEAD GA', ST_GeomFromText('POINT(-81.1464 32.3003)',4326), null, null, '2021-03-12T16:24:09.453583Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198768'),
+('a350805c-0d10-4fa0-9351-43424b2beca2', False, 'abercorn-creek-near-savannah-ga', 'ABERCORN CREEK NEAR SAVANNAH,GA', ST_GeomFromText('POINT(-81.1782 32.2558)',4326), null, null, '2021-03-12T16:24:09.837530Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198810'),
+('31509bea-a87b-4d92-af78-aa825ff3ae2a', False, 'savannah-river-i-95-near-port-wentworth-ga', 'SAVANNAH RIVER (I-95) NEAR PORT WENTWORTH, GA', ST_GeomFromText('POINT(-81.1512 32.2358)',4326), null, null, '2021-03-12T16:24:10.175450Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198840'),
+('4efebec3-04ac-4f9f-b49a-451770117fac', False, 'savannah-river-at-ga-25-at-port-wentworth-ga', 'SAVANNAH RIVER AT GA 25, AT PORT WENTWORTH, GA', ST_GeomFromText('POINT(-81.1537 32.166)',4326), null, null, '2021-03-12T16:24:10.598263Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198920'),
+('6453d62e-e970-4592-a696-74f73bc09901', False, 'middle-river-at-ga-25-at-port-wentworth-ga', 'MIDDLE RIVER AT GA 25 AT PORT WENTWORTH, GA', ST_GeomFromText('POINT(-81.1383 32.1656)',4326), null, null, '2021-03-12T16:24:10.943146Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198950'),
+('6492879d-184d-40e0-902f-65a26f121a83', False, 'savannah-river-at-usace-dock-at-savannah-ga', 'SAVANNAH RIVER AT USACE DOCK, AT SAVANNAH, GA', ST_GeomFromText('POINT(-81.0812 32.081)',4326), null, null, '2021-03-12T16:24:11.293437Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '021989773'),
+('413b15a2-4666-4c43-a911-7e02548a3841', False, 'l-back-river-above-lucknow-canal-nr-limehouse-sc', 'L BACK RIVER ABOVE LUCKNOW CANAL, NR LIMEHOUSE, SC', ST_GeomFromText('POINT(-81.1179 32.1858)',4326), null, null, '2021-03-12T16:24:11.632270Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '021989784'),
+('dead0d24-43b5-4383-9538-aa0769de9206', False, 'little-back-river-at-f&w-dock-near-limehouse-sc', 'LITTLE BACK RIVER AT F&W DOCK, NEAR LIMEHOUSE, SC', ST_GeomFromText('POINT(-81.1182 32.1708)',4326), null, null, '2021-03-12T16:24:11.969745Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '021989791'),
+('cda5713a-6f72-4d10-9831-2ae368a7a909', False, 'little-back-river-at-ga-25-at-port-wentworth-ga', 'LITTLE BACK RIVER AT GA 25 AT PORT WENTWORTH, GA', ST_GeomFromText('POINT(-81.13 32.1658)',4326), null, null, '2021-03-12T16:24:12.496629Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '021989792'),
+('093180ba-2e1d-4bbb-9dd4-feab113ce564', False, 'savannah-river-at-fort-pulaski-ga', 'SAVANNAH RIVER AT FORT PULASKI, GA', ST_GeomFromText('POINT(-80.9032 32.0341)',4326), null, null, '2021-03-12T16:24:12.829141Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198980'),
+('924a4b3d-aa54-452d-955d-d5763b916c03', False, 'south-channel-savannah-river-near-savannah-ga', 'SOUTH CHANNEL (SAVANNAH RIVER) NEAR SAVANNAH, GA', ST_GeomFromText('POINT(-81.0023 32.0827)',4326), null, null, '2021-03-12T16:24:13.427343Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02199000'),
+('0f2a5b22-363e-472a-ab11-8aabd9779af2', False, 'twelvemile-creek-near-liberty-sc', 'TWELVEMILE CREEK NEAR LIBERTY, SC', ST_GeomFromText('POINT(-82.7485 34.8015)',4326), null, null, '2021-03-12T16:24:13.753090Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02186000'),
+('420d5ecc-20f5-46cb-bbfc-f9ec08874283', False, 'eighteenmile-creek-above-pendleton-sc', 'EIGHTEENMILE CREEK ABOVE PENDLETON, SC', ST_GeomFromText('POINT(-82.7988 34.659)',4326), null, null, '2021-03-12T16:24:14.074492Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02186699'),
+('8ae11ab9-2efa-410f-ac1c-ce1eb4b9e33f', False, 'hartwell-lake-near-anderson-sc', 'HARTWELL LAKE NEAR ANDERSON, SC', ST_GeomFromText('POINT(-82.8161 34.475)',4326), null, null, '2021-03-12T16:24:14.423103Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02187010'),
+('fd46c733-b662-4195-b96d-0369df362a11', False, 'rocky-river-nr-starr-sc', 'ROCKY RIVER NR STARR, SC', ST_GeomFromText('POINT(-82.5774 34.3832)',4326), null, null, '2021-03-12T16:24:14.751185Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02187910'),
+('8f7dc916-885e-43dd-850a-509741483b80', False, 'russell-lake-above-calhoun-falls-sc', 'RUSSELL LAKE ABOVE CALHOUN FALLS, SC', ST_GeomFromText('POINT(-82.6181 34.1011)',4326), null, null, '2021-03-12T16:24:15.098149Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02188100'),
+('f956ed57-4c11-45aa-ba97-137fc08f26f2', False, 'little-river-near-mt-carmel-sc', 'LITTLE RIVER NEAR MT. CARMEL, SC', ST_GeomFromText('POINT(-82.5007 34.0715)',4326), null, null, '2021-03-12T16:24:15.436417Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02192500'),
+('7a1d3531-440f-4a13-bc32-f43101ebac4d', False, 'thurmond-lake-near-plum-branch-sc', 'THURMOND LAKE NEAR PLUM BRANCH, SC', ST_GeomFromText('POINT(-82.3506 33.8403)',4326), null, null, '2021-03-12T16:24:15.763304Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02193900'),
+('0247a7d6-fb7f-4968-b6dc-c2e8f277dab6', False, 'savannah-river-near-evans-ga', 'SAVANNAH RIVER NEAR EVANS, GA', ST_GeomFromText('POINT(-82.1232 33.5929)',4326), null, null, '2021-03-12T16:24:16.099103Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02195520'),
+('195be647-588b-4973-bf02-8717a31d02e1', False, 'stevens-creek-near-modoc-sc', 'STEVENS CREEK NEAR MODOC, SC', ST_GeomFromText('POINT(-82.1818 33.7293)',4326), null, null, '2021-03-12T16:24:16.445858Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02196000'),
+('c2c43566-82eb-4901-a1d3-81f2200e193e', False, 'savannah-rvr-at-stevens-creek-dam-nr-morgana-sc', 'SAVANNAH RVR AT STEVENS CREEK DAM NR MORGANA, SC', ST_GeomFromText('POINT(-82.051 33.5629)',4326), null, null, '2021-03-12T16:24:16.811461Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02196483'),
+('f8a51a89-74ce-4c03-bc93-594c97b7de00', False, 'augusta-canal-nr-augusta-ga-upper', 'AUGUSTA CANAL NR AUGUSTA, GA (UPPER)', ST_GeomFromText('POINT(-82.0379 33.5493)',4326), null, null, '2021-03-12T16:24:17.138369Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02196485'),
+('34b223e2-5394-466b-b7ac-9d84788a4db3', False, 'horse-creek-at-clearwater-sc', 'HORSE CREEK AT CLEARWATER, SC', ST_GeomFromText('POINT(-81.8971 33.4849)',4326), null, null, '2021-03-12T16:24:17.928663Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02196690'),
+('01de28df-bf75-428c-ae1d-f4feb975db63', False, '021970161', '021970161', ST_GeomFromText('POINT(-82.1572 33.39)',4326), null, null, '2021-03-12T16:24:18.253181Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '021970161'),
+('ace2e8fb-6357-4bc2-90be-b47e426caf1d', False, 'savannah-r-at-burtons-ferry-br-nr-millhaven-ga', 'SAVANNAH R AT BURTONS FERRY BR NR MILLHAVEN, GA', ST_GeomFromText('POINT(-81.5026 32.939)',4326), null, null, '2021-03-12T16:24:18.587471Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02197500'),
+('02675aaf-22fc-426a-bcab-228f285857c1', False, 'savannah-river-near-estill-sc', 'SAVANNAH RIVER NEAR ESTILL, SC', ST_GeomFromText('POINT(-81.4283 32.7033)',4326), null, null, '2021-03-12T16:24:18.908695Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198375'),
+('7c73c2ba-9e4f-4ac4-885b-d51aea328d5f', False, 'savannah-river-near-clyo-ga', 'SAVANNAH RIVER NEAR CLYO, GA', ST_GeomFromText('POINT(-81.2687 32.5282)',4326), null, null, '2021-03-12T16:24:19.236463Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198500'),
+('cd09e10e-ab4d-49ba-830d-31e34f8faaa3', False, 'savannah-river-above-hardeeville-sc', 'SAVANNAH RIVER ABOVE HARDEEVILLE, SC', ST_GeomFromText('POINT(-81.1284 32.3394)',4326), null, null, '2021-03-12T16:24:19.594214Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '6b60b4e6-ff3a-4b8a-8426-93347824588f', '00000000-0000-0000-0000-000000000000', null, '02198760');
 
 --INSERT INSTRUMENT STATUS--
 INSERT INTO instrument_status(id, instrument_id, status_id, "time")
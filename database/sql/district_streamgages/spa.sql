@@ -75,169 +75,169 @@ where i.project_id = '57fc22a2-b536-45a3-afbb-e5d0e3a422c6';
 
 
 --INSERT INSTRUMENTS--COUNT:161
-INSERT INTO instrument(id, deleted, slug, name, formula, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
+INSERT INTO instrument(id, deleted, slug, name, geometry, station, station_offset, create_date, update_date, type_id, project_id, creator, updater, usgs_id)
  VALUES 
-('6c23cba4-7412-40f0-a43c-14c6c148cd83', False, 'big-bend-np', 'Big Bend NP', null, ST_GeomFromText('POINT(-102.975 29.1833)',4326), null, null, '2021-03-12T16:38:02.633196Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08375300'),
-('dbd912dd-f0eb-471e-9dfb-6d44eacd58a2', False, 'aadn5', 'AADN5', null, ST_GeomFromText('POINT(-106.5731 35.1511)',4326), null, null, '2021-03-12T16:38:02.633638Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08329880'),
-('1bd9947f-158d-455b-8fb0-36a3bc402340', False, 'redford', 'Redford', null, ST_GeomFromText('POINT(-104.2161 29.4856)',4326), null, null, '2021-03-12T16:38:02.633797Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('ed209e90-6c83-48cc-96d9-4060b099b120', False, 'rawsacon5', 'RawsACON5', null, ST_GeomFromText('POINT(-107.8483 34.7197)',4326), null, null, '2021-03-12T16:38:02.633908Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('14b56fbf-4c69-4b03-af16-70a738f71019', False, 'rawsbatn5', 'RawsBATN5', null, ST_GeomFromText('POINT(-104.4406 32.1786)',4326), null, null, '2021-03-12T16:38:02.634247Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('1d955329-4d27-4060-a2cc-8912f16ff497', False, 'rawsbbrc2', 'RawsBBRC2', null, ST_GeomFromText('POINT(-107.7294 37.4961)',4326), null, null, '2021-03-12T16:38:02.634557Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('a575bae1-fa85-451a-a9b8-1298e14beeba', False, 'rawsbchn5', 'RawsBCHN5', null, ST_GeomFromText('POINT(-106.0986 33.7731)',4326), null, null, '2021-03-12T16:38:02.634865Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('b901b3e2-229d-449d-9ca5-13be060c0d8f', False, 'rawsbdan5', 'RawsBDAN5', null, ST_GeomFromText('POINT(-106.8819 33.8211)',4326), null, null, '2021-03-12T16:38:02.635163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('910c7098-4f68-4ab8-9a26-642d280a9d96', False, 'rawsbhrc2', 'RawsBHRC2', null, ST_GeomFromText('POINT(-106.2011 37.0208)',4326), null, null, '2021-03-12T16:38:02.635456Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('c44836e6-28b2-409c-8337-46bfc226884f', False, 'rawsblpc2', 'RawsBLPC2', null, ST_GeomFromText('POINT(-106.7786 37.7931)',4326), null, null, '2021-03-12T16:38:02.635749Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('023cace5-0c4d-4cc5-aa22-9e6dcf0b6b7c', False, 'rawsblwn5', 'RawsBLWN5', null, ST_GeomFromText('POINT(-108.1631 35.1942)',4326), null, null, '2021-03-12T16:38:02.636081Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('05ea4c64-7abc-484c-bece-5c77ca3c0ada', False, 'rawsbmoc2', 'RawsBMOC2', null, ST_GeomFromText('POINT(-105.2842 37.8614)',4326), null, null, '2021-03-12T16:38:02.636385Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('9cbb3dff-fc85-442a-9233-b3aced2b5161', False, 'rawsbnht2', 'RawsBNHT2', null, ST_GeomFromText('POINT(-101.1578 30.9856)',4326), null, null, '2021-03-12T16:38:02.636679Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('ca895b76-6cd7-4927-a040-ecd0a3083a06', False, 'rawsbvrn5', 'RawsBVRN5', null, ST_GeomFromText('POINT(-108.11 33.4236)',4326), null, null, '2021-03-12T16:38:02.636972Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('61947684-6df7-4fc5-a5d9-8ab45713b91b', False, 'rawscbbn5', 'RawsCBBN5', null, ST_GeomFromText('POINT(-107.0772 35.9419)',4326), null, null, '2021-03-12T16:38:02.637318Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('3756cb99-24c2-463d-b7ea-8f56241a7341', False, 'rawsccec2', 'RawsCCEC2', null, ST_GeomFromText('POINT(-104.0319 37.5425)',4326), null, null, '2021-03-12T16:38:02.637614Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('bf5c10f4-dd44-4f47-9bcf-680719187b0e', False, 'rawsccyc2', 'RawsCCYC2', null, ST_GeomFromText('POINT(-104.8692 37.9478)',4326), null, null, '2021-03-12T16:38:02.637958Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('454a2cce-ed98-49b1-8d1f-aa021858ccd8', False, 'rawscglk1', 'RawsCGLK1', null, ST_GeomFromText('POINT(-101.9014 37.1408)',4326), null, null, '2021-03-12T16:38:02.638264Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('987c8139-2c31-4319-b294-e87bf91bea26', False, 'rawscgrc2', 'RawsCGRC2', null, ST_GeomFromText('POINT(-105.4519 38.3456)',4326), null, null, '2021-03-12T16:38:02.638558Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('b30917a9-66da-422a-8f0f-8987406bbbcc', False, 'rawschac2', 'RawsCHAC2', null, ST_GeomFromText('POINT(-108.4892 37.1994)',4326), null, null, '2021-03-12T16:38:02.638857Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('30802dde-2319-4f37-8196-87708d492096', False, 'rawscimn5', 'RawsCIMN5', null, ST_GeomFromText('POINT(-105.1203 36.6061)',4326), null, null, '2021-03-12T16:38:02.639176Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('2d280101-f2c1-4db3-84d3-b9387c63a00a', False, 'rawscopn5', 'RawsCOPN5', null, ST_GeomFromText('POINT(-105.6861 33.3503)',4326), null, null, '2021-03-12T16:38:02.639475Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('ca49d20e-c8a4-4564-be3f-c67608efa0b6', False, 'rawscoyn5', 'RawsCOYN5', null, ST_GeomFromText('POINT(-106.6572 36.0731)',4326), null, null, '2021-03-12T16:38:02.639766Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('d5aac1c4-1a01-4dae-8cc8-ff03ce24f4b5', False, 'rawscppc2', 'RawsCPPC2', null, ST_GeomFromText('POINT(-105.2675 38.7422)',4326), null, null, '2021-03-12T16:38:02.640057Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('4f3e96b9-1e62-4b12-951f-118e77d5cc05', False, 'rawscppn5', 'RawsCPPN5', null, ST_GeomFromText('POINT(-103.8567 32.9278)',4326), null, null, '2021-03-12T16:38:02.640350Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('bab3961a-a572-48c9-98ea-a5b3bb353f00', False, 'rawscsmn5', 'RawsCSMN5', null, ST_GeomFromText('POINT(-105.82 32.7792)',4326), null, null, '2021-03-12T16:38:02.640644Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('8a7a53cf-383a-4a54-90ab-25b3125f3b43', False, 'rawscuhc2', 'RawsCUHC2', null, ST_GeomFromText('POINT(-104.9194 37.0919)',4326), null, null, '2021-03-12T16:38:02.640934Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('73318e0b-898e-48f9-8bce-b5aa61914a4a', False, 'rawscwrn5', 'RawsCWRN5', null, ST_GeomFromText('POINT(-107.6289 36.9767)',4326), null, null, '2021-03-12T16:38:02.641281Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('862ec060-a496-4d82-85d6-fe782601f5cf', False, 'rawscync2', 'RawsCYNC2', null, ST_GeomFromText('POINT(-105.1181 38.0167)',4326), null, null, '2021-03-12T16:38:02.641581Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('04a89f33-5146-40bf-844a-9ca1c44d3dc0', False, 'rawsdgct2', 'RawsDGCT2', null, ST_GeomFromText('POINT(-104.8339 31.9961)',4326), null, null, '2021-03-12T16:38:02.641886Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('6e38fe0f-a357-4f4a-b128-5feeaff7ea2e', False, 'rawsdlcn5', 'RawsDLCN5', null, ST_GeomFromText('POINT(-107.0 36.9353)',4326), null, null, '2021-03-12T16:38:02.642204Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('c85ea641-8912-4251-9a7f-812fe97c5c0c', False, 'rawsdpkn5', 'RawsDPKN5', null, ST_GeomFromText('POINT(-106.7719 36.4228)',4326), null, null, '2021-03-12T16:38:02.642498Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('31c8f673-c7d8-4ee4-98e2-df4ef8fb7b58', False, 'rawsdprn5', 'RawsDPRN5', null, ST_GeomFromText('POINT(-108.0617 33.6939)',4326), null, null, '2021-03-12T16:38:02.642792Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('856b4b09-c1a8-4509-9b1c-cb32901305d9', False, 'rawsdtln5', 'RawsDTLN5', null, ST_GeomFromText('POINT(-107.7681 34.2925)',4326), null, null, '2021-03-12T16:38:02.643143Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('2b47400a-93eb-4c0c-9802-cf5b5289c7c1', False, 'rawsdufc2', 'RawsDUFC2', null, ST_GeomFromText('POINT(-108.3267 37.4942)',4326), null, null, '2021-03-12T16:38:02.643527Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('939b1f14-7c6d-4a5c-8463-06827035ee35', False, 'rawsdunn5', 'RawsDUNN5', null, ST_GeomFromText('POINT(-105.1825 32.8247)',4326), null, null, '2021-03-12T16:38:02.643821Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('4bc937ba-d310-4758-b720-47861bacd21c', False, 'rawsdykc2', 'RawsDYKC2', null, ST_GeomFromText('POINT(-107.3053 37.2269)',4326), null, null, '2021-03-12T16:38:02.644119Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('f9916e28-ff68-431c-a406-5584bacf19ea', False, 'rawsfcrc2', 'RawsFCRC2', null, ST_GeomFromText('POINT(-104.8539 38.6589)',4326), null, null, '2021-03-12T16:38:02.644415Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('4ffeedba-ff2f-47ad-ba12-bf152a4a51ae', False, 'rawsfdst2', 'RawsFDST2', null, ST_GeomFromText('POINT(-103.8867 30.6006)',4326), null, null, '2021-03-12T16:38:02.644708Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('0c5ba1ee-2c0f-4099-96bb-c843ad5ba764', False, 'rawsgdbt2', 'RawsGDBT2', null, ST_GeomFromText('POINT(-104.8253 31.925)',4326), null, null, '2021-03-12T16:38:02.645060Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('eaa8af96-c2f5-4e43-9cd2-fe2478f040dd', False, 'rawsgmln5', 'RawsGMLN5', null, ST_GeomFromText('POINT(-108.1744 34.8519)',4326), null, null, '2021-03-12T16:38:02.645384Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('921b3aa3-634b-4d4b-b9bb-e7fe8f11dfaa', False, 'rawsgnmn5', 'RawsGNMN5', null, ST_GeomFromText('POINT(-108.2403 33.2236)',4326), null, null, '2021-03-12T16:38:02.645677Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('d75b286e-f562-45ad-9a74-f4397ff67909', False, 'rawsgrsn5', 'RawsGRSN5', null, ST_GeomFromText('POINT(-107.6653 35.2428)',4326), null, null, '2021-03-12T16:38:02.646014Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('f8fc2d93-7656-4c64-ab41-8dc3932b9eb5', False, 'rawsgscn5', 'RawsGSCN5', null, ST_GeomFromText('POINT(-105.4619 35.8939)',4326), null, null, '2021-03-12T16:38:02.646316Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('27fa1fa8-6b16-4f63-9daa-5d45c7de0c9c', False, 'rawshhrn5', 'RawsHHRN5', null, ST_GeomFromText('POINT(-108.3417 31.7019)',4326), null, null, '2021-03-12T16:38:02.646608Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('ca82fc36-c3d5-428e-8b1b-255a6c426f99', False, 'rawsjarn5', 'RawsJARN5', null, ST_GeomFromText('POINT(-106.1031 36.5558)',4326), null, null, '2021-03-12T16:38:02.646896Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('6facbc19-2547-4d46-9a9f-09f7bfbd1af2', False, 'rawsjesn5', 'RawsJESN5', null, ST_GeomFromText('POINT(-106.6197 35.8406)',4326), null, null, '2021-03-12T16:38:02.647225Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('85b3ee47-59e5-4e55-affd-a3de76a174d4', False, 'rawslomn5', 'RawsLOMN5', null, ST_GeomFromText('POINT(-106.5867 32.3222)',4326), null, null, '2021-03-12T16:38:02.647524Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('51a37c58-4b7a-4ebd-a08c-76c92dde666e', False, 'rawslpfc2', 'RawsLPFC2', null, ST_GeomFromText('POINT(-106.3497 39.1536)',4326), null, null, '2021-03-12T16:38:02.647818Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('f8a5d3ba-1dbf-4068-bcf7-f1b1ffa40794', False, 'rawslujc2', 'RawsLUJC2', null, ST_GeomFromText('POINT(-106.5678 38.2547)',4326), null, null, '2021-03-12T16:38:02.648137Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('ce46db90-2d9e-4da6-bdf7-13b18ab38b71', False, 'rawslvpn5', 'RawsLVPN5', null, ST_GeomFromText('POINT(-105.4942 35.5458)',4326), null, null, '2021-03-12T16:38:02.648432Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('1cd82b2e-69c7-4d94-a111-b8e7fe237f28', False, 'rawsmayn5', 'RawsMAYN5', null, ST_GeomFromText('POINT(-105.4697 32.9097)',4326), null, null, '2021-03-12T16:38:02.648725Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('9d07a839-d35e-49c1-9bae-5a9cd93b3fb6', False, 'rawsmcrn5', 'RawsMCRN5', null, ST_GeomFromText('POINT(-105.6217 32.4314)',4326), null, null, '2021-03-12T16:38:02.649063Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('0862e881-e903-4b26-8c0d-4a6931353c36', False, 'rawsmgln5', 'RawsMGLN5', null, ST_GeomFromText('POINT(-107.5392 33.8511)',4326), null, null, '2021-03-12T16:38:02.649392Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('1cb3de4e-53e2-4f19-93da-d077e8da87e3', False, 'rawsmgnn5', 'RawsMGNN5', null, ST_GeomFromText('POINT(-108.8067 33.3906)',4326), null, null, '2021-03-12T16:38:02.649693Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('6b86394a-c3c6-45d0-93dd-fffc67cba975', False, 'rawsmlcn5', 'RawsMLCN5', null, ST_GeomFromText('POINT(-104.3244 36.055)',4326), null, null, '2021-03-12T16:38:02.650003Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('be4946e4-fbad-4726-b7f0-769d4f5e86d8', False, 'rawsmmrc2', 'RawsMMRC2', null, ST_GeomFromText('POINT(-107.7086 37.0564)',4326), null, null, '2021-03-12T16:38:02.650301Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('12991958-6c69-4ccf-bef5-42589a9b15d9', False, 'rawsmndt2', 'RawsMNDT2', null, ST_GeomFromText('POINT(-102.1897 31.9431)',4326), null, null, '2021-03-12T16:38:02.650593Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('396f9a8e-ae0a-460f-8431-acd791b70bcd', False, 'rawsmpan5', 'RawsMPAN5', null, ST_GeomFromText('POINT(-103.7217 32.1797)',4326), null, null, '2021-03-12T16:38:02.650888Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('9675c12a-f1ed-49d1-b0e8-6e71eabc8bc1', False, 'rawsmrfc2', 'RawsMRFC2', null, ST_GeomFromText('POINT(-108.4128 37.2972)',4326), null, null, '2021-03-12T16:38:02.651187Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('4658ab6a-b4a1-4526-9c5b-f664204a64a7', False, 'rawsmrrn5', 'RawsMRRN5', null, ST_GeomFromText('POINT(-103.8 34.3)',4326), null, null, '2021-03-12T16:38:02.651486Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('3ef35229-0d54-49f6-a6a3-820bc76c5821', False, 'rawsmscn5', 'RawsMSCN5', null, ST_GeomFromText('POINT(-105.7689 33.1581)',4326), null, null, '2021-03-12T16:38:02.651780Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('a24ea0d0-0247-45fc-a7c1-b59d9f2fc720', False, 'rawsmtnn5', 'RawsMTNN5', null, ST_GeomFromText('POINT(-106.2617 34.5206)',4326), null, null, '2021-03-12T16:38:02.652112Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('12a721b2-2f96-4290-9601-d56d5fb9236c', False, 'rawspsgt2', 'RawsPSGT2', null, ST_GeomFromText('POINT(-104.7978 31.8944)',4326), null, null, '2021-03-12T16:38:02.652415Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('daf010e8-8b3b-43e0-a336-6071197030c8', False, 'rawspxwt2', 'RawsPXWT2', null, ST_GeomFromText('POINT(-104.9478 31.9722)',4326), null, null, '2021-03-12T16:38:02.652710Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('aa35a702-7a97-4047-b306-08c948439811', False, 'rawsqenn5', 'RawsQENN5', null, ST_GeomFromText('POINT(-104.6903 32.2036)',4326), null, null, '2021-03-12T16:38:02.653048Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('ddc38e7f-4a58-44ab-ace6-7c67edaebc06', False, 'rawsrddc2', 'RawsRDDC2', null, ST_GeomFromText('POINT(-104.9978 38.2072)',4326), null, null, '2021-03-12T16:38:02.653366Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('a9c88d46-f1ec-4c46-8e6d-af84065c09ba', False, 'rawsrdkc2', 'RawsRDKC2', null, ST_GeomFromText('POINT(-106.2117 38.8272)',4326), null, null, '2021-03-12T16:38:02.653658Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('5dccf082-db16-46ac-ae5c-90b32019a68e', False, 'rawsremn5', 'RawsREMN5', null, ST_GeomFromText('POINT(-104.3211 33.6506)',4326), null, null, '2021-03-12T16:38:02.653983Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('9fd62c44-047a-4218-a8cb-8eb1756312d8', False, 'rawsrgmn5', 'RawsRGMN5', null, ST_GeomFromText('POINT(-106.6808 35.1458)',4326), null, null, '2021-03-12T16:38:02.654297Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('7d6c55d0-65a4-4b0b-9c26-2b20fae116d9', False, 'rawsrrac2', 'RawsRRAC2', null, ST_GeomFromText('POINT(-104.9528 38.9586)',4326), null, null, '2021-03-12T16:38:02.654631Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('16608e22-cf8f-4521-a08f-4645ce77b13c', False, 'rawssdnc2', 'RawsSDNC2', null, ST_GeomFromText('POINT(-105.5108 37.7267)',4326), null, null, '2021-03-12T16:38:02.655055Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('f790761c-d4c2-49a7-8502-3f9a92afdec3', False, 'rawssdvc2', 'RawsSDVC2', null, ST_GeomFromText('POINT(-107.3028 37.0994)',4326), null, null, '2021-03-12T16:38:02.655599Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('03863286-f239-40a2-b36e-255529975907', False, 'rawssevn5', 'RawsSEVN5', null, ST_GeomFromText('POINT(-106.7981 34.3769)',4326), null, null, '2021-03-12T16:38:02.656136Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('601bfae9-d1be-46a3-9df7-b803a1555771', False, 'rawssfwn5', 'RawsSFWN5', null, ST_GeomFromText('POINT(-105.8603 35.6869)',4326), null, null, '2021-03-12T16:38:02.656517Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('58ef4527-e228-4b61-aef8-16125fe7c88a', False, 'rawssidc2', 'RawsSIDC2', null, ST_GeomFromText('POINT(-106.17 38.5933)',4326), null, null, '2021-03-12T16:38:02.657000Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('9247b6ba-c858-4378-b143-2b297f7a652e', False, 'rawsslmn5', 'RawsSLMN5', null, ST_GeomFromText('POINT(-108.4447 34.0606)',4326), null, null, '2021-03-12T16:38:02.657552Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('9a1f51bf-529e-49cc-baa0-4e8e2ef09a78', False, 'rawssndn5', 'RawsSNDN5', null, ST_GeomFromText('POINT(-106.525 32.58)',4326), null, null, '2021-03-12T16:38:02.658174Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('459b75d2-08fc-4263-a9f3-13eddb1dd5c2', False, 'rawssnln5', 'RawsSNLN5', null, ST_GeomFromText('POINT(-106.5906 35.2297)',4326), null, null, '2021-03-12T16:38:02.658765Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('4ca28ed0-1aa5-482f-9053-e20d243e6274', False, 'rawsstln5', 'RawsSTLN5', null, ST_GeomFromText('POINT(-106.8658 36.7317)',4326), null, null, '2021-03-12T16:38:02.659354Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('ec40d25a-6146-40c7-a47f-68e5c8331682', False, 'rawstjrn5', 'RawsTJRN5', null, ST_GeomFromText('POINT(-106.3158 35.0061)',4326), null, null, '2021-03-12T16:38:02.659885Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('33722e5f-a61e-48e3-892e-d4efe7d09629', False, 'rawstrun5', 'RawsTRUN5', null, ST_GeomFromText('POINT(-105.7694 36.0589)',4326), null, null, '2021-03-12T16:38:02.660489Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('f3c583c2-06bc-4e24-8dbb-a1106808db90', False, 'rawstwrn5', 'RawsTWRN5', null, ST_GeomFromText('POINT(-106.2661 35.7789)',4326), null, null, '2021-03-12T16:38:02.661106Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('1adc9d9a-bd66-43e3-860f-276921d37334', False, 'rawsucnc2', 'RawsUCNC2', null, ST_GeomFromText('POINT(-102.6303 37.0608)',4326), null, null, '2021-03-12T16:38:02.661703Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('1e625b98-c282-48cd-8624-9461fad5cdbc', False, 'rawswlcc2', 'RawsWLCC2', null, ST_GeomFromText('POINT(-105.0561 38.0028)',4326), null, null, '2021-03-12T16:38:02.662314Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('e0260f95-9a88-42da-b526-a221a38e4206', False, 'rawswpsn5', 'RawsWPSN5', null, ST_GeomFromText('POINT(-108.8608 36.0767)',4326), null, null, '2021-03-12T16:38:02.662925Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('fa2cdac9-3943-44f6-899e-dacb04981a15', False, 'rawsznrn5', 'RawsZNRN5', null, ST_GeomFromText('POINT(-108.9414 35.1392)',4326), null, null, '2021-03-12T16:38:02.663502Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('3517a70b-ad04-44e3-b67f-3ce74ff08a33', False, 'wightman-fork', 'Wightman Fork', null, ST_GeomFromText('POINT(-106.5217 37.4039)',4326), null, null, '2021-03-12T16:38:02.664109Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('3bd612a4-8022-48a7-8530-5948cb3840b2', False, 'ft-garland-s-d-c', 'FT Garland S D C', null, ST_GeomFromText('POINT(-105.415 37.425)',4326), null, null, '2021-03-12T16:38:02.664265Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('4017d022-5e29-4240-9695-fcb75929a23a', False, 'costilla-us', 'Costilla US', null, ST_GeomFromText('POINT(-105.2547 36.8984)',4326), null, null, '2021-03-12T16:38:02.664415Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08252500'),
-('5f3f6678-5788-4d43-a029-f835f0db6152', False, 'costilla-santvan', 'Costilla Santvan', null, ST_GeomFromText('POINT(-105.2811 36.8842)',4326), null, null, '2021-03-12T16:38:02.664592Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08253500'),
-('f5ecc69d-6937-47a2-b8fc-7a87a6cd9879', False, 'cundiyo', 'Cundiyo', null, ST_GeomFromText('POINT(-105.9047 35.9647)',4326), null, null, '2021-03-12T16:38:02.664773Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08291000'),
-('b3eb94c6-c0a0-4342-b061-c63ee6efbf5e', False, 'narrows_8023', 'Narrows', null, ST_GeomFromText('POINT(-107.1625 33.3861)',4326), null, null, '2021-03-12T16:38:02.664940Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08359500'),
-('d5c44ac0-6a0d-42b2-9955-1c16afb1af63', False, 'santa-fe', 'Santa Fe', null, ST_GeomFromText('POINT(-105.8436 35.6864)',4326), null, null, '2021-03-12T16:38:02.665139Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08316000'),
-('a25a4449-464d-4a24-b847-1c625d5bd1f4', False, 'nichols', 'Nichols', null, ST_GeomFromText('POINT(-105.88 35.6894)',4326), null, null, '2021-03-12T16:38:02.665316Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08316500'),
-('f7065efc-b02b-405b-8019-1c5da8c0ff4b', False, 'prairie-dtch', 'Prairie Dtch', null, ST_GeomFromText('POINT(-106.2315 37.6455)',4326), null, null, '2021-03-12T16:38:02.665516Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('25b78426-4251-44f2-a9e8-8f2d1c087391', False, 'dryden', 'Dryden', null, ST_GeomFromText('POINT(-102.1667 29.8236)',4326), null, null, '2021-03-12T16:38:02.665670Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('cb71d0c9-896f-4eb7-9f38-76ab619d84e2', False, 'foster-ranch', 'Foster Ranch', null, ST_GeomFromText('POINT(-101.7556 29.7806)',4326), null, null, '2021-03-12T16:38:02.665887Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('76a5ff46-43e7-4e6c-8d82-adaff0c6532a', False, 'summitville', 'Summitville', null, ST_GeomFromText('POINT(-106.5842 37.4292)',4326), null, null, '2021-03-12T16:38:02.666087Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('604b9f65-7428-4969-97b9-3ffcbc6db6de', False, 'terlingua', 'Terlingua', null, ST_GeomFromText('POINT(-103.6194 29.2028)',4326), null, null, '2021-03-12T16:38:02.666224Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('136e4f36-3e32-43ea-b36f-86a9171a43d5', False, 'ft-garland-ute', 'Ft Garland Ute', null, ST_GeomFromText('POINT(-105.4258 37.4471)',4326), null, null, '2021-03-12T16:38:02.666405Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('31ac80a7-c7f3-4301-8278-af29094be8a2', False, 'el-paso', 'El Paso', null, ST_GeomFromText('POINT(-106.5403 31.8028)',4326), null, null, '2021-03-12T16:38:02.666589Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('587e04dc-06ca-4449-b0d9-93da048bf709', False, 'castolon', 'Castolon', null, ST_GeomFromText('POINT(-103.5244 29.1378)',4326), null, null, '2021-03-12T16:38:02.666770Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08374550'),
-('f3614e83-c491-4b7a-8e10-446126da3376', False, 'talpa', 'Talpa', null, ST_GeomFromText('POINT(-105.5811 36.3031)',4326), null, null, '2021-03-12T16:38:02.666975Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08275500'),
-('b81194cd-042f-44a0-aba0-a24968250988', False, 'cochiti-santa-fe', 'Cochiti Santa Fe', null, ST_GeomFromText('POINT(-106.2289 35.5472)',4326), null, null, '2021-03-12T16:38:02.667166Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08317200'),
-('83945f90-ec45-47b9-9947-dbe0a7c2e2d8', False, 'fishtail-rd', 'Fishtail rd', null, ST_GeomFromText('POINT(-106.4708 36.7381)',4326), null, null, '2021-03-12T16:38:02.667345Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08282300'),
-('eb259ebd-cd32-4453-9610-b4054854149f', False, 'romero-dtch', 'Romero Dtch', null, ST_GeomFromText('POINT(-106.0384 37.0869)',4326), null, null, '2021-03-12T16:38:02.667525Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('b3ff4763-9049-40f8-9415-1996017e075d', False, 'manassa-dtch', 'Manassa Dtch', null, ST_GeomFromText('POINT(-106.0054 37.1054)',4326), null, null, '2021-03-12T16:38:02.667698Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('e06454a3-d78b-46eb-92cd-a7a20bd1c7c1', False, 'san-luis-val-cn', 'San Luis Val Cn', null, ST_GeomFromText('POINT(-106.0513 37.5767)',4326), null, null, '2021-03-12T16:38:02.667850Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('31da0de0-c862-4053-916b-ac078d4cdb30', False, 'prwp-dtch', 'PRWP Dtch', null, ST_GeomFromText('POINT(-107.3214 37.6669)',4326), null, null, '2021-03-12T16:38:02.667981Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('1933fb0b-9a03-48b9-9f7f-bda8c64b18ea', False, 'south-fork-bear', 'South Fork Bear', null, ST_GeomFromText('POINT(-106.6082 37.6798)',4326), null, null, '2021-03-12T16:38:02.668120Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('a50a824b-a252-4b38-84a7-12cd0874624c', False, 'bluewater', 'Bluewater', null, ST_GeomFromText('POINT(-108.1114 35.2925)',4326), null, null, '2021-03-12T16:38:02.668264Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08341400'),
-('05c7af91-9cf5-40dc-8d7c-98d60ff272b3', False, 'conejos', 'Conejos', null, ST_GeomFromText('POINT(-106.0069 37.1015)',4326), null, null, '2021-03-12T16:38:02.668474Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('d5aa8dbc-a688-46f1-af71-319d6ee3bcbe', False, 'monte-vista-cn', 'Monte Vista Cn', null, ST_GeomFromText('POINT(-106.222 37.616)',4326), null, null, '2021-03-12T16:38:02.668621Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('0b03ec20-1ddf-4311-9b2e-adda6a58cc2a', False, 'mountain-home', 'Mountain Home', null, ST_GeomFromText('POINT(-105.3936 37.3931)',4326), null, null, '2021-03-12T16:38:02.668758Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('4266e3a1-1c11-4e9d-8523-b4ef63f5f4d8', False, 'mountain-home-ds', 'Mountain Home DS', null, ST_GeomFromText('POINT(-105.395 37.394)',4326), null, null, '2021-03-12T16:38:02.668758Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('6d389011-7c6d-426e-9fe4-ecfede494a19', False, 'jasper-ds', 'Jasper DS', null, ST_GeomFromText('POINT(-106.3773 37.3889)',4326), null, null, '2021-03-12T16:38:02.669257Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('a00129dc-e7c1-4c53-ab4f-c01560a15524', False, 'jasper-us', 'Jasper US', null, ST_GeomFromText('POINT(-106.522 37.4025)',4326), null, null, '2021-03-12T16:38:02.669465Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('44a550b7-cc43-40da-a065-5a7129935f03', False, 'marshall-park-cg', 'Marshall Park CG', null, ST_GeomFromText('POINT(-106.9819 37.7932)',4326), null, null, '2021-03-12T16:38:02.669669Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('f25d66e2-ae55-48e0-b7be-8015db572cfa', False, 'humphreys-us', 'Humphreys US', null, ST_GeomFromText('POINT(-106.8593 37.6764)',4326), null, null, '2021-03-12T16:38:02.669849Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('c8bdaa72-3037-4edc-88d2-3340871597a3', False, 'terrace', 'Terrace', null, ST_GeomFromText('POINT(-106.285 37.3572)',4326), null, null, '2021-03-12T16:38:02.670096Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('f6f3bb48-aa8c-432e-9aae-b55d17dd378c', False, 'conejos-n-b', 'Conejos N B', null, ST_GeomFromText('POINT(-106.0091 37.1018)',4326), null, null, '2021-03-12T16:38:02.670233Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('56bbfc9a-eafa-4ba5-b75a-f5d41fde3672', False, 'tabor-dtch', 'Tabor Dtch', null, ST_GeomFromText('POINT(-107.1587 37.8728)',4326), null, null, '2021-03-12T16:38:02.670360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('e3fd4deb-b0a4-4f82-94d7-901b41e942c0', False, 'beaver-creek-ds', 'Beaver Creek DS', null, ST_GeomFromText('POINT(-106.6675 37.5989)',4326), null, null, '2021-03-12T16:38:02.670488Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('a1071415-17ef-4dcb-a2cd-295c296b5788', False, 'wagon-wg-goose', 'Wagon WG Goose', null, ST_GeomFromText('POINT(-106.83 37.7519)',4326), null, null, '2021-03-12T16:38:02.670620Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('93abc317-d62e-47a8-86c6-6b5e1b04f1b6', False, 'del-norte-san-fr', 'Del Norte San Fr', null, ST_GeomFromText('POINT(-106.3702 37.5793)',4326), null, null, '2021-03-12T16:38:02.670745Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('86b08923-5955-434c-9bf5-ac597bd81a05', False, 'hayner-bridge', 'Hayner Bridge', null, ST_GeomFromText('POINT(-107.0194 32.6133)',4326), null, null, '2021-03-12T16:38:02.670887Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('b2578e05-2951-452c-ac1d-d4f1a7bfec58', False, 'anthony', 'Anthony', null, ST_GeomFromText('POINT(-106.6014 31.915)',4326), null, null, '2021-03-12T16:38:02.671073Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('46e533be-0a2a-48ac-ac07-9bead5781ed6', False, 'american-cn', 'American Cn', null, ST_GeomFromText('POINT(-106.5264 31.7778)',4326), null, null, '2021-03-12T16:38:02.671264Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('1f71277c-eee5-4ab3-8461-014aa9b867e1', False, 'ft-quitman', 'Ft Quitman', null, ST_GeomFromText('POINT(-105.6083 31.0861)',4326), null, null, '2021-03-12T16:38:02.671398Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('c8466012-f149-4b8e-8f78-96695159c3a6', False, 'candelaria', 'Candelaria', null, ST_GeomFromText('POINT(-104.6861 30.175)',4326), null, null, '2021-03-12T16:38:02.671578Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('0a91b682-818d-4f21-8963-7ebd95afbd14', False, 'presidio-us', 'Presidio US', null, ST_GeomFromText('POINT(-104.4514 29.6042)',4326), null, null, '2021-03-12T16:38:02.671769Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('03de996a-2d06-43c4-b8d1-57cb133229e0', False, 'presidio', 'Presidio', null, ST_GeomFromText('POINT(-104.3936 29.5578)',4326), null, null, '2021-03-12T16:38:02.671973Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('898f9bc8-c6c3-44cb-af18-532f19e4a08f', False, 'american-ds', 'American DS', null, ST_GeomFromText('POINT(-106.5222 31.7761)',4326), null, null, '2021-03-12T16:38:02.672165Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('3b191d6e-94ce-4ef5-9994-6b33962d058a', False, 'mesilla-ds', 'Mesilla DS', null, ST_GeomFromText('POINT(-106.7717 32.2103)',4326), null, null, '2021-03-12T16:38:02.672311Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('df392add-ebfc-4ed7-bff8-fae8f77d112a', False, 'rio-conchos', 'Rio Conchos', null, ST_GeomFromText('POINT(-104.43 29.5833)',4326), null, null, '2021-03-12T16:38:02.672450Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('cda6dcd2-6a2a-4028-820e-8f79802f7e3d', False, 'leasburg-ds', 'Leasburg DS', null, ST_GeomFromText('POINT(-106.9194 32.4764)',4326), null, null, '2021-03-12T16:38:02.672701Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('eaddad50-8ebc-4606-8f26-e961432f4ae6', False, 'presidio-alamito', 'Presidio Alamito', null, ST_GeomFromText('POINT(-104.2875 29.5236)',4326), null, null, '2021-03-12T16:38:02.672912Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('bf22c204-ea8d-45c1-a8c5-259ff957db02', False, 'sanchez-res', 'Sanchez Res', null, ST_GeomFromText('POINT(-105.4125 37.1136)',4326), null, null, '2021-03-12T16:38:02.673107Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('ced53be8-401b-4345-8c96-b1625d0307f9', False, 'sanchez-res-ds', 'Sanchez Res DS', null, ST_GeomFromText('POINT(-105.4092 37.1143)',4326), null, null, '2021-03-12T16:38:02.673107Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('dd5cff09-5142-4838-80ec-7d24b4c698b6', False, 'rio-grande-res', 'Rio Grande Res', null, ST_GeomFromText('POINT(-107.2676 37.7219)',4326), null, null, '2021-03-12T16:38:02.673399Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('7475abe3-6e7a-41ca-8d96-afd8de71db1d', False, 'johnson-ranch', 'Johnson Ranch', null, ST_GeomFromText('POINT(-103.3903 29.0347)',4326), null, null, '2021-03-12T16:38:02.673547Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('5a4569ee-469e-4906-b731-9089439801ca', False, 'presidio-ds', 'Presidio DS', null, ST_GeomFromText('POINT(-104.2864 29.5192)',4326), null, null, '2021-03-12T16:38:02.673753Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('6cc641b6-61bd-4cdd-84eb-deb0ec17251a', False, 'mcclure-us', 'McClure US', null, ST_GeomFromText('POINT(-105.8228 35.6889)',4326), null, null, '2021-03-12T16:38:02.673980Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08315480'),
-('04f355ed-1dd9-4b24-82f2-08e236f7fbef', False, 'tijeras-arroyo', 'Tijeras Arroyo', null, ST_GeomFromText('POINT(-106.6575 35.0019)',4326), null, null, '2021-03-12T16:38:02.674179Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08330775'),
-('c87044ed-1fc7-4056-bb6d-40193d3e831d', False, 'santa-fe-tesq', 'Santa Fe Tesq', null, ST_GeomFromText('POINT(-105.905 35.7394)',4326), null, null, '2021-03-12T16:38:02.674394Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08302500'),
-('58c731d0-ecda-4b8b-886b-cd11e588b9a5', False, 'costilla-ds', 'Costilla DS', null, ST_GeomFromText('POINT(-105.2837 36.8728)',4326), null, null, '2021-03-12T16:38:02.674598Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08254000'),
-('5f553631-029c-4feb-85e2-542566788358', False, 'south-fork-us', 'South Fork US', null, ST_GeomFromText('POINT(-106.7534 37.5744)',4326), null, null, '2021-03-12T16:38:02.674787Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('51c6b0b6-dab7-4ada-b4da-8a99697a52ae', False, 'box-canyon', 'Box Canyon', null, ST_GeomFromText('POINT(-107.1413 37.7746)',4326), null, null, '2021-03-12T16:38:02.675014Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('98e96565-7f39-402d-a52b-18777d9046ed', False, 'farmers-union-cn', 'Farmers Union Cn', null, ST_GeomFromText('POINT(-106.2639 37.6659)',4326), null, null, '2021-03-12T16:38:02.675223Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('a972dba7-8d15-490b-86bc-f2cd835dcd15', False, 'empire-cn', 'Empire Cn', null, ST_GeomFromText('POINT(-106.1055 37.5808)',4326), null, null, '2021-03-12T16:38:02.675381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('00ba1c4c-bbe3-441e-8676-6771f33b59d2', False, 'garcia', 'Garcia', null, ST_GeomFromText('POINT(-105.5325 36.989)',4326), null, null, '2021-03-12T16:38:02.675529Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08261000'),
-('7192ce1e-0a53-469d-9f49-8858ab0581fa', False, 'chama', 'Chama', null, ST_GeomFromText('POINT(-106.5547 36.935)',4326), null, null, '2021-03-12T16:38:02.675743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08281400'),
-('e5443c12-11cc-47f2-92a9-da5b48cddc82', False, 'norton-drain', 'Norton Drain', null, ST_GeomFromText('POINT(-105.7715 37.3346)',4326), null, null, '2021-03-12T16:38:02.675975Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('2840c724-f7bf-4ea3-b5f2-73b062b797e2', False, 'norton-drain-s', 'Norton Drain S', null, ST_GeomFromText('POINT(-105.8547 37.2986)',4326), null, null, '2021-03-12T16:38:02.676138Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('1194b404-fca5-42b6-91eb-7f3cc27352ba', False, 'mountain-home-us', 'Mountain Home US', null, ST_GeomFromText('POINT(-105.3689 37.3947)',4326), null, null, '2021-03-12T16:38:02.676338Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('7ea71af2-e591-46fc-99c8-eef4deb52639', False, 'costilla-dtch-al', 'Costilla Dtch Al', null, ST_GeomFromText('POINT(-105.9488 37.5527)',4326), null, null, '2021-03-12T16:38:02.676509Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('db49a894-d4ff-4210-8c1c-91d1c4ac4cbe', False, 'centennial-cn', 'Centennial Cn', null, ST_GeomFromText('POINT(-106.0704 37.5719)',4326), null, null, '2021-03-12T16:38:02.676668Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('cce86983-ac32-4f2b-88df-2853c8c2f469', False, 'rawsrget2', 'RawsRGET2', null, ST_GeomFromText('POINT(-102.9631 29.1858)',4326), null, null, '2021-03-12T16:38:02.676823Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('8f354680-2109-4cc1-aa88-0482b70d9575', False, 'langtry', 'Langtry', null, ST_GeomFromText('POINT(-101.4458 29.8028)',4326), null, null, '2021-03-12T16:38:02.677572Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
-('b84c692d-ecef-4afb-9e19-f10cce939efe', False, 'rawswdrn5', 'RawsWDRN5', null, ST_GeomFromText('POINT(-105.6689 36.695)',4326), null, null, '2021-03-12T16:38:02.677825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null);
+('6c23cba4-7412-40f0-a43c-14c6c148cd83', False, 'big-bend-np', 'Big Bend NP', ST_GeomFromText('POINT(-102.975 29.1833)',4326), null, null, '2021-03-12T16:38:02.633196Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08375300'),
+('dbd912dd-f0eb-471e-9dfb-6d44eacd58a2', False, 'aadn5', 'AADN5', ST_GeomFromText('POINT(-106.5731 35.1511)',4326), null, null, '2021-03-12T16:38:02.633638Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08329880'),
+('1bd9947f-158d-455b-8fb0-36a3bc402340', False, 'redford', 'Redford', ST_GeomFromText('POINT(-104.2161 29.4856)',4326), null, null, '2021-03-12T16:38:02.633797Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('ed209e90-6c83-48cc-96d9-4060b099b120', False, 'rawsacon5', 'RawsACON5', ST_GeomFromText('POINT(-107.8483 34.7197)',4326), null, null, '2021-03-12T16:38:02.633908Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('14b56fbf-4c69-4b03-af16-70a738f71019', False, 'rawsbatn5', 'RawsBATN5', ST_GeomFromText('POINT(-104.4406 32.1786)',4326), null, null, '2021-03-12T16:38:02.634247Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('1d955329-4d27-4060-a2cc-8912f16ff497', False, 'rawsbbrc2', 'RawsBBRC2', ST_GeomFromText('POINT(-107.7294 37.4961)',4326), null, null, '2021-03-12T16:38:02.634557Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('a575bae1-fa85-451a-a9b8-1298e14beeba', False, 'rawsbchn5', 'RawsBCHN5', ST_GeomFromText('POINT(-106.0986 33.7731)',4326), null, null, '2021-03-12T16:38:02.634865Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('b901b3e2-229d-449d-9ca5-13be060c0d8f', False, 'rawsbdan5', 'RawsBDAN5', ST_GeomFromText('POINT(-106.8819 33.8211)',4326), null, null, '2021-03-12T16:38:02.635163Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('910c7098-4f68-4ab8-9a26-642d280a9d96', False, 'rawsbhrc2', 'RawsBHRC2', ST_GeomFromText('POINT(-106.2011 37.0208)',4326), null, null, '2021-03-12T16:38:02.635456Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('c44836e6-28b2-409c-8337-46bfc226884f', False, 'rawsblpc2', 'RawsBLPC2', ST_GeomFromText('POINT(-106.7786 37.7931)',4326), null, null, '2021-03-12T16:38:02.635749Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('023cace5-0c4d-4cc5-aa22-9e6dcf0b6b7c', False, 'rawsblwn5', 'RawsBLWN5', ST_GeomFromText('POINT(-108.1631 35.1942)',4326), null, null, '2021-03-12T16:38:02.636081Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('05ea4c64-7abc-484c-bece-5c77ca3c0ada', False, 'rawsbmoc2', 'RawsBMOC2', ST_GeomFromText('POINT(-105.2842 37.8614)',4326), null, null, '2021-03-12T16:38:02.636385Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('9cbb3dff-fc85-442a-9233-b3aced2b5161', False, 'rawsbnht2', 'RawsBNHT2', ST_GeomFromText('POINT(-101.1578 30.9856)',4326), null, null, '2021-03-12T16:38:02.636679Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('ca895b76-6cd7-4927-a040-ecd0a3083a06', False, 'rawsbvrn5', 'RawsBVRN5', ST_GeomFromText('POINT(-108.11 33.4236)',4326), null, null, '2021-03-12T16:38:02.636972Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('61947684-6df7-4fc5-a5d9-8ab45713b91b', False, 'rawscbbn5', 'RawsCBBN5', ST_GeomFromText('POINT(-107.0772 35.9419)',4326), null, null, '2021-03-12T16:38:02.637318Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('3756cb99-24c2-463d-b7ea-8f56241a7341', False, 'rawsccec2', 'RawsCCEC2', ST_GeomFromText('POINT(-104.0319 37.5425)',4326), null, null, '2021-03-12T16:38:02.637614Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('bf5c10f4-dd44-4f47-9bcf-680719187b0e', False, 'rawsccyc2', 'RawsCCYC2', ST_GeomFromText('POINT(-104.8692 37.9478)',4326), null, null, '2021-03-12T16:38:02.637958Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('454a2cce-ed98-49b1-8d1f-aa021858ccd8', False, 'rawscglk1', 'RawsCGLK1', ST_GeomFromText('POINT(-101.9014 37.1408)',4326), null, null, '2021-03-12T16:38:02.638264Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('987c8139-2c31-4319-b294-e87bf91bea26', False, 'rawscgrc2', 'RawsCGRC2', ST_GeomFromText('POINT(-105.4519 38.3456)',4326), null, null, '2021-03-12T16:38:02.638558Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('b30917a9-66da-422a-8f0f-8987406bbbcc', False, 'rawschac2', 'RawsCHAC2', ST_GeomFromText('POINT(-108.4892 37.1994)',4326), null, null, '2021-03-12T16:38:02.638857Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('30802dde-2319-4f37-8196-87708d492096', False, 'rawscimn5', 'RawsCIMN5', ST_GeomFromText('POINT(-105.1203 36.6061)',4326), null, null, '2021-03-12T16:38:02.639176Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('2d280101-f2c1-4db3-84d3-b9387c63a00a', False, 'rawscopn5', 'RawsCOPN5', ST_GeomFromText('POINT(-105.6861 33.3503)',4326), null, null, '2021-03-12T16:38:02.639475Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('ca49d20e-c8a4-4564-be3f-c67608efa0b6', False, 'rawscoyn5', 'RawsCOYN5', ST_GeomFromText('POINT(-106.6572 36.0731)',4326), null, null, '2021-03-12T16:38:02.639766Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('d5aac1c4-1a01-4dae-8cc8-ff03ce24f4b5', False, 'rawscppc2', 'RawsCPPC2', ST_GeomFromText('POINT(-105.2675 38.7422)',4326), null, null, '2021-03-12T16:38:02.640057Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('4f3e96b9-1e62-4b12-951f-118e77d5cc05', False, 'rawscppn5', 'RawsCPPN5', ST_GeomFromText('POINT(-103.8567 32.9278)',4326), null, null, '2021-03-12T16:38:02.640350Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('bab3961a-a572-48c9-98ea-a5b3bb353f00', False, 'rawscsmn5', 'RawsCSMN5', ST_GeomFromText('POINT(-105.82 32.7792)',4326), null, null, '2021-03-12T16:38:02.640644Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('8a7a53cf-383a-4a54-90ab-25b3125f3b43', False, 'rawscuhc2', 'RawsCUHC2', ST_GeomFromText('POINT(-104.9194 37.0919)',4326), null, null, '2021-03-12T16:38:02.640934Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('73318e0b-898e-48f9-8bce-b5aa61914a4a', False, 'rawscwrn5', 'RawsCWRN5', ST_GeomFromText('POINT(-107.6289 36.9767)',4326), null, null, '2021-03-12T16:38:02.641281Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('862ec060-a496-4d82-85d6-fe782601f5cf', False, 'rawscync2', 'RawsCYNC2', ST_GeomFromText('POINT(-105.1181 38.0167)',4326), null, null, '2021-03-12T16:38:02.641581Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('04a89f33-5146-40bf-844a-9ca1c44d3dc0', False, 'rawsdgct2', 'RawsDGCT2', ST_GeomFromText('POINT(-104.8339 31.9961)',4326), null, null, '2021-03-12T16:38:02.641886Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('6e38fe0f-a357-4f4a-b128-5feeaff7ea2e', False, 'rawsdlcn5', 'RawsDLCN5', ST_GeomFromText('POINT(-107.0 36.9353)',4326), null, null, '2021-03-12T16:38:02.642204Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('c85ea641-8912-4251-9a7f-812fe97c5c0c', False, 'rawsdpkn5', 'RawsDPKN5', ST_GeomFromText('POINT(-106.7719 36.4228)',4326), null, null, '2021-03-12T16:38:02.642498Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('31c8f673-c7d8-4ee4-98e2-df4ef8fb7b58', False, 'rawsdprn5', 'RawsDPRN5', ST_GeomFromText('POINT(-108.0617 33.6939)',4326), null, null, '2021-03-12T16:38:02.642792Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('856b4b09-c1a8-4509-9b1c-cb32901305d9', False, 'rawsdtln5', 'RawsDTLN5', ST_GeomFromText('POINT(-107.7681 34.2925)',4326), null, null, '2021-03-12T16:38:02.643143Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('2b47400a-93eb-4c0c-9802-cf5b5289c7c1', False, 'rawsdufc2', 'RawsDUFC2', ST_GeomFromText('POINT(-108.3267 37.4942)',4326), null, null, '2021-03-12T16:38:02.643527Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('939b1f14-7c6d-4a5c-8463-06827035ee35', False, 'rawsdunn5', 'RawsDUNN5', ST_GeomFromText('POINT(-105.1825 32.8247)',4326), null, null, '2021-03-12T16:38:02.643821Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('4bc937ba-d310-4758-b720-47861bacd21c', False, 'rawsdykc2', 'RawsDYKC2', ST_GeomFromText('POINT(-107.3053 37.2269)',4326), null, null, '2021-03-12T16:38:02.644119Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('f9916e28-ff68-431c-a406-5584bacf19ea', False, 'rawsfcrc2', 'RawsFCRC2', ST_GeomFromText('POINT(-104.8539 38.6589)',4326), null, null, '2021-03-12T16:38:02.644415Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('4ffeedba-ff2f-47ad-ba12-bf152a4a51ae', False, 'rawsfdst2', 'RawsFDST2', ST_GeomFromText('POINT(-103.8867 30.6006)',4326), null, null, '2021-03-12T16:38:02.644708Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('0c5ba1ee-2c0f-4099-96bb-c843ad5ba764', False, 'rawsgdbt2', 'RawsGDBT2', ST_GeomFromText('POINT(-104.8253 31.925)',4326), null, null, '2021-03-12T16:38:02.645060Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('eaa8af96-c2f5-4e43-9cd2-fe2478f040dd', False, 'rawsgmln5', 'RawsGMLN5', ST_GeomFromText('POINT(-108.1744 34.8519)',4326), null, null, '2021-03-12T16:38:02.645384Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('921b3aa3-634b-4d4b-b9bb-e7fe8f11dfaa', False, 'rawsgnmn5', 'RawsGNMN5', ST_GeomFromText('POINT(-108.2403 33.2236)',4326), null, null, '2021-03-12T16:38:02.645677Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('d75b286e-f562-45ad-9a74-f4397ff67909', False, 'rawsgrsn5', 'RawsGRSN5', ST_GeomFromText('POINT(-107.6653 35.2428)',4326), null, null, '2021-03-12T16:38:02.646014Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('f8fc2d93-7656-4c64-ab41-8dc3932b9eb5', False, 'rawsgscn5', 'RawsGSCN5', ST_GeomFromText('POINT(-105.4619 35.8939)',4326), null, null, '2021-03-12T16:38:02.646316Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('27fa1fa8-6b16-4f63-9daa-5d45c7de0c9c', False, 'rawshhrn5', 'RawsHHRN5', ST_GeomFromText('POINT(-108.3417 31.7019)',4326), null, null, '2021-03-12T16:38:02.646608Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('ca82fc36-c3d5-428e-8b1b-255a6c426f99', False, 'rawsjarn5', 'RawsJARN5', ST_GeomFromText('POINT(-106.1031 36.5558)',4326), null, null, '2021-03-12T16:38:02.646896Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('6facbc19-2547-4d46-9a9f-09f7bfbd1af2', False, 'rawsjesn5', 'RawsJESN5', ST_GeomFromText('POINT(-106.6197 35.8406)',4326), null, null, '2021-03-12T16:38:02.647225Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('85b3ee47-59e5-4e55-affd-a3de76a174d4', False, 'rawslomn5', 'RawsLOMN5', ST_GeomFromText('POINT(-106.5867 32.3222)',4326), null, null, '2021-03-12T16:38:02.647524Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('51a37c58-4b7a-4ebd-a08c-76c92dde666e', False, 'rawslpfc2', 'RawsLPFC2', ST_GeomFromText('POINT(-106.3497 39.1536)',4326), null, null, '2021-03-12T16:38:02.647818Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('f8a5d3ba-1dbf-4068-bcf7-f1b1ffa40794', False, 'rawslujc2', 'RawsLUJC2', ST_GeomFromText('POINT(-106.5678 38.2547)',4326), null, null, '2021-03-12T16:38:02.648137Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('ce46db90-2d9e-4da6-bdf7-13b18ab38b71', False, 'rawslvpn5', 'RawsLVPN5', ST_GeomFromText('POINT(-105.4942 35.5458)',4326), null, null, '2021-03-12T16:38:02.648432Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('1cd82b2e-69c7-4d94-a111-b8e7fe237f28', False, 'rawsmayn5', 'RawsMAYN5', ST_GeomFromText('POINT(-105.4697 32.9097)',4326), null, null, '2021-03-12T16:38:02.648725Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('9d07a839-d35e-49c1-9bae-5a9cd93b3fb6', False, 'rawsmcrn5', 'RawsMCRN5', ST_GeomFromText('POINT(-105.6217 32.4314)',4326), null, null, '2021-03-12T16:38:02.649063Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('0862e881-e903-4b26-8c0d-4a6931353c36', False, 'rawsmgln5', 'RawsMGLN5', ST_GeomFromText('POINT(-107.5392 33.8511)',4326), null, null, '2021-03-12T16:38:02.649392Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('1cb3de4e-53e2-4f19-93da-d077e8da87e3', False, 'rawsmgnn5', 'RawsMGNN5', ST_GeomFromText('POINT(-108.8067 33.3906)',4326), null, null, '2021-03-12T16:38:02.649693Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('6b86394a-c3c6-45d0-93dd-fffc67cba975', False, 'rawsmlcn5', 'RawsMLCN5', ST_GeomFromText('POINT(-104.3244 36.055)',4326), null, null, '2021-03-12T16:38:02.650003Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('be4946e4-fbad-4726-b7f0-769d4f5e86d8', False, 'rawsmmrc2', 'RawsMMRC2', ST_GeomFromText('POINT(-107.7086 37.0564)',4326), null, null, '2021-03-12T16:38:02.650301Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('12991958-6c69-4ccf-bef5-42589a9b15d9', False, 'rawsmndt2', 'RawsMNDT2', ST_GeomFromText('POINT(-102.1897 31.9431)',4326), null, null, '2021-03-12T16:38:02.650593Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('396f9a8e-ae0a-460f-8431-acd791b70bcd', False, 'rawsmpan5', 'RawsMPAN5', ST_GeomFromText('POINT(-103.7217 32.1797)',4326), null, null, '2021-03-12T16:38:02.650888Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('9675c12a-f1ed-49d1-b0e8-6e71eabc8bc1', False, 'rawsmrfc2', 'RawsMRFC2', ST_GeomFromText('POINT(-108.4128 37.2972)',4326), null, null, '2021-03-12T16:38:02.651187Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('4658ab6a-b4a1-4526-9c5b-f664204a64a7', False, 'rawsmrrn5', 'RawsMRRN5', ST_GeomFromText('POINT(-103.8 34.3)',4326), null, null, '2021-03-12T16:38:02.651486Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('3ef35229-0d54-49f6-a6a3-820bc76c5821', False, 'rawsmscn5', 'RawsMSCN5', ST_GeomFromText('POINT(-105.7689 33.1581)',4326), null, null, '2021-03-12T16:38:02.651780Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('a24ea0d0-0247-45fc-a7c1-b59d9f2fc720', False, 'rawsmtnn5', 'RawsMTNN5', ST_GeomFromText('POINT(-106.2617 34.5206)',4326), null, null, '2021-03-12T16:38:02.652112Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('12a721b2-2f96-4290-9601-d56d5fb9236c', False, 'rawspsgt2', 'RawsPSGT2', ST_GeomFromText('POINT(-104.7978 31.8944)',4326), null, null, '2021-03-12T16:38:02.652415Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('daf010e8-8b3b-43e0-a336-6071197030c8', False, 'rawspxwt2', 'RawsPXWT2', ST_GeomFromText('POINT(-104.9478 31.9722)',4326), null, null, '2021-03-12T16:38:02.652710Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('aa35a702-7a97-4047-b306-08c948439811', False, 'rawsqenn5', 'RawsQENN5', ST_GeomFromText('POINT(-104.6903 32.2036)',4326), null, null, '2021-03-12T16:38:02.653048Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('ddc38e7f-4a58-44ab-ace6-7c67edaebc06', False, 'rawsrddc2', 'RawsRDDC2', ST_GeomFromText('POINT(-104.9978 38.2072)',4326), null, null, '2021-03-12T16:38:02.653366Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('a9c88d46-f1ec-4c46-8e6d-af84065c09ba', False, 'rawsrdkc2', 'RawsRDKC2', ST_GeomFromText('POINT(-106.2117 38.8272)',4326), null, null, '2021-03-12T16:38:02.653658Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('5dccf082-db16-46ac-ae5c-90b32019a68e', False, 'rawsremn5', 'RawsREMN5', ST_GeomFromText('POINT(-104.3211 33.6506)',4326), null, null, '2021-03-12T16:38:02.653983Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('9fd62c44-047a-4218-a8cb-8eb1756312d8', False, 'rawsrgmn5', 'RawsRGMN5', ST_GeomFromText('POINT(-106.6808 35.1458)',4326), null, null, '2021-03-12T16:38:02.654297Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('7d6c55d0-65a4-4b0b-9c26-2b20fae116d9', False, 'rawsrrac2', 'RawsRRAC2', ST_GeomFromText('POINT(-104.9528 38.9586)',4326), null, null, '2021-03-12T16:38:02.654631Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('16608e22-cf8f-4521-a08f-4645ce77b13c', False, 'rawssdnc2', 'RawsSDNC2', ST_GeomFromText('POINT(-105.5108 37.7267)',4326), null, null, '2021-03-12T16:38:02.655055Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('f790761c-d4c2-49a7-8502-3f9a92afdec3', False, 'rawssdvc2', 'RawsSDVC2', ST_GeomFromText('POINT(-107.3028 37.0994)',4326), null, null, '2021-03-12T16:38:02.655599Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('03863286-f239-40a2-b36e-255529975907', False, 'rawssevn5', 'RawsSEVN5', ST_GeomFromText('POINT(-106.7981 34.3769)',4326), null, null, '2021-03-12T16:38:02.656136Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('601bfae9-d1be-46a3-9df7-b803a1555771', False, 'rawssfwn5', 'RawsSFWN5', ST_GeomFromText('POINT(-105.8603 35.6869)',4326), null, null, '2021-03-12T16:38:02.656517Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('58ef4527-e228-4b61-aef8-16125fe7c88a', False, 'rawssidc2', 'RawsSIDC2', ST_GeomFromText('POINT(-106.17 38.5933)',4326), null, null, '2021-03-12T16:38:02.657000Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('9247b6ba-c858-4378-b143-2b297f7a652e', False, 'rawsslmn5', 'RawsSLMN5', ST_GeomFromText('POINT(-108.4447 34.0606)',4326), null, null, '2021-03-12T16:38:02.657552Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('9a1f51bf-529e-49cc-baa0-4e8e2ef09a78', False, 'rawssndn5', 'RawsSNDN5', ST_GeomFromText('POINT(-106.525 32.58)',4326), null, null, '2021-03-12T16:38:02.658174Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('459b75d2-08fc-4263-a9f3-13eddb1dd5c2', False, 'rawssnln5', 'RawsSNLN5', ST_GeomFromText('POINT(-106.5906 35.2297)',4326), null, null, '2021-03-12T16:38:02.658765Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('4ca28ed0-1aa5-482f-9053-e20d243e6274', False, 'rawsstln5', 'RawsSTLN5', ST_GeomFromText('POINT(-106.8658 36.7317)',4326), null, null, '2021-03-12T16:38:02.659354Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('ec40d25a-6146-40c7-a47f-68e5c8331682', False, 'rawstjrn5', 'RawsTJRN5', ST_GeomFromText('POINT(-106.3158 35.0061)',4326), null, null, '2021-03-12T16:38:02.659885Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('33722e5f-a61e-48e3-892e-d4efe7d09629', False, 'rawstrun5', 'RawsTRUN5', ST_GeomFromText('POINT(-105.7694 36.0589)',4326), null, null, '2021-03-12T16:38:02.660489Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('f3c583c2-06bc-4e24-8dbb-a1106808db90', False, 'rawstwrn5', 'RawsTWRN5', ST_GeomFromText('POINT(-106.2661 35.7789)',4326), null, null, '2021-03-12T16:38:02.661106Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('1adc9d9a-bd66-43e3-860f-276921d37334', False, 'rawsucnc2', 'RawsUCNC2', ST_GeomFromText('POINT(-102.6303 37.0608)',4326), null, null, '2021-03-12T16:38:02.661703Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('1e625b98-c282-48cd-8624-9461fad5cdbc', False, 'rawswlcc2', 'RawsWLCC2', ST_GeomFromText('POINT(-105.0561 38.0028)',4326), null, null, '2021-03-12T16:38:02.662314Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('e0260f95-9a88-42da-b526-a221a38e4206', False, 'rawswpsn5', 'RawsWPSN5', ST_GeomFromText('POINT(-108.8608 36.0767)',4326), null, null, '2021-03-12T16:38:02.662925Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('fa2cdac9-3943-44f6-899e-dacb04981a15', False, 'rawsznrn5', 'RawsZNRN5', ST_GeomFromText('POINT(-108.9414 35.1392)',4326), null, null, '2021-03-12T16:38:02.663502Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('3517a70b-ad04-44e3-b67f-3ce74ff08a33', False, 'wightman-fork', 'Wightman Fork', ST_GeomFromText('POINT(-106.5217 37.4039)',4326), null, null, '2021-03-12T16:38:02.664109Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('3bd612a4-8022-48a7-8530-5948cb3840b2', False, 'ft-garland-s-d-c', 'FT Garland S D C', ST_GeomFromText('POINT(-105.415 37.425)',4326), null, null, '2021-03-12T16:38:02.664265Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('4017d022-5e29-4240-9695-fcb75929a23a', False, 'costilla-us', 'Costilla US', ST_GeomFromText('POINT(-105.2547 36.8984)',4326), null, null, '2021-03-12T16:38:02.664415Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08252500'),
+('5f3f6678-5788-4d43-a029-f835f0db6152', False, 'costilla-santvan', 'Costilla Santvan', ST_GeomFromText('POINT(-105.2811 36.8842)',4326), null, null, '2021-03-12T16:38:02.664592Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08253500'),
+('f5ecc69d-6937-47a2-b8fc-7a87a6cd9879', False, 'cundiyo', 'Cundiyo', ST_GeomFromText('POINT(-105.9047 35.9647)',4326), null, null, '2021-03-12T16:38:02.664773Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08291000'),
+('b3eb94c6-c0a0-4342-b061-c63ee6efbf5e', False, 'narrows_8023', 'Narrows', ST_GeomFromText('POINT(-107.1625 33.3861)',4326), null, null, '2021-03-12T16:38:02.664940Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08359500'),
+('d5c44ac0-6a0d-42b2-9955-1c16afb1af63', False, 'santa-fe', 'Santa Fe', ST_GeomFromText('POINT(-105.8436 35.6864)',4326), null, null, '2021-03-12T16:38:02.665139Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08316000'),
+('a25a4449-464d-4a24-b847-1c625d5bd1f4', False, 'nichols', 'Nichols', ST_GeomFromText('POINT(-105.88 35.6894)',4326), null, null, '2021-03-12T16:38:02.665316Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08316500'),
+('f7065efc-b02b-405b-8019-1c5da8c0ff4b', False, 'prairie-dtch', 'Prairie Dtch', ST_GeomFromText('POINT(-106.2315 37.6455)',4326), null, null, '2021-03-12T16:38:02.665516Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('25b78426-4251-44f2-a9e8-8f2d1c087391', False, 'dryden', 'Dryden', ST_GeomFromText('POINT(-102.1667 29.8236)',4326), null, null, '2021-03-12T16:38:02.665670Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('cb71d0c9-896f-4eb7-9f38-76ab619d84e2', False, 'foster-ranch', 'Foster Ranch', ST_GeomFromText('POINT(-101.7556 29.7806)',4326), null, null, '2021-03-12T16:38:02.665887Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('76a5ff46-43e7-4e6c-8d82-adaff0c6532a', False, 'summitville', 'Summitville', ST_GeomFromText('POINT(-106.5842 37.4292)',4326), null, null, '2021-03-12T16:38:02.666087Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('604b9f65-7428-4969-97b9-3ffcbc6db6de', False, 'terlingua', 'Terlingua', ST_GeomFromText('POINT(-103.6194 29.2028)',4326), null, null, '2021-03-12T16:38:02.666224Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('136e4f36-3e32-43ea-b36f-86a9171a43d5', False, 'ft-garland-ute', 'Ft Garland Ute', ST_GeomFromText('POINT(-105.4258 37.4471)',4326), null, null, '2021-03-12T16:38:02.666405Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('31ac80a7-c7f3-4301-8278-af29094be8a2', False, 'el-paso', 'El Paso', ST_GeomFromText('POINT(-106.5403 31.8028)',4326), null, null, '2021-03-12T16:38:02.666589Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('587e04dc-06ca-4449-b0d9-93da048bf709', False, 'castolon', 'Castolon', ST_GeomFromText('POINT(-103.5244 29.1378)',4326), null, null, '2021-03-12T16:38:02.666770Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08374550'),
+('f3614e83-c491-4b7a-8e10-446126da3376', False, 'talpa', 'Talpa', ST_GeomFromText('POINT(-105.5811 36.3031)',4326), null, null, '2021-03-12T16:38:02.666975Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08275500'),
+('b81194cd-042f-44a0-aba0-a24968250988', False, 'cochiti-santa-fe', 'Cochiti Santa Fe', ST_GeomFromText('POINT(-106.2289 35.5472)',4326), null, null, '2021-03-12T16:38:02.667166Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08317200'),
+('83945f90-ec45-47b9-9947-dbe0a7c2e2d8', False, 'fishtail-rd', 'Fishtail rd', ST_GeomFromText('POINT(-106.4708 36.7381)',4326), null, null, '2021-03-12T16:38:02.667345Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08282300'),
+('eb259ebd-cd32-4453-9610-b4054854149f', False, 'romero-dtch', 'Romero Dtch', ST_GeomFromText('POINT(-106.0384 37.0869)',4326), null, null, '2021-03-12T16:38:02.667525Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('b3ff4763-9049-40f8-9415-1996017e075d', False, 'manassa-dtch', 'Manassa Dtch', ST_GeomFromText('POINT(-106.0054 37.1054)',4326), null, null, '2021-03-12T16:38:02.667698Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('e06454a3-d78b-46eb-92cd-a7a20bd1c7c1', False, 'san-luis-val-cn', 'San Luis Val Cn', ST_GeomFromText('POINT(-106.0513 37.5767)',4326), null, null, '2021-03-12T16:38:02.667850Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('31da0de0-c862-4053-916b-ac078d4cdb30', False, 'prwp-dtch', 'PRWP Dtch', ST_GeomFromText('POINT(-107.3214 37.6669)',4326), null, null, '2021-03-12T16:38:02.667981Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('1933fb0b-9a03-48b9-9f7f-bda8c64b18ea', False, 'south-fork-bear', 'South Fork Bear', ST_GeomFromText('POINT(-106.6082 37.6798)',4326), null, null, '2021-03-12T16:38:02.668120Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('a50a824b-a252-4b38-84a7-12cd0874624c', False, 'bluewater', 'Bluewater', ST_GeomFromText('POINT(-108.1114 35.2925)',4326), null, null, '2021-03-12T16:38:02.668264Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08341400'),
+('05c7af91-9cf5-40dc-8d7c-98d60ff272b3', False, 'conejos', 'Conejos', ST_GeomFromText('POINT(-106.0069 37.1015)',4326), null, null, '2021-03-12T16:38:02.668474Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('d5aa8dbc-a688-46f1-af71-319d6ee3bcbe', False, 'monte-vista-cn', 'Monte Vista Cn', ST_GeomFromText('POINT(-106.222 37.616)',4326), null, null, '2021-03-12T16:38:02.668621Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('0b03ec20-1ddf-4311-9b2e-adda6a58cc2a', False, 'mountain-home', 'Mountain Home', ST_GeomFromText('POINT(-105.3936 37.3931)',4326), null, null, '2021-03-12T16:38:02.668758Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('4266e3a1-1c11-4e9d-8523-b4ef63f5f4d8', False, 'mountain-home-ds', 'Mountain Home DS', ST_GeomFromText('POINT(-105.395 37.394)',4326), null, null, '2021-03-12T16:38:02.668758Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('6d389011-7c6d-426e-9fe4-ecfede494a19', False, 'jasper-ds', 'Jasper DS', ST_GeomFromText('POINT(-106.3773 37.3889)',4326), null, null, '2021-03-12T16:38:02.669257Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('a00129dc-e7c1-4c53-ab4f-c01560a15524', False, 'jasper-us', 'Jasper US', ST_GeomFromText('POINT(-106.522 37.4025)',4326), null, null, '2021-03-12T16:38:02.669465Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('44a550b7-cc43-40da-a065-5a7129935f03', False, 'marshall-park-cg', 'Marshall Park CG', ST_GeomFromText('POINT(-106.9819 37.7932)',4326), null, null, '2021-03-12T16:38:02.669669Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('f25d66e2-ae55-48e0-b7be-8015db572cfa', False, 'humphreys-us', 'Humphreys US', ST_GeomFromText('POINT(-106.8593 37.6764)',4326), null, null, '2021-03-12T16:38:02.669849Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('c8bdaa72-3037-4edc-88d2-3340871597a3', False, 'terrace', 'Terrace', ST_GeomFromText('POINT(-106.285 37.3572)',4326), null, null, '2021-03-12T16:38:02.670096Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('f6f3bb48-aa8c-432e-9aae-b55d17dd378c', False, 'conejos-n-b', 'Conejos N B', ST_GeomFromText('POINT(-106.0091 37.1018)',4326), null, null, '2021-03-12T16:38:02.670233Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('56bbfc9a-eafa-4ba5-b75a-f5d41fde3672', False, 'tabor-dtch', 'Tabor Dtch', ST_GeomFromText('POINT(-107.1587 37.8728)',4326), null, null, '2021-03-12T16:38:02.670360Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('e3fd4deb-b0a4-4f82-94d7-901b41e942c0', False, 'beaver-creek-ds', 'Beaver Creek DS', ST_GeomFromText('POINT(-106.6675 37.5989)',4326), null, null, '2021-03-12T16:38:02.670488Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('a1071415-17ef-4dcb-a2cd-295c296b5788', False, 'wagon-wg-goose', 'Wagon WG Goose', ST_GeomFromText('POINT(-106.83 37.7519)',4326), null, null, '2021-03-12T16:38:02.670620Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('93abc317-d62e-47a8-86c6-6b5e1b04f1b6', False, 'del-norte-san-fr', 'Del Norte San Fr', ST_GeomFromText('POINT(-106.3702 37.5793)',4326), null, null, '2021-03-12T16:38:02.670745Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('86b08923-5955-434c-9bf5-ac597bd81a05', False, 'hayner-bridge', 'Hayner Bridge', ST_GeomFromText('POINT(-107.0194 32.6133)',4326), null, null, '2021-03-12T16:38:02.670887Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('b2578e05-2951-452c-ac1d-d4f1a7bfec58', False, 'anthony', 'Anthony', ST_GeomFromText('POINT(-106.6014 31.915)',4326), null, null, '2021-03-12T16:38:02.671073Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('46e533be-0a2a-48ac-ac07-9bead5781ed6', False, 'american-cn', 'American Cn', ST_GeomFromText('POINT(-106.5264 31.7778)',4326), null, null, '2021-03-12T16:38:02.671264Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('1f71277c-eee5-4ab3-8461-014aa9b867e1', False, 'ft-quitman', 'Ft Quitman', ST_GeomFromText('POINT(-105.6083 31.0861)',4326), null, null, '2021-03-12T16:38:02.671398Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('c8466012-f149-4b8e-8f78-96695159c3a6', False, 'candelaria', 'Candelaria', ST_GeomFromText('POINT(-104.6861 30.175)',4326), null, null, '2021-03-12T16:38:02.671578Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('0a91b682-818d-4f21-8963-7ebd95afbd14', False, 'presidio-us', 'Presidio US', ST_GeomFromText('POINT(-104.4514 29.6042)',4326), null, null, '2021-03-12T16:38:02.671769Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('03de996a-2d06-43c4-b8d1-57cb133229e0', False, 'presidio', 'Presidio', ST_GeomFromText('POINT(-104.3936 29.5578)',4326), null, null, '2021-03-12T16:38:02.671973Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('898f9bc8-c6c3-44cb-af18-532f19e4a08f', False, 'american-ds', 'American DS', ST_GeomFromText('POINT(-106.5222 31.7761)',4326), null, null, '2021-03-12T16:38:02.672165Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('3b191d6e-94ce-4ef5-9994-6b33962d058a', False, 'mesilla-ds', 'Mesilla DS', ST_GeomFromText('POINT(-106.7717 32.2103)',4326), null, null, '2021-03-12T16:38:02.672311Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('df392add-ebfc-4ed7-bff8-fae8f77d112a', False, 'rio-conchos', 'Rio Conchos', ST_GeomFromText('POINT(-104.43 29.5833)',4326), null, null, '2021-03-12T16:38:02.672450Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('cda6dcd2-6a2a-4028-820e-8f79802f7e3d', False, 'leasburg-ds', 'Leasburg DS', ST_GeomFromText('POINT(-106.9194 32.4764)',4326), null, null, '2021-03-12T16:38:02.672701Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('eaddad50-8ebc-4606-8f26-e961432f4ae6', False, 'presidio-alamito', 'Presidio Alamito', ST_GeomFromText('POINT(-104.2875 29.5236)',4326), null, null, '2021-03-12T16:38:02.672912Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('bf22c204-ea8d-45c1-a8c5-259ff957db02', False, 'sanchez-res', 'Sanchez Res', ST_GeomFromText('POINT(-105.4125 37.1136)',4326), null, null, '2021-03-12T16:38:02.673107Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('ced53be8-401b-4345-8c96-b1625d0307f9', False, 'sanchez-res-ds', 'Sanchez Res DS', ST_GeomFromText('POINT(-105.4092 37.1143)',4326), null, null, '2021-03-12T16:38:02.673107Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('dd5cff09-5142-4838-80ec-7d24b4c698b6', False, 'rio-grande-res', 'Rio Grande Res', ST_GeomFromText('POINT(-107.2676 37.7219)',4326), null, null, '2021-03-12T16:38:02.673399Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('7475abe3-6e7a-41ca-8d96-afd8de71db1d', False, 'johnson-ranch', 'Johnson Ranch', ST_GeomFromText('POINT(-103.3903 29.0347)',4326), null, null, '2021-03-12T16:38:02.673547Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('5a4569ee-469e-4906-b731-9089439801ca', False, 'presidio-ds', 'Presidio DS', ST_GeomFromText('POINT(-104.2864 29.5192)',4326), null, null, '2021-03-12T16:38:02.673753Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('6cc641b6-61bd-4cdd-84eb-deb0ec17251a', False, 'mcclure-us', 'McClure US', ST_GeomFromText('POINT(-105.8228 35.6889)',4326), null, null, '2021-03-12T16:38:02.673980Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08315480'),
+('04f355ed-1dd9-4b24-82f2-08e236f7fbef', False, 'tijeras-arroyo', 'Tijeras Arroyo', ST_GeomFromText('POINT(-106.6575 35.0019)',4326), null, null, '2021-03-12T16:38:02.674179Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08330775'),
+('c87044ed-1fc7-4056-bb6d-40193d3e831d', False, 'santa-fe-tesq', 'Santa Fe Tesq', ST_GeomFromText('POINT(-105.905 35.7394)',4326), null, null, '2021-03-12T16:38:02.674394Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08302500'),
+('58c731d0-ecda-4b8b-886b-cd11e588b9a5', False, 'costilla-ds', 'Costilla DS', ST_GeomFromText('POINT(-105.2837 36.8728)',4326), null, null, '2021-03-12T16:38:02.674598Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08254000'),
+('5f553631-029c-4feb-85e2-542566788358', False, 'south-fork-us', 'South Fork US', ST_GeomFromText('POINT(-106.7534 37.5744)',4326), null, null, '2021-03-12T16:38:02.674787Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('51c6b0b6-dab7-4ada-b4da-8a99697a52ae', False, 'box-canyon', 'Box Canyon', ST_GeomFromText('POINT(-107.1413 37.7746)',4326), null, null, '2021-03-12T16:38:02.675014Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('98e96565-7f39-402d-a52b-18777d9046ed', False, 'farmers-union-cn', 'Farmers Union Cn', ST_GeomFromText('POINT(-106.2639 37.6659)',4326), null, null, '2021-03-12T16:38:02.675223Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('a972dba7-8d15-490b-86bc-f2cd835dcd15', False, 'empire-cn', 'Empire Cn', ST_GeomFromText('POINT(-106.1055 37.5808)',4326), null, null, '2021-03-12T16:38:02.675381Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('00ba1c4c-bbe3-441e-8676-6771f33b59d2', False, 'garcia', 'Garcia', ST_GeomFromText('POINT(-105.5325 36.989)',4326), null, null, '2021-03-12T16:38:02.675529Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08261000'),
+('7192ce1e-0a53-469d-9f49-8858ab0581fa', False, 'chama', 'Chama', ST_GeomFromText('POINT(-106.5547 36.935)',4326), null, null, '2021-03-12T16:38:02.675743Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, '08281400'),
+('e5443c12-11cc-47f2-92a9-da5b48cddc82', False, 'norton-drain', 'Norton Drain', ST_GeomFromText('POINT(-105.7715 37.3346)',4326), null, null, '2021-03-12T16:38:02.675975Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('2840c724-f7bf-4ea3-b5f2-73b062b797e2', False, 'norton-drain-s', 'Norton Drain S', ST_GeomFromText('POINT(-105.8547 37.2986)',4326), null, null, '2021-03-12T16:38:02.676138Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('1194b404-fca5-42b6-91eb-7f3cc27352ba', False, 'mountain-home-us', 'Mountain Home US', ST_GeomFromText('POINT(-105.3689 37.3947)',4326), null, null, '2021-03-12T16:38:02.676338Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('7ea71af2-e591-46fc-99c8-eef4deb52639', False, 'costilla-dtch-al', 'Costilla Dtch Al', ST_GeomFromText('POINT(-105.9488 37.5527)',4326), null, null, '2021-03-12T16:38:02.676509Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('db49a894-d4ff-4210-8c1c-91d1c4ac4cbe', False, 'centennial-cn', 'Centennial Cn', ST_GeomFromText('POINT(-106.0704 37.5719)',4326), null, null, '2021-03-12T16:38:02.676668Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('cce86983-ac32-4f2b-88df-2853c8c2f469', False, 'rawsrget2', 'RawsRGET2', ST_GeomFromText('POINT(-102.9631 29.1858)',4326), null, null, '2021-03-12T16:38:02.676823Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('8f354680-2109-4cc1-aa88-0482b70d9575', False, 'langtry', 'Langtry', ST_GeomFromText('POINT(-101.4458 29.8028)',4326), null, null, '2021-03-12T16:38:02.677572Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null),
+('b84c692d-ecef-4afb-9e19-f10cce939efe', False, 'rawswdrn5', 'RawsWDRN5', ST_GeomFromText('POINT(-105.6689 36.695)',4326), null, null, '2021-03-12T16:38:02.677825Z', null, '98a61f29-18a8-430a-9d02-0f53486e0984', '57fc22a2-b536-45a3-afbb-e5d0e3a422c6', '00000000-0000-0000-0000-000000000000', null, null);
 
 --INSERT INSTRUMENT STATUS--
 INSERT INTO instrument_status(id, instrument_id, status_id, "time")
@@ -57,6 +57,14 @@ func CreateInstrumentAlertConfigs(db *sqlx.DB) echo.HandlerFunc {
 		p := c.Get("profile").(*models.Profile)
 		t := time.Now()
 		for idx := range ac.Items {
+			if err := models.ValidateAlertConfig(&ac.Items[idx]); err != nil {
+				return c.String(http.StatusBadRequest, err.Error())
+			}
+			if msg, err := alertConfigInstrumentMismatch(db, &instrumentID, &ac.Items[idx]); err != nil {
+				return c.String(http.StatusInternalServerError, err.Error())
+			} else if msg != "" {
+				return c.String(http.StatusBadRequest, msg)
+			}
 			ac.Items[idx].Creator, ac.Items[idx].CreateDate = p.ID, t
 		}
 		aa, err := models.CreateInstrumentAlertConfigs(db, &instrumentID, ac.Items)
@@ -85,6 +93,14 @@ func UpdateInstrumentAlertConfig(db *sqlx.DB) echo.HandlerFunc {
 		if err != nil {
 			return c.JSON(http.StatusBadRequest, err)
 		}
+		if err := models.ValidateAlertConfig(&alert); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		if msg, err := alertConfigInstrumentMismatch(db, &instrumentID, &alert); err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		} else if msg != "" {
+			return c.String(http.StatusBadRequest, msg)
+		}
 		// Profile and timestamp
 		p := c.Get("profile").(*models.Profile)
 		t := time.Now()
@@ -111,8 +127,164 @@ func DeleteInstrumentAlertConfig(db *sqlx.DB) echo.HandlerFunc {
 			return c.JSON(http.StatusBadRequest, err)
 		}
 		if err := models.DeleteInstrumentAlertConfig(db, &alertID, &instrumentID); err != nil {
-			return c.JSON(http.StatusInternalServerError, err)
+			return c.String(http.StatusInternalServerError, err.Error())
 		}
 		return c.JSON(http.StatusOK, make(map[string]interface{}))
 	}
 }
+
+// ListAlertConfigEvaluations lists the results of scheduled evaluations of an alert config
+// Optional query params: after, before (default last 7 days)
+func ListAlertConfigEvaluations(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		alertConfigID, err := uuid.Parse(c.Param("alert_config_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		var tw models.TimeWindow
+		if err := (&echo.DefaultBinder{}).BindQueryParams(c, &tw); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		// If after and before are not provided; Return last 7 days of evaluations from current time
+		if (tw.Before == time.Time{} && tw.After == time.Time{}) {
+			tw.Before = time.Now()
+			tw.After = tw.Before.AddDate(0, 0, -7)
+		}
+		ee, err := models.ListAlertEvaluations(db, &alertConfigID, &tw)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, ee)
+	}
+}
+
+// ListAlertConfigEmailDeliveries lists emails sent for alerts created by an alert config
+// Optional query params: after, before (default last 7 days)
+func ListAlertConfigEmailDeliveries(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		alertConfigID, err := uuid.Parse(c.Param("alert_config_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		var tw models.TimeWindow
+		if err := (&echo.DefaultBinder{}).BindQueryParams(c, &tw); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		// If after and before are not provided; Return last 7 days of deliveries from current time
+		if (tw.Before == time.Time{} && tw.After == time.Time{}) {
+			tw.Before = time.Now()
+			tw.After = tw.Before.AddDate(0, 0, -7)
+		}
+		dd, err := models.ListAlertEmailDeliveries(db, &alertConfigID, &tw)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, dd)
+	}
+}
+
+// backtestWindow binds the backtest time window from query params after and before (default last 30 days)
+func backtestWindow(c echo.Context) (*models.TimeWindow, error) {
+	var tw models.TimeWindow
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &tw); err != nil {
+		return nil, err
+	}
+	if (tw.Before == time.Time{}) {
+		tw.Before = time.Now()
+	}
+	if (tw.After == time.Time{}) {
+		tw.After = tw.Before.AddDate(0, 0, -30)
+	}
+	return &tw, nil
+}
+
+// BacktestAlertConfig replays an alert config over a historical window without creating alerts or sending notifications
+// Optional query params: after, before (default last 30 days)
+func BacktestAlertConfig(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		alertConfigID, ok, err := projectAlertConfigID(db, c)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if !ok {
+			return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+		}
+		tw, err := backtestWindow(c)
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		ac, err := models.GetAlertConfig(db, &alertConfigID)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		if err := models.ValidateAlertBacktest(ac, tw); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		b, err := models.BacktestAlertConfig(db, ac, tw)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, b)
+	}
+}
+
+// BacktestDraftAlertConfig replays an alert config that has not been saved over a historical window,
+// e.g. to tune thresholds before creating it. Optional query params: after, before (default last 30 days)
+func BacktestDraftAlertConfig(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		instrumentID, err := uuid.Parse(c.Param("instrument_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		var ac models.AlertConfig
+		if err := c.Bind(&ac); err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		if err := models.ValidateAlertConfig(&ac); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		if msg, err := alertConfigInstrumentMismatch(db, &instrumentID, &ac); err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		} else if msg != "" {
+			return c.String(http.StatusBadRequest, msg)
+		}
+		ac.InstrumentID = instrumentID
+		tw, err := backtestWindow(c)
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		if err := models.ValidateAlertBacktest(&ac, tw); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		b, err := models.BacktestAlertConfig(db, &ac, tw)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, b)
+	}
+}
+
+// alertConfigInstrumentMismatch returns a message if an alert config references a timeseries or instrument telemetry
+// that does not belong to the instrument, or "" if it does not; the permission to write alert configs is checked
+// on the instrument's project, so alert configs must not read data of other instruments
+func alertConfigInstrumentMismatch(db *sqlx.DB, instrumentID *uuid.UUID, ac *models.AlertConfig) (string, error) {
+	if ac.TimeseriesID != nil {
+		ok, err := models.TimeseriesBelongsToInstrument(db, ac.TimeseriesID, instrumentID)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return "timeseries_id does not belong to instrument", nil
+		}
+	}
+	if ac.InstrumentTelemetryID != nil {
+		ok, err := models.InstrumentTelemetryExists(db, instrumentID, ac.InstrumentTelemetryID)
+		if err != nil {
+			return "", err
+		}
+		if !ok {
+			return "instrument_telemetry_id does not belong to instrument", nil
+		}
+	}
+	return "", nil
+}
@@ -1,7 +1,12 @@
 package handlers
 
 import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"html"
 	"net/http"
+	"strings"
 
 	"github.com/USACE/instrumentation-api/models"
 
@@ -73,6 +78,9 @@ func UpdateMyAlertSubscription(db *sqlx.DB) echo.HandlerFunc {
 		if s.ID != sID {
 			return c.String(http.StatusBadRequest, "route parameter subscription_id does not match id in JSON payload")
 		}
+		if err := models.ValidateDeliveryMode(&s.DeliveryMode); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
 		// Get Profile
 		p := c.Get("profile").(*models.Profile)
 		// Verify Profile ID matches ProfileID of Subscription to be Modified
@@ -91,3 +99,249 @@ func UpdateMyAlertSubscription(db *sqlx.DB) echo.HandlerFunc {
 		return c.JSON(http.StatusOK, sUpdated)
 	}
 }
+
+// ListAlertEscalationSubscriptions lists profiles notified when alerts for an alert config are not acknowledged
+func ListAlertEscalationSubscriptions(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		alertConfigID, err := uuid.Parse(c.Param("alert_config_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		ss, err := models.ListAlertEscalationSubscriptions(db, &alertConfigID)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, ss)
+	}
+}
+
+// AddAlertEscalationSubscription adds a profile to the escalation subscribers for an alert config
+// Profiles that can not read the project are not added; escalation emails include alert details
+func AddAlertEscalationSubscription(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		alertConfigID, ok, err := projectAlertConfigID(db, c)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if !ok {
+			return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+		}
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		profileID, err := uuid.Parse(c.Param("profile_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		canRead, err := models.CanReadProjects(db, &models.Profile{ID: profileID}, []uuid.UUID{projectID})
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		if !canRead {
+			return c.String(http.StatusBadRequest, "profile can not read the project")
+		}
+		s, err := models.AddAlertEscalationSubscription(db, &alertConfigID, &profileID)
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		return c.JSON(http.StatusOK, s)
+	}
+}
+
+// RemoveAlertEscalationSubscription removes a profile from the escalation subscribers for an alert config
+func RemoveAlertEscalationSubscription(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		alertConfigID, err := uuid.Parse(c.Param("alert_config_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		profileID, err := uuid.Parse(c.Param("profile_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if err := models.RemoveAlertEscalationSubscription(db, &alertConfigID, &profileID); err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, make(map[string]interface{}))
+	}
+}
+
+// projectAlertConfigID parses the alert_config_id route parameter and checks that the alert config
+// belongs to the route instrument and project. Returns false if the alert config is not found
+func projectAlertConfigID(db *sqlx.DB, c echo.Context) (uuid.UUID, bool, error) {
+	projectID, err := uuid.Parse(c.Param("project_id"))
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	instrumentID, err := uuid.Parse(c.Param("instrument_id"))
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	alertConfigID, err := uuid.Parse(c.Param("alert_config_id"))
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	ok, err := models.AlertConfigExists(db, &projectID, &instrumentID, &alertConfigID)
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	return alertConfigID, ok, nil
+}
+
+// ListEmailAlertSubscriptions lists email addresses subscribed to an alert config
+func ListEmailAlertSubscriptions(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		alertConfigID, ok, err := projectAlertConfigID(db, c)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if !ok {
+			return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+		}
+		ss, err := models.ListEmailAlerts(db, &alertConfigID)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, ss)
+	}
+}
+
+// SubscribeEmailToAlerts subscribes an email address to an alert config
+func SubscribeEmailToAlerts(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		alertConfigID, ok, err := projectAlertConfigID(db, c)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if !ok {
+			return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+		}
+		var s models.EmailAlert
+		if err := c.Bind(&s); err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		if err := models.ValidateEmailAlert(&s); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		s.AlertConfigID = alertConfigID
+		sCreated, err := models.SubscribeEmailToAlerts(db, &s)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusCreated, sCreated)
+	}
+}
+
+// UpdateEmailAlertSubscription updates settings for an email subscription, i.e. mutes or unmutes notifications
+func UpdateEmailAlertSubscription(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		alertConfigID, ok, err := projectAlertConfigID(db, c)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if !ok {
+			return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+		}
+		sID, err := uuid.Parse(c.Param("email_subscription_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		var s models.EmailAlert
+		if err := c.Bind(&s); err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		if s.ID != sID {
+			return c.String(http.StatusBadRequest, "route parameter email_subscription_id does not match id in JSON payload")
+		}
+		if err := models.ValidateDeliveryMode(&s.DeliveryMode); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		s.AlertConfigID = alertConfigID
+		sUpdated, err := models.UpdateEmailAlert(db, &s)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, sUpdated)
+	}
+}
+
+// UnsubscribeEmailToAlerts removes an email subscription from an alert config
+func UnsubscribeEmailToAlerts(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		alertConfigID, ok, err := projectAlertConfigID(db, c)
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if !ok {
+			return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+		}
+		sID, err := uuid.Parse(c.Param("email_subscription_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if err := models.UnsubscribeEmailToAlerts(db, &alertConfigID, &sID); err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, make(map[string]interface{}))
+	}
+}
+
+// unsubscribePage is the page linked from alert emails; the form posts back to the same URL to unsubscribe
+const unsubscribePage = `<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>MIDAS Alert Unsubscribe</title></head>
+<body>
+<p>%s</p>
+%s
+</body>
+</html>
+`
+
+// GetEmailUnsubscribe shows the email subscription of an unsubscribe token sent in alert emails, with a form to
+// confirm unsubscribing. Nothing is changed, so links opened by mail scanners and previews do not unsubscribe
+// No authentication is required; the token identifies the subscription
+func GetEmailUnsubscribe(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		u, err := models.GetEmailUnsubscribe(db, c.Param("token"))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		alert := u.InstrumentName
+		if u.AlertName != nil {
+			alert = *u.AlertName + " (" + u.InstrumentName + ")"
+		}
+		message := fmt.Sprintf(
+			"Stop sending alerts for %s in project %s to %s?",
+			html.EscapeString(alert), html.EscapeString(u.ProjectName), html.EscapeString(u.Email),
+		)
+		return c.HTML(
+			http.StatusOK,
+			fmt.Sprintf(unsubscribePage, message, `<form method="post"><button type="submit">Unsubscribe</button></form>`),
+		)
+	}
+}
+
+// UnsubscribeEmailByToken removes an email subscription using the unsubscribe token sent in alert emails
+// Forms submitted from the unsubscribe page get a page in response; other requests get JSON
+// No authentication is required; the token identifies the subscription
+func UnsubscribeEmailByToken(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if err := models.UnsubscribeEmailByToken(db, c.Param("token")); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEApplicationForm) {
+			return c.HTML(http.StatusOK, fmt.Sprintf(unsubscribePage, "You have been unsubscribed.", ""))
+		}
+		return c.JSON(http.StatusOK, make(map[string]interface{}))
+	}
+}
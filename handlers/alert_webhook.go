@@ -0,0 +1,144 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/USACE/instrumentation-api/models"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/labstack/echo/v4"
+)
+
+// ListProjectAlertWebhooks lists alert webhooks for a project
+func ListProjectAlertWebhooks(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		ww, err := models.ListProjectAlertWebhooks(db, &projectID)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, ww)
+	}
+}
+
+// CreateAlertWebhook creates an alert webhook for a project, or for a single alert config in the project
+// The response includes the secret used to sign payloads; it is not returned again
+func CreateAlertWebhook(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		w := models.AlertWebhook{Enabled: true}
+		if err := c.Bind(&w); err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		if err := models.ValidateAlertWebhook(&w); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		p := c.Get("profile").(*models.Profile)
+		w.ProjectID, w.Creator, w.CreateDate = projectID, p.ID, time.Now()
+		wCreated, err := models.CreateAlertWebhook(db, &w)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.String(http.StatusBadRequest, "alert_config_id does not belong to project")
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusCreated, wCreated)
+	}
+}
+
+// UpdateAlertWebhook updates an alert webhook; the secret is replaced only if one is provided
+func UpdateAlertWebhook(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		webhookID, err := uuid.Parse(c.Param("alert_webhook_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		w := models.AlertWebhook{Enabled: true}
+		if err := c.Bind(&w); err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		if w.ID != webhookID {
+			return c.String(http.StatusBadRequest, "route parameter alert_webhook_id does not match id in JSON payload")
+		}
+		if err := models.ValidateAlertWebhook(&w); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		p := c.Get("profile").(*models.Profile)
+		t := time.Now()
+		w.ProjectID, w.Updater, w.UpdateDate = projectID, &p.ID, &t
+		wUpdated, err := models.UpdateAlertWebhook(db, &w)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, wUpdated)
+	}
+}
+
+// DeleteAlertWebhook deletes an alert webhook
+func DeleteAlertWebhook(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		webhookID, err := uuid.Parse(c.Param("alert_webhook_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if err := models.DeleteAlertWebhook(db, &projectID, &webhookID); err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, make(map[string]interface{}))
+	}
+}
+
+// ListAlertWebhookDeliveries lists the delivery log for an alert webhook
+func ListAlertWebhookDeliveries(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		webhookID, err := uuid.Parse(c.Param("alert_webhook_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if _, err := models.GetAlertWebhook(db, &projectID, &webhookID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		var tw models.TimeWindow
+		if err := (&echo.DefaultBinder{}).BindQueryParams(c, &tw); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		// If after and before are not provided; Return last 7 days of deliveries from current time
+		if (tw.Before == time.Time{} && tw.After == time.Time{}) {
+			tw.Before = time.Now()
+			tw.After = tw.Before.AddDate(0, 0, -7)
+		}
+		dd, err := models.ListAlertWebhookDeliveries(db, &webhookID, &tw)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, dd)
+	}
+}
@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"database/sql"
+	"errors"
 	"net/http"
 
 	"github.com/USACE/instrumentation-api/models"
@@ -71,3 +73,49 @@ func DoAlertUnread(db *sqlx.DB) echo.HandlerFunc {
 		return c.JSON(http.StatusOK, a)
 	}
 }
+
+// alertLifecycleFunc changes the status of an alert on behalf of a profile
+type alertLifecycleFunc func(db *sqlx.DB, instrumentID *uuid.UUID, alertID *uuid.UUID, profileID *uuid.UUID, comment *string) (*models.Alert, error)
+
+// alertLifecycleHandler returns a handler that changes the status of an alert using fn
+// Request body may include a comment, e.g. {"comment": "Field crew dispatched"}
+func alertLifecycleHandler(db *sqlx.DB, fn alertLifecycleFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		instrumentID, err := uuid.Parse(c.Param("instrument_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		alertID, err := uuid.Parse(c.Param("alert_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		var body struct {
+			Comment *string `json:"comment"`
+		}
+		if err := c.Bind(&body); err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		p := c.Get("profile").(*models.Profile)
+		a, err := fn(db, &instrumentID, &alertID, &p.ID, body.Comment)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
+			if errors.Is(err, models.ErrAlertStatus) {
+				return c.String(http.StatusConflict, err.Error())
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, a)
+	}
+}
+
+// AcknowledgeAlert acknowledges an open alert
+func AcknowledgeAlert(db *sqlx.DB) echo.HandlerFunc {
+	return alertLifecycleHandler(db, models.AcknowledgeAlert)
+}
+
+// ResolveAlert resolves an open or acknowledged alert
+func ResolveAlert(db *sqlx.DB) echo.HandlerFunc {
+	return alertLifecycleHandler(db, models.ResolveAlert)
+}
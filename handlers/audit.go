@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/USACE/instrumentation-api/models"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/labstack/echo/v4"
+)
+
+// actionFilter reads an audit log filter from the query parameters actor, entity_id, method, auth_method,
+// after, before (RFC3339) and limit
+func actionFilter(c echo.Context) (*models.ActionFilter, error) {
+	f := models.ActionFilter{Method: c.QueryParam("method"), AuthMethod: c.QueryParam("auth_method")}
+	for k, dst := range map[string]**uuid.UUID{"actor": &f.Actor, "entity_id": &f.EntityID} {
+		if v := c.QueryParam(k); v != "" {
+			id, err := uuid.Parse(v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: malformed ID", k)
+			}
+			*dst = &id
+		}
+	}
+	for k, dst := range map[string]**time.Time{"after": &f.After, "before": &f.Before} {
+		if v := c.QueryParam(k); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return nil, fmt.Errorf("%s: time must be RFC3339", k)
+			}
+			*dst = &t
+		}
+	}
+	if v := c.QueryParam("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 1 {
+			return nil, fmt.Errorf("limit must be a positive integer")
+		}
+		f.Limit = limit
+	}
+	return &f, nil
+}
+
+// ListProjectAuditLog lists actions on a project, most recent first
+func ListProjectAuditLog(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		f, err := actionFilter(c)
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		f.ProjectID = &projectID
+		aa, err := models.ListActions(db, f)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, aa)
+	}
+}
+
+// ListAuditLog lists all actions, including actions without a project, e.g. on profiles and tokens
+// The project_id query parameter filters actions on a project
+func ListAuditLog(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		f, err := actionFilter(c)
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		if v := c.QueryParam("project_id"); v != "" {
+			projectID, err := uuid.Parse(v)
+			if err != nil {
+				return c.String(http.StatusBadRequest, "Malformed ID")
+			}
+			f.ProjectID = &projectID
+		}
+		aa, err := models.ListActions(db, f)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, aa)
+	}
+}
@@ -20,7 +20,8 @@ func ListAwareParameters(db *sqlx.DB) echo.HandlerFunc {
 
 func ListAwarePlatformParameterConfig(db *sqlx.DB) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		cc, err := models.ListAwarePlatformParameterConfig(db)
+		p, _ := c.Get("profile").(*models.Profile)
+		cc, err := models.ListAwarePlatformParameterConfig(db, p)
 		if err != nil {
 			return c.String(http.StatusInternalServerError, err.Error())
 		}
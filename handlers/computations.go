@@ -1,7 +1,13 @@
 package handlers
 
 import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,29 +17,131 @@ import (
 	"github.com/USACE/instrumentation-api/models"
 )
 
-// ComputedTimeseries returns computed timeseries for a given instrument
-// This is an endpoint for debugging at this time
-func ComputedTimeseries(db *sqlx.DB) echo.HandlerFunc {
+// maxComputedTimesteps limits the number of regularized timesteps a single request may compute
+const maxComputedTimesteps = 100000
+
+// ListInstrumentComputedTimeseries returns computed timeseries for a given instrument,
+// including diagnostics for each timestep that could not be computed
+// Optional query params: after, before (default last 7 days), interval (default 1h),
+// method (carry_forward or interpolate; default carry_forward), format (json or csv; default json)
+func ListInstrumentComputedTimeseries(db *sqlx.DB) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		// Instrument ID
-		instrumentID, err := uuid.Parse("a7540f69-c41e-43b3-b655-6e44097edb7e")
+		projectID, err := uuid.Parse(c.Param("project_id"))
 		if err != nil {
-			return c.String(http.StatusBadRequest, err.Error())
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		instrumentID, err := uuid.Parse(c.Param("instrument_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		// Instrument must belong to the project in the route
+		instrumentProjectID, err := models.GetEntityProjectID(db, "instrument_id", instrumentID)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		if instrumentProjectID == nil || *instrumentProjectID != projectID {
+			return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
 		}
-		instrumentIDs := make([]uuid.UUID, 1)
-		instrumentIDs[0] = instrumentID
 		// Time Window
-		timeWindow := models.TimeWindow{
-			After:  time.Date(2020, 1, 3, 0, 0, 0, 0, time.UTC),
-			Before: time.Date(2021, 1, 5, 0, 0, 0, 0, time.UTC),
+		var tw models.TimeWindow
+		if err := (&echo.DefaultBinder{}).BindQueryParams(c, &tw); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
 		}
-		// Interval - Hard Code at 1 Hour
-		interval := time.Hour
-
-		tt, err := models.ComputedTimeseries(db, instrumentIDs, &timeWindow, &interval)
+		interval, method, err := computationOptions(&tw, c.QueryParam("interval"), c.QueryParam("method"))
 		if err != nil {
 			return c.String(http.StatusBadRequest, err.Error())
 		}
-		return c.JSON(http.StatusOK, &tt)
+		// Output Format
+		format := c.QueryParam("format")
+		if format != "" && format != "json" && format != "csv" {
+			return c.String(http.StatusBadRequest, "query parameter 'format' must be one of: json, csv")
+		}
+
+		tt, err := models.ComputedTimeseries(db, []uuid.UUID{instrumentID}, &tw, &interval, method)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		// Only return computed timeseries; stored timeseries are available from the timeseries endpoints
+		computed := make([]models.Timeseries, 0)
+		for _, t := range tt {
+			if t.IsComputed {
+				computed = append(computed, t)
+			}
+		}
+		if format == "csv" {
+			b, err := computedTimeseriesCSV(computed)
+			if err != nil {
+				return c.String(http.StatusInternalServerError, err.Error())
+			}
+			return c.Blob(http.StatusOK, "text/csv", b)
+		}
+		return c.JSON(http.StatusOK, computed)
+	}
+}
+
+// computationOptions validates the time window, interval and regularization method for computed timeseries
+// If after and before are not provided, tw is set to the last 7 days; interval defaults to 1h and method to carry_forward
+func computationOptions(tw *models.TimeWindow, intervalParam string, method string) (time.Duration, string, error) {
+	if (tw.Before == time.Time{} && tw.After == time.Time{}) {
+		tw.Before = time.Now()
+		tw.After = tw.Before.AddDate(0, 0, -7)
+	}
+	if tw.Before.Before(tw.After) {
+		return 0, "", errors.New("'before' must not be earlier than 'after'")
+	}
+	// Interval
+	interval := time.Hour
+	if intervalParam != "" {
+		i, err := time.ParseDuration(intervalParam)
+		if err != nil || i <= 0 {
+			return 0, "", errors.New("'interval' must be a positive duration (e.g. 15m, 1h)")
+		}
+		interval = i
+	}
+	if tw.Before.Sub(tw.After)/interval > maxComputedTimesteps {
+		return 0, "", fmt.Errorf("time window and interval exceed %d timesteps; use a shorter window or longer interval", maxComputedTimesteps)
+	}
+	// Regularization Method
+	if method == "" {
+		method = models.RegularizeMethodCarryForward
+	}
+	if method != models.RegularizeMethodCarryForward && method != models.RegularizeMethodInterpolate {
+		return 0, "", errors.New("'method' must be one of: carry_forward, interpolate")
+	}
+	return interval, method, nil
+}
+
+// computedTimeseriesCSV writes one row per timestep for each computed timeseries;
+// timesteps that could not be computed have an empty value and a diagnostic message
+func computedTimeseriesCSV(tt []models.Timeseries) ([]byte, error) {
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	if err := w.Write([]string{"timeseries_id", "variable", "time", "value", "diagnostic"}); err != nil {
+		return nil, err
+	}
+	for _, t := range tt {
+		// Measurements and Diagnostics are each sorted by time; merge them into a single ordered output
+		mIdx, dIdx := 0, 0
+		for mIdx < len(t.Measurements) || dIdx < len(t.Diagnostics) {
+			var row []string
+			if dIdx == len(t.Diagnostics) || (mIdx < len(t.Measurements) && t.Measurements[mIdx].Time.Before(t.Diagnostics[dIdx].Time)) {
+				m := t.Measurements[mIdx]
+				row = []string{t.TimeseriesID.String(), t.Variable, m.Time.Format(time.RFC3339), strconv.FormatFloat(m.Value, 'f', -1, 64), ""}
+				mIdx++
+			} else {
+				d := t.Diagnostics[dIdx]
+				msg := d.Error
+				if len(d.MissingVariables) > 0 {
+					msg = fmt.Sprintf("missing variables: %s", strings.Join(d.MissingVariables, " "))
+				}
+				row = []string{t.TimeseriesID.String(), t.Variable, d.Time.Format(time.RFC3339), "", msg}
+				dIdx++
+			}
+			if err := w.Write(row); err != nil {
+				return nil, err
+			}
+		}
 	}
+	w.Flush()
+	return b.Bytes(), w.Error()
 }
@@ -40,6 +40,13 @@ func PostExplorer(db *sqlx.DB) echo.HandlerFunc {
 		if err := (&echo.DefaultBinder{}).BindBody(c, &f.InstrumentID); err != nil {
 			return c.String(http.StatusBadRequest, err.Error())
 		}
+		// Instruments in private projects the profile can not read are left out
+		p, _ := c.Get("profile").(*models.Profile)
+		ids, err := models.ListReadableInstrumentIDs(db, p, f.InstrumentID)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		f.InstrumentID = ids
 
 		// Time Window From POST
 		if err := (&echo.DefaultBinder{}).BindQueryParams(c, &f.TimeWindow); err != nil {
@@ -53,7 +60,7 @@ func PostExplorer(db *sqlx.DB) echo.HandlerFunc {
 
 		// Get Stored And Computed Timeseries With Measurements
 		interval := time.Hour // Set to 1 Hour; TODO - do not hard-code interval
-		tt, err := models.ComputedTimeseries(db, f.InstrumentID, &f.TimeWindow, &interval)
+		tt, err := models.ComputedTimeseries(db, f.InstrumentID, &f.TimeWindow, &interval, models.RegularizeMethodCarryForward)
 		if err != nil {
 			return c.String(http.StatusInternalServerError, err.Error())
 		}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/USACE/instrumentation-api/dbutils"
+	"github.com/USACE/instrumentation-api/models"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/labstack/echo/v4"
+)
+
+// ListInstrumentFormulas lists formulas for a single instrument
+func ListInstrumentFormulas(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		instrumentID, err := uuid.Parse(c.Param("instrument_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		ff, err := models.ListInstrumentFormulas(db, &instrumentID)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, ff)
+	}
+}
+
+// GetFormula returns a single formula
+func GetFormula(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		instrumentID, err := uuid.Parse(c.Param("instrument_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		formulaID, err := uuid.Parse(c.Param("formula_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		f, err := models.GetFormula(db, &instrumentID, &formulaID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, f)
+	}
+}
+
+// CreateInstrumentFormulas creates one or more formulas for an instrument
+func CreateInstrumentFormulas(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		fc := models.FormulaCollection{}
+		if err := c.Bind(&fc); err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		// Get instrument_id from Route Params
+		instrumentID, err := uuid.Parse(c.Param("instrument_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		// Formula slugs share a namespace with the instrument's timeseries
+		// because both are referenced as variables in the form [instrument.slug]
+		slugsTaken, err := models.ListTimeseriesSlugsForInstrument(db, &instrumentID)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		// Set Creator, CreateDate on all items
+		p := c.Get("profile").(*models.Profile)
+		t := time.Now()
+		for idx := range fc.Items {
+			s, err := dbutils.NextUniqueSlug(fc.Items[idx].Name, slugsTaken)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, err)
+			}
+			fc.Items[idx].Slug = s
+			slugsTaken = append(slugsTaken, s)
+			fc.Items[idx].InstrumentID = instrumentID
+			fc.Items[idx].Creator, fc.Items[idx].CreateDate = p.ID, t
+		}
+		ff, err := models.CreateFormulas(db, fc.Items)
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		return c.JSON(http.StatusCreated, ff)
+	}
+}
+
+// UpdateInstrumentFormula updates an existing formula
+func UpdateInstrumentFormula(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		instrumentID, err := uuid.Parse(c.Param("instrument_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		formulaID, err := uuid.Parse(c.Param("formula_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		f := models.Formula{ID: formulaID}
+		if err := c.Bind(&f); err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		// check :formula_id in url params matches id in request body
+		if formulaID != f.ID {
+			return c.String(
+				http.StatusBadRequest,
+				"url formula_id does not match object id in body",
+			)
+		}
+		f.InstrumentID = instrumentID
+		// Profile and timestamp
+		p := c.Get("profile").(*models.Profile)
+		t := time.Now()
+		f.Updater, f.UpdateDate = &p.ID, &t
+
+		fUpdated, err := models.UpdateFormula(db, &f)
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		return c.JSON(http.StatusOK, fUpdated)
+	}
+}
+
+// DeleteInstrumentFormula deletes a formula
+func DeleteInstrumentFormula(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		instrumentID, err := uuid.Parse(c.Param("instrument_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		formulaID, err := uuid.Parse(c.Param("formula_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if err := models.DeleteFormula(db, &instrumentID, &formulaID); err != nil {
+			return c.JSON(http.StatusInternalServerError, err)
+		}
+		return c.JSON(http.StatusOK, make(map[string]interface{}))
+	}
+}
+
+// FormulaPreviewRequest is a draft formula and the options used to evaluate it
+type FormulaPreviewRequest struct {
+	models.Formula
+	models.TimeWindow
+	Interval string `json:"interval"`
+	Method   string `json:"method"`
+}
+
+// PreviewFormula evaluates a draft formula against historical data without saving it
+// Body includes the formula fields (instrument_id, formula, unit_id, variable_units) and optional
+// after, before (default last 7 days), interval (default 1h) and method (default carry_forward)
+func PreviewFormula(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		var r FormulaPreviewRequest
+		if err := c.Bind(&r); err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		// Draft formula must belong to an instrument in the project
+		i, err := models.GetInstrument(db, &r.InstrumentID)
+		if err != nil || i.ProjectID == nil || *i.ProjectID != projectID {
+			return c.String(http.StatusBadRequest, "instrument_id does not belong to project")
+		}
+		interval, method, err := computationOptions(&r.TimeWindow, r.Interval, r.Method)
+		if err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		p, _ := c.Get("profile").(*models.Profile)
+		fp, err := models.PreviewFormula(db, p, &r.Formula, &r.TimeWindow, &interval, method)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, fp)
+	}
+}
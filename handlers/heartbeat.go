@@ -4,22 +4,39 @@ import (
 	"net/http"
 
 	"github.com/USACE/instrumentation-api/models"
+	"github.com/USACE/instrumentation-api/notify"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/labstack/echo/v4"
 )
 
 // DoHeartbeat triggers regular-interval tasks
-func DoHeartbeat(db *sqlx.DB) echo.HandlerFunc {
+// appURL is the web application URL used to link to instruments in alert messages and webhook payloads;
+// apiURL is the public URL of this API used for unsubscribe links in alert emails
+func DoHeartbeat(db *sqlx.DB, smtpCfg *notify.SMTPConfig, appURL, apiURL string) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		// Create a Record of Heartbeat
 		h, err := models.DoHeartbeat(db)
 		if err != nil {
 			return c.JSON(http.StatusInternalServerError, err)
 		}
-		if err := models.DoCheckAlerts(db); err != nil {
+		// Emails and webhooks are sent even if some alert configs could not be evaluated
+		checkErr := models.DoCheckAlerts(db, appURL)
+		if err := models.DoEscalateAlerts(db); err != nil {
 			return c.String(http.StatusInternalServerError, err.Error())
 		}
+		if err := models.DoSendAlertEmails(db, smtpCfg, apiURL); err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		if err := models.DoSendAlertDigests(db, smtpCfg, apiURL); err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		if err := models.DoSendAlertWebhooks(db, appURL); err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		if checkErr != nil {
+			return c.String(http.StatusInternalServerError, checkErr.Error())
+		}
 		return c.JSON(http.StatusOK, h)
 	}
 }
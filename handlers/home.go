@@ -3,6 +3,9 @@ package handlers
 import (
 	"net/http"
 
+	"github.com/USACE/instrumentation-api/models"
+
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/labstack/echo/v4"
 )
@@ -16,20 +19,34 @@ type Home struct {
 	NewMeasurements2H   int `json:"new_measurements_2h" db:"new_measurements_2h"`
 }
 
-// GetHome returns information for the homepage
+// GetHome returns information for the homepage; counts only include projects the profile can read
 func GetHome(db *sqlx.DB) echo.HandlerFunc {
 	return func(c echo.Context) error {
+		profileID := uuid.Nil
+		if p, ok := c.Get("profile").(*models.Profile); ok {
+			profileID = p.ID
+		}
 		var h Home
 		if err := db.Get(
 			&h,
-			`SELECT (SELECT count(id) FROM instrument WHERE NOT deleted)        AS instrument_count,
-		            (SELECT count(id) FROM project WHERE NOT deleted)           AS project_count,
-		            (SELECT count(id) FROM instrument_group)                    AS instrument_group_count,
-					(SELECT count(id) FROM instrument
-					  WHERE NOT deleted and (now() - create_date) < '7 Days')   AS new_instruments_7d,
-					(SELECT count(timeseries_id) FROM timeseries_measurement
-					  WHERE (now() - timeseries_measurement.time) < '2 Hours' ) AS new_measurements_2h
+			`WITH readable AS (`+models.ReadableProjectsSQL("$1")+`), i AS (
+			     SELECT id, create_date FROM instrument
+			     WHERE  NOT deleted AND (project_id IS NULL OR project_id IN (SELECT id FROM readable))
+			 )
+			 SELECT (SELECT count(id) FROM i)                                   AS instrument_count,
+		            (SELECT count(id) FROM project
+					  WHERE NOT deleted AND id IN (SELECT id FROM readable))    AS project_count,
+		            (SELECT count(id) FROM instrument_group
+					  WHERE project_id IS NULL
+					     OR project_id IN (SELECT id FROM readable))            AS instrument_group_count,
+					(SELECT count(id) FROM i
+					  WHERE (now() - create_date) < '7 Days')                   AS new_instruments_7d,
+					(SELECT count(m.timeseries_id) FROM timeseries_measurement m
+					  INNER JOIN timeseries t ON t.id = m.timeseries_id
+					  WHERE (now() - m.time) < '2 Hours'
+					    AND t.instrument_id IN (SELECT id FROM i))              AS new_measurements_2h
 			`,
+			profileID,
 		); err != nil {
 			return c.String(http.StatusInternalServerError, err.Error())
 		}
@@ -84,3 +84,22 @@ func ListInstrumentConstants(db *sqlx.DB) echo.HandlerFunc {
 		return c.JSON(http.StatusOK, cc)
 	}
 }
+
+// ListInstrumentConstantHistory lists the effective-dated values of a single instrument constant
+func ListInstrumentConstantHistory(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		instrumentID, err := uuid.Parse(c.Param("instrument_id"))
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		timeseriesID, err := uuid.Parse(c.Param("timeseries_id"))
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		vv, err := models.ListInstrumentConstantHistory(db, &instrumentID, &timeseriesID)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, err)
+		}
+		return c.JSON(http.StatusOK, vv)
+	}
+}
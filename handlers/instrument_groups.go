@@ -15,7 +15,8 @@ import (
 // ListInstrumentGroups returns instrument groups
 func ListInstrumentGroups(db *sqlx.DB) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		groups, err := models.ListInstrumentGroups(db)
+		p, _ := c.Get("profile").(*models.Profile)
+		groups, err := models.ListInstrumentGroups(db, p)
 		if err != nil {
 			return c.JSON(http.StatusInternalServerError, err)
 		}
@@ -165,6 +166,9 @@ func CreateInstrumentGroupInstruments(db *sqlx.DB) echo.HandlerFunc {
 		if err := c.Bind(i); err != nil || i.ID == uuid.Nil {
 			return c.JSON(http.StatusBadRequest, models.DefaultMessageBadRequest)
 		}
+		if err := models.ValidateInstrumentGroupInstrument(db, instrumentGroupID, i.ID); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
 
 		if err := models.CreateInstrumentGroupInstruments(db, instrumentGroupID, i.ID); err != nil {
 			return c.JSON(http.StatusInternalServerError, err)
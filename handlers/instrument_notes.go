@@ -14,7 +14,8 @@ import (
 // ListInstrumentNotes returns instrument notes
 func ListInstrumentNotes(db *sqlx.DB) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		notes, err := models.ListInstrumentNotes(db)
+		p, _ := c.Get("profile").(*models.Profile)
+		notes, err := models.ListInstrumentNotes(db, p)
 		if err != nil {
 			return c.JSON(http.StatusInternalServerError, err)
 		}
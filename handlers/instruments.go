@@ -16,7 +16,8 @@ import (
 // ListInstruments returns instruments
 func ListInstruments(db *sqlx.DB) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		nn, err := models.ListInstruments(db)
+		p, _ := c.Get("profile").(*models.Profile)
+		nn, err := models.ListInstruments(db, p)
 		if err != nil {
 			return c.String(http.StatusBadRequest, err.Error())
 		}
@@ -27,7 +28,8 @@ func ListInstruments(db *sqlx.DB) echo.HandlerFunc {
 // GetInstrumentCount returns the total number of non deleted instruments in the system
 func GetInstrumentCount(db *sqlx.DB) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		count, err := models.GetInstrumentCount(db)
+		p, _ := c.Get("profile").(*models.Profile)
+		count, err := models.GetInstrumentCount(db, p)
 		if err != nil {
 			return c.JSON(http.StatusInternalServerError, err)
 		}
@@ -12,7 +12,8 @@ import (
 // ListOpendcsSites returns all Instruments, represented as Opendcs Sites
 func ListOpendcsSites(db *sqlx.DB) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		ss, err := models.ListOpendcsSites(db)
+		p, _ := c.Get("profile").(*models.Profile)
+		ss, err := models.ListOpendcsSites(db, p)
 		if err != nil {
 			return c.JSON(http.StatusBadRequest, models.DefaultMessageBadRequest)
 		}
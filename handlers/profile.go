@@ -43,7 +43,7 @@ func GetMyProfile(db *sqlx.DB) echo.HandlerFunc {
 	}
 }
 
-// CreateToken returns a list of all products
+// CreateToken creates a token for the current authenticated user
 func CreateToken(db *sqlx.DB) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		EDIPI := c.Get("EDIPI").(int)
@@ -54,7 +54,15 @@ func CreateToken(db *sqlx.DB) echo.HandlerFunc {
 				"could not locate user profile with information provided",
 			)
 		}
-		token, err := models.CreateProfileToken(db, &p.ID)
+		// Token settings are optional; tokens without settings never expire and carry the full rights of the profile
+		var n models.TokenSettings
+		if err := c.Bind(&n); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		if err := models.ValidateTokenSettings(db, &n); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		token, err := models.CreateProfileToken(db, &p.ID, &n)
 		if err != nil {
 			return c.String(http.StatusInternalServerError, err.Error())
 		}
@@ -83,3 +91,25 @@ func DeleteToken(db *sqlx.DB) echo.HandlerFunc {
 		return c.JSON(http.StatusOK, make(map[string]interface{}))
 	}
 }
+
+// UpdateTokenRateLimit sets the rate limits of a token; tokens without rate limits have the default limits
+func UpdateTokenRateLimit(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		tokenID := c.Param("token_id")
+		var r models.TokenRateLimit
+		if err := c.Bind(&r); err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		if err := models.ValidateTokenRateLimit(&r); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		n, err := models.UpdateTokenRateLimit(db, &tokenID, &r)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, n)
+	}
+}
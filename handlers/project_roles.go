@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"database/sql"
+	"errors"
 	"net/http"
 
 	"github.com/USACE/instrumentation-api/models"
@@ -41,12 +43,24 @@ func AddProjectMemberRole(db *sqlx.DB) echo.HandlerFunc {
 			return c.String(http.StatusBadRequest, "Malformed ID")
 		}
 
+		// role must be a built-in role or a role created for the project
+		if _, err := models.GetProjectRole(db, &projectID, &roleID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.String(http.StatusBadRequest, "role_id is not a role of the project")
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+
 		// profile granting role to profile_id
 		grantedBy := c.Get("profile").(*models.Profile)
 
 		r, err := models.AddProjectMemberRole(db, &projectID, &profileID, &roleID, &grantedBy.ID)
 
 		if err != nil {
+			// deleted service accounts can not be granted roles
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.String(http.StatusBadRequest, "profile_id is not an active profile")
+			}
 			return c.JSON(http.StatusInternalServerError, models.DefaultMessageInternalServerError)
 		}
 		return c.JSON(http.StatusOK, r)
@@ -75,3 +89,122 @@ func RemoveProjectMemberRole(db *sqlx.DB) echo.HandlerFunc {
 		return c.JSON(http.StatusOK, make(map[string]interface{}))
 	}
 }
+
+// ListPermissions lists the permissions a project role can grant
+func ListPermissions(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		pp, err := models.ListPermissions(db)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, pp)
+	}
+}
+
+// ListProjectRoles lists the roles that can be granted in a project and their permissions
+func ListProjectRoles(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		rr, err := models.ListProjectRoles(db, &projectID)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, rr)
+	}
+}
+
+// CreateProjectRole creates a role for a project with a set of permissions
+func CreateProjectRole(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		var r models.ProjectRole
+		if err := c.Bind(&r); err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		r.ID, r.ProjectID = uuid.Nil, &projectID
+		if err := models.ValidateProjectRole(db, &r); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		rCreated, err := models.CreateProjectRole(db, &r)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusCreated, rCreated)
+	}
+}
+
+// UpdateProjectRole updates the name and permissions of a role created for a project
+func UpdateProjectRole(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		roleID, err := uuid.Parse(c.Param("role_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		var r models.ProjectRole
+		if err := c.Bind(&r); err != nil {
+			return c.JSON(http.StatusBadRequest, err)
+		}
+		if r.ID != roleID {
+			return c.String(http.StatusBadRequest, "route parameter role_id does not match id in JSON payload")
+		}
+		if code, msg := projectRoleEditable(db, &projectID, &roleID); code != http.StatusOK {
+			return c.String(code, msg)
+		}
+		r.ProjectID = &projectID
+		if err := models.ValidateProjectRole(db, &r); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		rUpdated, err := models.UpdateProjectRole(db, &r)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, rUpdated)
+	}
+}
+
+// DeleteProjectRole deletes a role created for a project; the role is removed from project members
+func DeleteProjectRole(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		roleID, err := uuid.Parse(c.Param("role_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if code, msg := projectRoleEditable(db, &projectID, &roleID); code != http.StatusOK {
+			return c.String(code, msg)
+		}
+		if err := models.DeleteProjectRole(db, &projectID, &roleID); err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, make(map[string]interface{}))
+	}
+}
+
+// projectRoleEditable checks that a role was created for a project; built-in roles can not be changed
+// Returns http.StatusOK, or the status code and message to respond with
+func projectRoleEditable(db *sqlx.DB, projectID, roleID *uuid.UUID) (int, string) {
+	r, err := models.GetProjectRole(db, projectID, roleID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return http.StatusNotFound, models.DefaultMessageNotFound.Message
+		}
+		return http.StatusInternalServerError, err.Error()
+	}
+	if r.ProjectID == nil {
+		return http.StatusBadRequest, "built-in roles can not be changed"
+	}
+	return http.StatusOK, ""
+}
@@ -15,7 +15,8 @@ import (
 // ListProjects returns projects
 func ListProjects(db *sqlx.DB) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		projects, err := models.ListProjects(db)
+		p, _ := c.Get("profile").(*models.Profile)
+		projects, err := models.ListProjects(db, p)
 		if err != nil {
 			return c.JSON(http.StatusInternalServerError, err)
 		}
@@ -83,7 +84,8 @@ func ListProjectInstrumentGroups(db *sqlx.DB) echo.HandlerFunc {
 // GetProjectCount returns the total number of non deleted projects in the system
 func GetProjectCount(db *sqlx.DB) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		count, err := models.GetProjectCount(db)
+		p, _ := c.Get("profile").(*models.Profile)
+		count, err := models.GetProjectCount(db, p)
 		if err != nil {
 			return c.JSON(http.StatusInternalServerError, err)
 		}
@@ -132,6 +134,13 @@ func CreateProjectBulk(db *sqlx.DB) echo.HandlerFunc {
 			pc.Projects[idx].Creator = p.ID
 			// create date
 			pc.Projects[idx].CreateDate = t
+			// visibility; projects are public unless created private
+			if err := models.ValidateProjectVisibility(pc.Projects[idx].Visibility); err != nil {
+				return c.String(http.StatusBadRequest, err.Error())
+			}
+			if pc.Projects[idx].Visibility == "" {
+				pc.Projects[idx].Visibility = models.ProjectVisibilityPublic
+			}
 			// Assign Slug
 			s, err := dbutils.NextUniqueSlug(pc.Projects[idx].Name, slugsTaken)
 			if err != nil {
@@ -174,6 +183,10 @@ func UpdateProject(db *sqlx.DB) echo.HandlerFunc {
 				},
 			)
 		}
+		// visibility is unchanged if not provided
+		if err := models.ValidateProjectVisibility(p.Visibility); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
 
 		profile := c.Get("profile").(*models.Profile)
 
@@ -11,7 +11,7 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
-type searchFunc func(db *sqlx.DB, searchText *string, limit *int) ([]models.SearchResult, error)
+type searchFunc func(db *sqlx.DB, p *models.Profile, searchText *string, limit *int) ([]models.SearchResult, error)
 
 // Search allows searching using a string on different entities
 func Search(db *sqlx.DB) echo.HandlerFunc {
@@ -36,7 +36,9 @@ func Search(db *sqlx.DB) echo.HandlerFunc {
 		}
 		// Get Desired Number of Results; Hardcode 5 for now;
 		limit := 5
-		rr, err := fn(db, &searchText, &limit)
+		// Profile of the user searching; nil for anonymous requests
+		p, _ := c.Get("profile").(*models.Profile)
+		rr, err := fn(db, p, &searchText, &limit)
 		if err != nil {
 			return c.String(http.StatusInternalServerError, err.Error())
 		}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/USACE/instrumentation-api/models"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/labstack/echo/v4"
+)
+
+// ListServiceAccounts lists service accounts and their tokens
+func ListServiceAccounts(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		aa, err := models.ListServiceAccounts(db)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, aa)
+	}
+}
+
+// GetServiceAccount returns a service account and its tokens
+func GetServiceAccount(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		id, err := uuid.Parse(c.Param("profile_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		a, err := models.GetServiceAccount(db, &id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, a)
+	}
+}
+
+// CreateServiceAccount creates a service account; the creator is the admin making the request
+func CreateServiceAccount(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var a models.ServiceAccount
+		if err := c.Bind(&a); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		if err := models.ValidateServiceAccount(db, &a); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		p := c.Get("profile").(*models.Profile)
+		a.Creator = &p.ID
+		aNew, err := models.CreateServiceAccount(db, &a)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusCreated, aNew)
+	}
+}
+
+// DeleteServiceAccount deletes a service account and all of its tokens
+func DeleteServiceAccount(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		id, err := uuid.Parse(c.Param("profile_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if err := models.DeleteServiceAccount(db, &id); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, make(map[string]interface{}))
+	}
+}
+
+// CreateServiceAccountToken creates a token for a service account; token settings are the same as for /my_tokens
+func CreateServiceAccountToken(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		id, err := uuid.Parse(c.Param("profile_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		if _, err := models.GetServiceAccount(db, &id); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		var n models.TokenSettings
+		if err := c.Bind(&n); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		if err := models.ValidateTokenSettings(db, &n); err != nil {
+			return c.String(http.StatusBadRequest, err.Error())
+		}
+		token, err := models.CreateProfileToken(db, &id, &n)
+		if err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusCreated, token)
+	}
+}
+
+// DeleteServiceAccountToken deletes a token of a service account
+func DeleteServiceAccountToken(db *sqlx.DB) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		id, err := uuid.Parse(c.Param("profile_id"))
+		if err != nil {
+			return c.String(http.StatusBadRequest, "Malformed ID")
+		}
+		tokenID := c.Param("token_id")
+		if tokenID == "" {
+			return c.String(http.StatusBadRequest, "Bad Token ID")
+		}
+		if _, err := models.GetServiceAccount(db, &id); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		if err := models.DeleteToken(db, &id, &tokenID); err != nil {
+			return c.String(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, make(map[string]interface{}))
+	}
+}
@@ -41,6 +41,12 @@ func ListTimeseriesMeasurements(db *sqlx.DB) echo.HandlerFunc {
 		if err != nil {
 			return c.String(http.StatusBadRequest, "Malformed ID")
 		}
+		if ok, err := timeseriesInRouteInstrument(db, c, &tsID); err != nil || !ok {
+			if err != nil {
+				return c.String(http.StatusInternalServerError, err.Error())
+			}
+			return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+		}
 
 		// Time Window
 		var tw timeseries.TimeWindow
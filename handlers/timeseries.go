@@ -1,6 +1,9 @@
 package handlers
 
 import (
+	"database/sql"
+	"errors"
+
 	"github.com/USACE/instrumentation-api/dbutils"
 	"github.com/USACE/instrumentation-api/models"
 	ts "github.com/USACE/instrumentation-api/timeseries"
@@ -15,7 +18,8 @@ import (
 // ListTimeseries returns an array of timeseries
 func ListTimeseries(db *sqlx.DB) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		tt, err := models.ListTimeseries(db)
+		p, _ := c.Get("profile").(*models.Profile)
+		tt, err := models.ListTimeseries(db, p)
 		if err != nil {
 			return c.JSON(http.StatusBadRequest, err)
 		}
@@ -30,14 +34,37 @@ func GetTimeseries(db *sqlx.DB) echo.HandlerFunc {
 		if err != nil {
 			return c.String(http.StatusBadRequest, "Malformed ID")
 		}
+		if ok, err := timeseriesInRouteInstrument(db, c, &tsID); err != nil || !ok {
+			if err != nil {
+				return c.String(http.StatusInternalServerError, err.Error())
+			}
+			return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+		}
 		t, err := models.GetTimeseries(db, &tsID)
 		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
 			return c.JSON(http.StatusInternalServerError, err)
 		}
 		return c.JSON(http.StatusOK, t)
 	}
 }
 
+// timeseriesInRouteInstrument returns true if a timeseries belongs to the instrument in the route, or the route
+// has no instrument; project visibility is checked on the instrument, so routes must not reach other timeseries
+func timeseriesInRouteInstrument(db *sqlx.DB, c echo.Context, tsID *uuid.UUID) (bool, error) {
+	v := c.Param("instrument_id")
+	if v == "" {
+		return true, nil
+	}
+	instrumentID, err := uuid.Parse(v)
+	if err != nil {
+		return false, nil
+	}
+	return models.TimeseriesBelongsToInstrument(db, tsID, &instrumentID)
+}
+
 // ListInstrumentTimeseries lists timeseries for an instrument
 func ListInstrumentTimeseries(db *sqlx.DB) echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -117,7 +144,7 @@ func CreateTimeseries(db *sqlx.DB) echo.HandlerFunc {
 
 		tt, err := models.CreateTimeseries(db, tc.Items)
 		if err != nil {
-			return c.JSON(http.StatusBadRequest, err)
+			return c.String(http.StatusBadRequest, err.Error())
 		}
 
 		return c.JSON(http.StatusCreated, tt)
@@ -149,7 +176,7 @@ func UpdateTimeseries(db *sqlx.DB) echo.HandlerFunc {
 		// update
 		tUpdated, err := models.UpdateTimeseries(db, &t)
 		if err != nil {
-			return c.JSON(http.StatusBadRequest, err)
+			return c.String(http.StatusBadRequest, err.Error())
 		}
 		// return updated instrument
 		return c.JSON(http.StatusOK, tUpdated)
@@ -3,27 +3,44 @@ package main
 import (
 	"fmt"
 	"log"
+	"net"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/USACE/instrumentation-api/dbutils"
 	"github.com/USACE/instrumentation-api/handlers"
 	"github.com/USACE/instrumentation-api/middleware"
 	"github.com/USACE/instrumentation-api/models"
+	"github.com/USACE/instrumentation-api/notify"
 	"github.com/apex/gateway"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/kelseyhightower/envconfig"
 
 	"github.com/labstack/echo/v4"
-	echomw "github.com/labstack/echo/v4/middleware"
 
 	_ "github.com/jackc/pgx/v4/stdlib"
 )
 
 // Config stores configuration information stored in environment variables
 type Config struct {
-	AuthDisabled        bool   `envconfig:"AUTH_DISABLED"`
-	AuthJWTMocked       bool   `envconfig:"AUTH_JWT_MOCKED"`
-	ApplicationKey      string `envconfig:"APPLICATION_KEY"`
+	AuthDisabled        bool          `envconfig:"AUTH_DISABLED"`
+	AuthJWTMocked       bool          `envconfig:"AUTH_JWT_MOCKED"`
+	AuthJWTPublicKey    string        `envconfig:"AUTH_JWT_PUBLIC_KEY"`
+	AuthJWKSURL         string        `envconfig:"AUTH_JWKS_URL"`
+	AuthJWKSFile        string        `envconfig:"AUTH_JWKS_FILE"`
+	AuthJWKSRefresh     time.Duration `envconfig:"AUTH_JWKS_REFRESH" default:"1h"`
+	AuthJWTIssuer       string        `envconfig:"AUTH_JWT_ISSUER"`
+	AuthJWTAudience     string        `envconfig:"AUTH_JWT_AUDIENCE"`
+	ApplicationKey      string        `envconfig:"APPLICATION_KEY"`
+	APIKeyQueryDisabled bool          `envconfig:"API_KEY_QUERY_DISABLED"`
+	RateLimitDisabled   bool          `envconfig:"RATE_LIMIT_DISABLED"`
+	RateLimitWindow     time.Duration `envconfig:"RATE_LIMIT_WINDOW" default:"1m"`
+	RateLimitRead       int           `envconfig:"RATE_LIMIT_READ" default:"600"`
+	RateLimitWrite      int           `envconfig:"RATE_LIMIT_WRITE" default:"120"`
+	RateLimitAppRead    int           `envconfig:"RATE_LIMIT_APP_READ" default:"6000"`
+	RateLimitAppWrite   int           `envconfig:"RATE_LIMIT_APP_WRITE" default:"1200"`
+	TrustedProxies      []string      `envconfig:"TRUSTED_PROXIES"`
 	LambdaContext       bool
 	DBUser              string
 	DBPass              string
@@ -37,6 +54,13 @@ type Config struct {
 	AWSS3DisableSSL     bool   `envconfig:"AWS_S3_DISABLE_SSL"`
 	AWSS3ForcePathStyle bool   `envconfig:"AWS_S3_FORCE_PATH_STYLE"`
 	AWSS3Bucket         string `envconfig:"AWS_S3_BUCKET"`
+	SMTPHost            string `envconfig:"SMTP_HOST"`
+	SMTPPort            int    `envconfig:"SMTP_PORT"`
+	SMTPUser            string `envconfig:"SMTP_USER"`
+	SMTPPass            string `envconfig:"SMTP_PASS"`
+	EmailFrom           string `envconfig:"EMAIL_FROM"`
+	AppURL              string `envconfig:"APP_URL"`
+	APIURL              string `envconfig:"API_URL"`
 }
 
 func awsConfig(cfg *Config) *aws.Config {
@@ -52,6 +76,61 @@ func awsConfig(cfg *Config) *aws.Config {
 	return awsConfig
 }
 
+// jwtKeyConfig returns settings for verifying JWTs; keys come from a PEM public key, a JWKS URL or a JWKS file
+func jwtKeyConfig(cfg *Config) middleware.JWTKeyConfig {
+	return middleware.JWTKeyConfig{
+		PublicKey:   cfg.AuthJWTPublicKey,
+		JWKSURL:     cfg.AuthJWKSURL,
+		JWKSFile:    cfg.AuthJWKSFile,
+		JWKSRefresh: cfg.AuthJWKSRefresh,
+		Issuer:      cfg.AuthJWTIssuer,
+		Audience:    cfg.AuthJWTAudience,
+	}
+}
+
+// rateLimiter returns the limiter of requests per token, profile or IP address, or nil if rate limiting is disabled
+func rateLimiter(cfg *Config) *middleware.RateLimiter {
+	if cfg.RateLimitDisabled {
+		return nil
+	}
+	return middleware.NewRateLimiter(middleware.RateLimitConfig{
+		Window: cfg.RateLimitWindow,
+		Read:   cfg.RateLimitRead,
+		Write:  cfg.RateLimitWrite,
+
+		AppRead:  cfg.RateLimitAppRead,
+		AppWrite: cfg.RateLimitAppWrite,
+	})
+}
+
+// ipExtractor returns how the client IP address of a request is found; X-Forwarded-For is only read from
+// TRUSTED_PROXIES (CIDRs), otherwise the address of the connection is used so clients can not set their own address
+func ipExtractor(cfg *Config) (echo.IPExtractor, error) {
+	if len(cfg.TrustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+	opts := []echo.TrustOption{echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false)}
+	for _, cidr := range cfg.TrustedProxies {
+		_, n, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid TRUSTED_PROXIES range %s: %w", cidr, err)
+		}
+		opts = append(opts, echo.TrustIPRange(n))
+	}
+	return echo.ExtractIPFromXFFHeader(opts...), nil
+}
+
+// smtpConfig returns settings for sending alert emails; email is disabled if SMTP_HOST is not set
+func smtpConfig(cfg *Config) *notify.SMTPConfig {
+	return &notify.SMTPConfig{
+		Host:     cfg.SMTPHost,
+		Port:     cfg.SMTPPort,
+		Username: cfg.SMTPUser,
+		Password: cfg.SMTPPass,
+		From:     cfg.EmailFrom,
+	}
+}
+
 func (c *Config) dbConnStr() string {
 	return fmt.Sprintf("user=%s password=%s dbname=%s host=%s sslmode=%s", c.DBUser, c.DBPass, c.DBName, c.DBHost, c.DBSSLMode)
 }
@@ -85,58 +164,96 @@ func main() {
 	// AWS S3 Config
 	awsCfg := awsConfig(&cfg)
 
+	// JWT verification keys; not used if JWT is mocked or auth is disabled
+	var jwtKeys *middleware.JWTKeySet
+	if !cfg.AuthJWTMocked && !cfg.AuthDisabled {
+		var err error
+		if jwtKeys, err = middleware.NewJWTKeySet(jwtKeyConfig(&cfg)); err != nil {
+			log.Fatal(err.Error())
+		}
+	}
+
+	// SMTP Config
+	smtpCfg := smtpConfig(&cfg)
+
+	// Rate Limits; one limiter for all route groups so each client has a single budget
+	limiter := rateLimiter(&cfg)
+	extractIP, err := ipExtractor(&cfg)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
 	db := dbutils.Connection(cfg.dbConnStr())
 
 	e := echo.New()
+	e.IPExtractor = extractIP
 	e.Use(middleware.CORS, middleware.GZIP)
 	public := e.Group(cfg.RoutePrefix) // TODO: /instrumentation/v1/
 
+	// public routes; authentication is optional. Requests without credentials are anonymous
+	// and only read public projects; authenticated profiles also read the private projects they are members of
+	if cfg.AuthJWTMocked {
+		public.Use(middleware.SkipIfAnonymous(middleware.JWTMock(cfg.AuthDisabled, true)))
+	} else {
+		public.Use(middleware.SkipIfAnonymous(middleware.JWT(jwtKeys, cfg.AuthDisabled, true)))
+	}
+	public.Use(
+		middleware.KeyAuth(db, cfg.AuthDisabled, cfg.ApplicationKey, cfg.APIKeyQueryDisabled),
+		middleware.RateLimitMiddleware(limiter),
+		middleware.AttachOptionalProfileMiddleware(db),
+		middleware.ProjectVisibilityMiddleware(db),
+		middleware.AuditMiddleware(db),
+	)
+
 	// Media Routes
 	public.GET("/projects/:project_slug/images/*", handlers.GetMedia(awsCfg, &cfg.AWSS3Bucket, "/midas", &cfg.RoutePrefix))
 
 	// private routes; can be authenticated via cac or token
 	// setting the second parameter passed to each middleware function to "true"
-	// means that if `?key=` is in the query parameters, JWT middleware will automatically
-	// pass (call next(c)); authentication will then be handled by keyauth
+	// means that if an API key is in the request (X-API-Key header or `?key=`), JWT middleware
+	// will automatically pass (call next(c)); authentication will then be handled by keyauth
 	private := e.Group(cfg.RoutePrefix)
 	if cfg.AuthJWTMocked {
 		private.Use(middleware.JWTMock(cfg.AuthDisabled, true))
 	} else {
-		private.Use(middleware.JWT(cfg.AuthDisabled, true))
+		private.Use(middleware.JWT(jwtKeys, cfg.AuthDisabled, true))
 	}
 	// Attach keyauth middleware
-	private.Use(middleware.KeyAuth(
-		cfg.AuthDisabled,
-		cfg.ApplicationKey,
-		func(keyID string) (string, error) {
-			k, err := models.GetTokenInfoByTokenID(db, &keyID)
-			if err != nil {
-				return "", err
-			}
-			return k.Hash, nil
-		},
-	))
+	private.Use(
+		middleware.KeyAuth(db, cfg.AuthDisabled, cfg.ApplicationKey, cfg.APIKeyQueryDisabled),
+		middleware.RateLimitMiddleware(limiter),
+	)
 
 	// keyAuth not allowed on these routes
 	CACOnly := e.Group(cfg.RoutePrefix)
 	if cfg.AuthJWTMocked {
 		CACOnly.Use(middleware.JWTMock(cfg.AuthDisabled, false))
 	} else {
-		CACOnly.Use(middleware.JWT(cfg.AuthDisabled, false))
+		CACOnly.Use(middleware.JWT(jwtKeys, cfg.AuthDisabled, false))
 	}
 
 	app := e.Group(cfg.RoutePrefix)
-	app.Use(echomw.KeyAuthWithConfig(echomw.KeyAuthConfig{
-		KeyLookup: "query:key",
-		Validator: func(key string, c echo.Context) (bool, error) {
-			return key == cfg.ApplicationKey, nil
-		},
-	}))
+	app.Use(
+		middleware.AppKeyAuth(cfg.ApplicationKey, cfg.APIKeyQueryDisabled),
+		middleware.RateLimitMiddleware(limiter),
+		middleware.AuditMiddleware(db),
+	)
 
 	// AttachProfileMiddleware attaches ProfileID to context, whether
 	// authenticated by token or api key
-	private.Use(middleware.EDIPIMiddleware, middleware.AttachProfileMiddleware(db))
-	CACOnly.Use(middleware.EDIPIMiddleware, middleware.CACOnlyMiddleware)
+	// AuditMiddleware records requests that change data in the audit log
+	private.Use(
+		middleware.EDIPIMiddleware,
+		middleware.AttachProfileMiddleware(db),
+		middleware.ProjectVisibilityMiddleware(db),
+		middleware.AuditMiddleware(db),
+	)
+	CACOnly.Use(
+		middleware.RateLimitMiddleware(limiter),
+		middleware.EDIPIMiddleware,
+		middleware.CACOnlyMiddleware,
+		middleware.AuditMiddleware(db),
+	)
 
 	// Profile and Tokens
 	CACOnly.POST("/profiles", handlers.CreateProfile(db))
@@ -144,11 +261,20 @@ func main() {
 	CACOnly.GET("/my_projects", handlers.ListMyProjects(db))
 	CACOnly.POST("/my_tokens", handlers.CreateToken(db))
 	CACOnly.DELETE("/my_tokens/:token_id", handlers.DeleteToken(db))
+	private.PUT("/tokens/:token_id/rate_limit", handlers.UpdateTokenRateLimit(db), middleware.IsApplicationAdmin)
+
+	// Service Accounts (profiles for machine integrations, managed by application admins)
+	private.GET("/service_accounts", handlers.ListServiceAccounts(db), middleware.IsApplicationAdmin)
+	private.POST("/service_accounts", handlers.CreateServiceAccount(db), middleware.IsApplicationAdmin)
+	private.GET("/service_accounts/:profile_id", handlers.GetServiceAccount(db), middleware.IsApplicationAdmin)
+	private.DELETE("/service_accounts/:profile_id", handlers.DeleteServiceAccount(db), middleware.IsApplicationAdmin)
+	private.POST("/service_accounts/:profile_id/tokens", handlers.CreateServiceAccountToken(db), middleware.IsApplicationAdmin)
+	private.DELETE("/service_accounts/:profile_id/tokens/:token_id", handlers.DeleteServiceAccountToken(db), middleware.IsApplicationAdmin)
 
 	// Authenticated with Appkey only (routes only to be used by other components of the app)
 	// Routes do not have /project/:project_id context and are typically authorized
 	app.POST("/timeseries_measurements", handlers.CreateOrUpdateTimeseriesMeasurements(db))
-	app.POST("/heartbeat", handlers.DoHeartbeat(db))
+	app.POST("/heartbeat", handlers.DoHeartbeat(db, smtpCfg, cfg.AppURL, cfg.APIURL))
 
 	// Heartbeat
 	public.GET("/heartbeats", handlers.ListHeartbeats(db))
@@ -167,22 +293,47 @@ func main() {
 	// AlertConfigs
 	public.GET("/projects/:project_id/instruments/:instrument_id/alert_configs", handlers.ListInstrumentAlertConfigs(db))
 	public.GET("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id", handlers.GetAlertConfig(db))
-	private.POST("/projects/:project_id/instruments/:instrument_id/alert_configs", handlers.CreateInstrumentAlertConfigs(db))
-	private.PUT("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id", handlers.UpdateInstrumentAlertConfig(db))
-	private.DELETE("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id", handlers.DeleteInstrumentAlertConfig(db))
+	public.GET("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id/evaluations", handlers.ListAlertConfigEvaluations(db))
+	private.GET("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id/email_deliveries", handlers.ListAlertConfigEmailDeliveries(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertNotificationWrite))
+	private.GET("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id/escalation_subscriptions", handlers.ListAlertEscalationSubscriptions(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertNotificationWrite))
+	private.POST("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id/escalation_subscriptions/:profile_id", handlers.AddAlertEscalationSubscription(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertNotificationWrite))
+	private.DELETE("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id/escalation_subscriptions/:profile_id", handlers.RemoveAlertEscalationSubscription(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertNotificationWrite))
+	private.POST("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id/backtest", handlers.BacktestAlertConfig(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertConfigWrite))
+	private.POST("/projects/:project_id/instruments/:instrument_id/alert_configs/backtest", handlers.BacktestDraftAlertConfig(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertConfigWrite))
+	private.POST("/projects/:project_id/instruments/:instrument_id/alert_configs", handlers.CreateInstrumentAlertConfigs(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertConfigWrite))
+	private.PUT("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id", handlers.UpdateInstrumentAlertConfig(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertConfigWrite))
+	private.DELETE("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id", handlers.DeleteInstrumentAlertConfig(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertConfigWrite))
 
 	// Alerts
 	public.GET("/projects/:project_id/instruments/:instrument_id/alerts", handlers.ListAlertsForInstrument(db))
+	private.POST("/projects/:project_id/instruments/:instrument_id/alerts/:alert_id/acknowledge", handlers.AcknowledgeAlert(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertAcknowledge))
+	private.POST("/projects/:project_id/instruments/:instrument_id/alerts/:alert_id/resolve", handlers.ResolveAlert(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertAcknowledge))
+
+	// Alert Webhooks
+	private.GET("/projects/:project_id/alert_webhooks", handlers.ListProjectAlertWebhooks(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertNotificationWrite))
+	private.POST("/projects/:project_id/alert_webhooks", handlers.CreateAlertWebhook(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertNotificationWrite))
+	private.PUT("/projects/:project_id/alert_webhooks/:alert_webhook_id", handlers.UpdateAlertWebhook(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertNotificationWrite))
+	private.DELETE("/projects/:project_id/alert_webhooks/:alert_webhook_id", handlers.DeleteAlertWebhook(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertNotificationWrite))
+	private.GET("/projects/:project_id/alert_webhooks/:alert_webhook_id/deliveries", handlers.ListAlertWebhookDeliveries(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertNotificationWrite))
 	private.GET("/my_alerts", handlers.ListMyAlerts(db)) // Private because token required to determine user (i.e. who is "me")
 	private.POST("/my_alerts/:alert_id/read", handlers.DoAlertRead(db))
 	private.POST("/my_alerts/:alert_id/unread", handlers.DoAlertUnread(db))
 
 	// AlertSubscriptions
+	// Any profile may subscribe itself to the alerts of a project; subscriptions do not change project data
 	private.GET("/my_alert_subscriptions", handlers.ListMyAlertSubscriptions(db)) // Private because token required to determine user (i.e. who is "me")
 	private.POST("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id/subscribe", handlers.SubscribeProfileToAlerts(db))
 	private.POST("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id/unsubscribe", handlers.UnsubscribeProfileToAlerts(db))
 	private.PUT("/alert_subscriptions/:alert_subscription_id", handlers.UpdateMyAlertSubscription(db))
 
+	// Email AlertSubscriptions (email addresses that never log in); unsubscribe tokens are sent in alert emails
+	private.GET("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id/email_subscriptions", handlers.ListEmailAlertSubscriptions(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertNotificationWrite))
+	private.POST("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id/email_subscriptions", handlers.SubscribeEmailToAlerts(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertNotificationWrite))
+	private.PUT("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id/email_subscriptions/:email_subscription_id", handlers.UpdateEmailAlertSubscription(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertNotificationWrite))
+	private.DELETE("/projects/:project_id/instruments/:instrument_id/alert_configs/:alert_config_id/email_subscriptions/:email_subscription_id", handlers.UnsubscribeEmailToAlerts(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAlertNotificationWrite))
+	public.GET("/email_subscriptions/unsubscribe/:token", handlers.GetEmailUnsubscribe(db))
+	public.POST("/email_subscriptions/unsubscribe/:token", handlers.UnsubscribeEmailByToken(db))
+
 	// Email Autocomplete
 	public.GET("/email_autocomplete", handlers.ListEmailAutocomplete(db))
 
@@ -194,65 +345,88 @@ func main() {
 	public.GET("/projects/:project_id/instruments/names", handlers.ListProjectInstrumentNames(db))
 	public.GET("/projects/:project_id/instrument_groups", handlers.ListProjectInstrumentGroups(db))
 	private.POST("/projects", handlers.CreateProjectBulk(db), middleware.IsApplicationAdmin)
-	private.PUT("/projects/:project_id", handlers.UpdateProject(db))
-	private.DELETE("/projects/:project_id", handlers.DeleteFlagProject(db))
+	private.PUT("/projects/:project_id", handlers.UpdateProject(db), middleware.ProjectPermissionMiddleware(db, models.PermissionProjectWrite))
+	private.DELETE("/projects/:project_id", handlers.DeleteFlagProject(db), middleware.ProjectPermissionMiddleware(db, models.PermissionProjectWrite))
 
 	// Project Membership
 	// // list project memberships
-	private.GET("/projects/:project_id/members", handlers.ListProjectMembers(db))
+	private.GET("/projects/:project_id/members", handlers.ListProjectMembers(db), middleware.ProjectPermissionMiddleware(db, models.PermissionProjectMembers))
 	// add role to a user
-	private.POST("/projects/:project_id/members/:profile_id/roles/:role_id", handlers.AddProjectMemberRole(db))
+	private.POST("/projects/:project_id/members/:profile_id/roles/:role_id", handlers.AddProjectMemberRole(db), middleware.ProjectPermissionMiddleware(db, models.PermissionProjectMembers))
 	// remove role from a user
-	private.DELETE("/projects/:project_id/members/:profile_id/roles/:role_id", handlers.RemoveProjectMemberRole(db))
+	private.DELETE("/projects/:project_id/members/:profile_id/roles/:role_id", handlers.RemoveProjectMemberRole(db), middleware.ProjectPermissionMiddleware(db, models.PermissionProjectMembers))
+
+	// Project Roles and Permissions
+	public.GET("/permissions", handlers.ListPermissions(db))
+	private.GET("/projects/:project_id/roles", handlers.ListProjectRoles(db))
+	private.POST("/projects/:project_id/roles", handlers.CreateProjectRole(db), middleware.ProjectPermissionMiddleware(db, models.PermissionProjectMembers))
+	private.PUT("/projects/:project_id/roles/:role_id", handlers.UpdateProjectRole(db), middleware.ProjectPermissionMiddleware(db, models.PermissionProjectMembers))
+	private.DELETE("/projects/:project_id/roles/:role_id", handlers.DeleteProjectRole(db), middleware.ProjectPermissionMiddleware(db, models.PermissionProjectMembers))
+
+	// Audit Log
+	private.GET("/audit_log", handlers.ListAuditLog(db), middleware.IsApplicationAdmin)
+	private.GET("/projects/:project_id/audit_log", handlers.ListProjectAuditLog(db), middleware.ProjectPermissionMiddleware(db, models.PermissionAuditRead))
 
 	// Project Timeseries
-	private.POST("/projects/:project_id/timeseries/:timeseries_id", handlers.CreateProjectTimeseries(db))
-	private.DELETE("/projects/:project_id/timeseries/:timeseries_id", handlers.DeleteProjectTimeseries(db))
+	private.POST("/projects/:project_id/timeseries/:timeseries_id", handlers.CreateProjectTimeseries(db), middleware.ProjectPermissionMiddleware(db, models.PermissionTimeseriesWrite))
+	private.DELETE("/projects/:project_id/timeseries/:timeseries_id", handlers.DeleteProjectTimeseries(db), middleware.ProjectPermissionMiddleware(db, models.PermissionTimeseriesWrite))
 
 	// Instruments
 	public.GET("/instruments", handlers.ListInstruments(db))
 	public.GET("/instruments/count", handlers.GetInstrumentCount(db))
 	public.GET("/instruments/:instrument_id", handlers.GetInstrument(db))
-	private.POST("/projects/:project_id/instruments", handlers.CreateInstruments(db))
+	private.POST("/projects/:project_id/instruments", handlers.CreateInstruments(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
 	// TODO: Remove endpoint POST /instruments (no project context)
-	private.POST("/instruments", handlers.CreateInstruments(db))
-	private.PUT("/projects/:project_id/instruments/:instrument_id", handlers.UpdateInstrument(db))
-	private.PUT("/projects/:project_id/instruments/:instrument_id/geometry", handlers.UpdateInstrumentGeometry(db))
-	private.DELETE("/projects/:project_id/instruments/:instrument_id", handlers.DeleteFlagInstrument(db))
+	private.POST("/instruments", handlers.CreateInstruments(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
+	private.PUT("/projects/:project_id/instruments/:instrument_id", handlers.UpdateInstrument(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
+	private.PUT("/projects/:project_id/instruments/:instrument_id/geometry", handlers.UpdateInstrumentGeometry(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
+	private.DELETE("/projects/:project_id/instruments/:instrument_id", handlers.DeleteFlagInstrument(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
 
 	// Instrument Groups
 	public.GET("/instrument_groups", handlers.ListInstrumentGroups(db))
 	public.GET("/instrument_groups/:instrument_group_id", handlers.GetInstrumentGroup(db))
 	public.GET("/instrument_groups/:instrument_group_id/instruments", handlers.ListInstrumentGroupInstruments(db))
 	public.GET("/instrument_groups/:instrument_group_id/timeseries", handlers.ListInstrumentGroupTimeseries(db))
-	private.POST("/instrument_groups", handlers.CreateInstrumentGroup(db))
-	private.PUT("/instrument_groups/:instrument_group_id", handlers.UpdateInstrumentGroup(db))
-	private.DELETE("/instrument_groups/:instrument_group_id", handlers.DeleteFlagInstrumentGroup(db))
+	private.POST("/instrument_groups", handlers.CreateInstrumentGroup(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
+	private.PUT("/instrument_groups/:instrument_group_id", handlers.UpdateInstrumentGroup(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
+	private.DELETE("/instrument_groups/:instrument_group_id", handlers.DeleteFlagInstrumentGroup(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
 	// Add or Remove instrument from Instrument Group
-	private.POST("/instrument_groups/:instrument_group_id/instruments", handlers.CreateInstrumentGroupInstruments(db))
-	private.DELETE("/instrument_groups/:instrument_group_id/instruments/:instrument_id", handlers.DeleteInstrumentGroupInstruments(db))
+	private.POST("/instrument_groups/:instrument_group_id/instruments", handlers.CreateInstrumentGroupInstruments(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
+	private.DELETE("/instrument_groups/:instrument_group_id/instruments/:instrument_id", handlers.DeleteInstrumentGroupInstruments(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
 
 	// Instrument Constants (same as a timeseries in structure/payload)
 	public.GET("/projects/:project_id/instruments/:instrument_id/constants", handlers.ListInstrumentConstants(db))
-	private.POST("/projects/:project_id/instruments/:instrument_id/constants", handlers.CreateInstrumentConstants(db))
-	private.DELETE("/projects/:project_id/instruments/:instrument_id/constants/:timeseries_id", handlers.DeleteInstrumentConstant(db))
+	public.GET("/projects/:project_id/instruments/:instrument_id/constants/:timeseries_id/history", handlers.ListInstrumentConstantHistory(db))
+	private.POST("/projects/:project_id/instruments/:instrument_id/constants", handlers.CreateInstrumentConstants(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
+	private.DELETE("/projects/:project_id/instruments/:instrument_id/constants/:timeseries_id", handlers.DeleteInstrumentConstant(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
+
+	// Instrument Formulas (computed timeseries)
+	public.GET("/projects/:project_id/instruments/:instrument_id/formulas", handlers.ListInstrumentFormulas(db))
+	public.GET("/projects/:project_id/instruments/:instrument_id/formulas/:formula_id", handlers.GetFormula(db))
+	private.POST("/projects/:project_id/instruments/:instrument_id/formulas", handlers.CreateInstrumentFormulas(db), middleware.ProjectPermissionMiddleware(db, models.PermissionTimeseriesWrite))
+	private.PUT("/projects/:project_id/instruments/:instrument_id/formulas/:formula_id", handlers.UpdateInstrumentFormula(db), middleware.ProjectPermissionMiddleware(db, models.PermissionTimeseriesWrite))
+	private.DELETE("/projects/:project_id/instruments/:instrument_id/formulas/:formula_id", handlers.DeleteInstrumentFormula(db), middleware.ProjectPermissionMiddleware(db, models.PermissionTimeseriesWrite))
+	private.POST("/projects/:project_id/formulas/preview", handlers.PreviewFormula(db), middleware.ProjectPermissionMiddleware(db, models.PermissionTimeseriesWrite))
+
+	// Computed Timeseries
+	public.GET("/projects/:project_id/instruments/:instrument_id/computed_timeseries", handlers.ListInstrumentComputedTimeseries(db))
 
 	// Instrument Notes(GET, PUT, DELETE work with or without instrument context in URL)
 	public.GET("/instruments/notes", handlers.ListInstrumentNotes(db))
 	public.GET("/instruments/notes/:note_id", handlers.GetInstrumentNote(db))
 	public.GET("/instruments/:instrument_id/notes", handlers.ListInstrumentInstrumentNotes(db))
 	public.GET("/instruments/:instrument_id/notes/:note_id", handlers.GetInstrumentNote(db))
-	private.POST("/instruments/notes", handlers.CreateInstrumentNote(db))
-	private.PUT("/instruments/notes/:note_id", handlers.UpdateInstrumentNote(db))
-	private.DELETE("/instruments/notes/:note_id", handlers.DeleteInstrumentNote(db))
-	private.PUT("/instruments/:instrument_id/notes/:note_id", handlers.UpdateInstrumentNote(db))
-	private.DELETE("/instruments/:instrument_id/notes/:note_id", handlers.DeleteInstrumentNote(db))
+	private.POST("/instruments/notes", handlers.CreateInstrumentNote(db), middleware.ProjectPermissionMiddleware(db, models.PermissionNoteWrite))
+	private.PUT("/instruments/notes/:note_id", handlers.UpdateInstrumentNote(db), middleware.ProjectPermissionMiddleware(db, models.PermissionNoteWrite))
+	private.DELETE("/instruments/notes/:note_id", handlers.DeleteInstrumentNote(db), middleware.ProjectPermissionMiddleware(db, models.PermissionNoteWrite))
+	private.PUT("/instruments/:instrument_id/notes/:note_id", handlers.UpdateInstrumentNote(db), middleware.ProjectPermissionMiddleware(db, models.PermissionNoteWrite))
+	private.DELETE("/instruments/:instrument_id/notes/:note_id", handlers.DeleteInstrumentNote(db), middleware.ProjectPermissionMiddleware(db, models.PermissionNoteWrite))
 
 	// Instrument Status
 	public.GET("/instruments/:instrument_id/status", handlers.ListInstrumentStatus(db))
 	public.GET("/instruments/:instrument_id/status/:status_id", handlers.GetInstrumentStatus(db))
-	private.POST("/instruments/:instrument_id/status", handlers.CreateOrUpdateInstrumentStatus(db))
-	private.DELETE("/instruments/:instrument_id/status/:status_id", handlers.DeleteInstrumentStatus(db))
+	private.POST("/instruments/:instrument_id/status", handlers.CreateOrUpdateInstrumentStatus(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
+	private.DELETE("/instruments/:instrument_id/status/:status_id", handlers.DeleteInstrumentStatus(db), middleware.ProjectPermissionMiddleware(db, models.PermissionInstrumentWrite))
 
 	// Timeseries
 	public.GET("/projects/:project_id/timeseries", handlers.ListProjectTimeseries(db))
@@ -263,28 +437,28 @@ func main() {
 	public.GET("/timeseries/:timeseries_id/measurements", handlers.ListTimeseriesMeasurements(db))
 	public.GET("/instruments/:instrument_id/timeseries/:timeseries_id/measurements", handlers.ListTimeseriesMeasurements(db))
 	// TODO: Delete timeseries endpoints without project context in URL
-	private.POST("/timeseries", handlers.CreateTimeseries(db))
-	private.PUT("/timeseries/:timeseries_id", handlers.UpdateTimeseries(db))
-	private.DELETE("/timeseries/:timeseries_id", handlers.DeleteTimeseries(db))
-	private.POST("/projects/:project_id/timeseries_measurements", handlers.CreateOrUpdateProjectTimeseriesMeasurements(db))
+	private.POST("/timeseries", handlers.CreateTimeseries(db), middleware.ProjectPermissionMiddleware(db, models.PermissionTimeseriesWrite))
+	private.PUT("/timeseries/:timeseries_id", handlers.UpdateTimeseries(db), middleware.ProjectPermissionMiddleware(db, models.PermissionTimeseriesWrite))
+	private.DELETE("/timeseries/:timeseries_id", handlers.DeleteTimeseries(db), middleware.ProjectPermissionMiddleware(db, models.PermissionTimeseriesWrite))
+	private.POST("/projects/:project_id/timeseries_measurements", handlers.CreateOrUpdateProjectTimeseriesMeasurements(db), middleware.ProjectPermissionMiddleware(db, models.PermissionMeasurementWrite))
 
 	// Collection Groups
 	public.GET("/projects/:project_id/collection_groups", handlers.ListCollectionGroups(db))
 	public.GET("/projects/:project_id/collection_groups/:collection_group_id", handlers.GetCollectionGroupDetails(db))
-	private.POST("/projects/:project_id/collection_groups", handlers.CreateCollectionGroup(db))
-	private.PUT("/projects/:project_id/collection_groups/:collection_group_id", handlers.UpdateCollectionGroup(db))
-	private.DELETE("/projects/:project_id/collection_groups/:collection_group_id", handlers.DeleteCollectionGroup(db))
+	private.POST("/projects/:project_id/collection_groups", handlers.CreateCollectionGroup(db), middleware.ProjectPermissionMiddleware(db, models.PermissionCollectionGroupWrite))
+	private.PUT("/projects/:project_id/collection_groups/:collection_group_id", handlers.UpdateCollectionGroup(db), middleware.ProjectPermissionMiddleware(db, models.PermissionCollectionGroupWrite))
+	private.DELETE("/projects/:project_id/collection_groups/:collection_group_id", handlers.DeleteCollectionGroup(db), middleware.ProjectPermissionMiddleware(db, models.PermissionCollectionGroupWrite))
 	// // Collection Group; Add Timeseries to collection_group
-	private.POST("/projects/:project_id/collection_groups/:collection_group_id/timeseries/:timeseries_id", handlers.AddTimeseriesToCollectionGroup(db))
+	private.POST("/projects/:project_id/collection_groups/:collection_group_id/timeseries/:timeseries_id", handlers.AddTimeseriesToCollectionGroup(db), middleware.ProjectPermissionMiddleware(db, models.PermissionCollectionGroupWrite))
 	// // Collection Group; Remove Timeseries from collection_group
-	private.DELETE("/projects/:project_id/collection_groups/:collection_group_id/timeseries/:timeseries_id", handlers.RemoveTimeseriesFromCollectionGroup(db))
+	private.DELETE("/projects/:project_id/collection_groups/:collection_group_id/timeseries/:timeseries_id", handlers.RemoveTimeseriesFromCollectionGroup(db), middleware.ProjectPermissionMiddleware(db, models.PermissionCollectionGroupWrite))
 
 	// Plotting Configurations
 	public.GET("/projects/:project_id/plot_configurations", handlers.ListPlotConfigurations(db))
 	public.GET("/projects/:project_id/plot_configurations/:plot_configuration_id", handlers.GetPlotConfiguration(db))
-	private.POST("/projects/:project_id/plot_configurations", handlers.CreatePlotConfiguration(db))
-	private.PUT("/projects/:project_id/plot_configurations/:plot_configuration_id", handlers.UpdatePlotConfiguration(db))
-	private.DELETE("/projects/:project_id/plot_configurations/:plot_configuration_id", handlers.DeletePlotConfiguration(db))
+	private.POST("/projects/:project_id/plot_configurations", handlers.CreatePlotConfiguration(db), middleware.ProjectPermissionMiddleware(db, models.PermissionPlotConfigurationWrite))
+	private.PUT("/projects/:project_id/plot_configurations/:plot_configuration_id", handlers.UpdatePlotConfiguration(db), middleware.ProjectPermissionMiddleware(db, models.PermissionPlotConfigurationWrite))
+	private.DELETE("/projects/:project_id/plot_configurations/:plot_configuration_id", handlers.DeletePlotConfiguration(db), middleware.ProjectPermissionMiddleware(db, models.PermissionPlotConfigurationWrite))
 
 	// Misc
 	public.GET("/domains", handlers.GetDomains(db))
@@ -1,10 +1,16 @@
 package middleware
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"log"
 	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/USACE/instrumentation-api/models"
 	jwt "github.com/dgrijalva/jwt-go"
@@ -17,9 +23,8 @@ import (
 // Used for CAC-Only Routes
 func EDIPIMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
-		// If key is in query parameters; count on keyauth
-		key := c.QueryParam("key")
-		if key == "" {
+		// If an API key is in the request; count on keyauth
+		if !requestHasKey(c) {
 			user := c.Get("user").(*jwt.Token)
 			claims := user.Claims.(jwt.MapClaims)
 			// Get EDIPI
@@ -43,42 +48,73 @@ func CACOnlyMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	}
 }
 
+// requestProfile returns the profile of an authenticated request, or nil if the request is not authenticated
+func requestProfile(db *sqlx.DB, c echo.Context) (*models.Profile, error) {
+	// If Application "Superuser" authenticated using Key Authentication (?key= query parameter),
+	// lookup superuser profile; the "EDIPI" of the Superuser is consistently 79.
+	// The superuser is initialized as part of database and seed data initialization
+	if c.Get("ApplicationKeyAuthSuccess") == true {
+		return models.GetProfileFromEDIPI(db, 79)
+	}
+	// If a User was authenticated via KeyAuth, lookup the user's profile using key_id
+	if c.Get("KeyAuthSuccess") == true {
+		keyID := c.Get("KeyAuthKeyID").(string)
+		return models.GetProfileFromTokenID(db, keyID)
+	}
+	// If a User was authenticated using CAC (JWT), lookup Profile by EDIPI
+	EDIPI := c.Get("EDIPI")
+	if EDIPI == nil {
+		return nil, nil
+	}
+	return models.GetProfileFromEDIPI(db, EDIPI.(int))
+}
+
 // AttachProfileID attaches ProfileID of user to context
 func AttachProfileMiddleware(db *sqlx.DB) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			// If Application "Superuser" authenticated using Key Authentication (?key= query parameter),
-			// lookup superuser profile; the "EDIPI" of the Superuser is consistently 79.
-			// The superuser is initialized as part of database and seed data initialization
-			if c.Get("ApplicationKeyAuthSuccess") == true {
-				p, err := models.GetProfileFromEDIPI(db, 79)
-				if err != nil {
-					return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
-				}
-				c.Set("profile", p)
-				return next(c)
+			p, err := requestProfile(db, c)
+			if err != nil || p == nil {
+				return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
 			}
-			// If a User was authenticated via KeyAuth, lookup the user's profile using key_id
-			if c.Get("KeyAuthSuccess") == true {
-				keyID := c.Get("KeyAuthKeyID").(string)
-				p, err := models.GetProfileFromTokenID(db, keyID)
-				if err != nil {
-					return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
-				}
-				c.Set("profile", p)
+			c.Set("profile", p)
+
+			return next(c)
+		}
+	}
+}
+
+// SkipIfAnonymous skips an authentication middleware for requests without credentials
+// Used for public routes, where authentication is optional
+func SkipIfAnonymous(m echo.MiddlewareFunc) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		h := m(next)
+		return func(c echo.Context) error {
+			if c.Request().Header.Get(echo.HeaderAuthorization) == "" && !requestHasKey(c) {
 				return next(c)
 			}
-			// If a User was authenticated using CAC (JWT), lookup Profile by EDIPI
-			EDIPI := c.Get("EDIPI")
-			if EDIPI == nil {
-				return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
+			return h(c)
+		}
+	}
+}
+
+// AttachOptionalProfileMiddleware attaches the profile of an authenticated request to context
+// Requests without a profile continue anonymously
+func AttachOptionalProfileMiddleware(db *sqlx.DB) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if user, ok := c.Get("user").(*jwt.Token); ok {
+				if claims, ok := user.Claims.(jwt.MapClaims); ok {
+					if sub, ok := claims["sub"].(string); ok {
+						if EDIPI, err := strconv.Atoi(sub); err == nil {
+							c.Set("EDIPI", EDIPI)
+						}
+					}
+				}
 			}
-			p, err := models.GetProfileFromEDIPI(db, EDIPI.(int))
-			if err != nil {
-				return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
+			if p, err := requestProfile(db, c); err == nil && p != nil {
+				c.Set("profile", p)
 			}
-			c.Set("profile", p)
-
 			return next(c)
 		}
 	}
@@ -97,7 +133,130 @@ func IsApplicationAdmin(next echo.HandlerFunc) echo.HandlerFunc {
 	}
 }
 
-func IsProjectAdminMiddleware(db *sqlx.DB) echo.MiddlewareFunc {
+// projectRouteParams are route parameters identifying an entity that belongs to a project
+var projectRouteParams = []string{
+	"instrument_id", "instrument_group_id", "note_id", "status_id", "formula_id", "alert_config_id",
+	"alert_webhook_id", "collection_group_id", "plot_configuration_id",
+}
+
+// projectBodyKeys are request body keys used to find the project of a request without project context
+var projectBodyKeys = []string{"project_id", "instrument_id", "timeseries_id"}
+
+// jsonObjects returns the objects of a JSON value holding an object or array of objects
+func jsonObjects(v interface{}) []map[string]interface{} {
+	oo := make([]map[string]interface{}, 0)
+	switch t := v.(type) {
+	case map[string]interface{}:
+		oo = append(oo, t)
+	case []interface{}:
+		for _, e := range t {
+			if o, ok := e.(map[string]interface{}); ok {
+				oo = append(oo, o)
+			}
+		}
+	}
+	return oo
+}
+
+// requestBodyIDs returns the values of projectBodyKeys in a JSON request body holding an object or array of objects
+// The request body is restored so it can be bound by the handler. The body is read once per request; the IDs are
+// kept in context for the middleware that follows
+func requestBodyIDs(c echo.Context) (map[string][]string, error) {
+	if ids, ok := c.Get("RequestBodyIDs").(map[string][]string); ok {
+		return ids, nil
+	}
+	req := c.Request()
+	if req.Body == nil {
+		return nil, nil
+	}
+	b, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	ids := make(map[string][]string)
+	var v interface{}
+	// Malformed request bodies identify no project
+	if err := json.Unmarshal(b, &v); err == nil {
+		for _, o := range jsonObjects(v) {
+			for _, k := range projectBodyKeys {
+				if id, ok := o[k].(string); ok {
+					ids[k] = append(ids[k], id)
+				}
+			}
+		}
+	}
+	c.Set("RequestBodyIDs", ids)
+	return ids, nil
+}
+
+// requestProjectIDs returns the projects a request acts on; the project_id route parameter and the projects of
+// entities in the route. Timeseries can be added to more than one project, so timeseries_id only determines the
+// project of routes without project context, e.g. PUT /timeseries/:timeseries_id. Routes without any project
+// context, e.g. POST /instrument_groups, act on the projects of the entities in the request body.
+// Projects are looked up once per request and kept in context for the middleware that follows
+func requestProjectIDs(db *sqlx.DB, c echo.Context) ([]uuid.UUID, error) {
+	if pp, ok := c.Get("RequestProjectIDs").([]uuid.UUID); ok {
+		return pp, nil
+	}
+	projects := make(map[uuid.UUID]bool)
+	add := func(key, value string) error {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			// Malformed IDs identify no project; handlers reject them
+			return nil
+		}
+		if key == "project_id" {
+			projects[id] = true
+			return nil
+		}
+		projectID, err := models.GetEntityProjectID(db, key, id)
+		if err != nil {
+			return err
+		}
+		if projectID != nil {
+			projects[*projectID] = true
+		}
+		return nil
+	}
+	for _, k := range append([]string{"project_id"}, projectRouteParams...) {
+		if v := c.Param(k); v != "" {
+			if err := add(k, v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(projects) == 0 {
+		if v := c.Param("timeseries_id"); v != "" {
+			if err := add("timeseries_id", v); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(projects) == 0 {
+		ids, err := requestBodyIDs(c)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range projectBodyKeys {
+			for _, v := range ids[k] {
+				if err := add(k, v); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	pp := make([]uuid.UUID, 0, len(projects))
+	for id := range projects {
+		pp = append(pp, id)
+	}
+	c.Set("RequestProjectIDs", pp)
+	return pp, nil
+}
+
+// ProjectPermissionMiddleware allows a request if the profile is granted permission in every project the request acts on
+func ProjectPermissionMiddleware(db *sqlx.DB, permission string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			p, ok := c.Get("profile").(*models.Profile)
@@ -108,48 +267,272 @@ func IsProjectAdminMiddleware(db *sqlx.DB) echo.MiddlewareFunc {
 			if p.IsAdmin {
 				return next(c)
 			}
-			// Lookup project from URL Route Parameter
-			projectID, err := uuid.Parse(c.Param("project_id"))
-			if err != nil {
+			projectIDs, err := requestProjectIDs(db, c)
+			if err != nil || len(projectIDs) == 0 {
 				return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
 			}
-			project, err := models.GetProject(db, projectID)
-			if err != nil {
+			granted, err := models.HasProjectPermissionAll(db, &p.ID, projectIDs, permission)
+			if err != nil || !granted {
 				return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
 			}
-			grantingRole := fmt.Sprintf("%s.ADMIN", strings.ToUpper(project.Slug))
-			for _, r := range p.Roles {
-				if r == grantingRole {
-					return next(c)
+			return next(c)
+		}
+	}
+}
+
+// ProjectVisibilityMiddleware hides private projects from profiles that can not read them
+// Requests for a private project, or an entity of a private project, are not found
+func ProjectVisibilityMiddleware(db *sqlx.DB) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			p, _ := c.Get("profile").(*models.Profile)
+			// Application Admins can read all projects
+			if p != nil && p.IsAdmin {
+				return next(c)
+			}
+			pp, err := requestProjectIDs(db, c)
+			if err != nil {
+				return c.String(http.StatusInternalServerError, err.Error())
+			}
+			// Copied so the projects of the request kept in context are not changed
+			projectIDs := append(make([]uuid.UUID, 0, len(pp)+2), pp...)
+			// Timeseries are read in the project of their instrument, even on routes with other project context
+			if v := c.Param("timeseries_id"); v != "" {
+				if id, err := uuid.Parse(v); err == nil {
+					projectID, err := models.GetEntityProjectID(db, "timeseries_id", id)
+					if err != nil {
+						return c.String(http.StatusInternalServerError, err.Error())
+					}
+					if projectID != nil {
+						projectIDs = append(projectIDs, *projectID)
+					}
 				}
 			}
-			return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
+			if slug := c.Param("project_slug"); slug != "" {
+				projectID, err := models.GetProjectIDFromSlug(db, slug)
+				if err != nil {
+					return c.String(http.StatusInternalServerError, err.Error())
+				}
+				if projectID != nil {
+					projectIDs = append(projectIDs, *projectID)
+				}
+			}
+			readable, err := models.CanReadProjects(db, p, projectIDs)
+			if err != nil {
+				return c.String(http.StatusInternalServerError, err.Error())
+			}
+			if !readable {
+				return c.JSON(http.StatusNotFound, models.DefaultMessageNotFound)
+			}
+			return next(c)
+		}
+	}
+}
+
+// auditMaxResponseSize is the largest response body recorded in the audit log
+const auditMaxResponseSize = 256 << 10
+
+// auditSkippedRoutes are route suffixes of requests that do not change data, or run on a schedule; they are not
+// recorded in the audit log
+var auditSkippedRoutes = []string{"/backtest", "/formulas/preview", "/explorer", "/heartbeat"}
+
+// auditRedactedParams are route parameters holding secrets; they are not recorded in the audit log
+var auditRedactedParams = []string{"token"}
+
+// auditResponseWriter keeps a copy of the response body for the audit log
+type auditResponseWriter struct {
+	http.ResponseWriter
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	if !w.overflow {
+		if w.body.Len()+len(b) > auditMaxResponseSize {
+			w.overflow = true
+			w.body.Reset()
+		} else {
+			w.body.Write(b)
 		}
 	}
+	return w.ResponseWriter.Write(b)
 }
 
-func IsProjectMemberMiddleware(db *sqlx.DB) echo.MiddlewareFunc {
+func (w *auditResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// auditedRequest returns true if a request may change data
+func auditedRequest(c echo.Context) bool {
+	switch c.Request().Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	for _, r := range auditSkippedRoutes {
+		if strings.HasSuffix(c.Path(), r) {
+			return false
+		}
+	}
+	return true
+}
+
+// requestAuthMethod returns how a request was authenticated, and the key ID of a token
+func requestAuthMethod(c echo.Context) (string, *string) {
+	if c.Get("ApplicationKeyAuthSuccess") == true {
+		return models.AuthMethodApplicationKey, nil
+	}
+	if c.Get("KeyAuthSuccess") == true {
+		keyID := c.Get("KeyAuthKeyID").(string)
+		return models.AuthMethodToken, &keyID
+	}
+	if _, ok := c.Get("user").(*jwt.Token); ok {
+		return models.AuthMethodJWT, nil
+	}
+	return models.AuthMethodAnonymous, nil
+}
+
+// AuditMiddleware records requests that may change data in the audit log; the actor, auth method, route, entities,
+// response status and the entity named by the last audited route parameter before and after the request.
+// Requests that create entities record the created objects from the response. Requests are not failed if the
+// action can not be recorded
+func AuditMiddleware(db *sqlx.DB) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			p, ok := c.Get("profile").(*models.Profile)
-			if !ok {
-				return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
+			if !auditedRequest(c) {
+				return next(c)
 			}
-			projectID, err := uuid.Parse(c.Param("project_id"))
+			a := models.Action{
+				Time:       time.Now(),
+				Method:     c.Request().Method,
+				Route:      c.Path(),
+				Params:     make(map[string]string),
+				ProjectIDs: make([]uuid.UUID, 0),
+				EntityIDs:  make([]uuid.UUID, 0),
+			}
+			a.AuthMethod, a.TokenID = requestAuthMethod(c)
+			entities := make(map[uuid.UUID]bool)
+			// Entities in the route; the last audited entity is recorded before and after the request
+			var param string
+			var entityID uuid.UUID
+			for _, k := range c.ParamNames() {
+				v := c.Param(k)
+				redacted := false
+				for _, r := range auditRedactedParams {
+					if k == r {
+						redacted = true
+					}
+				}
+				if redacted {
+					continue
+				}
+				a.Params[k] = v
+				if id, err := uuid.Parse(v); err == nil {
+					entities[id] = true
+					if models.IsAuditedParam(k) {
+						param, entityID = k, id
+					}
+				}
+			}
+			// Entities in the request body
+			ids, err := requestBodyIDs(c)
 			if err != nil {
-				return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
+				log.Printf("audit: request body not read: %s\n", err.Error())
 			}
-			project, err := models.GetProject(db, projectID)
+			for _, vv := range ids {
+				for _, v := range vv {
+					if id, err := uuid.Parse(v); err == nil {
+						entities[id] = true
+					}
+				}
+			}
+			projects := make(map[uuid.UUID]bool)
+			projectIDs, err := requestProjectIDs(db, c)
 			if err != nil {
-				return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
+				log.Printf("audit: projects not found: %s\n", err.Error())
 			}
-			grantingRole := fmt.Sprintf("%s.MEMBER", strings.ToUpper(project.Slug))
-			for _, r := range p.Roles {
-				if r == grantingRole {
-					return next(c)
+			for _, id := range projectIDs {
+				projects[id] = true
+			}
+			var before map[string]interface{}
+			if param != "" {
+				if before, err = models.GetAuditSnapshot(db, param, &entityID); err != nil {
+					log.Printf("audit: %s %s not recorded: %s\n", param, entityID, err.Error())
 				}
 			}
-			return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
+
+			w := &auditResponseWriter{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = w
+			// Errors are handled here so the status written to the response is recorded; the error is not
+			// returned, or the error handler would write a second response
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+			c.Response().Writer = w.ResponseWriter
+			a.Status = c.Response().Status
+
+			var after interface{}
+			if a.Status < http.StatusBadRequest {
+				var snapshot map[string]interface{}
+				if param != "" {
+					if snapshot, err = models.GetAuditSnapshot(db, param, &entityID); err != nil {
+						log.Printf("audit: %s %s not recorded: %s\n", param, entityID, err.Error())
+					}
+				}
+				if a.Method == http.MethodPost && (snapshot == nil || reflect.DeepEqual(before, snapshot)) && !w.overflow {
+					// Entities created by the request
+					var created interface{}
+					if err := json.Unmarshal(w.body.Bytes(), &created); err == nil {
+						models.RedactAuditValue(created)
+						for _, o := range jsonObjects(created) {
+							if id, err := uuid.Parse(fmt.Sprint(o["id"])); err == nil {
+								entities[id] = true
+							}
+							if id, err := uuid.Parse(fmt.Sprint(o["project_id"])); err == nil {
+								projects[id] = true
+							}
+						}
+						after = created
+					}
+				} else if snapshot != nil {
+					after = snapshot
+					if before != nil {
+						if a.Diff, err = models.NewJSONValue(models.AuditDiff(before, snapshot)); err != nil {
+							log.Printf("audit: diff not recorded: %s\n", err.Error())
+						}
+					}
+				}
+			}
+			if before != nil {
+				if a.Before, err = models.NewJSONValue(before); err != nil {
+					log.Printf("audit: before not recorded: %s\n", err.Error())
+				}
+			}
+			if a.After, err = models.NewJSONValue(after); err != nil {
+				log.Printf("audit: after not recorded: %s\n", err.Error())
+			}
+			for id := range entities {
+				a.EntityIDs = append(a.EntityIDs, id)
+			}
+			for id := range projects {
+				a.ProjectIDs = append(a.ProjectIDs, id)
+			}
+
+			// The actor of requests on routes without a profile, e.g. POST /profiles, is looked up after the request
+			p, ok := c.Get("profile").(*models.Profile)
+			if !ok {
+				if p, err = requestProfile(db, c); err != nil {
+					p = nil
+				}
+			}
+			if p != nil {
+				a.Actor = &p.ID
+			}
+			if err := models.CreateAction(db, &a); err != nil {
+				log.Printf("audit: %s %s not recorded: %s\n", a.Method, a.Route, err.Error())
+			}
+			return nil
 		}
 	}
 }
@@ -3,4 +3,9 @@ package middleware
 import "github.com/labstack/echo/v4/middleware"
 
 // CORS is ready-to-go CORS middleware
-var CORS = middleware.CORS()
+// Rate limit headers are exposed so browser clients can read them
+var CORS = middleware.CORSWithConfig(middleware.CORSConfig{
+	AllowOrigins:  middleware.DefaultCORSConfig.AllowOrigins,
+	AllowMethods:  middleware.DefaultCORSConfig.AllowMethods,
+	ExposeHeaders: []string{HeaderRateLimitLimit, HeaderRateLimitRemaining, HeaderRateLimitReset, HeaderRetryAfter},
+})
@@ -0,0 +1,258 @@
+package middleware
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+)
+
+// jwksMissRefresh limits how often an unknown kid reloads the JWKS document; keys rotated by the
+// identity provider are picked up without waiting for the next refresh
+const jwksMissRefresh = time.Minute
+
+// After a failed reload the JWKS document is not reloaded for jwksRetryMin, doubled after each further failure
+// up to jwksRetryMax
+const (
+	jwksRetryMin = 10 * time.Second
+	jwksRetryMax = 5 * time.Minute
+)
+
+// JWTKeyConfig configures the keys and claims used to verify JWTs
+// At least one of PublicKey, JWKSURL or JWKSFile is required
+type JWTKeyConfig struct {
+	// PublicKey is a PEM encoded RSA public key; used for tokens without a kid, or with a kid not in the JWKS
+	PublicKey string
+	// JWKSURL is the URL of a JWKS document
+	JWKSURL string
+	// JWKSFile is the path of a local JWKS document; used if JWKSURL is not set
+	JWKSFile string
+	// JWKSRefresh is how often the JWKS document is reloaded
+	JWKSRefresh time.Duration
+	// Issuer and Audience are required to match the iss and aud claims if set
+	Issuer   string
+	Audience string
+}
+
+// JWTKeySet holds the keys used to verify JWTs, selected by kid
+type JWTKeySet struct {
+	cfg    JWTKeyConfig
+	client *http.Client
+	static *rsa.PublicKey
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	loaded    time.Time
+	lastMiss  time.Time
+	attempted time.Time
+	failures  int
+	reloading bool
+}
+
+// jwk is a JSON Web Key; only RSA keys are used
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// NewJWTKeySet loads the keys configured to verify JWTs
+func NewJWTKeySet(cfg JWTKeyConfig) (*JWTKeySet, error) {
+	s := JWTKeySet{cfg: cfg, client: &http.Client{Timeout: 10 * time.Second}, keys: make(map[string]*rsa.PublicKey)}
+	if cfg.PublicKey == "" && cfg.JWKSURL == "" && cfg.JWKSFile == "" {
+		return nil, errors.New("no JWT verification keys configured; set a public key, JWKS URL or JWKS file")
+	}
+	if cfg.PublicKey != "" {
+		k, err := jwt.ParseRSAPublicKeyFromPEM([]byte(cfg.PublicKey))
+		if err != nil {
+			return nil, err
+		}
+		s.static = k
+	}
+	if s.jwksConfigured() {
+		keys, err := s.fetch()
+		if err != nil {
+			return nil, err
+		}
+		s.keys, s.loaded, s.attempted = keys, time.Now(), time.Now()
+	}
+	return &s, nil
+}
+
+func (s *JWTKeySet) jwksConfigured() bool {
+	return s.cfg.JWKSURL != "" || s.cfg.JWKSFile != ""
+}
+
+// readJWKS reads the JWKS document from the configured URL or file
+func (s *JWTKeySet) readJWKS() ([]byte, error) {
+	if s.cfg.JWKSURL == "" {
+		return ioutil.ReadFile(s.cfg.JWKSFile)
+	}
+	resp, err := s.client.Get(s.cfg.JWKSURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS request returned %s", resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// fetch returns the RSA signing keys of the JWKS document
+func (s *JWTKeySet) fetch() (map[string]*rsa.PublicKey, error) {
+	b, err := s.readJWKS()
+	if err != nil {
+		return nil, err
+	}
+	var doc struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]*rsa.PublicKey)
+	for _, k := range doc.Keys {
+		if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") || (k.Alg != "" && !strings.HasPrefix(k.Alg, "RS")) {
+			continue
+		}
+		pk, err := parseJWK(&k)
+		if err != nil {
+			return nil, fmt.Errorf("JWKS key %s: %s", k.Kid, err.Error())
+		}
+		keys[k.Kid] = pk
+	}
+	return keys, nil
+}
+
+// parseJWK returns the RSA public key of a JWK
+func parseJWK(k *jwk) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, err
+	}
+	exp := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exp.IsInt64() || exp.Int64() < 2 || exp.Int64() > 1<<31-1 {
+		return nil, errors.New("invalid RSA key")
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exp.Int64())}, nil
+}
+
+// reloadDue returns true if the JWKS document should be reloaded for a kid; s.mu must be held.
+// Only one reload runs at a time, and failed reloads are retried with backoff
+func (s *JWTKeySet) reloadDue(kid string) bool {
+	if !s.jwksConfigured() || s.reloading {
+		return false
+	}
+	if s.failures > 0 {
+		retry := jwksRetryMin
+		for i := 1; i < s.failures && retry < jwksRetryMax; i++ {
+			retry *= 2
+		}
+		if retry > jwksRetryMax {
+			retry = jwksRetryMax
+		}
+		if time.Since(s.attempted) < retry {
+			return false
+		}
+	}
+	_, found := s.keys[kid]
+	stale := s.cfg.JWKSRefresh > 0 && time.Since(s.loaded) > s.cfg.JWKSRefresh
+	miss := kid != "" && !found && time.Since(s.lastMiss) > jwksMissRefresh
+	if miss {
+		s.lastMiss = time.Now()
+	}
+	return stale || miss
+}
+
+// reload fetches the JWKS document without holding s.mu, so other requests are verified with the current keys
+// in the meantime. Keys are kept if reloading fails
+func (s *JWTKeySet) reload() {
+	keys, err := s.fetch()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.reloading = false
+	if err != nil {
+		s.failures++
+		log.Printf("JWKS not reloaded: %s\n", err.Error())
+		return
+	}
+	s.keys, s.loaded, s.failures = keys, time.Now(), 0
+}
+
+// key returns the key with a kid. The JWKS document is reloaded every refresh interval, and when a kid is not
+// found at most once every jwksMissRefresh
+func (s *JWTKeySet) key(kid string) (*rsa.PublicKey, error) {
+	s.mu.Lock()
+	if s.reloadDue(kid) {
+		s.reloading, s.attempted = true, time.Now()
+		s.mu.Unlock()
+		s.reload()
+		s.mu.Lock()
+	}
+	defer s.mu.Unlock()
+	if k, ok := s.keys[kid]; ok && kid != "" {
+		return k, nil
+	}
+	if s.static != nil {
+		return s.static, nil
+	}
+	// Tokens without a kid are verified with the only key of a JWKS document
+	if kid == "" && len(s.keys) == 1 {
+		for _, k := range s.keys {
+			return k, nil
+		}
+	}
+	return nil, fmt.Errorf("no key for kid '%s'", kid)
+}
+
+// hasAudience returns true if the aud claim, a string or array of strings, includes audience
+func hasAudience(claims jwt.MapClaims, audience string) bool {
+	switch aud := claims["aud"].(type) {
+	case string:
+		return aud == audience
+	case []interface{}:
+		for _, a := range aud {
+			if s, ok := a.(string); ok && s == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// KeyFunc returns the key to verify a token, after checking the token's signing method, issuer and audience
+func (s *JWTKeySet) KeyFunc(t *jwt.Token) (interface{}, error) {
+	if _, ok := t.Method.(*jwt.SigningMethodRSA); !ok {
+		return nil, fmt.Errorf("unexpected jwt signing method=%v", t.Header["alg"])
+	}
+	claims, ok := t.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("unexpected jwt claims")
+	}
+	if s.cfg.Issuer != "" && !claims.VerifyIssuer(s.cfg.Issuer, true) {
+		return nil, errors.New("invalid jwt issuer")
+	}
+	if s.cfg.Audience != "" && !hasAudience(claims, s.cfg.Audience) {
+		return nil, errors.New("invalid jwt audience")
+	}
+	kid, _ := t.Header["kid"].(string)
+	return s.key(kid)
+}
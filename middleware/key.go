@@ -1,63 +1,178 @@
 package middleware
 
 import (
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/USACE/instrumentation-api/models"
 	"github.com/USACE/instrumentation-api/passwords"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 )
 
-// HashExtractor returns a hash (string) to be compared with user supplied key
-type HashExtractor func(keyID string) (string, error)
+// API keys are sent in the X-API-Key header, with the key ID of user tokens in the X-API-Key-ID header,
+// or in the query string (?key=&key_id=). Keys in headers take precedence; if the X-API-Key header is set, the key
+// ID is read from the X-API-Key-ID header and the query string is ignored
+const (
+	HeaderAPIKey   = "X-API-Key"
+	HeaderAPIKeyID = "X-API-Key-ID"
+)
+
+// MessageQueryKeyDisabled is returned for keys in the query string when query string keys are disabled
+var MessageQueryKeyDisabled = models.Message{
+	Message: "API keys in the query string are disabled; send the key in the " + HeaderAPIKey + " header",
+}
+
+// requestKey returns the API key and key ID of a request, and whether they were read from the query string
+func requestKey(c echo.Context) (string, string, bool) {
+	if key := c.Request().Header.Get(HeaderAPIKey); key != "" {
+		return key, c.Request().Header.Get(HeaderAPIKeyID), false
+	}
+	if key := c.QueryParam("key"); key != "" {
+		return key, c.QueryParam("key_id"), true
+	}
+	return "", "", false
+}
+
+// requestHasKey returns true if a request carries an API key in a header or the query string
+// Requests with a key are authenticated by key auth; JWT middleware with skipIfKey is skipped
+func requestHasKey(c echo.Context) bool {
+	key, _, _ := requestKey(c)
+	return key != ""
+}
+
+// AppKeyAuth allows requests with the application key; used for routes only called by other components of the app
+func AppKeyAuth(appKey string, queryDisabled bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key, _, fromQuery := requestKey(c)
+			if key == "" {
+				return echo.NewHTTPError(http.StatusBadRequest, "missing key")
+			}
+			if fromQuery && queryDisabled {
+				return c.JSON(http.StatusUnauthorized, MessageQueryKeyDisabled)
+			}
+			if key != appKey {
+				return echo.NewHTTPError(http.StatusUnauthorized, "invalid key")
+			}
+			c.Set("ApplicationKeyAuthSuccess", true)
+			return next(c)
+		}
+	}
+}
 
 // KeyAuth returns a ready-to-go key auth middleware
-func KeyAuth(isDisabled bool, appKey string, h HashExtractor) echo.MiddlewareFunc {
-	return middleware.KeyAuthWithConfig(
-		middleware.KeyAuthConfig{
+// Tokens are denied once expired, and are only allowed requests within their scopes and projects
+func KeyAuth(db *sqlx.DB, isDisabled bool, appKey string, queryDisabled bool) echo.MiddlewareFunc {
+	// validate compares a key with the application key or the hash stored in the database
+	validate := func(key, keyID string, c echo.Context) (bool, error) {
+		// If Key is Master ApplicationKey; Grant Access
+		////////////////////////////////////////////////
+		if key == appKey {
+			c.Set("ApplicationKeyAuthSuccess", true)
+			return true, nil
+		}
+		// Check Key against stored hash in the database
+		////////////////////////////////////////////////
+		// If key_id not provided; Deny Access
+		if keyID == "" {
+			return false, nil
+		}
+		// Lookup token in database using key_id; Deny Access if not found, error or expired
+		n, err := models.GetTokenInfoByTokenID(db, &keyID)
+		if err != nil || n.Expired() {
+			return false, nil
+		}
+		// Compare provided key with key hash; Deny Access if error
+		match, err := passwords.ComparePasswordAndHash(key, n.Hash)
+		if err != nil {
+			return false, err
+		}
+		// Compare provided key with key hash; Allow access if match
+		if match {
+			if err := models.UpdateTokenLastUsed(db, &n.ID); err != nil {
+				log.Printf("token %s last used not updated: %s\n", n.TokenID, err.Error())
+			}
+			c.Set("KeyAuthSuccess", true)
+			c.Set("KeyAuthKeyID", keyID)
+			c.Set("KeyAuthToken", n)
+			return true, nil
+		}
+		// Deny Access otherwise
+		return false, nil
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		h := tokenScopeMiddleware(db)(next)
+		return func(c echo.Context) error {
 			// If Auth Manually Disabled via Environment Variable
-			// or "?key=..." is not in QueryParams (counting on other auth middleware
+			// or no key is in the request (counting on other auth middleware
 			// further down the chain); Skip this middleware
-			Skipper: func(c echo.Context) bool {
-				if isDisabled || c.QueryParam("key") == "" {
-					return true
-				}
-				return false
-			},
-			// Compare key passed via query parameters with hash stored in the database
-			Validator: func(key string, c echo.Context) (bool, error) {
-				// If Key is Master ApplicationKey; Grant Access
-				////////////////////////////////////////////////
-				if key == appKey {
-					c.Set("ApplicationKeyAuthSuccess", true)
-					return true, nil
-				}
-				// Check Key against stored hash in the database
-				////////////////////////////////////////////////
-				// If key_id not provided as query parameter; Deny Access
-				keyID := c.QueryParam("key_id")
-				if keyID == "" {
-					return false, nil
-				}
-				// Lookup hash in database using key_id; Deny Access if not found or error
-				hash, err := h(keyID)
-				if err != nil {
-					return false, nil
-				}
-				// Compare provided key with key hash; Deny Access if error
-				match, err := passwords.ComparePasswordAndHash(key, hash)
-				if err != nil {
-					return false, err
-				}
-				// Compare provided key with key hash; Allow access if match
-				if match {
-					c.Set("KeyAuthSuccess", true)
-					c.Set("KeyAuthKeyID", keyID)
-					return true, nil
+			key, keyID, fromQuery := requestKey(c)
+			if isDisabled || key == "" {
+				return h(c)
+			}
+			if fromQuery && queryDisabled {
+				return c.JSON(http.StatusUnauthorized, MessageQueryKeyDisabled)
+			}
+			valid, err := validate(key, keyID, c)
+			if err != nil {
+				return &echo.HTTPError{Code: http.StatusUnauthorized, Message: "invalid key", Internal: err}
+			}
+			if !valid {
+				return echo.NewHTTPError(http.StatusUnauthorized, "invalid key")
+			}
+			return h(c)
+		}
+	}
+}
+
+// tokenScopeAllowed returns true if a token's scopes allow a request
+func tokenScopeAllowed(n *models.TokenInfo, c echo.Context) bool {
+	if n.HasScope(models.TokenScopeAdmin) {
+		return true
+	}
+	method := c.Request().Method
+	if n.HasScope(models.TokenScopeRead) && (method == http.MethodGet || method == http.MethodHead) {
+		return true
+	}
+	if n.HasScope(models.TokenScopeWriteMeasurements) && method == http.MethodPost &&
+		strings.HasSuffix(c.Path(), "/timeseries_measurements") {
+		return true
+	}
+	return false
+}
+
+// tokenScopeMiddleware denies requests authenticated with a token outside the token's scopes or projects
+// Tokens restricted to projects are only allowed requests that act on those projects; requests without project
+// context, e.g. GET /projects or GET /instruments, are denied because they are not limited to the token's projects
+func tokenScopeMiddleware(db *sqlx.DB) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			n, ok := c.Get("KeyAuthToken").(*models.TokenInfo)
+			if !ok {
+				return next(c)
+			}
+			if !tokenScopeAllowed(n, c) {
+				return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
+			}
+			if len(n.ProjectIDs) == 0 {
+				return next(c)
+			}
+			projectIDs, err := requestProjectIDs(db, c)
+			if err != nil {
+				return c.String(http.StatusInternalServerError, err.Error())
+			}
+			if len(projectIDs) == 0 {
+				return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
+			}
+			for _, projectID := range projectIDs {
+				if !n.AllowsProject(projectID) {
+					return c.JSON(http.StatusForbidden, models.DefaultMessageUnauthorized)
 				}
-				// Deny Access otherwise
-				return false, nil
-			},
-			KeyLookup: "query:key",
-		},
-	)
+			}
+			return next(c)
+		}
+	}
 }
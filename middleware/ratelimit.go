@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/USACE/instrumentation-api/models"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/labstack/echo/v4"
+)
+
+// Rate limit response headers; X-RateLimit-Reset is the unix time the current window ends and Retry-After is the
+// number of seconds until then, sent with 429 responses
+const (
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderRateLimitReset     = "X-RateLimit-Reset"
+	HeaderRetryAfter         = "Retry-After"
+)
+
+// MessageRateLimited is returned for requests over a rate limit
+var MessageRateLimited = models.Message{Message: "Rate Limit Exceeded"}
+
+// RateLimitConfig is the number of read (GET and HEAD) and write requests allowed for each client every window;
+// requests with the application key share the AppRead and AppWrite budgets
+type RateLimitConfig struct {
+	Window time.Duration
+	Read   int
+	Write  int
+
+	AppRead  int
+	AppWrite int
+}
+
+// rateLimitWindow counts the requests of a client in a window
+type rateLimitWindow struct {
+	start time.Time
+	count int
+}
+
+// RateLimiter counts requests of each client in fixed windows. Clients are the application key, tokens, profiles
+// authenticated by JWT, or the IP address of anonymous requests; reads and writes have separate budgets. Counts are kept in memory, so each
+// instance of the API has its own budgets
+type RateLimiter struct {
+	cfg RateLimitConfig
+
+	mu        sync.Mutex
+	windows   map[string]*rateLimitWindow
+	lastSweep time.Time
+}
+
+// NewRateLimiter returns a rate limiter with default limits
+func NewRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	return &RateLimiter{cfg: cfg, windows: make(map[string]*rateLimitWindow), lastSweep: time.Now()}
+}
+
+// take counts a request against a client's budget; returns true if the request is within limit, the requests left and
+// the end of the current window
+func (l *RateLimiter) take(key string, limit int, now time.Time) (bool, int, time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	// Windows that ended are removed once every window so clients that stopped making requests are not kept
+	if now.Sub(l.lastSweep) > l.cfg.Window {
+		for k, w := range l.windows {
+			if now.Sub(w.start) >= l.cfg.Window {
+				delete(l.windows, k)
+			}
+		}
+		l.lastSweep = now
+	}
+	w, ok := l.windows[key]
+	if !ok || now.Sub(w.start) >= l.cfg.Window {
+		w = &rateLimitWindow{start: now}
+		l.windows[key] = w
+	}
+	reset := w.start.Add(l.cfg.Window)
+	if w.count >= limit {
+		return false, 0, reset
+	}
+	w.count++
+	return true, limit - w.count, reset
+}
+
+// client returns the key and read and write limits of the client making a request. Anonymous requests are keyed on
+// c.RealIP(), which only reads forwarded headers from trusted proxies (see echo.IPExtractor)
+func (l *RateLimiter) client(c echo.Context) (string, int, int) {
+	if c.Get("ApplicationKeyAuthSuccess") == true {
+		return "app", l.cfg.AppRead, l.cfg.AppWrite
+	}
+	read, write := l.cfg.Read, l.cfg.Write
+	if n, ok := c.Get("KeyAuthToken").(*models.TokenInfo); ok {
+		if n.RateLimitRead != nil {
+			read = *n.RateLimitRead
+		}
+		if n.RateLimitWrite != nil {
+			write = *n.RateLimitWrite
+		}
+		return "token:" + n.TokenID, read, write
+	}
+	if user, ok := c.Get("user").(*jwt.Token); ok {
+		if claims, ok := user.Claims.(jwt.MapClaims); ok {
+			if sub, ok := claims["sub"].(string); ok {
+				return "user:" + sub, read, write
+			}
+		}
+	}
+	return "ip:" + c.RealIP(), read, write
+}
+
+// RateLimitMiddleware limits the requests of each client; used after authentication middleware so requests are
+// counted against the token or profile that made them. Requests over the limit are denied with 429 and Retry-After
+// A nil limiter does not limit requests
+func RateLimitMiddleware(l *RateLimiter) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if l == nil || c.Request().Method == http.MethodOptions {
+				return next(c)
+			}
+			key, read, write := l.client(c)
+			limit := write
+			switch c.Request().Method {
+			case http.MethodGet, http.MethodHead:
+				key, limit = key+":read", read
+			default:
+				key += ":write"
+			}
+			now := time.Now()
+			allowed, remaining, reset := l.take(key, limit, now)
+			h := c.Response().Header()
+			h.Set(HeaderRateLimitLimit, strconv.Itoa(limit))
+			h.Set(HeaderRateLimitRemaining, strconv.Itoa(remaining))
+			h.Set(HeaderRateLimitReset, strconv.FormatInt(reset.Unix(), 10))
+			if !allowed {
+				// Retry-After is rounded up to whole seconds
+				h.Set(HeaderRetryAfter, strconv.Itoa(int((reset.Sub(now)+time.Second-1)/time.Second)))
+				return c.JSON(http.StatusTooManyRequests, MessageRateLimited)
+			}
+			return next(c)
+		}
+	}
+}
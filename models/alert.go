@@ -1,6 +1,8 @@
 package models
 
 import (
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,8 +21,42 @@ type Alert struct {
 	Name           string    `json:"name"`
 	Body           string    `json:"body"`
 	CreateDate     time.Time `json:"create_date" db:"create_date"`
+	AlertLifecycle
+	// Subject and Body are rendered from the alert config templates when the alert is created
+	Subject *string `json:"subject"`
+	// An alert is active until its alert config clears it (ClearDate) or it is resolved;
+	// repeated evaluations that trigger while it is active update LastTriggerDate and TriggerCount
+	LastTriggerDate *time.Time `json:"last_trigger_date" db:"last_trigger_date"`
+	TriggerCount    int        `json:"trigger_count" db:"trigger_count"`
+	ClearDate       *time.Time `json:"clear_date" db:"clear_date"`
+	// Level is the highest threshold level crossed while the alert is active (threshold alert configs only)
+	Level *string `json:"level"`
 }
 
+// Alert status; an alert is opened, acknowledged by a named person and later resolved
+const (
+	AlertStatusOpen         = "open"
+	AlertStatusAcknowledged = "acknowledged"
+	AlertStatusResolved     = "resolved"
+)
+
+// AlertLifecycle is the shared state of an alert
+type AlertLifecycle struct {
+	Status                 string     `json:"status"`
+	AcknowledgedBy         *uuid.UUID `json:"acknowledged_by" db:"acknowledged_by"`
+	AcknowledgedByUsername *string    `json:"acknowledged_by_username" db:"acknowledged_by_username"`
+	AcknowledgeDate        *time.Time `json:"acknowledge_date" db:"acknowledge_date"`
+	AcknowledgeComment     *string    `json:"acknowledge_comment" db:"acknowledge_comment"`
+	ResolvedBy             *uuid.UUID `json:"resolved_by" db:"resolved_by"`
+	ResolvedByUsername     *string    `json:"resolved_by_username" db:"resolved_by_username"`
+	ResolveDate            *time.Time `json:"resolve_date" db:"resolve_date"`
+	ResolveComment         *string    `json:"resolve_comment" db:"resolve_comment"`
+	EscalateDate           *time.Time `json:"escalate_date" db:"escalate_date"`
+}
+
+// ErrAlertStatus is returned when an alert cannot move to the requested status from its current status
+var ErrAlertStatus = errors.New("alert status does not allow this action")
+
 // CreateAlerts creates one or more new alerts
 func CreateAlerts(db *sqlx.DB, alertConfigIDS []uuid.UUID) error {
 	txn, err := db.Beginx()
@@ -96,34 +132,101 @@ func DoAlertUnread(db *sqlx.DB, profileID *uuid.UUID, alertID *uuid.UUID) (*Aler
 	return GetMyAlert(db, profileID, alertID)
 }
 
-// DoCheckAlerts checks for alert conditions; Creates alerts as needed
-func DoCheckAlerts(db *sqlx.DB) error {
-	// TEMPORARY; SIMULATE ALERTS
-	alertID, err := uuid.Parse("6f3dfe9f-4664-4c78-931f-32ffac6d2d43")
+// GetAlert returns a single alert
+func GetAlert(db *sqlx.DB, alertID *uuid.UUID) (*Alert, error) {
+	var a Alert
+	if err := db.Get(&a, `SELECT * FROM v_alert WHERE id = $1`, alertID); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// AcknowledgeAlert records that a profile has acknowledged an open alert
+// Returns ErrAlertStatus if the alert is not open
+func AcknowledgeAlert(db *sqlx.DB, instrumentID *uuid.UUID, alertID *uuid.UUID, profileID *uuid.UUID, comment *string) (*Alert, error) {
+	return updateAlertStatus(
+		db, instrumentID, alertID, AlertEventAcknowledged,
+		`UPDATE alert
+		 SET    status = $4, acknowledged_by = $3, acknowledge_date = now(), acknowledge_comment = $5
+		 WHERE  id = $1 AND status = $6
+		        AND alert_config_id IN (SELECT id FROM alert_config WHERE instrument_id = $2)
+		 RETURNING id`,
+		alertID, instrumentID, profileID, AlertStatusAcknowledged, comment, AlertStatusOpen,
+	)
+}
+
+// ResolveAlert records that a profile has resolved an open or acknowledged alert
+// Returns ErrAlertStatus if the alert is already resolved
+func ResolveAlert(db *sqlx.DB, instrumentID *uuid.UUID, alertID *uuid.UUID, profileID *uuid.UUID, comment *string) (*Alert, error) {
+	return updateAlertStatus(
+		db, instrumentID, alertID, AlertEventResolved,
+		`UPDATE alert
+		 SET    status = $4, resolved_by = $3, resolve_date = now(), resolve_comment = $5
+		 WHERE  id = $1 AND status != $4
+		        AND alert_config_id IN (SELECT id FROM alert_config WHERE instrument_id = $2)
+		 RETURNING id`,
+		alertID, instrumentID, profileID, AlertStatusResolved, comment,
+	)
+}
+
+// updateAlertStatus runs a status change query returning the alert id, and queues webhook events for the change
+func updateAlertStatus(db *sqlx.DB, instrumentID *uuid.UUID, alertID *uuid.UUID, event, query string, args ...interface{}) (*Alert, error) {
+	txn, err := db.Beginx()
 	if err != nil {
-		return err
+		return nil, err
+	}
+	var aID uuid.UUID
+	if err := txn.Get(&aID, query, args...); err != nil {
+		txn.Rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, alertStatusError(db, instrumentID, alertID)
+		}
+		return nil, err
+	}
+	if _, err := txn.Exec(createAlertWebhookDeliveriesSQL, aID, event); err != nil {
+		txn.Rollback()
+		return nil, err
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, err
 	}
-	if err := CreateAlerts(db, []uuid.UUID{alertID}); err != nil {
+	return GetAlert(db, &aID)
+}
+
+// alertStatusError returns ErrAlertStatus if an alert exists for the instrument, otherwise sql.ErrNoRows
+func alertStatusError(db *sqlx.DB, instrumentID *uuid.UUID, alertID *uuid.UUID) error {
+	var exists bool
+	if err := db.Get(
+		&exists, `SELECT EXISTS (SELECT 1 FROM v_alert WHERE id = $1 AND instrument_id = $2)`, alertID, instrumentID,
+	); err != nil {
 		return err
 	}
-	return nil
+	if exists {
+		return ErrAlertStatus
+	}
+	return sql.ErrNoRows
 }
 
 // ListAlertsForInstrumentSQL returns all alerts for a single instrument
 var listAlertsForInstrumentSQL = `SELECT * FROM v_alert WHERE instrument_id = $1`
 
 // ListMyAlertsSQL returns all alerts for a profile's alert_profile_subscriptions
+// and escalated alerts for a profile's alert_escalation_subscriptions
 var listMyAlertsSQL = `SELECT a.*,
                               CASE WHEN r.alert_id IS NOT NULL THEN true
 	                               ELSE false
                               END AS read
 					   FROM v_alert a
 					       LEFT JOIN alert_read r ON r.alert_id = a.id
-                       WHERE a.alert_config_id IN (
+                       WHERE (a.alert_config_id IN (
                            SELECT alert_config_id
                            FROM alert_profile_subscription
                            WHERE profile_id = $1
-					   )`
+					   ) OR (a.escalate_date IS NOT NULL AND a.alert_config_id IN (
+                           SELECT alert_config_id
+                           FROM alert_escalation_subscription
+                           WHERE profile_id = $1
+					   )))`
 
 // GetMyAlertSQL returns a single alert
 var getMyAlertSQL = listMyAlertsSQL + " AND a.id = $2"
@@ -0,0 +1,136 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// maxAlertBacktestWindow limits how far back a backtest replays an alert config
+const maxAlertBacktestWindow = 366 * 24 * time.Hour
+
+// maxAlertBacktestEvaluations limits the number of scheduled evaluations replayed by a backtest
+const maxAlertBacktestEvaluations = 20000
+
+// AlertBacktest is the result of replaying an alert config over a historical window
+// The alert config is evaluated at each scheduled time in the window, the same as the heartbeat,
+// starting with no active alert. No alerts are created and no notifications are sent
+type AlertBacktest struct {
+	AlertConfigID uuid.UUID `json:"alert_config_id"`
+	After         time.Time `json:"after"`
+	Before        time.Time `json:"before"`
+	// Evaluations is the number of scheduled evaluations replayed
+	Evaluations int `json:"evaluations"`
+	// Triggered is the number of evaluations where the formula was true at least once
+	Triggered  int     `json:"triggered"`
+	Created    int     `json:"created"`
+	Updated    int     `json:"updated"`
+	Cleared    int     `json:"cleared"`
+	Suppressed int     `json:"suppressed"`
+	Errors     int     `json:"errors"`
+	LastError  *string `json:"last_error"`
+	// Triggers are the times an alert would have been created, or was suppressed during the cool-down period
+	Triggers []AlertBacktestTrigger `json:"triggers"`
+}
+
+// AlertBacktestTrigger is a time an alert would have been created or suppressed, and the values that triggered it
+type AlertBacktestTrigger struct {
+	Time   time.Time           `json:"time"`
+	Action string              `json:"action"`
+	Values AlertVariableValues `json:"values"`
+	Level  *string             `json:"level"`
+}
+
+// alertBacktestTimes returns the scheduled evaluation times of an alert config in the window (after, before]
+func alertBacktestTimes(ac *AlertConfig, tw *TimeWindow) ([]time.Time, error) {
+	if !tw.Before.After(tw.After) {
+		return nil, fmt.Errorf("before must be later than after")
+	}
+	if tw.Before.Sub(tw.After) > maxAlertBacktestWindow {
+		return nil, fmt.Errorf("backtest window must be %d days or less", int(maxAlertBacktestWindow.Hours()/24))
+	}
+	s, err := ParseSchedule(ac.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	tt := make([]time.Time, 0)
+	for t := s.Next(tw.After); !t.IsZero() && !t.After(tw.Before); t = s.Next(t) {
+		if len(tt) == maxAlertBacktestEvaluations {
+			return nil, fmt.Errorf("schedule has more than %d evaluations in the backtest window", maxAlertBacktestEvaluations)
+		}
+		tt = append(tt, t)
+	}
+	return tt, nil
+}
+
+// ValidateAlertBacktest checks that an alert config can be replayed over a time window
+func ValidateAlertBacktest(ac *AlertConfig, tw *TimeWindow) error {
+	_, err := alertBacktestTimes(ac, tw)
+	return err
+}
+
+// BacktestAlertConfig replays an alert config over the window (after, before] without saving evaluations or alerts
+func BacktestAlertConfig(db *sqlx.DB, ac *AlertConfig, tw *TimeWindow) (*AlertBacktest, error) {
+	times, err := alertBacktestTimes(ac, tw)
+	if err != nil {
+		return nil, err
+	}
+	series := make(map[string]*alertSeries)
+	if len(times) > 0 {
+		if series, err = loadAlertConfigSeries(db, ac, tw.After, times[len(times)-1]); err != nil {
+			return nil, err
+		}
+	}
+	b := replayAlertConfig(ac, series, tw, times)
+	return &b, nil
+}
+
+// replayAlertConfig evaluates an alert config at each time in times, starting with no active alert
+func replayAlertConfig(ac *AlertConfig, series map[string]*alertSeries, tw *TimeWindow, times []time.Time) AlertBacktest {
+	b := AlertBacktest{
+		AlertConfigID: ac.ID, After: tw.After, Before: tw.Before, Triggers: make([]AlertBacktestTrigger, 0),
+	}
+	var s alertState
+	from := tw.After
+	for _, at := range times {
+		// Each evaluation only sees data available at the time it is scheduled
+		window := make(map[string]*alertSeries)
+		for k, v := range series {
+			window[k] = v.window(from, at)
+		}
+		e, tt := evaluateAlertConfig(ac, window, &s, from, at)
+		b.Evaluations++
+		if e.TriggerTime != nil {
+			b.Triggered++
+		}
+		if e.Error != nil {
+			b.Errors++
+			b.LastError = e.Error
+		}
+		for _, t := range tt {
+			switch t.Action {
+			case AlertActionCreated:
+				b.Created++
+			case AlertActionUpdated:
+				b.Updated++
+			case AlertActionCleared:
+				b.Cleared++
+			case AlertActionSuppressed:
+				b.Suppressed++
+			}
+			if t.Action != AlertActionCreated && t.Action != AlertActionSuppressed {
+				continue
+			}
+			trigger := AlertBacktestTrigger{Time: t.Time, Action: t.Action, Values: t.Values}
+			if t.Level != "" {
+				level := t.Level
+				trigger.Level = &level
+			}
+			b.Triggers = append(b.Triggers, trigger)
+		}
+		from = at
+	}
+	return b
+}
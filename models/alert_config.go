@@ -16,8 +16,32 @@ type AlertConfig struct {
 	Formula      string    `json:"formula"`
 	Schedule     string    `json:"schedule"`
 	AuditInfo
+	// Subject and Body are templates rendered when an alert is created; see alertPlaceholders.
+	// Emails use a default subject if Subject is nil
+	Subject *string `json:"subject"`
+	// EscalateAfterMinutes is the time an alert may remain unacknowledged before escalation subscribers are notified;
+	// alerts are not escalated if nil
+	EscalateAfterMinutes *int `json:"escalate_after_minutes" db:"escalate_after_minutes"`
+	// ClearFormula clears an active alert when true (hysteresis); if nil, the alert clears when Formula is false
+	ClearFormula *string `json:"clear_formula" db:"clear_formula"`
+	// CooldownMinutes is the minimum time after an alert clears or is resolved before a new alert is created
+	CooldownMinutes *int `json:"cooldown_minutes" db:"cooldown_minutes"`
+	// ConsecutiveCount is the number of consecutive times Formula must be true before an alert is created
+	ConsecutiveCount int `json:"consecutive_count" db:"consecutive_count"`
+	// AlertType is AlertTypeFormula (default), AlertTypeThreshold or AlertTypeStale; threshold and stale configs
+	// are evaluated from AlertThreshold or AlertStaleData instead of Formula
+	AlertType string `json:"alert_type" db:"alert_type"`
+	AlertThreshold
+	AlertStaleData
 }
 
+// Alert config types
+const (
+	AlertTypeFormula   = "formula"
+	AlertTypeThreshold = "threshold"
+	AlertTypeStale     = "stale"
+)
+
 // AlertConfigCollection holds one ore more alert items
 type AlertConfigCollection struct {
 	Items []AlertConfig `json:"items"`
@@ -82,9 +106,12 @@ func CreateInstrumentAlertConfigs(db *sqlx.DB, instrumentID *uuid.UUID, alertCon
 	// Instrument
 	stmt1, err := txn.Preparex(
 		`INSERT INTO alert_config
-			(instrument_id, name, body, formula, schedule, creator, create_date)
+			(instrument_id, name, body, formula, schedule, creator, create_date, escalate_after_minutes,
+			 clear_formula, cooldown_minutes, consecutive_count, alert_type, timeseries_id, threshold_direction,
+			 watch_level, warning_level, critical_level, rate_of_change_limit, comparison_window_minutes,
+			 stale_after_hours, instrument_telemetry_id, subject)
 		VALUES
-			 ($1, $2, $3, $4, $5, $6, $7)
+			 ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
 		RETURNING *`,
 	)
 	if err != nil {
@@ -95,7 +122,11 @@ func CreateInstrumentAlertConfigs(db *sqlx.DB, instrumentID *uuid.UUID, alertCon
 	for idx, c := range alertConfigs {
 		var aCreated AlertConfig
 		// Load Instrument
-		if err := stmt1.Get(&aCreated, instrumentID, c.Name, c.Body, c.Formula, c.Schedule, c.Creator, c.CreateDate); err != nil {
+		if err := stmt1.Get(&aCreated, instrumentID, c.Name, c.Body, c.Formula, c.Schedule, c.Creator, c.CreateDate, c.EscalateAfterMinutes,
+			c.ClearFormula, c.CooldownMinutes, c.ConsecutiveCount, c.AlertType, c.TimeseriesID, c.Direction,
+			c.WatchLevel, c.WarningLevel, c.CriticalLevel, c.RateOfChangeLimit, c.ComparisonWindowMinutes,
+			c.StaleAfterHours, c.InstrumentTelemetryID, c.Subject,
+		); err != nil {
 			return make([]AlertConfig, 0), err
 		}
 		newAlertConfigs[idx] = aCreated
@@ -114,10 +145,16 @@ func UpdateInstrumentAlertConfig(db *sqlx.DB, instrumentID *uuid.UUID, alertConf
 
 	var cUpdated AlertConfig
 	err := db.QueryRowx(
-		`UPDATE alert_config SET name=$3, body=$4, formula=$5, schedule=$6, updater=$7, update_date=$8
+		`UPDATE alert_config SET name=$3, body=$4, formula=$5, schedule=$6, updater=$7, update_date=$8, escalate_after_minutes=$9,
+			clear_formula=$10, cooldown_minutes=$11, consecutive_count=$12, alert_type=$13, timeseries_id=$14,
+			threshold_direction=$15, watch_level=$16, warning_level=$17, critical_level=$18, rate_of_change_limit=$19,
+			comparison_window_minutes=$20, stale_after_hours=$21, instrument_telemetry_id=$22, subject=$23
 		WHERE id=$1 AND instrument_id=$2
 		RETURNING *`,
-		alertConfigID, instrumentID, ac.Name, ac.Body, ac.Formula, ac.Schedule, ac.Updater, ac.UpdateDate,
+		alertConfigID, instrumentID, ac.Name, ac.Body, ac.Formula, ac.Schedule, ac.Updater, ac.UpdateDate, ac.EscalateAfterMinutes,
+		ac.ClearFormula, ac.CooldownMinutes, ac.ConsecutiveCount, ac.AlertType, ac.TimeseriesID, ac.Direction,
+		ac.WatchLevel, ac.WarningLevel, ac.CriticalLevel, ac.RateOfChangeLimit, ac.ComparisonWindowMinutes,
+		ac.StaleAfterHours, ac.InstrumentTelemetryID, ac.Subject,
 	).StructScan(&cUpdated)
 	if err != nil {
 		return nil, err
@@ -125,6 +162,22 @@ func UpdateInstrumentAlertConfig(db *sqlx.DB, instrumentID *uuid.UUID, alertConf
 	return &cUpdated, nil
 }
 
+// AlertConfigExists returns true if an alert config belongs to an instrument in a project
+func AlertConfigExists(db *sqlx.DB, projectID *uuid.UUID, instrumentID *uuid.UUID, alertConfigID *uuid.UUID) (bool, error) {
+	var exists bool
+	if err := db.Get(
+		&exists,
+		`SELECT EXISTS (
+		     SELECT 1 FROM alert_config ac INNER JOIN instrument i ON i.id = ac.instrument_id
+		     WHERE  ac.id = $1 AND ac.instrument_id = $2 AND i.project_id = $3
+		 )`,
+		alertConfigID, instrumentID, projectID,
+	); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
 // DeleteInstrumentAlertConfig deletes an alert by ID
 func DeleteInstrumentAlertConfig(db *sqlx.DB, alertConfigID *uuid.UUID, instrumentID *uuid.UUID) error {
 	_, err := db.Exec(
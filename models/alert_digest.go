@@ -0,0 +1,295 @@
+package models
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/USACE/instrumentation-api/notify"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// Alert subscription delivery modes; subscriptions that are not immediate receive a digest email each period
+const (
+	DeliveryModeImmediate = "immediate"
+	DeliveryModeHourly    = "hourly"
+	DeliveryModeDaily     = "daily"
+	DeliveryModeWeekly    = "weekly"
+)
+
+// digestDeliveryModes are the delivery modes that receive digest emails
+var digestDeliveryModes = []string{DeliveryModeHourly, DeliveryModeDaily, DeliveryModeWeekly}
+
+// ValidateDeliveryMode checks that a subscription delivery mode is valid; it defaults to immediate
+func ValidateDeliveryMode(mode *string) error {
+	if *mode == "" {
+		*mode = DeliveryModeImmediate
+	}
+	if *mode == DeliveryModeImmediate {
+		return nil
+	}
+	for _, m := range digestDeliveryModes {
+		if *mode == m {
+			return nil
+		}
+	}
+	return fmt.Errorf(
+		"delivery_mode must be '%s', '%s', '%s' or '%s'", DeliveryModeImmediate, DeliveryModeHourly, DeliveryModeDaily, DeliveryModeWeekly,
+	)
+}
+
+// AlertEmailDigest is a single email to an address summarizing alerts created during a period
+type AlertEmailDigest struct {
+	ID           uuid.UUID  `json:"id"`
+	Email        string     `json:"email"`
+	DeliveryMode string     `json:"delivery_mode" db:"delivery_mode"`
+	PeriodStart  time.Time  `json:"period_start" db:"period_start"`
+	PeriodEnd    time.Time  `json:"period_end" db:"period_end"`
+	AlertCount   int        `json:"alert_count" db:"alert_count"`
+	Subject      string     `json:"subject"`
+	Body         string     `json:"body"`
+	Status       string     `json:"status"`
+	Attempts     int        `json:"attempts"`
+	NextAttempt  time.Time  `json:"next_attempt" db:"next_attempt"`
+	LastError    *string    `json:"last_error" db:"last_error"`
+	CreateDate   time.Time  `json:"create_date" db:"create_date"`
+	SentDate     *time.Time `json:"sent_date" db:"sent_date"`
+}
+
+// digestPeriod returns the latest complete digest period before now, in UTC.
+// Hourly periods start on the hour, daily periods at midnight and weekly periods at midnight on Monday
+func digestPeriod(mode string, now time.Time) (time.Time, time.Time) {
+	now = now.UTC()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	switch mode {
+	case DeliveryModeHourly:
+		end := now.Truncate(time.Hour)
+		return end.Add(-time.Hour), end
+	case DeliveryModeWeekly:
+		end := midnight.AddDate(0, 0, -((int(midnight.Weekday()) + 6) % 7))
+		return end.AddDate(0, 0, -7), end
+	}
+	return midnight.AddDate(0, 0, -1), midnight
+}
+
+// alertDigestEmail renders the subject and body of a digest of alerts, with counts per project and instrument.
+// unsubscribeTokens are the unsubscribe tokens of the email subscriptions in the digest, by alert config; a link
+// for each is included if apiURL is configured
+func alertDigestEmail(mode string, start, end time.Time, aa []Alert, apiURL string, unsubscribeTokens map[uuid.UUID]string) (string, string) {
+	sort.SliceStable(aa, func(i, j int) bool {
+		if aa[i].ProjectName != aa[j].ProjectName {
+			return aa[i].ProjectName < aa[j].ProjectName
+		}
+		if aa[i].InstrumentName != aa[j].InstrumentName {
+			return aa[i].InstrumentName < aa[j].InstrumentName
+		}
+		return aa[i].CreateDate.Before(aa[j].CreateDate)
+	})
+	subject := fmt.Sprintf("MIDAS %s%s Alert Digest: %d alert(s)", strings.ToUpper(mode[:1]), mode[1:], len(aa))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d alert(s) from %s to %s (UTC)\n\n", len(aa), start.Format(time.RFC3339), end.Format(time.RFC3339))
+	for idx := 0; idx < len(aa); {
+		project := aa[idx].ProjectName
+		n := 0
+		for k := idx; k < len(aa) && aa[k].ProjectName == project; k++ {
+			n++
+		}
+		fmt.Fprintf(&b, "%s: %d\n", project, n)
+		for jdx := idx; jdx < idx+n; {
+			instrument := aa[jdx].InstrumentName
+			m := 0
+			for k := jdx; k < idx+n && aa[k].InstrumentName == instrument; k++ {
+				m++
+			}
+			fmt.Fprintf(&b, "    %s: %d\n", instrument, m)
+			jdx += m
+		}
+		idx += n
+	}
+	b.WriteString("\nAlerts\n")
+	for _, a := range aa {
+		title := a.Name
+		if a.Subject != nil {
+			title = *a.Subject
+		}
+		fmt.Fprintf(&b, "\n%s  %s / %s\n%s\n", a.CreateDate.UTC().Format(time.RFC3339), a.ProjectName, a.InstrumentName, title)
+		if a.Level != nil {
+			fmt.Fprintf(&b, "Level: %s\n", *a.Level)
+		}
+		if a.Body != "" {
+			fmt.Fprintf(&b, "%s\n", a.Body)
+		}
+	}
+	if apiURL != "" && len(unsubscribeTokens) > 0 {
+		b.WriteString("\n")
+		listed := make(map[uuid.UUID]bool)
+		for _, a := range aa {
+			token, ok := unsubscribeTokens[a.AlertConfigID]
+			if !ok || listed[a.AlertConfigID] {
+				continue
+			}
+			listed[a.AlertConfigID] = true
+			fmt.Fprintf(
+				&b, "To stop receiving %s / %s / %s, visit %s/email_subscriptions/unsubscribe/%s\n",
+				a.ProjectName, a.InstrumentName, a.Name, strings.TrimRight(apiURL, "/"), token,
+			)
+		}
+	}
+	return subject, b.String()
+}
+
+// queueAlertDigests queues a digest email for each address with alerts during the latest complete period of each
+// digest delivery mode. Alerts are included for subscriptions of profiles and email addresses that are not muted.
+// Each period is compiled once, in a single transaction; periods that end while the heartbeat is not running are
+// not sent. Digests to email subscriptions include unsubscribe links if apiURL is configured
+func queueAlertDigests(db *sqlx.DB, now time.Time, apiURL string) error {
+	for _, mode := range digestDeliveryModes {
+		if err := queueAlertDigestPeriod(db, mode, now, apiURL); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queueAlertDigestPeriod queues the digests of a delivery mode for the latest complete period. Heartbeats queueing
+// the same delivery mode wait for each other, so a period is either queued for every address or not at all
+func queueAlertDigestPeriod(db *sqlx.DB, mode string, now time.Time, apiURL string) error {
+	start, end := digestPeriod(mode, now)
+	txn, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	if _, err := txn.Exec(`SELECT pg_advisory_xact_lock(hashtext('alert_email_digest:' || $1))`, mode); err != nil {
+		txn.Rollback()
+		return err
+	}
+	var queued bool
+	if err := txn.Get(
+		&queued,
+		`SELECT EXISTS (SELECT 1 FROM alert_email_digest WHERE delivery_mode = $1 AND period_start = $2)`,
+		mode, start,
+	); err != nil {
+		txn.Rollback()
+		return err
+	}
+	if queued {
+		return txn.Rollback()
+	}
+	aa := make([]struct {
+		Email            string  `db:"email"`
+		UnsubscribeToken *string `db:"unsubscribe_token"`
+		Alert
+	}, 0)
+	if err := txn.Select(
+		&aa,
+		`WITH s AS (
+		     SELECT email, alert_config_id, MAX(unsubscribe_token) AS unsubscribe_token
+		     FROM (
+		         SELECT p.email, s.alert_config_id, NULL AS unsubscribe_token
+		         FROM   alert_profile_subscription s
+		         INNER JOIN profile p ON p.id = s.profile_id
+		         WHERE  s.delivery_mode = $1 AND NOT s.mute_notify
+		         UNION ALL
+		         SELECT e.email, s.alert_config_id, s.unsubscribe_token
+		         FROM   alert_email_subscription s
+		         INNER JOIN email e ON e.id = s.email_id
+		         WHERE  s.delivery_mode = $1 AND NOT s.mute_notify
+		     ) ss
+		     GROUP BY email, alert_config_id
+		 )
+		 SELECT s.email, s.unsubscribe_token, a.*
+		 FROM   s
+		 INNER JOIN v_alert a ON a.alert_config_id = s.alert_config_id
+		 WHERE  a.create_date >= $2 AND a.create_date < $3
+		 ORDER BY s.email`,
+		mode, start, end,
+	); err != nil {
+		txn.Rollback()
+		return err
+	}
+	byEmail := make(map[string][]Alert)
+	tokens := make(map[string]map[uuid.UUID]string)
+	for _, a := range aa {
+		byEmail[a.Email] = append(byEmail[a.Email], a.Alert)
+		if a.UnsubscribeToken != nil {
+			if tokens[a.Email] == nil {
+				tokens[a.Email] = make(map[uuid.UUID]string)
+			}
+			tokens[a.Email][a.AlertConfigID] = *a.UnsubscribeToken
+		}
+	}
+	for email, ee := range byEmail {
+		subject, body := alertDigestEmail(mode, start, end, ee, apiURL, tokens[email])
+		if _, err := txn.Exec(
+			`INSERT INTO alert_email_digest (email, delivery_mode, period_start, period_end, alert_count, subject, body)
+			 VALUES ($1, $2, $3, $4, $5, $6, $7)
+			 ON CONFLICT DO NOTHING`,
+			email, mode, start, end, len(ee), subject, body,
+		); err != nil {
+			txn.Rollback()
+			return err
+		}
+	}
+	return txn.Commit()
+}
+
+// DoSendAlertDigests queues digest emails for periods that have ended and sends pending digests that are due
+// Failed digests are retried with exponential backoff, up to maxEmailDeliveryAttempts
+func DoSendAlertDigests(db *sqlx.DB, cfg *notify.SMTPConfig, apiURL string) error {
+	if err := queueAlertDigests(db, time.Now(), apiURL); err != nil {
+		return err
+	}
+	if !cfg.Enabled() {
+		// Digests stay pending until SMTP is configured
+		return nil
+	}
+	for {
+		dd := make([]AlertEmailDigest, 0)
+		if err := db.Select(
+			&dd,
+			`UPDATE alert_email_digest SET next_attempt = $2
+			 WHERE  id IN (
+			     SELECT id FROM alert_email_digest
+			     WHERE  status = $1 AND next_attempt <= now()
+			     ORDER BY create_date LIMIT $3
+			     FOR UPDATE SKIP LOCKED
+			 )
+			 RETURNING *`,
+			EmailDeliveryPending, time.Now().Add(deliveryClaimLease), deliveryClaimBatch,
+		); err != nil {
+			return err
+		}
+		if len(dd) == 0 {
+			return nil
+		}
+		for _, d := range dd {
+			attempts := d.Attempts + 1
+			if err := cfg.Send(&notify.Email{To: []string{d.Email}, Subject: d.Subject, Body: d.Body}); err != nil {
+				log.Printf("alert digest %s to %s failed (attempt %d): %s\n", d.ID, d.Email, attempts, err.Error())
+				status := EmailDeliveryPending
+				if attempts >= maxEmailDeliveryAttempts {
+					status = EmailDeliveryFailed
+				}
+				next := time.Now().Add(emailDeliveryBackoff * time.Duration(1<<uint(attempts-1)))
+				if _, err := db.Exec(
+					`UPDATE alert_email_digest SET status = $2, attempts = $3, next_attempt = $4, last_error = $5 WHERE id = $1`,
+					d.ID, status, attempts, next, err.Error(),
+				); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := db.Exec(
+				`UPDATE alert_email_digest SET status = $2, attempts = $3, sent_date = now() WHERE id = $1`,
+				d.ID, EmailDeliverySent, attempts,
+			); err != nil {
+				return err
+			}
+		}
+	}
+}
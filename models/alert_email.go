@@ -0,0 +1,187 @@
+package models
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/USACE/instrumentation-api/notify"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// Alert email delivery status
+const (
+	EmailDeliveryPending = "pending"
+	EmailDeliverySent    = "sent"
+	EmailDeliveryFailed  = "failed"
+)
+
+// maxEmailDeliveryAttempts is the number of attempts before an email delivery is marked failed
+const maxEmailDeliveryAttempts = 5
+
+// emailDeliveryBackoff is the wait before the first retry; doubled after each failed attempt
+const emailDeliveryBackoff = time.Minute
+
+// Pending email, digest and webhook deliveries are claimed deliveryClaimBatch at a time before they are sent, by
+// moving next_attempt deliveryClaimLease ahead, so concurrent senders skip them. Deliveries claimed by a sender
+// that stopped before updating them are due again once the lease ends
+const (
+	deliveryClaimBatch = 50
+	deliveryClaimLease = 30 * time.Minute
+)
+
+// AlertEmailDelivery is a log entry for an alert email sent to a single address
+type AlertEmailDelivery struct {
+	ID          uuid.UUID  `json:"id"`
+	AlertID     uuid.UUID  `json:"alert_id" db:"alert_id"`
+	Email       string     `json:"email"`
+	ProfileID   *uuid.UUID `json:"profile_id" db:"profile_id"`
+	EmailID     *uuid.UUID `json:"email_id" db:"email_id"`
+	Status      string     `json:"status"`
+	Attempts    int        `json:"attempts"`
+	NextAttempt time.Time  `json:"next_attempt" db:"next_attempt"`
+	LastError   *string    `json:"last_error" db:"last_error"`
+	CreateDate  time.Time  `json:"create_date" db:"create_date"`
+	SentDate    *time.Time `json:"sent_date" db:"sent_date"`
+	// Escalation is true for emails sent to escalation subscribers when an alert is not acknowledged
+	Escalation bool `json:"escalation"`
+}
+
+// createAlertEmailDeliveriesSQL queues an email for each subscribed profile and email address that is not muted
+// and receives an email per alert; other subscribers receive the alert in a digest
+var createAlertEmailDeliveriesSQL = `
+	INSERT INTO alert_email_delivery (alert_id, email, profile_id, email_id)
+	SELECT $1, p.email, p.id, null
+	FROM   alert_profile_subscription s
+	INNER JOIN profile p ON p.id = s.profile_id
+	WHERE  s.alert_config_id = $2 AND NOT s.mute_notify AND s.delivery_mode = 'immediate'
+	UNION
+	SELECT $1, e.email, null, e.id
+	FROM   alert_email_subscription s
+	INNER JOIN email e ON e.id = s.email_id
+	WHERE  s.alert_config_id = $2 AND NOT s.mute_notify AND s.delivery_mode = 'immediate'
+	ON CONFLICT DO NOTHING`
+
+// ListAlertEmailDeliveries lists email deliveries for alerts created by an alert config within a time window
+func ListAlertEmailDeliveries(db *sqlx.DB, alertConfigID *uuid.UUID, tw *TimeWindow) ([]AlertEmailDelivery, error) {
+	dd := make([]AlertEmailDelivery, 0)
+	if err := db.Select(
+		&dd,
+		`SELECT d.*
+		 FROM   alert_email_delivery d
+		 INNER JOIN alert a ON a.id = d.alert_id
+		 WHERE  a.alert_config_id = $1 AND d.create_date >= $2 AND d.create_date <= $3
+		 ORDER BY d.create_date DESC, d.email`,
+		alertConfigID, tw.After, tw.Before,
+	); err != nil {
+		return make([]AlertEmailDelivery, 0), err
+	}
+	return dd, nil
+}
+
+// alertEmail renders the email sent to subscribers for an alert
+// Emails to email subscriptions include an unsubscribe link if apiURL is configured
+func alertEmail(a *Alert, to string, escalation bool, apiURL string, unsubscribeToken *string) *notify.Email {
+	subject := fmt.Sprintf("MIDAS Alert: %s (%s)", a.Name, a.InstrumentName)
+	if escalation {
+		subject = fmt.Sprintf("MIDAS Alert Not Acknowledged: %s (%s)", a.Name, a.InstrumentName)
+	}
+	if a.Subject != nil && escalation {
+		subject = fmt.Sprintf("Not Acknowledged: %s", *a.Subject)
+	} else if a.Subject != nil {
+		subject = *a.Subject
+	}
+	body := fmt.Sprintf(
+		"%s\n\nProject: %s\nInstrument: %s\nAlert: %s\nTime: %s\n",
+		a.Body, a.ProjectName, a.InstrumentName, a.Name, a.CreateDate.UTC().Format(time.RFC3339),
+	)
+	if a.Level != nil {
+		body += fmt.Sprintf("Level: %s\n", *a.Level)
+	}
+	if apiURL != "" && unsubscribeToken != nil {
+		body += fmt.Sprintf(
+			"\nTo stop receiving this alert, visit %s/email_subscriptions/unsubscribe/%s\n",
+			strings.TrimRight(apiURL, "/"), *unsubscribeToken,
+		)
+	}
+	return &notify.Email{To: []string{to}, Subject: subject, Body: body}
+}
+
+// DoSendAlertEmails claims and sends pending alert emails that are due
+// Failed deliveries are retried with exponential backoff, up to maxEmailDeliveryAttempts
+func DoSendAlertEmails(db *sqlx.DB, cfg *notify.SMTPConfig, apiURL string) error {
+	if !cfg.Enabled() {
+		// Deliveries stay pending until SMTP is configured
+		return nil
+	}
+	for {
+		dd := make([]struct {
+			AlertEmailDelivery
+			Alert            Alert   `db:"alert"`
+			UnsubscribeToken *string `db:"unsubscribe_token"`
+		}, 0)
+		if err := db.Select(
+			&dd,
+			`WITH claimed AS (
+			     UPDATE alert_email_delivery SET next_attempt = $2
+			     WHERE  id IN (
+			         SELECT id FROM alert_email_delivery
+			         WHERE  status = $1 AND next_attempt <= now()
+			         ORDER BY create_date LIMIT $3
+			         FOR UPDATE SKIP LOCKED
+			     )
+			     RETURNING *
+			 )
+			 SELECT d.*,
+			        a.id              AS "alert.id",
+			        a.alert_config_id AS "alert.alert_config_id",
+			        a.project_id      AS "alert.project_id",
+			        a.project_name    AS "alert.project_name",
+			        a.instrument_id   AS "alert.instrument_id",
+			        a.instrument_name AS "alert.instrument_name",
+			        a.name            AS "alert.name",
+			        a.subject         AS "alert.subject",
+			        a.body            AS "alert.body",
+			        a.create_date     AS "alert.create_date",
+			        a.level           AS "alert.level",
+			        s.unsubscribe_token
+			 FROM   claimed d
+			 INNER JOIN v_alert a ON a.id = d.alert_id
+			 LEFT JOIN alert_email_subscription s ON s.email_id = d.email_id AND s.alert_config_id = a.alert_config_id
+			 ORDER BY d.create_date`,
+			EmailDeliveryPending, time.Now().Add(deliveryClaimLease), deliveryClaimBatch,
+		); err != nil {
+			return err
+		}
+		if len(dd) == 0 {
+			return nil
+		}
+		for _, d := range dd {
+			attempts := d.Attempts + 1
+			if err := cfg.Send(alertEmail(&d.Alert, d.Email, d.Escalation, apiURL, d.UnsubscribeToken)); err != nil {
+				log.Printf("alert email %s to %s failed (attempt %d): %s\n", d.ID, d.Email, attempts, err.Error())
+				status := EmailDeliveryPending
+				if attempts >= maxEmailDeliveryAttempts {
+					status = EmailDeliveryFailed
+				}
+				next := time.Now().Add(emailDeliveryBackoff * time.Duration(1<<uint(attempts-1)))
+				if _, err := db.Exec(
+					`UPDATE alert_email_delivery SET status = $2, attempts = $3, next_attempt = $4, last_error = $5 WHERE id = $1`,
+					d.ID, status, attempts, next, err.Error(),
+				); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := db.Exec(
+				`UPDATE alert_email_delivery SET status = $2, attempts = $3, sent_date = now() WHERE id = $1`,
+				d.ID, EmailDeliverySent, attempts,
+			); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// AlertEscalationSubscription is a profile notified when an alert is not acknowledged
+// within the alert config's escalate_after_minutes
+type AlertEscalationSubscription struct {
+	ID            uuid.UUID `json:"id"`
+	AlertConfigID uuid.UUID `json:"alert_config_id" db:"alert_config_id"`
+	ProfileID     uuid.UUID `json:"profile_id" db:"profile_id"`
+	Username      string    `json:"username"`
+	Email         string    `json:"email"`
+}
+
+// listAlertEscalationSubscriptionsSQL is the base SQL to retrieve escalation subscriptions with profile information
+var listAlertEscalationSubscriptionsSQL = `SELECT s.id, s.alert_config_id, s.profile_id, p.username, p.email
+                                           FROM   alert_escalation_subscription s
+                                           INNER JOIN profile p ON p.id = s.profile_id`
+
+// ListAlertEscalationSubscriptions lists the escalation subscribers for an alert config
+func ListAlertEscalationSubscriptions(db *sqlx.DB, alertConfigID *uuid.UUID) ([]AlertEscalationSubscription, error) {
+	ss := make([]AlertEscalationSubscription, 0)
+	if err := db.Select(
+		&ss, listAlertEscalationSubscriptionsSQL+" WHERE s.alert_config_id = $1 ORDER BY p.username", alertConfigID,
+	); err != nil {
+		return make([]AlertEscalationSubscription, 0), err
+	}
+	return ss, nil
+}
+
+// AddAlertEscalationSubscription adds a profile to the escalation subscribers for an alert config
+func AddAlertEscalationSubscription(db *sqlx.DB, alertConfigID *uuid.UUID, profileID *uuid.UUID) (*AlertEscalationSubscription, error) {
+	if _, err := db.Exec(
+		`INSERT INTO alert_escalation_subscription (alert_config_id, profile_id) VALUES ($1, $2)
+		 ON CONFLICT DO NOTHING`, alertConfigID, profileID,
+	); err != nil {
+		return nil, err
+	}
+	var s AlertEscalationSubscription
+	if err := db.Get(
+		&s, listAlertEscalationSubscriptionsSQL+" WHERE s.alert_config_id = $1 AND s.profile_id = $2", alertConfigID, profileID,
+	); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// RemoveAlertEscalationSubscription removes a profile from the escalation subscribers for an alert config
+func RemoveAlertEscalationSubscription(db *sqlx.DB, alertConfigID *uuid.UUID, profileID *uuid.UUID) error {
+	if _, err := db.Exec(
+		`DELETE FROM alert_escalation_subscription WHERE alert_config_id = $1 AND profile_id = $2`, alertConfigID, profileID,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DoEscalateAlerts escalates open alerts that have not been acknowledged within their alert config's
+// escalate_after_minutes and queues emails to escalation subscribers
+func DoEscalateAlerts(db *sqlx.DB) error {
+	txn, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	aa := make([]struct {
+		ID            uuid.UUID `db:"id"`
+		AlertConfigID uuid.UUID `db:"alert_config_id"`
+	}, 0)
+	if err := txn.Select(
+		&aa,
+		`UPDATE alert a
+		 SET    escalate_date = now()
+		 FROM   alert_config ac
+		 WHERE  ac.id = a.alert_config_id
+		        AND a.status = $1
+		        AND a.escalate_date IS NULL
+		        AND ac.escalate_after_minutes IS NOT NULL
+		        AND a.create_date + ac.escalate_after_minutes * interval '1 minute' <= now()
+		 RETURNING a.id, a.alert_config_id`,
+		AlertStatusOpen,
+	); err != nil {
+		txn.Rollback()
+		return err
+	}
+	for _, a := range aa {
+		if _, err := txn.Exec(
+			`INSERT INTO alert_email_delivery (alert_id, email, profile_id, escalation)
+			 SELECT $1, p.email, p.id, true
+			 FROM   alert_escalation_subscription s
+			 INNER JOIN profile p ON p.id = s.profile_id
+			 WHERE  s.alert_config_id = $2
+			 ON CONFLICT DO NOTHING`,
+			a.ID, a.AlertConfigID,
+		); err != nil {
+			txn.Rollback()
+			return err
+		}
+	}
+	return txn.Commit()
+}
@@ -0,0 +1,737 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Knetic/govaluate"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// maxAlertEvaluationWindow limits how far back a single evaluation looks for new data,
+// e.g. after the heartbeat has not run for some time
+const maxAlertEvaluationWindow = 7 * 24 * time.Hour
+
+// AlertEvaluation is the result of evaluating an AlertConfig formula
+// Formulas are evaluated at each measurement time in the window (WindowStart, Time];
+// if there are no new measurements in the window, the latest values are evaluated at Time.
+// Result is the formula result at the latest time evaluated; TriggerTime is the first time it was true
+type AlertEvaluation struct {
+	ID            uuid.UUID           `json:"id"`
+	AlertConfigID uuid.UUID           `json:"alert_config_id" db:"alert_config_id"`
+	Time          time.Time           `json:"time"`
+	WindowStart   time.Time           `json:"window_start" db:"window_start"`
+	Result        bool                `json:"result"`
+	TriggerTime   *time.Time          `json:"trigger_time" db:"trigger_time"`
+	Values        AlertVariableValues `json:"values" db:"variable_values"`
+	Error         *string             `json:"error"`
+	AlertID       *uuid.UUID          `json:"alert_id" db:"alert_id"`
+	// ConsecutiveCount is the number of consecutive times the formula was true at the end of the window
+	ConsecutiveCount int `json:"consecutive_count" db:"consecutive_count"`
+	// Action is the latest change to alerts made by the evaluation, if any
+	Action *string `json:"action"`
+	// Level is the threshold level crossed at the latest time evaluated (threshold alert configs only)
+	Level *string `json:"level"`
+}
+
+// AlertVariableValues is a map of { variable: value, } used to evaluate an alert formula
+type AlertVariableValues map[string]float64
+
+// Scan implements sql.Scanner for a JSON object stored in the database
+func (m *AlertVariableValues) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = make(AlertVariableValues)
+		return nil
+	case []byte:
+		return json.Unmarshal(v, m)
+	case string:
+		return json.Unmarshal([]byte(v), m)
+	default:
+		return fmt.Errorf("cannot scan %T into AlertVariableValues", src)
+	}
+}
+
+// alertSeries is a stored timeseries referenced by an alert formula
+type alertSeries struct {
+	TimeseriesID uuid.UUID `db:"timeseries_id"`
+	Variable     string    `db:"variable"`
+	IsConstant   bool      `db:"is_constant"`
+	Measurements []Measurement
+}
+
+// valueAt returns the latest value at or before t
+// Constants are effective-dated; the earliest value is in effect for all prior times
+func (s *alertSeries) valueAt(t time.Time) (float64, bool) {
+	idx := sort.Search(len(s.Measurements), func(i int) bool { return s.Measurements[i].Time.After(t) })
+	if idx > 0 {
+		return s.Measurements[idx-1].Value, true
+	}
+	if s.IsConstant && len(s.Measurements) > 0 {
+		return s.Measurements[0].Value, true
+	}
+	return 0, false
+}
+
+// latestTime returns the time of the latest measurement at or before t
+func (s *alertSeries) latestTime(t time.Time) (time.Time, bool) {
+	if s == nil {
+		return time.Time{}, false
+	}
+	idx := sort.Search(len(s.Measurements), func(i int) bool { return s.Measurements[i].Time.After(t) })
+	if idx == 0 {
+		return time.Time{}, false
+	}
+	return s.Measurements[idx-1].Time, true
+}
+
+// window returns the series limited to measurements in the window (from, at] and the latest measurement
+// before the window. Constants keep their earliest value
+func (s *alertSeries) window(from, at time.Time) *alertSeries {
+	lo := sort.Search(len(s.Measurements), func(i int) bool { return s.Measurements[i].Time.After(from) })
+	hi := sort.Search(len(s.Measurements), func(i int) bool { return s.Measurements[i].Time.After(at) })
+	if lo > 0 && !s.IsConstant {
+		lo--
+	} else {
+		lo = 0
+	}
+	w := *s
+	w.Measurements = s.Measurements[lo:hi]
+	return &w
+}
+
+// ValidateAlertConfig checks that alert config formulas compile, or its threshold or stale data settings are valid,
+// its schedule can be parsed, its subject and body templates are valid and its escalation and cool-down times
+// are positive. The alert type defaults to formula and a consecutive count that is not provided defaults to 1
+func ValidateAlertConfig(ac *AlertConfig) error {
+	switch ac.AlertType {
+	case "", AlertTypeFormula:
+		ac.AlertType = AlertTypeFormula
+		if _, err := govaluate.NewEvaluableExpression(ac.Formula); err != nil {
+			return fmt.Errorf("invalid formula: %s", err.Error())
+		}
+		if ac.ClearFormula != nil {
+			if _, err := govaluate.NewEvaluableExpression(*ac.ClearFormula); err != nil {
+				return fmt.Errorf("invalid clear_formula: %s", err.Error())
+			}
+		}
+	case AlertTypeThreshold:
+		if ac.ClearFormula != nil {
+			return fmt.Errorf("clear_formula is not supported by threshold alert configs")
+		}
+		if err := ac.AlertThreshold.validate(); err != nil {
+			return err
+		}
+	case AlertTypeStale:
+		if ac.ClearFormula != nil {
+			return fmt.Errorf("clear_formula is not supported by stale alert configs")
+		}
+		if err := ac.AlertStaleData.validate(); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("alert_type must be '%s', '%s' or '%s'", AlertTypeFormula, AlertTypeThreshold, AlertTypeStale)
+	}
+	s, err := ParseSchedule(ac.Schedule)
+	if err != nil {
+		return fmt.Errorf("invalid schedule: %s", err.Error())
+	}
+	if s.Next(time.Now()).IsZero() {
+		return fmt.Errorf("invalid schedule: '%s' never occurs", ac.Schedule)
+	}
+	if ac.Subject != nil {
+		if err := validateAlertTemplate("subject", *ac.Subject); err != nil {
+			return err
+		}
+	}
+	if err := validateAlertTemplate("body", ac.Body); err != nil {
+		return err
+	}
+	if ac.EscalateAfterMinutes != nil && *ac.EscalateAfterMinutes <= 0 {
+		return fmt.Errorf("escalate_after_minutes must be greater than 0")
+	}
+	if ac.CooldownMinutes != nil && *ac.CooldownMinutes <= 0 {
+		return fmt.Errorf("cooldown_minutes must be greater than 0")
+	}
+	if ac.ConsecutiveCount < 0 {
+		return fmt.Errorf("consecutive_count must be greater than 0")
+	}
+	if ac.ConsecutiveCount == 0 {
+		ac.ConsecutiveCount = 1
+	}
+	return nil
+}
+
+// alertPoint is the result of evaluating alert config formulas at a single time
+type alertPoint struct {
+	Time    time.Time
+	Trigger bool
+	Clear   bool
+	Values  AlertVariableValues
+	// Level is the threshold level crossed (threshold alert configs only)
+	Level string
+}
+
+// alertResultError is returned when an alert formula does not evaluate to true or false
+type alertResultError struct {
+	result interface{}
+}
+
+func (e alertResultError) Error() string {
+	return fmt.Sprintf("formula result %v is not true or false", e.result)
+}
+
+// evaluateAlertPoints evaluates the trigger expression, and clear expression if not nil, at each
+// measurement time in the window (from, at]. Without a clear expression, Clear is the opposite of Trigger.
+// An error message is returned if a formula result is not true or false, or no time could be evaluated
+func evaluateAlertPoints(trigger, clear *govaluate.EvaluableExpression, series map[string]*alertSeries, from, at time.Time) ([]alertPoint, *string) {
+	pp := make([]alertPoint, 0)
+
+	// Times to evaluate; each new measurement in the window
+	seen := make(map[time.Time]bool)
+	times := make([]time.Time, 0)
+	for _, s := range series {
+		for _, m := range s.Measurements {
+			if m.Time.After(from) && !m.Time.After(at) && !seen[m.Time] {
+				seen[m.Time] = true
+				times = append(times, m.Time)
+			}
+		}
+	}
+	if len(times) == 0 {
+		times = append(times, at)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	variables := trigger.Vars()
+	if clear != nil {
+		variables = append(variables, clear.Vars()...)
+	}
+	evaluate := func(expression *govaluate.EvaluableExpression, params map[string]interface{}) (bool, error) {
+		result, err := expression.Evaluate(params)
+		if err != nil {
+			return false, err
+		}
+		b, ok := result.(bool)
+		if !ok {
+			return false, alertResultError{result}
+		}
+		return b, nil
+	}
+
+	var lastErr error
+	for _, t := range times {
+		params := make(map[string]interface{})
+		values := make(AlertVariableValues)
+		missing := make([]string, 0)
+		for _, v := range variables {
+			if _, ok := params[v]; ok {
+				continue
+			}
+			s, ok := series[v]
+			if !ok {
+				missing = append(missing, v)
+				continue
+			}
+			val, ok := s.valueAt(t)
+			if !ok {
+				missing = append(missing, v)
+				continue
+			}
+			params[v], values[v] = val, val
+		}
+		if len(missing) > 0 {
+			lastErr = fmt.Errorf("missing variables: %s", strings.Join(missing, " "))
+			continue
+		}
+		p := alertPoint{Time: t, Values: values}
+		var err error
+		if p.Trigger, err = evaluate(trigger, params); err != nil {
+			if _, ok := err.(alertResultError); ok {
+				msg := err.Error()
+				return pp, &msg
+			}
+			lastErr = err
+			continue
+		}
+		p.Clear = !p.Trigger
+		if clear != nil {
+			if p.Clear, err = evaluate(clear, params); err != nil {
+				if _, ok := err.(alertResultError); ok {
+					msg := fmt.Sprintf("clear_formula: %s", err.Error())
+					return pp, &msg
+				}
+				lastErr = fmt.Errorf("clear_formula: %s", err.Error())
+				continue
+			}
+		}
+		pp = append(pp, p)
+	}
+	if len(pp) == 0 && lastErr != nil {
+		msg := lastErr.Error()
+		return pp, &msg
+	}
+	return pp, nil
+}
+
+// Alert evaluation actions
+const (
+	AlertActionCreated = "created"
+	AlertActionUpdated = "updated"
+	AlertActionCleared = "cleared"
+	// AlertActionSuppressed is recorded when an alert would be created during the cool-down period
+	AlertActionSuppressed = "suppressed"
+)
+
+// alertState is the state of an alert config carried from one evaluation to the next
+type alertState struct {
+	// ActiveAlertID is the alert that has not been cleared or resolved, if any
+	ActiveAlertID *uuid.UUID `db:"active_alert_id"`
+	Active        bool       `db:"active"`
+	// Consecutive is the number of consecutive times the formula has been true while no alert is active
+	Consecutive int `db:"consecutive"`
+	// LastClear is the latest time an alert was cleared or resolved; starts the cool-down period
+	LastClear *time.Time `db:"last_clear"`
+	// LastEvaluated is the time of the latest evaluation, if any
+	LastEvaluated *time.Time `db:"last_evaluated"`
+}
+
+// alertTransition is a change to alerts resulting from a single alertPoint
+type alertTransition struct {
+	Action string
+	Time   time.Time
+	Values AlertVariableValues
+	Level  string
+}
+
+// step advances the alert state by a single evaluated point
+// Returns the resulting transition, or nil if alerts do not change
+func (s *alertState) step(ac *AlertConfig, p alertPoint) *alertTransition {
+	if s.Active {
+		if p.Clear {
+			t := p.Time
+			s.Active, s.Consecutive, s.LastClear = false, 0, &t
+			return &alertTransition{Action: AlertActionCleared, Time: p.Time, Values: p.Values, Level: p.Level}
+		}
+		if p.Trigger {
+			return &alertTransition{Action: AlertActionUpdated, Time: p.Time, Values: p.Values, Level: p.Level}
+		}
+		return nil
+	}
+	if !p.Trigger {
+		s.Consecutive = 0
+		return nil
+	}
+	s.Consecutive++
+	if s.Consecutive < ac.ConsecutiveCount {
+		return nil
+	}
+	if ac.CooldownMinutes != nil && s.LastClear != nil &&
+		p.Time.Before(s.LastClear.Add(time.Duration(*ac.CooldownMinutes)*time.Minute)) {
+		return &alertTransition{Action: AlertActionSuppressed, Time: p.Time, Values: p.Values, Level: p.Level}
+	}
+	s.Active = true
+	return &alertTransition{Action: AlertActionCreated, Time: p.Time, Values: p.Values, Level: p.Level}
+}
+
+// evaluateAlertConfig evaluates an alert config over the window (from, at] starting from state s
+// Returns the evaluation and the transitions to apply to alerts, in order
+func evaluateAlertConfig(ac *AlertConfig, series map[string]*alertSeries, s *alertState, from, at time.Time) (AlertEvaluation, []alertTransition) {
+	e := AlertEvaluation{AlertConfigID: ac.ID, Time: at, WindowStart: from, Values: make(AlertVariableValues)}
+	tt := make([]alertTransition, 0)
+	var pp []alertPoint
+	var msg *string
+	switch ac.AlertType {
+	case AlertTypeThreshold:
+		pp, msg = evaluateThresholdPoints(&ac.AlertThreshold, series[thresholdVariable], from, at)
+	case AlertTypeStale:
+		pp, msg = evaluateStalePoint(&ac.AlertStaleData, series[staleVariable], at)
+	default:
+		trigger, err := govaluate.NewEvaluableExpression(ac.Formula)
+		if err != nil {
+			msg := fmt.Sprintf("invalid formula: %s", err.Error())
+			e.Error, e.ConsecutiveCount = &msg, s.Consecutive
+			return e, tt
+		}
+		var clear *govaluate.EvaluableExpression
+		if ac.ClearFormula != nil {
+			if clear, err = govaluate.NewEvaluableExpression(*ac.ClearFormula); err != nil {
+				msg := fmt.Sprintf("invalid clear_formula: %s", err.Error())
+				e.Error, e.ConsecutiveCount = &msg, s.Consecutive
+				return e, tt
+			}
+		}
+		pp, msg = evaluateAlertPoints(trigger, clear, series, from, at)
+	}
+	e.Error = msg
+	for _, p := range pp {
+		if p.Trigger && e.TriggerTime == nil {
+			t := p.Time
+			e.TriggerTime = &t
+		}
+		e.Result, e.Values, e.Level = p.Trigger, p.Values, nil
+		if p.Level != "" {
+			level := p.Level
+			e.Level = &level
+		}
+		if t := s.step(ac, p); t != nil {
+			tt = append(tt, *t)
+		}
+	}
+	// Values reported are those of the latest change to alerts
+	if len(tt) > 0 {
+		last := tt[len(tt)-1]
+		e.Action, e.Values = &last.Action, last.Values
+	}
+	e.ConsecutiveCount = s.Consecutive
+	return e, tt
+}
+
+// alertConfigSeries loads stored timeseries referenced by an alert config formula with measurements in the
+// window (from, at] and the latest measurement before the window. Variables are written [instrument.timeseries],
+// the same as instrument formulas, or [timeseries] for a timeseries belonging to the alert config's instrument.
+// Variables only reference timeseries in the alert config's project, so alerts never carry values of other projects
+func alertConfigSeries(db *sqlx.DB, ac *AlertConfig, variables []string, from, at time.Time) (map[string]*alertSeries, error) {
+	series := make(map[string]*alertSeries)
+	if len(variables) == 0 {
+		return series, nil
+	}
+	ss := make([]alertSeries, 0)
+	query, args, err := sqlx.In(
+		`-- Timeseries Belonging to the Alert Config's Instrument
+		 SELECT t.id AS timeseries_id,
+		        t.slug AS variable,
+		        ic.timeseries_id IS NOT NULL AS is_constant
+		 FROM   timeseries t
+		 LEFT JOIN instrument_constants ic ON ic.timeseries_id = t.id
+		 WHERE  t.instrument_id = ? AND t.slug IN (?)
+		 UNION
+		 SELECT t.id AS timeseries_id,
+		        i.slug || '.' || t.slug AS variable,
+		        ic.timeseries_id IS NOT NULL AS is_constant
+		 FROM   timeseries t
+		 INNER JOIN instrument i ON i.id = t.instrument_id
+		 INNER JOIN instrument ai ON ai.id = ? AND ai.project_id = i.project_id
+		 LEFT JOIN instrument_constants ic ON ic.timeseries_id = t.id
+		 WHERE  i.slug || '.' || t.slug IN (?)`,
+		ac.InstrumentID, variables, ac.InstrumentID, variables,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Select(&ss, db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	for idx := range ss {
+		series[ss[idx].Variable] = &ss[idx]
+	}
+	if err := alertSeriesMeasurements(db, ss, from, at); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// alertSeriesMeasurements loads measurements of each series in the window (from, at], the latest measurement
+// before the window and the earliest value of constants
+func alertSeriesMeasurements(db *sqlx.DB, ss []alertSeries, from, at time.Time) error {
+	if len(ss) == 0 {
+		return nil
+	}
+	// A timeseries may be referenced by more than one variable
+	ids := make([]uuid.UUID, len(ss))
+	byID := make(map[uuid.UUID][]*alertSeries)
+	for idx := range ss {
+		ss[idx].Measurements = make([]Measurement, 0)
+		ids[idx] = ss[idx].TimeseriesID
+		byID[ss[idx].TimeseriesID] = append(byID[ss[idx].TimeseriesID], &ss[idx])
+	}
+
+	mm := make([]struct {
+		TimeseriesID uuid.UUID `db:"timeseries_id"`
+		Measurement
+	}, 0)
+	query, args, err := sqlx.In(
+		`(
+		     SELECT timeseries_id, time, value
+		     FROM   timeseries_measurement
+		     WHERE  timeseries_id IN (?) AND time > ? AND time <= ?
+		 ) UNION (
+		     -- Latest Measurement Before Window
+		     SELECT DISTINCT ON (timeseries_id) timeseries_id, time, value
+		     FROM   timeseries_measurement
+		     WHERE  timeseries_id IN (?) AND time <= ?
+		     ORDER BY timeseries_id, time DESC
+		 ) UNION (
+		     -- Earliest Value of Constants; In Effect For All Prior Times
+		     SELECT DISTINCT ON (m.timeseries_id) m.timeseries_id, m.time, m.value
+		     FROM   timeseries_measurement m
+		     INNER JOIN instrument_constants ic ON ic.timeseries_id = m.timeseries_id
+		     WHERE  m.timeseries_id IN (?)
+		     ORDER BY m.timeseries_id, m.time ASC
+		 )
+		 ORDER BY time`,
+		ids, from, at, ids, from, ids,
+	)
+	if err != nil {
+		return err
+	}
+	if err := db.Select(&mm, db.Rebind(query), args...); err != nil {
+		return err
+	}
+	for _, m := range mm {
+		for _, s := range byID[m.TimeseriesID] {
+			s.Measurements = append(s.Measurements, m.Measurement)
+		}
+	}
+	return nil
+}
+
+// loadAlertConfigSeries loads the stored timeseries an alert config is evaluated against over the window (from, at]
+func loadAlertConfigSeries(db *sqlx.DB, ac *AlertConfig, from, at time.Time) (map[string]*alertSeries, error) {
+	switch ac.AlertType {
+	case AlertTypeThreshold:
+		return thresholdSeries(db, &ac.AlertThreshold, from, at)
+	case AlertTypeStale:
+		return staleSeries(db, ac, from, at)
+	}
+	variables := make([]string, 0)
+	if expression, err := govaluate.NewEvaluableExpression(ac.Formula); err == nil {
+		variables = append(variables, expression.Vars()...)
+	}
+	if ac.ClearFormula != nil {
+		if expression, err := govaluate.NewEvaluableExpression(*ac.ClearFormula); err == nil {
+			variables = append(variables, expression.Vars()...)
+		}
+	}
+	return alertConfigSeries(db, ac, variables, from, at)
+}
+
+// getAlertState locks an alert config for the rest of the transaction and loads the state left by previous
+// evaluations. Evaluations of the same alert config wait for each other, so each one reads the state the
+// previous one saved
+func getAlertState(txn *sqlx.Tx, alertConfigID *uuid.UUID) (*alertState, error) {
+	if _, err := txn.Exec(`SELECT pg_advisory_xact_lock(hashtext($1::text))`, alertConfigID); err != nil {
+		return nil, err
+	}
+	var s alertState
+	if err := txn.Get(
+		&s,
+		`SELECT a.id AS active_alert_id,
+		        a.id IS NOT NULL AS active,
+		        COALESCE((
+		            SELECT e.consecutive_count FROM alert_evaluation e
+		            WHERE  e.alert_config_id = $1
+		            ORDER BY e.time DESC LIMIT 1
+		        ), 0) AS consecutive,
+		        (
+		            SELECT MAX(GREATEST(x.clear_date, x.resolve_date)) FROM alert x
+		            WHERE  x.alert_config_id = $1
+		        ) AS last_clear,
+		        (
+		            SELECT MAX(e.time) FROM alert_evaluation e
+		            WHERE  e.alert_config_id = $1
+		        ) AS last_evaluated
+		 FROM   (SELECT 1) AS one
+		 LEFT JOIN LATERAL (
+		     SELECT id FROM alert
+		     WHERE  alert_config_id = $1 AND clear_date IS NULL AND status != $2
+		     ORDER BY create_date DESC LIMIT 1
+		 ) a ON true`,
+		alertConfigID, AlertStatusResolved,
+	); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// EvaluateAlertConfig evaluates an alert config over the window (from, at] and saves the evaluation
+// An alert is created once the formula is true for ConsecutiveCount evaluations, unless within the cool-down period.
+// While the alert is active, evaluations that trigger update the alert instead of creating another;
+// the alert is cleared when the clear formula is true. Emails to subscribers and webhook events are queued
+// for each alert created. appURL is the web application URL used to render {{link}} in alert templates.
+// The latest evaluation is read again once the alert config is locked: if another evaluation already covered the
+// scheduled time before at, nothing is evaluated and nil is returned; otherwise the window starts no earlier than
+// the latest evaluation, so no point is evaluated twice
+func EvaluateAlertConfig(db *sqlx.DB, ac *AlertConfig, from, at time.Time, appURL string) (*AlertEvaluation, error) {
+	sched, err := ParseSchedule(ac.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	txn, err := db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	s, err := getAlertState(txn, &ac.ID)
+	if err != nil {
+		txn.Rollback()
+		return nil, err
+	}
+	if s.LastEvaluated != nil {
+		if next := sched.Next(*s.LastEvaluated); next.IsZero() || next.After(at) {
+			return nil, txn.Rollback()
+		}
+		if s.LastEvaluated.After(from) {
+			from = *s.LastEvaluated
+		}
+	}
+	series, err := loadAlertConfigSeries(db, ac, from, at)
+	if err != nil {
+		txn.Rollback()
+		return nil, err
+	}
+	e, tt := evaluateAlertConfig(ac, series, s, from, at)
+
+	values, err := json.Marshal(e.Values)
+	if err != nil {
+		txn.Rollback()
+		return nil, err
+	}
+	var c *alertTemplateContext
+	for _, t := range tt {
+		if t.Action == AlertActionCreated {
+			if c, err = getAlertTemplateContext(db, ac, series, appURL); err != nil {
+				txn.Rollback()
+				return nil, err
+			}
+			break
+		}
+	}
+	activeID := s.ActiveAlertID
+	for _, t := range tt {
+		triggerValues, err := json.Marshal(t.Values)
+		if err != nil {
+			txn.Rollback()
+			return nil, err
+		}
+		switch t.Action {
+		case AlertActionCreated:
+			// Templates are rendered once so the alert reads the same in the UI, emails and webhooks
+			var subject *string
+			if ac.Subject != nil {
+				rendered := renderAlertTemplate(*ac.Subject, ac, c, &t)
+				subject = &rendered
+			}
+			var alertID uuid.UUID
+			if err := txn.Get(
+				&alertID,
+				`INSERT INTO alert (alert_config_id, last_trigger_date, trigger_values, level, subject, body)
+				 VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6)
+				 RETURNING id`,
+				ac.ID, t.Time, string(triggerValues), t.Level, subject, renderAlertTemplate(ac.Body, ac, c, &t),
+			); err != nil {
+				txn.Rollback()
+				return nil, err
+			}
+			if _, err := txn.Exec(createAlertEmailDeliveriesSQL, alertID, ac.ID); err != nil {
+				txn.Rollback()
+				return nil, err
+			}
+			if _, err := txn.Exec(createAlertWebhookDeliveriesSQL, alertID, AlertEventCreated); err != nil {
+				txn.Rollback()
+				return nil, err
+			}
+			activeID, e.AlertID = &alertID, &alertID
+		case AlertActionUpdated:
+			if _, err := txn.Exec(
+				`UPDATE alert
+				 SET    last_trigger_date = $2, trigger_count = trigger_count + 1, trigger_values = $3,
+				        level = CASE WHEN array_position($5::text[], $4) > COALESCE(array_position($5::text[], level), 0)
+				                     THEN $4 ELSE level END
+				 WHERE  id = $1`,
+				activeID, t.Time, string(triggerValues), t.Level, pq.Array(alertLevels),
+			); err != nil {
+				txn.Rollback()
+				return nil, err
+			}
+			e.AlertID = activeID
+		case AlertActionCleared:
+			if _, err := txn.Exec(`UPDATE alert SET clear_date = $2 WHERE id = $1`, activeID, t.Time); err != nil {
+				txn.Rollback()
+				return nil, err
+			}
+			e.AlertID, activeID = activeID, nil
+		}
+	}
+	if err := txn.Get(
+		&e.ID,
+		`INSERT INTO alert_evaluation
+		     (alert_config_id, time, window_start, result, trigger_time, variable_values, error, alert_id, consecutive_count, action, level)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		 RETURNING id`,
+		e.AlertConfigID, e.Time, e.WindowStart, e.Result, e.TriggerTime, string(values), e.Error, e.AlertID,
+		e.ConsecutiveCount, e.Action, e.Level,
+	); err != nil {
+		txn.Rollback()
+		return nil, err
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// ListAlertEvaluations lists evaluations of an alert config within a time window, most recent first
+func ListAlertEvaluations(db *sqlx.DB, alertConfigID *uuid.UUID, tw *TimeWindow) ([]AlertEvaluation, error) {
+	ee := make([]AlertEvaluation, 0)
+	if err := db.Select(
+		&ee,
+		`SELECT id, alert_config_id, time, window_start, result, trigger_time,
+		        variable_values::text AS variable_values, error, alert_id, consecutive_count, action, level
+		 FROM   alert_evaluation
+		 WHERE  alert_config_id = $1 AND time >= $2 AND time <= $3
+		 ORDER BY time DESC`,
+		alertConfigID, tw.After, tw.Before,
+	); err != nil {
+		return make([]AlertEvaluation, 0), err
+	}
+	return ee, nil
+}
+
+// DoCheckAlerts evaluates each alert config that has a scheduled time since it was last evaluated;
+// Creates, updates and clears alerts as needed. Alert configs that have never been evaluated are scheduled from their create_date
+func DoCheckAlerts(db *sqlx.DB, appURL string) error {
+	cc := make([]struct {
+		AlertConfig
+		LastEvaluated time.Time `db:"last_evaluated"`
+	}, 0)
+	if err := db.Select(
+		&cc,
+		`SELECT ac.*,
+		        COALESCE((
+		            SELECT MAX(e.time) FROM alert_evaluation e WHERE e.alert_config_id = ac.id
+		        ), ac.create_date) AS last_evaluated
+		 FROM   alert_config ac`,
+	); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	failed := 0
+	for _, c := range cc {
+		s, err := ParseSchedule(c.Schedule)
+		if err != nil {
+			log.Printf("alert config %s: %s\n", c.ID, err.Error())
+			continue
+		}
+		if next := s.Next(c.LastEvaluated); next.IsZero() || next.After(now) {
+			continue
+		}
+		from := c.LastEvaluated
+		if from.Before(now.Add(-maxAlertEvaluationWindow)) {
+			from = now.Add(-maxAlertEvaluationWindow)
+		}
+		if _, err := EvaluateAlertConfig(db, &c.AlertConfig, from, now, appURL); err != nil {
+			log.Printf("alert config %s could not be evaluated: %s\n", c.ID, err.Error())
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d alert config(s) could not be evaluated", failed)
+	}
+	return nil
+}
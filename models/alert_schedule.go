@@ -0,0 +1,147 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed cron-style schedule with five fields: minute hour day-of-month month day-of-week
+// Each field supports *, single values, ranges (a-b), lists (a,b,c) and steps (*/n, a-b/n)
+// Schedules are evaluated in UTC
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+	// cron semantics; if both day-of-month and day-of-week are restricted, either may match
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+// scheduleField describes the allowed values for a single schedule field
+type scheduleField struct {
+	name     string
+	min, max int
+}
+
+var scheduleFields = []scheduleField{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day-of-month", 1, 31},
+	{"month", 1, 12},
+	{"day-of-week", 0, 7},
+}
+
+// Bitmasks of every day of the month (1-31) and every day of the week (0-6); a day field that allows every day
+// is unrestricted however it is written (e.g. *, 1-31 or */1)
+const (
+	allScheduleDaysOfMonth uint64 = (1<<32 - 1) &^ 1
+	allScheduleDaysOfWeek  uint64 = 1<<7 - 1
+)
+
+// ParseSchedule parses a cron-style schedule string
+func ParseSchedule(spec string) (*Schedule, error) {
+	fields := strings.Fields(spec)
+	if len(fields) != len(scheduleFields) {
+		return nil, fmt.Errorf("schedule '%s' must have 5 fields (minute hour day-of-month month day-of-week)", spec)
+	}
+	bits := make([]uint64, len(fields))
+	for idx, f := range fields {
+		b, err := parseScheduleField(f, scheduleFields[idx])
+		if err != nil {
+			return nil, err
+		}
+		bits[idx] = b
+	}
+	// Sunday may be written as 0 or 7
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+	return &Schedule{
+		minute:        bits[0],
+		hour:          bits[1],
+		dayOfMonth:    bits[2],
+		month:         bits[3],
+		dayOfWeek:     bits[4],
+		anyDayOfMonth: bits[2] == allScheduleDaysOfMonth,
+		anyDayOfWeek:  bits[4]&allScheduleDaysOfWeek == allScheduleDaysOfWeek,
+	}, nil
+}
+
+// parseScheduleField returns a bitmask of values allowed by a single schedule field
+func parseScheduleField(field string, f scheduleField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, step := part, 1
+		if i := strings.Index(part, "/"); i != -1 {
+			s, err := strconv.Atoi(part[i+1:])
+			if err != nil || s < 1 {
+				return 0, fmt.Errorf("invalid step '%s' in %s field", part[i+1:], f.name)
+			}
+			rng, step = part[:i], s
+		}
+		lo, hi := f.min, f.max
+		if rng != "*" {
+			bounds := strings.SplitN(rng, "-", 2)
+			v, err := strconv.Atoi(bounds[0])
+			if err != nil {
+				return 0, fmt.Errorf("invalid value '%s' in %s field", bounds[0], f.name)
+			}
+			lo, hi = v, v
+			if len(bounds) == 2 {
+				if hi, err = strconv.Atoi(bounds[1]); err != nil {
+					return 0, fmt.Errorf("invalid value '%s' in %s field", bounds[1], f.name)
+				}
+			} else if step > 1 {
+				// a/n is shorthand for a-max/n
+				hi = f.max
+			}
+		}
+		if lo < f.min || hi > f.max || lo > hi {
+			return 0, fmt.Errorf("%s field value '%s' out of range %d-%d", f.name, rng, f.min, f.max)
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// dayMatches reports whether the day of t is allowed by the schedule
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dom := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dow := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.anyDayOfMonth || s.anyDayOfWeek {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Next returns the first scheduled time after t
+// The zero time is returned if the schedule never occurs (e.g. February 30)
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	for limit := t.AddDate(5, 0, 0); t.Before(limit); {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// staleVariable is the name of the hours since the latest measurement in stale data alert evaluations
+const staleVariable = "hours_since_measurement"
+
+// AlertStaleData is an alert raised when no data is received for StaleAfterHours; from a single timeseries
+// if the alert config TimeseriesID is set, otherwise from any timeseries of the instrument.
+// InstrumentTelemetryID optionally identifies the telemetry expected to deliver the data
+type AlertStaleData struct {
+	StaleAfterHours       *int       `json:"stale_after_hours" db:"stale_after_hours"`
+	InstrumentTelemetryID *uuid.UUID `json:"instrument_telemetry_id" db:"instrument_telemetry_id"`
+}
+
+// validate checks that a stale data alert has a positive number of hours
+func (a *AlertStaleData) validate() error {
+	if a.StaleAfterHours == nil || *a.StaleAfterHours <= 0 {
+		return fmt.Errorf("stale_after_hours must be greater than 0 for stale alert configs")
+	}
+	return nil
+}
+
+// InstrumentTelemetryExists returns true if an instrument telemetry record belongs to an instrument
+func InstrumentTelemetryExists(db *sqlx.DB, instrumentID *uuid.UUID, instrumentTelemetryID *uuid.UUID) (bool, error) {
+	var exists bool
+	if err := db.Get(
+		&exists,
+		`SELECT EXISTS (SELECT 1 FROM instrument_telemetry WHERE id = $1 AND instrument_id = $2)`,
+		instrumentTelemetryID, instrumentID,
+	); err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// staleSeries loads the times of measurements in the window (from, at] and the latest measurement before the window,
+// from the alert config timeseries if set, otherwise from any timeseries belonging to the alert config instrument.
+// Instrument constants are not data
+func staleSeries(db *sqlx.DB, ac *AlertConfig, from, at time.Time) (map[string]*alertSeries, error) {
+	s := alertSeries{Variable: staleVariable, Measurements: make([]Measurement, 0)}
+	if err := db.Select(
+		&s.Measurements,
+		`WITH m AS (
+		     SELECT m.time, m.value
+		     FROM   timeseries_measurement m
+		     INNER JOIN timeseries t ON t.id = m.timeseries_id
+		     WHERE  (t.id = $1 OR ($1::uuid IS NULL AND t.instrument_id = $2))
+		            AND t.id NOT IN (SELECT timeseries_id FROM instrument_constants)
+		 )
+		 (
+		     SELECT DISTINCT ON (time) time, value FROM m WHERE time > $3 AND time <= $4 ORDER BY time
+		 ) UNION (
+		     -- Latest Measurement Before Window
+		     SELECT time, value FROM m WHERE time <= $3 ORDER BY time DESC LIMIT 1
+		 )
+		 ORDER BY time`,
+		ac.TimeseriesID, ac.InstrumentID, from, at,
+	); err != nil {
+		return nil, err
+	}
+	return map[string]*alertSeries{staleVariable: &s}, nil
+}
+
+// evaluateStalePoint evaluates a stale data alert at time at
+// The point triggers if there is no measurement within StaleAfterHours before at, and clears when data resumes
+func evaluateStalePoint(a *AlertStaleData, s *alertSeries, at time.Time) ([]alertPoint, *string) {
+	if a.StaleAfterHours == nil {
+		msg := "stale_after_hours is not set"
+		return make([]alertPoint, 0), &msg
+	}
+	p := alertPoint{Time: at, Values: make(AlertVariableValues), Trigger: true}
+	if latest, ok := s.latestTime(at); ok {
+		hours := at.Sub(latest).Hours()
+		p.Values[staleVariable] = hours
+		p.Trigger = hours > float64(*a.StaleAfterHours)
+	}
+	p.Clear = !p.Trigger
+	return []alertPoint{p}, nil
+}
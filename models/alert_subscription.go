@@ -2,6 +2,8 @@ package models
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
@@ -19,6 +21,8 @@ type AlertSubscription struct {
 type AlertSubscriptionSettings struct {
 	MuteUI     bool `json:"mute_ui" db:"mute_ui"`
 	MuteNotify bool `json:"mute_notify" db:"mute_notify"`
+	// DeliveryMode is DeliveryModeImmediate (an email per alert) or an hourly, daily or weekly digest
+	DeliveryMode string `json:"delivery_mode" db:"delivery_mode"`
 }
 
 // AlertSubscriptionCollection is a collection of AlertSubscription items
@@ -26,12 +30,16 @@ type AlertSubscriptionCollection struct {
 	Items []AlertSubscription `json:"items"`
 }
 
-// EmailAlert is an email subscription to an alert
+// EmailAlert is an email subscription to an alert, for people who never log in
+// The unsubscribe token is only sent to the subscriber in alert emails
 type EmailAlert struct {
-	ID            uuid.UUID `json:"id"`
-	AlertConfigID uuid.UUID `json:"alert_config_id"`
-	EmailID       uuid.UUID `json:"profile_id"`
-	MuteNotify    bool      `json:"mute_notify" db:"mute_notify"`
+	ID               uuid.UUID `json:"id"`
+	AlertConfigID    uuid.UUID `json:"alert_config_id" db:"alert_config_id"`
+	EmailID          uuid.UUID `json:"email_id" db:"email_id"`
+	Email            string    `json:"email"`
+	MuteNotify       bool      `json:"mute_notify" db:"mute_notify"`
+	DeliveryMode     string    `json:"delivery_mode" db:"delivery_mode"`
+	UnsubscribeToken string    `json:"-" db:"unsubscribe_token"`
 }
 
 // UnmarshalJSON implements the UnmarshalJSON Interface for AlertSubscription
@@ -111,11 +119,145 @@ func ListMyAlertSubscriptions(db *sqlx.DB, profileID *uuid.UUID) ([]AlertSubscri
 func UpdateMyAlertSubscription(db *sqlx.DB, s *AlertSubscription) (*AlertSubscription, error) {
 
 	_, err := db.Exec(
-		"UPDATE alert_profile_subscription SET mute_ui=$1, mute_notify=$2 WHERE alert_config_id=$3 AND profile_id=$4",
-		s.MuteUI, s.MuteNotify, s.AlertConfigID, s.ProfileID,
+		"UPDATE alert_profile_subscription SET mute_ui=$1, mute_notify=$2, delivery_mode=$3 WHERE alert_config_id=$4 AND profile_id=$5",
+		s.MuteUI, s.MuteNotify, s.DeliveryMode, s.AlertConfigID, s.ProfileID,
 	)
 	if err != nil {
 		return nil, err
 	}
 	return GetAlertSubscription(db, &s.AlertConfigID, &s.ProfileID)
 }
+
+// listEmailAlertsSQL is the base SQL to retrieve email subscriptions with the email address
+var listEmailAlertsSQL = `SELECT s.id, s.alert_config_id, s.email_id, e.email, s.mute_notify, s.delivery_mode, s.unsubscribe_token
+                          FROM   alert_email_subscription s
+                          INNER JOIN email e ON e.id = s.email_id`
+
+// ValidateEmailAlert checks that an email subscription has an email address and a valid delivery mode
+func ValidateEmailAlert(s *EmailAlert) error {
+	s.Email = strings.ToLower(strings.TrimSpace(s.Email))
+	if s.Email == "" || !strings.Contains(s.Email, "@") {
+		return fmt.Errorf("a valid email is required")
+	}
+	return ValidateDeliveryMode(&s.DeliveryMode)
+}
+
+// ListEmailAlerts lists the email subscriptions for an alert config
+func ListEmailAlerts(db *sqlx.DB, alertConfigID *uuid.UUID) ([]EmailAlert, error) {
+	ss := make([]EmailAlert, 0)
+	if err := db.Select(&ss, listEmailAlertsSQL+" WHERE s.alert_config_id = $1 ORDER BY e.email", alertConfigID); err != nil {
+		return make([]EmailAlert, 0), err
+	}
+	return ss, nil
+}
+
+// GetEmailAlert returns a single email subscription for an alert config
+func GetEmailAlert(db *sqlx.DB, alertConfigID *uuid.UUID, id *uuid.UUID) (*EmailAlert, error) {
+	var s EmailAlert
+	if err := db.Get(&s, listEmailAlertsSQL+" WHERE s.alert_config_id = $1 AND s.id = $2", alertConfigID, id); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// SubscribeEmailToAlerts subscribes an email address to an alert config, creating the email if it does not exist
+// If the email is already subscribed, its settings are updated
+func SubscribeEmailToAlerts(db *sqlx.DB, s *EmailAlert) (*EmailAlert, error) {
+	txn, err := db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	var emailID uuid.UUID
+	if err := txn.Get(
+		&emailID,
+		`INSERT INTO email (email) VALUES ($1)
+		 ON CONFLICT (email) DO UPDATE SET email = EXCLUDED.email
+		 RETURNING id`,
+		s.Email,
+	); err != nil {
+		txn.Rollback()
+		return nil, err
+	}
+	var id uuid.UUID
+	if err := txn.Get(
+		&id,
+		`INSERT INTO alert_email_subscription (alert_config_id, email_id, mute_notify, delivery_mode)
+		 VALUES ($1, $2, $3, $4)
+		 ON CONFLICT (email_id, alert_config_id) DO UPDATE
+		 SET    mute_notify = EXCLUDED.mute_notify, delivery_mode = EXCLUDED.delivery_mode
+		 RETURNING id`,
+		s.AlertConfigID, emailID, s.MuteNotify, s.DeliveryMode,
+	); err != nil {
+		txn.Rollback()
+		return nil, err
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+	return GetEmailAlert(db, &s.AlertConfigID, &id)
+}
+
+// UpdateEmailAlert updates the settings of an email subscription
+func UpdateEmailAlert(db *sqlx.DB, s *EmailAlert) (*EmailAlert, error) {
+	var id uuid.UUID
+	if err := db.Get(
+		&id,
+		`UPDATE alert_email_subscription SET mute_notify = $3, delivery_mode = $4
+		 WHERE  id = $1 AND alert_config_id = $2
+		 RETURNING id`,
+		s.ID, s.AlertConfigID, s.MuteNotify, s.DeliveryMode,
+	); err != nil {
+		return nil, err
+	}
+	return GetEmailAlert(db, &s.AlertConfigID, &id)
+}
+
+// UnsubscribeEmailToAlerts removes an email subscription from an alert config
+func UnsubscribeEmailToAlerts(db *sqlx.DB, alertConfigID *uuid.UUID, id *uuid.UUID) error {
+	if _, err := db.Exec(
+		`DELETE FROM alert_email_subscription WHERE alert_config_id = $1 AND id = $2`, alertConfigID, id,
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// EmailUnsubscribe describes the email subscription with an unsubscribe token, shown before unsubscribing
+type EmailUnsubscribe struct {
+	Email          string  `json:"email"`
+	AlertName      *string `json:"alert_name" db:"alert_name"`
+	InstrumentName string  `json:"instrument_name" db:"instrument_name"`
+	ProjectName    string  `json:"project_name" db:"project_name"`
+}
+
+// GetEmailUnsubscribe returns the email subscription with an unsubscribe token
+// Returns sql.ErrNoRows if no subscription has the token
+func GetEmailUnsubscribe(db *sqlx.DB, token string) (*EmailUnsubscribe, error) {
+	var u EmailUnsubscribe
+	if err := db.Get(
+		&u,
+		`SELECT e.email, ac.name AS alert_name, i.name AS instrument_name, p.name AS project_name
+		 FROM   alert_email_subscription s
+		 INNER JOIN email e ON e.id = s.email_id
+		 INNER JOIN alert_config ac ON ac.id = s.alert_config_id
+		 INNER JOIN instrument i ON i.id = ac.instrument_id
+		 INNER JOIN project p ON p.id = i.project_id
+		 WHERE  s.unsubscribe_token = $1`,
+		token,
+	); err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+// UnsubscribeEmailByToken removes the email subscription with an unsubscribe token
+// Returns sql.ErrNoRows if no subscription has the token
+func UnsubscribeEmailByToken(db *sqlx.DB, token string) error {
+	var id uuid.UUID
+	if err := db.Get(
+		&id, `DELETE FROM alert_email_subscription WHERE unsubscribe_token = $1 RETURNING id`, token,
+	); err != nil {
+		return err
+	}
+	return nil
+}
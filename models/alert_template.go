@@ -0,0 +1,183 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// Alert template placeholders; written {{placeholder}} in alert config subject and body templates
+const (
+	AlertPlaceholderName       = "name"
+	AlertPlaceholderProject    = "project"
+	AlertPlaceholderInstrument = "instrument"
+	AlertPlaceholderValue      = "value"
+	AlertPlaceholderThreshold  = "threshold"
+	AlertPlaceholderLevel      = "level"
+	AlertPlaceholderTime       = "time"
+	AlertPlaceholderUnit       = "unit"
+	AlertPlaceholderLink       = "link"
+)
+
+// alertPlaceholders are the placeholders allowed in alert templates
+var alertPlaceholders = []string{
+	AlertPlaceholderName, AlertPlaceholderProject, AlertPlaceholderInstrument, AlertPlaceholderValue,
+	AlertPlaceholderThreshold, AlertPlaceholderLevel, AlertPlaceholderTime, AlertPlaceholderUnit, AlertPlaceholderLink,
+}
+
+// alertPlaceholderPattern matches a placeholder; spaces inside the braces are allowed, e.g. {{ value }}
+var alertPlaceholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z_]*)\s*\}\}`)
+
+// validateAlertTemplate checks that every placeholder in an alert template is known and braces are closed
+func validateAlertTemplate(field, tmpl string) error {
+	for _, m := range alertPlaceholderPattern.FindAllStringSubmatch(tmpl, -1) {
+		known := false
+		for _, p := range alertPlaceholders {
+			if m[1] == p {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf(
+				"%s: unknown placeholder '%s'; allowed placeholders are {{%s}}", field, m[0], strings.Join(alertPlaceholders, "}}, {{"),
+			)
+		}
+	}
+	if rest := alertPlaceholderPattern.ReplaceAllString(tmpl, ""); strings.Contains(rest, "{{") || strings.Contains(rest, "}}") {
+		return fmt.Errorf("%s: placeholders must be written {{placeholder}}", field)
+	}
+	return nil
+}
+
+// alertTemplateContext is the information about an alert config's project and instrument used to render templates
+type alertTemplateContext struct {
+	ProjectName    string  `db:"project_name"`
+	ProjectSlug    string  `db:"project_slug"`
+	InstrumentName string  `db:"instrument_name"`
+	InstrumentSlug string  `db:"instrument_slug"`
+	Unit           *string `db:"unit"`
+	Link           *string
+}
+
+// instrumentLink returns the link to an instrument in the web application, or nil if appURL is not configured
+func instrumentLink(appURL, projectSlug, instrumentSlug string) *string {
+	if appURL == "" {
+		return nil
+	}
+	link := fmt.Sprintf("%s/%s/instruments/%s", strings.TrimRight(appURL, "/"), projectSlug, instrumentSlug)
+	return &link
+}
+
+// getAlertTemplateContext loads the project and instrument of an alert config. The unit is that of the alert config
+// timeseries if set, otherwise of the only timeseries referenced by the alert config formula, if there is one
+func getAlertTemplateContext(db *sqlx.DB, ac *AlertConfig, series map[string]*alertSeries, appURL string) (*alertTemplateContext, error) {
+	timeseriesID := ac.TimeseriesID
+	if timeseriesID == nil {
+		ids := make(map[uuid.UUID]bool)
+		for _, s := range series {
+			if s.TimeseriesID != uuid.Nil && !s.IsConstant {
+				ids[s.TimeseriesID] = true
+			}
+		}
+		if len(ids) == 1 {
+			for id := range ids {
+				tsID := id
+				timeseriesID = &tsID
+			}
+		}
+	}
+	var c alertTemplateContext
+	if err := db.Get(
+		&c,
+		`SELECT p.name AS project_name, p.slug AS project_slug, i.name AS instrument_name, i.slug AS instrument_slug,
+		        (SELECT u.abbreviation FROM timeseries t INNER JOIN unit u ON u.id = t.unit_id WHERE t.id = $2) AS unit
+		 FROM   instrument i
+		 INNER JOIN project p ON p.id = i.project_id
+		 WHERE  i.id = $1`,
+		ac.InstrumentID, timeseriesID,
+	); err != nil {
+		return nil, err
+	}
+	c.Link = instrumentLink(appURL, c.ProjectSlug, c.InstrumentSlug)
+	return &c, nil
+}
+
+// formatAlertValue formats a value for an alert message, rounded to 4 decimal places
+func formatAlertValue(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1e4)/1e4, 'f', -1, 64)
+}
+
+// alertThresholdValue returns the threshold crossed by a transition; the level crossed or rate of change limit
+// for threshold alert configs, the hours without data for stale alert configs and the formula otherwise
+func alertThresholdValue(ac *AlertConfig, t *alertTransition) string {
+	switch ac.AlertType {
+	case AlertTypeThreshold:
+		if t.Level == AlertLevelRateOfChange && ac.RateOfChangeLimit != nil {
+			return formatAlertValue(*ac.RateOfChangeLimit)
+		}
+		for _, l := range ac.AlertThreshold.levels() {
+			if l.Name == t.Level {
+				return formatAlertValue(l.Value)
+			}
+		}
+		return ""
+	case AlertTypeStale:
+		if ac.StaleAfterHours != nil {
+			return strconv.Itoa(*ac.StaleAfterHours)
+		}
+		return ""
+	}
+	return ac.Formula
+}
+
+// alertTriggerValue returns the value that triggered a transition; the timeseries value for threshold alert configs,
+// the hours without data for stale alert configs, or each formula variable and value
+func alertTriggerValue(t *alertTransition) string {
+	for _, k := range []string{thresholdVariable, staleVariable} {
+		if v, ok := t.Values[k]; ok {
+			return formatAlertValue(v)
+		}
+	}
+	if len(t.Values) == 1 {
+		for _, v := range t.Values {
+			return formatAlertValue(v)
+		}
+	}
+	vv := make([]string, 0, len(t.Values))
+	for k, v := range t.Values {
+		vv = append(vv, fmt.Sprintf("%s=%s", k, formatAlertValue(v)))
+	}
+	sort.Strings(vv)
+	return strings.Join(vv, ", ")
+}
+
+// renderAlertTemplate replaces the placeholders in an alert template with the values of a transition
+// Placeholders without a value, e.g. {{link}} if the application URL is not configured, are removed
+func renderAlertTemplate(tmpl string, ac *AlertConfig, c *alertTemplateContext, t *alertTransition) string {
+	values := map[string]string{
+		AlertPlaceholderName:       ac.Name,
+		AlertPlaceholderProject:    c.ProjectName,
+		AlertPlaceholderInstrument: c.InstrumentName,
+		AlertPlaceholderValue:      alertTriggerValue(t),
+		AlertPlaceholderThreshold:  alertThresholdValue(ac, t),
+		AlertPlaceholderLevel:      t.Level,
+		AlertPlaceholderTime:       t.Time.UTC().Format(time.RFC3339),
+	}
+	if c.Unit != nil {
+		values[AlertPlaceholderUnit] = *c.Unit
+	}
+	if c.Link != nil {
+		values[AlertPlaceholderLink] = *c.Link
+	}
+	return alertPlaceholderPattern.ReplaceAllStringFunc(tmpl, func(m string) string {
+		return values[alertPlaceholderPattern.FindStringSubmatch(m)[1]]
+	})
+}
@@ -0,0 +1,188 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// Threshold directions; levels are crossed when a value is at or above (or at or below) the level
+const (
+	ThresholdAbove = "above"
+	ThresholdBelow = "below"
+)
+
+// Alert levels crossed by threshold alert configs. A value that does not cross any level is normal
+const (
+	AlertLevelRateOfChange = "rate_of_change"
+	AlertLevelWatch        = "watch"
+	AlertLevelWarning      = "warning"
+	AlertLevelCritical     = "critical"
+)
+
+// alertLevels are alert levels from least to most severe
+var alertLevels = []string{AlertLevelRateOfChange, AlertLevelWatch, AlertLevelWarning, AlertLevelCritical}
+
+// defaultComparisonWindowMinutes is the rate of change comparison window if one is not provided
+const defaultComparisonWindowMinutes = 60
+
+// thresholdVariable is the name of the timeseries value in threshold alert evaluations
+const thresholdVariable = "value"
+
+// AlertThreshold is a structured alert on a single timeseries with watch, warning and critical levels
+// and a rate of change limit. Levels that are nil are not checked
+type AlertThreshold struct {
+	TimeseriesID  *uuid.UUID `json:"timeseries_id" db:"timeseries_id"`
+	Direction     *string    `json:"threshold_direction" db:"threshold_direction"`
+	WatchLevel    *float64   `json:"watch_level" db:"watch_level"`
+	WarningLevel  *float64   `json:"warning_level" db:"warning_level"`
+	CriticalLevel *float64   `json:"critical_level" db:"critical_level"`
+	// RateOfChangeLimit is the largest allowed change in value per hour, in either direction
+	RateOfChangeLimit *float64 `json:"rate_of_change_limit" db:"rate_of_change_limit"`
+	// ComparisonWindowMinutes is how far before each measurement the rate of change is measured from
+	ComparisonWindowMinutes *int `json:"comparison_window_minutes" db:"comparison_window_minutes"`
+}
+
+// thresholdLevel is a named level of a threshold
+type thresholdLevel struct {
+	Name  string
+	Value float64
+}
+
+// levels returns the levels that are set, from least to most severe
+func (t *AlertThreshold) levels() []thresholdLevel {
+	ll := make([]thresholdLevel, 0)
+	if t.WatchLevel != nil {
+		ll = append(ll, thresholdLevel{AlertLevelWatch, *t.WatchLevel})
+	}
+	if t.WarningLevel != nil {
+		ll = append(ll, thresholdLevel{AlertLevelWarning, *t.WarningLevel})
+	}
+	if t.CriticalLevel != nil {
+		ll = append(ll, thresholdLevel{AlertLevelCritical, *t.CriticalLevel})
+	}
+	return ll
+}
+
+// validate checks that a threshold references a timeseries, has at least one level or a rate of change limit,
+// and that levels increase in severity in the threshold direction. The comparison window defaults to 60 minutes
+func (t *AlertThreshold) validate() error {
+	if t.TimeseriesID == nil {
+		return fmt.Errorf("timeseries_id is required for threshold alert configs")
+	}
+	ll := t.levels()
+	if len(ll) == 0 && t.RateOfChangeLimit == nil {
+		return fmt.Errorf("threshold alert configs require a watch, warning or critical level, or a rate_of_change_limit")
+	}
+	if len(ll) > 0 {
+		if t.Direction == nil || (*t.Direction != ThresholdAbove && *t.Direction != ThresholdBelow) {
+			return fmt.Errorf("threshold_direction must be '%s' or '%s'", ThresholdAbove, ThresholdBelow)
+		}
+		for idx := 1; idx < len(ll); idx++ {
+			if *t.Direction == ThresholdAbove && ll[idx].Value <= ll[idx-1].Value {
+				return fmt.Errorf("%s_level must be greater than %s_level", ll[idx].Name, ll[idx-1].Name)
+			}
+			if *t.Direction == ThresholdBelow && ll[idx].Value >= ll[idx-1].Value {
+				return fmt.Errorf("%s_level must be less than %s_level", ll[idx].Name, ll[idx-1].Name)
+			}
+		}
+	}
+	if t.RateOfChangeLimit != nil && *t.RateOfChangeLimit <= 0 {
+		return fmt.Errorf("rate_of_change_limit must be greater than 0")
+	}
+	if t.ComparisonWindowMinutes != nil && *t.ComparisonWindowMinutes <= 0 {
+		return fmt.Errorf("comparison_window_minutes must be greater than 0")
+	}
+	if t.RateOfChangeLimit != nil && t.ComparisonWindowMinutes == nil {
+		w := defaultComparisonWindowMinutes
+		t.ComparisonWindowMinutes = &w
+	}
+	return nil
+}
+
+// comparisonWindow returns the rate of change comparison window
+func (t *AlertThreshold) comparisonWindow() time.Duration {
+	if t.ComparisonWindowMinutes == nil {
+		return defaultComparisonWindowMinutes * time.Minute
+	}
+	return time.Duration(*t.ComparisonWindowMinutes) * time.Minute
+}
+
+// level returns the most severe level crossed by value v, or "" if v is normal
+func (t *AlertThreshold) level(v float64) string {
+	level := ""
+	if t.Direction == nil {
+		return level
+	}
+	for _, l := range t.levels() {
+		if (*t.Direction == ThresholdAbove && v >= l.Value) || (*t.Direction == ThresholdBelow && v <= l.Value) {
+			level = l.Name
+		}
+	}
+	return level
+}
+
+// thresholdSeries loads the timeseries referenced by a threshold with measurements in the window (from, at],
+// including the comparison window before from to measure the rate of change
+func thresholdSeries(db *sqlx.DB, t *AlertThreshold, from, at time.Time) (map[string]*alertSeries, error) {
+	series := make(map[string]*alertSeries)
+	if t.TimeseriesID == nil {
+		return series, nil
+	}
+	ss := []alertSeries{{TimeseriesID: *t.TimeseriesID, Variable: thresholdVariable}}
+	if err := alertSeriesMeasurements(db, ss, from.Add(-t.comparisonWindow()), at); err != nil {
+		return nil, err
+	}
+	series[thresholdVariable] = &ss[0]
+	return series, nil
+}
+
+// evaluateThresholdPoints evaluates a threshold at each measurement time in the window (from, at]
+// A point triggers if a level is crossed or the rate of change limit is exceeded, and clears otherwise.
+// If a level is crossed, it is reported instead of the rate of change
+func evaluateThresholdPoints(t *AlertThreshold, s *alertSeries, from, at time.Time) ([]alertPoint, *string) {
+	pp := make([]alertPoint, 0)
+	if s == nil {
+		msg := "timeseries does not exist"
+		return pp, &msg
+	}
+	times := make([]time.Time, 0)
+	for _, m := range s.Measurements {
+		if m.Time.After(from) && !m.Time.After(at) {
+			times = append(times, m.Time)
+		}
+	}
+	if len(times) == 0 {
+		times = append(times, at)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	for _, tm := range times {
+		v, ok := s.valueAt(tm)
+		if !ok {
+			continue
+		}
+		p := alertPoint{Time: tm, Values: AlertVariableValues{thresholdVariable: v}, Level: t.level(v)}
+		if t.RateOfChangeLimit != nil {
+			window := t.comparisonWindow()
+			if prev, ok := s.valueAt(tm.Add(-window)); ok {
+				rate := (v - prev) / window.Hours()
+				p.Values["rate_of_change"] = rate
+				if p.Level == "" && math.Abs(rate) > *t.RateOfChangeLimit {
+					p.Level = AlertLevelRateOfChange
+				}
+			}
+		}
+		p.Trigger, p.Clear = p.Level != "", p.Level == ""
+		pp = append(pp, p)
+	}
+	if len(pp) == 0 {
+		msg := "no measurements for timeseries"
+		return pp, &msg
+	}
+	return pp, nil
+}
@@ -0,0 +1,286 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/USACE/instrumentation-api/notify"
+	"github.com/USACE/instrumentation-api/passwords"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// Alert events posted to webhooks
+const (
+	AlertEventCreated      = "alert.created"
+	AlertEventAcknowledged = "alert.acknowledged"
+	AlertEventResolved     = "alert.resolved"
+)
+
+// Alert webhook delivery status
+const (
+	WebhookDeliveryPending = "pending"
+	WebhookDeliverySent    = "sent"
+	WebhookDeliveryFailed  = "failed"
+)
+
+// maxWebhookDeliveryAttempts is the number of attempts before a webhook delivery is marked failed
+const maxWebhookDeliveryAttempts = 5
+
+// webhookDeliveryBackoff is the wait before the first retry; doubled after each failed attempt
+const webhookDeliveryBackoff = time.Minute
+
+// AlertWebhook is an HTTP endpoint that receives alert events for all alert configs in a project,
+// or for a single alert config if AlertConfigID is set
+type AlertWebhook struct {
+	ID            uuid.UUID  `json:"id"`
+	ProjectID     uuid.UUID  `json:"project_id" db:"project_id"`
+	AlertConfigID *uuid.UUID `json:"alert_config_id" db:"alert_config_id"`
+	Name          string     `json:"name"`
+	URL           string     `json:"url"`
+	// Secret is used to sign payloads; it is only returned when a webhook is created
+	Secret  string `json:"secret,omitempty"`
+	Enabled bool   `json:"enabled"`
+	AuditInfo
+}
+
+// AlertWebhookDelivery is a log entry for an alert event posted to a webhook
+type AlertWebhookDelivery struct {
+	ID             uuid.UUID  `json:"id"`
+	AlertWebhookID uuid.UUID  `json:"alert_webhook_id" db:"alert_webhook_id"`
+	AlertID        uuid.UUID  `json:"alert_id" db:"alert_id"`
+	Event          string     `json:"event"`
+	Status         string     `json:"status"`
+	Attempts       int        `json:"attempts"`
+	NextAttempt    time.Time  `json:"next_attempt" db:"next_attempt"`
+	ResponseStatus *int       `json:"response_status" db:"response_status"`
+	LastError      *string    `json:"last_error" db:"last_error"`
+	CreateDate     time.Time  `json:"create_date" db:"create_date"`
+	SentDate       *time.Time `json:"sent_date" db:"sent_date"`
+}
+
+// AlertWebhookPayload is the JSON body posted to a webhook for an alert event
+type AlertWebhookPayload struct {
+	Event          string              `json:"event"`
+	DeliveryID     uuid.UUID           `json:"delivery_id"`
+	Time           time.Time           `json:"time"`
+	Alert          Alert               `json:"alert"`
+	ProjectSlug    string              `json:"project_slug"`
+	InstrumentSlug string              `json:"instrument_slug"`
+	Values         AlertVariableValues `json:"values"`
+	Link           *string             `json:"link"`
+}
+
+// ValidateAlertWebhook checks that a webhook has a name and an absolute http(s) URL of a public host
+func ValidateAlertWebhook(w *AlertWebhook) error {
+	if strings.TrimSpace(w.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	return notify.ValidateWebhookURL(w.URL)
+}
+
+// listAlertWebhooksSQL is the base SQL to retrieve alert webhooks; secrets are not returned
+var listAlertWebhooksSQL = `SELECT id, project_id, alert_config_id, name, url, enabled,
+                                   creator, create_date, updater, update_date
+                            FROM   alert_webhook`
+
+// createAlertWebhookDeliveriesSQL queues an event for each enabled webhook of an alert's project or alert config
+var createAlertWebhookDeliveriesSQL = `
+	INSERT INTO alert_webhook_delivery (alert_webhook_id, alert_id, event)
+	SELECT w.id, a.id, $2
+	FROM   alert a
+	INNER JOIN alert_config ac ON ac.id = a.alert_config_id
+	INNER JOIN instrument i ON i.id = ac.instrument_id
+	INNER JOIN alert_webhook w ON w.project_id = i.project_id AND (w.alert_config_id IS NULL OR w.alert_config_id = ac.id)
+	WHERE  a.id = $1 AND w.enabled
+	ON CONFLICT DO NOTHING`
+
+// ListProjectAlertWebhooks lists the alert webhooks for a project
+func ListProjectAlertWebhooks(db *sqlx.DB, projectID *uuid.UUID) ([]AlertWebhook, error) {
+	ww := make([]AlertWebhook, 0)
+	if err := db.Select(&ww, listAlertWebhooksSQL+" WHERE project_id = $1 ORDER BY name", projectID); err != nil {
+		return make([]AlertWebhook, 0), err
+	}
+	return ww, nil
+}
+
+// GetAlertWebhook returns a single alert webhook belonging to a project
+func GetAlertWebhook(db *sqlx.DB, projectID *uuid.UUID, id *uuid.UUID) (*AlertWebhook, error) {
+	var w AlertWebhook
+	if err := db.Get(&w, listAlertWebhooksSQL+" WHERE project_id = $1 AND id = $2", projectID, id); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+// CreateAlertWebhook creates an alert webhook; a secret is generated if one is not provided
+// Returns sql.ErrNoRows if the alert config does not belong to the project
+func CreateAlertWebhook(db *sqlx.DB, w *AlertWebhook) (*AlertWebhook, error) {
+	if w.Secret == "" {
+		w.Secret = passwords.GenerateRandom(40)
+	}
+	var id uuid.UUID
+	if err := db.Get(
+		&id,
+		`INSERT INTO alert_webhook (project_id, alert_config_id, name, url, secret, enabled, creator, create_date)
+		 SELECT $1, $2, $3, $4, $5, $6, $7, $8
+		 WHERE  $2::uuid IS NULL OR EXISTS (
+		     SELECT 1 FROM alert_config ac INNER JOIN instrument i ON i.id = ac.instrument_id
+		     WHERE  ac.id = $2 AND i.project_id = $1
+		 )
+		 RETURNING id`,
+		w.ProjectID, w.AlertConfigID, w.Name, w.URL, w.Secret, w.Enabled, w.Creator, w.CreateDate,
+	); err != nil {
+		return nil, err
+	}
+	wCreated, err := GetAlertWebhook(db, &w.ProjectID, &id)
+	if err != nil {
+		return nil, err
+	}
+	wCreated.Secret = w.Secret
+	return wCreated, nil
+}
+
+// UpdateAlertWebhook updates an alert webhook; the secret is replaced only if one is provided
+// Returns sql.ErrNoRows if the webhook or alert config does not belong to the project
+func UpdateAlertWebhook(db *sqlx.DB, w *AlertWebhook) (*AlertWebhook, error) {
+	var id uuid.UUID
+	if err := db.Get(
+		&id,
+		`UPDATE alert_webhook
+		 SET    alert_config_id = $3, name = $4, url = $5, secret = COALESCE(NULLIF($6, ''), secret),
+		        enabled = $7, updater = $8, update_date = $9
+		 WHERE  id = $1 AND project_id = $2 AND ($3::uuid IS NULL OR EXISTS (
+		     SELECT 1 FROM alert_config ac INNER JOIN instrument i ON i.id = ac.instrument_id
+		     WHERE  ac.id = $3 AND i.project_id = $2
+		 ))
+		 RETURNING id`,
+		w.ID, w.ProjectID, w.AlertConfigID, w.Name, w.URL, w.Secret, w.Enabled, w.Updater, w.UpdateDate,
+	); err != nil {
+		return nil, err
+	}
+	return GetAlertWebhook(db, &w.ProjectID, &id)
+}
+
+// DeleteAlertWebhook deletes an alert webhook and its delivery log
+func DeleteAlertWebhook(db *sqlx.DB, projectID *uuid.UUID, id *uuid.UUID) error {
+	if _, err := db.Exec(`DELETE FROM alert_webhook WHERE project_id = $1 AND id = $2`, projectID, id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListAlertWebhookDeliveries lists deliveries to a webhook within a time window, most recent first
+func ListAlertWebhookDeliveries(db *sqlx.DB, alertWebhookID *uuid.UUID, tw *TimeWindow) ([]AlertWebhookDelivery, error) {
+	dd := make([]AlertWebhookDelivery, 0)
+	if err := db.Select(
+		&dd,
+		`SELECT * FROM alert_webhook_delivery
+		 WHERE  alert_webhook_id = $1 AND create_date >= $2 AND create_date <= $3
+		 ORDER BY create_date DESC`,
+		alertWebhookID, tw.After, tw.Before,
+	); err != nil {
+		return make([]AlertWebhookDelivery, 0), err
+	}
+	return dd, nil
+}
+
+// alertWebhookPayload builds the payload posted to a webhook for an alert event
+// The link is omitted if appURL is not configured
+func alertWebhookPayload(db *sqlx.DB, d *AlertWebhookDelivery, appURL string) ([]byte, error) {
+	a, err := GetAlert(db, &d.AlertID)
+	if err != nil {
+		return nil, err
+	}
+	p := AlertWebhookPayload{Event: d.Event, DeliveryID: d.ID, Time: time.Now().UTC(), Alert: *a}
+	if err := db.QueryRowx(
+		`SELECT p.slug, i.slug, a.trigger_values::text
+		 FROM   alert a
+		 INNER JOIN alert_config ac ON ac.id = a.alert_config_id
+		 INNER JOIN instrument i ON i.id = ac.instrument_id
+		 INNER JOIN project p ON p.id = i.project_id
+		 WHERE  a.id = $1`,
+		d.AlertID,
+	).Scan(&p.ProjectSlug, &p.InstrumentSlug, &p.Values); err != nil {
+		return nil, err
+	}
+	p.Link = instrumentLink(appURL, p.ProjectSlug, p.InstrumentSlug)
+	return json.Marshal(p)
+}
+
+// DoSendAlertWebhooks claims and posts pending alert events to webhooks that are due
+// Failed deliveries are retried with exponential backoff, up to maxWebhookDeliveryAttempts
+func DoSendAlertWebhooks(db *sqlx.DB, appURL string) error {
+	for {
+		dd := make([]struct {
+			AlertWebhookDelivery
+			URL    string `db:"url"`
+			Secret string `db:"secret"`
+		}, 0)
+		if err := db.Select(
+			&dd,
+			`WITH claimed AS (
+			     UPDATE alert_webhook_delivery SET next_attempt = $2
+			     WHERE  id IN (
+			         SELECT id FROM alert_webhook_delivery
+			         WHERE  status = $1 AND next_attempt <= now()
+			         ORDER BY create_date LIMIT $3
+			         FOR UPDATE SKIP LOCKED
+			     )
+			     RETURNING *
+			 )
+			 SELECT d.*, w.url, w.secret
+			 FROM   claimed d
+			 INNER JOIN alert_webhook w ON w.id = d.alert_webhook_id
+			 ORDER BY d.create_date`,
+			WebhookDeliveryPending, time.Now().Add(deliveryClaimLease), deliveryClaimBatch,
+		); err != nil {
+			return err
+		}
+		if len(dd) == 0 {
+			return nil
+		}
+		for _, d := range dd {
+			attempts := d.Attempts + 1
+			payload, err := alertWebhookPayload(db, &d.AlertWebhookDelivery, appURL)
+			var status int
+			if err == nil {
+				w := notify.Webhook{URL: d.URL, Secret: d.Secret}
+				status, err = w.Send(d.Event, d.ID.String(), payload)
+			}
+			var responseStatus *int
+			if status != 0 {
+				responseStatus = &status
+			}
+			if err != nil {
+				log.Printf("alert webhook %s to %s failed (attempt %d): %s\n", d.ID, d.URL, attempts, err.Error())
+				s := WebhookDeliveryPending
+				if attempts >= maxWebhookDeliveryAttempts {
+					s = WebhookDeliveryFailed
+				}
+				next := time.Now().Add(webhookDeliveryBackoff * time.Duration(1<<uint(attempts-1)))
+				if _, err := db.Exec(
+					`UPDATE alert_webhook_delivery
+					 SET    status = $2, attempts = $3, next_attempt = $4, response_status = $5, last_error = $6
+					 WHERE  id = $1`,
+					d.ID, s, attempts, next, responseStatus, err.Error(),
+				); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := db.Exec(
+				`UPDATE alert_webhook_delivery
+				 SET    status = $2, attempts = $3, response_status = $4, last_error = null, sent_date = now()
+				 WHERE  id = $1`,
+				d.ID, WebhookDeliverySent, attempts, responseStatus,
+			); err != nil {
+				return err
+			}
+		}
+	}
+}
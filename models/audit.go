@@ -1,9 +1,17 @@
 package models
 
 import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 // AuditInfo holds common information about object creator and updater
@@ -14,10 +22,266 @@ type AuditInfo struct {
 	UpdateDate *time.Time `json:"update_date" db:"update_date"`
 }
 
-// Action captures an API action, including user, user's roles,
-// type (GET, POST, etc.), and Time
+// How the actor of an action was authenticated
+const (
+	AuthMethodJWT            = "jwt"
+	AuthMethodToken          = "token"
+	AuthMethodApplicationKey = "application_key"
+	AuthMethodAnonymous      = "anonymous"
+)
+
+// Action captures an API action that changes data; the actor and how they were authenticated, the route and
+// entities acted on, the response status and the entity in the route before and after the action
 type Action struct {
-	Actor int       `json:"actor"`
-	Type  string    `json:"action"`
-	Time  time.Time `json:"action_time"`
+	ID            uuid.UUID  `json:"id"`
+	Time          time.Time  `json:"action_time"`
+	Actor         *uuid.UUID `json:"actor"`
+	ActorUsername *string    `json:"actor_username"`
+	// ActorServiceAccount is true for actions of service accounts, false for people
+	ActorServiceAccount bool              `json:"actor_service_account"`
+	AuthMethod          string            `json:"auth_method"`
+	TokenID             *string           `json:"token_id"`
+	Method              string            `json:"method"`
+	Route               string            `json:"route"`
+	Params              map[string]string `json:"params"`
+	Status              int               `json:"status"`
+	ProjectIDs          []uuid.UUID       `json:"project_ids"`
+	EntityIDs           []uuid.UUID       `json:"entity_ids"`
+	Before              JSONValue         `json:"before"`
+	After               JSONValue         `json:"after"`
+	Diff                JSONValue         `json:"diff"`
+}
+
+// ActionFilter selects actions from the audit log; empty fields match every action
+type ActionFilter struct {
+	ProjectID  *uuid.UUID
+	Actor      *uuid.UUID
+	EntityID   *uuid.UUID
+	Method     string
+	AuthMethod string
+	After      *time.Time
+	Before     *time.Time
+	Limit      int
+}
+
+// Limits on the number of actions returned by ListActions
+const (
+	actionListDefaultLimit = 100
+	actionListMaxLimit     = 1000
+)
+
+// JSONValue is a JSON document stored in a JSONB column; empty values are null
+type JSONValue []byte
+
+// NewJSONValue returns the JSON encoding of v, or an empty JSONValue if v is nil
+func NewJSONValue(v interface{}) (JSONValue, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+// Scan implements sql.Scanner for a JSONB column
+func (v *JSONValue) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case nil:
+		*v = nil
+	case []byte:
+		*v = append(JSONValue{}, s...)
+	case string:
+		*v = JSONValue(s)
+	default:
+		return fmt.Errorf("cannot scan %T into JSONValue", src)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (v JSONValue) MarshalJSON() ([]byte, error) {
+	if len(v) == 0 {
+		return []byte("null"), nil
+	}
+	return v, nil
+}
+
+// sqlValue returns the document as a string for a JSONB parameter, or nil for null
+func (v JSONValue) sqlValue() *string {
+	if len(v) == 0 {
+		return nil
+	}
+	s := string(v)
+	return &s
+}
+
+// auditTables are the tables of entities identified by route parameters; the entity named by the last of these
+// parameters in a route is recorded before and after each action
+var auditTables = map[string]string{
+	"project_id":            "project",
+	"instrument_id":         "instrument",
+	"instrument_group_id":   "instrument_group",
+	"note_id":               "instrument_note",
+	"status_id":             "instrument_status",
+	"formula_id":            "formula",
+	"timeseries_id":         "timeseries",
+	"alert_config_id":       "alert_config",
+	"alert_id":              "alert",
+	"alert_webhook_id":      "alert_webhook",
+	"alert_subscription_id": "alert_profile_subscription",
+	"email_subscription_id": "alert_email_subscription",
+	"collection_group_id":   "collection_group",
+	"plot_configuration_id": "plot_configuration",
+	"role_id":               "role",
+}
+
+// auditRedactedKeys are keys of secrets; their values are not recorded in the audit log
+var auditRedactedKeys = []string{"secret", "secret_token", "hash", "unsubscribe_token"}
+
+// IsAuditedParam returns true if a route parameter identifies an entity recorded before and after each action
+func IsAuditedParam(param string) bool {
+	_, ok := auditTables[param]
+	return ok
+}
+
+// GetAuditSnapshot returns the row of the entity identified by a route parameter as a JSON object,
+// or nil if the parameter does not identify an audited entity or the entity does not exist
+func GetAuditSnapshot(db *sqlx.DB, param string, id *uuid.UUID) (map[string]interface{}, error) {
+	table, ok := auditTables[param]
+	if !ok {
+		return nil, nil
+	}
+	var b []byte
+	if err := db.Get(&b, fmt.Sprintf(`SELECT to_jsonb(t) FROM %s t WHERE t.id = $1`, table), id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	RedactAuditValue(m)
+	return m, nil
+}
+
+// RedactAuditValue replaces the values of secrets in a JSON object or array, and objects nested in it
+func RedactAuditValue(v interface{}) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			for _, r := range auditRedactedKeys {
+				if k == r {
+					t[k] = "[redacted]"
+				}
+			}
+			RedactAuditValue(e)
+		}
+	case []interface{}:
+		for _, e := range t {
+			RedactAuditValue(e)
+		}
+	}
+}
+
+// AuditDiff returns the fields changed between two snapshots of an entity as { field: { before: , after: }, }
+func AuditDiff(before, after map[string]interface{}) map[string]interface{} {
+	diff := make(map[string]interface{})
+	for k, b := range before {
+		if a, ok := after[k]; !ok || !reflect.DeepEqual(a, b) {
+			diff[k] = map[string]interface{}{"before": b, "after": after[k]}
+		}
+	}
+	for k, a := range after {
+		if _, ok := before[k]; !ok {
+			diff[k] = map[string]interface{}{"before": nil, "after": a}
+		}
+	}
+	return diff
+}
+
+// CreateAction records an action in the audit log
+func CreateAction(db *sqlx.DB, a *Action) error {
+	params, err := json.Marshal(a.Params)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec(
+		`INSERT INTO audit_log
+		     (action_time, actor, auth_method, token_id, method, route, params, status, project_ids, entity_ids, before, after, diff)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+		a.Time, a.Actor, a.AuthMethod, a.TokenID, a.Method, a.Route, string(params), a.Status,
+		pq.Array(a.ProjectIDs), pq.Array(a.EntityIDs), a.Before.sqlValue(), a.After.sqlValue(), a.Diff.sqlValue(),
+	)
+	return err
+}
+
+// ActionsFactory converts database rows to Action objects
+func ActionsFactory(rows *sqlx.Rows) ([]Action, error) {
+	defer rows.Close()
+	aa := make([]Action, 0)
+	for rows.Next() {
+		var a Action
+		var params JSONValue
+		if err := rows.Scan(
+			&a.ID, &a.Time, &a.Actor, &a.ActorUsername, &a.ActorServiceAccount, &a.AuthMethod, &a.TokenID, &a.Method, &a.Route, &params, &a.Status,
+			pq.Array(&a.ProjectIDs), pq.Array(&a.EntityIDs), &a.Before, &a.After, &a.Diff,
+		); err != nil {
+			return make([]Action, 0), err
+		}
+		if err := json.Unmarshal(params, &a.Params); err != nil {
+			return make([]Action, 0), err
+		}
+		aa = append(aa, a)
+	}
+	return aa, nil
+}
+
+// ListActions lists actions in the audit log matching a filter, most recent first
+func ListActions(db *sqlx.DB, f *ActionFilter) ([]Action, error) {
+	where, args := make([]string, 0), make([]interface{}, 0)
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(cond, len(args)))
+	}
+	if f.ProjectID != nil {
+		add("$%d = ANY(a.project_ids)", f.ProjectID)
+	}
+	if f.Actor != nil {
+		add("a.actor = $%d", f.Actor)
+	}
+	if f.EntityID != nil {
+		add("$%d = ANY(a.entity_ids)", f.EntityID)
+	}
+	if f.Method != "" {
+		add("a.method = $%d", strings.ToUpper(f.Method))
+	}
+	if f.AuthMethod != "" {
+		add("a.auth_method = $%d", f.AuthMethod)
+	}
+	if f.After != nil {
+		add("a.action_time >= $%d", f.After)
+	}
+	if f.Before != nil {
+		add("a.action_time < $%d", f.Before)
+	}
+	limit := f.Limit
+	if limit <= 0 {
+		limit = actionListDefaultLimit
+	}
+	if limit > actionListMaxLimit {
+		limit = actionListMaxLimit
+	}
+	query := `SELECT a.id, a.action_time, a.actor, p.username, COALESCE(p.is_service_account, false), a.auth_method, a.token_id, a.method, a.route, a.params,
+	                 a.status, a.project_ids, a.entity_ids, a.before, a.after, a.diff
+	          FROM   audit_log a
+	          LEFT JOIN profile p ON p.id = a.actor`
+	if len(where) > 0 {
+		query += " WHERE " + strings.Join(where, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY a.action_time DESC LIMIT %d", limit)
+	rows, err := db.Queryx(query, args...)
+	if err != nil {
+		return make([]Action, 0), err
+	}
+	return ActionsFactory(rows)
 }
@@ -42,22 +42,23 @@ func ListAwareParameters(db *sqlx.DB) ([]AwareParameter, error) {
 	return pp, nil
 }
 
-func listAwarePlatformParameterEnabled(db *sqlx.DB) ([]awarePlatformParameterEnabled, error) {
+func listAwarePlatformParameterEnabled(db *sqlx.DB, p *Profile) ([]awarePlatformParameterEnabled, error) {
 
 	sql := `SELECT project_id, instrument_id, aware_id, aware_parameter_key, timeseries_id
 			FROM v_aware_platform_parameter_enabled
+			WHERE project_id IN (` + ReadableProjectsSQL("$1") + `)
 			ORDER BY project_id, aware_id, aware_parameter_key`
 
 	aa := make([]awarePlatformParameterEnabled, 0)
-	if err := db.Select(&aa, sql); err != nil {
+	if err := db.Select(&aa, sql, readerID(p)); err != nil {
 		return make([]awarePlatformParameterEnabled, 0), err
 	}
 	return aa, nil
 }
 
-// ListAwarePlatformParameterConfig returns aware platform parameter configs
-func ListAwarePlatformParameterConfig(db *sqlx.DB) ([]AwarePlatformParameterConfig, error) {
-	ee, err := listAwarePlatformParameterEnabled(db)
+// ListAwarePlatformParameterConfig returns aware platform parameter configs in projects a profile can read
+func ListAwarePlatformParameterConfig(db *sqlx.DB, p *Profile) ([]AwarePlatformParameterConfig, error) {
+	ee, err := listAwarePlatformParameterEnabled(db, p)
 	if err != nil {
 		return make([]AwarePlatformParameterConfig, 0), err
 	}
@@ -2,10 +2,11 @@ package models
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Knetic/govaluate"
@@ -24,7 +25,11 @@ type TimeseriesInfo struct {
 	InstrumentID uuid.UUID `json:"instrument_id" db:"instrument_id"`
 	Variable     string    `json:"variable" db:"variable"`
 	IsComputed   bool      `json:"is_computed" db:"is_computed"`
+	IsConstant   bool      `json:"is_constant" db:"is_constant"`
 	Formula      *string   `json:"formula" db:"formula"`
+	UnitID       uuid.UUID `json:"unit_id" db:"unit_id"`
+	// VariableUnits holds declared units for formula variables; empty for stored timeseries
+	VariableUnits VariableUnitMap `json:"variable_units,omitempty" db:"variable_units"`
 }
 
 // Allows sending JSON Aggregated Data from the Database
@@ -42,8 +47,23 @@ type Timeseries struct {
 	NextMeasurementLow  *Measurement  `json:"next_measurement_low" db:"next_measurement_low"`
 	NextMeasurementHigh *Measurement  `json:"next_measurement_high" db:"next_measurement_high"`
 	TimeWindow          TimeWindow    `json:"time_window"`
+	Diagnostics         []Diagnostic  `json:"diagnostics,omitempty"`
+	Warnings            []string      `json:"warnings,omitempty"`
 }
 
+// Diagnostic explains why a computed timeseries has no value at a given time
+type Diagnostic struct {
+	Time             time.Time `json:"time"`
+	MissingVariables []string  `json:"missing_variables,omitempty"`
+	Error            string    `json:"error,omitempty"`
+}
+
+// Regularization methods used to align stored timeseries before computations
+const (
+	RegularizeMethodCarryForward = "carry_forward"
+	RegularizeMethodInterpolate  = "interpolate"
+)
+
 type Measurement struct {
 	Time  time.Time `json:"time"`
 	Value float64   `json:"value"`
@@ -112,55 +132,234 @@ func (ts Timeseries) RegularizeCarryForward(w TimeWindow, d time.Duration) (Time
 	}, nil
 }
 
+// RegularizeEffectiveDated regularizes an instrument constant over the time window w at interval d
+// Each stored value is an effective-dated parameter; it is in effect from its timestamp until the next value.
+// A constant has no value before its first effective date, so formulas using it are not computed for earlier
+// times. Constants are carried forward whatever regularization method is used for other timeseries
+func (ts Timeseries) RegularizeEffectiveDated(w TimeWindow, d time.Duration) (Timeseries, error) {
+	return ts.RegularizeCarryForward(w, d)
+}
+
+// RegularizeInterpolate converts potentially irregular timeseries measurements into a regular
+// interval timeseries over the time window w with measurements spaced at interval d
+// Missing values are filled-in using linear interpolation between the surrounding known values;
+// times before the first or after the last known value are left empty
 func (ts Timeseries) RegularizeInterpolate(w TimeWindow, d time.Duration) (Timeseries, error) {
-	log.Println("Not Implemented")
-	return Timeseries{}, errors.New("Method Not Implemented")
+
+	regularized := make([]Measurement, 0)
+
+	a := make([]Measurement, 0)
+	// Array of Measurements to Work Against
+	if ts.NextMeasurementLow != nil {
+		a = append(a, *ts.NextMeasurementLow)
+	}
+	a = append(a, ts.Measurements...)
+	if ts.NextMeasurementHigh != nil {
+		a = append(a, *ts.NextMeasurementHigh)
+	}
+
+	t, tEnd, wkIdx := w.After, w.Before, 0
+	for len(a) > 0 && !t.After(tEnd) {
+		// Before first known value or after last known value; not computable
+		if t.Before(a[0].Time) || t.After(a[len(a)-1].Time) {
+			t = t.Add(d)
+			continue
+		}
+		// Advance working index so that a[wkIdx].Time <= t < a[wkIdx+1].Time
+		for wkIdx < len(a)-1 && !t.Before(a[wkIdx+1].Time) {
+			wkIdx += 1
+		}
+		if t.Equal(a[wkIdx].Time) || wkIdx == len(a)-1 {
+			regularized = append(regularized, Measurement{t, a[wkIdx].Value})
+			t = t.Add(d)
+			continue
+		}
+		lo, hi := a[wkIdx], a[wkIdx+1]
+		frac := float64(t.Sub(lo.Time)) / float64(hi.Time.Sub(lo.Time))
+		regularized = append(regularized, Measurement{t, lo.Value + frac*(hi.Value-lo.Value)})
+		t = t.Add(d)
+	}
+	return Timeseries{
+		TimeseriesInfo:      ts.TimeseriesInfo,
+		Measurements:        regularized,
+		NextMeasurementLow:  ts.NextMeasurementLow,
+		NextMeasurementHigh: ts.NextMeasurementHigh,
+	}, nil
 }
 
-func (ts *Timeseries) Calculate(variableMap map[time.Time]map[string]interface{}) error {
+// UnitConverters returns functions to convert each formula variable to its declared unit
+// variableUnits maps each variable to the unit its timeseries is stored in. Warnings are added
+// to ts.Warnings when the formula's own unit is not dimensionally plausible given its inputs
+func (ts *Timeseries) UnitConverters(units map[uuid.UUID]Unit, variableUnits map[string]uuid.UUID) (map[string]func(float64) float64, error) {
 	expression, err := govaluate.NewEvaluableExpression(*ts.Formula)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	converters := make(map[string]func(float64) float64)
+	// effective unit of each variable after conversion
+	effective := make(map[string]Unit)
+	for _, v := range expression.Vars() {
+		storedID, ok := variableUnits[v]
+		if !ok {
+			continue
+		}
+		stored := units[storedID]
+		declaredID, ok := ts.VariableUnits[v]
+		if !ok || declaredID == storedID {
+			effective[v] = stored
+			continue
+		}
+		declared, ok := units[declaredID]
+		if !ok {
+			return nil, fmt.Errorf("variable %s declares unknown unit_id %s", v, declaredID)
+		}
+		c, err := stored.Converter(declared)
+		if err != nil {
+			return nil, fmt.Errorf("variable %s: %s", v, err.Error())
+		}
+		converters[v] = c
+		effective[v] = declared
 	}
-	t, end, interval := ts.TimeWindow.After, ts.TimeWindow.Before, time.Hour
-	for !t.After(end) {
-		if params, exists := variableMap[t]; exists {
-			valStr, err := expression.Evaluate(params)
-			if err != nil {
-				t = t.Add(interval)
+	ts.Warnings = append(ts.Warnings, formulaUnitWarnings(expression, effective, units[ts.UnitID])...)
+	return converters, nil
+}
+
+// formulaUnitWarnings checks that a formula is dimensionally plausible
+// Only formulas that add and subtract variables can be checked; all inputs and the output
+// must then share a dimension. Variables in unknown units are not checked
+func formulaUnitWarnings(expression *govaluate.EvaluableExpression, variableUnits map[string]Unit, output Unit) []string {
+	for _, t := range expression.Tokens() {
+		switch t.Kind {
+		case govaluate.VARIABLE, govaluate.NUMERIC, govaluate.CLAUSE, govaluate.CLAUSE_CLOSE:
+			continue
+		case govaluate.PREFIX, govaluate.MODIFIER:
+			if t.Value == "+" || t.Value == "-" {
 				continue
 			}
-			val64, err := strconv.ParseFloat(fmt.Sprint(valStr), 64)
-			if err != nil {
-				t = t.Add(interval)
-				continue
+		}
+		return nil
+	}
+	dimensions := make(map[string][]string)
+	dims := make([]string, 0)
+	for v, u := range variableUnits {
+		if d := u.Dimension(); d != "" {
+			if _, exists := dimensions[d]; !exists {
+				dims = append(dims, d)
 			}
-			ts.Measurements = append(ts.Measurements, Measurement{Time: t, Value: val64})
+			dimensions[d] = append(dimensions[d], v)
+		}
+	}
+	if len(dimensions) > 1 {
+		sort.Strings(dims)
+		parts := make([]string, len(dims))
+		for idx, d := range dims {
+			sort.Strings(dimensions[d])
+			parts[idx] = fmt.Sprintf("%s (%s)", d, strings.Join(dimensions[d], ", "))
 		}
-		t = t.Add(interval)
+		return []string{fmt.Sprintf("formula adds or subtracts variables with different measures: %s", strings.Join(parts, "; "))}
+	}
+	if len(dimensions) == 1 && output.Dimension() != "" && output.Dimension() != dims[0] {
+		return []string{fmt.Sprintf("formula unit %s (%s) does not match the measure of its inputs (%s)", output.Name, output.Measure, dims[0])}
+	}
+	return nil
+}
+
+// Calculate evaluates the formula at each interval d in the timeseries time window
+// Variables with a converter are converted before evaluation
+// Times that cannot be computed are recorded in ts.Diagnostics along with the reason
+func (ts *Timeseries) Calculate(variableMap map[time.Time]map[string]interface{}, d time.Duration, converters map[string]func(float64) float64) error {
+	expression, err := govaluate.NewEvaluableExpression(*ts.Formula)
+	if err != nil {
+		return err
+	}
+	vars := expression.Vars()
+	for t, end := ts.TimeWindow.After, ts.TimeWindow.Before; !t.After(end); t = t.Add(d) {
+		params := variableMap[t]
+		missing := make([]string, 0)
+		for _, v := range vars {
+			if _, exists := params[v]; !exists {
+				missing = append(missing, v)
+			}
+		}
+		if len(missing) > 0 {
+			ts.Diagnostics = append(ts.Diagnostics, Diagnostic{Time: t, MissingVariables: missing})
+			continue
+		}
+		if len(converters) > 0 {
+			converted := make(map[string]interface{}, len(params))
+			for k, v := range params {
+				converted[k] = v
+			}
+			for v, convert := range converters {
+				converted[v] = convert(params[v].(float64))
+			}
+			params = converted
+		}
+		valStr, err := expression.Evaluate(params)
+		if err != nil {
+			ts.Diagnostics = append(ts.Diagnostics, Diagnostic{Time: t, Error: err.Error()})
+			continue
+		}
+		val64, err := strconv.ParseFloat(fmt.Sprint(valStr), 64)
+		if err != nil {
+			ts.Diagnostics = append(ts.Diagnostics, Diagnostic{Time: t, Error: fmt.Sprintf("formula result %v is not a number", valStr)})
+			continue
+		}
+		ts.Measurements = append(ts.Measurements, Measurement{Time: t, Value: val64})
 	}
 	return nil
 }
 
 // ComputedTimeseries returns computed and stored timeseries for a specified array of instrument IDs
-func ComputedTimeseries(db *sqlx.DB, instrumentIDs []uuid.UUID, tw *TimeWindow, interval *time.Duration) ([]Timeseries, error) {
+// Stored timeseries are regularized to interval using method before formulas are evaluated
+func ComputedTimeseries(db *sqlx.DB, instrumentIDs []uuid.UUID, tw *TimeWindow, interval *time.Duration, method string) ([]Timeseries, error) {
+	return computeTimeseries(db, instrumentIDs, make([]Timeseries, 0), tw, interval, method)
+}
+
+// computeTimeseries returns computed and stored timeseries for a specified array of instrument IDs
+// drafts are computed timeseries that have not been saved; they are evaluated after the saved formulas
+func computeTimeseries(db *sqlx.DB, instrumentIDs []uuid.UUID, drafts []Timeseries, tw *TimeWindow, interval *time.Duration, method string) ([]Timeseries, error) {
+
+	var regularize func(Timeseries, TimeWindow, time.Duration) (Timeseries, error)
+	switch method {
+	case RegularizeMethodCarryForward:
+		regularize = Timeseries.RegularizeCarryForward
+	case RegularizeMethodInterpolate:
+		regularize = Timeseries.RegularizeInterpolate
+	default:
+		return make([]Timeseries, 0), fmt.Errorf("unknown regularization method '%s'", method)
+	}
 
 	tt := make([]DBTimeseries, 0)
 	sql := `
 	-- Get Timeseries and Dependencies for Computations
 	-- timeseries required based on requested instrument
-	WITH requested_instruments AS (
+	WITH RECURSIVE requested_instruments AS (
 		SELECT id
 		FROM instrument
 		WHERE id IN (?)
+	), required_formulas AS (
+	-- Formulas for Instrument
+		SELECT id FROM formula WHERE instrument_id IN (SELECT id FROM requested_instruments)
+		UNION
+	-- Formulas referenced by required formulas, on any instrument
+		SELECT f.id
+		FROM required_formulas r
+		INNER JOIN formula rf ON rf.id = r.id
+		CROSS JOIN LATERAL regexp_matches(rf.formula, '\[([^\]]*)\]', 'g') AS v(parsed)
+		INNER JOIN (
+			SELECT f.id, i.slug || '.' || f.slug AS variable
+			FROM formula f
+			INNER JOIN instrument i ON i.id = f.instrument_id
+		) f ON f.variable = v.parsed[1]
 	), required_timeseries AS (
 	-- 	Timeseries for Instrument
 		SELECT id FROM timeseries WHERE instrument_id IN (SELECT id FROM requested_instruments)
 		UNION
-	-- Dependencies for Instrument Timeseries
+	-- Timeseries referenced by required formulas, on any instrument
 		SELECT dependency_timeseries_id AS id
 		FROM v_timeseries_dependency
-		WHERE instrument_id IN (SELECT id from requested_instruments)
+		WHERE timeseries_id IN (SELECT id FROM required_formulas) AND dependency_timeseries_id IS NOT NULL
 	),
 	-- Next Timeseries Measurement Outside Time Window (Earlier); Needed for Calculation Interpolation
 	next_low AS (
@@ -197,28 +396,40 @@ func ComputedTimeseries(db *sqlx.DB, instrumentIDs []uuid.UUID, tw *TimeWindow,
 		   ts.instrument_id         AS instrument_id,
 		   i.slug || '.' || ts.slug AS variable,
 		   false                    AS is_computed,
+		   ic.timeseries_id IS NOT NULL AS is_constant,
 		   null                     AS formula,
+		   ts.unit_id               AS unit_id,
+		   '{}'::text               AS variable_units,
 		   COALESCE(m.measurements, '[]') AS measurements,
 		   nl.measurement::text     AS next_measurement_low,
 		   nh.measurement::text     AS next_measurement_high
 	FROM required_timeseries r
 	INNER JOIN timeseries ts ON ts.id = r.id
-	INNER JOIN instrument i ON i.id = ts.instrument_id AND i.id IN (SELECT id FROM requested_instruments)
+	INNER JOIN instrument i ON i.id = ts.instrument_id
+	LEFT JOIN instrument_constants ic ON ic.timeseries_id = r.id
 	LEFT JOIN measurements m ON m.timeseries_id = r.id
 	LEFT JOIN next_low nl ON nl.timeseries_id = r.id
 	LEFT JOIN next_high nh ON nh.timeseries_id = r.id
 	UNION
 	-- Computed Timeseries
-	SELECT i.formula_id            AS timeseries_id,
-		   i.id                    AS instrument_id,
-		   i.slug || '.formula'    AS variable,
+	SELECT f.id                    AS timeseries_id,
+		   f.instrument_id         AS instrument_id,
+		   i.slug || '.' || f.slug AS variable,
 		   true                    AS is_computed,
-		   i.formula               AS formula,
+		   false                   AS is_constant,
+		   f.formula               AS formula,
+		   f.unit_id               AS unit_id,
+		   COALESCE((
+			   SELECT json_object_agg(v.variable, v.unit_id)
+			   FROM formula_variable_unit v
+			   WHERE v.formula_id = f.id
+		   ), '{}')::text          AS variable_units,
 		   '[]'::text              AS measurements,
 		   null                    AS next_measurement_low,
 		   null                    AS next_measurement_high
-	FROM instrument i
-	WHERE i.formula IS NOT NULL AND i.id IN (SELECT id FROM requested_instruments)
+	FROM formula f
+	INNER JOIN instrument i ON i.id = f.instrument_id
+	WHERE f.id IN (SELECT id FROM required_formulas)
 	ORDER BY is_computed
 	`
 
@@ -254,43 +465,148 @@ func ComputedTimeseries(db *sqlx.DB, instrumentIDs []uuid.UUID, tw *TimeWindow,
 		}
 	}
 
+	// Draft formulas are computed last, along with the saved formulas
+	tt2 = append(tt2, drafts...)
+
+	// Dependencies on other instruments are used in computations but only timeseries of the
+	// requested instruments are returned
+	requested := make(map[uuid.UUID]bool)
+	for _, id := range instrumentIDs {
+		requested[id] = true
+	}
+
 	// Final Timeseries to be returned
 	tt3 := make([]Timeseries, 0)
 
 	// a map of all available parameters for a given time slice
 	variableMap := make(map[time.Time]map[string]interface{})
+	// a map of the unit each timeseries variable is measured in
+	variableUnits := make(map[string]uuid.UUID)
 
 	// todo: Optimization - do not need to regularize all timeseries
 	// only need to regularize those that will be used as computation dependencies
+	formulas := make([]Timeseries, 0)
 	for _, ts := range tt2 {
-		tsReg, err := ts.RegularizeCarryForward(*tw, *interval)
+		// Calculated timeseries (identified by .IsComputed) are returned from the database
+		// last in the query using ORDER BY is_computed, followed by drafts
+		if ts.IsComputed {
+			formulas = append(formulas, ts)
+			continue
+		}
+		var tsReg Timeseries
+		var err error
+		if ts.IsConstant {
+			tsReg, err = ts.RegularizeEffectiveDated(*tw, *interval)
+		} else {
+			tsReg, err = regularize(ts, *tw, *interval)
+		}
 		if err != nil {
 			return make([]Timeseries, 0), err
 		}
 		// Add All Measurements from Regularized Timeseries to Map
-		for _, m := range tsReg.Measurements {
-			if _, exists := variableMap[m.Time]; !exists {
-				variableMap[m.Time] = make(map[string]interface{})
-			}
-			variableMap[m.Time][ts.Variable] = m.Value
-		}
-		// If not a computed timeseries, add raw version of timeseries to response
-		if !ts.IsComputed {
+		addToVariableMap(variableMap, ts.Variable, tsReg.Measurements)
+		variableUnits[ts.Variable] = ts.UnitID
+		// Add raw version of stored timeseries to response
+		if requested[ts.InstrumentID] {
 			tt3 = append(tt3, ts)
-			continue
 		}
+	}
+	// all units, only fetched when there is a formula to compute
+	if len(formulas) == 0 {
+		return tt3, nil
+	}
+	units, err := GetUnitMap(db)
+	if err != nil {
+		return make([]Timeseries, 0), err
+	}
 
-		// Computations
-		// It is known that all stored timeseries have been added to the Map and computations
-		// can now be run because alculated timeseries (identified by .IsComputed)
-		// are returned from the database last in the query using ORDER BY is_computed
-		err = ts.Calculate(variableMap)
+	// Computations
+	// All stored timeseries have been added to the Map; formulas are computed after the formulas
+	// they reference, and their results are added to the Map for the formulas that reference them
+	computed := make([]Timeseries, len(formulas))
+	for _, idx := range formulaOrder(formulas) {
+		ts := formulas[idx]
+		converters, err := ts.UnitConverters(units, variableUnits)
+		if err == nil {
+			err = ts.Calculate(variableMap, *interval, converters)
+		}
 		if err != nil {
 			log.Printf("Error Computing Formula for Timeseries %s\n", ts.TimeseriesID)
-			continue
+			ts.Diagnostics = append(ts.Diagnostics, Diagnostic{Time: tw.After, Error: err.Error()})
+		}
+		if ts.Variable != "" {
+			addToVariableMap(variableMap, ts.Variable, ts.Measurements)
+			variableUnits[ts.Variable] = ts.UnitID
+		}
+		computed[idx] = ts
+	}
+	for _, ts := range computed {
+		if requested[ts.InstrumentID] {
+			tt3 = append(tt3, ts)
 		}
-		tt3 = append(tt3, ts)
 	}
 
 	return tt3, nil
 }
+
+// addToVariableMap adds the measurements of a timeseries to the map of parameters for each time slice
+func addToVariableMap(variableMap map[time.Time]map[string]interface{}, variable string, mm []Measurement) {
+	for _, m := range mm {
+		if _, exists := variableMap[m.Time]; !exists {
+			variableMap[m.Time] = make(map[string]interface{})
+		}
+		variableMap[m.Time][variable] = m.Value
+	}
+}
+
+// formulaOrder returns the indexes of formulas in the order they are computed; formulas that reference
+// other formulas are computed after them. Formulas that reference each other in a cycle are computed
+// last, and report the variables they are missing
+func formulaOrder(formulas []Timeseries) []int {
+	index := make(map[string]int)
+	for idx, f := range formulas {
+		if f.Variable != "" {
+			index[f.Variable] = idx
+		}
+	}
+	dependencies := make([][]int, len(formulas))
+	for idx, f := range formulas {
+		expression, err := govaluate.NewEvaluableExpression(*f.Formula)
+		if err != nil {
+			continue
+		}
+		for _, v := range expression.Vars() {
+			if d, ok := index[v]; ok && d != idx {
+				dependencies[idx] = append(dependencies[idx], d)
+			}
+		}
+	}
+	order := make([]int, 0, len(formulas))
+	done := make([]bool, len(formulas))
+	for len(order) < len(formulas) {
+		progress := false
+		for idx := range formulas {
+			if done[idx] {
+				continue
+			}
+			ready := true
+			for _, d := range dependencies[idx] {
+				if !done[d] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				order, done[idx], progress = append(order, idx), true, true
+			}
+		}
+		if !progress {
+			for idx := range formulas {
+				if !done[idx] {
+					order, done[idx] = append(order, idx), true
+				}
+			}
+		}
+	}
+	return order
+}
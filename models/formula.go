@@ -0,0 +1,287 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Knetic/govaluate"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// Formula is a named computed timeseries belonging to an instrument
+type Formula struct {
+	ID            uuid.UUID       `json:"id"`
+	InstrumentID  uuid.UUID       `json:"instrument_id" db:"instrument_id"`
+	Slug          string          `json:"slug"`
+	Name          string          `json:"name"`
+	Formula       string          `json:"formula"`
+	ParameterID   uuid.UUID       `json:"parameter_id" db:"parameter_id"`
+	UnitID        uuid.UUID       `json:"unit_id" db:"unit_id"`
+	VariableUnits VariableUnitMap `json:"variable_units" db:"variable_units"`
+	AuditInfo
+}
+
+// VariableUnitMap is a map of { variable: unit_id, }
+// Declares the unit each formula variable is converted to before the formula is evaluated
+type VariableUnitMap map[string]uuid.UUID
+
+// Scan implements sql.Scanner for a JSON object aggregated in the database
+func (m *VariableUnitMap) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = make(VariableUnitMap)
+		return nil
+	case []byte:
+		return json.Unmarshal(v, m)
+	case string:
+		return json.Unmarshal([]byte(v), m)
+	default:
+		return fmt.Errorf("cannot scan %T into VariableUnitMap", src)
+	}
+}
+
+// FormulaCollection is a collection of Formula items
+type FormulaCollection struct {
+	Items []Formula
+}
+
+// UnmarshalJSON implements UnmarshalJSON interface
+// Allows unpacking object or array of objects into array of objects
+func (c *FormulaCollection) UnmarshalJSON(b []byte) error {
+
+	switch JSONType(b) {
+	case "ARRAY":
+		if err := json.Unmarshal(b, &c.Items); err != nil {
+			return err
+		}
+	case "OBJECT":
+		var f Formula
+		if err := json.Unmarshal(b, &f); err != nil {
+			return err
+		}
+		c.Items = []Formula{f}
+	default:
+		c.Items = make([]Formula, 0)
+	}
+	return nil
+}
+
+// ListInstrumentFormulas returns an array of formulas for a given instrument
+func ListInstrumentFormulas(db *sqlx.DB, instrumentID *uuid.UUID) ([]Formula, error) {
+
+	ff := make([]Formula, 0)
+	if err := db.Select(&ff, listFormulasSQL+" WHERE instrument_id = $1 ORDER BY name", instrumentID); err != nil {
+		return make([]Formula, 0), err
+	}
+	return ff, nil
+}
+
+// GetFormula returns a single formula of an instrument; sql.ErrNoRows if the instrument has no formula with the id
+func GetFormula(db *sqlx.DB, instrumentID, id *uuid.UUID) (*Formula, error) {
+
+	var f Formula
+	if err := db.Get(&f, listFormulasSQL+" WHERE id = $1 AND instrument_id = $2", id, instrumentID); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// CreateFormulas creates many formulas from an array of formulas
+func CreateFormulas(db *sqlx.DB, formulas []Formula) ([]Formula, error) {
+
+	txn, err := db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+
+	stmt, err := txn.Preparex(
+		`INSERT INTO formula (instrument_id, slug, name, formula, parameter_id, unit_id, creator, create_date)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 RETURNING id`,
+	)
+	if err != nil {
+		txn.Rollback()
+		return make([]Formula, 0), err
+	}
+
+	stmt2, err := txn.Preparex(createFormulaVariableUnitSQL)
+	if err != nil {
+		txn.Rollback()
+		return make([]Formula, 0), err
+	}
+
+	ids := make([]uuid.UUID, len(formulas))
+	for idx, f := range formulas {
+		if err := stmt.Get(
+			&ids[idx], f.InstrumentID, f.Slug, f.Name, f.Formula, f.ParameterID, f.UnitID, f.Creator, f.CreateDate,
+		); err != nil {
+			txn.Rollback()
+			return make([]Formula, 0), err
+		}
+		for variable, unitID := range f.VariableUnits {
+			if _, err := stmt2.Exec(ids[idx], variable, unitID); err != nil {
+				txn.Rollback()
+				return make([]Formula, 0), err
+			}
+		}
+	}
+
+	if err := stmt.Close(); err != nil {
+		txn.Rollback()
+		return make([]Formula, 0), err
+	}
+	if err := stmt2.Close(); err != nil {
+		txn.Rollback()
+		return make([]Formula, 0), err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return make([]Formula, 0), err
+	}
+
+	ff := make([]Formula, 0)
+	query, args, err := sqlx.In(listFormulasSQL+" WHERE id IN (?) ORDER BY name", ids)
+	if err != nil {
+		return make([]Formula, 0), err
+	}
+	if err := db.Select(&ff, db.Rebind(query), args...); err != nil {
+		return make([]Formula, 0), err
+	}
+	return ff, nil
+}
+
+// UpdateFormula updates a single formula
+func UpdateFormula(db *sqlx.DB, f *Formula) (*Formula, error) {
+
+	txn, err := db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+
+	var fID uuid.UUID
+	if err := txn.Get(
+		&fID,
+		`UPDATE formula
+		 SET    name = $3,
+		        formula = $4,
+		        parameter_id = $5,
+		        unit_id = $6,
+		        updater = $7,
+		        update_date = $8
+		 WHERE  id = $1 AND instrument_id = $2
+		 RETURNING id`,
+		f.ID, f.InstrumentID, f.Name, f.Formula, f.ParameterID, f.UnitID, f.Updater, f.UpdateDate,
+	); err != nil {
+		txn.Rollback()
+		return nil, err
+	}
+	// Replace declared variable units
+	if _, err := txn.Exec(`DELETE FROM formula_variable_unit WHERE formula_id = $1`, fID); err != nil {
+		txn.Rollback()
+		return nil, err
+	}
+	for variable, unitID := range f.VariableUnits {
+		if _, err := txn.Exec(createFormulaVariableUnitSQL, fID, variable, unitID); err != nil {
+			txn.Rollback()
+			return nil, err
+		}
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+	return GetFormula(db, &f.InstrumentID, &fID)
+}
+
+// DeleteFormula deletes a single formula
+func DeleteFormula(db *sqlx.DB, instrumentID *uuid.UUID, id *uuid.UUID) error {
+
+	if _, err := db.Exec(`DELETE FROM formula WHERE instrument_id = $1 AND id = $2`, instrumentID, id); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FormulaPreview is a draft formula evaluated over a time window without being saved
+type FormulaPreview struct {
+	Computed Timeseries   `json:"computed"`
+	Inputs   []Timeseries `json:"inputs"`
+}
+
+// PreviewFormula evaluates a draft formula over time window tw at interval using the same
+// machinery as saved formulas. Nothing is persisted. Inputs are the timeseries and formulas
+// referenced by the formula; variables only reference timeseries in projects profile p can read
+func PreviewFormula(db *sqlx.DB, p *Profile, f *Formula, tw *TimeWindow, interval *time.Duration, method string) (*FormulaPreview, error) {
+
+	// A formula that does not compile is reported as a diagnostic on the computed timeseries
+	variables := make([]string, 0)
+	if expression, err := govaluate.NewEvaluableExpression(f.Formula); err == nil {
+		variables = expression.Vars()
+	}
+	// Variables may reference timeseries and formulas belonging to other instruments
+	instrumentIDs := []uuid.UUID{f.InstrumentID}
+	if len(variables) > 0 {
+		query, args, err := sqlx.In(
+			`SELECT DISTINCT t.instrument_id
+			 FROM   (SELECT instrument_id, slug FROM timeseries UNION ALL SELECT instrument_id, slug FROM formula) t
+			 INNER JOIN instrument i ON i.id = t.instrument_id
+			 WHERE  i.slug || '.' || t.slug IN (?)
+			        AND i.project_id IN (`+ReadableProjectsSQL("?")+`)`, variables, readerID(p), readerID(p),
+		)
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]uuid.UUID, 0)
+		if err := db.Select(&ids, db.Rebind(query), args...); err != nil {
+			return nil, err
+		}
+		instrumentIDs = append(instrumentIDs, ids...)
+	}
+
+	draft := Timeseries{
+		TimeseriesInfo: TimeseriesInfo{
+			InstrumentID:  f.InstrumentID,
+			IsComputed:    true,
+			Formula:       &f.Formula,
+			UnitID:        f.UnitID,
+			VariableUnits: f.VariableUnits,
+		},
+		Measurements: make([]Measurement, 0),
+		TimeWindow:   *tw,
+	}
+	tt, err := computeTimeseries(db, instrumentIDs, []Timeseries{draft}, tw, interval, method)
+	if err != nil {
+		return nil, err
+	}
+
+	// The draft is always computed last
+	fp := FormulaPreview{Computed: tt[len(tt)-1], Inputs: make([]Timeseries, 0)}
+	found := make(map[string]bool)
+	for _, t := range tt[:len(tt)-1] {
+		for _, v := range variables {
+			if t.Variable == v && !found[v] {
+				fp.Inputs = append(fp.Inputs, t)
+				found[v] = true
+			}
+		}
+	}
+	for _, v := range variables {
+		if !found[v] {
+			fp.Computed.Warnings = append(fp.Computed.Warnings, fmt.Sprintf("variable %s does not match any timeseries", v))
+		}
+	}
+	return &fp, nil
+}
+
+var createFormulaVariableUnitSQL = `INSERT INTO formula_variable_unit (formula_id, variable, unit_id) VALUES ($1, $2, $3)`
+
+// listFormulasSQL is the base SQL to retrieve all formulas
+var listFormulasSQL = `SELECT id, instrument_id, slug, name, formula, parameter_id, unit_id,
+                              COALESCE((
+                                  SELECT json_object_agg(v.variable, v.unit_id)
+                                  FROM   formula_variable_unit v
+                                  WHERE  v.formula_id = formula.id
+                              ), '{}')::text AS variable_units,
+                              creator, create_date, updater, update_date
+                       FROM   formula`
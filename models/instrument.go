@@ -23,7 +23,7 @@ type Instrument struct {
 	Groups        []uuid.UUID      `json:"groups"`
 	Constants     []uuid.UUID      `json:"constants"`
 	AlertConfigs  []uuid.UUID      `json:"alert_configs"`
-	Formula       *string          `json:"formula"`
+	Formulas      []uuid.UUID      `json:"formulas"`
 	StatusID      uuid.UUID        `json:"status_id" db:"status_id"`
 	Status        string           `json:"status"`
 	StatusTime    time.Time        `json:"status_time" db:"status_time"`
@@ -94,10 +94,13 @@ func ListInstrumentSlugs(db *sqlx.DB) ([]string, error) {
 	return ss, nil
 }
 
-// ListInstruments returns an array of instruments from the database
-func ListInstruments(db *sqlx.DB) ([]Instrument, error) {
+// ListInstruments returns an array of instruments from the database in projects a profile can read
+func ListInstruments(db *sqlx.DB, p *Profile) ([]Instrument, error) {
 
-	rows, err := db.Queryx(listInstrumentsSQL + " WHERE NOT deleted")
+	rows, err := db.Queryx(
+		listInstrumentsSQL+" WHERE NOT deleted AND (project_id IS NULL OR project_id IN ("+ReadableProjectsSQL("$1")+"))",
+		readerID(p),
+	)
 	if err != nil {
 		return make([]Instrument, 0), err
 	}
@@ -119,10 +122,14 @@ func GetInstrument(db *sqlx.DB, id *uuid.UUID) (*Instrument, error) {
 	return &ii[0], nil
 }
 
-// GetInstrumentCount returns the number of instruments in the database
-func GetInstrumentCount(db *sqlx.DB) (int, error) {
+// GetInstrumentCount returns the number of instruments in the database in projects a profile can read
+func GetInstrumentCount(db *sqlx.DB, p *Profile) (int, error) {
 	var count int
-	if err := db.Get(&count, "SELECT COUNT(id) FROM instrument WHERE NOT deleted"); err != nil {
+	if err := db.Get(
+		&count,
+		"SELECT COUNT(id) FROM instrument WHERE NOT deleted AND (project_id IS NULL OR project_id IN ("+ReadableProjectsSQL("$1")+"))",
+		readerID(p),
+	); err != nil {
 		return 0, err
 	}
 	return count, nil
@@ -139,9 +146,9 @@ func CreateInstruments(db *sqlx.DB, instruments []Instrument) ([]IDAndSlug, erro
 	// Instrument
 	stmt1, err := txn.Preparex(
 		`INSERT INTO instrument
-			(slug, name, type_id, geometry, station, station_offset, creator, create_date, project_id, formula, nid_id, usgs_id)
+			(slug, name, type_id, geometry, station, station_offset, creator, create_date, project_id, nid_id, usgs_id)
 		 VALUES
-			 ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			 ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		 RETURNING id, slug`,
 	)
 	if err != nil {
@@ -165,7 +172,7 @@ func CreateInstruments(db *sqlx.DB, instruments []Instrument) ([]IDAndSlug, erro
 		if err := stmt1.Get(
 			&ii[idx],
 			i.Slug, i.Name, i.TypeID, wkt.MarshalString(i.Geometry.Geometry()),
-			i.Station, i.StationOffset, i.Creator, i.CreateDate, i.ProjectID, i.Formula, i.NIDID, i.USGSID,
+			i.Station, i.StationOffset, i.Creator, i.CreateDate, i.ProjectID, i.NIDID, i.USGSID,
 		); err != nil {
 			return make([]IDAndSlug, 0), err
 		}
@@ -246,9 +253,8 @@ func UpdateInstrument(db *sqlx.DB, i *Instrument) (*Instrument, error) {
 				project_id = $8,
 				station = $9,
 				station_offset = $10,
-				formula = $11,
-				nid_id = $12,
-				usgs_id = $13
+				nid_id = $11,
+				usgs_id = $12
 		 WHERE project_id = $1 AND id = $2
 		 RETURNING id`,
 	)
@@ -256,7 +262,7 @@ func UpdateInstrument(db *sqlx.DB, i *Instrument) (*Instrument, error) {
 	var updatedID uuid.UUID
 	if err := stmt1.QueryRow(
 		i.ProjectID, i.ID, i.Name, i.TypeID, wkb.Value(i.Geometry.Geometry()),
-		i.Updater, i.UpdateDate, i.ProjectID, i.Station, i.StationOffset, i.Formula, i.NIDID, i.USGSID,
+		i.Updater, i.UpdateDate, i.ProjectID, i.Station, i.StationOffset, i.NIDID, i.USGSID,
 	).Scan(&updatedID); err != nil {
 		return nil, err
 	}
@@ -319,7 +325,7 @@ func InstrumentsFactory(rows *sqlx.Rows) ([]Instrument, error) {
 		err := rows.Scan(
 			&i.ID, &i.Deleted, &i.StatusID, &i.Status, &i.StatusTime, &i.Slug, &i.Name, &i.TypeID, &i.Type, wkb.Scanner(&p), &i.Station, &i.StationOffset,
 			&i.Creator, &i.CreateDate, &i.Updater, &i.UpdateDate, &i.ProjectID, pq.Array(&i.Constants), pq.Array(&i.Groups), pq.Array(&i.AlertConfigs),
-			&i.Formula, &i.NIDID, &i.USGSID,
+			pq.Array(&i.Formulas), &i.NIDID, &i.USGSID,
 		)
 		if err != nil {
 			return make([]Instrument, 0), err
@@ -336,6 +342,25 @@ func InstrumentsFactory(rows *sqlx.Rows) ([]Instrument, error) {
 // ListInstrumentsSQL is the base SQL to retrieve all instrumentsJSON
 var listInstrumentsSQL = `SELECT id, deleted, status_id, status, status_time, slug,
 	name, type_id, type, geometry, station, station_offset, creator, create_date,
-	updater, update_date, project_id, constants, groups, alert_configs, formula, nid_id,
+	updater, update_date, project_id, constants, groups, alert_configs, formulas, nid_id,
 	usgs_id FROM v_instrument
 	`
+
+// ListReadableInstrumentIDs returns the instruments in ids that are in projects a profile can read
+func ListReadableInstrumentIDs(db *sqlx.DB, p *Profile, ids []uuid.UUID) ([]uuid.UUID, error) {
+	rr := make([]uuid.UUID, 0)
+	if len(ids) == 0 {
+		return rr, nil
+	}
+	query, args, err := sqlx.In(
+		"SELECT id FROM instrument WHERE id IN (?) AND (project_id IS NULL OR project_id IN ("+ReadableProjectsSQL("?")+"))",
+		ids, readerID(p), readerID(p),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Select(&rr, db.Rebind(query), args...); err != nil {
+		return nil, err
+	}
+	return rr, nil
+}
@@ -1,12 +1,22 @@
 package models
 
 import (
+	"time"
+
 	ts "github.com/USACE/instrumentation-api/timeseries"
 	"github.com/google/uuid"
 
 	"github.com/jmoiron/sqlx"
 )
 
+// InstrumentConstantValue is a single effective-dated value of an instrument constant
+// EffectiveUntil is nil for the value currently in effect
+type InstrumentConstantValue struct {
+	EffectiveFrom  time.Time  `json:"effective_from" db:"effective_from"`
+	EffectiveUntil *time.Time `json:"effective_until" db:"effective_until"`
+	Value          float64    `json:"value"`
+}
+
 // ListInstrumentConstants lists constants for a given instrument id
 func ListInstrumentConstants(db *sqlx.DB, id *uuid.UUID) ([]ts.Timeseries, error) {
 	// ListInstrumentTimeseries returns an array of timeseries for an instrument
@@ -23,6 +33,26 @@ func ListInstrumentConstants(db *sqlx.DB, id *uuid.UUID) ([]ts.Timeseries, error
 	return tt, nil
 }
 
+// ListInstrumentConstantHistory lists the values of an instrument constant in the order they took effect
+func ListInstrumentConstantHistory(db *sqlx.DB, instrumentID *uuid.UUID, timeseriesID *uuid.UUID) ([]InstrumentConstantValue, error) {
+	vv := make([]InstrumentConstantValue, 0)
+	if err := db.Select(&vv,
+		`SELECT time AS effective_from,
+		        LEAD(time) OVER (ORDER BY time) AS effective_until,
+		        value
+		 FROM timeseries_measurement
+		 WHERE timeseries_id IN (
+			SELECT timeseries_id
+			FROM instrument_constants
+			WHERE instrument_id = $1 AND timeseries_id = $2
+		 )
+		 ORDER BY time`, instrumentID, timeseriesID,
+	); err != nil {
+		return make([]InstrumentConstantValue, 0), err
+	}
+	return vv, nil
+}
+
 // CreateInstrumentConstants creates many instrument constants from an array of instrument constants
 // An InstrumentConstant is structurally the same as a timeseries and saved in the same tables
 func CreateInstrumentConstants(db *sqlx.DB, tt []ts.Timeseries) ([]ts.Timeseries, error) {
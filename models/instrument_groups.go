@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -69,12 +70,13 @@ func ListInstrumentGroupSlugs(db *sqlx.DB) ([]string, error) {
 	return ss, nil
 }
 
-// ListInstrumentGroups returns a list of instrument groups
-func ListInstrumentGroups(db *sqlx.DB) ([]InstrumentGroup, error) {
+// ListInstrumentGroups returns a list of instrument groups in projects a profile can read
+func ListInstrumentGroups(db *sqlx.DB, p *Profile) ([]InstrumentGroup, error) {
 
 	gg := make([]InstrumentGroup, 0)
 	if err := db.Select(
-		&gg, listInstrumentGroupsSQL+" WHERE NOT deleted",
+		&gg, listInstrumentGroupsSQL+" WHERE NOT deleted AND (project_id IS NULL OR project_id IN ("+ReadableProjectsSQL("$1")+"))",
+		readerID(p),
 	); err != nil {
 		return make([]InstrumentGroup, 0), err
 	}
@@ -176,6 +178,27 @@ func ListInstrumentGroupInstruments(db *sqlx.DB, ID uuid.UUID) ([]Instrument, er
 	return InstrumentsFactory(rows)
 }
 
+// ValidateInstrumentGroupInstrument checks that an instrument belongs to the same project as the instrument group
+func ValidateInstrumentGroupInstrument(db *sqlx.DB, instrumentGroupID uuid.UUID, instrumentID uuid.UUID) error {
+
+	var ok bool
+	if err := db.Get(
+		&ok,
+		`SELECT EXISTS (
+			SELECT 1 FROM instrument i
+			INNER JOIN instrument_group g ON g.project_id = i.project_id
+			WHERE g.id = $1 AND i.id = $2 AND NOT i.deleted
+		)`,
+		instrumentGroupID, instrumentID,
+	); err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("instrument must belong to the project of the instrument group")
+	}
+	return nil
+}
+
 // CreateInstrumentGroupInstruments adds an instrument to an instrument group
 func CreateInstrumentGroupInstruments(db *sqlx.DB, instrumentGroupID uuid.UUID, instrumentID uuid.UUID) error {
 
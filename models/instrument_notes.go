@@ -44,11 +44,17 @@ func (c *InstrumentNoteCollection) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// ListInstrumentNotes returns an array of instruments from the database
-func ListInstrumentNotes(db *sqlx.DB) ([]InstrumentNote, error) {
+// ListInstrumentNotes returns an array of instrument notes from the database in projects a profile can read
+func ListInstrumentNotes(db *sqlx.DB, p *Profile) ([]InstrumentNote, error) {
 
 	nn := make([]InstrumentNote, 0)
-	if err := db.Select(&nn, listInstrumentNotesSQL()); err != nil {
+	if err := db.Select(
+		&nn,
+		listInstrumentNotesSQL()+` WHERE N.instrument_id IN (
+			SELECT id FROM instrument WHERE project_id IS NULL OR project_id IN (`+ReadableProjectsSQL("$1")+`)
+		)`,
+		readerID(p),
+	); err != nil {
 		return make([]InstrumentNote, 0), err
 	}
 	return nn, nil
@@ -34,9 +34,9 @@ func (n *Instrument) AsSite() Site {
 
 // ListOpendcsSites returns an array of instruments from the database
 // And formats them as OpenDCS Sites
-func ListOpendcsSites(db *sqlx.DB) ([]Site, error) {
+func ListOpendcsSites(db *sqlx.DB, p *Profile) ([]Site, error) {
 
-	nn, err := ListInstruments(db)
+	nn, err := ListInstruments(db, p)
 	if err != nil {
 		return make([]Site, 0), err
 	}
@@ -2,6 +2,8 @@ package models
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/USACE/instrumentation-api/passwords"
@@ -17,23 +19,84 @@ type Profile struct {
 	Tokens  []TokenInfoProfile `json:"tokens"`
 	IsAdmin bool               `json:"is_admin" db:"is_admin"`
 	Roles   []string           `json:"roles"`
+	// IsServiceAccount is true for profiles of machine integrations; see ServiceAccount
+	IsServiceAccount bool `json:"is_service_account" db:"is_service_account"`
 }
 
 func (p *Profile) attachTokens(db *sqlx.DB) error {
-
-	if err := db.Select(&p.Tokens, "SELECT token_id, issued FROM profile_token WHERE profile_id=$1", p.ID); err != nil {
+	tt, err := listProfileTokens(db, &p.ID)
+	if err != nil {
 		return err
 	}
+	p.Tokens = append(p.Tokens, tt...)
 	return nil
 }
 
+// listProfileTokens lists the tokens of a profile, oldest first
+func listProfileTokens(db *sqlx.DB, profileID *uuid.UUID) ([]TokenInfoProfile, error) {
+	rows, err := db.Queryx(
+		`SELECT token_id, issued, name, description, expires, scopes, project_ids, last_used, rate_limit_read, rate_limit_write
+		 FROM profile_token WHERE profile_id=$1 ORDER BY issued`, profileID,
+	)
+	if err != nil {
+		return make([]TokenInfoProfile, 0), err
+	}
+	defer rows.Close()
+	tt := make([]TokenInfoProfile, 0)
+	for rows.Next() {
+		var t TokenInfoProfile
+		if err := rows.Scan(
+			&t.TokenID, &t.Issued, &t.Name, &t.Description, &t.Expires, pq.Array(&t.Scopes), pq.Array(&t.ProjectIDs), &t.LastUsed,
+			&t.RateLimitRead, &t.RateLimitWrite,
+		); err != nil {
+			return make([]TokenInfoProfile, 0), err
+		}
+		tt = append(tt, t)
+	}
+	return tt, nil
+}
+
+// Token scopes; a token is only allowed requests within its scopes, and never more than its profile is allowed
+const (
+	// TokenScopeRead allows GET requests
+	TokenScopeRead = "read"
+	// TokenScopeWriteMeasurements allows posting timeseries measurements
+	TokenScopeWriteMeasurements = "write-measurements"
+	// TokenScopeAdmin allows every request the profile is allowed
+	TokenScopeAdmin = "admin"
+)
+
+var tokenScopes = []string{TokenScopeRead, TokenScopeWriteMeasurements, TokenScopeAdmin}
+
+// TokenSettings are the settings of a token chosen when it is created
+type TokenSettings struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+	// Expires is the time the token stops working; tokens without expires never expire
+	Expires *time.Time `json:"expires"`
+	Scopes  []string   `json:"scopes"`
+	// ProjectIDs restrict the token to projects; tokens without project_ids are not restricted
+	ProjectIDs []uuid.UUID `json:"project_ids" db:"project_ids"`
+}
+
+// TokenRateLimit is the number of read and write requests allowed for a token each rate limit window
+// Rate limits are set by application admins; tokens without rate limits have the default limits
+type TokenRateLimit struct {
+	RateLimitRead  *int `json:"rate_limit_read" db:"rate_limit_read"`
+	RateLimitWrite *int `json:"rate_limit_write" db:"rate_limit_write"`
+}
+
 // TokenInfoProfile is token information embedded in Profile
 type TokenInfoProfile struct {
 	TokenID string    `json:"token_id" db:"token_id"`
 	Issued  time.Time `json:"issued"`
+	TokenSettings
+	LastUsed *time.Time `json:"last_used" db:"last_used"`
+	TokenRateLimit
 }
 
 // ProfileInfo is information necessary to construct a profile
+// EDIPI is 0 for service accounts, which are not tied to a CAC
 type ProfileInfo struct {
 	EDIPI    int    `json:"-"`
 	Username string `json:"username"`
@@ -47,6 +110,37 @@ type TokenInfo struct {
 	ProfileID uuid.UUID `json:"profile_id" db:"profile_id"`
 	Issued    time.Time `json:"issued"`
 	Hash      string    `json:"-"`
+	TokenSettings
+	LastUsed *time.Time `json:"last_used" db:"last_used"`
+	TokenRateLimit
+}
+
+// Expired returns true if a token has expired
+func (n *TokenInfo) Expired() bool {
+	return n.Expires != nil && !time.Now().Before(*n.Expires)
+}
+
+// HasScope returns true if a token was granted a scope; the admin scope includes every scope
+func (n *TokenInfo) HasScope(scope string) bool {
+	for _, s := range n.Scopes {
+		if s == scope || s == TokenScopeAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsProject returns true if a token is not restricted to projects or is restricted to projects including projectID
+func (n *TokenInfo) AllowsProject(projectID uuid.UUID) bool {
+	if len(n.ProjectIDs) == 0 {
+		return true
+	}
+	for _, id := range n.ProjectIDs {
+		if id == projectID {
+			return true
+		}
+	}
+	return false
 }
 
 // Token includes all TokenInfo and the actual token string generated for a user
@@ -65,7 +159,7 @@ func ProfilesFactory(rows *sqlx.Rows) ([]Profile, error) {
 		p.Tokens = make([]TokenInfoProfile, 0)
 		p.Roles = make([]string, 0)
 		err := rows.Scan(
-			&p.ID, &p.EDIPI, &p.Username, &p.Email, &p.IsAdmin, pq.Array(&p.Roles),
+			&p.ID, &p.EDIPI, &p.Username, &p.Email, &p.IsAdmin, pq.Array(&p.Roles), &p.IsServiceAccount,
 		)
 		if err != nil {
 			return make([]Profile, 0), err
@@ -81,7 +175,7 @@ func ProfilesFactory(rows *sqlx.Rows) ([]Profile, error) {
 func GetProfileFromEDIPI(db *sqlx.DB, e int) (*Profile, error) {
 	// Would prefer to do this in one query using a join and postgres json/array aggregation
 	// for now it's implemented with two queries
-	rows, err := db.Queryx("SELECT id, edipi, username, email, is_admin, roles FROM v_profile WHERE edipi=$1", e)
+	rows, err := db.Queryx("SELECT id, edipi, username, email, is_admin, roles, is_service_account FROM v_profile WHERE edipi=$1", e)
 	if err != nil {
 		return nil, err
 	}
@@ -101,9 +195,9 @@ func GetProfileFromEDIPI(db *sqlx.DB, e int) (*Profile, error) {
 // GetProfileFromTokenID returns a profile given a token ID
 func GetProfileFromTokenID(db *sqlx.DB, tokenID string) (*Profile, error) {
 	rows, err := db.Queryx(
-		`SELECT p.id, p.edipi, p.username, p.email, p.is_admin
+		`SELECT p.id, COALESCE(p.edipi, 0), p.username, p.email, p.is_admin, p.roles, p.is_service_account
 		 FROM profile_token t
-		 LEFT JOIN v_profile p ON p.id = t.profile_id
+		 INNER JOIN v_profile p ON p.id = t.profile_id
 		 WHERE t.token_id=$1`, tokenID,
 	)
 	if err != nil {
@@ -113,6 +207,9 @@ func GetProfileFromTokenID(db *sqlx.DB, tokenID string) (*Profile, error) {
 	if err != nil {
 		return nil, err
 	}
+	if len(pp) == 0 {
+		return nil, errors.New("Profile Does Not Exist for Token")
+	}
 	if err := pp[0].attachTokens(db); err != nil {
 		return nil, err
 	}
@@ -132,10 +229,64 @@ func CreateProfile(db *sqlx.DB, n *ProfileInfo) (*Profile, error) {
 	return &p, nil
 }
 
+// ValidateTokenSettings checks the settings of a new token. Tokens without scopes are granted the admin scope,
+// the full rights of the profile
+func ValidateTokenSettings(db *sqlx.DB, s *TokenSettings) error {
+	if s.Name != nil && len(*s.Name) > 120 {
+		return fmt.Errorf("name must be 120 characters or less")
+	}
+	if s.Expires != nil && !s.Expires.After(time.Now()) {
+		return fmt.Errorf("expires must be in the future")
+	}
+	if len(s.Scopes) == 0 {
+		s.Scopes = []string{TokenScopeAdmin}
+	}
+	scopes := make([]string, 0, len(s.Scopes))
+	seen := make(map[string]bool)
+	for _, scope := range s.Scopes {
+		known := false
+		for _, k := range tokenScopes {
+			if scope == k {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown scope '%s'; scopes are '%s'", scope, strings.Join(tokenScopes, "', '"))
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+	s.Scopes = scopes
+	if s.ProjectIDs == nil {
+		s.ProjectIDs = make([]uuid.UUID, 0)
+	}
+	if len(s.ProjectIDs) > 0 {
+		query, args, err := sqlx.In("SELECT COUNT(DISTINCT id) FROM project WHERE id IN (?) AND NOT deleted", s.ProjectIDs)
+		if err != nil {
+			return err
+		}
+		ids := make(map[uuid.UUID]bool)
+		for _, id := range s.ProjectIDs {
+			ids[id] = true
+		}
+		var count int
+		if err := db.Get(&count, db.Rebind(query), args...); err != nil {
+			return err
+		}
+		if count != len(ids) {
+			return fmt.Errorf("project_ids must be projects that exist")
+		}
+	}
+	return nil
+}
+
 // CreateProfileToken creates a secret token and stores the HASH (not the actual token)
 // to the database. The return payload of this function is the first and last time you'll see
 // the raw token unless the user writes it down or stores it somewhere safe.
-func CreateProfileToken(db *sqlx.DB, profileID *uuid.UUID) (*Token, error) {
+func CreateProfileToken(db *sqlx.DB, profileID *uuid.UUID, s *TokenSettings) (*Token, error) {
 	secretToken := passwords.GenerateRandom(40)
 	tokenID := passwords.GenerateRandom(40)
 
@@ -144,9 +295,15 @@ func CreateProfileToken(db *sqlx.DB, profileID *uuid.UUID) (*Token, error) {
 		return nil, err
 	}
 	var t Token
-	if err := db.Get(
-		&t, "INSERT INTO profile_token (token_id, profile_id, hash) VALUES ($1,$2,$3) RETURNING *",
-		tokenID, profileID, hash,
+	if err := db.QueryRowx(
+		`INSERT INTO profile_token (token_id, profile_id, hash, name, description, expires, scopes, project_ids)
+		 VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
+		 RETURNING id, token_id, profile_id, issued, hash, name, description, expires, scopes, project_ids, last_used,
+		           rate_limit_read, rate_limit_write`,
+		tokenID, profileID, hash, s.Name, s.Description, s.Expires, pq.Array(s.Scopes), pq.Array(s.ProjectIDs),
+	).Scan(
+		&t.ID, &t.TokenID, &t.ProfileID, &t.Issued, &t.Hash, &t.Name, &t.Description, &t.Expires,
+		pq.Array(&t.Scopes), pq.Array(&t.ProjectIDs), &t.LastUsed, &t.RateLimitRead, &t.RateLimitWrite,
 	); err != nil {
 		return nil, err
 	}
@@ -157,14 +314,47 @@ func CreateProfileToken(db *sqlx.DB, profileID *uuid.UUID) (*Token, error) {
 // GetTokenInfoByTokenID returns a single token by token id
 func GetTokenInfoByTokenID(db *sqlx.DB, tokenID *string) (*TokenInfo, error) {
 	var n TokenInfo
-	if err := db.Get(
-		&n, "SELECT id, token_id, profile_id, issued, hash FROM profile_token WHERE token_id=$1 LIMIT 1", tokenID,
+	if err := db.QueryRowx(
+		`SELECT id, token_id, profile_id, issued, hash, name, description, expires, scopes, project_ids, last_used,
+		        rate_limit_read, rate_limit_write
+		 FROM profile_token WHERE token_id=$1 LIMIT 1`, tokenID,
+	).Scan(
+		&n.ID, &n.TokenID, &n.ProfileID, &n.Issued, &n.Hash, &n.Name, &n.Description, &n.Expires,
+		pq.Array(&n.Scopes), pq.Array(&n.ProjectIDs), &n.LastUsed, &n.RateLimitRead, &n.RateLimitWrite,
 	); err != nil {
 		return nil, err
 	}
 	return &n, nil
 }
 
+// ValidateTokenRateLimit checks that token rate limits are positive; unset limits are the default limits
+func ValidateTokenRateLimit(r *TokenRateLimit) error {
+	if (r.RateLimitRead != nil && *r.RateLimitRead < 1) || (r.RateLimitWrite != nil && *r.RateLimitWrite < 1) {
+		return errors.New("rate_limit_read and rate_limit_write must be positive, or null for the default limits")
+	}
+	return nil
+}
+
+// UpdateTokenRateLimit sets the rate limits of a token
+func UpdateTokenRateLimit(db *sqlx.DB, tokenID *string, r *TokenRateLimit) (*TokenInfo, error) {
+	if _, err := db.Exec(
+		`UPDATE profile_token SET rate_limit_read = $2, rate_limit_write = $3 WHERE token_id = $1`,
+		tokenID, r.RateLimitRead, r.RateLimitWrite,
+	); err != nil {
+		return nil, err
+	}
+	return GetTokenInfoByTokenID(db, tokenID)
+}
+
+// UpdateTokenLastUsed records the time a token was used; at most once a minute to limit writes
+func UpdateTokenLastUsed(db *sqlx.DB, id *uuid.UUID) error {
+	_, err := db.Exec(
+		`UPDATE profile_token SET last_used = now()
+		 WHERE id = $1 AND (last_used IS NULL OR last_used < now() - INTERVAL '1 minute')`, id,
+	)
+	return err
+}
+
 // DeleteToken deletes a token by token_id
 func DeleteToken(db *sqlx.DB, profileID *uuid.UUID, tokenID *string) error {
 	sql := "DELETE FROM profile_token WHERE profile_id=$1 AND token_id=$2"
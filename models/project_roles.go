@@ -1,10 +1,70 @@
 package models
 
 import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"strings"
+
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Project permissions; each role grants a set of permissions in the projects it is granted in
+const (
+	PermissionProjectRead            = "project.read"
+	PermissionProjectWrite           = "project.write"
+	PermissionProjectMembers         = "project.members"
+	PermissionInstrumentWrite        = "instrument.write"
+	PermissionTimeseriesWrite        = "timeseries.write"
+	PermissionMeasurementWrite       = "measurement.write"
+	PermissionNoteWrite              = "note.write"
+	PermissionAlertConfigWrite       = "alert_config.write"
+	PermissionAlertAcknowledge       = "alert.acknowledge"
+	PermissionAlertNotificationWrite = "alert_notification.write"
+	PermissionCollectionGroupWrite   = "collection_group.write"
+	PermissionPlotConfigurationWrite = "plot_configuration.write"
+	PermissionAuditRead              = "audit.read"
 )
 
+// Permission is an action a role allows in a project
+type Permission struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ProjectRole is a role that can be granted to members of a project and the permissions it grants
+// Built-in roles have no project_id and can be granted in every project
+type ProjectRole struct {
+	ID          uuid.UUID  `json:"id"`
+	ProjectID   *uuid.UUID `json:"project_id"`
+	Name        string     `json:"name"`
+	Permissions []string   `json:"permissions"`
+}
+
+// projectRoleNamePattern matches role names; roles are granted as <PROJECT_SLUG>.<ROLE NAME>
+var projectRoleNamePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,59}$`)
+
+const listProjectRolesSQL = `SELECT r.id, r.project_id, r.name,
+       COALESCE(array_agg(rp.permission ORDER BY rp.permission) FILTER (WHERE rp.permission IS NOT NULL), '{}')
+FROM role r
+LEFT JOIN role_permission rp ON rp.role_id = r.id`
+
+// ProjectRolesFactory converts database rows to project roles
+func ProjectRolesFactory(rows *sqlx.Rows) ([]ProjectRole, error) {
+	defer rows.Close()
+	rr := make([]ProjectRole, 0)
+	var r ProjectRole
+	for rows.Next() {
+		if err := rows.Scan(&r.ID, &r.ProjectID, &r.Name, pq.Array(&r.Permissions)); err != nil {
+			return make([]ProjectRole, 0), err
+		}
+		rr = append(rr, r)
+	}
+	return rr, nil
+}
+
 // ProjectMembership holds
 type ProjectMembership struct {
 	ID        uuid.UUID `json:"id" db:"id"`
@@ -13,6 +73,8 @@ type ProjectMembership struct {
 	Email     string    `json:"email"`
 	RoleID    uuid.UUID `json:"role_id" db:"role_id"`
 	Role      string    `json:"role"`
+	// IsServiceAccount is true for members that are service accounts
+	IsServiceAccount bool `json:"is_service_account" db:"is_service_account"`
 }
 
 // ListProjectMembers lists users (profiles) who have permissions on a project and their role info
@@ -21,7 +83,7 @@ func ListProjectMembers(db *sqlx.DB, projectID *uuid.UUID) ([]ProjectMembership,
 	rr := make([]ProjectMembership, 0)
 	if err := db.Select(
 		&rr,
-		`SELECT id, profile_id, username, email, role_id, role
+		`SELECT id, profile_id, username, email, role_id, role, is_service_account
 		 FROM v_profile_project_roles
 		 WHERE project_id = $1
 		 ORDER BY email`,
@@ -33,6 +95,7 @@ func ListProjectMembers(db *sqlx.DB, projectID *uuid.UUID) ([]ProjectMembership,
 }
 
 // AddProjectMemberRole adds a role to a user for a specific project
+// Returns sql.ErrNoRows if the profile does not exist or is a deleted service account
 func AddProjectMemberRole(db *sqlx.DB, projectID, profileID, roleID, grantedBy *uuid.UUID) (*ProjectMembership, error) {
 	var id uuid.UUID
 	// NOTE: DO UPDATE does not change underlying value;
@@ -41,7 +104,7 @@ func AddProjectMemberRole(db *sqlx.DB, projectID, profileID, roleID, grantedBy *
 	if err := db.Get(
 		&id,
 		`INSERT INTO profile_project_roles (project_id, profile_id, role_id, granted_by)
-	     VALUES ($1, $2, $3, $4)
+		 SELECT $1::uuid, id, $3::uuid, $4::uuid FROM profile WHERE id = $2 AND NOT deleted
 		 ON CONFLICT ON CONSTRAINT unique_profile_project_role DO UPDATE SET project_id = EXCLUDED.project_id
 		 RETURNING id`,
 		projectID, profileID, roleID, grantedBy,
@@ -52,7 +115,7 @@ func AddProjectMemberRole(db *sqlx.DB, projectID, profileID, roleID, grantedBy *
 	var pm ProjectMembership
 	if err := db.Get(
 		&pm,
-		`SELECT id, profile_id, username, email, role_id, role
+		`SELECT id, profile_id, username, email, role_id, role, is_service_account
 		 FROM v_profile_project_roles
 		 WHERE id = $1`,
 		id,
@@ -73,3 +136,195 @@ func RemoveProjectMemberRole(db *sqlx.DB, projectID, profileID, roleID *uuid.UUI
 	}
 	return nil
 }
+
+// ListPermissions lists the permissions a role can grant
+func ListPermissions(db *sqlx.DB) ([]Permission, error) {
+	pp := make([]Permission, 0)
+	if err := db.Select(&pp, "SELECT name, description FROM permission ORDER BY name"); err != nil {
+		return make([]Permission, 0), err
+	}
+	return pp, nil
+}
+
+// ListProjectRoles lists the roles that can be granted in a project; built-in roles and roles created for the project
+func ListProjectRoles(db *sqlx.DB, projectID *uuid.UUID) ([]ProjectRole, error) {
+	rows, err := db.Queryx(
+		listProjectRolesSQL+`
+		 WHERE NOT r.deleted AND (r.project_id IS NULL OR r.project_id = $1)
+		 GROUP BY r.id
+		 ORDER BY r.project_id NULLS FIRST, r.name`,
+		projectID,
+	)
+	if err != nil {
+		return make([]ProjectRole, 0), err
+	}
+	return ProjectRolesFactory(rows)
+}
+
+// GetProjectRole returns a role that can be granted in a project
+// Returns sql.ErrNoRows if the role does not exist or belongs to another project
+func GetProjectRole(db *sqlx.DB, projectID, roleID *uuid.UUID) (*ProjectRole, error) {
+	rows, err := db.Queryx(
+		listProjectRolesSQL+`
+		 WHERE NOT r.deleted AND (r.project_id IS NULL OR r.project_id = $1) AND r.id = $2
+		 GROUP BY r.id`,
+		projectID, roleID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	rr, err := ProjectRolesFactory(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(rr) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return &rr[0], nil
+}
+
+// ValidateProjectRole checks that a project role has a unique name and known permissions
+// The name is converted to upper case and duplicate permissions are removed
+func ValidateProjectRole(db *sqlx.DB, r *ProjectRole) error {
+	r.Name = strings.ToUpper(strings.TrimSpace(r.Name))
+	if !projectRoleNamePattern.MatchString(r.Name) {
+		return fmt.Errorf("name must start with a letter and contain only letters, numbers and underscores (60 characters or less)")
+	}
+	var exists bool
+	if err := db.Get(
+		&exists,
+		`SELECT EXISTS (
+		     SELECT 1 FROM role WHERE name = $1 AND (project_id IS NULL OR project_id = $2) AND id != $3
+		 )`,
+		r.Name, r.ProjectID, r.ID,
+	); err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("role '%s' already exists", r.Name)
+	}
+	if len(r.Permissions) == 0 {
+		return fmt.Errorf("permissions are required")
+	}
+	pp, err := ListPermissions(db)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]bool)
+	for _, p := range pp {
+		known[p.Name] = true
+	}
+	seen := make(map[string]bool)
+	permissions := make([]string, 0)
+	for _, p := range r.Permissions {
+		if !known[p] {
+			return fmt.Errorf("unknown permission '%s'", p)
+		}
+		if !seen[p] {
+			seen[p] = true
+			permissions = append(permissions, p)
+		}
+	}
+	r.Permissions = permissions
+	return nil
+}
+
+// setRolePermissions replaces the permissions of a role
+func setRolePermissions(txn *sqlx.Tx, roleID uuid.UUID, permissions []string) error {
+	if _, err := txn.Exec(`DELETE FROM role_permission WHERE role_id = $1`, roleID); err != nil {
+		return err
+	}
+	if _, err := txn.Exec(
+		`INSERT INTO role_permission (role_id, permission) SELECT $1, UNNEST($2::text[])`,
+		roleID, pq.Array(permissions),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CreateProjectRole creates a role for a project
+func CreateProjectRole(db *sqlx.DB, r *ProjectRole) (*ProjectRole, error) {
+	txn, err := db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	var id uuid.UUID
+	if err := txn.Get(&id, `INSERT INTO role (name, project_id) VALUES ($1, $2) RETURNING id`, r.Name, r.ProjectID); err != nil {
+		txn.Rollback()
+		return nil, err
+	}
+	if err := setRolePermissions(txn, id, r.Permissions); err != nil {
+		txn.Rollback()
+		return nil, err
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+	return GetProjectRole(db, r.ProjectID, &id)
+}
+
+// UpdateProjectRole updates the name and permissions of a role created for a project
+// Returns sql.ErrNoRows if the role does not belong to the project; built-in roles can not be updated
+func UpdateProjectRole(db *sqlx.DB, r *ProjectRole) (*ProjectRole, error) {
+	txn, err := db.Beginx()
+	if err != nil {
+		return nil, err
+	}
+	var id uuid.UUID
+	if err := txn.Get(
+		&id,
+		`UPDATE role SET name = $3 WHERE id = $1 AND project_id = $2 AND NOT deleted RETURNING id`,
+		r.ID, r.ProjectID, r.Name,
+	); err != nil {
+		txn.Rollback()
+		return nil, err
+	}
+	if err := setRolePermissions(txn, id, r.Permissions); err != nil {
+		txn.Rollback()
+		return nil, err
+	}
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+	return GetProjectRole(db, r.ProjectID, &id)
+}
+
+// DeleteProjectRole deletes a role created for a project and removes it from project members
+// Returns sql.ErrNoRows if the role does not belong to the project; built-in roles can not be deleted
+func DeleteProjectRole(db *sqlx.DB, projectID, roleID *uuid.UUID) error {
+	txn, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	if _, err := txn.Exec(
+		`DELETE FROM profile_project_roles WHERE role_id = (SELECT id FROM role WHERE id = $2 AND project_id = $1)`,
+		projectID, roleID,
+	); err != nil {
+		txn.Rollback()
+		return err
+	}
+	var id uuid.UUID
+	if err := txn.Get(&id, `DELETE FROM role WHERE id = $2 AND project_id = $1 RETURNING id`, projectID, roleID); err != nil {
+		txn.Rollback()
+		return err
+	}
+	return txn.Commit()
+}
+
+// HasProjectPermissionAll returns true if a profile is granted a permission in every one of projectIDs
+func HasProjectPermissionAll(db *sqlx.DB, profileID *uuid.UUID, projectIDs []uuid.UUID, permission string) (bool, error) {
+	var granted int
+	if err := db.Get(
+		&granted,
+		`SELECT COUNT(DISTINCT ppr.project_id)
+		 FROM   profile_project_roles ppr
+		 INNER JOIN role r ON r.id = ppr.role_id AND NOT r.deleted
+		 INNER JOIN role_permission rp ON rp.role_id = r.id
+		 WHERE  ppr.profile_id = $1 AND ppr.project_id = ANY($2) AND rp.permission = $3`,
+		profileID, pq.Array(projectIDs), permission,
+	); err != nil {
+		return false, err
+	}
+	return granted == countDistinctIDs(projectIDs), nil
+}
@@ -1,7 +1,9 @@
 package models
 
 import (
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/google/uuid"
@@ -10,9 +12,37 @@ import (
 )
 
 const listProjectsSQL = `SELECT id, federal_id, image, office_id, deleted, slug, name, creator, create_date,
-     updater, update_date, instrument_count, instrument_group_count, timeseries
+     updater, update_date, instrument_count, instrument_group_count, timeseries, visibility
 	 FROM v_project`
 
+// Project visibility; private projects are only readable by profiles with the project.read permission
+const (
+	ProjectVisibilityPublic  = "public"
+	ProjectVisibilityPrivate = "private"
+)
+
+// ReadableProjectsSQL selects the IDs of projects a profile can read; public projects, private projects the
+// profile has the project.read permission in, or every project for application admins. param is the bind
+// parameter holding the profile ID, e.g. $1
+func ReadableProjectsSQL(param string) string {
+	return `SELECT id FROM project
+	        WHERE  visibility = '` + ProjectVisibilityPublic + `'
+	               OR EXISTS (SELECT 1 FROM profile WHERE id = ` + param + ` AND is_admin)
+	        UNION
+	        SELECT ppr.project_id
+	        FROM   profile_project_roles ppr
+	        INNER JOIN role_permission rp ON rp.role_id = ppr.role_id
+	        WHERE  ppr.profile_id = ` + param + ` AND rp.permission = '` + PermissionProjectRead + `'`
+}
+
+// readerID returns the ID of the profile reading project data; uuid.Nil for anonymous requests
+func readerID(p *Profile) uuid.UUID {
+	if p == nil {
+		return uuid.Nil
+	}
+	return p.ID
+}
+
 // Project is a project data structure
 type Project struct {
 	ID                   uuid.UUID   `json:"id"`
@@ -25,6 +55,7 @@ type Project struct {
 	Timeseries           []uuid.UUID `json:"timeseries" db:"timeseries"`
 	InstrumentCount      int         `json:"instrument_count" db:"instrument_count"`
 	InstrumentGroupCount int         `json:"instrument_group_count" db:"instrument_group_count"`
+	Visibility           string      `json:"visibility"`
 	AuditInfo
 }
 
@@ -62,6 +93,7 @@ func ProjectFactory(rows *sqlx.Rows) ([]Project, error) {
 		err := rows.Scan(
 			&p.ID, &p.FederalID, &p.Image, &p.OfficeID, &p.Deleted, &p.Slug, &p.Name, &p.Creator, &p.CreateDate,
 			&p.Updater, &p.UpdateDate, &p.InstrumentCount, &p.InstrumentGroupCount, pq.Array(&p.Timeseries),
+			&p.Visibility,
 		)
 		if err != nil {
 			return make([]Project, 0), err
@@ -80,9 +112,11 @@ func ListProjectSlugs(db *sqlx.DB) ([]string, error) {
 	return ss, nil
 }
 
-// ListProjects returns a slice of projects
-func ListProjects(db *sqlx.DB) ([]Project, error) {
-	rows, err := db.Queryx(listProjectsSQL + " WHERE NOT deleted ORDER BY name")
+// ListProjects returns a slice of projects a profile can read; p is nil for anonymous requests
+func ListProjects(db *sqlx.DB, p *Profile) ([]Project, error) {
+	rows, err := db.Queryx(
+		listProjectsSQL+" WHERE NOT deleted AND id IN ("+ReadableProjectsSQL("$1")+") ORDER BY name", readerID(p),
+	)
 	if err != nil {
 		return make([]Project, 0), err
 	}
@@ -93,7 +127,8 @@ func ListMyProjects(db *sqlx.DB, profileID *uuid.UUID) ([]Project, error) {
 
 	rows, err := db.Queryx(
 		`SELECT DISTINCT p.id, p.federal_id, p.image, p.office_id, p.deleted, p.slug, p.name, p.creator, p.create_date,
-						 p.updater, p.update_date, p.instrument_count, p.instrument_group_count, p.timeseries
+						 p.updater, p.update_date, p.instrument_count, p.instrument_group_count, p.timeseries,
+						 p.visibility
 	     FROM profile_project_roles ppr
 	     INNER JOIN v_project p on p.id = ppr.project_id
 	     WHERE ppr.profile_id = $1 AND NOT p.deleted
@@ -141,10 +176,14 @@ func ListProjectInstrumentGroups(db *sqlx.DB, id uuid.UUID) ([]InstrumentGroup,
 	return gg, nil
 }
 
-// GetProjectCount returns the number of projects in the database that are not deleted
-func GetProjectCount(db *sqlx.DB) (int, error) {
+// GetProjectCount returns the number of projects in the database that are not deleted and a profile can read
+func GetProjectCount(db *sqlx.DB, p *Profile) (int, error) {
 	var count int
-	if err := db.Get(&count, "SELECT COUNT(id) FROM project WHERE NOT deleted"); err != nil {
+	if err := db.Get(
+		&count,
+		"SELECT COUNT(id) FROM project WHERE NOT deleted AND id IN ("+ReadableProjectsSQL("$1")+")",
+		readerID(p),
+	); err != nil {
 		return 0, err
 	}
 	return count, nil
@@ -160,6 +199,9 @@ func GetProject(db *sqlx.DB, id uuid.UUID) (*Project, error) {
 	if err != nil {
 		return nil, err
 	}
+	if len(pp) == 0 {
+		return nil, sql.ErrNoRows
+	}
 	return &pp[0], nil
 }
 
@@ -173,8 +215,8 @@ func CreateProjectBulk(db *sqlx.DB, projects []Project) ([]IDAndSlug, error) {
 
 	// Instrument
 	stmt1, err := txn.Preparex(
-		`INSERT INTO project (federal_id, slug, name, creator, create_date)
-		 VALUES ($1, $2, $3, $4, $5)
+		`INSERT INTO project (federal_id, slug, name, creator, create_date, visibility)
+		 VALUES ($1, $2, $3, $4, $5, $6)
 		 RETURNING id, slug`,
 	)
 	if err != nil {
@@ -184,7 +226,7 @@ func CreateProjectBulk(db *sqlx.DB, projects []Project) ([]IDAndSlug, error) {
 	pp := make([]IDAndSlug, len(projects))
 	for idx, p := range projects {
 		if err := stmt1.Get(
-			&pp[idx], p.FederalID, p.Slug, p.Name, p.Creator, p.CreateDate,
+			&pp[idx], p.FederalID, p.Slug, p.Name, p.Creator, p.CreateDate, p.Visibility,
 		); err != nil {
 			return make([]IDAndSlug, 0), err
 		}
@@ -202,8 +244,9 @@ func CreateProjectBulk(db *sqlx.DB, projects []Project) ([]IDAndSlug, error) {
 func UpdateProject(db *sqlx.DB, p *Project) (*Project, error) {
 
 	_, err := db.Exec(
-		"UPDATE project SET name=$2, updater=$3, update_date=$4, office_id=$5, federal_id=$6 WHERE id=$1 RETURNING id",
-		p.ID, p.Name, p.Updater, p.UpdateDate, p.OfficeID, p.FederalID,
+		`UPDATE project SET name=$2, updater=$3, update_date=$4, office_id=$5, federal_id=$6,
+		 visibility=COALESCE(NULLIF($7, ''), visibility) WHERE id=$1 RETURNING id`,
+		p.ID, p.Name, p.Updater, p.UpdateDate, p.OfficeID, p.FederalID, p.Visibility,
 	)
 	if err != nil {
 		return nil, err
@@ -280,3 +323,90 @@ func DeleteProjectTimeseries(db *sqlx.DB, projectID *uuid.UUID, timeseriesID *uu
 	}
 	return nil
 }
+
+// projectEntitySQL looks up the project an entity belongs to, keyed by the route parameter or
+// request body key that identifies the entity
+var projectEntitySQL = map[string]string{
+	"instrument_id":       `SELECT project_id FROM instrument WHERE id = $1`,
+	"instrument_group_id": `SELECT project_id FROM instrument_group WHERE id = $1`,
+	"timeseries_id":       `SELECT project_id FROM v_timeseries WHERE id = $1`,
+	"note_id": `SELECT i.project_id FROM instrument_note n
+	            INNER JOIN instrument i ON i.id = n.instrument_id
+	            WHERE n.id = $1`,
+	"status_id": `SELECT i.project_id FROM instrument_status s
+	              INNER JOIN instrument i ON i.id = s.instrument_id
+	              WHERE s.id = $1`,
+	"formula_id": `SELECT i.project_id FROM formula f
+	               INNER JOIN instrument i ON i.id = f.instrument_id
+	               WHERE f.id = $1`,
+	"alert_config_id": `SELECT i.project_id FROM alert_config ac
+	                    INNER JOIN instrument i ON i.id = ac.instrument_id
+	                    WHERE ac.id = $1`,
+	"alert_webhook_id":      `SELECT project_id FROM alert_webhook WHERE id = $1`,
+	"collection_group_id":   `SELECT project_id FROM collection_group WHERE id = $1`,
+	"plot_configuration_id": `SELECT project_id FROM plot_configuration WHERE id = $1`,
+}
+
+// GetEntityProjectID returns the project an entity belongs to. The entity is identified by key, the name of the
+// route parameter or request body key holding its ID, e.g. instrument_id. Returns nil if the key is not known,
+// or the entity does not exist or does not belong to a project
+func GetEntityProjectID(db *sqlx.DB, key string, id uuid.UUID) (*uuid.UUID, error) {
+	q, ok := projectEntitySQL[key]
+	if !ok {
+		return nil, nil
+	}
+	var projectID *uuid.UUID
+	if err := db.Get(&projectID, q, id); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return projectID, nil
+}
+
+// ValidateProjectVisibility checks that a project visibility is valid; empty visibility is allowed and
+// means public for new projects and unchanged for updates
+func ValidateProjectVisibility(visibility string) error {
+	if visibility == "" || visibility == ProjectVisibilityPublic || visibility == ProjectVisibilityPrivate {
+		return nil
+	}
+	return fmt.Errorf("visibility must be '%s' or '%s'", ProjectVisibilityPublic, ProjectVisibilityPrivate)
+}
+
+// CanReadProjects returns true if a profile can read every one of projectIDs; p is nil for anonymous requests
+func CanReadProjects(db *sqlx.DB, p *Profile, projectIDs []uuid.UUID) (bool, error) {
+	if len(projectIDs) == 0 {
+		return true, nil
+	}
+	var readable int
+	if err := db.Get(
+		&readable,
+		"SELECT COUNT(DISTINCT r.id) FROM ("+ReadableProjectsSQL("$1")+") r WHERE r.id = ANY($2)",
+		readerID(p), pq.Array(projectIDs),
+	); err != nil {
+		return false, err
+	}
+	return readable == countDistinctIDs(projectIDs), nil
+}
+
+// countDistinctIDs returns the number of distinct IDs in a list
+func countDistinctIDs(ids []uuid.UUID) int {
+	m := make(map[uuid.UUID]bool)
+	for _, id := range ids {
+		m[id] = true
+	}
+	return len(m)
+}
+
+// GetProjectIDFromSlug returns the ID of the project with a slug, or nil if no project has the slug
+func GetProjectIDFromSlug(db *sqlx.DB, slug string) (*uuid.UUID, error) {
+	var id uuid.UUID
+	if err := db.Get(&id, "SELECT id FROM project WHERE slug = $1", slug); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &id, nil
+}
@@ -12,11 +12,13 @@ type SearchResult struct {
 	Item interface{} `json:"item,omitempty"`
 }
 
-// ProjectSearch returns search result for projects
-func ProjectSearch(db *sqlx.DB, str *string, limit *int) ([]SearchResult, error) {
+// ProjectSearch returns search result for projects a profile can read
+func ProjectSearch(db *sqlx.DB, p *Profile, str *string, limit *int) ([]SearchResult, error) {
 
 	rows, err := db.Queryx(
-		listProjectsSQL+` WHERE NOT deleted AND name ILIKE '%' || $1 || '%' LIMIT $2 ORDER BY name`, str, limit,
+		listProjectsSQL+` WHERE NOT deleted AND name ILIKE '%' || $1 || '%' AND id IN (`+ReadableProjectsSQL("$3")+`)
+		 ORDER BY name LIMIT $2`,
+		str, limit, readerID(p),
 	)
 	if err != nil {
 		return make([]SearchResult, 0), err
@@ -0,0 +1,147 @@
+package models
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ServiceAccount is a profile for a machine integration. Service accounts are created by application admins,
+// are not tied to a CAC (EDIPI), and authenticate with their own tokens; they are granted project roles like
+// any other profile, and their actions are attributed to the service account
+type ServiceAccount struct {
+	ID          uuid.UUID          `json:"id"`
+	Username    string             `json:"username"`
+	Email       string             `json:"email"`
+	Description *string            `json:"description"`
+	Roles       []string           `json:"roles"`
+	Tokens      []TokenInfoProfile `json:"tokens"`
+	Creator     *uuid.UUID         `json:"creator"`
+	CreateDate  time.Time          `json:"create_date" db:"create_date"`
+}
+
+const listServiceAccountsSQL = `SELECT p.id, p.username, p.email, p.description, v.roles, p.creator, p.create_date
+FROM profile p
+INNER JOIN v_profile v ON v.id = p.id
+WHERE p.is_service_account AND NOT p.deleted`
+
+// ServiceAccountsFactory converts database rows to ServiceAccount objects
+func ServiceAccountsFactory(rows *sqlx.Rows) ([]ServiceAccount, error) {
+	defer rows.Close()
+	aa := make([]ServiceAccount, 0)
+	for rows.Next() {
+		var a ServiceAccount
+		a.Tokens = make([]TokenInfoProfile, 0)
+		if err := rows.Scan(
+			&a.ID, &a.Username, &a.Email, &a.Description, pq.Array(&a.Roles), &a.Creator, &a.CreateDate,
+		); err != nil {
+			return make([]ServiceAccount, 0), err
+		}
+		aa = append(aa, a)
+	}
+	return aa, nil
+}
+
+// ValidateServiceAccount checks the username and email of a new service account; both are unique among all profiles
+func ValidateServiceAccount(db *sqlx.DB, a *ServiceAccount) error {
+	a.Username, a.Email = strings.TrimSpace(a.Username), strings.TrimSpace(a.Email)
+	if a.Username == "" || len(a.Username) > 240 {
+		return errors.New("username is required and must be 240 characters or less")
+	}
+	if a.Email == "" || len(a.Email) > 240 {
+		return errors.New("email is required and must be 240 characters or less")
+	}
+	var exists bool
+	if err := db.Get(
+		&exists, "SELECT EXISTS (SELECT 1 FROM profile WHERE username = $1 OR email = $2)", a.Username, a.Email,
+	); err != nil {
+		return err
+	}
+	if exists {
+		return errors.New("a profile with this username or email already exists")
+	}
+	return nil
+}
+
+// CreateServiceAccount creates a service account
+func CreateServiceAccount(db *sqlx.DB, a *ServiceAccount) (*ServiceAccount, error) {
+	var id uuid.UUID
+	if err := db.Get(
+		&id,
+		`INSERT INTO profile (username, email, is_service_account, description, creator)
+		 VALUES ($1, $2, true, $3, $4)
+		 RETURNING id`,
+		a.Username, a.Email, a.Description, a.Creator,
+	); err != nil {
+		return nil, err
+	}
+	return GetServiceAccount(db, &id)
+}
+
+// ListServiceAccounts lists service accounts and their tokens
+func ListServiceAccounts(db *sqlx.DB) ([]ServiceAccount, error) {
+	rows, err := db.Queryx(listServiceAccountsSQL + " ORDER BY p.username")
+	if err != nil {
+		return make([]ServiceAccount, 0), err
+	}
+	aa, err := ServiceAccountsFactory(rows)
+	if err != nil {
+		return make([]ServiceAccount, 0), err
+	}
+	for idx := range aa {
+		if aa[idx].Tokens, err = listProfileTokens(db, &aa[idx].ID); err != nil {
+			return make([]ServiceAccount, 0), err
+		}
+	}
+	return aa, nil
+}
+
+// DeleteServiceAccount deletes a service account: its tokens and project roles are deleted, and the profile is
+// marked deleted so its actions stay attributed to it. Returns sql.ErrNoRows if the profile is not a service account
+func DeleteServiceAccount(db *sqlx.DB, id *uuid.UUID) error {
+	txn, err := db.Beginx()
+	if err != nil {
+		return err
+	}
+	var deletedID uuid.UUID
+	if err := txn.Get(
+		&deletedID,
+		`UPDATE profile SET deleted = true WHERE id = $1 AND is_service_account AND NOT deleted RETURNING id`, id,
+	); err != nil {
+		txn.Rollback()
+		return err
+	}
+	if _, err := txn.Exec(`DELETE FROM profile_token WHERE profile_id = $1`, id); err != nil {
+		txn.Rollback()
+		return err
+	}
+	if _, err := txn.Exec(`DELETE FROM profile_project_roles WHERE profile_id = $1`, id); err != nil {
+		txn.Rollback()
+		return err
+	}
+	return txn.Commit()
+}
+
+// GetServiceAccount returns a service account and its tokens; sql.ErrNoRows if the profile is not a service account
+func GetServiceAccount(db *sqlx.DB, id *uuid.UUID) (*ServiceAccount, error) {
+	rows, err := db.Queryx(listServiceAccountsSQL+" AND p.id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	aa, err := ServiceAccountsFactory(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(aa) == 0 {
+		return nil, sql.ErrNoRows
+	}
+	if aa[0].Tokens, err = listProfileTokens(db, id); err != nil {
+		return nil, err
+	}
+	return &aa[0], nil
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"fmt"
 
 	ts "github.com/USACE/instrumentation-api/timeseries"
 
@@ -85,11 +86,13 @@ func ListTimeseriesSlugsForInstrument(db *sqlx.DB, id *uuid.UUID) ([]string, err
 	return ss, nil
 }
 
-// ListTimeseries lists all timeseries
-func ListTimeseries(db *sqlx.DB) ([]ts.Timeseries, error) {
+// ListTimeseries lists all timeseries in projects a profile can read
+func ListTimeseries(db *sqlx.DB, p *Profile) ([]ts.Timeseries, error) {
 
 	tt := make([]ts.Timeseries, 0)
-	if err := db.Select(&tt, listTimeseriesSQL); err != nil {
+	if err := db.Select(
+		&tt, listTimeseriesSQL+" WHERE project_id IS NULL OR project_id IN ("+ReadableProjectsSQL("$1")+")", readerID(p),
+	); err != nil {
 		return make([]ts.Timeseries, 0), err
 	}
 	return tt, nil
@@ -140,7 +143,25 @@ func GetTimeseries(db *sqlx.DB, id *uuid.UUID) (*ts.Timeseries, error) {
 	return &t, nil
 }
 
+// TimeseriesBelongsToInstrument returns true if a stored or computed timeseries belongs to an instrument
+func TimeseriesBelongsToInstrument(db *sqlx.DB, timeseriesID, instrumentID *uuid.UUID) (bool, error) {
+	var belongs bool
+	if err := db.Get(
+		&belongs,
+		"SELECT EXISTS (SELECT 1 FROM v_timeseries WHERE id = $1 AND instrument_id = $2)",
+		timeseriesID, instrumentID,
+	); err != nil {
+		return false, err
+	}
+	return belongs, nil
+}
+
+// formulaSlugExistsSQL checks if a formula of an instrument has a slug; formula slugs share a namespace with the
+// instrument's timeseries because both are referenced as variables in the form [instrument.slug]
+const formulaSlugExistsSQL = `SELECT EXISTS (SELECT 1 FROM formula WHERE instrument_id = $1 AND slug = $2)`
+
 // CreateTimeseries creates many timeseries from an array of timeseries
+// Timeseries with the slug of a formula of their instrument are rejected
 func CreateTimeseries(db *sqlx.DB, tt []ts.Timeseries) ([]ts.Timeseries, error) {
 
 	txn, err := db.Beginx()
@@ -161,6 +182,15 @@ func CreateTimeseries(db *sqlx.DB, tt []ts.Timeseries) ([]ts.Timeseries, error)
 	// Insert
 	uu := make([]ts.Timeseries, len(tt))
 	for idx, t := range tt {
+		var exists bool
+		if err := txn.Get(&exists, formulaSlugExistsSQL, t.InstrumentID, t.Slug); err != nil {
+			txn.Rollback()
+			return make([]ts.Timeseries, 0), err
+		}
+		if exists {
+			txn.Rollback()
+			return make([]ts.Timeseries, 0), fmt.Errorf("slug '%s' is used by a formula of the instrument", t.Slug)
+		}
 		if err := stmt.Get(&uu[idx], t.InstrumentID, t.Slug, t.Name, t.ParameterID, t.UnitID); err != nil {
 			return make([]ts.Timeseries, 0), err
 		}
@@ -178,8 +208,24 @@ func CreateTimeseries(db *sqlx.DB, tt []ts.Timeseries) ([]ts.Timeseries, error)
 }
 
 // UpdateTimeseries updates a timeseries
+// Timeseries moved to an instrument with a formula of the same slug are rejected
 func UpdateTimeseries(db *sqlx.DB, t *ts.Timeseries) (*ts.Timeseries, error) {
 
+	var exists bool
+	if err := db.Get(
+		&exists,
+		`SELECT EXISTS (
+			SELECT 1 FROM formula f
+			INNER JOIN timeseries t ON t.slug = f.slug
+			WHERE t.id = $1 AND f.instrument_id = $2
+		)`,
+		t.ID, t.InstrumentID,
+	); err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf("slug of timeseries %s is used by a formula of the instrument", t.ID)
+	}
 	var tID uuid.UUID
 	if err := db.Get(&tID, `UPDATE timeseries
 		                   SET name = $2, instrument_id = $3, parameter_id = $4, unit_id = $5
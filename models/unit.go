@@ -1,6 +1,8 @@
 package models
 
 import (
+	"fmt"
+
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 )
@@ -14,6 +16,42 @@ type Unit struct {
 	UnitFamily   string    `json:"unit_family" db:"unit_family"`
 	MeasureID    uuid.UUID `json:"measure_id" db:"measure_id"`
 	Measure      string    `json:"measure"`
+	SIFactor     *float64  `json:"si_factor" db:"si_factor"`
+	SIOffset     float64   `json:"si_offset" db:"si_offset"`
+}
+
+// measureDimension groups measures that are stored separately but share an SI unit
+var measureDimension = map[string]string{
+	"linear speed": "velocity",
+	"volume rate":  "flow",
+}
+
+// Dimension returns the physical dimension of a unit; empty string if not known
+func (u Unit) Dimension() string {
+	if u.Measure == "unknown" {
+		return ""
+	}
+	if d, ok := measureDimension[u.Measure]; ok {
+		return d
+	}
+	return u.Measure
+}
+
+// Converter returns a function that converts values in unit u to unit target
+func (u Unit) Converter(target Unit) (func(float64) float64, error) {
+	if u.ID == target.ID {
+		return func(v float64) float64 { return v }, nil
+	}
+	if u.Dimension() == "" || u.Dimension() != target.Dimension() {
+		return nil, fmt.Errorf("cannot convert %s (%s) to %s (%s)", u.Name, u.Measure, target.Name, target.Measure)
+	}
+	if u.SIFactor == nil || target.SIFactor == nil {
+		return nil, fmt.Errorf("no conversion factor available between %s and %s", u.Name, target.Name)
+	}
+	from, to := u, target
+	return func(v float64) float64 {
+		return (v**from.SIFactor + from.SIOffset - to.SIOffset) / *to.SIFactor
+	}, nil
 }
 
 // ListUnits returns a slice of units
@@ -21,7 +59,7 @@ func ListUnits(db *sqlx.DB) ([]Unit, error) {
 	uu := make([]Unit, 0)
 	if err := db.Select(
 		&uu,
-		`SELECT id, name, abbreviation, unit_family_id, unit_family, measure_id, measure
+		`SELECT id, name, abbreviation, unit_family_id, unit_family, measure_id, measure, si_factor, si_offset
 		 FROM v_unit
 		 ORDER BY name`,
 	); err != nil {
@@ -29,3 +67,16 @@ func ListUnits(db *sqlx.DB) ([]Unit, error) {
 	}
 	return uu, nil
 }
+
+// GetUnitMap returns a map of { unit_id: Unit, }
+func GetUnitMap(db *sqlx.DB) (map[uuid.UUID]Unit, error) {
+	uu, err := ListUnits(db)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[uuid.UUID]Unit)
+	for _, u := range uu {
+		m[u.ID] = u
+	}
+	return m, nil
+}
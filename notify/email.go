@@ -0,0 +1,71 @@
+package notify
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// ErrSMTPNotConfigured is returned by Send if no SMTP host is configured
+var ErrSMTPNotConfigured = errors.New("notify: SMTP host is not configured")
+
+// SMTPConfig holds settings for sending email through an SMTP server
+// Username and Password are optional; if Username is empty, mail is sent without authentication
+// (e.g. to a local SMTP sink such as MailHog during development)
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+// Enabled returns true if an SMTP host is configured
+func (c *SMTPConfig) Enabled() bool {
+	return c != nil && c.Host != ""
+}
+
+// Email is a plain text email message
+type Email struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// message returns the email formatted as an RFC 5322 message
+func (e *Email) message(from string) []byte {
+	// Header values must not contain line breaks
+	header := strings.NewReplacer("\r", " ", "\n", " ")
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", header.Replace(from))
+	fmt.Fprintf(&b, "To: %s\r\n", header.Replace(strings.Join(e.To, ", ")))
+	fmt.Fprintf(&b, "Subject: %s\r\n", header.Replace(e.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"UTF-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(e.Body, "\r\n", "\n"), "\n", "\r\n"))
+	return b.Bytes()
+}
+
+// Send sends an email
+func (c *SMTPConfig) Send(e *Email) error {
+	if !c.Enabled() {
+		return ErrSMTPNotConfigured
+	}
+	if len(e.To) == 0 {
+		return errors.New("notify: email has no recipients")
+	}
+	port := c.Port
+	if port == 0 {
+		port = 25
+	}
+	var auth smtp.Auth
+	if c.Username != "" {
+		auth = smtp.PlainAuth("", c.Username, c.Password, c.Host)
+	}
+	return smtp.SendMail(fmt.Sprintf("%s:%d", c.Host, port), auth, c.From, e.To, e.message(c.From))
+}
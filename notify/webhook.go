@@ -0,0 +1,145 @@
+package notify
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Webhook request headers
+const (
+	WebhookEventHeader     = "X-MIDAS-Event"
+	WebhookDeliveryHeader  = "X-MIDAS-Delivery"
+	WebhookTimestampHeader = "X-MIDAS-Timestamp"
+	WebhookSignatureHeader = "X-MIDAS-Signature"
+)
+
+// webhookBlockedNetworks are the private, shared and reserved networks webhooks can not be posted to
+var webhookBlockedNetworks = func() []*net.IPNet {
+	nn := make([]*net.IPNet, 0)
+	for _, cidr := range []string{
+		"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "172.16.0.0/12", "192.0.0.0/24", "192.168.0.0/16",
+		"198.18.0.0/15", "240.0.0.0/4", "fc00::/7",
+	} {
+		_, n, _ := net.ParseCIDR(cidr)
+		nn = append(nn, n)
+	}
+	return nn
+}()
+
+// ErrWebhookAddress is returned for webhooks that resolve to loopback, link-local, private or reserved addresses
+var ErrWebhookAddress = errors.New("notify: webhook address is not public")
+
+// webhookAddressAllowed returns true if webhooks can be posted to an IP address
+func webhookAddressAllowed(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() ||
+		ip.IsUnspecified() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, n := range webhookBlockedNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateWebhookURL checks that a URL is an absolute http(s) URL whose host does not resolve to a loopback,
+// link-local, private or reserved address. Hosts that do not resolve are allowed; the address is checked again
+// each time a webhook is posted
+func ValidateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return errors.New("url must be an absolute http:// or https:// URL")
+	}
+	ips, err := net.LookupIP(u.Hostname())
+	if err != nil {
+		return nil
+	}
+	for _, ip := range ips {
+		if !webhookAddressAllowed(ip) {
+			return fmt.Errorf("url host %s resolves to an address that is not public", u.Hostname())
+		}
+	}
+	return nil
+}
+
+// webhookDialControl refuses connections to addresses webhooks can not be posted to; checked after DNS
+// resolution, so hosts that resolve to a different address after the webhook is saved are refused too
+func webhookDialControl(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !webhookAddressAllowed(ip) {
+		return ErrWebhookAddress
+	}
+	return nil
+}
+
+// webhookClient is the HTTP client used to post webhooks; receivers must respond within the timeout.
+// Redirects are not followed, and connections are only made to public addresses
+var webhookClient = &http.Client{
+	Timeout: 10 * time.Second,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   10 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Control:   webhookDialControl,
+		}).DialContext,
+		TLSHandshakeTimeout: 10 * time.Second,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+// Webhook is an HTTP endpoint that receives signed JSON payloads
+type Webhook struct {
+	URL    string
+	Secret string
+}
+
+// SignWebhook returns the signature of a webhook payload sent at timestamp (unix seconds)
+// The signature is the hex encoded HMAC-SHA256 of "timestamp.payload" using the webhook secret
+func SignWebhook(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send posts a JSON payload to the webhook
+// Returns the HTTP status code of the response (0 if no response), and an error if the status is not 2xx
+func (w *Webhook) Send(event, deliveryID string, payload []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "MIDAS-Webhook")
+	req.Header.Set(WebhookEventHeader, event)
+	req.Header.Set(WebhookDeliveryHeader, deliveryID)
+	req.Header.Set(WebhookTimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(WebhookSignatureHeader, SignWebhook(w.Secret, timestamp, payload))
+
+	resp, err := webhookClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("notify: webhook responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
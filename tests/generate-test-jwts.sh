@@ -0,0 +1,65 @@
+#!/bin/bash
+#
+# Generates a local RSA key pair for regression tests, writes the public key to jwks.json
+# and signs the JWTs in the postman environments with the private key.
+# The private key is deleted; run again to rotate the key and all test JWTs.
+#
+# Requires openssl, xxd and base64
+
+set -euo pipefail
+cd "$(dirname "$0")"
+
+KID="regression-test-key"
+ISSUER="http://localhost/auth/realms/regression-test"
+AUDIENCE="instrumentation-api"
+EXP=2000000000
+
+tmp=$(mktemp -d)
+trap 'rm -rf "$tmp"' EXIT
+
+b64url() {
+    base64 | tr -d '\n=' | tr '/+' '_-'
+}
+
+openssl genrsa -out "$tmp/key.pem" 2048 2>/dev/null
+# A second key the API does not know; used to test tokens signed by an unknown key
+openssl genrsa -out "$tmp/unknown.pem" 2048 2>/dev/null
+
+n=$(openssl rsa -in "$tmp/key.pem" -noout -modulus | cut -d= -f2 | xxd -r -p | b64url)
+cat > jwks.json <<EOF
+{
+    "keys": [
+        {"kty": "RSA", "kid": "$KID", "use": "sig", "alg": "RS512", "n": "$n", "e": "AQAB"}
+    ]
+}
+EOF
+
+# sign <key> <kid> <claims>
+sign() {
+    local header payload signature
+    header=$(printf '{"alg":"RS512","typ":"JWT","kid":"%s"}' "$2" | b64url)
+    payload=$(printf '%s' "$3" | b64url)
+    signature=$(printf '%s.%s' "$header" "$payload" | openssl dgst -sha512 -sign "$1" -binary | b64url)
+    printf '%s.%s.%s' "$header" "$payload" "$signature"
+}
+
+# claims <sub> <name> [issuer]
+claims() {
+    printf '{"sub":"%s","name":"%s","iss":"%s","aud":"%s","iat":1516239022,"exp":%d,"roles":["PUBLIC.USER"]}' \
+        "$1" "$2" "${3:-$ISSUER}" "$AUDIENCE" "$EXP"
+}
+
+# set_variable <name> <value>
+set_variable() {
+    for env in postman_environment.docker-compose.json postman_environment.local.json; do
+        sed -i "/\"key\": \"$1\"/{n;s|\"value\": \".*\"|\"value\": \"$2\"|}" "$env"
+    done
+}
+
+set_variable jwt_new_user "$(sign "$tmp/key.pem" "$KID" "$(claims 1 User.New)")"
+set_variable jwt_existing_admin "$(sign "$tmp/key.pem" "$KID" "$(claims 2 User.Admin)")"
+set_variable jwt_existing_user "$(sign "$tmp/key.pem" "$KID" "$(claims 3 User.User)")"
+set_variable jwt_project_member "$(sign "$tmp/key.pem" "$KID" "$(claims 4 User.Member)")"
+set_variable jwt_non_member "$(sign "$tmp/key.pem" "$KID" "$(claims 5 User.NonMember)")"
+set_variable jwt_wrong_issuer "$(sign "$tmp/key.pem" "$KID" "$(claims 2 User.Admin http://localhost/auth/realms/other)")"
+set_variable jwt_unknown_key "$(sign "$tmp/unknown.pem" unknown-key "$(claims 2 User.Admin)")"